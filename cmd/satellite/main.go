@@ -355,6 +355,17 @@ var (
 		Short: "Fix last_net entries in the database for satellites with DistinctIP=false",
 		RunE:  cmdFixLastNets,
 	}
+	metabaseCmd = &cobra.Command{
+		Use:   "metabase",
+		Short: "Metabase commands",
+	}
+	metabaseVerifyCmd = &cobra.Command{
+		Use:   "verify",
+		Short: "Verify the consistency of objects and segments",
+		Long: "Look for segments without an object and for objects with a wrong segment count in a stream ID range. " +
+			"Nothing is changed unless --fix is set. The report is written as JSON.",
+		RunE: cmdMetabaseVerify,
+	}
 
 	runCfg   Satellite
 	setupCfg Satellite
@@ -398,6 +409,13 @@ var (
 	setInvoiceStatusCfg struct {
 		DryRun bool `help:"do not update stripe" default:"false"`
 	}
+	metabaseVerifyCfg struct {
+		StartStreamID string `help:"verify only streams after this stream ID" default:""`
+		EndStreamID   string `help:"verify only streams up to and including this stream ID" default:""`
+		Limit         int    `help:"maximum number of inconsistencies of each kind to report" default:"1000"`
+		Fix           bool   `help:"delete orphaned segments and recompute segment counts instead of only reporting them" default:"false"`
+		Output        string `help:"destination of report output" default:""`
+	}
 
 	confDir     string
 	identityDir string
@@ -430,6 +448,7 @@ func init() {
 	rootCmd.AddCommand(fetchPiecesCmd)
 	rootCmd.AddCommand(repairSegmentCmd)
 	rootCmd.AddCommand(fixLastNetsCmd)
+	rootCmd.AddCommand(metabaseCmd)
 	reportsCmd.AddCommand(nodeUsageCmd)
 	reportsCmd.AddCommand(partnerAttributionCmd)
 	reportsCmd.AddCommand(reportsGracefulExitCmd)
@@ -458,6 +477,7 @@ func init() {
 	billingCmd.AddCommand(completePendingInvoiceTokenPaymentCmd)
 	billingCmd.AddCommand(stripeCustomerCmd)
 	consistencyCmd.AddCommand(consistencyGECleanupCmd)
+	metabaseCmd.AddCommand(metabaseVerifyCmd)
 	process.Bind(runCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(runMigrationCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(runAPICmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
@@ -499,6 +519,8 @@ func init() {
 	process.Bind(stripeCustomerCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(consistencyGECleanupCmd, &consistencyGECleanupCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(fixLastNetsCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
+	process.Bind(metabaseVerifyCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
+	process.Bind(metabaseVerifyCmd, &metabaseVerifyCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 
 	if err := consistencyGECleanupCmd.MarkFlagRequired("before"); err != nil {
 		panic(err)
@@ -0,0 +1,107 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/spf13/cobra"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/process"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/metabase"
+)
+
+type consistencyReport struct {
+	Fix                    bool                   `json:"fix"`
+	OrphanedStreams        []orphanedStream       `json:"orphanedStreams"`
+	SegmentCountMismatches []segmentCountMismatch `json:"segmentCountMismatches"`
+	DeletedSegments        int64                  `json:"deletedSegments"`
+	FixedObjects           int64                  `json:"fixedObjects"`
+}
+
+type orphanedStream struct {
+	StreamID uuid.UUID `json:"streamId"`
+	Segments int64     `json:"segments"`
+}
+
+type segmentCountMismatch struct {
+	ProjectID    uuid.UUID `json:"projectId"`
+	BucketName   string    `json:"bucketName"`
+	ObjectKey    []byte    `json:"objectKey"`
+	Version      int64     `json:"version"`
+	StreamID     uuid.UUID `json:"streamId"`
+	SegmentCount int32     `json:"segmentCount"`
+	Segments     int64     `json:"segments"`
+}
+
+func cmdMetabaseVerify(cmd *cobra.Command, args []string) (err error) {
+	ctx, _ := process.Ctx(cmd)
+	log := zap.L()
+
+	opts := metabase.VerifyConsistency{
+		Limit: metabaseVerifyCfg.Limit,
+		Fix:   metabaseVerifyCfg.Fix,
+	}
+	if metabaseVerifyCfg.StartStreamID != "" {
+		opts.StartStreamID, err = uuid.FromString(metabaseVerifyCfg.StartStreamID)
+		if err != nil {
+			return errs.New("invalid start stream ID: %+v", err)
+		}
+	}
+	if metabaseVerifyCfg.EndStreamID != "" {
+		opts.EndStreamID, err = uuid.FromString(metabaseVerifyCfg.EndStreamID)
+		if err != nil {
+			return errs.New("invalid end stream ID: %+v", err)
+		}
+	}
+
+	metabaseDB, err := metabase.Open(ctx, log.Named("metabase"), runCfg.Metainfo.DatabaseURL,
+		runCfg.Config.Metainfo.Metabase("satellite-metabase-verify"))
+	if err != nil {
+		return errs.New("Error creating metabase connection: %+v", err)
+	}
+	defer func() {
+		err = errs.Combine(err, metabaseDB.Close())
+	}()
+
+	result, err := metabaseDB.VerifyConsistency(ctx, opts)
+	if err != nil {
+		return err
+	}
+
+	report := consistencyReport{
+		Fix:                    opts.Fix,
+		OrphanedStreams:        make([]orphanedStream, 0, len(result.OrphanedStreams)),
+		SegmentCountMismatches: make([]segmentCountMismatch, 0, len(result.SegmentCountMismatches)),
+		DeletedSegments:        result.DeletedSegments,
+		FixedObjects:           result.FixedObjects,
+	}
+	for _, stream := range result.OrphanedStreams {
+		report.OrphanedStreams = append(report.OrphanedStreams, orphanedStream{
+			StreamID: stream.StreamID,
+			Segments: stream.Segments,
+		})
+	}
+	for _, mismatch := range result.SegmentCountMismatches {
+		report.SegmentCountMismatches = append(report.SegmentCountMismatches, segmentCountMismatch{
+			ProjectID:    mismatch.ProjectID,
+			BucketName:   mismatch.BucketName,
+			ObjectKey:    []byte(mismatch.ObjectKey),
+			Version:      int64(mismatch.Version),
+			StreamID:     mismatch.StreamID,
+			SegmentCount: mismatch.SegmentCount,
+			Segments:     mismatch.Segments,
+		})
+	}
+
+	return runWithOutput(metabaseVerifyCfg.Output, func(w io.Writer) error {
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(report)
+	})
+}
@@ -104,9 +104,9 @@ func createEndpoint(ctx context.Context, satIdent, snIdent *identity.FullIdentit
 
 	monitorService := monitor.NewService(log, piecesStore, contactService, 1<<40, time.Hour, func(context.Context) {}, cfg.Storage2.Monitor)
 
-	retainService := retain.NewService(log, piecesStore, cfg.Retain)
+	retainService := retain.NewService(log, piecesStore, nil, cfg.Retain)
 
-	trashChore := pieces.NewTrashChore(log, 24*time.Hour, 7*24*time.Hour, trustPool, piecesStore)
+	trashChore := pieces.NewTrashChore(log, 24*time.Hour, 7*24*time.Hour, trustPool, piecesStore, nil)
 
 	pieceDeleter := pieces.NewDeleter(log, piecesStore, cfg.Storage2.DeleteWorkers, cfg.Storage2.DeleteQueueSize)
 
@@ -115,8 +115,8 @@ func createEndpoint(ctx context.Context, satIdent, snIdent *identity.FullIdentit
 	usedSerials := usedserials.NewTable(cfg.Storage2.MaxUsedSerialsSize)
 
 	bandwidthdbCache := bandwidth.NewCache(snDB.Bandwidth())
-	endpoint := try.E1(piecestore.NewEndpoint(log, snIdent, trustPool, monitorService, retainService, new(contact.PingStats), piecesStore, trashChore, pieceDeleter, ordersStore, bandwidthdbCache, usedSerials, cfg.Storage2))
-	collectorService := collector.NewService(log, piecesStore, usedSerials, collector.Config{Interval: 1000 * time.Hour})
+	endpoint := try.E1(piecestore.NewEndpoint(log, snIdent, trustPool, monitorService, retainService, new(contact.PingStats), piecesStore, trashChore, pieceDeleter, ordersStore, bandwidthdbCache, usedSerials, nil, nil, cfg.Storage2))
+	collectorService := collector.NewService(log, piecesStore, usedSerials, nil, collector.Config{Interval: 1000 * time.Hour})
 
 	return endpoint, collectorService
 }
@@ -6,6 +6,7 @@ package controllers
 import (
 	"encoding/json"
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/gorilla/mux"
@@ -319,6 +320,96 @@ func (controller *Storage) DiskSpace(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// defaultForecastDays is the number of days of usage history used for forecasts by default.
+const defaultForecastDays = 30
+
+// Forecasts returns disk space forecasts of all storagenodes, ordered by the time until they are full.
+func (controller *Storage) Forecasts(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Add("Content-Type", "application/json")
+
+	period, err := forecastPeriod(r)
+	if err != nil {
+		controller.serveError(w, http.StatusBadRequest, ErrStorage.Wrap(err))
+		return
+	}
+
+	forecasts, err := controller.service.Forecasts(ctx, period)
+	if err != nil {
+		controller.log.Error("could not get forecasts", zap.Error(err))
+		controller.serveError(w, http.StatusInternalServerError, ErrStorage.Wrap(err))
+		return
+	}
+
+	if err = json.NewEncoder(w).Encode(forecasts); err != nil {
+		controller.log.Error("failed to write json response", zap.Error(err))
+		return
+	}
+}
+
+// Forecast returns disk space forecast of concrete storagenode.
+func (controller *Storage) Forecast(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Add("Content-Type", "application/json")
+	segments := mux.Vars(r)
+
+	nodeIDparam, ok := segments["nodeID"]
+	if !ok {
+		controller.serveError(w, http.StatusBadRequest, ErrStorage.New("node id is missing"))
+		return
+	}
+	nodeID, err := storj.NodeIDFromString(nodeIDparam)
+	if err != nil {
+		controller.serveError(w, http.StatusBadRequest, ErrStorage.Wrap(err))
+		return
+	}
+
+	period, err := forecastPeriod(r)
+	if err != nil {
+		controller.serveError(w, http.StatusBadRequest, ErrStorage.Wrap(err))
+		return
+	}
+
+	forecast, err := controller.service.Forecast(ctx, nodeID, period)
+	if err != nil {
+		if nodes.ErrNoNode.Has(err) {
+			controller.serveError(w, http.StatusNotFound, ErrStorage.Wrap(err))
+			return
+		}
+
+		controller.log.Error("could not get forecast", zap.Error(err))
+		controller.serveError(w, http.StatusInternalServerError, ErrStorage.Wrap(err))
+		return
+	}
+
+	if err = json.NewEncoder(w).Encode(forecast); err != nil {
+		controller.log.Error("failed to write json response", zap.Error(err))
+		return
+	}
+}
+
+// forecastPeriod returns the usage history period requested with the days query parameter.
+func forecastPeriod(r *http.Request) (time.Duration, error) {
+	days := defaultForecastDays
+	if daysParam := r.URL.Query().Get("days"); daysParam != "" {
+		var err error
+		days, err = strconv.Atoi(daysParam)
+		if err != nil {
+			return 0, err
+		}
+		if days < 2 {
+			return 0, errs.New("days must be at least 2")
+		}
+	}
+	return time.Duration(days) * 24 * time.Hour, nil
+}
+
 // serveError set http statuses and send json error.
 func (controller *Storage) serveError(w http.ResponseWriter, status int, err error) {
 	w.WriteHeader(status)
@@ -128,6 +128,8 @@ func NewServer(log *zap.Logger, listener net.Listener, assets fs.FS, services Se
 	storageRouter.HandleFunc("/satellites/{satelliteID}/usage/{nodeID}", storageController.UsageSatellite).Methods(http.MethodGet)
 	storageRouter.HandleFunc("/disk-space", storageController.TotalDiskSpace).Methods(http.MethodGet)
 	storageRouter.HandleFunc("/disk-space/{nodeID}", storageController.DiskSpace).Methods(http.MethodGet)
+	storageRouter.HandleFunc("/forecast", storageController.Forecasts).Methods(http.MethodGet)
+	storageRouter.HandleFunc("/forecast/{nodeID}", storageController.Forecast).Methods(http.MethodGet)
 
 	reputationController := controllers.NewReputation(server.log, server.reputation)
 	reputationRouter := apiRouter.PathPrefix("/reputation").Subrouter()
@@ -186,6 +186,74 @@ func (service *Service) DiskSpace(ctx context.Context, nodeID storj.NodeID) (_ D
 	return service.dialDiskSpace(ctx, node)
 }
 
+// Forecast projects when the allocated disk space of a node runs out, based on the
+// growth of its used space over the forecast period.
+func (service *Service) Forecast(ctx context.Context, nodeID storj.NodeID, period time.Duration) (_ Forecast, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	node, err := service.nodes.Get(ctx, nodeID)
+	if err != nil {
+		return Forecast{}, Error.Wrap(err)
+	}
+
+	forecast, err := service.forecast(ctx, node, period)
+	return forecast, Error.Wrap(err)
+}
+
+// Forecasts projects when the allocated disk space of every reachable node runs out,
+// ordered so the nodes which run out of space first come first.
+func (service *Service) Forecasts(ctx context.Context, period time.Duration) (_ []Forecast, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	listNodes, err := service.nodes.List(ctx)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	forecasts := make([]Forecast, 0, len(listNodes))
+	for _, node := range listNodes {
+		forecast, err := service.forecast(ctx, node, period)
+		if err != nil {
+			if nodes.ErrNodeNotReachable.Has(err) {
+				continue
+			}
+
+			return nil, Error.Wrap(err)
+		}
+
+		forecasts = append(forecasts, forecast)
+	}
+
+	SortForecasts(forecasts)
+	return forecasts, nil
+}
+
+// forecast dials node and projects when its allocated disk space runs out.
+func (service *Service) forecast(ctx context.Context, node nodes.Node, period time.Duration) (_ Forecast, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	to := time.Now()
+	usage, err := service.dialUsage(ctx, node, to.Add(-period), to)
+	if err != nil {
+		return Forecast{}, err
+	}
+
+	diskSpace, err := service.dialDiskSpace(ctx, node)
+	if err != nil {
+		return Forecast{}, err
+	}
+
+	cache := make(UsageStampDailyCache)
+	for _, stamp := range usage.Stamps {
+		cache.Add(stamp)
+	}
+
+	forecast := NewForecast(cache.Sorted(), diskSpace)
+	forecast.NodeID = node.ID
+	forecast.NodeName = node.Name
+	return forecast, nil
+}
+
 // dialDiskSpace dials node and retrieves all info about concrete storagenode disk space usage.
 func (service *Service) dialDiskSpace(ctx context.Context, node nodes.Node) (diskSpace DiskSpace, err error) {
 	conn, err := service.dialer.DialNodeURL(ctx, storj.NodeURL{
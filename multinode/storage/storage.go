@@ -6,6 +6,8 @@ package storage
 import (
 	"sort"
 	"time"
+
+	"storj.io/common/storj"
 )
 
 // Usage holds storage usage stamps and summary for a particular period.
@@ -85,3 +87,73 @@ func (diskSpace *DiskSpace) Add(space DiskSpace) {
 	diskSpace.Available += space.Available
 	diskSpace.Overused += space.Overused
 }
+
+// Forecast holds the projected growth of the used space of a storagenode.
+type Forecast struct {
+	NodeID    storj.NodeID `json:"nodeId"`
+	NodeName  string       `json:"nodeName"`
+	Available int64        `json:"available"`
+	// DailyGrowth is the average amount of bytes by which used space grows per day.
+	DailyGrowth float64 `json:"dailyGrowth"`
+	// DaysToFull is the estimated number of days until the allocated space runs out.
+	// It's nil when the used space isn't growing.
+	DaysToFull *float64 `json:"daysToFull"`
+}
+
+// NewForecast projects when the available disk space runs out based on the daily usage stamps.
+func NewForecast(stamps []UsageStamp, diskSpace DiskSpace) Forecast {
+	forecast := Forecast{
+		Available:   diskSpace.Available,
+		DailyGrowth: dailyGrowth(stamps),
+	}
+
+	if forecast.DailyGrowth > 0 {
+		days := 0.0
+		if diskSpace.Available > 0 {
+			days = float64(diskSpace.Available) / forecast.DailyGrowth
+		}
+		forecast.DaysToFull = &days
+	}
+
+	return forecast
+}
+
+// dailyGrowth returns the least squares slope of the stored bytes per day.
+func dailyGrowth(stamps []UsageStamp) float64 {
+	if len(stamps) < 2 {
+		return 0
+	}
+
+	first := stamps[0].IntervalStart
+	var sumX, sumY, sumXY, sumXX float64
+	for _, stamp := range stamps {
+		x := stamp.IntervalStart.Sub(first).Hours() / 24
+		y := stamp.AtRestTotalBytes
+		sumX += x
+		sumY += y
+		sumXY += x * y
+		sumXX += x * x
+	}
+
+	n := float64(len(stamps))
+	denominator := n*sumXX - sumX*sumX
+	if denominator == 0 {
+		return 0
+	}
+	return (n*sumXY - sumX*sumY) / denominator
+}
+
+// SortForecasts sorts forecasts so nodes which run out of space first come first.
+func SortForecasts(forecasts []Forecast) {
+	sort.SliceStable(forecasts, func(i, j int) bool {
+		a, b := forecasts[i].DaysToFull, forecasts[j].DaysToFull
+		switch {
+		case a == nil:
+			return false
+		case b == nil:
+			return true
+		default:
+			return *a < *b
+		}
+	})
+}
@@ -227,3 +227,48 @@ func TestDiskSpaceAdd(t *testing.T) {
 		assert.Equal(t, totalDiskSpace, test.expected)
 	}
 }
+
+func TestNewForecast(t *testing.T) {
+	start := time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC)
+	stamps := func(bytes ...float64) []storage.UsageStamp {
+		var result []storage.UsageStamp
+		for i, b := range bytes {
+			result = append(result, storage.UsageStamp{
+				AtRestTotalBytes: b,
+				IntervalStart:    start.AddDate(0, 0, i),
+			})
+		}
+		return result
+	}
+
+	t.Run("growing", func(t *testing.T) {
+		forecast := storage.NewForecast(stamps(100, 200, 300, 400), storage.DiskSpace{Available: 1000})
+		require.InDelta(t, 100, forecast.DailyGrowth, 1e-9)
+		require.NotNil(t, forecast.DaysToFull)
+		require.InDelta(t, 10, *forecast.DaysToFull, 1e-9)
+	})
+
+	t.Run("shrinking", func(t *testing.T) {
+		forecast := storage.NewForecast(stamps(400, 300, 200), storage.DiskSpace{Available: 1000})
+		require.Less(t, forecast.DailyGrowth, 0.0)
+		require.Nil(t, forecast.DaysToFull)
+	})
+
+	t.Run("not enough history", func(t *testing.T) {
+		forecast := storage.NewForecast(stamps(400), storage.DiskSpace{Available: 1000})
+		require.Zero(t, forecast.DailyGrowth)
+		require.Nil(t, forecast.DaysToFull)
+	})
+
+	t.Run("sort", func(t *testing.T) {
+		forecasts := []storage.Forecast{
+			storage.NewForecast(stamps(0, 0), storage.DiskSpace{Available: 1000}),
+			storage.NewForecast(stamps(0, 10), storage.DiskSpace{Available: 1000}),
+			storage.NewForecast(stamps(0, 100), storage.DiskSpace{Available: 1000}),
+		}
+		storage.SortForecasts(forecasts)
+		require.InDelta(t, 10, *forecasts[0].DaysToFull, 1e-9)
+		require.InDelta(t, 100, *forecasts[1].DaysToFull, 1e-9)
+		require.Nil(t, forecasts[2].DaysToFull)
+	})
+}
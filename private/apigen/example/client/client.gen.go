@@ -0,0 +1,249 @@
+// AUTOGENERATED BY private/apigen
+// DO NOT EDIT.
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/private/apigen/example"
+	"storj.io/storj/private/apigen/example/myapi"
+)
+
+const dateLayout = "2006-01-02T15:04:05.999Z"
+
+// ErrExampleClient is the error class of the errors of the API client.
+var ErrExampleClient = errs.Class("example api client")
+
+// APIError is the error returned when the API responds with an error status code.
+type APIError struct {
+	// Status is the HTTP status code of the response.
+	Status int
+	// Message is the error message of the response.
+	Message string
+}
+
+// Error returns the status code and the error message.
+func (e *APIError) Error() string {
+	return fmt.Sprintf("%d %s: %s", e.Status, http.StatusText(e.Status), e.Message)
+}
+
+// Client is an API client.
+type Client struct {
+	// BaseURL is the URL of the server without the path of the API, e.g. "https://example.test".
+	BaseURL string
+	// HTTPClient sends the requests. When nil, http.DefaultClient is used.
+	HTTPClient *http.Client
+	// Header is added to every request, e.g. to authenticate it.
+	Header http.Header
+}
+
+// do sends a request to the path with the query, the JSON encoded request body, if it isn't nil,
+// and decodes the JSON response body into response, if it isn't nil.
+func (c *Client) do(ctx context.Context, method, path string, query url.Values, request, response interface{}) (err error) {
+	fullURL := strings.TrimSuffix(c.BaseURL, "/") + path
+	if len(query) > 0 {
+		fullURL += "?" + query.Encode()
+	}
+
+	var body io.Reader = http.NoBody
+	if request != nil {
+		data, err := json.Marshal(request)
+		if err != nil {
+			return ErrExampleClient.Wrap(err)
+		}
+		body = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, fullURL, body)
+	if err != nil {
+		return ErrExampleClient.Wrap(err)
+	}
+	for key, values := range c.Header {
+		req.Header[key] = values
+	}
+	if request != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	httpClient := c.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return ErrExampleClient.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, ErrExampleClient.Wrap(resp.Body.Close())) }()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		var apiErr struct {
+			Error string `json:"error"`
+		}
+		// the body of some errors, e.g. from a proxy, isn't JSON.
+		_ = json.NewDecoder(resp.Body).Decode(&apiErr)
+		return &APIError{Status: resp.StatusCode, Message: apiErr.Error}
+	}
+
+	if response == nil {
+		return nil
+	}
+	return ErrExampleClient.Wrap(json.NewDecoder(resp.Body).Decode(response))
+}
+
+// Documents returns the client of the Documents API endpoints.
+func (c *Client) Documents() *DocumentsClient {
+	return &DocumentsClient{client: c}
+}
+
+// DocumentsClient is an API client of the Documents API endpoints.
+type DocumentsClient struct {
+	client *Client
+}
+
+// Get calls the "Get Documents" endpoint.
+//
+// Get the paths to all the documents under the specified paths
+func (c *DocumentsClient) Get(ctx context.Context) (_ []myapi.Document, err error) {
+	fullPath := "/api/v0/docs/"
+	var response []myapi.Document
+	err = c.client.do(ctx, "GET", fullPath, nil, nil, &response)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// GetOne calls the "Get One" endpoint.
+//
+// Get the document in the specified path
+func (c *DocumentsClient) GetOne(ctx context.Context, path string) (_ *myapi.Document, err error) {
+	fullPath := "/api/v0/docs/" + url.PathEscape(path)
+	var response myapi.Document
+	err = c.client.do(ctx, "GET", fullPath, nil, nil, &response)
+	if err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// GetTag calls the "Get a tag" endpoint.
+//
+// Get the tag of the document in the specified path and tag label
+func (c *DocumentsClient) GetTag(ctx context.Context, path string, tagName string) (_ *[2]string, err error) {
+	fullPath := "/api/v0/docs/" + url.PathEscape(path) + "/tag/" + url.PathEscape(tagName)
+	var response [2]string
+	err = c.client.do(ctx, "GET", fullPath, nil, nil, &response)
+	if err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// GetVersions calls the "Get Version" endpoint.
+//
+// Get all the version of the document in the specified path
+func (c *DocumentsClient) GetVersions(ctx context.Context, path string) (_ []myapi.Version, err error) {
+	fullPath := "/api/v0/docs/" + url.PathEscape(path) + "/versions"
+	var response []myapi.Version
+	err = c.client.do(ctx, "GET", fullPath, nil, nil, &response)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// UpdateContent calls the "Update Content" endpoint.
+//
+// Update the content of the document with the specified path and ID if the last update is before the indicated date
+func (c *DocumentsClient) UpdateContent(ctx context.Context, path string, id uuid.UUID, date time.Time, request myapi.NewDocument) (_ *myapi.Document, err error) {
+	fullPath := "/api/v0/docs/" + url.PathEscape(path)
+	query := url.Values{}
+	query.Set("id", id.String())
+	query.Set("date", date.Format(dateLayout))
+	var response myapi.Document
+	err = c.client.do(ctx, "POST", fullPath, query, request, &response)
+	if err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// Users returns the client of the Users API endpoints.
+func (c *Client) Users() *UsersClient {
+	return &UsersClient{client: c}
+}
+
+// UsersClient is an API client of the Users API endpoints.
+type UsersClient struct {
+	client *Client
+}
+
+// Get calls the "Get Users" endpoint.
+//
+// Get the list of registered users
+func (c *UsersClient) Get(ctx context.Context) (_ []myapi.User, err error) {
+	fullPath := "/api/v0/users/"
+	var response []myapi.User
+	err = c.client.do(ctx, "GET", fullPath, nil, nil, &response)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// Create calls the "Create Users" endpoint.
+//
+// Create users
+func (c *UsersClient) Create(ctx context.Context, request []myapi.User) (err error) {
+	fullPath := "/api/v0/users/"
+	return c.client.do(ctx, "POST", fullPath, nil, request, nil)
+}
+
+// GetAge calls the "Get User's age" endpoint.
+//
+// Get the user's age
+func (c *UsersClient) GetAge(ctx context.Context) (_ *myapi.UserAge[int16], err error) {
+	fullPath := "/api/v0/users/age"
+	var response myapi.UserAge[int16]
+	err = c.client.do(ctx, "GET", fullPath, nil, nil, &response)
+	if err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// Projects returns the client of the Projects API endpoints.
+func (c *Client) Projects() *ProjectsClient {
+	return &ProjectsClient{client: c}
+}
+
+// ProjectsClient is an API client of the Projects API endpoints.
+type ProjectsClient struct {
+	client *Client
+}
+
+// CreateProject calls the "Create Projects" endpoint.
+//
+// Create projects
+func (c *ProjectsClient) CreateProject(ctx context.Context, request example.Project) (_ *example.Project, err error) {
+	fullPath := "/api/v0/projects/"
+	var response example.Project
+	err = c.client.do(ctx, "POST", fullPath, nil, request, &response)
+	if err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
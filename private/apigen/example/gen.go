@@ -187,6 +187,8 @@ func main() {
 	a.MustWriteTS(filepath.Join("private", "apigen", "example", "client-api.gen.ts"))
 	a.MustWriteTSMock(filepath.Join("private", "apigen", "example", "client-api-mock.gen.ts"))
 	a.MustWriteDocs(filepath.Join("private", "apigen", "example", "apidocs.gen.md"))
+	a.MustWriteOpenAPI(filepath.Join("private", "apigen", "example", "openapi.gen.json"))
+	a.MustWriteGoClient(filepath.Join("private", "apigen", "example", "client", "client.gen.go"))
 }
 
 // authMiddleware customize endpoints to authenticate requests by API Key or Cookie.
@@ -0,0 +1,347 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package apigen
+
+import (
+	"fmt"
+	"go/format"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/uuid"
+)
+
+// MustWriteGoClient writes generated Go code of an API client into a file indicated by path.
+// The package of the generated code is named after the directory of path, and it imports the
+// packages of the request and response types.
+//
+// If an error occurs, it panics.
+func (a *API) MustWriteGoClient(path string) {
+	rootDir := a.outputRootDir()
+	fullpath := filepath.Join(rootDir, path)
+
+	generated, err := a.generateGoClient(filepath.Base(filepath.Dir(fullpath)))
+	if err != nil {
+		panic(err)
+	}
+
+	err = os.MkdirAll(filepath.Dir(fullpath), 0700)
+	if err != nil {
+		panic(errs.Wrap(err))
+	}
+
+	err = os.WriteFile(fullpath, generated, 0644)
+	if err != nil {
+		panic(errs.Wrap(err))
+	}
+}
+
+// generateGoClient generates the code of an API client in the package packageName and returns an
+// output.
+func (a *API) generateGoClient(packageName string) ([]byte, error) {
+	if a.PackagePath == "" {
+		return nil, errs.New("Package path must be defined")
+	}
+
+	apiPackageName := a.PackageName
+	if apiPackageName == "" {
+		parts := strings.Split(a.PackagePath, "/")
+		apiPackageName = parts[len(parts)-1]
+	}
+
+	result := &StringBuilder{}
+	pf := result.Writelnf
+
+	imports := newGoImports("")
+	i := imports.add
+	i(
+		"bytes", "context", "encoding/json", "fmt", "io", "net/http", "net/url", "strings",
+		"github.com/zeebo/errs",
+	)
+
+	typeRef := func(t reflect.Type) string {
+		return goClientTypeRef(t, i)
+	}
+
+	pf("// Err%sClient is the error class of the errors of the API client.", capitalize(apiPackageName))
+	pf("var Err%sClient = errs.Class(\"%s api client\")", capitalize(apiPackageName), apiPackageName)
+	pf("")
+	pf(goClientBase, capitalize(apiPackageName))
+
+	for _, group := range a.EndpointGroups {
+		cname := capitalize(group.Name)
+
+		pf("")
+		pf("// %s returns the client of the %s API endpoints.", cname, group.Name)
+		pf("func (c *Client) %s() *%sClient {", cname, cname)
+		pf("return &%sClient{client: c}", cname)
+		pf("}")
+		pf("")
+		pf("// %sClient is an API client of the %s API endpoints.", cname, group.Name)
+		pf("type %sClient struct {", cname)
+		pf("client *Client")
+		pf("}")
+
+		for _, endpoint := range group.endpoints {
+			var args []string
+			for _, param := range append(endpoint.PathParams, endpoint.QueryParams...) {
+				args = append(args, fmt.Sprintf("%s %s", param.Name, typeRef(param.Type)))
+			}
+			if endpoint.Request != nil {
+				args = append(args, "request "+typeRef(reflect.TypeOf(endpoint.Request)))
+			}
+
+			var responseType, returnType string
+			if endpoint.Response != nil {
+				t := reflect.TypeOf(endpoint.Response)
+				responseType = typeRef(t)
+				returnType = responseType
+				if !isNillableType(t) {
+					returnType = "*" + returnType
+				}
+			}
+
+			path, err := goClientPath(a.endpointBasePath()+"/"+strings.ToLower(group.Prefix), endpoint, i)
+			if err != nil {
+				return nil, err
+			}
+
+			pf("")
+			pf("// %s calls the %q endpoint.", endpoint.GoName, endpoint.Name)
+			pf("//")
+			pf("// %s", endpoint.Description)
+			if returnType != "" {
+				pf(
+					"func (c *%sClient) %s(ctx context.Context, %s) (_ %s, err error) {",
+					cname, endpoint.GoName, strings.Join(args, ", "), returnType,
+				)
+			} else {
+				pf(
+					"func (c *%sClient) %s(ctx context.Context, %s) (err error) {",
+					cname, endpoint.GoName, strings.Join(args, ", "),
+				)
+			}
+			pf("fullPath := %s", path)
+
+			query := "nil"
+			if len(endpoint.QueryParams) > 0 {
+				query = "query"
+				pf("query := url.Values{}")
+				for _, param := range endpoint.QueryParams {
+					value, err := goClientParamValue(param, i)
+					if err != nil {
+						return nil, err
+					}
+					pf("query.Set(\"%s\", %s)", param.Name, value)
+				}
+			}
+
+			request := "nil"
+			if endpoint.Request != nil {
+				request = "request"
+			}
+
+			if returnType == "" {
+				pf("return c.client.do(ctx, \"%s\", fullPath, %s, %s, nil)", endpoint.Method, query, request)
+				pf("}")
+				continue
+			}
+
+			pf("var response %s", responseType)
+			pf("err = c.client.do(ctx, \"%s\", fullPath, %s, %s, &response)", endpoint.Method, query, request)
+			pf("if err != nil {")
+			pf("return nil, err")
+			pf("}")
+			if isNillableType(reflect.TypeOf(endpoint.Response)) {
+				pf("return response, nil")
+			} else {
+				pf("return &response, nil")
+			}
+			pf("}")
+		}
+	}
+
+	fileBody := result.String()
+	result = &StringBuilder{}
+	pf = result.Writelnf
+
+	pf("// AUTOGENERATED BY private/apigen")
+	pf("// DO NOT EDIT.")
+	pf("")
+
+	pf("package %s", packageName)
+	pf("")
+
+	imports.write(pf)
+
+	if imports.has("time") {
+		pf("const dateLayout = \"%s\"", DateFormat)
+		pf("")
+	}
+
+	result.WriteString(fileBody)
+
+	output, err := format.Source([]byte(result.String()))
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+
+	return output, nil
+}
+
+// goClientBase is the code of the API client, which is the same for all the APIs except for the
+// name of the error class.
+const goClientBase = `// APIError is the error returned when the API responds with an error status code.
+type APIError struct {
+	// Status is the HTTP status code of the response.
+	Status int
+	// Message is the error message of the response.
+	Message string
+}
+
+// Error returns the status code and the error message.
+func (e *APIError) Error() string {
+	return fmt.Sprintf("%%d %%s: %%s", e.Status, http.StatusText(e.Status), e.Message)
+}
+
+// Client is an API client.
+type Client struct {
+	// BaseURL is the URL of the server without the path of the API, e.g. "https://example.test".
+	BaseURL string
+	// HTTPClient sends the requests. When nil, http.DefaultClient is used.
+	HTTPClient *http.Client
+	// Header is added to every request, e.g. to authenticate it.
+	Header http.Header
+}
+
+// do sends a request to the path with the query, the JSON encoded request body, if it isn't nil,
+// and decodes the JSON response body into response, if it isn't nil.
+func (c *Client) do(ctx context.Context, method, path string, query url.Values, request, response interface{}) (err error) {
+	fullURL := strings.TrimSuffix(c.BaseURL, "/") + path
+	if len(query) > 0 {
+		fullURL += "?" + query.Encode()
+	}
+
+	var body io.Reader = http.NoBody
+	if request != nil {
+		data, err := json.Marshal(request)
+		if err != nil {
+			return Err%[1]sClient.Wrap(err)
+		}
+		body = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, fullURL, body)
+	if err != nil {
+		return Err%[1]sClient.Wrap(err)
+	}
+	for key, values := range c.Header {
+		req.Header[key] = values
+	}
+	if request != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	httpClient := c.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return Err%[1]sClient.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, Err%[1]sClient.Wrap(resp.Body.Close())) }()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		var apiErr struct {
+			Error string ` + "`json:\"error\"`" + `
+		}
+		// the body of some errors, e.g. from a proxy, isn't JSON.
+		_ = json.NewDecoder(resp.Body).Decode(&apiErr)
+		return &APIError{Status: resp.StatusCode, Message: apiErr.Error}
+	}
+
+	if response == nil {
+		return nil
+	}
+	return Err%[1]sClient.Wrap(json.NewDecoder(resp.Body).Decode(response))
+}`
+
+// goClientTypeRef returns the reference of the type t in the generated API client code, which
+// refers to the named types through the package names used by goImports, and imports their
+// packages.
+func goClientTypeRef(t reflect.Type, i func(paths ...string)) string {
+	if t.Name() != "" {
+		if t.PkgPath() == "" {
+			return t.Name()
+		}
+
+		i(t.PkgPath())
+		pkgName, _ := importPath(t.PkgPath()).PkgName()
+		return pkgName + "." + t.Name()
+	}
+
+	switch t.Kind() {
+	case reflect.Array:
+		return fmt.Sprintf("[%d]%s", t.Len(), goClientTypeRef(t.Elem(), i))
+	case reflect.Slice:
+		return "[]" + goClientTypeRef(t.Elem(), i)
+	case reflect.Pointer:
+		return "*" + goClientTypeRef(t.Elem(), i)
+	case reflect.Map:
+		return fmt.Sprintf("map[%s]%s", goClientTypeRef(t.Key(), i), goClientTypeRef(t.Elem(), i))
+	}
+
+	return t.String()
+}
+
+// goClientPath returns the Go expression of the path of endpoint in the generated API client code,
+// whose path parameters are replaced by their values.
+func goClientPath(groupPath string, endpoint *FullEndpoint, i func(paths ...string)) (string, error) {
+	path := groupPath + endpoint.Path
+	for _, param := range endpoint.PathParams {
+		value, err := goClientParamValue(param, i)
+		if err != nil {
+			return "", err
+		}
+
+		placeholder := "{" + param.Name + "}"
+		if !strings.Contains(path, placeholder) {
+			return "", errs.New("path %q doesn't contain the path parameter %q", endpoint.Path, param.Name)
+		}
+		i("net/url")
+		path = strings.Replace(path, placeholder, `" + url.PathEscape(`+value+`) + "`, 1)
+	}
+
+	path = `"` + path + `"`
+	return strings.TrimSuffix(path, ` + ""`), nil
+}
+
+// goClientParamValue returns the Go expression of the string value of the parameter in the
+// generated API client code.
+func goClientParamValue(param Param, i func(paths ...string)) (string, error) {
+	switch param.Type {
+	case reflect.TypeOf(uuid.UUID{}):
+		return param.Name + ".String()", nil
+	case reflect.TypeOf(time.Time{}):
+		i("time")
+		return param.Name + ".Format(dateLayout)", nil
+	}
+
+	switch param.Type.Kind() {
+	case reflect.String:
+		return param.Name, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		i("strconv")
+		return fmt.Sprintf("strconv.FormatUint(uint64(%s), 10)", param.Name), nil
+	default:
+		return "", errs.New("Unsupported parameter type \"%s\"", param.Type)
+	}
+}
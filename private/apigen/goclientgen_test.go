@@ -0,0 +1,71 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package apigen_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/stretchr/testify/require"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/uuid"
+	"storj.io/storj/private/api"
+	"storj.io/storj/private/apigen/example"
+	"storj.io/storj/private/apigen/example/client"
+	"storj.io/storj/private/apigen/example/myapi"
+)
+
+type notFoundService struct {
+	service
+}
+
+func (s notFoundService) GetOne(
+	ctx context.Context,
+	pathParam string,
+) (*myapi.Document, api.HTTPError) {
+	return nil, api.HTTPError{Status: http.StatusNotFound, Err: errs.New("document %q not found", pathParam)}
+}
+
+func TestAPIClient(t *testing.T) {
+	ctx := testcontext.NewWithTimeout(t, 5*time.Second)
+	defer ctx.Cleanup()
+
+	router := mux.NewRouter()
+	example.NewDocuments(zaptest.NewLogger(t), monkit.Package(), notFoundService{}, router, auth{})
+
+	server := httptest.NewServer(router)
+	defer server.Close()
+
+	docs := (&client.Client{BaseURL: server.URL}).Documents()
+
+	id, err := uuid.New()
+	require.NoError(t, err)
+	date := time.Now().UTC().Truncate(time.Millisecond)
+
+	doc, err := docs.UpdateContent(ctx, "foo", id, date, myapi.NewDocument{Content: "baz"})
+	require.NoError(t, err)
+	require.Equal(t, id, doc.ID)
+	require.True(t, date.Equal(doc.Date))
+	require.Equal(t, "foo", doc.PathParam)
+	require.Equal(t, "baz", doc.Body)
+
+	tag, err := docs.GetTag(ctx, "foo", "category")
+	require.NoError(t, err)
+	require.Equal(t, [2]string{}, *tag)
+
+	_, err = docs.GetOne(ctx, "missing")
+	var apiErr *client.APIError
+	require.True(t, errors.As(err, &apiErr))
+	require.Equal(t, http.StatusNotFound, apiErr.Status)
+	require.Equal(t, `document "missing" not found`, apiErr.Message)
+}
@@ -57,49 +57,8 @@ func (a *API) generateGo() ([]byte, error) {
 		packageName = parts[len(parts)-1]
 	}
 
-	imports := struct {
-		All      map[importPath]bool
-		Standard []importPath
-		External []importPath
-		Internal []importPath
-	}{
-		All: make(map[importPath]bool),
-	}
-
-	i := func(paths ...string) {
-		for _, path := range paths {
-			if path == "" || path == a.PackagePath {
-				continue
-			}
-
-			ipath := importPath(path)
-			if _, ok := imports.All[ipath]; ok {
-				continue
-			}
-			imports.All[ipath] = true
-
-			var slice *[]importPath
-			switch {
-			case !strings.Contains(path, "."):
-				slice = &imports.Standard
-			case strings.HasPrefix(path, "storj.io"):
-				slice = &imports.Internal
-			default:
-				slice = &imports.External
-			}
-			*slice = append(*slice, ipath)
-		}
-	}
-
-	var getTypePackages func(t reflect.Type) []string
-	getTypePackages = func(t reflect.Type) []string {
-		t = getElementaryType(t)
-		if t.Kind() == reflect.Map {
-			pkgs := []string{getElementaryType(t.Key()).PkgPath()}
-			return append(pkgs, getTypePackages(t.Elem())...)
-		}
-		return []string{t.PkgPath()}
-	}
+	imports := newGoImports(a.PackagePath)
+	i := imports.add
 
 	for _, group := range a.EndpointGroups {
 		for _, method := range group.endpoints {
@@ -347,26 +306,9 @@ func (a *API) generateGo() ([]byte, error) {
 	pf("package %s", packageName)
 	pf("")
 
-	pf("import (")
-	all := [][]importPath{imports.Standard, imports.External, imports.Internal}
-	for sn, slice := range all {
-		slices.Sort(slice)
-		for pn, path := range slice {
-			if r, ok := path.PkgName(); ok {
-				pf(`%s "%s"`, r, path)
-			} else {
-				pf(`"%s"`, path)
-			}
-
-			if pn == len(slice)-1 && sn < len(all)-1 {
-				pf("")
-			}
-		}
-	}
-	pf(")")
-	pf("")
+	imports.write(pf)
 
-	if _, ok := imports.All["time"]; ok {
+	if imports.has("time") {
 		pf("const dateLayout = \"%s\"", DateFormat)
 		pf("")
 	}
@@ -484,6 +426,88 @@ func handleParams(builder *StringBuilder, i func(paths ...string), pathParams, q
 	return nil
 }
 
+// getTypePackages returns the paths of the packages of the types which t is
+// composed of.
+func getTypePackages(t reflect.Type) []string {
+	t = getElementaryType(t)
+	if t.Kind() == reflect.Map {
+		pkgs := []string{getElementaryType(t.Key()).PkgPath()}
+		return append(pkgs, getTypePackages(t.Elem())...)
+	}
+	return []string{t.PkgPath()}
+}
+
+// goImports collects the imports of generated Go code grouped by standard
+// library, external and storj.io packages.
+type goImports struct {
+	// self is the path of the package of the generated code, which isn't imported.
+	self     string
+	all      map[importPath]bool
+	standard []importPath
+	external []importPath
+	internal []importPath
+}
+
+func newGoImports(self string) *goImports {
+	return &goImports{
+		self: self,
+		all:  make(map[importPath]bool),
+	}
+}
+
+// add adds the paths to the imports, ignoring the empty ones.
+func (imports *goImports) add(paths ...string) {
+	for _, path := range paths {
+		if path == "" || path == imports.self {
+			continue
+		}
+
+		ipath := importPath(path)
+		if _, ok := imports.all[ipath]; ok {
+			continue
+		}
+		imports.all[ipath] = true
+
+		var slice *[]importPath
+		switch {
+		case !strings.Contains(path, "."):
+			slice = &imports.standard
+		case strings.HasPrefix(path, "storj.io"):
+			slice = &imports.internal
+		default:
+			slice = &imports.external
+		}
+		*slice = append(*slice, ipath)
+	}
+}
+
+// has returns whether path is imported.
+func (imports *goImports) has(path string) bool {
+	return imports.all[importPath(path)]
+}
+
+// write writes the import declaration through pf.
+func (imports *goImports) write(pf func(format string, a ...interface{})) {
+	pf("import (")
+	all := [][]importPath{imports.standard, imports.external, imports.internal}
+	for sn, slice := range all {
+		slices.Sort(slice)
+		for pn, path := range slice {
+			if r, ok := path.PkgName(); ok {
+				pf(`%s "%s"`, r, path)
+			} else {
+				pf(`"%s"`, path)
+			}
+
+			if pn == len(slice)-1 && sn < len(all)-1 {
+				pf("")
+			}
+		}
+	}
+	pf(")")
+	pf("")
+}
+
 type importPath string
 
 // PkgName returns the name of the package based of the last part of the import
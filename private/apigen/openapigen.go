@@ -0,0 +1,363 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package apigen
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/memory"
+	"storj.io/common/uuid"
+)
+
+// openAPIVersion is the version of the OpenAPI specification of the generated documents.
+const openAPIVersion = "3.0.3"
+
+// MustWriteOpenAPI writes the OpenAPI document of the API in JSON format into a file indicated by
+// path.
+//
+// If an error occurs, it panics.
+func (a *API) MustWriteOpenAPI(path string) {
+	rootDir := a.outputRootDir()
+	fullpath := filepath.Join(rootDir, path)
+
+	generated, err := a.generateOpenAPI()
+	if err != nil {
+		panic(err)
+	}
+
+	err = os.MkdirAll(filepath.Dir(fullpath), 0700)
+	if err != nil {
+		panic(errs.Wrap(err))
+	}
+
+	err = os.WriteFile(fullpath, generated, 0644)
+	if err != nil {
+		panic(errs.Wrap(err))
+	}
+}
+
+type openAPIDocument struct {
+	OpenAPI    string                                  `json:"openapi"`
+	Info       openAPIInfo                             `json:"info"`
+	Tags       []openAPITag                            `json:"tags,omitempty"`
+	Paths      map[string]map[string]*openAPIOperation `json:"paths"`
+	Components openAPIComponents                       `json:"components"`
+}
+
+type openAPIInfo struct {
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+	Version     string `json:"version"`
+}
+
+type openAPITag struct {
+	Name string `json:"name"`
+}
+
+type openAPIOperation struct {
+	OperationID string                      `json:"operationId"`
+	Tags        []string                    `json:"tags"`
+	Summary     string                      `json:"summary"`
+	Description string                      `json:"description,omitempty"`
+	Parameters  []openAPIParameter          `json:"parameters,omitempty"`
+	RequestBody *openAPIRequestBody         `json:"requestBody,omitempty"`
+	Responses   map[string]*openAPIResponse `json:"responses"`
+}
+
+type openAPIParameter struct {
+	Name     string         `json:"name"`
+	In       string         `json:"in"`
+	Required bool           `json:"required"`
+	Schema   *openAPISchema `json:"schema"`
+}
+
+type openAPIRequestBody struct {
+	Required bool                        `json:"required"`
+	Content  map[string]openAPIMediaType `json:"content"`
+}
+
+type openAPIResponse struct {
+	Ref         string                      `json:"$ref,omitempty"`
+	Description string                      `json:"description,omitempty"`
+	Content     map[string]openAPIMediaType `json:"content,omitempty"`
+}
+
+type openAPIMediaType struct {
+	Schema *openAPISchema `json:"schema"`
+}
+
+type openAPIComponents struct {
+	Schemas   map[string]*openAPISchema   `json:"schemas,omitempty"`
+	Responses map[string]*openAPIResponse `json:"responses"`
+}
+
+type openAPISchema struct {
+	Ref                  string                    `json:"$ref,omitempty"`
+	AllOf                []*openAPISchema          `json:"allOf,omitempty"`
+	Type                 string                    `json:"type,omitempty"`
+	Format               string                    `json:"format,omitempty"`
+	Nullable             bool                      `json:"nullable,omitempty"`
+	Minimum              *int                      `json:"minimum,omitempty"`
+	Items                *openAPISchema            `json:"items,omitempty"`
+	MinItems             *int                      `json:"minItems,omitempty"`
+	MaxItems             *int                      `json:"maxItems,omitempty"`
+	Properties           map[string]*openAPISchema `json:"properties,omitempty"`
+	Required             []string                  `json:"required,omitempty"`
+	AdditionalProperties *openAPISchema            `json:"additionalProperties,omitempty"`
+}
+
+// generateOpenAPI generates the OpenAPI document of the API and returns an output.
+func (a *API) generateOpenAPI() ([]byte, error) {
+	title := a.PackageName
+	if title == "" {
+		parts := strings.Split(a.PackagePath, "/")
+		title = parts[len(parts)-1]
+	}
+
+	doc := openAPIDocument{
+		OpenAPI: openAPIVersion,
+		Info: openAPIInfo{
+			Title:       title + " API",
+			Description: a.Description,
+			Version:     a.Version,
+		},
+		Paths: map[string]map[string]*openAPIOperation{},
+		Components: openAPIComponents{
+			Responses: map[string]*openAPIResponse{
+				"Error": {
+					Description: "The error of the request",
+					Content: map[string]openAPIMediaType{
+						"application/json": {Schema: &openAPISchema{
+							Type: "object",
+							Properties: map[string]*openAPISchema{
+								"error": {Type: "string"},
+							},
+							Required: []string{"error"},
+						}},
+					},
+				},
+			},
+		},
+	}
+
+	schemas := openAPISchemas{
+		names:   map[string]reflect.Type{},
+		schemas: map[string]*openAPISchema{},
+	}
+
+	for _, group := range a.EndpointGroups {
+		doc.Tags = append(doc.Tags, openAPITag{Name: group.Name})
+
+		for _, endpoint := range group.endpoints {
+			op := &openAPIOperation{
+				OperationID: uncapitalize(group.Name) + endpoint.GoName,
+				Tags:        []string{group.Name},
+				Summary:     endpoint.Name,
+				Description: endpoint.Description,
+				Responses: map[string]*openAPIResponse{
+					"default": {Ref: "#/components/responses/Error"},
+				},
+			}
+
+			for _, param := range endpoint.PathParams {
+				schema, err := schemas.get(param.Type)
+				if err != nil {
+					return nil, err
+				}
+				op.Parameters = append(op.Parameters, openAPIParameter{
+					Name: param.Name, In: "path", Required: true, Schema: schema,
+				})
+			}
+			for _, param := range endpoint.QueryParams {
+				schema, err := schemas.get(param.Type)
+				if err != nil {
+					return nil, err
+				}
+				op.Parameters = append(op.Parameters, openAPIParameter{
+					Name: param.Name, In: "query", Required: true, Schema: schema,
+				})
+			}
+
+			if endpoint.Request != nil {
+				schema, err := schemas.get(reflect.TypeOf(endpoint.Request))
+				if err != nil {
+					return nil, err
+				}
+				op.RequestBody = &openAPIRequestBody{
+					Required: true,
+					Content:  map[string]openAPIMediaType{"application/json": {Schema: schema}},
+				}
+			}
+
+			okResponse := &openAPIResponse{Description: "OK"}
+			if endpoint.Response != nil {
+				schema, err := schemas.get(reflect.TypeOf(endpoint.Response))
+				if err != nil {
+					return nil, err
+				}
+				okResponse.Content = map[string]openAPIMediaType{"application/json": {Schema: schema}}
+			}
+			op.Responses[fmt.Sprint(http.StatusOK)] = okResponse
+
+			path := a.endpointBasePath() + "/" + strings.ToLower(group.Prefix) + endpoint.Path
+			if _, ok := doc.Paths[path]; !ok {
+				doc.Paths[path] = map[string]*openAPIOperation{}
+			}
+			doc.Paths[path][strings.ToLower(endpoint.Method)] = op
+		}
+	}
+
+	if len(schemas.schemas) > 0 {
+		doc.Components.Schemas = schemas.schemas
+	}
+
+	output, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+
+	return append(output, '\n'), nil
+}
+
+// openAPISchemas collects the schemas of the named struct types, which are referenced from the
+// schemas that use them.
+type openAPISchemas struct {
+	names   map[string]reflect.Type
+	schemas map[string]*openAPISchema
+}
+
+// get returns the schema of t. The schemas of the named struct types are added to the components
+// and referenced.
+func (s *openAPISchemas) get(t reflect.Type) (*openAPISchema, error) {
+	switch t {
+	case reflect.TypeOf(uuid.UUID{}):
+		return &openAPISchema{Type: "string", Format: "uuid"}, nil
+	case reflect.TypeOf(time.Time{}):
+		return &openAPISchema{Type: "string", Format: "date-time"}, nil
+	case reflect.TypeOf(memory.Size(0)):
+		return &openAPISchema{Type: "string"}, nil
+	}
+
+	zero := 0
+	switch t.Kind() {
+	case reflect.Bool:
+		return &openAPISchema{Type: "boolean"}, nil
+	case reflect.Int8, reflect.Int16, reflect.Int32:
+		return &openAPISchema{Type: "integer", Format: "int32"}, nil
+	case reflect.Int, reflect.Int64:
+		return &openAPISchema{Type: "integer", Format: "int64"}, nil
+	case reflect.Uint8, reflect.Uint16:
+		return &openAPISchema{Type: "integer", Format: "int32", Minimum: &zero}, nil
+	case reflect.Uint, reflect.Uint32, reflect.Uint64:
+		return &openAPISchema{Type: "integer", Format: "int64", Minimum: &zero}, nil
+	case reflect.Float32:
+		return &openAPISchema{Type: "number", Format: "float"}, nil
+	case reflect.Float64:
+		return &openAPISchema{Type: "number", Format: "double"}, nil
+	case reflect.String:
+		return &openAPISchema{Type: "string"}, nil
+	case reflect.Slice:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return &openAPISchema{Type: "string", Format: "byte", Nullable: true}, nil
+		}
+		items, err := s.get(t.Elem())
+		if err != nil {
+			return nil, err
+		}
+		return &openAPISchema{Type: "array", Items: items, Nullable: true}, nil
+	case reflect.Array:
+		items, err := s.get(t.Elem())
+		if err != nil {
+			return nil, err
+		}
+		n := t.Len()
+		return &openAPISchema{Type: "array", Items: items, MinItems: &n, MaxItems: &n}, nil
+	case reflect.Map:
+		if t.Key().Kind() != reflect.String {
+			return nil, errs.New("map keys of type %q are not supported", t.Key())
+		}
+		values, err := s.get(t.Elem())
+		if err != nil {
+			return nil, err
+		}
+		return &openAPISchema{Type: "object", AdditionalProperties: values, Nullable: true}, nil
+	case reflect.Pointer:
+		schema, err := s.get(t.Elem())
+		if err != nil {
+			return nil, err
+		}
+		return nullableSchema(schema), nil
+	case reflect.Struct:
+		if t.Name() == "" {
+			return s.object(t)
+		}
+		return s.ref(t)
+	default:
+		return nil, errs.New("type %q is not supported", t)
+	}
+}
+
+// ref adds the schema of the named struct type t to the components, if it isn't yet, and returns
+// a reference to it.
+func (s *openAPISchemas) ref(t reflect.Type) (*openAPISchema, error) {
+	name := typeNameWithoutGenerics(t.Name())
+	ref := &openAPISchema{Ref: "#/components/schemas/" + name}
+
+	if other, ok := s.names[name]; ok {
+		if other != t {
+			return nil, errs.New("types %q and %q have the same schema name %q", other, t, name)
+		}
+		return ref, nil
+	}
+	// the type is registered before its schema is built to support recursive types.
+	s.names[name] = t
+
+	schema, err := s.object(t)
+	if err != nil {
+		return nil, err
+	}
+	s.schemas[name] = schema
+
+	return ref, nil
+}
+
+// object returns the schema of the struct type t.
+func (s *openAPISchemas) object(t reflect.Type) (*openAPISchema, error) {
+	schema := &openAPISchema{Type: "object", Properties: map[string]*openAPISchema{}}
+	for _, field := range GetClassFieldsFromStruct(t) {
+		fieldSchema, err := s.get(field.Type)
+		if err != nil {
+			return nil, err
+		}
+		if field.Nullable {
+			fieldSchema = nullableSchema(fieldSchema)
+		}
+
+		schema.Properties[field.Name] = fieldSchema
+		if !field.Optional {
+			schema.Required = append(schema.Required, field.Name)
+		}
+	}
+
+	return schema, nil
+}
+
+// nullableSchema returns schema which also accepts null. References can't have sibling properties,
+// so they are wrapped with allOf.
+func nullableSchema(schema *openAPISchema) *openAPISchema {
+	if schema.Ref != "" {
+		return &openAPISchema{AllOf: []*openAPISchema{schema}, Nullable: true}
+	}
+	schema.Nullable = true
+	return schema
+}
@@ -0,0 +1,98 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package apigen
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/uuid"
+)
+
+type testOpenAPIItem struct {
+	ID      uuid.UUID        `json:"id"`
+	Name    string           `json:"name,omitempty"`
+	Size    uint32           `json:"size"`
+	Parent  *testOpenAPIItem `json:"parent"`
+	Created time.Time        `json:"created"`
+}
+
+func TestOpenAPI(t *testing.T) {
+	a := &API{PackagePath: "storj.io/storj/private/apigen/test", Version: "v1", BasePath: "/api"}
+	g := a.Group("Items", "items")
+	g.Get("/{id}", &Endpoint{
+		Name:           "Get Item",
+		Description:    "Get the item",
+		GoName:         "GetItem",
+		TypeScriptName: "getItem",
+		PathParams:     []Param{NewParam("id", uuid.UUID{})},
+		QueryParams:    []Param{NewParam("depth", uint(0))},
+		Response:       testOpenAPIItem{},
+	})
+	g.Put("/{id}", &Endpoint{
+		Name:           "Update Item",
+		Description:    "Update the item",
+		GoName:         "UpdateItem",
+		TypeScriptName: "updateItem",
+		PathParams:     []Param{NewParam("id", uuid.UUID{})},
+		Request:        testOpenAPIItem{},
+	})
+
+	output, err := a.generateOpenAPI()
+	require.NoError(t, err)
+
+	var doc openAPIDocument
+	require.NoError(t, json.Unmarshal(output, &doc))
+
+	require.Equal(t, openAPIVersion, doc.OpenAPI)
+	require.Equal(t, "test API", doc.Info.Title)
+	require.Equal(t, []openAPITag{{Name: "Items"}}, doc.Tags)
+
+	require.Len(t, doc.Paths, 1)
+	ops := doc.Paths["/api/v1/items/{id}"]
+	require.Len(t, ops, 2)
+
+	get := ops["get"]
+	require.Equal(t, "itemsGetItem", get.OperationID)
+	require.Equal(t, []openAPIParameter{
+		{Name: "id", In: "path", Required: true, Schema: &openAPISchema{Type: "string", Format: "uuid"}},
+		{Name: "depth", In: "query", Required: true, Schema: &openAPISchema{Type: "integer", Format: "int64", Minimum: new(int)}},
+	}, get.Parameters)
+	require.Nil(t, get.RequestBody)
+	require.Equal(t, "#/components/schemas/testOpenAPIItem", get.Responses["200"].Content["application/json"].Schema.Ref)
+	require.Equal(t, "#/components/responses/Error", get.Responses["default"].Ref)
+
+	put := ops["put"]
+	require.Equal(t, "#/components/schemas/testOpenAPIItem", put.RequestBody.Content["application/json"].Schema.Ref)
+	require.Nil(t, put.Responses["200"].Content)
+
+	item := doc.Components.Schemas["testOpenAPIItem"]
+	require.NotNil(t, item)
+	require.Equal(t, []string{"id", "size", "parent", "created"}, item.Required)
+	require.Equal(t, &openAPISchema{Type: "string", Format: "date-time"}, item.Properties["created"])
+	require.Equal(t, &openAPISchema{
+		AllOf:    []*openAPISchema{{Ref: "#/components/schemas/testOpenAPIItem"}},
+		Nullable: true,
+	}, item.Properties["parent"])
+
+	t.Run("schema names must be unique", func(t *testing.T) {
+		type testOpenAPIItem struct {
+			Other string `json:"other"`
+		}
+
+		g.Post("/", &Endpoint{
+			Name:           "Create Item",
+			Description:    "Create an item",
+			GoName:         "CreateItem",
+			TypeScriptName: "createItem",
+			Request:        testOpenAPIItem{},
+		})
+
+		_, err := a.generateOpenAPI()
+		require.Error(t, err)
+	})
+}
@@ -24,6 +24,14 @@
 //
 //	STORJ_TEST_HOST=127.0.0.2;127.0.0.3
 //
+// # Gateway
+//
+// Setting Config.EnableGateways starts an S3 gateway for every uplink. The gateway
+// isn't part of this repository, so it's started from the executable found in PATH
+// or from the path set with:
+//
+//	STORJ_TEST_GATEWAY=/home/user/go/bin/gateway
+//
 // # Debugging
 //
 // For debugging, it's possible to set STORJ_TEST_MONKIT to get a trace per test.
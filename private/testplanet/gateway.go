@@ -0,0 +1,164 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information
+
+package testplanet
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/testrand"
+)
+
+// GatewayExecutableEnv is the environment variable to specify the gateway executable.
+const GatewayExecutableEnv = "STORJ_TEST_GATEWAY"
+
+// gatewayStartTimeout is how long to wait for a gateway to start listening.
+const gatewayStartTimeout = 30 * time.Second
+
+// Gateway is an S3 compatible gateway, which uses the access of an uplink to the first satellite.
+type Gateway struct {
+	Log    *zap.Logger
+	Name   string
+	Uplink *Uplink
+
+	// Addr is the address of the S3 endpoint.
+	Addr      string
+	AccessKey string
+	SecretKey string
+
+	directory string
+	cmd       *exec.Cmd
+}
+
+// URL returns the S3 endpoint URL of the gateway.
+func (gateway *Gateway) URL() string { return "http://" + gateway.Addr }
+
+// GatewayExecutable returns the path of the gateway executable, which is read from
+// STORJ_TEST_GATEWAY or found in PATH.
+//
+// The gateway isn't a dependency of this module, hence it must be installed separately.
+func GatewayExecutable() (string, error) {
+	if path := os.Getenv(GatewayExecutableEnv); path != "" {
+		return path, nil
+	}
+	path, err := exec.LookPath("gateway")
+	if err != nil {
+		return "", errs.New("gateway executable not found, install storj.io/gateway or set %s: %w", GatewayExecutableEnv, err)
+	}
+	return path, nil
+}
+
+// newGateways creates a gateway for every uplink.
+func (planet *Planet) newGateways() ([]*Gateway, error) {
+	if len(planet.Satellites) == 0 {
+		return nil, errs.New("gateways require at least one satellite")
+	}
+
+	var gateways []*Gateway
+	for i, uplink := range planet.Uplinks {
+		name := "gateway" + strconv.Itoa(i)
+
+		directory := filepath.Join(planet.directory, name)
+		if err := os.MkdirAll(directory, 0700); err != nil {
+			return nil, errs.Wrap(err)
+		}
+
+		addr, err := planet.freeAddress()
+		if err != nil {
+			return nil, errs.Wrap(err)
+		}
+
+		gateways = append(gateways, &Gateway{
+			Log:       planet.log.Named(name),
+			Name:      name,
+			Uplink:    uplink,
+			Addr:      addr,
+			AccessKey: testrand.BucketName(),
+			SecretKey: testrand.BucketName(),
+			directory: directory,
+		})
+	}
+	return gateways, nil
+}
+
+// freeAddress returns an address, which isn't currently used on the planet host.
+func (planet *Planet) freeAddress() (string, error) {
+	listener, err := net.Listen("tcp", net.JoinHostPort(planet.config.Host, "0"))
+	if err != nil {
+		return "", err
+	}
+	addr := listener.Addr().String()
+	return addr, listener.Close()
+}
+
+// start starts the gateway process, which is stopped when ctx is canceled.
+func (gateway *Gateway) start(ctx context.Context, executable string, satellite *Satellite) error {
+	access, err := gateway.Uplink.Access[satellite.ID()].Serialize()
+	if err != nil {
+		return errs.Wrap(err)
+	}
+
+	gateway.cmd = exec.CommandContext(ctx, executable, "run",
+		"--config-dir", gateway.directory,
+		"--access", access,
+		"--server.address", gateway.Addr,
+		"--minio.access-key", gateway.AccessKey,
+		"--minio.secret-key", gateway.SecretKey,
+		"--log.level", "info",
+	)
+	gateway.cmd.Stdout = &zapWriter{log: gateway.Log}
+	gateway.cmd.Stderr = &zapWriter{log: gateway.Log}
+
+	return errs.Wrap(gateway.cmd.Start())
+}
+
+// waitForStart waits until the gateway accepts connections.
+func (gateway *Gateway) waitForStart(ctx context.Context) error {
+	deadline := time.Now().Add(gatewayStartTimeout)
+	for {
+		conn, err := (&net.Dialer{}).DialContext(ctx, "tcp", gateway.Addr)
+		if err == nil {
+			return conn.Close()
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("gateway %s didn't start: %w", gateway.Name, err)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(50 * time.Millisecond):
+		}
+	}
+}
+
+// wait waits for the gateway process to exit.
+func (gateway *Gateway) wait(ctx context.Context) error {
+	err := gateway.cmd.Wait()
+	var exitErr *exec.ExitError
+	if ctx.Err() != nil && errors.As(err, &exitErr) {
+		// killed on shutdown.
+		return nil
+	}
+	return errs.Wrap(err)
+}
+
+// zapWriter writes the process output to the log.
+type zapWriter struct {
+	log *zap.Logger
+}
+
+func (w *zapWriter) Write(p []byte) (int, error) {
+	w.log.Info(string(p))
+	return len(p), nil
+}
@@ -65,6 +65,10 @@ type Config struct {
 	NonParallel bool
 	Timeout     time.Duration
 
+	// EnableGateways starts an S3 gateway for every uplink. The tests are
+	// skipped when the gateway executable isn't available, see GatewayExecutable.
+	EnableGateways bool
+
 	applicationName string
 }
 
@@ -93,6 +97,9 @@ type Planet struct {
 	StorageNodes   []*StorageNode
 	Multinodes     []*Multinode
 	Uplinks        []*Uplink
+	Gateways       []*Gateway
+
+	gatewayExecutable string
 
 	identities    *testidentity.Identities
 	whitelistPath string // TODO: in-memory
@@ -213,6 +220,18 @@ func (planet *Planet) createPeers(ctx context.Context, satelliteDatabases satell
 		return errs.Wrap(err)
 	}
 
+	if planet.config.EnableGateways {
+		planet.gatewayExecutable, err = GatewayExecutable()
+		if err != nil {
+			return errs.Wrap(err)
+		}
+
+		planet.Gateways, err = planet.newGateways()
+		if err != nil {
+			return errs.Wrap(err)
+		}
+	}
+
 	return nil
 }
 
@@ -256,9 +275,34 @@ func (planet *Planet) Start(ctx context.Context) {
 
 	_ = group.Wait()
 
+	planet.startGateways(ctx)
+
 	planet.started = true
 }
 
+// startGateways starts the gateways and waits until they accept connections.
+func (planet *Planet) startGateways(ctx context.Context) {
+	var group errgroup.Group
+	for _, gateway := range planet.Gateways {
+		gateway := gateway
+
+		if err := gateway.start(ctx, planet.gatewayExecutable, planet.Satellites[0]); err != nil {
+			planet.run.Go(func() error { return err })
+			continue
+		}
+		planet.run.Go(func() error {
+			return gateway.wait(ctx)
+		})
+		group.Go(func() error {
+			return gateway.waitForStart(ctx)
+		})
+	}
+
+	if err := group.Wait(); err != nil {
+		planet.log.Error("gateway failed to start", zap.Error(err))
+	}
+}
+
 // StopPeer stops a single peer in the planet.
 func (planet *Planet) StopPeer(peer Peer) error {
 	if peer == nil {
@@ -37,6 +37,11 @@ func Run(t *testing.T, config Config, test func(t *testing.T, ctx *testcontext.C
 			if satelliteDB.MasterDB.URL == "" {
 				t.Skipf("Database %s connection string not provided. %s", satelliteDB.MasterDB.Name, satelliteDB.MasterDB.Message)
 			}
+			if config.EnableGateways {
+				if _, err := GatewayExecutable(); err != nil {
+					t.Skip(err)
+				}
+			}
 			planetConfig := config
 			if planetConfig.Name == "" {
 				planetConfig.Name = t.Name()
@@ -44,6 +44,8 @@ import (
 	"storj.io/storj/satellite/gracefulexit"
 	"storj.io/storj/satellite/mailservice"
 	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/abortmultipart"
+	"storj.io/storj/satellite/metabase/segmentdeletion"
 	"storj.io/storj/satellite/metabase/zombiedeletion"
 	"storj.io/storj/satellite/metainfo"
 	"storj.io/storj/satellite/metainfo/expireddeletion"
@@ -153,6 +155,14 @@ type Satellite struct {
 		Chore *zombiedeletion.Chore
 	}
 
+	AbortMultipart struct {
+		Chore *abortmultipart.Chore
+	}
+
+	SegmentDeletion struct {
+		Chore *segmentdeletion.Chore
+	}
+
 	Accounting struct {
 		Tally            *tally.Service
 		Rollup           *rollup.Service
@@ -472,6 +482,8 @@ func (planet *Planet) newSatellite(ctx context.Context, prefix string, index int
 		MinPartSize:      config.Metainfo.MinPartSize,
 		MaxNumberOfParts: config.Metainfo.MaxNumberOfParts,
 		ServerSideCopy:   config.Metainfo.ServerSideCopy,
+
+		DeferSegmentDeletion: config.Metainfo.DeferSegmentDeletion,
 	})
 	if err != nil {
 		return nil, errs.Wrap(err)
@@ -635,6 +647,8 @@ func createNewSystem(name string, log *zap.Logger, config satellite.Config, peer
 
 	system.ExpiredDeletion.Chore = peer.ExpiredDeletion.Chore
 	system.ZombieDeletion.Chore = peer.ZombieDeletion.Chore
+	system.AbortMultipart.Chore = peer.AbortMultipart.Chore
+	system.SegmentDeletion.Chore = peer.SegmentDeletion.Chore
 
 	system.Accounting.Tally = peer.Accounting.Tally
 	system.Accounting.Rollup = peer.Accounting.Rollup
@@ -31,6 +31,7 @@ import (
 	"storj.io/storj/storagenode/collector"
 	"storj.io/storj/storagenode/console/consoleserver"
 	"storj.io/storj/storagenode/contact"
+	"storj.io/storj/storagenode/evidence"
 	"storj.io/storj/storagenode/forgetsatellite"
 	"storj.io/storj/storagenode/gracefulexit"
 	"storj.io/storj/storagenode/monitor"
@@ -39,6 +40,7 @@ import (
 	"storj.io/storj/storagenode/orders"
 	"storj.io/storj/storagenode/pieces"
 	"storj.io/storj/storagenode/piecestore"
+	"storj.io/storj/storagenode/popularity"
 	"storj.io/storj/storagenode/preflight"
 	"storj.io/storj/storagenode/retain"
 	"storj.io/storj/storagenode/storagenodedb/storagenodedbtest"
@@ -204,6 +206,16 @@ func (planet *Planet) newStorageNode(ctx context.Context, prefix string, index,
 			Concurrency: 5,
 			CachePath:   filepath.Join(planet.directory, "retain"),
 		},
+		Evidence: evidence.Config{
+			DefaultDuration: time.Hour,
+			MaxDuration:     24 * time.Hour,
+		},
+		Popularity: popularity.Config{
+			Enabled:       true,
+			Width:         1024,
+			Depth:         4,
+			DecayInterval: defaultInterval,
+		},
 		Version: version.Config{
 			Config: planet.NewVersionConfig(),
 		},
@@ -20,11 +20,20 @@ func ServeJSONError(ctx context.Context, log *zap.Logger, w http.ResponseWriter,
 
 // ServeCustomJSONError writes a JSON error with a custom message to the response output stream.
 func ServeCustomJSONError(ctx context.Context, log *zap.Logger, w http.ResponseWriter, status int, err error, msg string) {
+	ServeCodedJSONError(ctx, log, w, status, "", err, msg)
+}
+
+// ServeCodedJSONError writes a JSON error with a custom message and a machine-readable
+// error code to the response output stream. The code is omitted when it's empty.
+func ServeCodedJSONError(ctx context.Context, log *zap.Logger, w http.ResponseWriter, status int, errorCode string, err error, msg string) {
 	fields := []zap.Field{
 		zap.Int("code", status),
 		zap.String("message", msg),
 		zap.Error(err),
 	}
+	if errorCode != "" {
+		fields = append(fields, zap.String("errorCode", errorCode))
+	}
 
 	if requestID := requestid.FromContext(ctx); requestID != "" {
 		fields = append(fields, zap.String("requestID", requestID))
@@ -44,9 +53,14 @@ func ServeCustomJSONError(ctx context.Context, log *zap.Logger, w http.ResponseW
 
 	w.WriteHeader(status)
 
-	err = json.NewEncoder(w).Encode(map[string]string{
+	body := map[string]string{
 		"error": msg,
-	})
+	}
+	if errorCode != "" {
+		body["code"] = errorCode
+	}
+
+	err = json.NewEncoder(w).Encode(body)
 	if err != nil {
 		log.Error("failed to write json error response", zap.Error(err))
 	}
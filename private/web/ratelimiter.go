@@ -90,8 +90,7 @@ func (rl *RateLimiter) Limit(next http.Handler) http.Handler {
 			ServeCustomJSONError(r.Context(), rl.log, w, http.StatusInternalServerError, err, internalServerErrMsg)
 			return
 		}
-		limit := rl.getUserLimit(key)
-		if !limit.Allow() {
+		if !rl.Allow(key) {
 			ServeJSONError(r.Context(), rl.log, w, http.StatusTooManyRequests, errs.New(rateLimitErrMsg))
 			return
 		}
@@ -99,6 +98,11 @@ func (rl *RateLimiter) Limit(next http.Handler) http.Handler {
 	})
 }
 
+// Allow reports whether an event for the key may happen now.
+func (rl *RateLimiter) Allow(key string) bool {
+	return rl.getUserLimit(key).Allow()
+}
+
 // GetRequestIP gets the original IP address of the request by handling the request headers.
 func GetRequestIP(r *http.Request) (ip string, err error) {
 	realIP := r.Header.Get("X-REAL-IP")
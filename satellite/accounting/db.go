@@ -203,6 +203,39 @@ func (b *ProjectReportItem) ToStringSlice() []string {
 	}
 }
 
+// APIKeyEgress contains the egress attributed to an API key.
+type APIKeyEgress struct {
+	APIKeyID uuid.UUID
+	Settled  int64
+	Inline   int64
+}
+
+// APIKeyReportItem is total egress of an API key for a usage report.
+type APIKeyReportItem struct {
+	ProjectID   uuid.UUID
+	ProjectName string
+
+	APIKeyID   uuid.UUID
+	APIKeyName string
+	Egress     float64
+
+	Since  time.Time `json:"since"`
+	Before time.Time `json:"before"`
+}
+
+// ToStringSlice converts report item values to a slice of strings.
+func (b *APIKeyReportItem) ToStringSlice() []string {
+	return []string{
+		b.ProjectName,
+		b.ProjectID.String(),
+		b.APIKeyID.String(),
+		b.APIKeyName,
+		fmt.Sprintf("%f", b.Egress),
+		b.Since.String(),
+		b.Before.String(),
+	}
+}
+
 // Usage contains project's usage split on segments and storage.
 type Usage struct {
 	Storage  int64
@@ -290,6 +323,8 @@ type ProjectAccounting interface {
 	GetProjectObjectsSegments(ctx context.Context, projectID uuid.UUID) (ProjectObjectsSegments, error)
 	// GetBucketUsageRollups returns usage rollup per each bucket for specified period of time.
 	GetBucketUsageRollups(ctx context.Context, projectID uuid.UUID, since, before time.Time) ([]BucketUsageRollup, error)
+	// GetAPIKeyEgress returns the egress attributed to each API key of a project for specified period of time.
+	GetAPIKeyEgress(ctx context.Context, projectID uuid.UUID, since, before time.Time) ([]APIKeyEgress, error)
 	// GetSingleBucketUsageRollup returns usage rollup per single bucket for specified period of time.
 	GetSingleBucketUsageRollup(ctx context.Context, projectID uuid.UUID, bucket string, since, before time.Time) (*BucketUsageRollup, error)
 	// GetBucketTotals returns per bucket total usage summary since bucket creation.
@@ -112,16 +112,21 @@ type UploadLimit struct {
 	SegmentsLimit   int64
 }
 
+// SegmentsLimit returns the segment limit of the project, falling back to the default limit.
+func (usage *Service) SegmentsLimit(limits ProjectLimits) int64 {
+	if limits.Segments != nil {
+		return *limits.Segments
+	}
+	return usage.defaultMaxSegments
+}
+
 // ExceedsUploadLimits returns combined checks for storage and segment limits.
 // Supply nonzero headroom parameters to check if there is room for a new object.
 func (usage *Service) ExceedsUploadLimits(
 	ctx context.Context, projectID uuid.UUID, storageSizeHeadroom int64, segmentCountHeadroom int64, limits ProjectLimits) (limit UploadLimit) {
 	defer mon.Task()(&ctx)(nil)
 
-	limit.SegmentsLimit = usage.defaultMaxSegments
-	if limits.Segments != nil {
-		limit.SegmentsLimit = *limits.Segments
-	}
+	limit.SegmentsLimit = usage.SegmentsLimit(limits)
 
 	limit.StorageLimit = usage.defaultMaxStorage
 	if limits.Usage != nil {
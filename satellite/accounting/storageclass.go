@@ -0,0 +1,53 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package accounting
+
+import (
+	"sort"
+
+	"storj.io/storj/satellite/buckets"
+)
+
+// UnknownStorageClass is the class of usage in placements without a storage class.
+const UnknownStorageClass = "UNKNOWN"
+
+// StorageClassUsage is the usage of a project summed for a single storage class.
+type StorageClassUsage struct {
+	StorageClass string `json:"storageClass"`
+	Product      string `json:"product"`
+
+	Storage      float64 `json:"storage"`
+	Egress       float64 `json:"egress"`
+	ObjectCount  int64   `json:"objectCount"`
+	SegmentCount int64   `json:"segmentCount"`
+}
+
+// UsageByStorageClass sums the bucket usages by the storage class of their placement.
+// The result is sorted by storage class name.
+func UsageByStorageClass(classes buckets.StorageClasses, usages []BucketUsage) []StorageClassUsage {
+	byClass := map[string]*StorageClassUsage{}
+	for _, usage := range usages {
+		name, product := UnknownStorageClass, ""
+		if class, ok := classes.ByPlacement(usage.DefaultPlacement); ok {
+			name, product = class.Name, class.Product
+		}
+
+		summed, ok := byClass[name]
+		if !ok {
+			summed = &StorageClassUsage{StorageClass: name, Product: product}
+			byClass[name] = summed
+		}
+		summed.Storage += usage.Storage
+		summed.Egress += usage.Egress
+		summed.ObjectCount += usage.ObjectCount
+		summed.SegmentCount += usage.SegmentCount
+	}
+
+	result := make([]StorageClassUsage, 0, len(byClass))
+	for _, summed := range byClass {
+		result = append(result, *summed)
+	}
+	sort.Slice(result, func(i, k int) bool { return result[i].StorageClass < result[k].StorageClass })
+	return result
+}
@@ -23,14 +23,25 @@ import (
 	"storj.io/storj/satellite/accounting"
 	"storj.io/storj/satellite/admin"
 	backoffice "storj.io/storj/satellite/admin/back-office"
+	"storj.io/storj/satellite/adminaudit"
+	"storj.io/storj/satellite/adminwebhook"
 	"storj.io/storj/satellite/analytics"
 	"storj.io/storj/satellite/buckets"
 	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/console/restkeys"
 	"storj.io/storj/satellite/emission"
+	"storj.io/storj/satellite/escrow"
+	"storj.io/storj/satellite/incident"
+	"storj.io/storj/satellite/kms"
+	"storj.io/storj/satellite/limitschedule"
+	"storj.io/storj/satellite/maintenance"
 	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/objectpins"
+	"storj.io/storj/satellite/metabase/zombiedeletion"
 	"storj.io/storj/satellite/payments"
 	"storj.io/storj/satellite/payments/stripe"
+	"storj.io/storj/satellite/projectdeletion"
+	"storj.io/storj/satellite/repair/prioritize"
 )
 
 // Admin is the satellite core process that runs chores.
@@ -91,6 +102,48 @@ type Admin struct {
 	Accounting struct {
 		Service *accounting.Service
 	}
+
+	Maintenance struct {
+		Service *maintenance.Service
+	}
+
+	ProjectDeletion struct {
+		Service *projectdeletion.Service
+		Chore   *projectdeletion.Chore
+	}
+
+	RepairPriority struct {
+		Service *prioritize.Service
+	}
+
+	ZombieDeletion struct {
+		Service *zombiedeletion.Service
+	}
+
+	ObjectPins struct {
+		Service *objectpins.Service
+	}
+
+	LimitSchedule struct {
+		Service *limitschedule.Service
+	}
+
+	KeyManagement struct {
+		Service *kms.Service
+	}
+
+	PassphraseEscrow struct {
+		Service *escrow.Service
+	}
+
+	AdminAudit struct {
+		Service *adminaudit.Service
+	}
+
+	AdminWebhooks struct {
+		Service *adminwebhook.Service
+		Chore   *adminwebhook.Chore
+	}
 }
 
 // NewAdmin creates a new satellite admin peer.
@@ -239,6 +292,121 @@ func NewAdmin(log *zap.Logger, full *identity.FullIdentity, db DB, metabaseDB *m
 		)
 	}
 
+	{ // setup maintenance announcements
+		peer.Maintenance.Service = maintenance.NewService(
+			log.Named("maintenance"),
+			peer.DB.MaintenanceWindows(),
+			config.Maintenance,
+		)
+	}
+
+	{ // setup project data purging
+		peer.ProjectDeletion.Service = projectdeletion.NewService(
+			log.Named("project-deletion"),
+			peer.DB.ProjectDataPurges(),
+			peer.DB.Buckets(),
+			peer.MetabaseDB,
+			config.Admin.ProjectDeletion,
+		)
+		peer.ProjectDeletion.Chore = projectdeletion.NewChore(
+			log.Named("project-deletion:chore"),
+			peer.ProjectDeletion.Service,
+			config.Admin.ProjectDeletion,
+		)
+		peer.Services.Add(lifecycle.Item{
+			Name:  "project-deletion:chore",
+			Run:   peer.ProjectDeletion.Chore.Run,
+			Close: peer.ProjectDeletion.Chore.Close,
+		})
+	}
+
+	{ // setup repair prioritization after incidents
+		peer.RepairPriority.Service = prioritize.NewService(
+			log.Named("repair-priority"),
+			peer.MetabaseDB,
+			peer.DB.Buckets(),
+			peer.DB.OverlayCache(),
+			peer.DB.RepairQueue(),
+			config.Overlay.Node.OnlineWindow,
+			config.Overlay.AsOfSystemTime,
+			config.Admin.RepairPriority,
+		)
+		peer.Services.Add(lifecycle.Item{
+			Name:  "repair-priority",
+			Close: peer.RepairPriority.Service.Close,
+		})
+	}
+
+	{ // setup pending object grace periods and targeted zombie cleanup
+		peer.ZombieDeletion.Service = zombiedeletion.NewService(
+			log.Named("zombie-deletion"),
+			config.ZombieDeletion,
+			peer.MetabaseDB,
+			peer.DB.PendingObjectGracePeriods(),
+		)
+	}
+
+	{ // setup object pins
+		peer.ObjectPins.Service = objectpins.NewService(
+			log.Named("object-pins"),
+			peer.MetabaseDB,
+		)
+	}
+
+	{ // setup scheduled limit changes
+		peer.LimitSchedule.Service = limitschedule.NewService(
+			log.Named("limit-schedule"),
+			peer.DB.ProjectLimitChanges(),
+		)
+	}
+
+	{ // setup passphrase escrow
+		if config.PassphraseEscrow.Enabled {
+			if config.KeyManagement.EscrowThreshold <= 0 {
+				return nil, errs.Combine(errs.New("passphrase escrow requires key-management.escrow-threshold"), peer.Close())
+			}
+
+			peer.KeyManagement.Service = kms.NewService(config.KeyManagement)
+			peer.Services.Add(lifecycle.Item{
+				Name:  "kms:service",
+				Run:   peer.KeyManagement.Service.Initialize,
+				Close: peer.KeyManagement.Service.Close,
+			})
+
+			peer.PassphraseEscrow.Service = escrow.NewService(
+				log.Named("passphrase-escrow"),
+				peer.DB.PassphraseEscrows(),
+				peer.KeyManagement.Service,
+				config.PassphraseEscrow,
+			)
+		}
+	}
+
+	{ // setup admin audit events
+		peer.AdminAudit.Service = adminaudit.NewService(
+			log.Named("admin-audit"),
+			peer.DB.AdminAuditEvents(),
+		)
+	}
+
+	{ // setup admin webhooks
+		peer.AdminWebhooks.Service = adminwebhook.NewService(
+			log.Named("admin-webhooks"),
+			peer.DB.AdminWebhookDeliveries(),
+			config.Admin.Webhooks,
+		)
+		peer.AdminWebhooks.Chore = adminwebhook.NewChore(
+			log.Named("admin-webhooks:chore"),
+			peer.AdminWebhooks.Service,
+			config.Admin.Webhooks,
+		)
+		peer.Services.Add(lifecycle.Item{
+			Name:  "admin-webhooks:chore",
+			Run:   peer.AdminWebhooks.Chore.Run,
+			Close: peer.AdminWebhooks.Chore.Close,
+		})
+	}
+
 	{ // setup admin
 		var err error
 		peer.Admin.Listener, err = net.Listen("tcp", config.Admin.Address)
@@ -274,6 +442,16 @@ func NewAdmin(log *zap.Logger, full *identity.FullIdentity, db DB, metabaseDB *m
 			peer.Analytics.Service,
 			peer.Payments.Accounts,
 			peer.Admin.Service,
+			peer.Maintenance.Service,
+			peer.ProjectDeletion.Service,
+			peer.RepairPriority.Service,
+			peer.ZombieDeletion.Service,
+			peer.ObjectPins.Service,
+			peer.LimitSchedule.Service,
+			peer.PassphraseEscrow.Service,
+			peer.AdminAudit.Service,
+			peer.AdminWebhooks.Service,
+			incident.NewCollector(log.Named("incident"), monkit.Default, config),
 			config.Console,
 			adminConfig,
 		)
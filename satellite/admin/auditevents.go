@@ -0,0 +1,218 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+	"go.uber.org/zap"
+
+	"storj.io/common/context2"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/adminaudit"
+)
+
+type auditEventResponse struct {
+	ID         uuid.UUID  `json:"id"`
+	AdminEmail string     `json:"adminEmail"`
+	UserID     *uuid.UUID `json:"userId,omitempty"`
+	Action     string     `json:"action"`
+	Path       string     `json:"path"`
+	Reason     string     `json:"reason,omitempty"`
+	CreatedAt  time.Time  `json:"createdAt"`
+}
+
+func (server *Server) searchAuditEvents(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	query := r.URL.Query()
+	opts := adminaudit.SearchOptions{
+		AdminEmail: query.Get("adminEmail"),
+		Action:     query.Get("action"),
+	}
+
+	if userIDParam := query.Get("userId"); userIDParam != "" {
+		userID, err := uuid.FromString(userIDParam)
+		if err != nil {
+			sendJSONError(w, "invalid userId", err.Error(), http.StatusBadRequest)
+			return
+		}
+		opts.UserID = &userID
+	}
+
+	for name, dest := range map[string]*time.Time{"since": &opts.Since, "before": &opts.Before} {
+		param := query.Get(name)
+		if param == "" {
+			continue
+		}
+		value, err := time.Parse(time.RFC3339, param)
+		if err != nil {
+			sendJSONError(w, "invalid "+name, err.Error(), http.StatusBadRequest)
+			return
+		}
+		*dest = value
+	}
+
+	if limitParam := query.Get("limit"); limitParam != "" {
+		limit, err := strconv.Atoi(limitParam)
+		if err != nil {
+			sendJSONError(w, "invalid limit", err.Error(), http.StatusBadRequest)
+			return
+		}
+		opts.Limit = limit
+	}
+
+	if cursorParam := query.Get("cursor"); cursorParam != "" {
+		cursor, err := adminaudit.ParseCursor(cursorParam)
+		if err != nil {
+			sendJSONError(w, "invalid cursor", err.Error(), http.StatusBadRequest)
+			return
+		}
+		opts.Cursor = &cursor
+	}
+
+	result, err := server.auditEvents.Search(ctx, opts)
+	if err != nil {
+		if adminaudit.ErrValidation.Has(err) {
+			sendJSONError(w, "invalid search", err.Error(), http.StatusBadRequest)
+			return
+		}
+		sendJSONError(w, "failed to search audit events", err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	var output struct {
+		Events     []auditEventResponse `json:"events"`
+		NextCursor string               `json:"nextCursor,omitempty"`
+	}
+	output.Events = make([]auditEventResponse, 0, len(result.Events))
+	for _, event := range result.Events {
+		output.Events = append(output.Events, auditEventResponse{
+			ID:         event.ID,
+			AdminEmail: event.AdminEmail,
+			UserID:     event.UserID,
+			Action:     event.Action,
+			Path:       event.Path,
+			Reason:     event.Reason,
+			CreatedAt:  event.CreatedAt,
+		})
+	}
+	if result.Next != nil {
+		output.NextCursor = result.Next.String()
+	}
+
+	data, err := json.Marshal(output)
+	if err != nil {
+		sendJSONError(w, "json encoding failed",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONData(w, http.StatusOK, data)
+}
+
+// withAuditEvents records the changes made through the API, i.e. the
+// requests other than GET which succeeded, and enqueues the webhooks of the
+// recorded changes. A failure to record a change is only logged, because the
+// change was already made.
+func (server *Server) withAuditEvents(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet || r.Method == http.MethodHead {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		ctx := r.Context()
+
+		action := r.URL.Path
+		if route := mux.CurrentRoute(r); route != nil {
+			if template, err := route.GetPathTemplate(); err == nil {
+				action = template
+			}
+		}
+
+		event := adminaudit.Event{
+			AdminEmail: r.Header.Get("X-Forwarded-Email"),
+			Action:     r.Method + " " + action,
+			Path:       r.URL.Path,
+		}
+		// the user is looked up before the change, which may delete it.
+		event.UserID = server.auditEventUser(ctx, mux.Vars(r))
+
+		recorder := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(recorder, r.WithContext(context.WithValue(ctx, auditEventKey{}, &event)))
+		if recorder.status >= http.StatusBadRequest {
+			return
+		}
+
+		ctx = context2.WithoutCancellation(ctx)
+
+		recorded, err := server.auditEvents.Record(ctx, event)
+		if err != nil {
+			server.log.Error("failed to record admin audit event",
+				zap.String("action", event.Action),
+				zap.String("path", event.Path),
+				zap.Error(err))
+			return
+		}
+
+		if kind, ok := webhookKinds[recorded.Action]; ok {
+			if err := server.webhooks.Enqueue(ctx, kind, recorded); err != nil {
+				server.log.Error("failed to enqueue admin webhook",
+					zap.Stringer("event", recorded.ID),
+					zap.String("action", recorded.Action),
+					zap.Error(err))
+			}
+		}
+	})
+}
+
+// auditEventKey is the context key of the audit event of a request.
+type auditEventKey struct{}
+
+// setAuditEventReason sets the reason recorded with the audit event of the
+// request, e.g. the reason the admin gave for the change.
+func setAuditEventReason(ctx context.Context, reason string) {
+	if event, ok := ctx.Value(auditEventKey{}).(*adminaudit.Event); ok {
+		event.Reason = reason
+	}
+}
+
+// auditEventUser returns the ID of the user targeted by a request, either
+// directly or as the owner of the targeted project, or nil when the request
+// doesn't target an existing user or project.
+func (server *Server) auditEventUser(ctx context.Context, vars map[string]string) *uuid.UUID {
+	if email, ok := vars["useremail"]; ok {
+		user, err := server.db.Console().Users().GetByEmail(ctx, email)
+		if err != nil {
+			return nil
+		}
+		return &user.ID
+	}
+	if projectID, ok := vars["project"]; ok {
+		project, err := server.getProjectByAnyID(ctx, projectID)
+		if err != nil {
+			return nil
+		}
+		return &project.OwnerID
+	}
+	return nil
+}
+
+// statusRecorder records the status code written to a response.
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+// WriteHeader records the status code and writes it to the response.
+func (recorder *statusRecorder) WriteHeader(status int) {
+	recorder.status = status
+	recorder.ResponseWriter.WriteHeader(status)
+}
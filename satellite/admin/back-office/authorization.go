@@ -4,6 +4,7 @@
 package admin
 
 import (
+	"context"
 	"net/http"
 	"strings"
 
@@ -144,6 +145,18 @@ func (auth *Authorizer) HasPermissions(group string, perms ...Permission) bool {
 	return groupAuth.Has(perms...)
 }
 
+// Caller returns the permissions of the user who belongs to groups.
+func (auth *Authorizer) Caller(groups ...string) Caller {
+	caller := Caller{groups: groups}
+	for _, g := range groups {
+		if groupAuth, ok := auth.groupsRoles[g]; ok {
+			caller.roles = append(caller.roles, groupAuth)
+		}
+	}
+
+	return caller
+}
+
 // IsRejected verifies that r is from a user who belongs to a group that has all perms and returns
 // false, otherwise responds with http.StatusUnauthorized using
 // storj.io/storj/private.api.ServeError and returns true.
@@ -158,14 +171,74 @@ func (auth *Authorizer) IsRejected(w http.ResponseWriter, r *http.Request, perms
 		return true
 	}
 
-	groups := strings.Split(groupsh, ",")
-	for _, g := range groups {
-		if auth.HasPermissions(g, perms...) {
-			return false
-		}
+	if auth.Caller(strings.Split(groupsh, ",")...).Has(perms...) {
+		return false
 	}
 
 	err := Error.Wrap(ErrAuthorizer.New("Not enough permissions (your groups: %s)", groupsh))
 	api.ServeError(auth.log, w, http.StatusUnauthorized, err)
 	return true
 }
+
+// WithCaller is an HTTP middleware that attaches the Caller of the request to its context, so the
+// Service can verify the permissions of the user and report the operations that the user is
+// allowed to perform.
+func (auth *Authorizer) WithCaller(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var groups []string
+		if groupsh := r.Header.Get("X-Forwarded-Groups"); groupsh != "" {
+			groups = strings.Split(groupsh, ",")
+		}
+
+		ctx := WithCaller(r.Context(), auth.Caller(groups...))
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// Caller is the user who performs an operation, with the roles of the groups that the user belongs
+// to.
+type Caller struct {
+	groups []string
+	roles  []Authorization
+}
+
+// Has returns true if any of the groups of the caller has all the passed permissions.
+func (caller Caller) Has(perms ...Permission) bool {
+	for _, role := range caller.roles {
+		if role.Has(perms...) {
+			return true
+		}
+	}
+
+	return false
+}
+
+type callerKey struct{}
+
+// WithCaller returns a copy of ctx which carries caller.
+func WithCaller(ctx context.Context, caller Caller) context.Context {
+	return context.WithValue(ctx, callerKey{}, caller)
+}
+
+// GetCaller returns the caller carried by ctx. It returns false when ctx doesn't carry one, which
+// means that the operation isn't performed through the API.
+func GetCaller(ctx context.Context) (Caller, bool) {
+	caller, ok := ctx.Value(callerKey{}).(Caller)
+	return caller, ok
+}
+
+// authorize returns an error when the caller carried by ctx doesn't have all perms. Operations
+// without a caller aren't performed through the API and they are always authorized.
+func (s *Service) authorize(ctx context.Context, perms ...Permission) api.HTTPError {
+	caller, ok := GetCaller(ctx)
+	if !ok || caller.Has(perms...) {
+		return api.HTTPError{}
+	}
+
+	return api.HTTPError{
+		Status: http.StatusUnauthorized,
+		Err: Error.Wrap(ErrAuthorizer.New(
+			"Not enough permissions (your groups: %s)", strings.Join(caller.groups, ","),
+		)),
+	}
+}
@@ -0,0 +1,200 @@
+// AUTOGENERATED BY private/apigen
+// DO NOT EDIT.
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/uuid"
+	backoffice "storj.io/storj/satellite/admin/back-office"
+)
+
+// ErrAdminClient is the error class of the errors of the API client.
+var ErrAdminClient = errs.Class("admin api client")
+
+// APIError is the error returned when the API responds with an error status code.
+type APIError struct {
+	// Status is the HTTP status code of the response.
+	Status int
+	// Message is the error message of the response.
+	Message string
+}
+
+// Error returns the status code and the error message.
+func (e *APIError) Error() string {
+	return fmt.Sprintf("%d %s: %s", e.Status, http.StatusText(e.Status), e.Message)
+}
+
+// Client is an API client.
+type Client struct {
+	// BaseURL is the URL of the server without the path of the API, e.g. "https://example.test".
+	BaseURL string
+	// HTTPClient sends the requests. When nil, http.DefaultClient is used.
+	HTTPClient *http.Client
+	// Header is added to every request, e.g. to authenticate it.
+	Header http.Header
+}
+
+// do sends a request to the path with the query, the JSON encoded request body, if it isn't nil,
+// and decodes the JSON response body into response, if it isn't nil.
+func (c *Client) do(ctx context.Context, method, path string, query url.Values, request, response interface{}) (err error) {
+	fullURL := strings.TrimSuffix(c.BaseURL, "/") + path
+	if len(query) > 0 {
+		fullURL += "?" + query.Encode()
+	}
+
+	var body io.Reader = http.NoBody
+	if request != nil {
+		data, err := json.Marshal(request)
+		if err != nil {
+			return ErrAdminClient.Wrap(err)
+		}
+		body = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, fullURL, body)
+	if err != nil {
+		return ErrAdminClient.Wrap(err)
+	}
+	for key, values := range c.Header {
+		req.Header[key] = values
+	}
+	if request != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	httpClient := c.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return ErrAdminClient.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, ErrAdminClient.Wrap(resp.Body.Close())) }()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		var apiErr struct {
+			Error string `json:"error"`
+		}
+		// the body of some errors, e.g. from a proxy, isn't JSON.
+		_ = json.NewDecoder(resp.Body).Decode(&apiErr)
+		return &APIError{Status: resp.StatusCode, Message: apiErr.Error}
+	}
+
+	if response == nil {
+		return nil
+	}
+	return ErrAdminClient.Wrap(json.NewDecoder(resp.Body).Decode(response))
+}
+
+// Settings returns the client of the Settings API endpoints.
+func (c *Client) Settings() *SettingsClient {
+	return &SettingsClient{client: c}
+}
+
+// SettingsClient is an API client of the Settings API endpoints.
+type SettingsClient struct {
+	client *Client
+}
+
+// GetSettings calls the "Get settings" endpoint.
+//
+// Gets the settings of the service and relevant Storj services settings
+func (c *SettingsClient) GetSettings(ctx context.Context) (_ *backoffice.Settings, err error) {
+	fullPath := "/back-office/api/v1/settings/"
+	var response backoffice.Settings
+	err = c.client.do(ctx, "GET", fullPath, nil, nil, &response)
+	if err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// PlacementManagement returns the client of the PlacementManagement API endpoints.
+func (c *Client) PlacementManagement() *PlacementManagementClient {
+	return &PlacementManagementClient{client: c}
+}
+
+// PlacementManagementClient is an API client of the PlacementManagement API endpoints.
+type PlacementManagementClient struct {
+	client *Client
+}
+
+// GetPlacements calls the "Get placements" endpoint.
+//
+// Gets placement rule IDs and their locations
+func (c *PlacementManagementClient) GetPlacements(ctx context.Context) (_ []backoffice.PlacementInfo, err error) {
+	fullPath := "/back-office/api/v1/placements/"
+	var response []backoffice.PlacementInfo
+	err = c.client.do(ctx, "GET", fullPath, nil, nil, &response)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// UserManagement returns the client of the UserManagement API endpoints.
+func (c *Client) UserManagement() *UserManagementClient {
+	return &UserManagementClient{client: c}
+}
+
+// UserManagementClient is an API client of the UserManagement API endpoints.
+type UserManagementClient struct {
+	client *Client
+}
+
+// GetUserByEmail calls the "Get user" endpoint.
+//
+// Gets user by email address
+func (c *UserManagementClient) GetUserByEmail(ctx context.Context, email string) (_ *backoffice.UserAccount, err error) {
+	fullPath := "/back-office/api/v1/users/" + url.PathEscape(email)
+	var response backoffice.UserAccount
+	err = c.client.do(ctx, "GET", fullPath, nil, nil, &response)
+	if err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// ProjectManagement returns the client of the ProjectManagement API endpoints.
+func (c *Client) ProjectManagement() *ProjectManagementClient {
+	return &ProjectManagementClient{client: c}
+}
+
+// ProjectManagementClient is an API client of the ProjectManagement API endpoints.
+type ProjectManagementClient struct {
+	client *Client
+}
+
+// GetProject calls the "Get project" endpoint.
+//
+// Gets project by ID
+func (c *ProjectManagementClient) GetProject(ctx context.Context, publicID uuid.UUID) (_ *backoffice.Project, err error) {
+	fullPath := "/back-office/api/v1/projects/" + url.PathEscape(publicID.String())
+	var response backoffice.Project
+	err = c.client.do(ctx, "GET", fullPath, nil, nil, &response)
+	if err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// UpdateProjectLimits calls the "Update project limits" endpoint.
+//
+// Updates project limits by ID
+func (c *ProjectManagementClient) UpdateProjectLimits(ctx context.Context, publicID uuid.UUID, request backoffice.ProjectLimitsUpdate) (err error) {
+	fullPath := "/back-office/api/v1/projects/limits/" + url.PathEscape(publicID.String())
+	return c.client.do(ctx, "PUT", fullPath, nil, request, nil)
+}
@@ -2,7 +2,8 @@
 // See LICENSE for copying information.
 
 // Package main defines the satellite administration API through the API generator and generates
-// source code of the API server handlers and clients and the documentation markdown document.
+// source code of the API server handlers and clients, the documentation markdown document, and the
+// OpenAPI document.
 package main
 
 //go:generate go run $GOFILE
@@ -99,6 +100,8 @@ func main() {
 	api.MustWriteGo(filepath.Join("satellite", "admin", "back-office", "handlers.gen.go"))
 	api.MustWriteTS(filepath.Join("satellite", "admin", "back-office", "ui", "src", "api", "client.gen.ts"))
 	api.MustWriteDocs(filepath.Join("satellite", "admin", "back-office", "api-docs.gen.md"))
+	api.MustWriteOpenAPI(filepath.Join("satellite", "admin", "back-office", "openapi.gen.json"))
+	api.MustWriteGoClient(filepath.Join("satellite", "admin", "back-office", "client", "client.gen.go"))
 }
 
 type authMiddleware struct {
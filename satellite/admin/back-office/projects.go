@@ -66,6 +66,10 @@ func (s *Service) GetProject(ctx context.Context, id uuid.UUID) (*Project, api.H
 	var err error
 	defer mon.Task()(&ctx)(&err)
 
+	if apiErr := s.authorize(ctx, PermProjectView); apiErr.Err != nil {
+		return nil, apiErr
+	}
+
 	p, err := s.consoleDB.Projects().GetByPublicID(ctx, id)
 	if err != nil {
 		status := http.StatusInternalServerError
@@ -257,6 +261,10 @@ func (s *Service) UpdateProjectLimits(ctx context.Context, id uuid.UUID, req Pro
 	var err error
 	defer mon.Task()(&ctx)(&err)
 
+	if apiErr := s.authorize(ctx, PermProjectSetLimits); apiErr.Err != nil {
+		return apiErr
+	}
+
 	p, err := s.consoleDB.Projects().GetByPublicID(ctx, id)
 	if err != nil {
 		status := http.StatusInternalServerError
@@ -86,10 +86,13 @@ func NewServer(
 
 	// API endpoints.
 	// API generator already add the PathPrefix.
-	NewPlacementManagement(log, mon, service, root)
-	NewUserManagement(log, mon, service, root, auth)
-	NewProjectManagement(log, mon, service, root, auth)
-	NewSettings(log, mon, service, root)
+	// The caller is attached to the requests, so the service can verify its permissions.
+	apiRouter := root.NewRoute().Subrouter()
+	apiRouter.Use(auth.WithCaller)
+	NewPlacementManagement(log, mon, service, apiRouter)
+	NewUserManagement(log, mon, service, apiRouter, auth)
+	NewProjectManagement(log, mon, service, apiRouter, auth)
+	NewSettings(log, mon, service, apiRouter)
 
 	root = root.PathPrefix(PathPrefix).Subrouter()
 	// Static assets for the web interface.
@@ -85,9 +85,10 @@ type BucketFlags struct {
 	View                   bool `json:"view"`
 }
 
-// GetSettings returns the service settings.
+// GetSettings returns the service settings. When ctx carries a Caller, the features that the
+// caller isn't allowed to use are reported as disabled.
 func (s *Service) GetSettings(ctx context.Context) (*Settings, api.HTTPError) {
-	return &Settings{
+	settings := &Settings{
 		Admin: SettingsAdmin{
 			Features: FeatureFlags{
 				Account: AccountFlags{
@@ -137,5 +138,49 @@ func (s *Service) GetSettings(ctx context.Context) (*Settings, api.HTTPError) {
 				SwitchSatellite: false,
 			},
 		},
-	}, api.HTTPError{}
+	}
+
+	if caller, ok := GetCaller(ctx); ok {
+		settings.Admin.Features.restrict(caller)
+	}
+
+	return settings, api.HTTPError{}
+}
+
+// restrict disables the features that caller doesn't have the permissions to use. The features
+// without a permission aren't implemented yet; they get one when they are.
+func (f *FeatureFlags) restrict(caller Caller) {
+	allow := func(flag *bool, perms ...Permission) {
+		*flag = *flag && caller.Has(perms...)
+	}
+
+	allow(&f.Account.Delete, PermAccountDeleteNoData)
+	allow(&f.Account.History, PermAccountView)
+	allow(&f.Account.List, PermAccountView)
+	allow(&f.Account.Projects, PermAccountView, PermProjectView)
+	allow(&f.Account.Search, PermAccountView)
+	allow(&f.Account.Suspend, PermAccountSuspendTemporary)
+	allow(&f.Account.Unsuspend, PermAccountReActivateTemporary)
+	allow(&f.Account.ResetMFA, PermAccountDisableMFA)
+	allow(&f.Account.UpdateInfo, PermAccountChangeEmail)
+	allow(&f.Account.UpdateLimits, PermAccountChangeLimits)
+	allow(&f.Account.UpdatePlacement, PermAccountSetDataPlacement)
+	allow(&f.Account.UpdateStatus, PermAccountSuspendPermanently, PermAccountReActivatePermanently)
+	allow(&f.Account.UpdateValueAttribution, PermAccountSetUserAgent)
+	allow(&f.Account.View, PermAccountView)
+
+	allow(&f.Project.History, PermProjectView)
+	allow(&f.Project.List, PermProjectView)
+	allow(&f.Project.UpdateLimits, PermProjectSetLimits)
+	allow(&f.Project.UpdatePlacement, PermProjectSetDataPlacement)
+	allow(&f.Project.UpdateValueAttribution, PermProjectSetUserAgent)
+	allow(&f.Project.View, PermProjectView)
+	allow(&f.Project.MemberList, PermProjectView)
+	allow(&f.Project.MemberAdd, PermProjectSendInvitation)
+
+	allow(&f.Bucket.History, PermBucketView)
+	allow(&f.Bucket.List, PermBucketView)
+	allow(&f.Bucket.UpdatePlacement, PermBucketSetDataPlacement)
+	allow(&f.Bucket.UpdateValueAttribution, PermBucketSetUserAgent)
+	allow(&f.Bucket.View, PermBucketView)
 }
@@ -4,12 +4,16 @@
 package admin_test
 
 import (
+	"net/http"
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
 
 	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
 	"storj.io/storj/private/testplanet"
+	admin "storj.io/storj/satellite/admin/back-office"
 )
 
 func TestGetSettings(t *testing.T) {
@@ -18,7 +22,31 @@ func TestGetSettings(t *testing.T) {
 	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
 		service := planet.Satellites[0].Admin.Admin.Service
 
-		_, apiErr := service.GetSettings(ctx)
+		settings, apiErr := service.GetSettings(ctx)
 		require.NoError(t, apiErr.Err)
+		require.True(t, settings.Admin.Features.Project.UpdateLimits)
+
+		auth := admin.NewAuthorizer(zaptest.NewLogger(t), []string{"admin"}, []string{"viewer"}, nil, nil)
+
+		settings, apiErr = service.GetSettings(admin.WithCaller(ctx, auth.Caller("admin")))
+		require.NoError(t, apiErr.Err)
+		require.True(t, settings.Admin.Features.Account.View)
+		require.True(t, settings.Admin.Features.Project.UpdateLimits)
+
+		settings, apiErr = service.GetSettings(admin.WithCaller(ctx, auth.Caller("viewer")))
+		require.NoError(t, apiErr.Err)
+		require.True(t, settings.Admin.Features.Account.View)
+		require.True(t, settings.Admin.Features.Project.View)
+		require.False(t, settings.Admin.Features.Project.UpdateLimits)
+
+		settings, apiErr = service.GetSettings(admin.WithCaller(ctx, auth.Caller("engineering")))
+		require.NoError(t, apiErr.Err)
+		require.False(t, settings.Admin.Features.Account.View)
+		require.False(t, settings.Admin.Features.Project.View)
+
+		// the service rejects the operations that the caller isn't allowed to perform.
+		apiErr = service.UpdateProjectLimits(admin.WithCaller(ctx, auth.Caller("viewer")), testrand.UUID(), admin.ProjectLimitsUpdate{})
+		require.Error(t, apiErr.Err)
+		require.Equal(t, http.StatusUnauthorized, apiErr.Status)
 	})
 }
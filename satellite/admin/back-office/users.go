@@ -45,6 +45,10 @@ func (s *Service) GetUserByEmail(ctx context.Context, email string) (*UserAccoun
 	var err error
 	defer mon.Task()(&ctx)(&err)
 
+	if apiErr := s.authorize(ctx, PermAccountView); apiErr.Err != nil {
+		return nil, apiErr
+	}
+
 	user, err := s.consoleDB.Users().GetByEmail(ctx, email)
 	if err != nil {
 		status := http.StatusInternalServerError
@@ -132,3 +132,37 @@ func (server *Server) getBucketInfo(w http.ResponseWriter, r *http.Request) {
 		sendJSONData(w, http.StatusOK, data)
 	}
 }
+
+func (server *Server) validateBucketSettings(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	project, bucket, err := validateBucketPathParameters(mux.Vars(r))
+	if err != nil {
+		sendJSONError(w, err.Error(), "", http.StatusBadRequest)
+		return
+	}
+
+	issues, err := server.buckets.ValidateBucketSettings(ctx, bucket, project.UUID)
+	if err != nil {
+		if buckets.ErrBucketNotFound.Has(err) {
+			sendJSONError(w, "bucket does not exist", "", http.StatusNotFound)
+		} else {
+			sendJSONError(w, "unable to validate bucket settings", err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	data, err := json.Marshal(struct {
+		Valid  bool                    `json:"valid"`
+		Issues []buckets.SettingsIssue `json:"issues"`
+	}{
+		Valid:  len(issues) == 0,
+		Issues: append([]buckets.SettingsIssue{}, issues...),
+	})
+	if err != nil {
+		sendJSONError(w, "failed to marshal bucket settings issues", err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONData(w, http.StatusOK, data)
+}
@@ -0,0 +1,141 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+	"go.uber.org/zap"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/private/web"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/console/consoleauth"
+)
+
+// impersonateUser issues a short-lived, read-only console session of a user
+// to the admin, so support can reproduce the issues reported by the user
+// without resetting their password. The reason is required and recorded with
+// the audit event of the request.
+func (server *Server) impersonateUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	vars := mux.Vars(r)
+	userEmail, ok := vars["useremail"]
+	if !ok {
+		sendJSONError(w, "user-email missing",
+			"", http.StatusBadRequest)
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		sendJSONError(w, "failed to read body",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	var input struct {
+		Reason string `json:"reason"`
+	}
+
+	err = json.Unmarshal(body, &input)
+	if err != nil {
+		sendJSONError(w, "failed to unmarshal request",
+			err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	input.Reason = strings.TrimSpace(input.Reason)
+	if input.Reason == "" {
+		sendJSONError(w, "reason is required",
+			"the reason for impersonating the user is recorded in the audit events", http.StatusBadRequest)
+		return
+	}
+
+	if server.console.AuthTokenSecret == "" {
+		sendJSONError(w, "impersonation is not enabled",
+			"the console auth token secret is not configured", http.StatusInternalServerError)
+		return
+	}
+
+	user, err := server.db.Console().Users().GetByEmail(ctx, userEmail)
+	if errors.Is(err, sql.ErrNoRows) {
+		sendJSONError(w, fmt.Sprintf("user with email %q does not exist", userEmail),
+			"", http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		sendJSONError(w, "failed to get user",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if user.Status != console.Active && user.Status != console.PendingBotVerification {
+		sendJSONError(w, "user is not active",
+			fmt.Sprintf("the console doesn't accept sessions of users with status %s", user.Status), http.StatusConflict)
+		return
+	}
+
+	ip, err := web.GetRequestIP(r)
+	if err != nil {
+		sendJSONError(w, "unable to get the client address",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sessionID, err := uuid.New()
+	if err != nil {
+		sendJSONError(w, "failed to generate session id",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	expiresAt := server.nowFn().Add(server.config.ImpersonationDuration)
+	_, err = server.db.Console().WebappSessions().CreateReadOnly(ctx, sessionID, user.ID, ip, r.UserAgent(), expiresAt)
+	if err != nil {
+		sendJSONError(w, "failed to create session",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	token := consoleauth.Token{Payload: sessionID.Bytes()}
+	token.Signature, err = server.consoleTokens.SignToken(token)
+	if err != nil {
+		sendJSONError(w, "failed to sign session token",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	setAuditEventReason(ctx, input.Reason)
+
+	server.log.Info("issued read-only session to impersonate user",
+		zap.String("admin", r.Header.Get("X-Forwarded-Email")),
+		zap.Stringer("user", user.ID),
+		zap.String("reason", input.Reason),
+		zap.Time("expires-at", expiresAt))
+
+	data, err := json.Marshal(struct {
+		Token     string    `json:"token"`
+		ExpiresAt time.Time `json:"expiresAt"`
+	}{
+		Token:     token.String(),
+		ExpiresAt: expiresAt,
+	})
+	if err != nil {
+		sendJSONError(w, "json encoding failed",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONData(w, http.StatusOK, data)
+}
@@ -0,0 +1,92 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/common/testcontext"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/adminaudit"
+)
+
+func TestImpersonateUser(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount:   1,
+		StorageNodeCount: 0,
+		UplinkCount:      1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(_ *zap.Logger, _ int, config *satellite.Config) {
+				config.Admin.Address = "127.0.0.1:0"
+				config.Admin.ImpersonationDuration = 5 * time.Minute
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		address := sat.Admin.Admin.Listener.Addr()
+		authToken := sat.Config.Console.AuthToken
+		user, err := sat.DB.Console().Users().GetByEmail(ctx, planet.Uplinks[0].Projects[0].Owner.Email)
+		require.NoError(t, err)
+
+		link := fmt.Sprintf("http://%s/api/users/%s/impersonate", address, user.Email)
+
+		t.Run("reason required", func(t *testing.T) {
+			assertReq(ctx, t, link, http.MethodPost, `{"reason":" "}`, http.StatusBadRequest, "", authToken)
+		})
+
+		t.Run("unknown user", func(t *testing.T) {
+			unknown := fmt.Sprintf("http://%s/api/users/%s/impersonate", address, "unknown@storj.test")
+			assertReq(ctx, t, unknown, http.MethodPost, `{"reason":"ticket 1234"}`, http.StatusNotFound, "", authToken)
+		})
+
+		before := time.Now()
+		body := assertReq(ctx, t, link, http.MethodPost, `{"reason":"ticket 1234"}`, http.StatusOK, "", authToken)
+
+		var output struct {
+			Token     string    `json:"token"`
+			ExpiresAt time.Time `json:"expiresAt"`
+		}
+		require.NoError(t, json.Unmarshal(body, &output))
+		require.NotEmpty(t, output.Token)
+		require.WithinDuration(t, before.Add(5*time.Minute), output.ExpiresAt, time.Minute)
+
+		consoleReq := func(method, path, body string) int {
+			req, err := http.NewRequestWithContext(ctx, method, sat.ConsoleURL()+path, strings.NewReader(body))
+			require.NoError(t, err)
+			req.AddCookie(&http.Cookie{Name: "_tokenKey", Value: output.Token})
+			req.Header.Set("Content-Type", "application/json")
+
+			res, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			require.NoError(t, res.Body.Close())
+			return res.StatusCode
+		}
+
+		// the session can read, but not change anything.
+		require.Equal(t, http.StatusOK, consoleReq(http.MethodGet, "/api/v0/auth/account", ""))
+		require.Equal(t, http.StatusForbidden, consoleReq(http.MethodPatch, "/api/v0/auth/account", `{"fullName":"Impersonator"}`))
+		require.Equal(t, http.StatusForbidden, consoleReq(http.MethodPost, "/api/v0/auth/refresh-session", ""))
+
+		updated, err := sat.DB.Console().Users().Get(ctx, user.ID)
+		require.NoError(t, err)
+		require.Equal(t, user.FullName, updated.FullName)
+
+		events, err := sat.Admin.AdminAudit.Service.Search(ctx, adminaudit.SearchOptions{
+			UserID: &user.ID,
+			Action: "POST /api/users/{useremail}/impersonate",
+		})
+		require.NoError(t, err)
+		require.Len(t, events.Events, 1)
+		require.Equal(t, "ticket 1234", events.Events[0].Reason)
+	})
+}
@@ -0,0 +1,47 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+
+	"go.uber.org/zap"
+)
+
+func (server *Server) getIncidentBundle(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	filename := fmt.Sprintf("incident-%s.zip", server.nowFn().UTC().Format("20060102T150405Z"))
+	w.Header().Set("Content-Type", "application/zip")
+	w.Header().Set("Content-Disposition", `attachment; filename="`+filename+`"`)
+	w.WriteHeader(http.StatusOK)
+
+	// the bundle is streamed, so a failure can't be reported as a JSON
+	// error anymore. The archive is left incomplete, which makes it invalid.
+	counter := &countingWriter{w: w}
+	if err := server.incident.WriteBundle(ctx, counter); err != nil {
+		server.log.Error("failed to collect incident bundle",
+			zap.String("operator", r.Header.Get("X-Forwarded-Email")),
+			zap.Error(err))
+		return
+	}
+
+	server.log.Info("incident bundle collected",
+		zap.String("operator", r.Header.Get("X-Forwarded-Email")),
+		zap.Int64("size", counter.n))
+}
+
+// countingWriter counts the bytes written to w.
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
+}
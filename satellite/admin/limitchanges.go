@@ -0,0 +1,242 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"storj.io/common/memory"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/limitschedule"
+)
+
+type limitChangeRequest struct {
+	EffectiveAt time.Time    `json:"effectiveAt"`
+	Usage       *memory.Size `json:"usage,omitempty"`
+	Bandwidth   *memory.Size `json:"bandwidth,omitempty"`
+	Segments    *int64       `json:"segments,omitempty"`
+	Rate        *int         `json:"rate,omitempty"`
+	Burst       *int         `json:"burst,omitempty"`
+	Buckets     *int         `json:"buckets,omitempty"`
+}
+
+type limitChangeResponse struct {
+	ID          uuid.UUID    `json:"id"`
+	ProjectID   uuid.UUID    `json:"projectId"`
+	EffectiveAt time.Time    `json:"effectiveAt"`
+	Usage       *memory.Size `json:"usage,omitempty"`
+	Bandwidth   *memory.Size `json:"bandwidth,omitempty"`
+	Segments    *int64       `json:"segments,omitempty"`
+	Rate        *int         `json:"rate,omitempty"`
+	Burst       *int         `json:"burst,omitempty"`
+	Buckets     *int         `json:"buckets,omitempty"`
+	CreatedBy   string       `json:"createdBy"`
+	CreatedAt   time.Time    `json:"createdAt"`
+	UpdatedAt   time.Time    `json:"updatedAt"`
+	AppliedAt   *time.Time   `json:"appliedAt,omitempty"`
+}
+
+func toLimitChangeResponse(change limitschedule.Change) limitChangeResponse {
+	return limitChangeResponse{
+		ID:          change.ID,
+		ProjectID:   change.ProjectID,
+		EffectiveAt: change.EffectiveAt,
+		Usage:       change.Limits.Usage,
+		Bandwidth:   change.Limits.Bandwidth,
+		Segments:    change.Limits.Segments,
+		Rate:        change.Limits.Rate,
+		Burst:       change.Limits.Burst,
+		Buckets:     change.Limits.Buckets,
+		CreatedBy:   change.CreatedBy,
+		CreatedAt:   change.CreatedAt,
+		UpdatedAt:   change.UpdatedAt,
+		AppliedAt:   change.AppliedAt,
+	}
+}
+
+func (server *Server) listLimitChanges(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	project, ok := server.limitChangeProject(w, r)
+	if !ok {
+		return
+	}
+
+	changes, err := server.limitSchedule.List(ctx, project.ID)
+	if err != nil {
+		sendJSONError(w, "failed to list limit changes", err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	output := make([]limitChangeResponse, 0, len(changes))
+	for _, change := range changes {
+		output = append(output, toLimitChangeResponse(change))
+	}
+
+	data, err := json.Marshal(output)
+	if err != nil {
+		sendJSONError(w, "json encoding failed", err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONData(w, http.StatusOK, data)
+}
+
+func (server *Server) scheduleLimitChange(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	project, ok := server.limitChangeProject(w, r)
+	if !ok {
+		return
+	}
+
+	input, ok := decodeLimitChange(w, r)
+	if !ok {
+		return
+	}
+
+	change, err := server.limitSchedule.Schedule(ctx, r.Header.Get("X-Forwarded-Email"), project.ID, input.EffectiveAt, input.limits())
+	if err != nil {
+		sendLimitChangeError(w, "failed to schedule limit change", err)
+		return
+	}
+
+	data, err := json.Marshal(toLimitChangeResponse(change))
+	if err != nil {
+		sendJSONError(w, "json encoding failed", err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONData(w, http.StatusOK, data)
+}
+
+func (server *Server) editLimitChange(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id, ok := server.projectLimitChangeID(w, r)
+	if !ok {
+		return
+	}
+
+	input, ok := decodeLimitChange(w, r)
+	if !ok {
+		return
+	}
+
+	change, err := server.limitSchedule.Edit(ctx, r.Header.Get("X-Forwarded-Email"), id, input.EffectiveAt, input.limits())
+	if err != nil {
+		sendLimitChangeError(w, "failed to edit limit change", err)
+		return
+	}
+
+	data, err := json.Marshal(toLimitChangeResponse(change))
+	if err != nil {
+		sendJSONError(w, "json encoding failed", err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONData(w, http.StatusOK, data)
+}
+
+func (server *Server) cancelLimitChange(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id, ok := server.projectLimitChangeID(w, r)
+	if !ok {
+		return
+	}
+
+	err := server.limitSchedule.Cancel(ctx, r.Header.Get("X-Forwarded-Email"), id)
+	if err != nil {
+		sendLimitChangeError(w, "failed to cancel limit change", err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// limitChangeProject returns the project of the request. It sends an error
+// response and returns false when the project doesn't exist.
+func (server *Server) limitChangeProject(w http.ResponseWriter, r *http.Request) (*console.Project, bool) {
+	project, err := server.getProjectByAnyID(r.Context(), mux.Vars(r)["project"])
+	if errors.Is(err, sql.ErrNoRows) {
+		sendJSONError(w, "project with specified uuid does not exist", "", http.StatusNotFound)
+		return nil, false
+	}
+	if err != nil {
+		sendJSONError(w, "failed to get project", err.Error(), http.StatusInternalServerError)
+		return nil, false
+	}
+	return project, true
+}
+
+// projectLimitChangeID returns the ID of the limit change of the request,
+// after checking that the change belongs to the project of the request.
+func (server *Server) projectLimitChangeID(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	project, ok := server.limitChangeProject(w, r)
+	if !ok {
+		return uuid.UUID{}, false
+	}
+
+	id, err := uuid.FromString(mux.Vars(r)["id"])
+	if err != nil {
+		sendJSONError(w, "invalid limit change id", err.Error(), http.StatusBadRequest)
+		return uuid.UUID{}, false
+	}
+
+	change, err := server.limitSchedule.Get(r.Context(), id)
+	if err != nil {
+		sendLimitChangeError(w, "failed to get limit change", err)
+		return uuid.UUID{}, false
+	}
+	if change.ProjectID != project.ID {
+		sendJSONError(w, "limit change does not exist", "", http.StatusNotFound)
+		return uuid.UUID{}, false
+	}
+	if !change.Pending() {
+		sendJSONError(w, "limit change was already applied", "", http.StatusConflict)
+		return uuid.UUID{}, false
+	}
+	return id, true
+}
+
+func (input limitChangeRequest) limits() limitschedule.Limits {
+	return limitschedule.Limits{
+		Usage:     input.Usage,
+		Bandwidth: input.Bandwidth,
+		Segments:  input.Segments,
+		Rate:      input.Rate,
+		Burst:     input.Burst,
+		Buckets:   input.Buckets,
+	}
+}
+
+// decodeLimitChange decodes the request body into a limit change request.
+// It sends an error response and returns false when the body is invalid.
+func decodeLimitChange(w http.ResponseWriter, r *http.Request) (limitChangeRequest, bool) {
+	var input limitChangeRequest
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		sendJSONError(w, "invalid json", err.Error(), http.StatusBadRequest)
+		return limitChangeRequest{}, false
+	}
+	return input, true
+}
+
+func sendLimitChangeError(w http.ResponseWriter, errMsg string, err error) {
+	switch {
+	case limitschedule.ErrValidation.Has(err):
+		sendJSONError(w, errMsg, err.Error(), http.StatusBadRequest)
+	case limitschedule.ErrNotFound.Has(err):
+		sendJSONError(w, errMsg, err.Error(), http.StatusNotFound)
+	default:
+		sendJSONError(w, errMsg, err.Error(), http.StatusInternalServerError)
+	}
+}
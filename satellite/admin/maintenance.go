@@ -0,0 +1,199 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/maintenance"
+)
+
+type maintenanceWindowRequest struct {
+	StartsAt   time.Time `json:"startsAt"`
+	EndsAt     time.Time `json:"endsAt"`
+	Components []string  `json:"components"`
+	Message    string    `json:"message"`
+}
+
+type maintenanceWindowResponse struct {
+	ID         uuid.UUID `json:"id"`
+	StartsAt   time.Time `json:"startsAt"`
+	EndsAt     time.Time `json:"endsAt"`
+	Components []string  `json:"components"`
+	Message    string    `json:"message"`
+	CreatedBy  string    `json:"createdBy"`
+	CreatedAt  time.Time `json:"createdAt"`
+	UpdatedAt  time.Time `json:"updatedAt"`
+}
+
+func toMaintenanceWindowResponse(window maintenance.Window) maintenanceWindowResponse {
+	return maintenanceWindowResponse{
+		ID:         window.ID,
+		StartsAt:   window.StartsAt,
+		EndsAt:     window.EndsAt,
+		Components: window.Components.Names(),
+		Message:    window.Message,
+		CreatedBy:  window.CreatedBy,
+		CreatedAt:  window.CreatedAt,
+		UpdatedAt:  window.UpdatedAt,
+	}
+}
+
+func (server *Server) listMaintenanceWindows(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	windows, err := server.maintenance.ListUpcoming(ctx)
+	if err != nil {
+		sendJSONError(w, "failed to list maintenance windows", err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	output := make([]maintenanceWindowResponse, 0, len(windows))
+	for _, window := range windows {
+		output = append(output, toMaintenanceWindowResponse(window))
+	}
+
+	data, err := json.Marshal(output)
+	if err != nil {
+		sendJSONError(w, "json encoding failed", err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONData(w, http.StatusOK, data)
+}
+
+func (server *Server) createMaintenanceWindow(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	window, ok := decodeMaintenanceWindow(w, r)
+	if !ok {
+		return
+	}
+	window.CreatedBy = r.Header.Get("X-Forwarded-Email")
+
+	window, err := server.maintenance.Create(ctx, window)
+	if err != nil {
+		sendMaintenanceError(w, "failed to create maintenance window", err)
+		return
+	}
+
+	data, err := json.Marshal(toMaintenanceWindowResponse(window))
+	if err != nil {
+		sendJSONError(w, "json encoding failed", err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONData(w, http.StatusOK, data)
+}
+
+func (server *Server) getMaintenanceWindow(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id, err := uuid.FromString(mux.Vars(r)["id"])
+	if err != nil {
+		sendJSONError(w, "invalid maintenance window id", err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	window, err := server.maintenance.Get(ctx, id)
+	if err != nil {
+		sendMaintenanceError(w, "failed to get maintenance window", err)
+		return
+	}
+
+	data, err := json.Marshal(toMaintenanceWindowResponse(window))
+	if err != nil {
+		sendJSONError(w, "json encoding failed", err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONData(w, http.StatusOK, data)
+}
+
+func (server *Server) updateMaintenanceWindow(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id, err := uuid.FromString(mux.Vars(r)["id"])
+	if err != nil {
+		sendJSONError(w, "invalid maintenance window id", err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	window, ok := decodeMaintenanceWindow(w, r)
+	if !ok {
+		return
+	}
+	window.ID = id
+
+	window, err = server.maintenance.Update(ctx, window)
+	if err != nil {
+		sendMaintenanceError(w, "failed to update maintenance window", err)
+		return
+	}
+
+	data, err := json.Marshal(toMaintenanceWindowResponse(window))
+	if err != nil {
+		sendJSONError(w, "json encoding failed", err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONData(w, http.StatusOK, data)
+}
+
+func (server *Server) deleteMaintenanceWindow(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id, err := uuid.FromString(mux.Vars(r)["id"])
+	if err != nil {
+		sendJSONError(w, "invalid maintenance window id", err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	err = server.maintenance.Delete(ctx, id)
+	if err != nil {
+		sendMaintenanceError(w, "failed to delete maintenance window", err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// decodeMaintenanceWindow decodes the request body into a maintenance window.
+// It sends an error response and returns false when the body is invalid.
+func decodeMaintenanceWindow(w http.ResponseWriter, r *http.Request) (maintenance.Window, bool) {
+	var input maintenanceWindowRequest
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		sendJSONError(w, "invalid json", err.Error(), http.StatusBadRequest)
+		return maintenance.Window{}, false
+	}
+
+	components, err := maintenance.ParseComponents(input.Components)
+	if err != nil {
+		sendJSONError(w, "invalid components", err.Error(), http.StatusBadRequest)
+		return maintenance.Window{}, false
+	}
+
+	return maintenance.Window{
+		StartsAt:   input.StartsAt,
+		EndsAt:     input.EndsAt,
+		Components: components,
+		Message:    input.Message,
+	}, true
+}
+
+func sendMaintenanceError(w http.ResponseWriter, errMsg string, err error) {
+	switch {
+	case maintenance.ErrValidation.Has(err):
+		sendJSONError(w, errMsg, err.Error(), http.StatusBadRequest)
+	case maintenance.ErrNotFound.Has(err):
+		sendJSONError(w, errMsg, err.Error(), http.StatusNotFound)
+	default:
+		sendJSONError(w, errMsg, err.Error(), http.StatusInternalServerError)
+	}
+}
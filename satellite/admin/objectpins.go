@@ -0,0 +1,340 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"storj.io/storj/satellite/buckets"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/objectpins"
+)
+
+// objectPinVersion identifies a pinned object version. The key is the
+// encrypted object key, which is base64 encoded in JSON.
+type objectPinVersion struct {
+	EncryptedObjectKey []byte `json:"encryptedObjectKey"`
+	Version            int64  `json:"version"`
+}
+
+type createObjectPinRequest struct {
+	Name     string             `json:"name"`
+	Versions []objectPinVersion `json:"versions"`
+}
+
+type objectPinResponse struct {
+	Name         string             `json:"name"`
+	CreatedAt    time.Time          `json:"createdAt"`
+	VersionCount int64              `json:"versionCount"`
+	Versions     []objectPinVersion `json:"versions,omitempty"`
+}
+
+// undeleteObjectRequest identifies the object by its encrypted key, which is
+// base64 encoded in JSON.
+type undeleteObjectRequest struct {
+	EncryptedObjectKey []byte `json:"encryptedObjectKey"`
+}
+
+type undeleteObjectResponse struct {
+	DeleteMarkerVersion int64  `json:"deleteMarkerVersion"`
+	RestoredVersion     *int64 `json:"restoredVersion,omitempty"`
+}
+
+type objectLockBucketReport struct {
+	BucketName         string     `json:"bucketName"`
+	RetainedObjects    int64      `json:"retainedObjects"`
+	RetainedBytes      int64      `json:"retainedBytes"`
+	SoonestRetainUntil *time.Time `json:"soonestRetainUntil,omitempty"`
+	LegalHoldObjects   int64      `json:"legalHoldObjects"`
+	LegalHoldBytes     int64      `json:"legalHoldBytes"`
+}
+
+func newObjectPinResponse(pin metabase.ObjectPin) objectPinResponse {
+	response := objectPinResponse{
+		Name:         pin.Name,
+		CreatedAt:    pin.CreatedAt,
+		VersionCount: pin.VersionCount,
+	}
+	for _, version := range pin.Versions {
+		response.Versions = append(response.Versions, objectPinVersion{
+			EncryptedObjectKey: []byte(version.ObjectKey),
+			Version:            int64(version.Version),
+		})
+	}
+	return response
+}
+
+// objectPinBucket returns the bucket of an object pin request.
+func (server *Server) objectPinBucket(w http.ResponseWriter, r *http.Request) (bucket metabase.BucketLocation, ok bool) {
+	ctx := r.Context()
+
+	project, bucketName, err := validateBucketPathParameters(mux.Vars(r))
+	if err != nil {
+		sendJSONError(w, err.Error(), "", http.StatusBadRequest)
+		return metabase.BucketLocation{}, false
+	}
+
+	_, err = server.buckets.GetBucket(ctx, bucketName, project.UUID)
+	if err != nil {
+		if buckets.ErrBucketNotFound.Has(err) {
+			sendJSONError(w, "bucket does not exist", "", http.StatusNotFound)
+		} else {
+			sendJSONError(w, "unable to get bucket", err.Error(), http.StatusInternalServerError)
+		}
+		return metabase.BucketLocation{}, false
+	}
+	return metabase.BucketLocation{ProjectID: project.UUID, BucketName: string(bucketName)}, true
+}
+
+func objectPinActor(r *http.Request) objectpins.Actor {
+	return objectpins.Actor{Source: "admin", Email: r.Header.Get("X-Forwarded-Email")}
+}
+
+func (server *Server) listObjectPins(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	bucket, ok := server.objectPinBucket(w, r)
+	if !ok {
+		return
+	}
+
+	pins, err := server.objectPins.List(ctx, bucket.ProjectID, bucket.BucketName)
+	if err != nil {
+		sendJSONError(w, "unable to list object pins", err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	response := make([]objectPinResponse, 0, len(pins))
+	for _, pin := range pins {
+		response = append(response, newObjectPinResponse(pin))
+	}
+
+	data, err := json.Marshal(response)
+	if err != nil {
+		sendJSONError(w, "json encoding failed", err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONData(w, http.StatusOK, data)
+}
+
+func (server *Server) createObjectPin(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	bucket, ok := server.objectPinBucket(w, r)
+	if !ok {
+		return
+	}
+
+	var input createObjectPinRequest
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		sendJSONError(w, "failed to read body", err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	opts := metabase.CreateObjectPin{
+		ObjectPinLocation: metabase.ObjectPinLocation{
+			ProjectID:  bucket.ProjectID,
+			BucketName: bucket.BucketName,
+			Name:       input.Name,
+		},
+	}
+	for _, version := range input.Versions {
+		opts.Versions = append(opts.Versions, metabase.ObjectPinVersion{
+			ObjectKey: metabase.ObjectKey(version.EncryptedObjectKey),
+			Version:   metabase.Version(version.Version),
+		})
+	}
+
+	pin, err := server.objectPins.Create(ctx, objectPinActor(r), opts)
+	if err != nil {
+		switch {
+		case metabase.ErrInvalidRequest.Has(err):
+			sendJSONError(w, "invalid object pin", err.Error(), http.StatusBadRequest)
+		case metabase.ErrObjectNotFound.Has(err):
+			sendJSONError(w, "object versions not found", err.Error(), http.StatusNotFound)
+		case metabase.ErrObjectPinExists.Has(err):
+			sendJSONError(w, "object pin already exists", err.Error(), http.StatusConflict)
+		default:
+			sendJSONError(w, "unable to create object pin", err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	data, err := json.Marshal(newObjectPinResponse(pin))
+	if err != nil {
+		sendJSONError(w, "json encoding failed", err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONData(w, http.StatusOK, data)
+}
+
+func (server *Server) getObjectPin(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	bucket, ok := server.objectPinBucket(w, r)
+	if !ok {
+		return
+	}
+
+	pin, err := server.objectPins.Get(ctx, metabase.ObjectPinLocation{
+		ProjectID:  bucket.ProjectID,
+		BucketName: bucket.BucketName,
+		Name:       mux.Vars(r)["pin"],
+	})
+	if err != nil {
+		switch {
+		case metabase.ErrInvalidRequest.Has(err):
+			sendJSONError(w, "invalid object pin", err.Error(), http.StatusBadRequest)
+		case metabase.ErrObjectPinNotFound.Has(err):
+			sendJSONError(w, "object pin does not exist", "", http.StatusNotFound)
+		default:
+			sendJSONError(w, "unable to get object pin", err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	data, err := json.Marshal(newObjectPinResponse(pin))
+	if err != nil {
+		sendJSONError(w, "json encoding failed", err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONData(w, http.StatusOK, data)
+}
+
+func (server *Server) releaseObjectPin(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	bucket, ok := server.objectPinBucket(w, r)
+	if !ok {
+		return
+	}
+
+	err := server.objectPins.Release(ctx, objectPinActor(r), metabase.ObjectPinLocation{
+		ProjectID:  bucket.ProjectID,
+		BucketName: bucket.BucketName,
+		Name:       mux.Vars(r)["pin"],
+	})
+	if err != nil {
+		switch {
+		case metabase.ErrInvalidRequest.Has(err):
+			sendJSONError(w, "invalid object pin", err.Error(), http.StatusBadRequest)
+		case metabase.ErrObjectPinNotFound.Has(err):
+			sendJSONError(w, "object pin does not exist", "", http.StatusNotFound)
+		default:
+			sendJSONError(w, "unable to release object pin", err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (server *Server) undeleteObject(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	bucket, ok := server.objectPinBucket(w, r)
+	if !ok {
+		return
+	}
+
+	var input undeleteObjectRequest
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		sendJSONError(w, "failed to read body", err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	result, err := server.objectPins.Undelete(ctx, objectPinActor(r), metabase.ObjectLocation{
+		ProjectID:  bucket.ProjectID,
+		BucketName: bucket.BucketName,
+		ObjectKey:  metabase.ObjectKey(input.EncryptedObjectKey),
+	})
+	if err != nil {
+		switch {
+		case metabase.ErrInvalidRequest.Has(err):
+			sendJSONError(w, "invalid object", err.Error(), http.StatusBadRequest)
+		case metabase.ErrObjectNotFound.Has(err):
+			sendJSONError(w, "object does not exist", "", http.StatusNotFound)
+		case metabase.ErrFailedPrecondition.Has(err):
+			sendJSONError(w, "object is not deleted", err.Error(), http.StatusConflict)
+		case metabase.ErrObjectLock.Has(err):
+			sendJSONError(w, "delete marker is retained", err.Error(), http.StatusConflict)
+		case metabase.ErrConflict.Has(err):
+			sendJSONError(w, "object was concurrently modified", err.Error(), http.StatusConflict)
+		default:
+			sendJSONError(w, "unable to undelete object", err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	response := undeleteObjectResponse{
+		DeleteMarkerVersion: int64(result.DeleteMarker.Version),
+	}
+	if result.Object != nil {
+		restored := int64(result.Object.Version)
+		response.RestoredVersion = &restored
+	}
+
+	data, err := json.Marshal(response)
+	if err != nil {
+		sendJSONError(w, "json encoding failed", err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONData(w, http.StatusOK, data)
+}
+
+func (server *Server) getObjectLockReport(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	projectUUIDString, ok := mux.Vars(r)["project"]
+	if !ok {
+		sendJSONError(w, "project-uuid missing", "", http.StatusBadRequest)
+		return
+	}
+
+	project, err := server.getProjectByAnyID(ctx, projectUUIDString)
+	if errors.Is(err, sql.ErrNoRows) {
+		sendJSONError(w, "project with specified uuid does not exist", "", http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		sendJSONError(w, "error getting project", err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	report, err := server.objectPins.ComplianceReport(ctx, project.ID, r.URL.Query().Get("bucket"))
+	if err != nil {
+		sendJSONError(w, "unable to get object lock report", err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	response := make([]objectLockBucketReport, 0, len(report))
+	for _, bucket := range report {
+		response = append(response, objectLockBucketReport{
+			BucketName:         bucket.BucketName,
+			RetainedObjects:    bucket.RetainedObjects,
+			RetainedBytes:      bucket.RetainedBytes,
+			SoonestRetainUntil: bucket.SoonestRetainUntil,
+			LegalHoldObjects:   bucket.LegalHoldObjects,
+			LegalHoldBytes:     bucket.LegalHoldBytes,
+		})
+	}
+
+	data, err := json.Marshal(response)
+	if err != nil {
+		sendJSONError(w, "json encoding failed", err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONData(w, http.StatusOK, data)
+}
@@ -0,0 +1,227 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/escrow"
+)
+
+type passphraseRecoveryRequest struct {
+	Reason string `json:"reason"`
+}
+
+type passphraseRecoveryApproval struct {
+	ApprovedBy string    `json:"approvedBy"`
+	CreatedAt  time.Time `json:"createdAt"`
+}
+
+type passphraseRecoveryResponse struct {
+	ID          uuid.UUID                    `json:"id"`
+	ProjectID   uuid.UUID                    `json:"projectId"`
+	RequestedBy string                       `json:"requestedBy"`
+	Reason      string                       `json:"reason"`
+	CreatedAt   time.Time                    `json:"createdAt"`
+	Approvals   []passphraseRecoveryApproval `json:"approvals"`
+	RecoveredAt *time.Time                   `json:"recoveredAt,omitempty"`
+}
+
+func toPassphraseRecoveryResponse(recovery escrow.Recovery) passphraseRecoveryResponse {
+	approvals := make([]passphraseRecoveryApproval, 0, len(recovery.Approvals))
+	for _, approval := range recovery.Approvals {
+		approvals = append(approvals, passphraseRecoveryApproval{
+			ApprovedBy: approval.ApprovedBy,
+			CreatedAt:  approval.CreatedAt,
+		})
+	}
+
+	return passphraseRecoveryResponse{
+		ID:          recovery.ID,
+		ProjectID:   recovery.ProjectID,
+		RequestedBy: recovery.RequestedBy,
+		Reason:      recovery.Reason,
+		CreatedAt:   recovery.CreatedAt,
+		Approvals:   approvals,
+		RecoveredAt: recovery.RecoveredAt,
+	}
+}
+
+func (server *Server) listPassphraseRecoveries(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	if !server.passphraseEscrowEnabled(w) {
+		return
+	}
+
+	project, ok := server.limitChangeProject(w, r)
+	if !ok {
+		return
+	}
+
+	recoveries, err := server.passphraseEscrow.List(ctx, project.ID)
+	if err != nil {
+		sendJSONError(w, "failed to list passphrase recoveries", err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	output := make([]passphraseRecoveryResponse, 0, len(recoveries))
+	for _, recovery := range recoveries {
+		output = append(output, toPassphraseRecoveryResponse(recovery))
+	}
+
+	data, err := json.Marshal(output)
+	if err != nil {
+		sendJSONError(w, "json encoding failed", err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONData(w, http.StatusOK, data)
+}
+
+func (server *Server) requestPassphraseRecovery(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	if !server.passphraseEscrowEnabled(w) {
+		return
+	}
+
+	project, ok := server.limitChangeProject(w, r)
+	if !ok {
+		return
+	}
+
+	var input passphraseRecoveryRequest
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		sendJSONError(w, "invalid json", err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	recovery, err := server.passphraseEscrow.Request(ctx, r.Header.Get("X-Forwarded-Email"), project.ID, input.Reason)
+	if err != nil {
+		sendPassphraseRecoveryError(w, "failed to request passphrase recovery", err)
+		return
+	}
+
+	data, err := json.Marshal(toPassphraseRecoveryResponse(recovery))
+	if err != nil {
+		sendJSONError(w, "json encoding failed", err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONData(w, http.StatusOK, data)
+}
+
+func (server *Server) approvePassphraseRecovery(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	if !server.passphraseEscrowEnabled(w) {
+		return
+	}
+
+	id, ok := server.projectPassphraseRecoveryID(w, r)
+	if !ok {
+		return
+	}
+
+	recovery, err := server.passphraseEscrow.Approve(ctx, r.Header.Get("X-Forwarded-Email"), id)
+	if err != nil {
+		sendPassphraseRecoveryError(w, "failed to approve passphrase recovery", err)
+		return
+	}
+
+	data, err := json.Marshal(toPassphraseRecoveryResponse(recovery))
+	if err != nil {
+		sendJSONError(w, "json encoding failed", err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONData(w, http.StatusOK, data)
+}
+
+func (server *Server) recoverPassphrase(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	if !server.passphraseEscrowEnabled(w) {
+		return
+	}
+
+	id, ok := server.projectPassphraseRecoveryID(w, r)
+	if !ok {
+		return
+	}
+
+	passphrase, err := server.passphraseEscrow.Recover(ctx, r.Header.Get("X-Forwarded-Email"), id)
+	if err != nil {
+		sendPassphraseRecoveryError(w, "failed to recover passphrase", err)
+		return
+	}
+
+	data, err := json.Marshal(struct {
+		Passphrase string `json:"passphrase"`
+	}{
+		Passphrase: string(passphrase),
+	})
+	if err != nil {
+		sendJSONError(w, "json encoding failed", err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Cache-Control", "no-store")
+	sendJSONData(w, http.StatusOK, data)
+}
+
+// passphraseEscrowEnabled sends an error response and returns false when
+// passphrase escrow isn't enabled on the satellite.
+func (server *Server) passphraseEscrowEnabled(w http.ResponseWriter) bool {
+	if server.passphraseEscrow == nil {
+		sendJSONError(w, "passphrase escrow is not enabled", "", http.StatusNotFound)
+		return false
+	}
+	return true
+}
+
+// projectPassphraseRecoveryID returns the ID of the passphrase recovery of
+// the request, after checking that it belongs to the project of the request.
+func (server *Server) projectPassphraseRecoveryID(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	project, ok := server.limitChangeProject(w, r)
+	if !ok {
+		return uuid.UUID{}, false
+	}
+
+	id, err := uuid.FromString(mux.Vars(r)["id"])
+	if err != nil {
+		sendJSONError(w, "invalid passphrase recovery id", err.Error(), http.StatusBadRequest)
+		return uuid.UUID{}, false
+	}
+
+	recovery, err := server.passphraseEscrow.Get(r.Context(), id)
+	if err != nil {
+		sendPassphraseRecoveryError(w, "failed to get passphrase recovery", err)
+		return uuid.UUID{}, false
+	}
+	if recovery.ProjectID != project.ID {
+		sendJSONError(w, "passphrase recovery does not exist", "", http.StatusNotFound)
+		return uuid.UUID{}, false
+	}
+	return id, true
+}
+
+func sendPassphraseRecoveryError(w http.ResponseWriter, errMsg string, err error) {
+	switch {
+	case escrow.ErrValidation.Has(err):
+		sendJSONError(w, errMsg, err.Error(), http.StatusBadRequest)
+	case escrow.ErrNotFound.Has(err):
+		sendJSONError(w, errMsg, err.Error(), http.StatusNotFound)
+	case escrow.ErrConflict.Has(err):
+		sendJSONError(w, errMsg, err.Error(), http.StatusConflict)
+	default:
+		sendJSONError(w, errMsg, err.Error(), http.StatusInternalServerError)
+	}
+}
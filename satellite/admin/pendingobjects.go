@@ -0,0 +1,354 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/buckets"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/zombiedeletion"
+)
+
+type gracePeriodRequest struct {
+	GracePeriod string `json:"gracePeriod"`
+}
+
+type gracePeriodResponse struct {
+	ProjectID   uuid.UUID `json:"projectId"`
+	BucketName  string    `json:"bucketName,omitempty"`
+	GracePeriod string    `json:"gracePeriod"`
+	UpdatedAt   time.Time `json:"updatedAt"`
+}
+
+type pendingObjectResponse struct {
+	BucketName             string     `json:"bucketName"`
+	EncryptedObjectKey     []byte     `json:"encryptedObjectKey"`
+	Version                int64      `json:"version"`
+	StreamID               uuid.UUID  `json:"streamId"`
+	CreatedAt              time.Time  `json:"createdAt"`
+	ExpiresAt              *time.Time `json:"expiresAt,omitempty"`
+	ZombieDeletionDeadline *time.Time `json:"zombieDeletionDeadline,omitempty"`
+}
+
+type pendingObjectsResponse struct {
+	Objects []pendingObjectResponse `json:"objects"`
+	More    bool                    `json:"more"`
+}
+
+type pendingObjectPartResponse struct {
+	Number        uint32    `json:"number"`
+	Size          int64     `json:"size"`
+	SegmentCount  int32     `json:"segmentCount"`
+	EncryptedETag []byte    `json:"encryptedETag,omitempty"`
+	LastModified  time.Time `json:"lastModified"`
+}
+
+type pendingObjectPartsResponse struct {
+	Parts []pendingObjectPartResponse `json:"parts"`
+	More  bool                        `json:"more"`
+}
+
+type zombieCleanupResponse struct {
+	ObjectsDeleted  int64 `json:"objectsDeleted"`
+	SegmentsDeleted int64 `json:"segmentsDeleted"`
+	BytesDeleted    int64 `json:"bytesDeleted"`
+}
+
+// gracePeriodLocation returns the project and the optional bucket of a grace period request.
+func (server *Server) gracePeriodLocation(w http.ResponseWriter, r *http.Request) (projectID uuid.UUID, bucketName string, ok bool) {
+	ctx := r.Context()
+	vars := mux.Vars(r)
+
+	if _, hasBucket := vars["bucket"]; !hasBucket {
+		project, err := server.getProjectByAnyID(ctx, vars["project"])
+		if err != nil {
+			sendJSONError(w, "error getting project", err.Error(), http.StatusBadRequest)
+			return uuid.UUID{}, "", false
+		}
+		return project.ID, "", true
+	}
+
+	project, bucket, err := validateBucketPathParameters(vars)
+	if err != nil {
+		sendJSONError(w, err.Error(), "", http.StatusBadRequest)
+		return uuid.UUID{}, "", false
+	}
+
+	_, err = server.buckets.GetBucket(ctx, bucket, project.UUID)
+	if err != nil {
+		if buckets.ErrBucketNotFound.Has(err) {
+			sendJSONError(w, "bucket does not exist", "", http.StatusNotFound)
+		} else {
+			sendJSONError(w, "unable to get bucket", err.Error(), http.StatusInternalServerError)
+		}
+		return uuid.UUID{}, "", false
+	}
+	return project.UUID, string(bucket), true
+}
+
+func (server *Server) getPendingObjectGracePeriod(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	projectID, bucketName, ok := server.gracePeriodLocation(w, r)
+	if !ok {
+		return
+	}
+
+	gracePeriod, err := server.zombieDeletion.GetGracePeriod(ctx, projectID, bucketName)
+	if err != nil {
+		if zombiedeletion.ErrGracePeriodNotFound.Has(err) {
+			sendJSONError(w, "grace period is not overridden", "", http.StatusNotFound)
+			return
+		}
+		sendJSONError(w, "unable to get grace period", err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	data, err := json.Marshal(gracePeriodResponse{
+		ProjectID:   gracePeriod.ProjectID,
+		BucketName:  gracePeriod.BucketName,
+		GracePeriod: gracePeriod.Period.String(),
+		UpdatedAt:   gracePeriod.UpdatedAt,
+	})
+	if err != nil {
+		sendJSONError(w, "json encoding failed", err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONData(w, http.StatusOK, data)
+}
+
+func (server *Server) setPendingObjectGracePeriod(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	projectID, bucketName, ok := server.gracePeriodLocation(w, r)
+	if !ok {
+		return
+	}
+
+	var input gracePeriodRequest
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		sendJSONError(w, "failed to read body", err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	period, err := time.ParseDuration(input.GracePeriod)
+	if err != nil {
+		sendJSONError(w, "invalid grace period", err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	err = server.zombieDeletion.SetGracePeriod(ctx, zombiedeletion.GracePeriod{
+		ProjectID:  projectID,
+		BucketName: bucketName,
+		Period:     period,
+	})
+	if err != nil {
+		if zombiedeletion.ErrInvalidGracePeriod.Has(err) {
+			sendJSONError(w, "invalid grace period", err.Error(), http.StatusBadRequest)
+			return
+		}
+		sendJSONError(w, "unable to set grace period", err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+func (server *Server) deletePendingObjectGracePeriod(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	projectID, bucketName, ok := server.gracePeriodLocation(w, r)
+	if !ok {
+		return
+	}
+
+	err := server.zombieDeletion.DeleteGracePeriod(ctx, projectID, bucketName)
+	if err != nil {
+		if zombiedeletion.ErrGracePeriodNotFound.Has(err) {
+			sendJSONError(w, "grace period is not overridden", "", http.StatusNotFound)
+			return
+		}
+		sendJSONError(w, "unable to delete grace period", err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+func (server *Server) cleanupBucketZombieObjects(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	projectID, bucketName, ok := server.gracePeriodLocation(w, r)
+	if !ok {
+		return
+	}
+
+	result, err := server.zombieDeletion.CleanupBucket(ctx, metabase.BucketLocation{
+		ProjectID:  projectID,
+		BucketName: bucketName,
+	})
+	if err != nil {
+		sendJSONError(w, "unable to delete zombie objects", err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	data, err := json.Marshal(zombieCleanupResponse{
+		ObjectsDeleted:  result.ObjectsDeleted,
+		SegmentsDeleted: result.SegmentsDeleted,
+		BytesDeleted:    result.BytesDeleted,
+	})
+	if err != nil {
+		sendJSONError(w, "json encoding failed", err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONData(w, http.StatusOK, data)
+}
+
+func (server *Server) listPendingObjects(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	project, err := server.getProjectByAnyID(ctx, mux.Vars(r)["project"])
+	if err != nil {
+		sendJSONError(w, "error getting project", err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	query := r.URL.Query()
+
+	var olderThan time.Duration
+	if value := query.Get("older-than"); value != "" {
+		olderThan, err = time.ParseDuration(value)
+		if err != nil || olderThan < 0 {
+			sendJSONError(w, "invalid older-than duration", "", http.StatusBadRequest)
+			return
+		}
+	}
+
+	var limit int
+	if value := query.Get("limit"); value != "" {
+		limit, err = strconv.Atoi(value)
+		if err != nil || limit < 0 {
+			sendJSONError(w, "invalid limit", "", http.StatusBadRequest)
+			return
+		}
+	}
+
+	objects, more, err := server.zombieDeletion.ListPendingObjects(ctx, project.ID, olderThan, limit)
+	if err != nil {
+		sendJSONError(w, "unable to list pending objects", err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	response := pendingObjectsResponse{
+		Objects: make([]pendingObjectResponse, 0, len(objects)),
+		More:    more,
+	}
+	for _, object := range objects {
+		response.Objects = append(response.Objects, pendingObjectResponse{
+			BucketName:             object.BucketName,
+			EncryptedObjectKey:     []byte(object.ObjectKey),
+			Version:                int64(object.Version),
+			StreamID:               object.StreamID,
+			CreatedAt:              object.CreatedAt,
+			ExpiresAt:              object.ExpiresAt,
+			ZombieDeletionDeadline: object.ZombieDeletionDeadline,
+		})
+	}
+
+	data, err := json.Marshal(response)
+	if err != nil {
+		sendJSONError(w, "json encoding failed", err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONData(w, http.StatusOK, data)
+}
+
+func (server *Server) listPendingObjectParts(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	projectID, bucketName, ok := server.gracePeriodLocation(w, r)
+	if !ok {
+		return
+	}
+
+	query := r.URL.Query()
+
+	key, err := base64.StdEncoding.DecodeString(query.Get("key"))
+	if err != nil || len(key) == 0 {
+		sendJSONError(w, "invalid key", "", http.StatusBadRequest)
+		return
+	}
+
+	version, err := strconv.ParseInt(query.Get("version"), 10, 64)
+	if err != nil {
+		sendJSONError(w, "invalid version", "", http.StatusBadRequest)
+		return
+	}
+
+	var cursor uint64
+	if value := query.Get("cursor"); value != "" {
+		cursor, err = strconv.ParseUint(value, 10, 32)
+		if err != nil {
+			sendJSONError(w, "invalid cursor", "", http.StatusBadRequest)
+			return
+		}
+	}
+
+	var limit int
+	if value := query.Get("limit"); value != "" {
+		limit, err = strconv.Atoi(value)
+		if err != nil || limit < 0 {
+			sendJSONError(w, "invalid limit", "", http.StatusBadRequest)
+			return
+		}
+	}
+
+	result, err := server.zombieDeletion.ListParts(ctx, metabase.ObjectLocation{
+		ProjectID:  projectID,
+		BucketName: bucketName,
+		ObjectKey:  metabase.ObjectKey(key),
+	}, metabase.Version(version), uint32(cursor), limit)
+	if err != nil {
+		switch {
+		case metabase.ErrObjectNotFound.Has(err):
+			sendJSONError(w, "object does not exist", "", http.StatusNotFound)
+		case zombiedeletion.ErrObjectNotPending.Has(err):
+			sendJSONError(w, "object is not pending", err.Error(), http.StatusConflict)
+		case metabase.ErrInvalidRequest.Has(err):
+			sendJSONError(w, "invalid request", err.Error(), http.StatusBadRequest)
+		default:
+			sendJSONError(w, "unable to list parts", err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	response := pendingObjectPartsResponse{
+		Parts: make([]pendingObjectPartResponse, 0, len(result.Parts)),
+		More:  result.More,
+	}
+	for _, part := range result.Parts {
+		response.Parts = append(response.Parts, pendingObjectPartResponse{
+			Number:        part.Number,
+			Size:          part.Size,
+			SegmentCount:  part.SegmentCount,
+			EncryptedETag: part.EncryptedETag,
+			LastModified:  part.LastModified,
+		})
+	}
+
+	data, err := json.Marshal(response)
+	if err != nil {
+		sendJSONError(w, "json encoding failed", err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONData(w, http.StatusOK, data)
+}
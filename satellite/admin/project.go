@@ -25,6 +25,7 @@ import (
 	"storj.io/storj/satellite/buckets"
 	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/payments/stripe"
+	"storj.io/storj/satellite/projectdeletion"
 )
 
 func (server *Server) checkProjectUsage(w http.ResponseWriter, r *http.Request) {
@@ -541,6 +542,90 @@ func (server *Server) deleteProject(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+func (server *Server) purgeProjectData(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	vars := mux.Vars(r)
+	projectUUIDString, ok := vars["project"]
+	if !ok {
+		sendJSONError(w, "project-uuid missing",
+			"", http.StatusBadRequest)
+		return
+	}
+
+	project, err := server.getProjectByAnyID(ctx, projectUUIDString)
+	if err != nil {
+		sendJSONError(w, "error getting project",
+			err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	purge, err := server.projectDeletion.Start(ctx, r.Header.Get("X-Forwarded-Email"), project.ID)
+	if err != nil {
+		switch {
+		case projectdeletion.ErrProtectedData.Has(err):
+			sendJSONError(w, "project data cannot be purged",
+				err.Error(), http.StatusConflict)
+		case projectdeletion.ErrAlreadyRunning.Has(err):
+			sendJSONError(w, "project data purge already running",
+				err.Error(), http.StatusConflict)
+		default:
+			sendJSONError(w, "unable to start project data purge",
+				err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	data, err := json.Marshal(purge)
+	if err != nil {
+		sendJSONError(w, "json encoding failed",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONData(w, http.StatusAccepted, data)
+}
+
+func (server *Server) getProjectDataPurge(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	vars := mux.Vars(r)
+	projectUUIDString, ok := vars["project"]
+	if !ok {
+		sendJSONError(w, "project-uuid missing",
+			"", http.StatusBadRequest)
+		return
+	}
+
+	project, err := server.getProjectByAnyID(ctx, projectUUIDString)
+	if err != nil {
+		sendJSONError(w, "error getting project",
+			err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	purge, err := server.projectDeletion.Status(ctx, project.ID)
+	if err != nil {
+		if projectdeletion.ErrNotFound.Has(err) {
+			sendJSONError(w, "project data purge not found",
+				"", http.StatusNotFound)
+			return
+		}
+		sendJSONError(w, "unable to get project data purge",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	data, err := json.Marshal(purge)
+	if err != nil {
+		sendJSONError(w, "json encoding failed",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONData(w, http.StatusOK, data)
+}
+
 func (server *Server) _updateProjectsUserAgent(ctx context.Context, projectID uuid.UUID, newUserAgent []byte) (err error) {
 	err = server.db.Console().Projects().UpdateUserAgent(ctx, projectID, newUserAgent)
 	if err != nil {
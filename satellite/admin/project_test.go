@@ -18,7 +18,9 @@ import (
 
 	"storj.io/common/macaroon"
 	"storj.io/common/memory"
+	"storj.io/common/storj"
 	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
 	"storj.io/common/uuid"
 	"storj.io/storj/private/testplanet"
 	"storj.io/storj/satellite"
@@ -582,6 +584,94 @@ func TestProjectAdd(t *testing.T) {
 	})
 }
 
+func TestProjectClone(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount:   1,
+		StorageNodeCount: 0,
+		UplinkCount:      1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(_ *zap.Logger, _ int, config *satellite.Config) {
+				config.Admin.Address = "127.0.0.1:0"
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		address := sat.Admin.Admin.Listener.Addr()
+		sourceID := planet.Uplinks[0].Projects[0].ID
+		projects := sat.DB.Console().Projects()
+
+		burst, maxBuckets := 50, 7
+		require.NoError(t, projects.UpdateBurstLimit(ctx, sourceID, &burst))
+		require.NoError(t, projects.UpdateBucketLimit(ctx, sourceID, &maxBuckets))
+		require.NoError(t, projects.UpdateDefaultPlacement(ctx, sourceID, storj.EU))
+
+		_, err := sat.DB.Buckets().CreateBucket(ctx, buckets.Bucket{
+			ID:                testrand.UUID(),
+			Name:              "locked",
+			ProjectID:         sourceID,
+			Placement:         storj.EU,
+			Versioning:        buckets.VersioningEnabled,
+			ObjectLockEnabled: true,
+		})
+		require.NoError(t, err)
+
+		_, err = sat.DB.Buckets().CreateBucket(ctx, buckets.Bucket{
+			ID:        testrand.UUID(),
+			Name:      "logs",
+			ProjectID: sourceID,
+		})
+		require.NoError(t, err)
+
+		lifecycle := buckets.LifecycleConfiguration{Rules: []buckets.LifecycleRule{
+			{ID: "logs", Prefix: "logs/", Enabled: true, ExpireCurrentAfterDays: 30},
+		}}
+		require.NoError(t, sat.DB.Buckets().SetBucketLifecycle(ctx, []byte("logs"), sourceID, lifecycle))
+
+		link := "http://" + address.String() + "/api/projects/" + sourceID.String() + "/clone"
+		body := assertReq(ctx, t, link, http.MethodPost, `{"projectName":"Staging"}`, http.StatusOK, "", sat.Config.Console.AuthToken)
+
+		var output struct {
+			ProjectID uuid.UUID `json:"projectId"`
+			Buckets   int       `json:"buckets"`
+		}
+		require.NoError(t, json.Unmarshal(body, &output))
+		require.Equal(t, 2, output.Buckets)
+
+		source, err := projects.Get(ctx, sourceID)
+		require.NoError(t, err)
+		clone, err := projects.Get(ctx, output.ProjectID)
+		require.NoError(t, err)
+		require.Equal(t, "Staging", clone.Name)
+		require.Equal(t, source.OwnerID, clone.OwnerID)
+		require.Equal(t, source.StorageLimit, clone.StorageLimit)
+		require.Equal(t, source.BandwidthLimit, clone.BandwidthLimit)
+		require.Equal(t, source.SegmentLimit, clone.SegmentLimit)
+		require.Equal(t, &burst, clone.BurstLimit)
+		require.Equal(t, &maxBuckets, clone.MaxBuckets)
+		require.Equal(t, storj.EU, clone.DefaultPlacement)
+		require.Equal(t, source.DefaultVersioning, clone.DefaultVersioning)
+
+		locked, err := sat.DB.Buckets().GetBucket(ctx, []byte("locked"), output.ProjectID)
+		require.NoError(t, err)
+		require.Equal(t, storj.EU, locked.Placement)
+		require.Equal(t, buckets.VersioningEnabled, locked.Versioning)
+		require.True(t, locked.ObjectLockEnabled)
+
+		clonedLifecycle, err := sat.DB.Buckets().GetBucketLifecycle(ctx, []byte("logs"), output.ProjectID)
+		require.NoError(t, err)
+		require.NotNil(t, clonedLifecycle)
+		require.Equal(t, lifecycle.Rules, clonedLifecycle.Rules)
+
+		// the clone is a member of the owner's projects.
+		own, err := projects.GetOwn(ctx, source.OwnerID)
+		require.NoError(t, err)
+		require.Len(t, own, 2)
+
+		body = assertReq(ctx, t, link, http.MethodPost, `{}`, http.StatusBadRequest, "", sat.Config.Console.AuthToken)
+		require.Contains(t, string(body), "ProjectName is not set")
+	})
+}
+
 func TestUpdateProjectsUserAgent(t *testing.T) {
 	testplanet.Run(t, testplanet.Config{
 		SatelliteCount:   1,
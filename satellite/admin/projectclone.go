@@ -0,0 +1,207 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"go.uber.org/zap"
+
+	"storj.io/common/macaroon"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/buckets"
+	"storj.io/storj/satellite/console"
+)
+
+// cloneProject creates a new project with the configuration of an existing
+// project. The data and the access grants of the project aren't copied.
+func (server *Server) cloneProject(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	vars := mux.Vars(r)
+	projectUUIDString, ok := vars["project"]
+	if !ok {
+		sendJSONError(w, "project-uuid missing",
+			"", http.StatusBadRequest)
+		return
+	}
+
+	source, err := server.getProjectByAnyID(ctx, projectUUIDString)
+	if errors.Is(err, sql.ErrNoRows) {
+		sendJSONError(w, "project with specified uuid does not exist",
+			"", http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		sendJSONError(w, "error getting project",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		sendJSONError(w, "failed to read body",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	var input struct {
+		ProjectName string `json:"projectName"`
+	}
+
+	var output struct {
+		ProjectID uuid.UUID `json:"projectId"`
+		Buckets   int       `json:"buckets"`
+	}
+
+	err = json.Unmarshal(body, &input)
+	if err != nil {
+		sendJSONError(w, "failed to unmarshal request",
+			err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if input.ProjectName == "" {
+		sendJSONError(w, "ProjectName is not set",
+			"", http.StatusBadRequest)
+		return
+	}
+
+	project, err := server.insertProjectClone(ctx, source, input.ProjectName)
+	if err != nil {
+		sendJSONError(w, "failed to insert project",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+	output.ProjectID = project.ID
+
+	output.Buckets, err = server.cloneBuckets(ctx, source.ID, project.ID)
+	if err != nil {
+		// the clone is left in place, so it can be inspected or deleted.
+		sendJSONError(w, "failed to clone buckets",
+			"project "+project.ID.String()+": "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	server.log.Info("project cloned",
+		zap.Stringer("Source Project ID", source.ID),
+		zap.Stringer("Project ID", project.ID),
+		zap.Int("Buckets", output.Buckets),
+		zap.String("Operator", r.Header.Get("X-Forwarded-Email")),
+	)
+
+	data, err := json.Marshal(output)
+	if err != nil {
+		sendJSONError(w, "json encoding failed",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONData(w, http.StatusOK, data)
+}
+
+// insertProjectClone inserts a project owned by the owner of source with the
+// limits, the default placement and the default versioning of source.
+func (server *Server) insertProjectClone(ctx context.Context, source *console.Project, name string) (_ *console.Project, err error) {
+	projects := server.db.Console().Projects()
+
+	project, err := projects.Insert(ctx, &console.Project{
+		Name:             name,
+		Description:      source.Description,
+		OwnerID:          source.OwnerID,
+		UserAgent:        source.UserAgent,
+		StorageLimit:     source.StorageLimit,
+		BandwidthLimit:   source.BandwidthLimit,
+		SegmentLimit:     source.SegmentLimit,
+		RateLimit:        source.RateLimit,
+		MaxBuckets:       source.MaxBuckets,
+		DefaultPlacement: source.DefaultPlacement,
+		PathEncryption:   source.PathEncryption,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = server.db.Console().ProjectMembers().Insert(ctx, project.OwnerID, project.ID, console.RoleAdmin)
+	if err != nil {
+		return nil, err
+	}
+
+	var storage, bandwidth *int64
+	if source.StorageLimit != nil {
+		limit := source.StorageLimit.Int64()
+		storage = &limit
+	}
+	if source.BandwidthLimit != nil {
+		limit := source.BandwidthLimit.Int64()
+		bandwidth = &limit
+	}
+	// the burst limit isn't set on insert.
+	err = projects.UpdateAllLimits(ctx, project.ID, storage, bandwidth, source.SegmentLimit,
+		source.MaxBuckets, source.RateLimit, source.BurstLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	err = projects.UpdateDefaultVersioning(ctx, project.ID, source.DefaultVersioning)
+	if err != nil {
+		return nil, err
+	}
+
+	return project, nil
+}
+
+// cloneBuckets creates the buckets of the source project in the destination
+// project, with the same settings and lifecycle configurations. The inventory
+// configurations aren't cloned, because they deliver reports into a bucket of
+// the source project.
+func (server *Server) cloneBuckets(ctx context.Context, sourceID, destinationID uuid.UUID) (count int, err error) {
+	opts := buckets.ListOptions{
+		Direction: buckets.DirectionForward,
+		Limit:     100,
+	}
+	for {
+		list, err := server.buckets.ListBuckets(ctx, sourceID, opts, macaroon.AllowedBuckets{All: true})
+		if err != nil {
+			return count, err
+		}
+
+		for _, bucket := range list.Items {
+			lifecycle, err := server.buckets.GetBucketLifecycle(ctx, []byte(bucket.Name), sourceID)
+			if err != nil {
+				return count, err
+			}
+
+			bucket.ID, err = uuid.New()
+			if err != nil {
+				return count, err
+			}
+			bucket.ProjectID = destinationID
+
+			_, err = server.buckets.CreateBucket(ctx, bucket)
+			if err != nil {
+				return count, err
+			}
+
+			if lifecycle != nil {
+				err = server.buckets.SetBucketLifecycle(ctx, []byte(bucket.Name), destinationID, *lifecycle)
+				if err != nil {
+					return count, err
+				}
+			}
+			count++
+		}
+
+		if !list.More || len(list.Items) == 0 {
+			return count, nil
+		}
+		opts = opts.NextPage(list)
+	}
+}
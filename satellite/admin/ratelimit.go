@@ -0,0 +1,251 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin
+
+import (
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"golang.org/x/time/rate"
+
+	"storj.io/storj/private/web"
+)
+
+// RateLimitConfig configures the rate limiting of the admin API and the
+// lockout of the clients which repeatedly fail to authenticate.
+type RateLimitConfig struct {
+	Interval        time.Duration `help:"the average interval between the requests of a single IP address or admin, 0 to disable rate limiting" default:"100ms" testDefault:"0"`
+	IPBurst         int           `help:"number of requests of a single IP address allowed at once" default:"50"`
+	AdminBurst      int           `help:"number of requests of a single admin allowed at once" default:"50"`
+	NumLimits       int           `help:"number of IP addresses and admins whose rate limits and failed authentications are stored" default:"1000"`
+	MaxAuthFailures int           `help:"number of consecutive failed authentications after which an IP address is locked out, 0 to disable the lockout" default:"10" testDefault:"0"`
+	LockoutDuration time.Duration `help:"how long an IP address is locked out after repeated failed authentications" default:"15m"`
+}
+
+// withRateLimit limits the requests per IP address and per admin, and locks
+// out IP addresses after config.MaxAuthFailures consecutive responses with
+// 401 Unauthorized or 403 Forbidden.
+func (server *Server) withRateLimit(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ip, err := web.GetRequestIP(r)
+		if err != nil {
+			sendJSONError(w, "unable to get the client address", err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		now := server.nowFn()
+		if until, locked := server.lockout.lockedUntil(ip, now); locked {
+			w.Header().Set("Retry-After", strconv.Itoa(int(until.Sub(now).Seconds())+1))
+			sendJSONError(w, "Too Many Requests",
+				"too many failed authentications, try again later", http.StatusTooManyRequests)
+			return
+		}
+
+		if !server.ipLimiter.allow(ip, now) {
+			sendJSONError(w, "Too Many Requests",
+				"request limit of the IP address exceeded", http.StatusTooManyRequests)
+			return
+		}
+
+		if email := r.Header.Get("X-Forwarded-Email"); email != "" && !server.adminLimiter.allow(email, now) {
+			sendJSONError(w, "Too Many Requests",
+				"request limit of the admin exceeded", http.StatusTooManyRequests)
+			return
+		}
+
+		recorder := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(recorder, r)
+
+		switch {
+		case recorder.status == http.StatusUnauthorized || recorder.status == http.StatusForbidden:
+			if server.lockout.fail(ip, now) {
+				server.log.Warn("locked out IP address after repeated failed authentications",
+					zap.String("ip", ip),
+					zap.String("user", r.Header.Get("X-Forwarded-Email")),
+					zap.Duration("duration", server.config.RateLimit.LockoutDuration))
+			}
+		case recorder.status < http.StatusBadRequest:
+			server.lockout.succeed(ip)
+		}
+	})
+}
+
+// rateLimiter limits the requests per key with a token bucket per key.
+type rateLimiter struct {
+	limit    rate.Limit
+	burst    int
+	maxKeys  int
+	refilled time.Duration
+
+	mu       sync.Mutex
+	limiters map[string]*keyLimiter
+}
+
+type keyLimiter struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+// newRateLimiter creates a rate limiter which allows a request per interval
+// on average and burst requests at once. An interval of 0 disables it.
+func newRateLimiter(interval time.Duration, burst, maxKeys int) *rateLimiter {
+	limit := rate.Inf
+	if interval > 0 {
+		limit = rate.Every(interval)
+	}
+	return &rateLimiter{
+		limit:    limit,
+		burst:    burst,
+		maxKeys:  maxKeys,
+		refilled: time.Duration(burst) * interval,
+		limiters: make(map[string]*keyLimiter),
+	}
+}
+
+// allow reports whether a request of the key may happen at now.
+func (limiter *rateLimiter) allow(key string, now time.Time) bool {
+	if limiter.limit == rate.Inf {
+		return true
+	}
+
+	limiter.mu.Lock()
+	defer limiter.mu.Unlock()
+
+	entry, ok := limiter.limiters[key]
+	if !ok {
+		if len(limiter.limiters) >= limiter.maxKeys {
+			limiter.evict(now)
+		}
+		entry = &keyLimiter{limiter: rate.NewLimiter(limiter.limit, limiter.burst)}
+		limiter.limiters[key] = entry
+	}
+	entry.lastSeen = now
+	return entry.limiter.AllowN(now, 1)
+}
+
+// evict removes the limiters whose buckets are full again, which behave the
+// same as new ones, or the least recently used limiter when there are none.
+func (limiter *rateLimiter) evict(now time.Time) {
+	var oldestKey string
+	var oldest time.Time
+	for key, entry := range limiter.limiters {
+		if now.Sub(entry.lastSeen) >= limiter.refilled {
+			delete(limiter.limiters, key)
+			continue
+		}
+		if oldestKey == "" || entry.lastSeen.Before(oldest) {
+			oldestKey, oldest = key, entry.lastSeen
+		}
+	}
+	if len(limiter.limiters) >= limiter.maxKeys {
+		delete(limiter.limiters, oldestKey)
+	}
+}
+
+// lockout locks out the keys after consecutive failures.
+type lockout struct {
+	maxFailures int
+	duration    time.Duration
+	maxKeys     int
+
+	mu      sync.Mutex
+	entries map[string]*lockoutEntry
+}
+
+type lockoutEntry struct {
+	failures    int
+	lockedUntil time.Time
+	lastSeen    time.Time
+}
+
+// newLockout creates a lockout after maxFailures consecutive failures. A
+// maxFailures of 0 disables it.
+func newLockout(maxFailures int, duration time.Duration, maxKeys int) *lockout {
+	return &lockout{
+		maxFailures: maxFailures,
+		duration:    duration,
+		maxKeys:     maxKeys,
+		entries:     make(map[string]*lockoutEntry),
+	}
+}
+
+// lockedUntil returns until when the key is locked out, if it's locked out at now.
+func (lockout *lockout) lockedUntil(key string, now time.Time) (time.Time, bool) {
+	if lockout.maxFailures <= 0 {
+		return time.Time{}, false
+	}
+
+	lockout.mu.Lock()
+	defer lockout.mu.Unlock()
+
+	entry, ok := lockout.entries[key]
+	if !ok || !now.Before(entry.lockedUntil) {
+		return time.Time{}, false
+	}
+	return entry.lockedUntil, true
+}
+
+// fail records a failure of the key and returns whether it locked the key out.
+func (lockout *lockout) fail(key string, now time.Time) bool {
+	if lockout.maxFailures <= 0 {
+		return false
+	}
+
+	lockout.mu.Lock()
+	defer lockout.mu.Unlock()
+
+	entry, ok := lockout.entries[key]
+	if !ok {
+		if len(lockout.entries) >= lockout.maxKeys {
+			lockout.evict(now)
+		}
+		entry = &lockoutEntry{}
+		lockout.entries[key] = entry
+	}
+	entry.lastSeen = now
+	entry.failures++
+	if entry.failures < lockout.maxFailures {
+		return false
+	}
+
+	entry.failures = 0
+	entry.lockedUntil = now.Add(lockout.duration)
+	return true
+}
+
+// succeed resets the consecutive failures of the key.
+func (lockout *lockout) succeed(key string) {
+	if lockout.maxFailures <= 0 {
+		return
+	}
+
+	lockout.mu.Lock()
+	defer lockout.mu.Unlock()
+
+	if entry, ok := lockout.entries[key]; ok && entry.failures > 0 {
+		entry.failures = 0
+	}
+}
+
+// evict removes the entries which aren't locked out, or the least recently
+// failed entry when all of them are.
+func (lockout *lockout) evict(now time.Time) {
+	var oldestKey string
+	var oldest time.Time
+	for key, entry := range lockout.entries {
+		if !now.Before(entry.lockedUntil) {
+			delete(lockout.entries, key)
+			continue
+		}
+		if oldestKey == "" || entry.lastSeen.Before(oldest) {
+			oldestKey, oldest = key, entry.lastSeen
+		}
+	}
+	if len(lockout.entries) >= lockout.maxKeys {
+		delete(lockout.entries, oldestKey)
+	}
+}
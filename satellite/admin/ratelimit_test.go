@@ -0,0 +1,110 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestRateLimit(t *testing.T) {
+	config := RateLimitConfig{
+		Interval:        time.Second,
+		IPBurst:         3,
+		AdminBurst:      2,
+		NumLimits:       10,
+		MaxAuthFailures: 2,
+		LockoutDuration: time.Minute,
+	}
+
+	now := time.Now()
+	server := &Server{
+		log:          zaptest.NewLogger(t),
+		ipLimiter:    newRateLimiter(config.Interval, config.IPBurst, config.NumLimits),
+		adminLimiter: newRateLimiter(config.Interval, config.AdminBurst, config.NumLimits),
+		lockout:      newLockout(config.MaxAuthFailures, config.LockoutDuration, config.NumLimits),
+		nowFn:        func() time.Time { return now },
+		config:       Config{RateLimit: config},
+	}
+
+	status := http.StatusOK
+	handler := server.withRateLimit(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(status)
+	}))
+
+	request := func(ip, email string) int {
+		r := httptest.NewRequest(http.MethodGet, "/api/users/user@mail.test", nil)
+		r.RemoteAddr = ip + ":1234"
+		if email != "" {
+			r.Header.Set("X-Forwarded-Email", email)
+		}
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+		return w.Code
+	}
+
+	t.Run("ip", func(t *testing.T) {
+		for i := 0; i < config.IPBurst; i++ {
+			require.Equal(t, http.StatusOK, request("10.0.0.1", ""))
+		}
+		require.Equal(t, http.StatusTooManyRequests, request("10.0.0.1", ""))
+		require.Equal(t, http.StatusOK, request("10.0.0.2", ""))
+
+		now = now.Add(config.Interval)
+		require.Equal(t, http.StatusOK, request("10.0.0.1", ""))
+	})
+
+	t.Run("admin", func(t *testing.T) {
+		require.Equal(t, http.StatusOK, request("10.0.1.1", "admin@storj.test"))
+		require.Equal(t, http.StatusOK, request("10.0.1.2", "admin@storj.test"))
+		require.Equal(t, http.StatusTooManyRequests, request("10.0.1.3", "admin@storj.test"))
+		require.Equal(t, http.StatusOK, request("10.0.1.3", "other@storj.test"))
+	})
+
+	t.Run("lockout", func(t *testing.T) {
+		now = now.Add(time.Hour)
+
+		// a success resets the consecutive failures.
+		status = http.StatusForbidden
+		require.Equal(t, http.StatusForbidden, request("10.0.2.1", ""))
+		status = http.StatusOK
+		require.Equal(t, http.StatusOK, request("10.0.2.1", ""))
+
+		now = now.Add(time.Hour)
+		status = http.StatusUnauthorized
+		require.Equal(t, http.StatusUnauthorized, request("10.0.2.1", ""))
+		require.Equal(t, http.StatusUnauthorized, request("10.0.2.1", ""))
+
+		status = http.StatusOK
+		require.Equal(t, http.StatusTooManyRequests, request("10.0.2.1", ""))
+		require.Equal(t, http.StatusOK, request("10.0.2.2", ""))
+
+		now = now.Add(config.LockoutDuration)
+		require.Equal(t, http.StatusOK, request("10.0.2.1", ""))
+	})
+}
+
+func TestRateLimiterEviction(t *testing.T) {
+	now := time.Now()
+	limiter := newRateLimiter(time.Second, 1, 2)
+
+	require.True(t, limiter.allow("a", now))
+	require.True(t, limiter.allow("b", now.Add(time.Millisecond)))
+	require.False(t, limiter.allow("a", now.Add(2*time.Millisecond)))
+
+	// the least recently used limiter is evicted, when none is full again.
+	require.True(t, limiter.allow("c", now.Add(3*time.Millisecond)))
+	require.Len(t, limiter.limiters, 2)
+	require.NotContains(t, limiter.limiters, "b")
+
+	// limiters which are full again are evicted first.
+	now = now.Add(time.Minute)
+	require.True(t, limiter.allow("d", now))
+	require.Len(t, limiter.limiters, 1)
+}
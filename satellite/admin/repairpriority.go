@@ -0,0 +1,153 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"storj.io/storj/satellite/buckets"
+	"storj.io/storj/satellite/repair/prioritize"
+)
+
+func (server *Server) startRepairPriority(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	vars := mux.Vars(r)
+	projectUUIDString, ok := vars["project"]
+	if !ok {
+		sendJSONError(w, "project-uuid missing",
+			"", http.StatusBadRequest)
+		return
+	}
+
+	project, err := server.getProjectByAnyID(ctx, projectUUIDString)
+	if err != nil {
+		sendJSONError(w, "error getting project",
+			err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	bucketName := r.URL.Query().Get("bucket")
+	if bucketName != "" {
+		_, err := server.buckets.GetBucket(ctx, []byte(bucketName), project.ID)
+		if err != nil {
+			if buckets.ErrBucketNotFound.Has(err) {
+				sendJSONError(w, "bucket does not exist",
+					"", http.StatusNotFound)
+				return
+			}
+			sendJSONError(w, "unable to get bucket",
+				err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}
+
+	job, err := server.repairPriority.Start(ctx, r.Header.Get("X-Forwarded-Email"), project.ID, bucketName)
+	if err != nil {
+		if prioritize.ErrAlreadyRunning.Has(err) {
+			sendJSONError(w, "repair prioritization already running",
+				err.Error(), http.StatusConflict)
+			return
+		}
+		sendJSONError(w, "unable to start repair prioritization",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	data, err := json.Marshal(job)
+	if err != nil {
+		sendJSONError(w, "json encoding failed",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONData(w, http.StatusAccepted, data)
+}
+
+func (server *Server) getRepairPriority(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	vars := mux.Vars(r)
+	projectUUIDString, ok := vars["project"]
+	if !ok {
+		sendJSONError(w, "project-uuid missing",
+			"", http.StatusBadRequest)
+		return
+	}
+
+	project, err := server.getProjectByAnyID(ctx, projectUUIDString)
+	if err != nil {
+		sendJSONError(w, "error getting project",
+			err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	job, err := server.repairPriority.Status(ctx, project.ID)
+	if err != nil {
+		if prioritize.ErrNotFound.Has(err) {
+			sendJSONError(w, "repair prioritization not found",
+				"", http.StatusNotFound)
+			return
+		}
+		sendJSONError(w, "unable to get repair prioritization",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	data, err := json.Marshal(job)
+	if err != nil {
+		sendJSONError(w, "json encoding failed",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONData(w, http.StatusOK, data)
+}
+
+func (server *Server) abortRepairPriority(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	vars := mux.Vars(r)
+	projectUUIDString, ok := vars["project"]
+	if !ok {
+		sendJSONError(w, "project-uuid missing",
+			"", http.StatusBadRequest)
+		return
+	}
+
+	project, err := server.getProjectByAnyID(ctx, projectUUIDString)
+	if err != nil {
+		sendJSONError(w, "error getting project",
+			err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	job, err := server.repairPriority.Abort(ctx, r.Header.Get("X-Forwarded-Email"), project.ID)
+	if err != nil {
+		switch {
+		case prioritize.ErrNotFound.Has(err):
+			sendJSONError(w, "repair prioritization not found",
+				"", http.StatusNotFound)
+		case prioritize.ErrNotRunning.Has(err):
+			sendJSONError(w, "repair prioritization not running",
+				err.Error(), http.StatusConflict)
+		default:
+			sendJSONError(w, "unable to abort repair prioritization",
+				err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	data, err := json.Marshal(job)
+	if err != nil {
+		sendJSONError(w, "json encoding failed",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONData(w, http.StatusOK, data)
+}
@@ -23,15 +23,26 @@ import (
 	"storj.io/storj/private/emptyfs"
 	"storj.io/storj/satellite/accounting"
 	backoffice "storj.io/storj/satellite/admin/back-office"
+	"storj.io/storj/satellite/adminaudit"
+	"storj.io/storj/satellite/adminwebhook"
 	"storj.io/storj/satellite/analytics"
 	"storj.io/storj/satellite/attribution"
 	"storj.io/storj/satellite/buckets"
 	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/console/consoleauth"
 	"storj.io/storj/satellite/console/consoleweb"
 	"storj.io/storj/satellite/console/restkeys"
+	"storj.io/storj/satellite/escrow"
+	"storj.io/storj/satellite/incident"
+	"storj.io/storj/satellite/limitschedule"
+	"storj.io/storj/satellite/maintenance"
+	"storj.io/storj/satellite/metabase/objectpins"
+	"storj.io/storj/satellite/metabase/zombiedeletion"
 	"storj.io/storj/satellite/oidc"
 	"storj.io/storj/satellite/payments"
 	"storj.io/storj/satellite/payments/stripe"
+	"storj.io/storj/satellite/projectdeletion"
+	"storj.io/storj/satellite/repair/prioritize"
 )
 
 // Assets contains either the built admin/back-office/ui or it is nil.
@@ -56,6 +67,12 @@ type Config struct {
 
 	AuthorizationToken string `internal:"true"`
 	BackOffice         backoffice.Config
+	ProjectDeletion    projectdeletion.Config
+	RepairPriority     prioritize.Config
+	Webhooks           adminwebhook.Config
+	RateLimit          RateLimitConfig
+
+	ImpersonationDuration time.Duration `help:"how long the read-only console sessions issued to admins for impersonating users last" default:"15m"`
 }
 
 // Groups defines permission groups.
@@ -92,9 +109,27 @@ type Server struct {
 	restKeys       *restkeys.Service
 	analytics      *analytics.Service
 	freezeAccounts *console.AccountFreezeService
+	maintenance    *maintenance.Service
+
+	projectDeletion  *projectdeletion.Service
+	repairPriority   *prioritize.Service
+	zombieDeletion   *zombiedeletion.Service
+	objectPins       *objectpins.Service
+	limitSchedule    *limitschedule.Service
+	passphraseEscrow *escrow.Service
+	auditEvents      *adminaudit.Service
+	webhooks         *adminwebhook.Service
+	incident         *incident.Collector
+
+	ipLimiter    *rateLimiter
+	adminLimiter *rateLimiter
+	lockout      *lockout
 
 	nowFn func() time.Time
 
+	// consoleTokens signs the tokens of the console sessions issued to admins.
+	consoleTokens *consoleauth.Service
+
 	console consoleweb.Config
 	config  Config
 }
@@ -110,6 +145,16 @@ func NewServer(
 	analyticsService *analytics.Service,
 	accounts payments.Accounts,
 	backOfficeService *backoffice.Service,
+	maintenanceService *maintenance.Service,
+	projectDeletion *projectdeletion.Service,
+	repairPriority *prioritize.Service,
+	zombieDeletion *zombiedeletion.Service,
+	objectPins *objectpins.Service,
+	limitSchedule *limitschedule.Service,
+	passphraseEscrow *escrow.Service,
+	auditEvents *adminaudit.Service,
+	webhooks *adminwebhook.Service,
+	incident *incident.Collector,
 	console consoleweb.Config,
 	config Config,
 ) *Server {
@@ -124,14 +169,32 @@ func NewServer(
 		restKeys:       restKeys,
 		analytics:      analyticsService,
 		freezeAccounts: freezeAccounts,
+		maintenance:    maintenanceService,
+
+		projectDeletion:  projectDeletion,
+		repairPriority:   repairPriority,
+		zombieDeletion:   zombieDeletion,
+		objectPins:       objectPins,
+		limitSchedule:    limitSchedule,
+		passphraseEscrow: passphraseEscrow,
+		auditEvents:      auditEvents,
+		webhooks:         webhooks,
+		incident:         incident,
+
+		ipLimiter:    newRateLimiter(config.RateLimit.Interval, config.RateLimit.IPBurst, config.RateLimit.NumLimits),
+		adminLimiter: newRateLimiter(config.RateLimit.Interval, config.RateLimit.AdminBurst, config.RateLimit.NumLimits),
+		lockout:      newLockout(config.RateLimit.MaxAuthFailures, config.RateLimit.LockoutDuration, config.RateLimit.NumLimits),
 
 		nowFn: time.Now,
 
+		consoleTokens: consoleauth.NewService(consoleauth.Config{}, &consoleauth.Hmac{Secret: []byte(console.AuthTokenSecret)}),
+
 		console: console,
 		config:  config,
 	}
 
 	root := mux.NewRouter()
+	root.Use(server.withRateLimit)
 
 	api := root.PathPrefix("/api/").Subrouter()
 
@@ -139,7 +202,7 @@ func NewServer(
 
 	// prod owners only
 	fullAccessAPI := api.NewRoute().Subrouter()
-	fullAccessAPI.Use(server.withAuth([]string{config.Groups.LimitUpdate}, true))
+	fullAccessAPI.Use(server.withAuth([]string{config.Groups.LimitUpdate}, true), server.withAuditEvents)
 	fullAccessAPI.HandleFunc("/users", server.addUser).Methods("POST")
 	fullAccessAPI.HandleFunc("/users/{useremail}", server.updateUser).Methods("PUT")
 	fullAccessAPI.HandleFunc("/users/{useremail}", server.deleteUser).Methods("DELETE")
@@ -150,6 +213,7 @@ func NewServer(
 	fullAccessAPI.HandleFunc("/users/{useremail}/geofence", server.createGeofenceForAccount).Methods("PATCH")
 	fullAccessAPI.HandleFunc("/users/{useremail}/geofence", server.deleteGeofenceForAccount).Methods("DELETE")
 	fullAccessAPI.HandleFunc("/users/{useremail}/trial-expiration", server.updateFreeTrialExpiration).Methods("PATCH")
+	fullAccessAPI.HandleFunc("/users/{useremail}/impersonate", server.impersonateUser).Methods("POST")
 	fullAccessAPI.HandleFunc("/oauth/clients", server.createOAuthClient).Methods("POST")
 	fullAccessAPI.HandleFunc("/oauth/clients/{id}", server.updateOAuthClient).Methods("PUT")
 	fullAccessAPI.HandleFunc("/oauth/clients/{id}", server.deleteOAuthClient).Methods("DELETE")
@@ -157,24 +221,58 @@ func NewServer(
 	fullAccessAPI.HandleFunc("/projects/{project}", server.renameProject).Methods("PUT")
 	fullAccessAPI.HandleFunc("/projects/{project}", server.deleteProject).Methods("DELETE")
 	fullAccessAPI.HandleFunc("/projects/{project}", server.getProject).Methods("GET")
+	fullAccessAPI.HandleFunc("/projects/{project}/clone", server.cloneProject).Methods("POST")
 	fullAccessAPI.HandleFunc("/projects/{project}/apikeys", server.addAPIKey).Methods("POST")
 	fullAccessAPI.HandleFunc("/projects/{project}/apikeys", server.listAPIKeys).Methods("GET")
 	fullAccessAPI.HandleFunc("/projects/{project}/apikeys", server.deleteAPIKeyByName).Methods("DELETE").Queries("name", "")
+	fullAccessAPI.HandleFunc("/projects/{project}/data", server.purgeProjectData).Methods("DELETE")
+	fullAccessAPI.HandleFunc("/projects/{project}/data", server.getProjectDataPurge).Methods("GET")
+	fullAccessAPI.HandleFunc("/projects/{project}/repair-priority", server.startRepairPriority).Methods("POST")
+	fullAccessAPI.HandleFunc("/projects/{project}/repair-priority", server.getRepairPriority).Methods("GET")
+	fullAccessAPI.HandleFunc("/projects/{project}/repair-priority", server.abortRepairPriority).Methods("DELETE")
 	fullAccessAPI.HandleFunc("/projects/{project}/buckets/{bucket}", server.getBucketInfo).Methods("GET")
+	fullAccessAPI.HandleFunc("/projects/{project}/buckets/{bucket}/validate", server.validateBucketSettings).Methods("GET")
 	fullAccessAPI.HandleFunc("/projects/{project}/buckets/{bucket}/geofence", server.createGeofenceForBucket).Methods("POST")
 	fullAccessAPI.HandleFunc("/projects/{project}/buckets/{bucket}/geofence", server.deleteGeofenceForBucket).Methods("DELETE")
+	fullAccessAPI.HandleFunc("/projects/{project}/buckets/{bucket}/pending-object-grace-period", server.getPendingObjectGracePeriod).Methods("GET")
+	fullAccessAPI.HandleFunc("/projects/{project}/buckets/{bucket}/pending-object-grace-period", server.setPendingObjectGracePeriod).Methods("PUT")
+	fullAccessAPI.HandleFunc("/projects/{project}/buckets/{bucket}/pending-object-grace-period", server.deletePendingObjectGracePeriod).Methods("DELETE")
+	fullAccessAPI.HandleFunc("/projects/{project}/buckets/{bucket}/zombie-objects", server.cleanupBucketZombieObjects).Methods("DELETE")
+	fullAccessAPI.HandleFunc("/projects/{project}/buckets/{bucket}/pending-objects/parts", server.listPendingObjectParts).Methods("GET")
+	fullAccessAPI.HandleFunc("/projects/{project}/buckets/{bucket}/pins", server.listObjectPins).Methods("GET")
+	fullAccessAPI.HandleFunc("/projects/{project}/buckets/{bucket}/pins", server.createObjectPin).Methods("POST")
+	fullAccessAPI.HandleFunc("/projects/{project}/buckets/{bucket}/pins/{pin}", server.getObjectPin).Methods("GET")
+	fullAccessAPI.HandleFunc("/projects/{project}/buckets/{bucket}/pins/{pin}", server.releaseObjectPin).Methods("DELETE")
+	fullAccessAPI.HandleFunc("/projects/{project}/buckets/{bucket}/undelete", server.undeleteObject).Methods("POST")
+	fullAccessAPI.HandleFunc("/projects/{project}/object-lock-report", server.getObjectLockReport).Methods("GET")
 	fullAccessAPI.HandleFunc("/projects/{project}/usage", server.checkProjectUsage).Methods("GET")
+	fullAccessAPI.HandleFunc("/projects/{project}/pending-object-grace-period", server.getPendingObjectGracePeriod).Methods("GET")
+	fullAccessAPI.HandleFunc("/projects/{project}/pending-object-grace-period", server.setPendingObjectGracePeriod).Methods("PUT")
+	fullAccessAPI.HandleFunc("/projects/{project}/pending-object-grace-period", server.deletePendingObjectGracePeriod).Methods("DELETE")
+	fullAccessAPI.HandleFunc("/projects/{project}/pending-objects", server.listPendingObjects).Methods("GET")
 	fullAccessAPI.HandleFunc("/projects/{project}/useragent", server.updateProjectsUserAgent).Methods("PATCH")
 	fullAccessAPI.HandleFunc("/projects/{project}/geofence", server.createGeofenceForProject).Methods("POST")
 	fullAccessAPI.HandleFunc("/projects/{project}/geofence", server.deleteGeofenceForProject).Methods("DELETE")
+	fullAccessAPI.HandleFunc("/projects/{project}/passphrase-recoveries", server.listPassphraseRecoveries).Methods("GET")
+	fullAccessAPI.HandleFunc("/projects/{project}/passphrase-recoveries", server.requestPassphraseRecovery).Methods("POST")
+	fullAccessAPI.HandleFunc("/projects/{project}/passphrase-recoveries/{id}/approve", server.approvePassphraseRecovery).Methods("POST")
+	fullAccessAPI.HandleFunc("/projects/{project}/passphrase-recoveries/{id}/recover", server.recoverPassphrase).Methods("POST")
 	fullAccessAPI.HandleFunc("/apikeys/{apikey}", server.getAPIKey).Methods("GET")
 	fullAccessAPI.HandleFunc("/apikeys/{apikey}", server.deleteAPIKey).Methods("DELETE")
 	fullAccessAPI.HandleFunc("/restkeys/{useremail}", server.addRESTKey).Methods("POST")
 	fullAccessAPI.HandleFunc("/restkeys/{apikey}/revoke", server.revokeRESTKey).Methods("PUT")
+	fullAccessAPI.HandleFunc("/maintenance-windows", server.listMaintenanceWindows).Methods("GET")
+	fullAccessAPI.HandleFunc("/maintenance-windows", server.createMaintenanceWindow).Methods("POST")
+	fullAccessAPI.HandleFunc("/maintenance-windows/{id}", server.getMaintenanceWindow).Methods("GET")
+	fullAccessAPI.HandleFunc("/maintenance-windows/{id}", server.updateMaintenanceWindow).Methods("PUT")
+	fullAccessAPI.HandleFunc("/maintenance-windows/{id}", server.deleteMaintenanceWindow).Methods("DELETE")
+	fullAccessAPI.HandleFunc("/incident-bundle", server.getIncidentBundle).Methods("GET")
+	fullAccessAPI.HandleFunc("/audit-events", server.searchAuditEvents).Methods("GET")
+	fullAccessAPI.HandleFunc("/webhook-deliveries", server.listWebhookDeliveries).Methods("GET")
 
 	// limit update access required
 	limitUpdateAPI := api.NewRoute().Subrouter()
-	limitUpdateAPI.Use(server.withAuth([]string{config.Groups.LimitUpdate}, false))
+	limitUpdateAPI.Use(server.withAuth([]string{config.Groups.LimitUpdate}, false), server.withAuditEvents)
 	limitUpdateAPI.HandleFunc("/users/{useremail}", server.userInfo).Methods("GET")
 	limitUpdateAPI.HandleFunc("/users/{useremail}/limits", server.userLimits).Methods("GET")
 	limitUpdateAPI.HandleFunc("/users/{useremail}/limits", server.updateLimits).Methods("PUT")
@@ -190,6 +288,10 @@ func NewServer(
 	limitUpdateAPI.HandleFunc("/users/pending-deletion", server.usersPendingDeletion).Methods("GET")
 	limitUpdateAPI.HandleFunc("/projects/{project}/limit", server.getProjectLimit).Methods("GET")
 	limitUpdateAPI.HandleFunc("/projects/{project}/limit", server.putProjectLimit).Methods("PUT")
+	limitUpdateAPI.HandleFunc("/projects/{project}/limit-changes", server.listLimitChanges).Methods("GET")
+	limitUpdateAPI.HandleFunc("/projects/{project}/limit-changes", server.scheduleLimitChange).Methods("POST")
+	limitUpdateAPI.HandleFunc("/projects/{project}/limit-changes/{id}", server.editLimitChange).Methods("PUT")
+	limitUpdateAPI.HandleFunc("/projects/{project}/limit-changes/{id}", server.cancelLimitChange).Methods("DELETE")
 
 	// NewServer adds the backoffice.PahtPrefix for the static assets, but not for the API because the
 	// generator already add the PathPrefix to router when the API handlers are hooked.
@@ -0,0 +1,107 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/adminwebhook"
+)
+
+// webhookKinds maps the actions recorded by withAuditEvents to the kinds of
+// webhooks they're delivered as.
+var webhookKinds = map[string]string{
+	"PUT /api/users/{useremail}/billing-freeze":             adminwebhook.KindAccountFreeze,
+	"DELETE /api/users/{useremail}/billing-freeze":          adminwebhook.KindAccountFreeze,
+	"DELETE /api/users/{useremail}/billing-warning":         adminwebhook.KindAccountFreeze,
+	"PUT /api/users/{useremail}/violation-freeze":           adminwebhook.KindAccountFreeze,
+	"DELETE /api/users/{useremail}/violation-freeze":        adminwebhook.KindAccountFreeze,
+	"PUT /api/users/{useremail}/legal-freeze":               adminwebhook.KindAccountFreeze,
+	"DELETE /api/users/{useremail}/legal-freeze":            adminwebhook.KindAccountFreeze,
+	"PUT /api/users/{useremail}/trial-expiration-freeze":    adminwebhook.KindAccountFreeze,
+	"DELETE /api/users/{useremail}/trial-expiration-freeze": adminwebhook.KindAccountFreeze,
+
+	"PUT /api/users/{useremail}/limits":                 adminwebhook.KindLimitChange,
+	"PUT /api/projects/{project}/limit":                 adminwebhook.KindLimitChange,
+	"POST /api/projects/{project}/limit-changes":        adminwebhook.KindLimitChange,
+	"PUT /api/projects/{project}/limit-changes/{id}":    adminwebhook.KindLimitChange,
+	"DELETE /api/projects/{project}/limit-changes/{id}": adminwebhook.KindLimitChange,
+}
+
+type webhookDeliveryResponse struct {
+	ID            uuid.UUID           `json:"id"`
+	EventID       uuid.UUID           `json:"eventId"`
+	URL           string              `json:"url"`
+	Kind          string              `json:"kind"`
+	Status        adminwebhook.Status `json:"status"`
+	Attempts      int                 `json:"attempts"`
+	LastError     string              `json:"lastError,omitempty"`
+	NextAttemptAt time.Time           `json:"nextAttemptAt"`
+	CreatedAt     time.Time           `json:"createdAt"`
+	FinishedAt    *time.Time          `json:"finishedAt,omitempty"`
+}
+
+func (server *Server) listWebhookDeliveries(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	query := r.URL.Query()
+
+	status := adminwebhook.Status(query.Get("status"))
+	switch status {
+	case "":
+		status = adminwebhook.StatusFailed
+	case adminwebhook.StatusPending, adminwebhook.StatusDelivered, adminwebhook.StatusFailed:
+	default:
+		sendJSONError(w, "invalid status",
+			"status must be pending, delivered or failed", http.StatusBadRequest)
+		return
+	}
+
+	limit := 100
+	if limitParam := query.Get("limit"); limitParam != "" {
+		var err error
+		limit, err = strconv.Atoi(limitParam)
+		if err != nil || limit <= 0 || limit > 1000 {
+			sendJSONError(w, "invalid limit",
+				"limit must be between 1 and 1000", http.StatusBadRequest)
+			return
+		}
+	}
+
+	deliveries, err := server.webhooks.List(ctx, status, limit)
+	if err != nil {
+		sendJSONError(w, "failed to list webhook deliveries",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	output := make([]webhookDeliveryResponse, 0, len(deliveries))
+	for _, delivery := range deliveries {
+		output = append(output, webhookDeliveryResponse{
+			ID:            delivery.ID,
+			EventID:       delivery.EventID,
+			URL:           delivery.URL,
+			Kind:          delivery.Kind,
+			Status:        delivery.Status,
+			Attempts:      delivery.Attempts,
+			LastError:     delivery.LastError,
+			NextAttemptAt: delivery.NextAttemptAt,
+			CreatedAt:     delivery.CreatedAt,
+			FinishedAt:    delivery.FinishedAt,
+		})
+	}
+
+	data, err := json.Marshal(output)
+	if err != nil {
+		sendJSONError(w, "json encoding failed",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONData(w, http.StatusOK, data)
+}
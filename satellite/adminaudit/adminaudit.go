@@ -0,0 +1,111 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package adminaudit stores the changes made through the admin API, so they
+// can be searched during incident reviews without accessing the database.
+package adminaudit
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+
+	"storj.io/common/uuid"
+)
+
+var (
+	mon = monkit.Package()
+
+	// Error is the default error class for the admin audit package.
+	Error = errs.Class("admin audit")
+
+	// ErrValidation is returned when the search options are invalid.
+	ErrValidation = errs.Class("admin audit validation")
+)
+
+const (
+	// DefaultSearchLimit is the number of events returned when no limit is specified.
+	DefaultSearchLimit = 100
+	// MaxSearchLimit is the maximum number of events returned by a single search.
+	MaxSearchLimit = 1000
+)
+
+// Event is a change made through the admin API.
+type Event struct {
+	ID         uuid.UUID
+	AdminEmail string
+	// UserID is the user affected by the change, either directly or as the
+	// owner of the changed project. It's nil when no user is affected.
+	UserID *uuid.UUID
+	// Action is the method and the route of the request, e.g.
+	// "PUT /api/users/{useremail}/limits".
+	Action string
+	// Path is the path of the request.
+	Path string
+	// Reason is the reason given by the admin for the change, if any.
+	Reason    string
+	CreatedAt time.Time
+}
+
+// Cursor is the position of an event in the search results.
+type Cursor struct {
+	CreatedAt time.Time
+	ID        uuid.UUID
+}
+
+// String encodes the cursor to be passed to ParseCursor.
+func (cursor Cursor) String() string {
+	return strconv.FormatInt(cursor.CreatedAt.UnixNano(), 10) + "_" + cursor.ID.String()
+}
+
+// ParseCursor decodes a cursor encoded by Cursor.String.
+func ParseCursor(s string) (Cursor, error) {
+	createdAt, id, ok := strings.Cut(s, "_")
+	if !ok {
+		return Cursor{}, ErrValidation.New("invalid cursor %q", s)
+	}
+	nanos, err := strconv.ParseInt(createdAt, 10, 64)
+	if err != nil {
+		return Cursor{}, ErrValidation.New("invalid cursor %q", s)
+	}
+	cursor := Cursor{CreatedAt: time.Unix(0, nanos).UTC()}
+	cursor.ID, err = uuid.FromString(id)
+	if err != nil {
+		return Cursor{}, ErrValidation.New("invalid cursor %q", s)
+	}
+	return cursor, nil
+}
+
+// SearchOptions filters the events. Empty filters match every event.
+type SearchOptions struct {
+	AdminEmail string
+	UserID     *uuid.UUID
+	Action     string
+	// Since and Before limit the events to the ones created in [Since, Before).
+	Since  time.Time
+	Before time.Time
+	// Cursor continues a search after the last event of the previous page.
+	Cursor *Cursor
+	Limit  int
+}
+
+// SearchResult is a page of events, ordered from the newest.
+type SearchResult struct {
+	Events []Event
+	// Next is the cursor of the next page, nil when there are no more events.
+	Next *Cursor
+}
+
+// DB is the interface for storing the admin audit events.
+//
+// architecture: Database
+type DB interface {
+	// Insert inserts a new event.
+	Insert(ctx context.Context, event Event) (Event, error)
+	// Search returns at most opts.Limit events matching opts, ordered from the newest.
+	Search(ctx context.Context, opts SearchOptions) ([]Event, error)
+}
@@ -0,0 +1,87 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package adminaudit
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/common/uuid"
+)
+
+// Service records and searches the changes made through the admin API.
+//
+// architecture: Service
+type Service struct {
+	log *zap.Logger
+	db  DB
+
+	nowFn func() time.Time
+}
+
+// NewService creates a new admin audit service.
+func NewService(log *zap.Logger, db DB) *Service {
+	return &Service{
+		log: log,
+		db:  db,
+
+		nowFn: time.Now,
+	}
+}
+
+// TestingSetNow allows tests to have the service act as if the current time is whatever they want.
+func (service *Service) TestingSetNow(nowFn func() time.Time) {
+	service.nowFn = nowFn
+}
+
+// Record stores a change made through the admin API.
+func (service *Service) Record(ctx context.Context, event Event) (_ Event, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	event.ID, err = uuid.New()
+	if err != nil {
+		return Event{}, Error.Wrap(err)
+	}
+	event.CreatedAt = service.nowFn()
+
+	event, err = service.db.Insert(ctx, event)
+	if err != nil {
+		return Event{}, Error.Wrap(err)
+	}
+	return event, nil
+}
+
+// Search returns a page of the events matching opts, ordered from the newest.
+func (service *Service) Search(ctx context.Context, opts SearchOptions) (_ SearchResult, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	switch {
+	case opts.Limit < 0 || opts.Limit > MaxSearchLimit:
+		return SearchResult{}, ErrValidation.New("limit must be between 1 and %d", MaxSearchLimit)
+	case opts.Limit == 0:
+		opts.Limit = DefaultSearchLimit
+	}
+	if !opts.Since.IsZero() && !opts.Before.IsZero() && !opts.Since.Before(opts.Before) {
+		return SearchResult{}, ErrValidation.New("the start of the time range must be before its end")
+	}
+
+	limit := opts.Limit
+	// an additional event tells whether there's a next page.
+	opts.Limit++
+
+	events, err := service.db.Search(ctx, opts)
+	if err != nil {
+		return SearchResult{}, Error.Wrap(err)
+	}
+
+	result := SearchResult{Events: events}
+	if len(events) > limit {
+		result.Events = events[:limit]
+		last := result.Events[limit-1]
+		result.Next = &Cursor{CreatedAt: last.CreatedAt, ID: last.ID}
+	}
+	return result, nil
+}
@@ -0,0 +1,104 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package adminaudit_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/adminaudit"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestService(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		service := adminaudit.NewService(zaptest.NewLogger(t), db.AdminAuditEvents())
+
+		now := time.Now().Truncate(time.Second)
+		service.TestingSetNow(func() time.Time {
+			now = now.Add(time.Second)
+			return now
+		})
+
+		userID := testrand.UUID()
+		var recorded []adminaudit.Event
+		for _, event := range []adminaudit.Event{
+			{AdminEmail: "first@storj.test", UserID: &userID, Action: "PUT /api/users/{useremail}/limits", Path: "/api/users/user@mail.test/limits"},
+			{AdminEmail: "second@storj.test", UserID: &userID, Action: "PUT /api/users/{useremail}/billing-freeze", Path: "/api/users/user@mail.test/billing-freeze"},
+			{AdminEmail: "first@storj.test", Action: "POST /api/maintenance-windows", Path: "/api/maintenance-windows", Reason: "planned upgrade"},
+			{AdminEmail: "first@storj.test", UserID: &userID, Action: "PUT /api/users/{useremail}/limits", Path: "/api/users/user@mail.test/limits"},
+		} {
+			event, err := service.Record(ctx, event)
+			require.NoError(t, err)
+			require.False(t, event.ID.IsZero())
+			require.False(t, event.CreatedAt.IsZero())
+			recorded = append(recorded, event)
+		}
+
+		ids := func(events []adminaudit.Event) (ids []string) {
+			for _, event := range events {
+				ids = append(ids, event.ID.String())
+			}
+			return ids
+		}
+
+		result, err := service.Search(ctx, adminaudit.SearchOptions{})
+		require.NoError(t, err)
+		require.Len(t, result.Events, 4)
+		require.Nil(t, result.Next)
+		require.Equal(t, recorded[3].ID, result.Events[0].ID)
+		require.Equal(t, recorded[0].ID, result.Events[3].ID)
+		require.Equal(t, "planned upgrade", result.Events[1].Reason)
+		require.Empty(t, result.Events[0].Reason)
+
+		result, err = service.Search(ctx, adminaudit.SearchOptions{AdminEmail: "first@storj.test"})
+		require.NoError(t, err)
+		require.Equal(t, ids([]adminaudit.Event{recorded[3], recorded[2], recorded[0]}), ids(result.Events))
+
+		result, err = service.Search(ctx, adminaudit.SearchOptions{UserID: &userID, Action: "PUT /api/users/{useremail}/limits"})
+		require.NoError(t, err)
+		require.Equal(t, ids([]adminaudit.Event{recorded[3], recorded[0]}), ids(result.Events))
+		require.Equal(t, &userID, result.Events[0].UserID)
+
+		result, err = service.Search(ctx, adminaudit.SearchOptions{Before: recorded[0].CreatedAt})
+		require.NoError(t, err)
+		require.Empty(t, result.Events)
+
+		result, err = service.Search(ctx, adminaudit.SearchOptions{Since: recorded[1].CreatedAt, Before: recorded[3].CreatedAt})
+		require.NoError(t, err)
+		require.Equal(t, ids([]adminaudit.Event{recorded[2], recorded[1]}), ids(result.Events))
+
+		// pages continue after the cursor of the previous page.
+		var paged []adminaudit.Event
+		opts := adminaudit.SearchOptions{Limit: 3}
+		for {
+			result, err := service.Search(ctx, opts)
+			require.NoError(t, err)
+			paged = append(paged, result.Events...)
+			if result.Next == nil {
+				break
+			}
+
+			cursor, err := adminaudit.ParseCursor(result.Next.String())
+			require.NoError(t, err)
+			opts.Cursor = &cursor
+		}
+		require.Equal(t, ids([]adminaudit.Event{recorded[3], recorded[2], recorded[1], recorded[0]}), ids(paged))
+
+		_, err = service.Search(ctx, adminaudit.SearchOptions{Limit: adminaudit.MaxSearchLimit + 1})
+		require.True(t, adminaudit.ErrValidation.Has(err), err)
+
+		_, err = service.Search(ctx, adminaudit.SearchOptions{Since: recorded[0].CreatedAt, Before: recorded[0].CreatedAt})
+		require.True(t, adminaudit.ErrValidation.Has(err), err)
+
+		_, err = adminaudit.ParseCursor("invalid")
+		require.True(t, adminaudit.ErrValidation.Has(err), err)
+	})
+}
@@ -0,0 +1,85 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package adminwebhook delivers webhooks of selected admin actions to
+// external endpoints.
+package adminwebhook
+
+import (
+	"context"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+
+	"storj.io/common/uuid"
+)
+
+var (
+	mon = monkit.Package()
+
+	// Error is the default error class for the admin webhook package.
+	Error = errs.Class("admin webhook")
+)
+
+const (
+	// KindAccountFreeze is the kind of the actions which freeze or unfreeze an account.
+	KindAccountFreeze = "account-freeze"
+	// KindLimitChange is the kind of the actions which change user or project limits.
+	KindLimitChange = "limit-change"
+)
+
+// Status is the state of a delivery.
+type Status string
+
+const (
+	// StatusPending means the webhook is still being delivered.
+	StatusPending Status = "pending"
+	// StatusDelivered means the endpoint accepted the webhook.
+	StatusDelivered Status = "delivered"
+	// StatusFailed means all attempts at delivering the webhook failed.
+	StatusFailed Status = "failed"
+)
+
+// Payload is the JSON body of a webhook.
+type Payload struct {
+	// ID is the ID of the admin audit event, which is the same for the
+	// deliveries to all endpoints and for retried deliveries.
+	ID         uuid.UUID  `json:"id"`
+	Kind       string     `json:"kind"`
+	Action     string     `json:"action"`
+	Path       string     `json:"path"`
+	AdminEmail string     `json:"adminEmail"`
+	UserID     *uuid.UUID `json:"userId,omitempty"`
+	CreatedAt  time.Time  `json:"createdAt"`
+}
+
+// Delivery is a webhook delivered to a single endpoint.
+type Delivery struct {
+	ID            uuid.UUID
+	EventID       uuid.UUID
+	URL           string
+	Kind          string
+	Payload       []byte
+	Status        Status
+	Attempts      int
+	LastError     string
+	NextAttemptAt time.Time
+	CreatedAt     time.Time
+	// FinishedAt is set once the webhook was delivered or the delivery failed.
+	FinishedAt *time.Time
+}
+
+// DB is the interface for storing the webhook deliveries.
+//
+// architecture: Database
+type DB interface {
+	// Insert inserts a new delivery.
+	Insert(ctx context.Context, delivery Delivery) error
+	// Update updates the status, the attempts and the times of a delivery.
+	Update(ctx context.Context, delivery Delivery) error
+	// ListDue returns at most limit pending deliveries which are due at the specified time, ordered by their next attempt.
+	ListDue(ctx context.Context, now time.Time, limit int) ([]Delivery, error)
+	// ListByStatus returns at most limit deliveries with the specified status, ordered from the newest.
+	ListByStatus(ctx context.Context, status Status, limit int) ([]Delivery, error)
+}
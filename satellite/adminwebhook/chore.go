@@ -0,0 +1,58 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package adminwebhook
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"storj.io/common/sync2"
+)
+
+// Chore delivers the pending webhooks of admin actions.
+//
+// architecture: Chore
+type Chore struct {
+	log     *zap.Logger
+	service *Service
+	config  Config
+
+	Loop *sync2.Cycle
+}
+
+// NewChore creates a new instance of the admin webhook chore.
+func NewChore(log *zap.Logger, service *Service, config Config) *Chore {
+	return &Chore{
+		log:     log,
+		service: service,
+		config:  config,
+
+		Loop: sync2.NewCycle(config.Interval),
+	}
+}
+
+// Run starts delivering the pending webhooks.
+func (chore *Chore) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return chore.Loop.Run(ctx, func(ctx context.Context) error {
+		for {
+			attempted, err := chore.service.DeliverDue(ctx)
+			if err != nil {
+				chore.log.Error("delivering admin webhooks failed", zap.Error(err))
+				return nil
+			}
+			if attempted == 0 || attempted < chore.config.ListLimit {
+				return nil
+			}
+		}
+	})
+}
+
+// Close stops the admin webhook chore.
+func (chore *Chore) Close() error {
+	chore.Loop.Close()
+	return nil
+}
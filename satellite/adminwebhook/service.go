@@ -0,0 +1,227 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package adminwebhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/adminaudit"
+)
+
+// SignatureHeader is the header containing the signature of the payload.
+const SignatureHeader = "X-Storj-Webhook-Signature"
+
+// DeliveryHeader is the header containing the ID of the delivery.
+const DeliveryHeader = "X-Storj-Webhook-Delivery"
+
+// maxBackoffDoublings limits how many times the retry backoff is doubled.
+const maxBackoffDoublings = 10
+
+// Config contains configurable values for the admin webhooks.
+type Config struct {
+	URLs         []string      `help:"endpoints which receive the webhooks of admin actions" default:""`
+	Kinds        []string      `help:"kinds of admin actions sent to the endpoints, all of them when empty: account-freeze, limit-change" default:""`
+	Secret       string        `help:"secret used to sign the webhook payloads with HMAC-SHA256" default:""`
+	Interval     time.Duration `help:"how often to deliver the pending webhooks" releaseDefault:"1m" devDefault:"10s"`
+	Timeout      time.Duration `help:"timeout of a single webhook request" default:"10s"`
+	RetryBackoff time.Duration `help:"delay before retrying a failed delivery, doubled after every failed attempt" default:"1m"`
+	MaxAttempts  int           `help:"how many times delivering a webhook is attempted before the delivery fails" default:"5"`
+	ListLimit    int           `help:"how many pending deliveries are attempted in a single iteration" default:"100"`
+}
+
+// Service enqueues and delivers the webhooks of admin actions.
+//
+// A delivery is stored for every configured endpoint when an action is
+// made, and delivered by the Chore. Failed deliveries are retried with an
+// exponential backoff, until they fail config.MaxAttempts times.
+//
+// architecture: Service
+type Service struct {
+	log    *zap.Logger
+	db     DB
+	client *http.Client
+	config Config
+	kinds  map[string]bool
+
+	nowFn func() time.Time
+}
+
+// NewService creates a new admin webhook service.
+func NewService(log *zap.Logger, db DB, config Config) *Service {
+	if config.MaxAttempts <= 0 {
+		config.MaxAttempts = 1
+	}
+	kinds := make(map[string]bool, len(config.Kinds))
+	for _, kind := range config.Kinds {
+		kinds[kind] = true
+	}
+	return &Service{
+		log:    log,
+		db:     db,
+		client: &http.Client{Timeout: config.Timeout},
+		config: config,
+		kinds:  kinds,
+		nowFn:  time.Now,
+	}
+}
+
+// TestingSetNow allows tests to have the service act as if the current time is whatever they want.
+func (service *Service) TestingSetNow(nowFn func() time.Time) {
+	service.nowFn = nowFn
+}
+
+// Enqueue stores a delivery of the event for every endpoint, unless the kind
+// of the action isn't selected.
+func (service *Service) Enqueue(ctx context.Context, kind string, event adminaudit.Event) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(service.config.URLs) == 0 {
+		return nil
+	}
+	if len(service.kinds) > 0 && !service.kinds[kind] {
+		return nil
+	}
+
+	payload, err := json.Marshal(Payload{
+		ID:         event.ID,
+		Kind:       kind,
+		Action:     event.Action,
+		Path:       event.Path,
+		AdminEmail: event.AdminEmail,
+		UserID:     event.UserID,
+		CreatedAt:  event.CreatedAt,
+	})
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	now := service.nowFn()
+	for _, url := range service.config.URLs {
+		id, err := uuid.New()
+		if err != nil {
+			return Error.Wrap(err)
+		}
+
+		err = service.db.Insert(ctx, Delivery{
+			ID:            id,
+			EventID:       event.ID,
+			URL:           url,
+			Kind:          kind,
+			Payload:       payload,
+			Status:        StatusPending,
+			NextAttemptAt: now,
+		})
+		if err != nil {
+			return Error.Wrap(err)
+		}
+	}
+	return nil
+}
+
+// DeliverDue attempts the pending deliveries which are due, and returns how
+// many of them were attempted.
+func (service *Service) DeliverDue(ctx context.Context) (attempted int, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	deliveries, err := service.db.ListDue(ctx, service.nowFn(), service.config.ListLimit)
+	if err != nil {
+		return 0, Error.Wrap(err)
+	}
+
+	for _, delivery := range deliveries {
+		sendErr := service.send(ctx, delivery)
+
+		now := service.nowFn()
+		switch {
+		case sendErr == nil:
+			mon.Counter("admin_webhook_delivered").Inc(1)
+			delivery.Status = StatusDelivered
+			delivery.FinishedAt = &now
+		case delivery.Attempts+1 >= service.config.MaxAttempts:
+			mon.Counter("admin_webhook_failed").Inc(1)
+			delivery.Attempts++
+			delivery.LastError = sendErr.Error()
+			delivery.Status = StatusFailed
+			delivery.FinishedAt = &now
+			service.log.Error("delivering admin webhook failed",
+				zap.Stringer("Delivery ID", delivery.ID),
+				zap.Stringer("Event ID", delivery.EventID),
+				zap.String("URL", delivery.URL),
+				zap.Int("Attempts", delivery.Attempts),
+				zap.Error(sendErr))
+		default:
+			mon.Counter("admin_webhook_retried").Inc(1)
+			doublings := delivery.Attempts
+			if doublings > maxBackoffDoublings {
+				doublings = maxBackoffDoublings
+			}
+			delivery.Attempts++
+			delivery.LastError = sendErr.Error()
+			delivery.NextAttemptAt = now.Add(service.config.RetryBackoff << doublings)
+		}
+
+		if err := service.db.Update(ctx, delivery); err != nil {
+			return attempted, Error.Wrap(err)
+		}
+		attempted++
+	}
+	return attempted, nil
+}
+
+// List returns at most limit deliveries with the specified status, ordered from the newest.
+func (service *Service) List(ctx context.Context, status Status, limit int) (_ []Delivery, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	deliveries, err := service.db.ListByStatus(ctx, status, limit)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return deliveries, nil
+}
+
+func (service *Service) send(ctx context.Context, delivery Delivery) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, delivery.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set(DeliveryHeader, delivery.ID.String())
+	request.Header.Set(SignatureHeader, Sign(service.config.Secret, delivery.Payload))
+
+	response, err := service.client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_, _ = io.Copy(io.Discard, response.Body)
+		err = errs.Combine(err, response.Body.Close())
+	}()
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return errs.New("unexpected status %d", response.StatusCode)
+	}
+	return nil
+}
+
+// Sign returns the signature of a payload, i.e. "sha256=" followed by the
+// hex encoded HMAC-SHA256 of the payload with the secret.
+func Sign(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	_, _ = mac.Write(payload)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
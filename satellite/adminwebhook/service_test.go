@@ -0,0 +1,132 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package adminwebhook_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/adminaudit"
+	"storj.io/storj/satellite/adminwebhook"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestService(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		var mu sync.Mutex
+		var received []adminwebhook.Payload
+		failures := 1
+
+		endpoint := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			require.Equal(t, adminwebhook.Sign("secret", body), r.Header.Get(adminwebhook.SignatureHeader))
+			require.NotEmpty(t, r.Header.Get(adminwebhook.DeliveryHeader))
+
+			mu.Lock()
+			defer mu.Unlock()
+			if failures > 0 {
+				failures--
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+
+			var payload adminwebhook.Payload
+			require.NoError(t, json.Unmarshal(body, &payload))
+			received = append(received, payload)
+		}))
+		defer endpoint.Close()
+
+		rejecting := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+		}))
+		defer rejecting.Close()
+
+		service := adminwebhook.NewService(zaptest.NewLogger(t), db.AdminWebhookDeliveries(), adminwebhook.Config{
+			URLs:         []string{endpoint.URL, rejecting.URL},
+			Kinds:        []string{adminwebhook.KindAccountFreeze},
+			Secret:       "secret",
+			Timeout:      time.Second,
+			RetryBackoff: time.Minute,
+			MaxAttempts:  2,
+			ListLimit:    10,
+		})
+
+		now := time.Now().Truncate(time.Second)
+		service.TestingSetNow(func() time.Time { return now })
+
+		userID := testrand.UUID()
+		event := adminaudit.Event{
+			ID:         testrand.UUID(),
+			AdminEmail: "admin@storj.test",
+			UserID:     &userID,
+			Action:     "PUT /api/users/{useremail}/billing-freeze",
+			Path:       "/api/users/user@mail.test/billing-freeze",
+			CreatedAt:  now,
+		}
+
+		// kinds which aren't selected aren't delivered.
+		require.NoError(t, service.Enqueue(ctx, adminwebhook.KindLimitChange, event))
+		require.NoError(t, service.Enqueue(ctx, adminwebhook.KindAccountFreeze, event))
+
+		pending, err := service.List(ctx, adminwebhook.StatusPending, 10)
+		require.NoError(t, err)
+		require.Len(t, pending, 2)
+
+		// both endpoints fail the first attempt.
+		attempted, err := service.DeliverDue(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 2, attempted)
+
+		pending, err = service.List(ctx, adminwebhook.StatusPending, 10)
+		require.NoError(t, err)
+		require.Len(t, pending, 2)
+		for _, delivery := range pending {
+			require.Equal(t, 1, delivery.Attempts)
+			require.NotEmpty(t, delivery.LastError)
+		}
+
+		// the retry isn't due yet.
+		attempted, err = service.DeliverDue(ctx)
+		require.NoError(t, err)
+		require.Zero(t, attempted)
+
+		now = now.Add(time.Minute)
+		attempted, err = service.DeliverDue(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 2, attempted)
+
+		delivered, err := service.List(ctx, adminwebhook.StatusDelivered, 10)
+		require.NoError(t, err)
+		require.Len(t, delivered, 1)
+		require.Equal(t, endpoint.URL, delivered[0].URL)
+		require.NotNil(t, delivered[0].FinishedAt)
+
+		failed, err := service.List(ctx, adminwebhook.StatusFailed, 10)
+		require.NoError(t, err)
+		require.Len(t, failed, 1)
+		require.Equal(t, rejecting.URL, failed[0].URL)
+		require.Equal(t, 2, failed[0].Attempts)
+		require.Contains(t, failed[0].LastError, "404")
+
+		mu.Lock()
+		defer mu.Unlock()
+		require.Len(t, received, 1)
+		require.Equal(t, event.ID, received[0].ID)
+		require.Equal(t, adminwebhook.KindAccountFreeze, received[0].Kind)
+		require.Equal(t, event.Action, received[0].Action)
+		require.Equal(t, &userID, received[0].UserID)
+	})
+}
@@ -44,7 +44,9 @@ import (
 	"storj.io/storj/satellite/gracefulexit"
 	"storj.io/storj/satellite/kms"
 	"storj.io/storj/satellite/mailservice"
+	"storj.io/storj/satellite/maintenance"
 	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/objectpins"
 	"storj.io/storj/satellite/metainfo"
 	"storj.io/storj/satellite/nodeselection"
 	"storj.io/storj/satellite/nodestats"
@@ -173,6 +175,10 @@ type API struct {
 		Service *abtesting.Service
 	}
 
+	Maintenance struct {
+		Service *maintenance.Service
+	}
+
 	Buckets struct {
 		Service *buckets.Service
 	}
@@ -181,6 +187,10 @@ type API struct {
 		Service *kms.Service
 	}
 
+	ObjectPins struct {
+		Service *objectpins.Service
+	}
+
 	SuccessTrackers *metainfo.SuccessTrackers
 }
 
@@ -439,6 +449,14 @@ func NewAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 		})
 	}
 
+	{ // setup maintenance announcements
+		peer.Maintenance.Service = maintenance.NewService(
+			peer.Log.Named("maintenance"),
+			peer.DB.MaintenanceWindows(),
+			config.Maintenance,
+		)
+	}
+
 	{ // setup metainfo
 		peer.Metainfo.Metabase = metabaseDB
 
@@ -587,6 +605,13 @@ func NewAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 		peer.REST.Keys = restkeys.NewService(peer.DB.OIDC().OAuthTokens(), config.RESTKeys)
 	}
 
+	{ // setup object pins
+		peer.ObjectPins.Service = objectpins.NewService(
+			peer.Log.Named("object-pins"),
+			peer.Metainfo.Metabase,
+		)
+	}
+
 	{ // setup console
 		consoleConfig := config.Console
 		peer.Console.Listener, err = net.Listen("tcp", consoleConfig.Address)
@@ -626,6 +651,7 @@ func NewAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 			accountFreezeService,
 			emissionService,
 			peer.KeyManagement.Service,
+			peer.ObjectPins.Service,
 			externalAddress,
 			consoleConfig.SatelliteName,
 			config.Metainfo.ProjectLimits.MaxBuckets,
@@ -640,6 +666,11 @@ func NewAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 			return nil, errs.Combine(err, peer.Close())
 		}
 
+		peer.Services.Add(lifecycle.Item{
+			Name: "console:revocation-propagation",
+			Run:  peer.Console.Service.RunRevocationPropagation,
+		})
+
 		peer.Console.Endpoint = consoleweb.NewServer(
 			peer.Log.Named("console:endpoint"),
 			consoleConfig,
@@ -648,6 +679,7 @@ func NewAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 			peer.Mail.Service,
 			peer.Analytics.Service,
 			peer.ABTesting.Service,
+			peer.Maintenance.Service,
 			accountFreezeService,
 			peer.Console.Listener,
 			config.Payments.StripeCoinPayments.StripePublicKey,
@@ -44,6 +44,7 @@ type Bucket struct {
 	DefaultEncryptionParameters storj.EncryptionParameters
 	Placement                   storj.PlacementConstraint
 	Versioning                  Versioning
+	ObjectLockEnabled           bool
 }
 
 // ListDirection specifies listing direction.
@@ -140,4 +141,24 @@ type DB interface {
 	CountBuckets(ctx context.Context, projectID uuid.UUID) (int, error)
 	// IterateBucketLocations iterates through all buckets with specific page size.
 	IterateBucketLocations(ctx context.Context, pageSize int, fn func([]metabase.BucketLocation) error) (err error)
+
+	// GetBucketLifecycle returns the lifecycle configuration of a bucket, or nil when it has none.
+	GetBucketLifecycle(ctx context.Context, bucketName []byte, projectID uuid.UUID) (_ *LifecycleConfiguration, err error)
+	// SetBucketLifecycle replaces the lifecycle configuration of an existing bucket.
+	SetBucketLifecycle(ctx context.Context, bucketName []byte, projectID uuid.UUID, config LifecycleConfiguration) (err error)
+	// DeleteBucketLifecycle removes the lifecycle configuration of a bucket.
+	DeleteBucketLifecycle(ctx context.Context, bucketName []byte, projectID uuid.UUID) (err error)
+	// IterateBucketLifecycles iterates through all buckets which have a lifecycle configuration.
+	IterateBucketLifecycles(ctx context.Context, pageSize int, fn func([]BucketLifecycle) error) (err error)
+
+	// GetBucketInventory returns the inventory configuration of a bucket, or nil when it has none.
+	GetBucketInventory(ctx context.Context, bucketName []byte, projectID uuid.UUID) (_ *InventoryConfiguration, err error)
+	// SetBucketInventory replaces the inventory configuration of an existing bucket.
+	SetBucketInventory(ctx context.Context, bucketName []byte, projectID uuid.UUID, config InventoryConfiguration) (err error)
+	// DeleteBucketInventory removes the inventory configuration of a bucket.
+	DeleteBucketInventory(ctx context.Context, bucketName []byte, projectID uuid.UUID) (err error)
+	// SetBucketInventoryLastRun records when the last inventory report of a bucket was written.
+	SetBucketInventoryLastRun(ctx context.Context, bucketName []byte, projectID uuid.UUID, lastRunAt time.Time) (err error)
+	// IterateBucketInventories iterates through all buckets which have an inventory configuration.
+	IterateBucketInventories(ctx context.Context, pageSize int, fn func([]BucketInventory) error) (err error)
 }
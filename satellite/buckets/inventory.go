@@ -0,0 +1,134 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package buckets
+
+import (
+	"regexp"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/satellite/metabase"
+)
+
+// ErrInvalidInventory is used when an inventory configuration is not valid.
+var ErrInvalidInventory = errs.Class("invalid inventory configuration")
+
+var inventoryIDRegexp = regexp.MustCompile(`^[a-zA-Z0-9._-]{1,64}$`)
+
+// InventoryFrequency is how often an inventory report is generated.
+type InventoryFrequency string
+
+const (
+	// InventoryDaily generates a report once a day.
+	InventoryDaily InventoryFrequency = "daily"
+	// InventoryWeekly generates a report once a week.
+	InventoryWeekly InventoryFrequency = "weekly"
+)
+
+// Interval returns the minimum time between two reports.
+func (frequency InventoryFrequency) Interval() time.Duration {
+	switch frequency {
+	case InventoryDaily:
+		return 24 * time.Hour
+	case InventoryWeekly:
+		return 7 * 24 * time.Hour
+	default:
+		return 0
+	}
+}
+
+// InventoryVersions selects which object versions are listed in an inventory report.
+type InventoryVersions string
+
+const (
+	// InventoryCurrentVersions lists only the current version of every object.
+	InventoryCurrentVersions InventoryVersions = "current"
+	// InventoryAllVersions lists every committed version and delete marker.
+	InventoryAllVersions InventoryVersions = "all"
+)
+
+// InventoryFormat is the file format of an inventory report.
+type InventoryFormat string
+
+const (
+	// InventoryCSV writes the report as gzip compressed CSV.
+	InventoryCSV InventoryFormat = "csv"
+)
+
+// InventoryConfiguration describes an S3 style inventory report of a bucket.
+//
+// The satellite only knows the encrypted object keys, so the report lists them
+// in their encrypted form and the customer decrypts them with their own access.
+//
+// Reports are written with credentials issued by the satellite, never with an
+// access grant of the customer: an API key which only allows reading, listing
+// and writing in the destination bucket, and a passphrase which encrypts the
+// reports. The reports only contain metadata the satellite already knows, so
+// holding their passphrase doesn't reveal any customer data.
+type InventoryConfiguration struct {
+	// ID distinguishes the reports of a bucket in the destination, like the S3 inventory configuration ID.
+	ID                string             `json:"id"`
+	DestinationBucket string             `json:"destinationBucket"`
+	DestinationPrefix string             `json:"destinationPrefix,omitempty"`
+	Format            InventoryFormat    `json:"format"`
+	Frequency         InventoryFrequency `json:"frequency"`
+	IncludedVersions  InventoryVersions  `json:"includedVersions"`
+	// APIKey is the serialized API key, issued by the satellite, which is used to write the reports.
+	APIKey string `json:"apiKey,omitempty"`
+	// ReportPassphrase is the passphrase, generated by the satellite, which encrypts the reports.
+	ReportPassphrase string `json:"reportPassphrase,omitempty"`
+}
+
+// Validate checks that the inventory configuration is well-formed.
+func (config *InventoryConfiguration) Validate() error {
+	switch {
+	case !inventoryIDRegexp.MatchString(config.ID):
+		return ErrInvalidInventory.New("ID must be 1 to 64 characters of letters, digits, '.', '_' or '-'")
+	case config.DestinationBucket == "":
+		return ErrInvalidInventory.New("destination bucket missing")
+	}
+
+	switch config.Format {
+	case InventoryCSV:
+	default:
+		return ErrInvalidInventory.New("unsupported format %q", config.Format)
+	}
+	if config.Frequency.Interval() == 0 {
+		return ErrInvalidInventory.New("unsupported frequency %q", config.Frequency)
+	}
+	switch config.IncludedVersions {
+	case InventoryCurrentVersions, InventoryAllVersions:
+	default:
+		return ErrInvalidInventory.New("unsupported included versions %q", config.IncludedVersions)
+	}
+	return nil
+}
+
+// ValidateCredentials checks that the credentials for writing the reports were issued.
+func (config *InventoryConfiguration) ValidateCredentials() error {
+	switch {
+	case config.APIKey == "":
+		return ErrInvalidInventory.New("API key missing")
+	case config.ReportPassphrase == "":
+		return ErrInvalidInventory.New("report passphrase missing")
+	}
+	return nil
+}
+
+// BucketInventory is the inventory configuration of a specific bucket.
+type BucketInventory struct {
+	Bucket        metabase.BucketLocation
+	Configuration InventoryConfiguration
+	// LastRunAt is when the last report was written. It is nil when no report was written yet.
+	LastRunAt *time.Time
+}
+
+// Due returns whether a new report should be written at the specified time.
+func (inventory *BucketInventory) Due(now time.Time) bool {
+	if inventory.LastRunAt == nil {
+		return true
+	}
+	return !now.Before(inventory.LastRunAt.Add(inventory.Configuration.Frequency.Interval()))
+}
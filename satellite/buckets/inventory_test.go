@@ -0,0 +1,129 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package buckets_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite/buckets"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/metabase"
+)
+
+func validInventory() buckets.InventoryConfiguration {
+	return buckets.InventoryConfiguration{
+		ID:                "daily-report",
+		DestinationBucket: "reports",
+		DestinationPrefix: "inventory/",
+		Format:            buckets.InventoryCSV,
+		Frequency:         buckets.InventoryDaily,
+		IncludedVersions:  buckets.InventoryCurrentVersions,
+		APIKey:            "apikey",
+		ReportPassphrase:  "passphrase",
+	}
+}
+
+func TestInventoryConfiguration_Validate(t *testing.T) {
+	valid := validInventory()
+	require.NoError(t, valid.Validate())
+
+	for name, modify := range map[string]func(*buckets.InventoryConfiguration){
+		"missing id":          func(c *buckets.InventoryConfiguration) { c.ID = "" },
+		"invalid id":          func(c *buckets.InventoryConfiguration) { c.ID = "a/b" },
+		"missing destination": func(c *buckets.InventoryConfiguration) { c.DestinationBucket = "" },
+		"parquet":             func(c *buckets.InventoryConfiguration) { c.Format = "parquet" },
+		"hourly":              func(c *buckets.InventoryConfiguration) { c.Frequency = "hourly" },
+		"unknown versions":    func(c *buckets.InventoryConfiguration) { c.IncludedVersions = "some" },
+	} {
+		t.Run(name, func(t *testing.T) {
+			config := validInventory()
+			modify(&config)
+			require.True(t, buckets.ErrInvalidInventory.Has(config.Validate()))
+		})
+	}
+}
+
+func TestBucketInventory_Due(t *testing.T) {
+	now := time.Now()
+	inventory := buckets.BucketInventory{Configuration: validInventory()}
+	require.True(t, inventory.Due(now))
+
+	lastRun := now.Add(-23 * time.Hour)
+	inventory.LastRunAt = &lastRun
+	require.False(t, inventory.Due(now))
+	require.True(t, inventory.Due(now.Add(time.Hour)))
+
+	inventory.Configuration.Frequency = buckets.InventoryWeekly
+	require.False(t, inventory.Due(now.Add(time.Hour)))
+	require.True(t, inventory.Due(lastRun.Add(7*24*time.Hour)))
+}
+
+func TestBucketInventory(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{SatelliteCount: 1}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		bucketsDB := sat.DB.Buckets()
+
+		project, err := sat.DB.Console().Projects().Insert(ctx, &console.Project{Name: "testproject"})
+		require.NoError(t, err)
+
+		config := validInventory()
+
+		err = bucketsDB.SetBucketInventory(ctx, []byte("testbucket"), project.ID, config)
+		require.True(t, buckets.ErrBucketNotFound.Has(err), err)
+
+		_, err = bucketsDB.CreateBucket(ctx, newTestBucket("testbucket", project.ID))
+		require.NoError(t, err)
+
+		stored, err := bucketsDB.GetBucketInventory(ctx, []byte("testbucket"), project.ID)
+		require.NoError(t, err)
+		require.Nil(t, stored)
+
+		err = bucketsDB.SetBucketInventory(ctx, []byte("testbucket"), project.ID, buckets.InventoryConfiguration{ID: "invalid"})
+		require.True(t, buckets.ErrInvalidInventory.Has(err), err)
+
+		withoutKey := config
+		withoutKey.APIKey = ""
+		err = bucketsDB.SetBucketInventory(ctx, []byte("testbucket"), project.ID, withoutKey)
+		require.True(t, buckets.ErrInvalidInventory.Has(err), err)
+
+		require.NoError(t, bucketsDB.SetBucketInventory(ctx, []byte("testbucket"), project.ID, config))
+
+		stored, err = bucketsDB.GetBucketInventory(ctx, []byte("testbucket"), project.ID)
+		require.NoError(t, err)
+		require.Equal(t, &config, stored)
+
+		lastRun := time.Now().Truncate(time.Second).UTC()
+		require.NoError(t, bucketsDB.SetBucketInventoryLastRun(ctx, []byte("testbucket"), project.ID, lastRun))
+
+		// updating the configuration keeps the time of the last report.
+		config.Frequency = buckets.InventoryWeekly
+		require.NoError(t, bucketsDB.SetBucketInventory(ctx, []byte("testbucket"), project.ID, config))
+
+		var iterated []buckets.BucketInventory
+		err = bucketsDB.IterateBucketInventories(ctx, 1, func(page []buckets.BucketInventory) error {
+			iterated = append(iterated, page...)
+			return nil
+		})
+		require.NoError(t, err)
+		require.Len(t, iterated, 1)
+		require.Equal(t, metabase.BucketLocation{ProjectID: project.ID, BucketName: "testbucket"}, iterated[0].Bucket)
+		require.Equal(t, config, iterated[0].Configuration)
+		require.NotNil(t, iterated[0].LastRunAt)
+		require.WithinDuration(t, lastRun, *iterated[0].LastRunAt, time.Second)
+
+		// deleting the bucket removes its configuration.
+		require.NoError(t, bucketsDB.DeleteBucket(ctx, []byte("testbucket"), project.ID))
+		_, err = bucketsDB.CreateBucket(ctx, newTestBucket("testbucket", project.ID))
+		require.NoError(t, err)
+
+		stored, err = bucketsDB.GetBucketInventory(ctx, []byte("testbucket"), project.ID)
+		require.NoError(t, err)
+		require.Nil(t, stored)
+	})
+}
@@ -0,0 +1,129 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package buckets
+
+import (
+	"strings"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/satellite/metabase"
+)
+
+const (
+	// MaxLifecycleRules is the maximum number of lifecycle rules of a single bucket.
+	MaxLifecycleRules        = 1000
+	maxLifecycleRuleIDLength = 255
+)
+
+// ErrInvalidLifecycle is used when a lifecycle configuration is not valid.
+var ErrInvalidLifecycle = errs.Class("invalid lifecycle configuration")
+
+// LifecycleConfiguration contains the lifecycle rules of a bucket.
+type LifecycleConfiguration struct {
+	Rules []LifecycleRule `json:"rules"`
+}
+
+// BucketLifecycle is the lifecycle configuration of a specific bucket.
+type BucketLifecycle struct {
+	Bucket        metabase.BucketLocation
+	Configuration LifecycleConfiguration
+}
+
+// LifecycleRule is an S3 style lifecycle rule, which applies to all objects
+// with keys starting with Prefix. Zero days disable the corresponding action.
+type LifecycleRule struct {
+	ID      string `json:"id"`
+	Prefix  string `json:"prefix"`
+	Enabled bool   `json:"enabled"`
+
+	// ExpireCurrentAfterDays expires the current version of an object this many days after it was created.
+	ExpireCurrentAfterDays int `json:"expireCurrentAfterDays,omitempty"`
+	// AbortIncompleteUploadAfterDays deletes pending objects this many days after the upload was started.
+	AbortIncompleteUploadAfterDays int `json:"abortIncompleteUploadAfterDays,omitempty"`
+	// NoncurrentVersionExpirationDays deletes noncurrent versions this many days after they became noncurrent.
+	NoncurrentVersionExpirationDays int `json:"noncurrentVersionExpirationDays,omitempty"`
+}
+
+// Validate checks that the lifecycle configuration is well-formed.
+func (config *LifecycleConfiguration) Validate() error {
+	if len(config.Rules) > MaxLifecycleRules {
+		return ErrInvalidLifecycle.New("at most %d rules are allowed", MaxLifecycleRules)
+	}
+
+	ids := make(map[string]struct{}, len(config.Rules))
+	for _, rule := range config.Rules {
+		switch {
+		case rule.ID == "":
+			return ErrInvalidLifecycle.New("rule ID missing")
+		case len(rule.ID) > maxLifecycleRuleIDLength:
+			return ErrInvalidLifecycle.New("rule ID %q is longer than %d characters", rule.ID, maxLifecycleRuleIDLength)
+		case rule.ExpireCurrentAfterDays < 0, rule.AbortIncompleteUploadAfterDays < 0, rule.NoncurrentVersionExpirationDays < 0:
+			return ErrInvalidLifecycle.New("rule %q has negative days", rule.ID)
+		case rule.ExpireCurrentAfterDays == 0 && rule.AbortIncompleteUploadAfterDays == 0 && rule.NoncurrentVersionExpirationDays == 0:
+			return ErrInvalidLifecycle.New("rule %q has no actions", rule.ID)
+		}
+		if _, ok := ids[rule.ID]; ok {
+			return ErrInvalidLifecycle.New("duplicate rule ID %q", rule.ID)
+		}
+		ids[rule.ID] = struct{}{}
+	}
+	return nil
+}
+
+// LifecycleAction is the action a lifecycle rule requires for an object.
+type LifecycleAction int
+
+const (
+	// LifecycleNone means the object is kept.
+	LifecycleNone LifecycleAction = iota
+	// LifecycleExpireCurrent means the current version of the object has expired.
+	LifecycleExpireCurrent
+	// LifecycleAbortUpload means the pending object should be deleted.
+	LifecycleAbortUpload
+	// LifecycleExpireNoncurrent means the noncurrent version should be deleted.
+	LifecycleExpireNoncurrent
+)
+
+// LifecycleObject contains the information about an object version needed to evaluate lifecycle rules.
+type LifecycleObject struct {
+	ObjectKey metabase.ObjectKey
+	Status    metabase.ObjectStatus
+	CreatedAt time.Time
+	// NoncurrentSince is when a newer version was created. It is zero for the current version.
+	NoncurrentSince time.Time
+}
+
+// Evaluate returns the action required by the enabled rules for the object at the specified time.
+// When multiple rules apply, the first matching rule wins.
+func (config *LifecycleConfiguration) Evaluate(object LifecycleObject, now time.Time) LifecycleAction {
+	for _, rule := range config.Rules {
+		if !rule.Enabled || !strings.HasPrefix(string(object.ObjectKey), rule.Prefix) {
+			continue
+		}
+
+		switch {
+		case object.Status == metabase.Pending:
+			if elapsed(rule.AbortIncompleteUploadAfterDays, object.CreatedAt, now) {
+				return LifecycleAbortUpload
+			}
+		case object.Status.IsDeleteMarker():
+			// delete markers are removed together with the versions they hide.
+		case !object.NoncurrentSince.IsZero():
+			if elapsed(rule.NoncurrentVersionExpirationDays, object.NoncurrentSince, now) {
+				return LifecycleExpireNoncurrent
+			}
+		default:
+			if elapsed(rule.ExpireCurrentAfterDays, object.CreatedAt, now) {
+				return LifecycleExpireCurrent
+			}
+		}
+	}
+	return LifecycleNone
+}
+
+func elapsed(days int, since, now time.Time) bool {
+	return days > 0 && !now.Before(since.Add(time.Duration(days)*24*time.Hour))
+}
@@ -0,0 +1,155 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package buckets_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite/buckets"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/metabase"
+)
+
+func TestLifecycleConfiguration_Validate(t *testing.T) {
+	for _, tt := range []struct {
+		name  string
+		rules []buckets.LifecycleRule
+		ok    bool
+	}{
+		{name: "empty", ok: true},
+		{name: "valid", rules: []buckets.LifecycleRule{{ID: "a", ExpireCurrentAfterDays: 1}, {ID: "b", AbortIncompleteUploadAfterDays: 7}}, ok: true},
+		{name: "missing id", rules: []buckets.LifecycleRule{{ExpireCurrentAfterDays: 1}}},
+		{name: "duplicate id", rules: []buckets.LifecycleRule{{ID: "a", ExpireCurrentAfterDays: 1}, {ID: "a", ExpireCurrentAfterDays: 2}}},
+		{name: "negative days", rules: []buckets.LifecycleRule{{ID: "a", NoncurrentVersionExpirationDays: -1}}},
+		{name: "no actions", rules: []buckets.LifecycleRule{{ID: "a", Enabled: true}}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			config := buckets.LifecycleConfiguration{Rules: tt.rules}
+			err := config.Validate()
+			if tt.ok {
+				require.NoError(t, err)
+			} else {
+				require.True(t, buckets.ErrInvalidLifecycle.Has(err), err)
+			}
+		})
+	}
+}
+
+func TestLifecycleConfiguration_Evaluate(t *testing.T) {
+	now := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	day := 24 * time.Hour
+
+	config := buckets.LifecycleConfiguration{Rules: []buckets.LifecycleRule{
+		{ID: "disabled", Prefix: "logs/", ExpireCurrentAfterDays: 1},
+		{ID: "logs", Prefix: "logs/", Enabled: true, ExpireCurrentAfterDays: 30, NoncurrentVersionExpirationDays: 7},
+		{ID: "uploads", Enabled: true, AbortIncompleteUploadAfterDays: 3},
+	}}
+
+	for _, tt := range []struct {
+		name   string
+		object buckets.LifecycleObject
+		action buckets.LifecycleAction
+	}{
+		{
+			name:   "current not expired",
+			object: buckets.LifecycleObject{ObjectKey: "logs/a", Status: metabase.CommittedVersioned, CreatedAt: now.Add(-29 * day)},
+			action: buckets.LifecycleNone,
+		},
+		{
+			name:   "current expired",
+			object: buckets.LifecycleObject{ObjectKey: "logs/a", Status: metabase.CommittedVersioned, CreatedAt: now.Add(-30 * day)},
+			action: buckets.LifecycleExpireCurrent,
+		},
+		{
+			name:   "prefix does not match",
+			object: buckets.LifecycleObject{ObjectKey: "data/a", Status: metabase.CommittedUnversioned, CreatedAt: now.Add(-300 * day)},
+			action: buckets.LifecycleNone,
+		},
+		{
+			name:   "noncurrent expired",
+			object: buckets.LifecycleObject{ObjectKey: "logs/a", Status: metabase.CommittedVersioned, CreatedAt: now.Add(-10 * day), NoncurrentSince: now.Add(-8 * day)},
+			action: buckets.LifecycleExpireNoncurrent,
+		},
+		{
+			name:   "noncurrent not expired",
+			object: buckets.LifecycleObject{ObjectKey: "logs/a", Status: metabase.CommittedVersioned, CreatedAt: now.Add(-100 * day), NoncurrentSince: now.Add(-6 * day)},
+			action: buckets.LifecycleNone,
+		},
+		{
+			name:   "delete marker",
+			object: buckets.LifecycleObject{ObjectKey: "logs/a", Status: metabase.DeleteMarkerVersioned, CreatedAt: now.Add(-100 * day)},
+			action: buckets.LifecycleNone,
+		},
+		{
+			name:   "pending",
+			object: buckets.LifecycleObject{ObjectKey: "data/a", Status: metabase.Pending, CreatedAt: now.Add(-3 * day)},
+			action: buckets.LifecycleAbortUpload,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.action, config.Evaluate(tt.object, now))
+		})
+	}
+}
+
+func TestBucketLifecycle(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{SatelliteCount: 1}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		bucketsDB := sat.DB.Buckets()
+
+		project, err := sat.DB.Console().Projects().Insert(ctx, &console.Project{Name: "testproject"})
+		require.NoError(t, err)
+
+		config := buckets.LifecycleConfiguration{Rules: []buckets.LifecycleRule{
+			{ID: "expire", Prefix: "logs/", Enabled: true, ExpireCurrentAfterDays: 30},
+		}}
+
+		err = bucketsDB.SetBucketLifecycle(ctx, []byte("testbucket"), project.ID, config)
+		require.True(t, buckets.ErrBucketNotFound.Has(err), err)
+
+		_, err = bucketsDB.CreateBucket(ctx, newTestBucket("testbucket", project.ID))
+		require.NoError(t, err)
+
+		stored, err := bucketsDB.GetBucketLifecycle(ctx, []byte("testbucket"), project.ID)
+		require.NoError(t, err)
+		require.Nil(t, stored)
+
+		err = bucketsDB.SetBucketLifecycle(ctx, []byte("testbucket"), project.ID, buckets.LifecycleConfiguration{
+			Rules: []buckets.LifecycleRule{{ID: "invalid"}},
+		})
+		require.True(t, buckets.ErrInvalidLifecycle.Has(err), err)
+
+		err = bucketsDB.SetBucketLifecycle(ctx, []byte("testbucket"), project.ID, config)
+		require.NoError(t, err)
+
+		stored, err = bucketsDB.GetBucketLifecycle(ctx, []byte("testbucket"), project.ID)
+		require.NoError(t, err)
+		require.Equal(t, &config, stored)
+
+		var iterated []buckets.BucketLifecycle
+		err = bucketsDB.IterateBucketLifecycles(ctx, 1, func(page []buckets.BucketLifecycle) error {
+			iterated = append(iterated, page...)
+			return nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, []buckets.BucketLifecycle{{
+			Bucket:        metabase.BucketLocation{ProjectID: project.ID, BucketName: "testbucket"},
+			Configuration: config,
+		}}, iterated)
+
+		// deleting the bucket removes its configuration.
+		require.NoError(t, bucketsDB.DeleteBucket(ctx, []byte("testbucket"), project.ID))
+		_, err = bucketsDB.CreateBucket(ctx, newTestBucket("testbucket", project.ID))
+		require.NoError(t, err)
+
+		stored, err = bucketsDB.GetBucketLifecycle(ctx, []byte("testbucket"), project.ID)
+		require.NoError(t, err)
+		require.Nil(t, stored)
+	})
+}
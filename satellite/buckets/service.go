@@ -8,6 +8,7 @@ import (
 
 	"github.com/zeebo/errs"
 
+	"storj.io/common/uuid"
 	"storj.io/storj/satellite/metabase"
 )
 
@@ -55,3 +56,81 @@ func (buckets *Service) UpdateBucket(ctx context.Context, bucket Bucket) (Bucket
 
 	return buckets.DB.UpdateBucket(ctx, bucket)
 }
+
+// EnableBucketVersioning overrides the default EnableBucketVersioning behaviour by validating
+// that the resulting bucket settings are consistent.
+func (buckets *Service) EnableBucketVersioning(ctx context.Context, bucketName []byte, projectID uuid.UUID) error {
+	if err := buckets.validateVersioningChange(ctx, bucketName, projectID, VersioningEnabled); err != nil {
+		return err
+	}
+	return buckets.DB.EnableBucketVersioning(ctx, bucketName, projectID)
+}
+
+// SuspendBucketVersioning overrides the default SuspendBucketVersioning behaviour by validating
+// that the resulting bucket settings are consistent.
+func (buckets *Service) SuspendBucketVersioning(ctx context.Context, bucketName []byte, projectID uuid.UUID) error {
+	if err := buckets.validateVersioningChange(ctx, bucketName, projectID, VersioningSuspended); err != nil {
+		return err
+	}
+	return buckets.DB.SuspendBucketVersioning(ctx, bucketName, projectID)
+}
+
+// SetBucketLifecycle overrides the default SetBucketLifecycle behaviour by validating
+// that the resulting bucket settings are consistent.
+func (buckets *Service) SetBucketLifecycle(ctx context.Context, bucketName []byte, projectID uuid.UUID, config LifecycleConfiguration) error {
+	if err := config.Validate(); err != nil {
+		return err
+	}
+
+	from, err := buckets.settings(ctx, bucketName, projectID)
+	if err != nil {
+		return err
+	}
+
+	to := from
+	to.Lifecycle = &config
+	if err := ValidateSettingsTransition(from, to); err != nil {
+		return err
+	}
+	return buckets.DB.SetBucketLifecycle(ctx, bucketName, projectID, config)
+}
+
+// ValidateBucketSettings checks the current settings of a bucket for inconsistent combinations.
+func (buckets *Service) ValidateBucketSettings(ctx context.Context, bucketName []byte, projectID uuid.UUID) ([]SettingsIssue, error) {
+	settings, err := buckets.settings(ctx, bucketName, projectID)
+	if err != nil {
+		return nil, err
+	}
+	return ValidateSettings(settings), nil
+}
+
+// settings returns the settings of a bucket including its lifecycle configuration.
+func (buckets *Service) settings(ctx context.Context, bucketName []byte, projectID uuid.UUID) (Settings, error) {
+	bucket, err := buckets.GetBucket(ctx, bucketName, projectID)
+	if err != nil {
+		return Settings{}, err
+	}
+
+	settings := bucket.Settings()
+	settings.Lifecycle, err = buckets.GetBucketLifecycle(ctx, bucketName, projectID)
+	if err != nil {
+		return Settings{}, err
+	}
+	return settings, nil
+}
+
+// validateVersioningChange checks whether the versioning state of a bucket may be changed.
+// Object lock can only be enabled when the bucket is created, and the versioning update
+// itself is conditional on the current versioning state, so validating the settings read
+// here cannot race with another settings change.
+func (buckets *Service) validateVersioningChange(ctx context.Context, bucketName []byte, projectID uuid.UUID, versioning Versioning) error {
+	from, err := buckets.settings(ctx, bucketName, projectID)
+	if err != nil {
+		return err
+	}
+
+	to := from
+	to.Versioning = versioning
+
+	return ValidateSettingsTransition(from, to)
+}
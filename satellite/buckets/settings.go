@@ -0,0 +1,107 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package buckets
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/zeebo/errs"
+)
+
+// ErrInvalidSettings is returned when a bucket settings change would result in an inconsistent state.
+var ErrInvalidSettings = errs.Class("invalid bucket settings")
+
+// Settings is the combination of bucket settings which must be consistent with each other.
+type Settings struct {
+	Versioning        Versioning
+	ObjectLockEnabled bool
+	// Lifecycle is nil when the bucket has no lifecycle configuration.
+	Lifecycle *LifecycleConfiguration
+}
+
+// Settings returns the settings of the bucket. The lifecycle configuration is
+// stored separately from the bucket and isn't included.
+func (b Bucket) Settings() Settings {
+	return Settings{
+		Versioning:        b.Versioning,
+		ObjectLockEnabled: b.ObjectLockEnabled,
+	}
+}
+
+// SettingsIssue describes an inconsistency between bucket settings.
+type SettingsIssue struct {
+	// Setting is the name of the setting which must be changed to resolve the issue.
+	Setting string `json:"setting"`
+	// Message describes the issue and how to resolve it.
+	Message string `json:"message"`
+}
+
+// ValidateSettings checks the bucket settings for inconsistent combinations.
+func ValidateSettings(settings Settings) (issues []SettingsIssue) {
+	if settings.Versioning < VersioningUnsupported || settings.Versioning > VersioningSuspended {
+		issues = append(issues, SettingsIssue{
+			Setting: "versioning",
+			Message: "unknown versioning state",
+		})
+	}
+
+	if settings.ObjectLockEnabled && settings.Versioning != VersioningEnabled {
+		issues = append(issues, SettingsIssue{
+			Setting: "versioning",
+			Message: "object lock requires versioning to be enabled; enable versioning for the bucket",
+		})
+	}
+
+	if settings.Lifecycle != nil {
+		issues = append(issues, validateLifecycleSettings(settings)...)
+	}
+
+	return issues
+}
+
+// validateLifecycleSettings checks the lifecycle configuration and whether its rules
+// have an effect with the other bucket settings.
+func validateLifecycleSettings(settings Settings) (issues []SettingsIssue) {
+	if err := settings.Lifecycle.Validate(); err != nil {
+		return append(issues, SettingsIssue{
+			Setting: "lifecycle",
+			Message: err.Error(),
+		})
+	}
+
+	if settings.Versioning.IsUnversioned() {
+		for _, rule := range settings.Lifecycle.Rules {
+			if rule.NoncurrentVersionExpirationDays > 0 {
+				issues = append(issues, SettingsIssue{
+					Setting: "lifecycle",
+					Message: fmt.Sprintf("rule %q expires noncurrent versions, which only exist in versioned buckets; enable versioning or remove the noncurrent version expiration", rule.ID),
+				})
+			}
+		}
+	}
+
+	return issues
+}
+
+// ValidateSettingsTransition checks whether bucket settings may be changed from one state to another.
+func ValidateSettingsTransition(from, to Settings) error {
+	var problems []string
+
+	if !from.Versioning.IsUnversioned() && to.Versioning.IsUnversioned() {
+		problems = append(problems, "versioning cannot be disabled once it has been enabled; suspend versioning instead")
+	}
+	if from.ObjectLockEnabled && !to.ObjectLockEnabled {
+		problems = append(problems, "object lock cannot be disabled once it has been enabled")
+	}
+
+	for _, issue := range ValidateSettings(to) {
+		problems = append(problems, issue.Message)
+	}
+
+	if len(problems) > 0 {
+		return ErrInvalidSettings.New("%s", strings.Join(problems, "; "))
+	}
+	return nil
+}
@@ -0,0 +1,152 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package buckets_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite/buckets"
+)
+
+func TestValidateSettings(t *testing.T) {
+	require.Empty(t, buckets.ValidateSettings(buckets.Settings{Versioning: buckets.Unversioned}))
+	require.Empty(t, buckets.ValidateSettings(buckets.Settings{Versioning: buckets.VersioningEnabled, ObjectLockEnabled: true}))
+
+	issues := buckets.ValidateSettings(buckets.Settings{Versioning: buckets.VersioningSuspended, ObjectLockEnabled: true})
+	require.Len(t, issues, 1)
+	require.Equal(t, "versioning", issues[0].Setting)
+
+	require.NotEmpty(t, buckets.ValidateSettings(buckets.Settings{Versioning: 10}))
+
+	noncurrent := &buckets.LifecycleConfiguration{Rules: []buckets.LifecycleRule{
+		{ID: "noncurrent", Enabled: true, NoncurrentVersionExpirationDays: 30},
+	}}
+	require.Empty(t, buckets.ValidateSettings(buckets.Settings{Versioning: buckets.VersioningSuspended, Lifecycle: noncurrent}))
+
+	issues = buckets.ValidateSettings(buckets.Settings{Versioning: buckets.Unversioned, Lifecycle: noncurrent})
+	require.Len(t, issues, 1)
+	require.Equal(t, "lifecycle", issues[0].Setting)
+
+	issues = buckets.ValidateSettings(buckets.Settings{
+		Versioning: buckets.VersioningEnabled,
+		Lifecycle:  &buckets.LifecycleConfiguration{Rules: []buckets.LifecycleRule{{ID: "no actions", Enabled: true}}},
+	})
+	require.Len(t, issues, 1)
+	require.Equal(t, "lifecycle", issues[0].Setting)
+}
+
+func TestValidateSettingsTransition(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		from, to buckets.Settings
+		valid    bool
+	}{
+		{
+			name:  "enable versioning",
+			from:  buckets.Settings{Versioning: buckets.Unversioned},
+			to:    buckets.Settings{Versioning: buckets.VersioningEnabled},
+			valid: true,
+		},
+		{
+			name:  "suspend versioning",
+			from:  buckets.Settings{Versioning: buckets.VersioningEnabled},
+			to:    buckets.Settings{Versioning: buckets.VersioningSuspended},
+			valid: true,
+		},
+		{
+			name: "disable versioning",
+			from: buckets.Settings{Versioning: buckets.VersioningSuspended},
+			to:   buckets.Settings{Versioning: buckets.Unversioned},
+		},
+		{
+			name: "suspend versioning with object lock",
+			from: buckets.Settings{Versioning: buckets.VersioningEnabled, ObjectLockEnabled: true},
+			to:   buckets.Settings{Versioning: buckets.VersioningSuspended, ObjectLockEnabled: true},
+		},
+		{
+			name: "disable object lock",
+			from: buckets.Settings{Versioning: buckets.VersioningEnabled, ObjectLockEnabled: true},
+			to:   buckets.Settings{Versioning: buckets.VersioningEnabled},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := buckets.ValidateSettingsTransition(tt.from, tt.to)
+			if tt.valid {
+				require.NoError(t, err)
+			} else {
+				require.True(t, buckets.ErrInvalidSettings.Has(err))
+			}
+		})
+	}
+}
+
+func TestSuspendVersioningWithObjectLock(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		service := planet.Satellites[0].API.Buckets.Service
+		projectID := testrand.UUID()
+
+		_, err := service.CreateBucket(ctx, buckets.Bucket{
+			ID:                testrand.UUID(),
+			Name:              TestBucket,
+			ProjectID:         projectID,
+			Versioning:        buckets.VersioningEnabled,
+			ObjectLockEnabled: true,
+		})
+		require.NoError(t, err)
+
+		issues, err := service.ValidateBucketSettings(ctx, []byte(TestBucket), projectID)
+		require.NoError(t, err)
+		require.Empty(t, issues)
+
+		err = service.SuspendBucketVersioning(ctx, []byte(TestBucket), projectID)
+		require.True(t, buckets.ErrInvalidSettings.Has(err))
+
+		state, err := service.GetBucketVersioningState(ctx, []byte(TestBucket), projectID)
+		require.NoError(t, err)
+		require.Equal(t, buckets.VersioningEnabled, state)
+	})
+}
+
+func TestSetBucketLifecycleSettings(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		service := planet.Satellites[0].API.Buckets.Service
+		projectID := testrand.UUID()
+
+		_, err := service.CreateBucket(ctx, buckets.Bucket{
+			ID:        testrand.UUID(),
+			Name:      TestBucket,
+			ProjectID: projectID,
+		})
+		require.NoError(t, err)
+
+		noncurrent := buckets.LifecycleConfiguration{Rules: []buckets.LifecycleRule{
+			{ID: "noncurrent", Enabled: true, NoncurrentVersionExpirationDays: 30},
+		}}
+
+		// noncurrent versions only exist in versioned buckets.
+		err = service.SetBucketLifecycle(ctx, []byte(TestBucket), projectID, noncurrent)
+		require.True(t, buckets.ErrInvalidSettings.Has(err), err)
+
+		err = service.SetBucketLifecycle(ctx, []byte(TestBucket), projectID, buckets.LifecycleConfiguration{
+			Rules: []buckets.LifecycleRule{{ID: "empty", Enabled: true}},
+		})
+		require.True(t, buckets.ErrInvalidLifecycle.Has(err), err)
+
+		require.NoError(t, service.EnableBucketVersioning(ctx, []byte(TestBucket), projectID))
+		require.NoError(t, service.SetBucketLifecycle(ctx, []byte(TestBucket), projectID, noncurrent))
+
+		issues, err := service.ValidateBucketSettings(ctx, []byte(TestBucket), projectID)
+		require.NoError(t, err)
+		require.Empty(t, issues)
+	})
+}
@@ -0,0 +1,177 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package buckets
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/pflag"
+	"github.com/zeebo/errs"
+
+	"storj.io/common/storj"
+)
+
+// ErrStorageClass is used when a storage class is not configured or not allowed.
+var ErrStorageClass = errs.Class("storage class")
+
+// StorageClass is a customer facing name of a placement, together with the
+// product which is used to price the usage in it.
+type StorageClass struct {
+	Name      string
+	Placement storj.PlacementConstraint
+	Product   string
+}
+
+// Ensure that StorageClasses implements pflag.Value.
+var _ pflag.Value = (*StorageClasses)(nil)
+
+// StorageClasses is the set of storage classes offered by the satellite.
+// Every storage class maps to exactly one placement.
+//
+// The mapping is the same for every project, since it's configured with
+// metainfo.storage-classes. Per-project mappings need the entitlements
+// service, which isn't available yet.
+type StorageClasses struct {
+	classes []StorageClass
+}
+
+// NewStorageClasses creates storage classes from the definitions.
+func NewStorageClasses(classes ...StorageClass) (StorageClasses, error) {
+	var result StorageClasses
+	for _, class := range classes {
+		if err := result.add(class); err != nil {
+			return StorageClasses{}, err
+		}
+	}
+	return result, nil
+}
+
+// Type returns the type of the pflag.Value.
+func (StorageClasses) Type() string { return "buckets.StorageClasses" }
+
+// String returns the string representation of the storage classes.
+func (classes *StorageClasses) String() string {
+	if classes == nil {
+		return ""
+	}
+	var s strings.Builder
+	for i, class := range classes.classes {
+		if i > 0 {
+			s.WriteRune(';')
+		}
+		s.WriteString(fmt.Sprintf("%s:%d:%s", class.Name, class.Placement, class.Product))
+	}
+	return s.String()
+}
+
+// Set sets the storage classes to the parsed string.
+func (classes *StorageClasses) Set(s string) error {
+	var parsed StorageClasses
+	for _, classStr := range strings.Split(s, ";") {
+		classStr = strings.TrimSpace(classStr)
+		if classStr == "" {
+			continue
+		}
+
+		info := strings.Split(classStr, ":")
+		if len(info) != 3 {
+			return ErrStorageClass.New("invalid storage class (expected format name:placement:product, got %s)", classStr)
+		}
+
+		placement, err := strconv.ParseUint(strings.TrimSpace(info[1]), 10, 16)
+		if err != nil {
+			return ErrStorageClass.New("invalid placement %q (%s)", info[1], err)
+		}
+
+		err = parsed.add(StorageClass{
+			Name:      strings.ToUpper(strings.TrimSpace(info[0])),
+			Placement: storj.PlacementConstraint(placement),
+			Product:   strings.TrimSpace(info[2]),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	*classes = parsed
+	return nil
+}
+
+func (classes *StorageClasses) add(class StorageClass) error {
+	if class.Name == "" {
+		return ErrStorageClass.New("name must not be empty")
+	}
+	if class.Product == "" {
+		return ErrStorageClass.New("product of %q must not be empty", class.Name)
+	}
+	for _, existing := range classes.classes {
+		if existing.Name == class.Name {
+			return ErrStorageClass.New("%q is defined more than once", class.Name)
+		}
+		if existing.Placement == class.Placement {
+			return ErrStorageClass.New("%q and %q use the same placement %d", existing.Name, class.Name, class.Placement)
+		}
+	}
+	classes.classes = append(classes.classes, class)
+	return nil
+}
+
+// Enabled returns whether any storage class is configured. Without storage
+// classes buckets are created with the placement of the project.
+func (classes StorageClasses) Enabled() bool { return len(classes.classes) > 0 }
+
+// All returns the storage classes sorted by name.
+func (classes StorageClasses) All() []StorageClass {
+	all := append([]StorageClass(nil), classes.classes...)
+	sort.Slice(all, func(i, k int) bool { return all[i].Name < all[k].Name })
+	return all
+}
+
+// ByName returns the storage class with the specified name. The name is case insensitive,
+// as in the S3 x-amz-storage-class header.
+func (classes StorageClasses) ByName(name string) (StorageClass, bool) {
+	name = strings.ToUpper(name)
+	for _, class := range classes.classes {
+		if class.Name == name {
+			return class, true
+		}
+	}
+	return StorageClass{}, false
+}
+
+// ByPlacement returns the storage class which maps to the placement.
+func (classes StorageClasses) ByPlacement(placement storj.PlacementConstraint) (StorageClass, bool) {
+	for _, class := range classes.classes {
+		if class.Placement == placement {
+			return class, true
+		}
+	}
+	return StorageClass{}, false
+}
+
+// ForBucket returns the storage class of a new bucket in a project with the specified
+// default placement. An empty name selects the class of the project placement.
+//
+// Projects pinned to a non-default placement can only use the storage class of
+// that placement, since their data must not leave it.
+func (classes StorageClasses) ForBucket(name string, projectPlacement storj.PlacementConstraint) (StorageClass, error) {
+	if name == "" {
+		class, ok := classes.ByPlacement(projectPlacement)
+		if !ok {
+			return StorageClass{}, ErrStorageClass.New("no storage class is available for placement %d", projectPlacement)
+		}
+		return class, nil
+	}
+
+	class, ok := classes.ByName(name)
+	if !ok {
+		return StorageClass{}, ErrStorageClass.New("unknown storage class %q", name)
+	}
+	if projectPlacement != storj.DefaultPlacement && class.Placement != projectPlacement {
+		return StorageClass{}, ErrStorageClass.New("storage class %q is not available for the project", class.Name)
+	}
+	return class, nil
+}
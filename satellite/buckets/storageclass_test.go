@@ -0,0 +1,71 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package buckets_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/storj"
+	"storj.io/storj/satellite/accounting"
+	"storj.io/storj/satellite/buckets"
+)
+
+func TestStorageClasses(t *testing.T) {
+	var classes buckets.StorageClasses
+	require.False(t, classes.Enabled())
+
+	for _, invalid := range []string{
+		"standard",
+		"standard:x:product",
+		"standard:0:",
+		"standard:0:a;standard:1:b",
+		"standard:0:a;archive:0:b",
+	} {
+		require.Error(t, classes.Set(invalid), invalid)
+	}
+
+	require.NoError(t, classes.Set("standard:0:standard; glacier:10:archive;eu:1:regional"))
+	require.True(t, classes.Enabled())
+	require.Equal(t, "STANDARD:0:standard;GLACIER:10:archive;EU:1:regional", classes.String())
+
+	class, ok := classes.ByName("glacier")
+	require.True(t, ok)
+	require.Equal(t, buckets.StorageClass{Name: "GLACIER", Placement: 10, Product: "archive"}, class)
+
+	class, err := classes.ForBucket("", storj.DefaultPlacement)
+	require.NoError(t, err)
+	require.Equal(t, "STANDARD", class.Name)
+
+	class, err = classes.ForBucket("GLACIER", storj.DefaultPlacement)
+	require.NoError(t, err)
+	require.Equal(t, storj.PlacementConstraint(10), class.Placement)
+
+	// region pinned projects can't leave their placement.
+	_, err = classes.ForBucket("GLACIER", 1)
+	require.True(t, buckets.ErrStorageClass.Has(err))
+
+	class, err = classes.ForBucket("", 1)
+	require.NoError(t, err)
+	require.Equal(t, "EU", class.Name)
+
+	_, err = classes.ForBucket("", 2)
+	require.True(t, buckets.ErrStorageClass.Has(err))
+
+	_, err = classes.ForBucket("unknown", storj.DefaultPlacement)
+	require.True(t, buckets.ErrStorageClass.Has(err))
+
+	usage := accounting.UsageByStorageClass(classes, []accounting.BucketUsage{
+		{BucketName: "a", DefaultPlacement: 0, Storage: 1, Egress: 2, ObjectCount: 3, SegmentCount: 4},
+		{BucketName: "b", DefaultPlacement: 0, Storage: 1, Egress: 2, ObjectCount: 3, SegmentCount: 4},
+		{BucketName: "c", DefaultPlacement: 10, Storage: 5},
+		{BucketName: "d", DefaultPlacement: 7, Storage: 6},
+	})
+	require.Equal(t, []accounting.StorageClassUsage{
+		{StorageClass: "GLACIER", Product: "archive", Storage: 5},
+		{StorageClass: "STANDARD", Product: "standard", Storage: 2, Egress: 4, ObjectCount: 6, SegmentCount: 8},
+		{StorageClass: accounting.UnknownStorageClass, Storage: 6},
+	}, usage)
+}
@@ -0,0 +1,44 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console
+
+import (
+	"context"
+	"time"
+
+	"storj.io/common/uuid"
+)
+
+// BillingContacts exposes methods to manage the billing contacts of users.
+//
+// architecture: Database
+type BillingContacts interface {
+	// Insert adds a billing contact to a user.
+	Insert(ctx context.Context, contact BillingContact) (*BillingContact, error)
+	// Get returns the billing contact with the given ID.
+	Get(ctx context.Context, id uuid.UUID) (*BillingContact, error)
+	// ListByUserID returns the billing contacts of a user ordered by email.
+	ListByUserID(ctx context.Context, userID uuid.UUID) ([]BillingContact, error)
+	// ListByEmail returns the billing contacts of all users with the given email.
+	ListByEmail(ctx context.Context, email string) ([]BillingContact, error)
+	// UpdateEmail changes the email of a billing contact of a user.
+	UpdateEmail(ctx context.Context, userID, id uuid.UUID, email string) (*BillingContact, error)
+	// Delete removes a billing contact of a user.
+	Delete(ctx context.Context, userID, id uuid.UUID) error
+}
+
+// BillingContact is an email-only identity, which receives the billing notices
+// of an account and can view its invoices without being able to sign in.
+type BillingContact struct {
+	ID        uuid.UUID `json:"id"`
+	UserID    uuid.UUID `json:"-"`
+	Email     string    `json:"email"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// BillingContactView is the restricted billing view of a billing contact.
+type BillingContactView struct {
+	OwnerEmail string              `json:"ownerEmail"`
+	Invoices   *BillingHistoryPage `json:"invoices"`
+}
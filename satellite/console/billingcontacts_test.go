@@ -0,0 +1,97 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console_test
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestBillingContactsDB(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		contacts := db.Console().BillingContacts()
+		userID := testrand.UUID()
+		otherUserID := testrand.UUID()
+
+		list, err := contacts.ListByUserID(ctx, userID)
+		require.NoError(t, err)
+		require.Empty(t, list)
+
+		finance, err := contacts.Insert(ctx, console.BillingContact{
+			ID:     testrand.UUID(),
+			UserID: userID,
+			Email:  "finance@example.test",
+		})
+		require.NoError(t, err)
+		require.False(t, finance.CreatedAt.IsZero())
+
+		_, err = contacts.Insert(ctx, console.BillingContact{
+			ID:     testrand.UUID(),
+			UserID: userID,
+			Email:  "accounting@example.test",
+		})
+		require.NoError(t, err)
+
+		// the same email can't be added twice for the same user.
+		_, err = contacts.Insert(ctx, console.BillingContact{
+			ID:     testrand.UUID(),
+			UserID: userID,
+			Email:  "finance@example.test",
+		})
+		require.Error(t, err)
+
+		// but it can be a billing contact of another user.
+		_, err = contacts.Insert(ctx, console.BillingContact{
+			ID:     testrand.UUID(),
+			UserID: otherUserID,
+			Email:  "finance@example.test",
+		})
+		require.NoError(t, err)
+
+		list, err = contacts.ListByUserID(ctx, userID)
+		require.NoError(t, err)
+		require.Len(t, list, 2)
+		require.Equal(t, "accounting@example.test", list[0].Email)
+		require.Equal(t, "finance@example.test", list[1].Email)
+
+		list, err = contacts.ListByEmail(ctx, "finance@example.test")
+		require.NoError(t, err)
+		require.Len(t, list, 2)
+
+		got, err := contacts.Get(ctx, finance.ID)
+		require.NoError(t, err)
+		require.Equal(t, userID, got.UserID)
+		require.Equal(t, finance.Email, got.Email)
+
+		// another user can't change the contact.
+		_, err = contacts.UpdateEmail(ctx, otherUserID, finance.ID, "billing@example.test")
+		require.ErrorIs(t, err, sql.ErrNoRows)
+
+		updated, err := contacts.UpdateEmail(ctx, userID, finance.ID, "billing@example.test")
+		require.NoError(t, err)
+		require.Equal(t, "billing@example.test", updated.Email)
+		require.Equal(t, userID, updated.UserID)
+
+		// another user can't remove the contact.
+		require.NoError(t, contacts.Delete(ctx, otherUserID, finance.ID))
+		_, err = contacts.Get(ctx, finance.ID)
+		require.NoError(t, err)
+
+		require.NoError(t, contacts.Delete(ctx, userID, finance.ID))
+		_, err = contacts.Get(ctx, finance.ID)
+		require.ErrorIs(t, err, sql.ErrNoRows)
+
+		list, err = contacts.ListByUserID(ctx, userID)
+		require.NoError(t, err)
+		require.Len(t, list, 1)
+	})
+}
@@ -11,6 +11,7 @@ import (
 
 	"storj.io/common/storj"
 	"storj.io/common/uuid"
+	"storj.io/storj/satellite/revocation"
 )
 
 // Config keeps track of core console service configuration parameters.
@@ -35,12 +36,16 @@ type Config struct {
 	ObjectBrowserKeyNamePrefix        string                    `help:"prefix for object browser API key names" default:".storj-web-file-browser-api-key-"`
 	ObjectBrowserKeyLifetime          time.Duration             `help:"duration for which the object browser API key remains valid" default:"72h"`
 	MaxNameCharacters                 int                       `help:"defines the maximum number of characters allowed for names, e.g. user first/last names and company names" default:"100"`
+	MaxTrustedDevices                 int                       `help:"maximum number of trusted devices remembered per user, the least recently used ones are forgotten first" default:"20"`
+	MaxBillingContacts                int                       `help:"maximum number of billing contacts per user" default:"5"`
+	BillingContactLinkExpiration      time.Duration             `help:"duration that the billing view links sent to billing contacts are valid for" default:"720h"`
 	BillingInformationTabEnabled      bool                      `help:"indicates if billing information tab should be enabled" default:"false"`
 	SatelliteManagedEncryptionEnabled bool                      `help:"indicates whether satellite managed encryption projects can be created." default:"false"`
 	UsageLimits                       UsageLimitsConfig
 	Captcha                           CaptchaConfig
 	Session                           SessionConfig
 	AccountFreeze                     AccountFreezeConfig
+	RevocationPropagation             revocation.PropagationConfig
 }
 
 // CaptchaConfig contains configurations for login/registration captcha system.
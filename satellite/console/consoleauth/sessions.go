@@ -14,6 +14,8 @@ import (
 type WebappSessions interface {
 	// Create creates a webapp session and returns the session info.
 	Create(ctx context.Context, id, userID uuid.UUID, ip, userAgent string, expires time.Time) (WebappSession, error)
+	// CreateReadOnly creates a read-only webapp session and returns the session info.
+	CreateReadOnly(ctx context.Context, id, userID uuid.UUID, ip, userAgent string, expires time.Time) (WebappSession, error)
 	// GetBySessionID gets the session info from the session ID.
 	GetBySessionID(ctx context.Context, sessionID uuid.UUID) (WebappSession, error)
 	// GetAllByUserID gets all webapp sessions with userID.
@@ -28,6 +30,14 @@ type WebappSessions interface {
 	DeleteExpired(ctx context.Context, now time.Time, asOfSystemTimeInterval time.Duration, pageSize int) error
 }
 
+const (
+	// SessionStatusDefault is the status of a regular webapp session.
+	SessionStatusDefault = 0
+	// SessionStatusReadOnly is the status of a webapp session, which is only
+	// allowed to read, e.g. one issued to an admin impersonating the user.
+	SessionStatusReadOnly = 1
+)
+
 // WebappSession represents a session on the satellite web app.
 type WebappSession struct {
 	ID        uuid.UUID
@@ -133,5 +133,5 @@ func (a *Analytics) PageViewTriggered(w http.ResponseWriter, r *http.Request) {
 
 // serveJSONError writes JSON error to response output stream.
 func (a *Analytics) serveJSONError(ctx context.Context, w http.ResponseWriter, status int, err error) {
-	web.ServeJSONError(ctx, a.log, w, status, err)
+	serveCodedJSONError(ctx, a.log, w, status, err, err.Error())
 }
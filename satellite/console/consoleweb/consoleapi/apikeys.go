@@ -15,7 +15,6 @@ import (
 	"go.uber.org/zap"
 
 	"storj.io/common/uuid"
-	"storj.io/storj/private/web"
 	"storj.io/storj/satellite/console"
 )
 
@@ -312,7 +311,48 @@ func (keys *APIKeys) DeleteByNameAndProjectID(w http.ResponseWriter, r *http.Req
 	}
 }
 
+// RevokeEverywhere deletes an API key and reports whether the edge services
+// have invalidated the credentials derived from it.
+func (keys *APIKeys) RevokeEverywhere(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	idParam, ok := mux.Vars(r)["id"]
+	if !ok {
+		keys.serveJSONError(ctx, w, http.StatusBadRequest, errs.New("missing id route param"))
+		return
+	}
+
+	keyID, err := uuid.FromString(idParam)
+	if err != nil {
+		keys.serveJSONError(ctx, w, http.StatusBadRequest, err)
+		return
+	}
+
+	result, err := keys.service.RevokeAPIKeyEverywhere(ctx, keyID)
+	if err != nil {
+		switch {
+		case console.ErrUnauthorized.Has(err):
+			keys.serveJSONError(ctx, w, http.StatusUnauthorized, err)
+		case console.ErrForbidden.Has(err):
+			keys.serveJSONError(ctx, w, http.StatusForbidden, err)
+		case console.ErrNoAPIKey.Has(err):
+			keys.serveJSONError(ctx, w, http.StatusNotFound, err)
+		default:
+			keys.serveJSONError(ctx, w, http.StatusInternalServerError, err)
+		}
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	err = json.NewEncoder(w).Encode(result)
+	if err != nil {
+		keys.log.Error("failed to write json api key revocation response", zap.Error(ErrAPIKeysAPI.Wrap(err)))
+	}
+}
+
 // serveJSONError writes JSON error to response output stream.
 func (keys *APIKeys) serveJSONError(ctx context.Context, w http.ResponseWriter, status int, err error) {
-	web.ServeJSONError(ctx, keys.log, w, status, err)
+	serveCodedJSONError(ctx, keys.log, w, status, err, err.Error())
 }
@@ -57,6 +57,10 @@ type Auth struct {
 	analytics                 *analytics.Service
 	mailService               *mailservice.Service
 	cookieAuth                *consolewebauth.CookieAuth
+
+	// PartnerEmailLimiter throttles the emails sent to a single address
+	// through the partner signup API.
+	PartnerEmailLimiter *web.RateLimiter
 }
 
 // NewAuth is a constructor for api auth controller.
@@ -1261,6 +1265,55 @@ func (a *Auth) GetUserSettings(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// GetTrustedDevices returns the devices the user has logged in from.
+func (a *Auth) GetTrustedDevices(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	devices, err := a.service.GetTrustedDevices(ctx)
+	if err != nil {
+		a.serveJSONError(ctx, w, err)
+		return
+	}
+
+	if devices == nil {
+		devices = []console.TrustedDevice{}
+	}
+
+	err = json.NewEncoder(w).Encode(devices)
+	if err != nil {
+		a.log.Error("could not encode trusted devices", zap.Error(ErrAuthAPI.Wrap(err)))
+	}
+}
+
+// RemoveTrustedDevice removes one of the devices the user has logged in from.
+func (a *Auth) RemoveTrustedDevice(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	idParam, ok := mux.Vars(r)["id"]
+	if !ok {
+		a.serveJSONError(ctx, w, console.ErrValidation.New("missing device id route param"))
+		return
+	}
+
+	id, err := uuid.FromString(idParam)
+	if err != nil {
+		a.serveJSONError(ctx, w, console.ErrValidation.New("invalid device id"))
+		return
+	}
+
+	err = a.service.RemoveTrustedDevice(ctx, id)
+	if err != nil {
+		a.serveJSONError(ctx, w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
 // SetOnboardingStatus updates a user's onboarding status.
 func (a *Auth) SetOnboardingStatus(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -1303,6 +1356,7 @@ func (a *Auth) SetUserSettings(w http.ResponseWriter, r *http.Request) {
 		OnboardingStep   *string                  `json:"onboardingStep"`
 		SessionDuration  *int64                   `json:"sessionDuration"`
 		NoticeDismissal  *console.NoticeDismissal `json:"noticeDismissal"`
+		LoginAlerts      *bool                    `json:"loginAlerts"`
 	}
 
 	err = json.NewDecoder(r.Body).Decode(&updateInfo)
@@ -1327,6 +1381,7 @@ func (a *Auth) SetUserSettings(w http.ResponseWriter, r *http.Request) {
 		PassphrasePrompt: updateInfo.PassphrasePrompt,
 		SessionDuration:  newDuration,
 		NoticeDismissal:  updateInfo.NoticeDismissal,
+		LoginAlerts:      updateInfo.LoginAlerts,
 	})
 	if err != nil {
 		a.serveJSONError(ctx, w, err)
@@ -1360,7 +1415,7 @@ func (a *Auth) RequestLimitIncrease(w http.ResponseWriter, r *http.Request) {
 // serveJSONError writes JSON error to response output stream.
 func (a *Auth) serveJSONError(ctx context.Context, w http.ResponseWriter, err error) {
 	status := a.getStatusCode(err)
-	web.ServeCustomJSONError(ctx, a.log, w, status, err, a.getUserErrorMessage(err))
+	serveCodedJSONError(ctx, a.log, w, status, err, a.getUserErrorMessage(err))
 }
 
 // getStatusCode returns http.StatusCode depends on console error class.
@@ -10,13 +10,17 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/gorilla/mux"
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
 
+	"storj.io/common/storj"
 	"storj.io/common/uuid"
-	"storj.io/storj/private/web"
 	"storj.io/storj/satellite/accounting"
+	"storj.io/storj/satellite/buckets"
 	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/bucketinventory"
 )
 
 const (
@@ -33,13 +37,15 @@ var (
 type Buckets struct {
 	log     *zap.Logger
 	service *console.Service
+	nodeURL storj.NodeURL
 }
 
 // NewBuckets is a constructor for api buckets controller.
-func NewBuckets(log *zap.Logger, service *console.Service) *Buckets {
+func NewBuckets(log *zap.Logger, service *console.Service, nodeURL storj.NodeURL) *Buckets {
 	return &Buckets{
 		log:     log,
 		service: service,
+		nodeURL: nodeURL,
 	}
 }
 
@@ -210,7 +216,340 @@ func (b *Buckets) GetBucketTotals(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// GetInventory returns the inventory report configuration of a bucket.
+func (b *Buckets) GetInventory(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	projectID, bucketName, ok := b.bucketParams(ctx, w, r)
+	if !ok {
+		return
+	}
+
+	config, err := b.service.GetBucketInventory(ctx, projectID, bucketName)
+	if err != nil {
+		b.serveInventoryError(ctx, w, err)
+		return
+	}
+	if config == nil {
+		b.serveJSONError(ctx, w, http.StatusNotFound, errs.New("bucket %q has no inventory configuration", bucketName))
+		return
+	}
+
+	err = json.NewEncoder(w).Encode(config)
+	if err != nil {
+		b.log.Error("failed to write json bucket inventory response", zap.Error(ErrBucketsAPI.Wrap(err)))
+	}
+}
+
+// SetInventory replaces the inventory report configuration of a bucket. The
+// response contains the passphrase which encrypts the reports.
+func (b *Buckets) SetInventory(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	projectID, bucketName, ok := b.bucketParams(ctx, w, r)
+	if !ok {
+		return
+	}
+
+	var config buckets.InventoryConfiguration
+	if err = json.NewDecoder(r.Body).Decode(&config); err != nil {
+		b.serveJSONError(ctx, w, http.StatusBadRequest, err)
+		return
+	}
+
+	stored, err := b.service.SetBucketInventory(ctx, projectID, bucketName, config)
+	if err != nil {
+		b.serveInventoryError(ctx, w, err)
+		return
+	}
+
+	err = json.NewEncoder(w).Encode(stored)
+	if err != nil {
+		b.log.Error("failed to write json bucket inventory response", zap.Error(ErrBucketsAPI.Wrap(err)))
+	}
+}
+
+// DeleteInventory stops the inventory reports of a bucket.
+func (b *Buckets) DeleteInventory(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	projectID, bucketName, ok := b.bucketParams(ctx, w, r)
+	if !ok {
+		return
+	}
+
+	err = b.service.DeleteBucketInventory(ctx, projectID, bucketName)
+	if err != nil {
+		b.serveInventoryError(ctx, w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// DiffInventory compares two inventory reports of a bucket, identified by the
+// creation times in the from and to query parameters.
+func (b *Buckets) DiffInventory(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	projectID, bucketName, ok := b.bucketParams(ctx, w, r)
+	if !ok {
+		return
+	}
+
+	var times [2]time.Time
+	for i, param := range []string{"from", "to"} {
+		value := r.URL.Query().Get(param)
+		if value == "" {
+			b.serveJSONError(ctx, w, http.StatusBadRequest, errs.New(missingParamErrMsg, param))
+			return
+		}
+		times[i], err = bucketinventory.ParseReportTime(value)
+		if err != nil {
+			b.serveJSONError(ctx, w, http.StatusBadRequest, errs.New(invalidParamErrMsg, value, param, err))
+			return
+		}
+	}
+
+	limit := 0
+	if limitString := r.URL.Query().Get("limit"); limitString != "" {
+		limit, err = strconv.Atoi(limitString)
+		if err != nil {
+			b.serveJSONError(ctx, w, http.StatusBadRequest, errs.New(invalidParamErrMsg, limitString, "limit", err))
+			return
+		}
+	}
+
+	diff, err := b.service.DiffBucketInventory(ctx, b.nodeURL, projectID, bucketName, times[0], times[1], limit)
+	if err != nil {
+		b.serveInventoryError(ctx, w, err)
+		return
+	}
+
+	err = json.NewEncoder(w).Encode(diff)
+	if err != nil {
+		b.log.Error("failed to write json bucket inventory diff response", zap.Error(ErrBucketsAPI.Wrap(err)))
+	}
+}
+
+// bucketParams parses the project ID and bucket name query parameters of the single bucket endpoints.
+func (b *Buckets) bucketParams(ctx context.Context, w http.ResponseWriter, r *http.Request) (projectID uuid.UUID, bucketName string, ok bool) {
+	projectIDString := r.URL.Query().Get("projectID")
+	if projectIDString == "" {
+		b.serveJSONError(ctx, w, http.StatusBadRequest, errs.New(missingParamErrMsg, "projectID"))
+		return uuid.UUID{}, "", false
+	}
+	projectID, err := uuid.FromString(projectIDString)
+	if err != nil {
+		b.serveJSONError(ctx, w, http.StatusBadRequest, errs.New(invalidParamErrMsg, projectIDString, "projectID", err))
+		return uuid.UUID{}, "", false
+	}
+
+	bucketName = r.URL.Query().Get("bucket")
+	if bucketName == "" {
+		b.serveJSONError(ctx, w, http.StatusBadRequest, errs.New(missingParamErrMsg, "bucket"))
+		return uuid.UUID{}, "", false
+	}
+
+	return projectID, bucketName, true
+}
+
+func (b *Buckets) serveInventoryError(ctx context.Context, w http.ResponseWriter, err error) {
+	switch {
+	case console.ErrValidation.Has(err):
+		b.serveJSONError(ctx, w, http.StatusBadRequest, err)
+	case console.ErrUnauthorized.Has(err):
+		b.serveJSONError(ctx, w, http.StatusUnauthorized, err)
+	case console.ErrForbidden.Has(err), bucketinventory.ErrReportAccessDenied.Has(err):
+		b.serveJSONError(ctx, w, http.StatusForbidden, err)
+	case buckets.ErrBucketNotFound.Has(err), bucketinventory.ErrReportNotFound.Has(err):
+		b.serveJSONError(ctx, w, http.StatusNotFound, err)
+	default:
+		b.serveJSONError(ctx, w, http.StatusInternalServerError, err)
+	}
+}
+
+// objectPinVersion identifies a pinned object version. The key is the
+// encrypted object key, which is base64 encoded in JSON.
+type objectPinVersion struct {
+	EncryptedObjectKey []byte `json:"encryptedObjectKey"`
+	Version            int64  `json:"version"`
+}
+
+type objectPinResponse struct {
+	Name         string             `json:"name"`
+	CreatedAt    time.Time          `json:"createdAt"`
+	VersionCount int64              `json:"versionCount"`
+	Versions     []objectPinVersion `json:"versions,omitempty"`
+}
+
+func newObjectPinResponse(pin metabase.ObjectPin) objectPinResponse {
+	response := objectPinResponse{
+		Name:         pin.Name,
+		CreatedAt:    pin.CreatedAt,
+		VersionCount: pin.VersionCount,
+	}
+	for _, version := range pin.Versions {
+		response.Versions = append(response.Versions, objectPinVersion{
+			EncryptedObjectKey: []byte(version.ObjectKey),
+			Version:            int64(version.Version),
+		})
+	}
+	return response
+}
+
+// ListObjectPins returns the pins of a bucket.
+func (b *Buckets) ListObjectPins(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	projectID, bucketName, ok := b.bucketParams(ctx, w, r)
+	if !ok {
+		return
+	}
+
+	pins, err := b.service.ListObjectPins(ctx, projectID, bucketName)
+	if err != nil {
+		b.serveObjectPinError(ctx, w, err)
+		return
+	}
+
+	response := make([]objectPinResponse, 0, len(pins))
+	for _, pin := range pins {
+		response = append(response, newObjectPinResponse(pin))
+	}
+
+	err = json.NewEncoder(w).Encode(response)
+	if err != nil {
+		b.log.Error("failed to write json object pins response", zap.Error(ErrBucketsAPI.Wrap(err)))
+	}
+}
+
+// CreateObjectPin pins object versions of a bucket. The pinned versions can't
+// be deleted or expire until the pin is released.
+func (b *Buckets) CreateObjectPin(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	projectID, bucketName, ok := b.bucketParams(ctx, w, r)
+	if !ok {
+		return
+	}
+
+	var request struct {
+		Name     string             `json:"name"`
+		Versions []objectPinVersion `json:"versions"`
+	}
+	if err = json.NewDecoder(r.Body).Decode(&request); err != nil {
+		b.serveJSONError(ctx, w, http.StatusBadRequest, err)
+		return
+	}
+
+	versions := make([]metabase.ObjectPinVersion, 0, len(request.Versions))
+	for _, version := range request.Versions {
+		versions = append(versions, metabase.ObjectPinVersion{
+			ObjectKey: metabase.ObjectKey(version.EncryptedObjectKey),
+			Version:   metabase.Version(version.Version),
+		})
+	}
+
+	pin, err := b.service.CreateObjectPin(ctx, projectID, bucketName, request.Name, versions)
+	if err != nil {
+		b.serveObjectPinError(ctx, w, err)
+		return
+	}
+
+	err = json.NewEncoder(w).Encode(newObjectPinResponse(pin))
+	if err != nil {
+		b.log.Error("failed to write json object pin response", zap.Error(ErrBucketsAPI.Wrap(err)))
+	}
+}
+
+// GetObjectPin returns a pin of a bucket together with its versions.
+func (b *Buckets) GetObjectPin(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	projectID, bucketName, ok := b.bucketParams(ctx, w, r)
+	if !ok {
+		return
+	}
+
+	pin, err := b.service.GetObjectPin(ctx, projectID, bucketName, mux.Vars(r)["name"])
+	if err != nil {
+		b.serveObjectPinError(ctx, w, err)
+		return
+	}
+
+	err = json.NewEncoder(w).Encode(newObjectPinResponse(pin))
+	if err != nil {
+		b.log.Error("failed to write json object pin response", zap.Error(ErrBucketsAPI.Wrap(err)))
+	}
+}
+
+// ReleaseObjectPin releases a pin of a bucket.
+func (b *Buckets) ReleaseObjectPin(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	projectID, bucketName, ok := b.bucketParams(ctx, w, r)
+	if !ok {
+		return
+	}
+
+	err = b.service.ReleaseObjectPin(ctx, projectID, bucketName, mux.Vars(r)["name"])
+	if err != nil {
+		b.serveObjectPinError(ctx, w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (b *Buckets) serveObjectPinError(ctx context.Context, w http.ResponseWriter, err error) {
+	switch {
+	case metabase.ErrInvalidRequest.Has(err):
+		b.serveJSONError(ctx, w, http.StatusBadRequest, err)
+	case console.ErrUnauthorized.Has(err):
+		b.serveJSONError(ctx, w, http.StatusUnauthorized, err)
+	case metabase.ErrObjectNotFound.Has(err), metabase.ErrObjectPinNotFound.Has(err):
+		b.serveJSONError(ctx, w, http.StatusNotFound, err)
+	case metabase.ErrObjectPinExists.Has(err):
+		b.serveJSONError(ctx, w, http.StatusConflict, err)
+	default:
+		b.serveJSONError(ctx, w, http.StatusInternalServerError, err)
+	}
+}
+
 // serveJSONError writes JSON error to response output stream.
 func (b *Buckets) serveJSONError(ctx context.Context, w http.ResponseWriter, status int, err error) {
-	web.ServeJSONError(ctx, b.log, w, status, err)
+	serveCodedJSONError(ctx, b.log, w, status, err, err.Error())
 }
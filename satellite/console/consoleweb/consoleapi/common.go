@@ -5,9 +5,14 @@ package consoleapi
 
 import (
 	"context"
+	"net/http"
 	"sync"
 
 	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/storj/private/web"
+	"storj.io/storj/satellite/errcatalog"
 )
 
 var (
@@ -63,3 +68,11 @@ func (c *ContextChannel) initialize() {
 	c.channel = make(chan interface{})
 	c.initialized = true
 }
+
+// serveCodedJSONError writes a JSON error including the error catalog code, which clients
+// can use to tell the errors apart.
+func serveCodedJSONError(ctx context.Context, log *zap.Logger, w http.ResponseWriter, status int, err error, msg string) {
+	entry := errcatalog.LookupHTTP(err, status)
+	errcatalog.Record("console", entry.Code)
+	web.ServeCodedJSONError(ctx, log, w, status, string(entry.Code), err, msg)
+}
@@ -0,0 +1,196 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoleapi
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/console"
+)
+
+const (
+	// LinksharingBrandingTimestampHeader is the header containing the unix time at which
+	// the linksharing branding response was signed.
+	LinksharingBrandingTimestampHeader = "X-Storj-Timestamp"
+	// LinksharingBrandingSignatureHeader is the header containing the hex encoded HMAC-SHA256
+	// signature of the linksharing branding response.
+	LinksharingBrandingSignatureHeader = "X-Storj-Signature"
+)
+
+// ErrLinksharingBrandingAPI - console linksharing branding api error type.
+var ErrLinksharingBrandingAPI = errs.Class("consoleapi linksharing branding")
+
+// LinksharingBranding is an api controller that exposes linksharing presentation settings.
+type LinksharingBranding struct {
+	log           *zap.Logger
+	service       *console.Service
+	signingSecret []byte
+	nowFn         func() time.Time
+}
+
+// NewLinksharingBranding is a constructor for linksharing branding controller.
+func NewLinksharingBranding(log *zap.Logger, service *console.Service, signingSecret string) *LinksharingBranding {
+	return &LinksharingBranding{
+		log:           log,
+		service:       service,
+		signingSecret: []byte(signingSecret),
+		nowFn:         time.Now,
+	}
+}
+
+// Get returns the linksharing branding settings of a project.
+func (b *LinksharingBranding) Get(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	projectID, err := uuid.FromString(mux.Vars(r)["id"])
+	if err != nil {
+		b.serveJSONError(ctx, w, http.StatusBadRequest, err)
+		return
+	}
+
+	branding, err := b.service.GetLinksharingBranding(ctx, projectID)
+	if err != nil {
+		b.serveServiceError(ctx, w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	err = json.NewEncoder(w).Encode(branding)
+	if err != nil {
+		b.log.Error("could not encode linksharing branding", zap.Error(ErrLinksharingBrandingAPI.Wrap(err)))
+	}
+}
+
+// Update updates the linksharing branding settings of a project.
+func (b *LinksharingBranding) Update(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	projectID, err := uuid.FromString(mux.Vars(r)["id"])
+	if err != nil {
+		b.serveJSONError(ctx, w, http.StatusBadRequest, err)
+		return
+	}
+
+	var branding console.LinksharingBranding
+	if err = json.NewDecoder(r.Body).Decode(&branding); err != nil {
+		b.serveJSONError(ctx, w, http.StatusBadRequest, err)
+		return
+	}
+
+	updated, err := b.service.UpdateLinksharingBranding(ctx, projectID, branding)
+	if err != nil {
+		b.serveServiceError(ctx, w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	err = json.NewEncoder(w).Encode(updated)
+	if err != nil {
+		b.log.Error("could not encode linksharing branding", zap.Error(ErrLinksharingBrandingAPI.Wrap(err)))
+	}
+}
+
+// Delete resets the linksharing branding settings of a project.
+func (b *LinksharingBranding) Delete(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	projectID, err := uuid.FromString(mux.Vars(r)["id"])
+	if err != nil {
+		b.serveJSONError(ctx, w, http.StatusBadRequest, err)
+		return
+	}
+
+	err = b.service.DeleteLinksharingBranding(ctx, projectID)
+	if err != nil {
+		b.serveServiceError(ctx, w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// GetSigned returns the linksharing branding settings of a project identified by its public ID.
+// The response is signed with the shared secret, so the linksharing service can verify that
+// the settings originate from the satellite.
+func (b *LinksharingBranding) GetSigned(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	publicID, err := uuid.FromString(mux.Vars(r)["publicID"])
+	if err != nil {
+		b.serveJSONError(ctx, w, http.StatusBadRequest, err)
+		return
+	}
+
+	branding, err := b.service.GetLinksharingBrandingNoAuth(ctx, publicID)
+	if err != nil {
+		b.serveServiceError(ctx, w, err)
+		return
+	}
+
+	body, err := json.Marshal(branding)
+	if err != nil {
+		b.serveJSONError(ctx, w, http.StatusInternalServerError, err)
+		return
+	}
+
+	timestamp := strconv.FormatInt(b.nowFn().Unix(), 10)
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set(LinksharingBrandingTimestampHeader, timestamp)
+	w.Header().Set(LinksharingBrandingSignatureHeader, SignLinksharingBranding(b.signingSecret, timestamp, body))
+	_, err = w.Write(body)
+	if err != nil {
+		b.log.Error("could not write linksharing branding", zap.Error(ErrLinksharingBrandingAPI.Wrap(err)))
+	}
+}
+
+// SignLinksharingBranding returns the hex encoded HMAC-SHA256 signature of a linksharing
+// branding response body signed at the specified unix timestamp.
+func SignLinksharingBranding(secret []byte, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	_, _ = mac.Write([]byte(timestamp))
+	_, _ = mac.Write([]byte("."))
+	_, _ = mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func (b *LinksharingBranding) serveServiceError(ctx context.Context, w http.ResponseWriter, err error) {
+	switch {
+	case console.ErrValidation.Has(err):
+		b.serveJSONError(ctx, w, http.StatusBadRequest, err)
+	case console.ErrUnauthorized.Has(err), console.ErrNoMembership.Has(err):
+		b.serveJSONError(ctx, w, http.StatusUnauthorized, err)
+	case errors.Is(err, sql.ErrNoRows):
+		b.serveJSONError(ctx, w, http.StatusNotFound, err)
+	default:
+		b.serveJSONError(ctx, w, http.StatusInternalServerError, err)
+	}
+}
+
+// serveJSONError writes JSON error to response output stream.
+func (b *LinksharingBranding) serveJSONError(ctx context.Context, w http.ResponseWriter, status int, err error) {
+	serveCodedJSONError(ctx, b.log, w, status, err, err.Error())
+}
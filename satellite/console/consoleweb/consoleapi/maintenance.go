@@ -0,0 +1,68 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoleapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/storj/private/web"
+	"storj.io/storj/satellite/maintenance"
+)
+
+// ErrMaintenanceAPI - console maintenance api error type.
+var ErrMaintenanceAPI = errs.Class("consoleapi maintenance")
+
+// Maintenance is an api controller that exposes scheduled maintenance announcements.
+type Maintenance struct {
+	log     *zap.Logger
+	service *maintenance.Service
+}
+
+// NewMaintenance is a constructor for maintenance controller.
+func NewMaintenance(log *zap.Logger, service *maintenance.Service) *Maintenance {
+	return &Maintenance{
+		log:     log,
+		service: service,
+	}
+}
+
+// MaintenanceWindow is a maintenance announcement shown in the satellite console.
+type MaintenanceWindow struct {
+	StartsAt time.Time `json:"startsAt"`
+	EndsAt   time.Time `json:"endsAt"`
+	Message  string    `json:"message"`
+}
+
+// GetActive returns maintenance windows in progress which affect the satellite console.
+func (m *Maintenance) GetActive(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	windows, err := m.service.Active(ctx, maintenance.ComponentConsole)
+	if err != nil {
+		web.ServeJSONError(ctx, m.log, w, http.StatusInternalServerError, err)
+		return
+	}
+
+	active := make([]MaintenanceWindow, 0, len(windows))
+	for _, window := range windows {
+		active = append(active, MaintenanceWindow{
+			StartsAt: window.StartsAt,
+			EndsAt:   window.EndsAt,
+			Message:  window.Message,
+		})
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	err = json.NewEncoder(w).Encode(active)
+	if err != nil {
+		m.log.Error("could not encode maintenance windows", zap.Error(ErrMaintenanceAPI.Wrap(err)))
+	}
+}
@@ -0,0 +1,200 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoleapi
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/storj/private/post"
+	"storj.io/storj/private/web"
+	"storj.io/storj/satellite/analytics"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/console/consoleweb/consoleapi/utils"
+)
+
+type partnerContextKey struct{}
+
+// WithPartner returns a context carrying the partner, which was authenticated
+// by its public signup API key.
+func WithPartner(ctx context.Context, partner string) context.Context {
+	return context.WithValue(ctx, partnerContextKey{}, partner)
+}
+
+// GetPartner returns the partner authenticated by its public signup API key.
+func GetPartner(ctx context.Context) (string, bool) {
+	partner, ok := ctx.Value(partnerContextKey{}).(string)
+	return partner, ok && partner != ""
+}
+
+// PartnerRegister creates a new user account on behalf of a partner portal.
+//
+// The partner is attributed as the user agent of the account, so partner
+// specific behavior, e.g. VarPartners, applies to the user, and the referral
+// is stored with the account. The same captcha validation as for the UI
+// signup applies and the user has to activate the account through the
+// activation email.
+func (a *Auth) PartnerRegister(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	partner, ok := GetPartner(ctx)
+	if !ok {
+		a.serveJSONError(ctx, w, console.ErrUnauthorized.New("partner API key missing"))
+		return
+	}
+
+	var registerData struct {
+		FullName        string `json:"fullName"`
+		Email           string `json:"email"`
+		Password        string `json:"password"`
+		CompanyName     string `json:"companyName"`
+		CaptchaResponse string `json:"captchaResponse"`
+		Referral        string `json:"referral"`
+	}
+
+	err = json.NewDecoder(r.Body).Decode(&registerData)
+	if err != nil {
+		a.serveJSONError(ctx, w, console.ErrValidation.Wrap(err))
+		return
+	}
+
+	registerData.Email = strings.TrimSpace(registerData.Email)
+	if !utils.ValidateEmail(registerData.Email) {
+		a.serveJSONError(ctx, w, console.ErrValidation.Wrap(errs.New("Invalid email.")))
+		return
+	}
+
+	if a.badPasswords != nil {
+		if _, exists := a.badPasswords[registerData.Password]; exists {
+			a.serveJSONError(ctx, w, console.ErrValidation.Wrap(errs.New("The password is on a list of insecure or breached passwords.")))
+			return
+		}
+	}
+
+	if len([]rune(registerData.Referral)) > 100 {
+		a.serveJSONError(ctx, w, console.ErrValidation.Wrap(errs.New("Referral must be less than or equal to 100 characters")))
+		return
+	}
+
+	verified, unverified, err := a.service.GetUserByEmailWithUnverified(ctx, registerData.Email)
+	if err != nil && !console.ErrEmailNotFound.Has(err) {
+		a.serveJSONError(ctx, w, err)
+		return
+	}
+
+	// the response doesn't reveal whether the account exists, the owner of
+	// the email address is notified instead. The notifications are throttled
+	// per address, so a partner can't flood an inbox by retrying a signup.
+	if verified != nil {
+		if !a.allowPartnerEmail(verified.Email) {
+			w.WriteHeader(http.StatusAccepted)
+			return
+		}
+		a.mailService.SendRenderedAsync(
+			ctx,
+			[]post.Address{{Address: verified.Email}},
+			&console.AccountAlreadyExistsEmail{
+				Origin:            a.ExternalAddress,
+				SatelliteName:     a.SatelliteName,
+				SignInLink:        a.ExternalAddress + "login",
+				ResetPasswordLink: a.ExternalAddress + "forgot-password",
+				CreateAccountLink: a.ExternalAddress + "signup",
+			},
+		)
+		w.WriteHeader(http.StatusAccepted)
+		return
+	}
+
+	var user *console.User
+	if len(unverified) > 0 {
+		user = &unverified[0]
+	} else {
+		ip, err := web.GetRequestIP(r)
+		if err != nil {
+			a.serveJSONError(ctx, w, err)
+			return
+		}
+
+		user, err = a.service.CreateUser(ctx,
+			console.CreateUser{
+				FullName:        registerData.FullName,
+				Email:           registerData.Email,
+				UserAgent:       []byte(partner),
+				SignupReferral:  registerData.Referral,
+				Password:        registerData.Password,
+				IsProfessional:  registerData.CompanyName != "",
+				CompanyName:     registerData.CompanyName,
+				CaptchaResponse: registerData.CaptchaResponse,
+				IP:              ip,
+			},
+			console.RegistrationSecret{},
+		)
+		if err != nil {
+			if console.ErrEmailUsed.Has(err) {
+				w.WriteHeader(http.StatusAccepted)
+				return
+			}
+			a.serveJSONError(ctx, w, err)
+			return
+		}
+
+		fields := analytics.TrackCreateUserFields{
+			ID:            user.ID,
+			FullName:      user.FullName,
+			Email:         user.Email,
+			Type:          analytics.Personal,
+			Referrer:      registerData.Referral,
+			UserAgent:     partner,
+			SignupCaptcha: user.SignupCaptcha,
+		}
+		if user.IsProfessional {
+			fields.Type = analytics.Professional
+			fields.CompanyName = user.CompanyName
+		}
+		a.analytics.TrackCreateUser(fields)
+
+		a.log.Info("partner registered user",
+			zap.String("partner", partner),
+			zap.String("referral", registerData.Referral),
+			zap.Stringer("user", user.ID))
+	}
+
+	if !a.allowPartnerEmail(user.Email) {
+		w.WriteHeader(http.StatusAccepted)
+		return
+	}
+
+	token, err := a.service.GenerateActivationToken(ctx, user.ID, user.Email)
+	if err != nil {
+		a.serveJSONError(ctx, w, err)
+		return
+	}
+
+	a.mailService.SendRenderedAsync(
+		ctx,
+		[]post.Address{{Address: user.Email}},
+		&console.AccountActivationEmail{
+			ActivationLink: a.ActivateAccountURL + "?token=" + token,
+			Origin:         a.ExternalAddress,
+		},
+	)
+
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// allowPartnerEmail reports whether an email may be sent to the address
+// through the partner signup API.
+func (a *Auth) allowPartnerEmail(email string) bool {
+	if a.PartnerEmailLimiter == nil {
+		return true
+	}
+	return a.PartnerEmailLimiter.Allow(strings.ToLower(email))
+}
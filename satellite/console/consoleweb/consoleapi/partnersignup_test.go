@@ -0,0 +1,119 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoleapi_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/common/testcontext"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/console"
+)
+
+func TestAuth_PartnerRegister(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 0,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Console.RateLimit.Burst = 10
+				config.Console.PartnerSignup.Enabled = true
+				config.Console.PartnerSignup.RateLimit.Burst = 4
+				config.Console.PartnerSignup.EmailInterval = time.Hour
+				require.NoError(t, config.Console.PartnerSignup.APIKeys.Set("partner:secret"))
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+
+		sender := &EmailVerifier{Context: ctx}
+		sat.API.Mail.Service.Sender = sender
+
+		register := func(key, email string) int {
+			jsonBody, err := json.Marshal(map[string]interface{}{
+				"fullName": "Test User",
+				"email":    email,
+				"password": "password",
+				"referral": "spring-campaign",
+			})
+			require.NoError(t, err)
+
+			url := sat.ConsoleURL() + "/api/v0/auth/partner/register"
+			req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewBuffer(jsonBody))
+			require.NoError(t, err)
+			req.Header.Set("Content-Type", "application/json")
+			if key != "" {
+				req.Header.Set("Authorization", "Bearer "+key)
+			}
+
+			result, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			require.NoError(t, result.Body.Close())
+			return result.StatusCode
+		}
+
+		requireNoEmail := func() {
+			waitCtx, cancel := context.WithTimeout(ctx, time.Second)
+			defer cancel()
+			_, err := sender.Data.Get(waitCtx)
+			require.Error(t, err)
+		}
+
+		// requests without a valid partner API key are rejected.
+		require.Equal(t, http.StatusUnauthorized, register("", "new@mail.test"))
+		require.Equal(t, http.StatusUnauthorized, register("invalid", "new@mail.test"))
+
+		_, unverified, err := sat.DB.Console().Users().GetByEmailWithUnverified(ctx, "new@mail.test")
+		require.NoError(t, err)
+		require.Empty(t, unverified)
+
+		// the partner and the referral are stored with the new account.
+		require.Equal(t, http.StatusAccepted, register("secret", "new@mail.test"))
+		body, err := sender.Data.Get(ctx)
+		require.NoError(t, err)
+		require.Contains(t, body, "/activation")
+
+		_, unverified, err = sat.DB.Console().Users().GetByEmailWithUnverified(ctx, "new@mail.test")
+		require.NoError(t, err)
+		require.Len(t, unverified, 1)
+		require.Equal(t, []byte("partner"), unverified[0].UserAgent)
+		require.Equal(t, "spring-campaign", unverified[0].SignupReferral)
+
+		// a repeated signup doesn't create another account or resend the
+		// activation email right away.
+		require.Equal(t, http.StatusAccepted, register("secret", "NEW@mail.test"))
+		requireNoEmail()
+
+		_, unverified, err = sat.DB.Console().Users().GetByEmailWithUnverified(ctx, "new@mail.test")
+		require.NoError(t, err)
+		require.Len(t, unverified, 1)
+
+		// the owner of an existing account is notified once, without the
+		// response revealing that the account exists.
+		existing, err := sat.AddUser(ctx, console.CreateUser{
+			FullName: "Existing User",
+			Email:    "existing@mail.test",
+		}, 1)
+		require.NoError(t, err)
+
+		require.Equal(t, http.StatusAccepted, register("secret", existing.Email))
+		body, err = sender.Data.Get(ctx)
+		require.NoError(t, err)
+		require.Contains(t, body, "/login")
+
+		require.Equal(t, http.StatusAccepted, register("secret", existing.Email))
+		requireNoEmail()
+
+		// the signups of a partner are rate limited.
+		require.Equal(t, http.StatusTooManyRequests, register("secret", "other@mail.test"))
+	})
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
 
+	"storj.io/common/uuid"
 	"storj.io/storj/private/web"
 	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/payments"
@@ -891,7 +892,183 @@ func (p *Payments) RemoveTaxID(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// GetBillingContacts returns the billing contacts of the user.
+func (p *Payments) GetBillingContacts(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	contacts, err := p.service.Payments().GetBillingContacts(ctx)
+	if err != nil {
+		p.serveBillingContactError(ctx, w, err)
+		return
+	}
+
+	if contacts == nil {
+		contacts = []console.BillingContact{}
+	}
+
+	if err = json.NewEncoder(w).Encode(contacts); err != nil {
+		p.log.Error("failed to encode billing contacts", zap.Error(ErrPaymentsAPI.Wrap(err)))
+	}
+}
+
+// AddBillingContact adds a billing contact to the user.
+func (p *Payments) AddBillingContact(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	var request struct {
+		Email string `json:"email"`
+	}
+	if err = json.NewDecoder(r.Body).Decode(&request); err != nil {
+		p.serveJSONError(ctx, w, http.StatusBadRequest, err)
+		return
+	}
+
+	contact, err := p.service.Payments().AddBillingContact(ctx, request.Email)
+	if err != nil {
+		p.serveBillingContactError(ctx, w, err)
+		return
+	}
+
+	if err = json.NewEncoder(w).Encode(contact); err != nil {
+		p.log.Error("failed to encode billing contact", zap.Error(ErrPaymentsAPI.Wrap(err)))
+	}
+}
+
+// UpdateBillingContact changes the email of a billing contact of the user.
+func (p *Payments) UpdateBillingContact(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	id, err := uuid.FromString(mux.Vars(r)["id"])
+	if err != nil {
+		p.serveJSONError(ctx, w, http.StatusBadRequest, errs.New("invalid billing contact id"))
+		return
+	}
+
+	var request struct {
+		Email string `json:"email"`
+	}
+	if err = json.NewDecoder(r.Body).Decode(&request); err != nil {
+		p.serveJSONError(ctx, w, http.StatusBadRequest, err)
+		return
+	}
+
+	contact, err := p.service.Payments().UpdateBillingContact(ctx, id, request.Email)
+	if err != nil {
+		p.serveBillingContactError(ctx, w, err)
+		return
+	}
+
+	if err = json.NewEncoder(w).Encode(contact); err != nil {
+		p.log.Error("failed to encode billing contact", zap.Error(ErrPaymentsAPI.Wrap(err)))
+	}
+}
+
+// RemoveBillingContact removes a billing contact of the user.
+func (p *Payments) RemoveBillingContact(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	id, err := uuid.FromString(mux.Vars(r)["id"])
+	if err != nil {
+		p.serveJSONError(ctx, w, http.StatusBadRequest, errs.New("invalid billing contact id"))
+		return
+	}
+
+	err = p.service.Payments().RemoveBillingContact(ctx, id)
+	if err != nil {
+		p.serveBillingContactError(ctx, w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// GetBillingContactView returns the billing view of the billing contact identified by the token.
+func (p *Payments) GetBillingContactView(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	query := r.URL.Query()
+
+	limit := uint64(10)
+	if limitParam := query.Get("limit"); limitParam != "" {
+		limit, err = strconv.ParseUint(limitParam, 10, 32)
+		if err != nil {
+			p.serveJSONError(ctx, w, http.StatusBadRequest, err)
+			return
+		}
+	}
+
+	view, err := p.service.GetBillingContactView(ctx, query.Get("token"), payments.InvoiceCursor{
+		Limit:         int(limit),
+		StartingAfter: query.Get("starting_after"),
+		EndingBefore:  query.Get("ending_before"),
+	})
+	if err != nil {
+		p.serveBillingContactError(ctx, w, err)
+		return
+	}
+
+	if err = json.NewEncoder(w).Encode(view); err != nil {
+		p.log.Error("failed to encode billing contact view", zap.Error(ErrPaymentsAPI.Wrap(err)))
+	}
+}
+
+// RequestBillingContactLinks sends new links to the billing view to a billing contact.
+func (p *Payments) RequestBillingContactLinks(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	var request struct {
+		Email string `json:"email"`
+	}
+	if err = json.NewDecoder(r.Body).Decode(&request); err != nil {
+		p.serveJSONError(ctx, w, http.StatusBadRequest, err)
+		return
+	}
+
+	// the response doesn't reveal whether the email belongs to a billing contact.
+	err = p.service.RequestBillingContactLinks(ctx, request.Email)
+	if err != nil {
+		p.serveJSONError(ctx, w, http.StatusInternalServerError, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// serveBillingContactError writes JSON error of a billing contact request to response output stream.
+func (p *Payments) serveBillingContactError(ctx context.Context, w http.ResponseWriter, err error) {
+	switch {
+	case console.ErrUnauthorized.Has(err), console.ErrTokenInvalid.Has(err), console.ErrTokenExpiration.Has(err):
+		p.serveJSONError(ctx, w, http.StatusUnauthorized, err)
+	case console.ErrValidation.Has(err):
+		p.serveJSONError(ctx, w, http.StatusBadRequest, err)
+	case console.ErrConflict.Has(err):
+		p.serveJSONError(ctx, w, http.StatusConflict, err)
+	default:
+		p.serveJSONError(ctx, w, http.StatusInternalServerError, err)
+	}
+}
+
 // serveJSONError writes JSON error to response output stream.
 func (p *Payments) serveJSONError(ctx context.Context, w http.ResponseWriter, status int, err error) {
-	web.ServeJSONError(ctx, p.log, w, status, err)
+	serveCodedJSONError(ctx, p.log, w, status, err, err.Error())
 }
@@ -18,7 +18,6 @@ import (
 	"go.uber.org/zap"
 
 	"storj.io/common/uuid"
-	"storj.io/storj/private/web"
 	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/console/consoleweb/consoleapi/utils"
 )
@@ -768,6 +767,8 @@ func (p *Projects) InviteUser(w http.ResponseWriter, r *http.Request) {
 			status = http.StatusUnauthorized
 		} else if console.ErrNotPaidTier.Has(err) {
 			status = http.StatusPaymentRequired
+		} else if console.ErrInvitationPolicy.Has(err) {
+			status = http.StatusForbidden
 		}
 		p.serveJSONError(ctx, w, status, err)
 	}
@@ -815,6 +816,114 @@ func (p *Projects) ReinviteUsers(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// BulkInviteUsers sends invitations to many email addresses at once and returns the outcome for every address.
+func (p *Projects) BulkInviteUsers(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	id, err := uuid.FromString(mux.Vars(r)["id"])
+	if err != nil {
+		p.serveJSONError(ctx, w, http.StatusBadRequest, err)
+		return
+	}
+
+	var data struct {
+		Emails []string `json:"emails"`
+	}
+	if err = json.NewDecoder(r.Body).Decode(&data); err != nil {
+		p.serveJSONError(ctx, w, http.StatusBadRequest, err)
+		return
+	}
+
+	results, err := p.service.BulkInviteProjectMembers(ctx, id, data.Emails)
+	if err != nil {
+		status := http.StatusInternalServerError
+		switch {
+		case console.ErrUnauthorized.Has(err) || console.ErrNoMembership.Has(err):
+			status = http.StatusUnauthorized
+		case console.ErrForbidden.Has(err):
+			status = http.StatusForbidden
+		case console.ErrValidation.Has(err):
+			status = http.StatusBadRequest
+		case console.ErrNotPaidTier.Has(err):
+			status = http.StatusPaymentRequired
+		}
+		p.serveJSONError(ctx, w, status, err)
+		return
+	}
+
+	err = json.NewEncoder(w).Encode(results)
+	if err != nil {
+		p.serveJSONError(ctx, w, http.StatusInternalServerError, err)
+	}
+}
+
+// GetInvitationPolicy returns the invitation policy of a project.
+func (p *Projects) GetInvitationPolicy(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	id, err := uuid.FromString(mux.Vars(r)["id"])
+	if err != nil {
+		p.serveJSONError(ctx, w, http.StatusBadRequest, err)
+		return
+	}
+
+	policy, err := p.service.GetProjectInvitationPolicy(ctx, id)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if console.ErrUnauthorized.Has(err) || console.ErrNoMembership.Has(err) {
+			status = http.StatusUnauthorized
+		}
+		p.serveJSONError(ctx, w, status, err)
+		return
+	}
+
+	err = json.NewEncoder(w).Encode(policy)
+	if err != nil {
+		p.serveJSONError(ctx, w, http.StatusInternalServerError, err)
+	}
+}
+
+// UpdateInvitationPolicy updates the invitation policy of a project.
+func (p *Projects) UpdateInvitationPolicy(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	id, err := uuid.FromString(mux.Vars(r)["id"])
+	if err != nil {
+		p.serveJSONError(ctx, w, http.StatusBadRequest, err)
+		return
+	}
+
+	var policy console.ProjectInvitationPolicy
+	if err = json.NewDecoder(r.Body).Decode(&policy); err != nil {
+		p.serveJSONError(ctx, w, http.StatusBadRequest, err)
+		return
+	}
+
+	updated, err := p.service.UpdateProjectInvitationPolicy(ctx, id, policy)
+	if err != nil {
+		status := http.StatusInternalServerError
+		switch {
+		case console.ErrUnauthorized.Has(err) || console.ErrNoMembership.Has(err):
+			status = http.StatusUnauthorized
+		case console.ErrValidation.Has(err):
+			status = http.StatusBadRequest
+		}
+		p.serveJSONError(ctx, w, status, err)
+		return
+	}
+
+	err = json.NewEncoder(w).Encode(updated)
+	if err != nil {
+		p.serveJSONError(ctx, w, http.StatusInternalServerError, err)
+	}
+}
+
 // GetInviteLink returns a link to an invitation given project ID and invitee's email.
 func (p *Projects) GetInviteLink(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -989,5 +1098,5 @@ func (p *Projects) DeleteMembersAndInvitations(w http.ResponseWriter, r *http.Re
 
 // serveJSONError writes JSON error to response output stream.
 func (p *Projects) serveJSONError(ctx context.Context, w http.ResponseWriter, status int, err error) {
-	web.ServeJSONError(ctx, p.log, w, status, err)
+	serveCodedJSONError(ctx, p.log, w, status, err, err.Error())
 }
@@ -0,0 +1,838 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoleapi
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/console/consoleweb/consoleapi/utils"
+)
+
+// ErrSCIMAPI - console SCIM api error type.
+var ErrSCIMAPI = errs.Class("consoleapi scim")
+
+const (
+	scimUserSchema       = "urn:ietf:params:scim:schemas:core:2.0:User"
+	scimGroupSchema      = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	scimListSchema       = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	scimErrorSchema      = "urn:ietf:params:scim:api:messages:2.0:Error"
+	scimSPConfigSchema   = "urn:ietf:params:scim:schemas:core:2.0:ServiceProviderConfig"
+	scimContentType      = "application/scim+json"
+	scimDefaultPageCount = 100
+)
+
+var (
+	// scimFilterRegexp matches the only filters supported by the API, which
+	// are the ones identity providers use to look a user up before creating it.
+	scimFilterRegexp = regexp.MustCompile(`(?i)^\s*(userName|externalId)\s+eq\s+"(.*)"\s*$`)
+	// scimMemberPathRegexp matches the path which selects a member of a group.
+	scimMemberPathRegexp = regexp.MustCompile(`(?i)^\s*members\s*\[\s*value\s+eq\s+"(.*)"\s*\]\s*$`)
+)
+
+type scimProviderContextKey struct{}
+
+// WithSCIMProvider returns a context carrying the SCIM provider, which was
+// authenticated by its bearer token.
+func WithSCIMProvider(ctx context.Context, provider console.SCIMProvider) context.Context {
+	return context.WithValue(ctx, scimProviderContextKey{}, provider)
+}
+
+// GetSCIMProvider returns the SCIM provider authenticated by its bearer token.
+func GetSCIMProvider(ctx context.Context) (console.SCIMProvider, bool) {
+	provider, ok := ctx.Value(scimProviderContextKey{}).(console.SCIMProvider)
+	return provider, ok && provider.Name != ""
+}
+
+// SCIM is an api controller that implements the SCIM 2.0 protocol (RFC 7643
+// and RFC 7644), so identity providers can provision the users of an account
+// and their project memberships.
+//
+// Groups are the projects owned by the account owner of the provider.
+type SCIM struct {
+	log     *zap.Logger
+	service *console.Service
+}
+
+// NewSCIM is a constructor for SCIM controller.
+func NewSCIM(log *zap.Logger, service *console.Service) *SCIM {
+	return &SCIM{
+		log:     log,
+		service: service,
+	}
+}
+
+type scimName struct {
+	Formatted  string `json:"formatted,omitempty"`
+	GivenName  string `json:"givenName,omitempty"`
+	FamilyName string `json:"familyName,omitempty"`
+}
+
+type scimEmail struct {
+	Value   string `json:"value"`
+	Type    string `json:"type,omitempty"`
+	Primary bool   `json:"primary,omitempty"`
+}
+
+type scimMeta struct {
+	ResourceType string     `json:"resourceType"`
+	Created      *time.Time `json:"created,omitempty"`
+}
+
+type scimUser struct {
+	Schemas     []string    `json:"schemas"`
+	ID          string      `json:"id"`
+	ExternalID  string      `json:"externalId,omitempty"`
+	UserName    string      `json:"userName"`
+	Name        scimName    `json:"name"`
+	DisplayName string      `json:"displayName,omitempty"`
+	Active      bool        `json:"active"`
+	Emails      []scimEmail `json:"emails"`
+	Meta        scimMeta    `json:"meta"`
+}
+
+// scimUserRequest is the representation of a user sent by the identity
+// provider to create or replace it.
+type scimUserRequest struct {
+	ExternalID  string      `json:"externalId"`
+	UserName    string      `json:"userName"`
+	Name        scimName    `json:"name"`
+	DisplayName string      `json:"displayName"`
+	Active      *bool       `json:"active"`
+	Emails      []scimEmail `json:"emails"`
+}
+
+type scimMember struct {
+	Value string `json:"value"`
+}
+
+type scimGroup struct {
+	Schemas     []string     `json:"schemas"`
+	ID          string       `json:"id"`
+	DisplayName string       `json:"displayName"`
+	Members     []scimMember `json:"members"`
+	Meta        scimMeta     `json:"meta"`
+}
+
+type scimListResponse struct {
+	Schemas      []string    `json:"schemas"`
+	TotalResults int         `json:"totalResults"`
+	StartIndex   int         `json:"startIndex"`
+	ItemsPerPage int         `json:"itemsPerPage"`
+	Resources    interface{} `json:"Resources"`
+}
+
+type scimPatchRequest struct {
+	Schemas    []string             `json:"schemas"`
+	Operations []scimPatchOperation `json:"Operations"`
+}
+
+type scimPatchOperation struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path"`
+	Value json.RawMessage `json:"value"`
+}
+
+type scimSupported struct {
+	Supported bool `json:"supported"`
+}
+
+type scimFilterConfig struct {
+	Supported  bool `json:"supported"`
+	MaxResults int  `json:"maxResults"`
+}
+
+type scimAuthScheme struct {
+	Type        string `json:"type"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+type scimServiceProviderConfig struct {
+	Schemas               []string         `json:"schemas"`
+	Patch                 scimSupported    `json:"patch"`
+	Bulk                  scimSupported    `json:"bulk"`
+	ChangePassword        scimSupported    `json:"changePassword"`
+	Sort                  scimSupported    `json:"sort"`
+	ETag                  scimSupported    `json:"etag"`
+	Filter                scimFilterConfig `json:"filter"`
+	AuthenticationSchemes []scimAuthScheme `json:"authenticationSchemes"`
+}
+
+type scimError struct {
+	Schemas  []string `json:"schemas"`
+	Status   string   `json:"status"`
+	SCIMType string   `json:"scimType,omitempty"`
+	Detail   string   `json:"detail"`
+}
+
+// GetServiceProviderConfig returns the SCIM features supported by the API.
+func (s *SCIM) GetServiceProviderConfig(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	s.serveJSON(w, http.StatusOK, scimServiceProviderConfig{
+		Schemas: []string{scimSPConfigSchema},
+		Patch:   scimSupported{Supported: true},
+		Filter:  scimFilterConfig{Supported: true, MaxResults: scimDefaultPageCount},
+		AuthenticationSchemes: []scimAuthScheme{{
+			Type:        "oauthbearertoken",
+			Name:        "OAuth Bearer Token",
+			Description: "Authentication with the token configured for the identity provider",
+		}},
+	})
+}
+
+// ListUsers returns the users provisioned by the identity provider.
+func (s *SCIM) ListUsers(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	provider, ok := s.getProvider(ctx, w)
+	if !ok {
+		return
+	}
+
+	startIndex, count, err := parseSCIMPage(r)
+	if err != nil {
+		s.serveError(ctx, w, err)
+		return
+	}
+
+	var filter console.SCIMUserFilter
+	if value := r.URL.Query().Get("filter"); value != "" {
+		match := scimFilterRegexp.FindStringSubmatch(value)
+		if match == nil {
+			s.serveSCIMError(w, http.StatusBadRequest, "invalidFilter", "only userName and externalId equality filters are supported")
+			return
+		}
+		if strings.EqualFold(match[1], "userName") {
+			filter.Email = match[2]
+		} else {
+			filter.ExternalID = match[2]
+		}
+	}
+
+	users, total, err := s.service.ListProvisionedUsers(ctx, provider, filter, startIndex-1, count)
+	if err != nil {
+		s.serveError(ctx, w, err)
+		return
+	}
+
+	resources := make([]scimUser, 0, len(users))
+	for i := range users {
+		resources = append(resources, toSCIMUser(&users[i]))
+	}
+
+	s.serveJSON(w, http.StatusOK, scimListResponse{
+		Schemas:      []string{scimListSchema},
+		TotalResults: total,
+		StartIndex:   startIndex,
+		ItemsPerPage: len(resources),
+		Resources:    resources,
+	})
+}
+
+// CreateUser provisions a user of the identity provider.
+func (s *SCIM) CreateUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	provider, ok := s.getProvider(ctx, w)
+	if !ok {
+		return
+	}
+
+	var request scimUserRequest
+	if err = json.NewDecoder(r.Body).Decode(&request); err != nil {
+		s.serveError(ctx, w, console.ErrValidation.Wrap(err))
+		return
+	}
+
+	info, err := request.info()
+	if err != nil {
+		s.serveError(ctx, w, err)
+		return
+	}
+
+	user, err := s.service.ProvisionUser(ctx, provider, info)
+	if err != nil {
+		s.serveError(ctx, w, err)
+		return
+	}
+
+	s.serveJSON(w, http.StatusCreated, toSCIMUser(user))
+}
+
+// GetUser returns a user provisioned by the identity provider.
+func (s *SCIM) GetUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	provider, ok := s.getProvider(ctx, w)
+	if !ok {
+		return
+	}
+
+	id, err := uuid.FromString(mux.Vars(r)["id"])
+	if err != nil {
+		s.serveError(ctx, w, console.ErrSCIMNotFound.Wrap(err))
+		return
+	}
+
+	user, err := s.service.GetProvisionedUser(ctx, provider, id)
+	if err != nil {
+		s.serveError(ctx, w, err)
+		return
+	}
+
+	s.serveJSON(w, http.StatusOK, toSCIMUser(user))
+}
+
+// ReplaceUser replaces the attributes of a user provisioned by the identity provider.
+func (s *SCIM) ReplaceUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	provider, ok := s.getProvider(ctx, w)
+	if !ok {
+		return
+	}
+
+	id, err := uuid.FromString(mux.Vars(r)["id"])
+	if err != nil {
+		s.serveError(ctx, w, console.ErrSCIMNotFound.Wrap(err))
+		return
+	}
+
+	var request scimUserRequest
+	if err = json.NewDecoder(r.Body).Decode(&request); err != nil {
+		s.serveError(ctx, w, console.ErrValidation.Wrap(err))
+		return
+	}
+
+	info, err := request.info()
+	if err != nil {
+		s.serveError(ctx, w, err)
+		return
+	}
+
+	user, err := s.service.UpdateProvisionedUser(ctx, provider, id, console.SCIMUserUpdate{
+		Email:      &info.Email,
+		FullName:   &info.FullName,
+		ShortName:  &info.ShortName,
+		ExternalID: &info.ExternalID,
+		Active:     &info.Active,
+	})
+	if err != nil {
+		s.serveError(ctx, w, err)
+		return
+	}
+
+	s.serveJSON(w, http.StatusOK, toSCIMUser(user))
+}
+
+// PatchUser changes the attributes of a user provisioned by the identity
+// provider. Identity providers mostly use it to deactivate and reactivate users.
+func (s *SCIM) PatchUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	provider, ok := s.getProvider(ctx, w)
+	if !ok {
+		return
+	}
+
+	id, err := uuid.FromString(mux.Vars(r)["id"])
+	if err != nil {
+		s.serveError(ctx, w, console.ErrSCIMNotFound.Wrap(err))
+		return
+	}
+
+	var request scimPatchRequest
+	if err = json.NewDecoder(r.Body).Decode(&request); err != nil {
+		s.serveError(ctx, w, console.ErrValidation.Wrap(err))
+		return
+	}
+
+	var update console.SCIMUserUpdate
+	for _, op := range request.Operations {
+		switch strings.ToLower(op.Op) {
+		case "add", "replace":
+		default:
+			s.serveError(ctx, w, console.ErrValidation.New("unsupported operation %q", op.Op))
+			return
+		}
+
+		if op.Path == "" {
+			var values map[string]json.RawMessage
+			if err = json.Unmarshal(op.Value, &values); err != nil {
+				s.serveError(ctx, w, console.ErrValidation.Wrap(err))
+				return
+			}
+			for path, value := range values {
+				if err = applySCIMUserPatch(&update, path, value); err != nil {
+					s.serveError(ctx, w, err)
+					return
+				}
+			}
+			continue
+		}
+
+		if err = applySCIMUserPatch(&update, op.Path, op.Value); err != nil {
+			s.serveError(ctx, w, err)
+			return
+		}
+	}
+
+	user, err := s.service.UpdateProvisionedUser(ctx, provider, id, update)
+	if err != nil {
+		s.serveError(ctx, w, err)
+		return
+	}
+
+	s.serveJSON(w, http.StatusOK, toSCIMUser(user))
+}
+
+// DeleteUser deprovisions a user of the identity provider.
+func (s *SCIM) DeleteUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	provider, ok := s.getProvider(ctx, w)
+	if !ok {
+		return
+	}
+
+	id, err := uuid.FromString(mux.Vars(r)["id"])
+	if err != nil {
+		s.serveError(ctx, w, console.ErrSCIMNotFound.Wrap(err))
+		return
+	}
+
+	if err = s.service.DeprovisionUser(ctx, provider, id); err != nil {
+		s.serveError(ctx, w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ListGroups returns the projects of the account managed by the identity provider.
+func (s *SCIM) ListGroups(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	provider, ok := s.getProvider(ctx, w)
+	if !ok {
+		return
+	}
+
+	startIndex, count, err := parseSCIMPage(r)
+	if err != nil {
+		s.serveError(ctx, w, err)
+		return
+	}
+
+	groups, err := s.service.ListSCIMGroups(ctx, provider)
+	if err != nil {
+		s.serveError(ctx, w, err)
+		return
+	}
+
+	total := len(groups)
+	if startIndex-1 < len(groups) {
+		groups = groups[startIndex-1:]
+	} else {
+		groups = nil
+	}
+	if count < len(groups) {
+		groups = groups[:count]
+	}
+
+	resources := make([]scimGroup, 0, len(groups))
+	for i := range groups {
+		resources = append(resources, toSCIMGroup(&groups[i]))
+	}
+
+	s.serveJSON(w, http.StatusOK, scimListResponse{
+		Schemas:      []string{scimListSchema},
+		TotalResults: total,
+		StartIndex:   startIndex,
+		ItemsPerPage: len(resources),
+		Resources:    resources,
+	})
+}
+
+// GetGroup returns a project of the account managed by the identity provider.
+func (s *SCIM) GetGroup(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	provider, ok := s.getProvider(ctx, w)
+	if !ok {
+		return
+	}
+
+	id, err := uuid.FromString(mux.Vars(r)["id"])
+	if err != nil {
+		s.serveError(ctx, w, console.ErrSCIMNotFound.Wrap(err))
+		return
+	}
+
+	group, err := s.service.GetSCIMGroup(ctx, provider, id)
+	if err != nil {
+		s.serveError(ctx, w, err)
+		return
+	}
+
+	s.serveJSON(w, http.StatusOK, toSCIMGroup(group))
+}
+
+// PatchGroup adds and removes members of a project of the account managed by
+// the identity provider. The projects themselves are managed in the console.
+func (s *SCIM) PatchGroup(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	provider, ok := s.getProvider(ctx, w)
+	if !ok {
+		return
+	}
+
+	id, err := uuid.FromString(mux.Vars(r)["id"])
+	if err != nil {
+		s.serveError(ctx, w, console.ErrSCIMNotFound.Wrap(err))
+		return
+	}
+
+	var request scimPatchRequest
+	if err = json.NewDecoder(r.Body).Decode(&request); err != nil {
+		s.serveError(ctx, w, console.ErrValidation.Wrap(err))
+		return
+	}
+
+	var add, remove []uuid.UUID
+	for _, op := range request.Operations {
+		if match := scimMemberPathRegexp.FindStringSubmatch(op.Path); match != nil && strings.EqualFold(op.Op, "remove") {
+			memberID, err := uuid.FromString(match[1])
+			if err != nil {
+				s.serveError(ctx, w, console.ErrValidation.Wrap(err))
+				return
+			}
+			remove = append(remove, memberID)
+			continue
+		}
+
+		if !strings.EqualFold(op.Path, "members") {
+			s.serveSCIMError(w, http.StatusBadRequest, "mutability", "only the members of a group can be changed")
+			return
+		}
+
+		memberIDs, err := parseSCIMMembers(op.Value)
+		if err != nil {
+			s.serveError(ctx, w, err)
+			return
+		}
+
+		switch strings.ToLower(op.Op) {
+		case "add":
+			add = append(add, memberIDs...)
+		case "remove":
+			remove = append(remove, memberIDs...)
+		case "replace":
+			group, err := s.service.GetSCIMGroup(ctx, provider, id)
+			if err != nil {
+				s.serveError(ctx, w, err)
+				return
+			}
+			add = append(add, memberIDs...)
+			for _, current := range group.MemberIDs {
+				if !containsUUID(memberIDs, current) {
+					remove = append(remove, current)
+				}
+			}
+		default:
+			s.serveError(ctx, w, console.ErrValidation.New("unsupported operation %q", op.Op))
+			return
+		}
+	}
+
+	group, err := s.service.UpdateSCIMGroupMembers(ctx, provider, id, add, remove)
+	if err != nil {
+		s.serveError(ctx, w, err)
+		return
+	}
+
+	s.serveJSON(w, http.StatusOK, toSCIMGroup(group))
+}
+
+// UnsupportedGroupOperation responds to the requests which create, replace or
+// delete groups. The projects are managed in the console.
+func (s *SCIM) UnsupportedGroupOperation(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	s.serveSCIMError(w, http.StatusNotImplemented, "", "projects can only be created, renamed and deleted in the console")
+}
+
+// info returns the attributes of the user in the request.
+func (request scimUserRequest) info() (console.SCIMUserInfo, error) {
+	info := console.SCIMUserInfo{
+		Email:      strings.TrimSpace(request.UserName),
+		FullName:   scimFullName(request.Name, request.DisplayName),
+		ShortName:  strings.TrimSpace(request.Name.GivenName),
+		ExternalID: request.ExternalID,
+		Active:     request.Active == nil || *request.Active,
+	}
+	if info.Email == "" {
+		for _, email := range request.Emails {
+			if email.Primary || info.Email == "" {
+				info.Email = strings.TrimSpace(email.Value)
+			}
+		}
+	}
+	if !utils.ValidateEmail(info.Email) {
+		return info, console.ErrValidation.New("userName must be an email address")
+	}
+	return info, nil
+}
+
+// applySCIMUserPatch applies the value of the attribute at path to the update.
+func applySCIMUserPatch(update *console.SCIMUserUpdate, path string, value json.RawMessage) error {
+	parseString := func() (string, error) {
+		var s string
+		if err := json.Unmarshal(value, &s); err != nil {
+			return "", console.ErrValidation.New("%s must be a string", path)
+		}
+		return s, nil
+	}
+
+	var err error
+	switch strings.ToLower(path) {
+	case "active":
+		var active bool
+		if err := json.Unmarshal(value, &active); err != nil {
+			// some identity providers send booleans as strings.
+			s, err := parseString()
+			if err != nil {
+				return err
+			}
+			active, err = strconv.ParseBool(s)
+			if err != nil {
+				return console.ErrValidation.New("active must be a boolean")
+			}
+		}
+		update.Active = &active
+	case "username":
+		var email string
+		if email, err = parseString(); err != nil {
+			return err
+		}
+		if !utils.ValidateEmail(strings.TrimSpace(email)) {
+			return console.ErrValidation.New("userName must be an email address")
+		}
+		update.Email = &email
+	case "externalid":
+		var externalID string
+		if externalID, err = parseString(); err != nil {
+			return err
+		}
+		update.ExternalID = &externalID
+	case "displayname", "name.formatted":
+		var fullName string
+		if fullName, err = parseString(); err != nil {
+			return err
+		}
+		update.FullName = &fullName
+	case "name.givenname":
+		var shortName string
+		if shortName, err = parseString(); err != nil {
+			return err
+		}
+		update.ShortName = &shortName
+	case "name":
+		var name scimName
+		if err := json.Unmarshal(value, &name); err != nil {
+			return console.ErrValidation.New("name must be an object")
+		}
+		if fullName := scimFullName(name, ""); fullName != "" {
+			update.FullName = &fullName
+		}
+		if name.GivenName != "" {
+			update.ShortName = &name.GivenName
+		}
+	default:
+		// the attributes which aren't stored, e.g. phone numbers, are ignored.
+	}
+	return nil
+}
+
+// scimFullName returns the full name of the user from its name attributes.
+func scimFullName(name scimName, displayName string) string {
+	if fullName := strings.TrimSpace(name.Formatted); fullName != "" {
+		return fullName
+	}
+	if fullName := strings.TrimSpace(name.GivenName + " " + name.FamilyName); fullName != "" {
+		return fullName
+	}
+	return strings.TrimSpace(displayName)
+}
+
+// parseSCIMMembers parses the members of a group in a patch operation.
+func parseSCIMMembers(value json.RawMessage) ([]uuid.UUID, error) {
+	var members []scimMember
+	if err := json.Unmarshal(value, &members); err != nil {
+		return nil, console.ErrValidation.New("members must be a list of objects with a value")
+	}
+
+	ids := make([]uuid.UUID, 0, len(members))
+	for _, member := range members {
+		id, err := uuid.FromString(member.Value)
+		if err != nil {
+			return nil, console.ErrValidation.Wrap(err)
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// parseSCIMPage parses the 1-based start index and the count of a list request.
+func parseSCIMPage(r *http.Request) (startIndex, count int, err error) {
+	startIndex, count = 1, scimDefaultPageCount
+
+	if value := r.URL.Query().Get("startIndex"); value != "" {
+		startIndex, err = strconv.Atoi(value)
+		if err != nil {
+			return 0, 0, console.ErrValidation.New("startIndex must be a number")
+		}
+		if startIndex < 1 {
+			startIndex = 1
+		}
+	}
+
+	if value := r.URL.Query().Get("count"); value != "" {
+		count, err = strconv.Atoi(value)
+		if err != nil {
+			return 0, 0, console.ErrValidation.New("count must be a number")
+		}
+		if count < 0 {
+			count = 0
+		}
+		if count > scimDefaultPageCount {
+			count = scimDefaultPageCount
+		}
+	}
+
+	return startIndex, count, nil
+}
+
+func containsUUID(ids []uuid.UUID, id uuid.UUID) bool {
+	for _, other := range ids {
+		if other == id {
+			return true
+		}
+	}
+	return false
+}
+
+func toSCIMUser(user *console.ProvisionedUser) scimUser {
+	created := user.ProvisionedAt
+	return scimUser{
+		Schemas:    []string{scimUserSchema},
+		ID:         user.User.ID.String(),
+		ExternalID: user.ExternalID,
+		UserName:   user.User.Email,
+		Name: scimName{
+			Formatted: user.User.FullName,
+			GivenName: user.User.ShortName,
+		},
+		DisplayName: user.User.FullName,
+		Active:      user.Active(),
+		Emails:      []scimEmail{{Value: user.User.Email, Type: "work", Primary: true}},
+		Meta:        scimMeta{ResourceType: "User", Created: &created},
+	}
+}
+
+func toSCIMGroup(group *console.SCIMGroup) scimGroup {
+	members := make([]scimMember, 0, len(group.MemberIDs))
+	for _, id := range group.MemberIDs {
+		members = append(members, scimMember{Value: id.String()})
+	}
+
+	created := group.Project.CreatedAt
+	return scimGroup{
+		Schemas:     []string{scimGroupSchema},
+		ID:          group.Project.PublicID.String(),
+		DisplayName: group.Project.Name,
+		Members:     members,
+		Meta:        scimMeta{ResourceType: "Group", Created: &created},
+	}
+}
+
+// getProvider returns the provider authenticated for the request or responds
+// with an error.
+func (s *SCIM) getProvider(ctx context.Context, w http.ResponseWriter) (console.SCIMProvider, bool) {
+	provider, ok := GetSCIMProvider(ctx)
+	if !ok {
+		s.serveSCIMError(w, http.StatusUnauthorized, "", "SCIM token missing")
+	}
+	return provider, ok
+}
+
+// serveJSON writes the SCIM resource to the response.
+func (s *SCIM) serveJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", scimContentType)
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		s.log.Error("could not encode SCIM response", zap.Error(ErrSCIMAPI.Wrap(err)))
+	}
+}
+
+// serveError writes the error to the response with the status matching its class.
+func (s *SCIM) serveError(ctx context.Context, w http.ResponseWriter, err error) {
+	switch {
+	case console.ErrValidation.Has(err):
+		s.serveSCIMError(w, http.StatusBadRequest, "invalidValue", err.Error())
+	case console.ErrEmailUsed.Has(err):
+		s.serveSCIMError(w, http.StatusConflict, "uniqueness", "userName is already in use")
+	case console.ErrSCIMNotFound.Has(err):
+		s.serveSCIMError(w, http.StatusNotFound, "", "resource not found")
+	default:
+		s.log.Error("SCIM request failed", zap.Error(ErrSCIMAPI.Wrap(err)))
+		s.serveSCIMError(w, http.StatusInternalServerError, "", "internal server error")
+	}
+}
+
+// serveSCIMError writes an error in the format defined by the SCIM protocol.
+func (s *SCIM) serveSCIMError(w http.ResponseWriter, status int, scimType, detail string) {
+	s.serveJSON(w, status, scimError{
+		Schemas:  []string{scimErrorSchema},
+		Status:   strconv.Itoa(status),
+		SCIMType: scimType,
+		Detail:   detail,
+	})
+}
@@ -16,7 +16,6 @@ import (
 	"go.uber.org/zap"
 
 	"storj.io/common/uuid"
-	"storj.io/storj/private/web"
 	"storj.io/storj/satellite/accounting"
 	"storj.io/storj/satellite/console"
 )
@@ -185,6 +184,126 @@ func (ul *UsageLimits) UsageReport(w http.ResponseWriter, r *http.Request) {
 	wr.Flush()
 }
 
+// APIKeyUsageReport returns the egress of every API key for all the projects that user owns or a single user's project.
+func (ul *UsageLimits) APIKeyUsageReport(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	sinceStamp, err := strconv.ParseInt(r.URL.Query().Get("since"), 10, 64)
+	if err != nil {
+		ul.serveJSONError(ctx, w, http.StatusBadRequest, err)
+		return
+	}
+	beforeStamp, err := strconv.ParseInt(r.URL.Query().Get("before"), 10, 64)
+	if err != nil {
+		ul.serveJSONError(ctx, w, http.StatusBadRequest, err)
+		return
+	}
+
+	since := time.Unix(sinceStamp, 0).UTC()
+	before := time.Unix(beforeStamp, 0).UTC()
+
+	if before.Sub(since) > ul.allowedReportDateRange {
+		ul.serveJSONError(ctx, w, http.StatusForbidden, errs.New("date range must be less than %v", ul.allowedReportDateRange))
+		return
+	}
+
+	var projectID uuid.UUID
+
+	idParam := r.URL.Query().Get("projectID")
+	if idParam != "" {
+		projectID, err = uuid.FromString(idParam)
+		if err != nil {
+			ul.serveJSONError(ctx, w, http.StatusBadRequest, errs.New("invalid project id: %v", err))
+			return
+		}
+	}
+
+	usage, err := ul.service.GetAPIKeyUsageReport(ctx, since, before, projectID)
+	if err != nil {
+		if console.ErrUnauthorized.Has(err) {
+			ul.serveJSONError(ctx, w, http.StatusUnauthorized, err)
+			return
+		}
+
+		ul.serveJSONError(ctx, w, http.StatusInternalServerError, err)
+		return
+	}
+
+	dateFormat := "2006-01-02"
+	fileName := "storj-api-key-report-" + idParam + "-" + since.Format(dateFormat) + "-to-" + before.Format(dateFormat) + ".csv"
+
+	w.Header().Set("Content-Type", "text/csv")
+	w.Header().Set("Content-Disposition", "attachment;filename="+fileName)
+
+	wr := csv.NewWriter(w)
+
+	err = wr.Write([]string{"ProjectName", "ProjectID", "APIKeyID", "APIKeyName", "Egress GB", "Since", "Before"})
+	if err != nil {
+		ul.serveJSONError(ctx, w, http.StatusInternalServerError, errs.New("Error writing CSV data"))
+		return
+	}
+
+	for _, u := range usage {
+		err = wr.Write(u.ToStringSlice())
+		if err != nil {
+			ul.serveJSONError(ctx, w, http.StatusInternalServerError, errs.New("Error writing CSV data"))
+			return
+		}
+	}
+
+	w.Header().Set("Cache-Control", "public, max-age=3600") // Cache the same request for 1 hour.
+
+	wr.Flush()
+}
+
+// AccountUsage returns usage and estimated cost for every project that user owns or is a member of.
+func (ul *UsageLimits) AccountUsage(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	sinceStamp, err := strconv.ParseInt(r.URL.Query().Get("since"), 10, 64)
+	if err != nil {
+		ul.serveJSONError(ctx, w, http.StatusBadRequest, err)
+		return
+	}
+	beforeStamp, err := strconv.ParseInt(r.URL.Query().Get("before"), 10, 64)
+	if err != nil {
+		ul.serveJSONError(ctx, w, http.StatusBadRequest, err)
+		return
+	}
+
+	since := time.Unix(sinceStamp, 0).UTC()
+	before := time.Unix(beforeStamp, 0).UTC()
+
+	if before.Before(since) {
+		ul.serveJSONError(ctx, w, http.StatusBadRequest, errs.New("before must not be earlier than since"))
+		return
+	}
+	if before.Sub(since) > ul.allowedReportDateRange {
+		ul.serveJSONError(ctx, w, http.StatusForbidden, errs.New("date range must be less than %v", ul.allowedReportDateRange))
+		return
+	}
+
+	usage, err := ul.service.GetAccountUsage(ctx, since, before)
+	if err != nil {
+		if console.ErrUnauthorized.Has(err) {
+			ul.serveJSONError(ctx, w, http.StatusUnauthorized, err)
+			return
+		}
+
+		ul.serveJSONError(ctx, w, http.StatusInternalServerError, err)
+		return
+	}
+
+	err = json.NewEncoder(w).Encode(usage)
+	if err != nil {
+		ul.log.Error("error encoding account usage", zap.Error(ErrUsageLimitsAPI.Wrap(err)))
+	}
+}
+
 // DailyUsage returns daily usage by project ID.
 func (ul *UsageLimits) DailyUsage(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -239,5 +358,5 @@ func (ul *UsageLimits) DailyUsage(w http.ResponseWriter, r *http.Request) {
 
 // serveJSONError writes JSON error to response output stream.
 func (ul *UsageLimits) serveJSONError(ctx context.Context, w http.ResponseWriter, status int, err error) {
-	web.ServeJSONError(ctx, ul.log, w, status, err)
+	serveCodedJSONError(ctx, ul.log, w, status, err, err.Error())
 }
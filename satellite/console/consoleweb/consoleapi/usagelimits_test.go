@@ -89,6 +89,69 @@ func TestTotalUsageLimits(t *testing.T) {
 	})
 }
 
+func TestAccountUsage(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Console.OpenRegistrationEnabled = true
+				config.Console.RateLimit.Burst = 10
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+
+		user, err := sat.AddUser(ctx, console.CreateUser{
+			FullName: "Account Usage Test",
+			Email:    "au@test.test",
+		}, 3)
+		require.NoError(t, err)
+
+		owner, err := sat.AddUser(ctx, console.CreateUser{
+			FullName: "Account Usage Owner",
+			Email:    "au-owner@test.test",
+		}, 3)
+		require.NoError(t, err)
+
+		owned, err := sat.AddProject(ctx, user.ID, "owned")
+		require.NoError(t, err)
+
+		shared, err := sat.AddProject(ctx, owner.ID, "shared")
+		require.NoError(t, err)
+		_, err = sat.DB.Console().ProjectMembers().Insert(ctx, user.ID, shared.ID, console.RoleMember)
+		require.NoError(t, err)
+
+		now := time.Now()
+		since := strconv.FormatInt(now.Add(-time.Hour).Unix(), 10)
+		before := strconv.FormatInt(now.Unix(), 10)
+
+		body, status, err := doRequestWithAuth(ctx, t, sat, user, http.MethodGet, "projects/account-usage?since="+since+"&before="+before, nil)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, status)
+
+		var output console.AccountUsage
+		require.NoError(t, json.Unmarshal(body, &output))
+		require.Len(t, output.Projects, 2)
+
+		for _, project := range output.Projects {
+			switch project.ProjectID {
+			case owned.PublicID:
+				require.True(t, project.Owned)
+				require.NotNil(t, project.CostCents)
+			case shared.PublicID:
+				require.False(t, project.Owned)
+				require.Nil(t, project.CostCents)
+			default:
+				t.Fatalf("unexpected project %s", project.ProjectID)
+			}
+		}
+
+		_, status, err = doRequestWithAuth(ctx, t, sat, user, http.MethodGet, "projects/account-usage?since="+before+"&before="+since, nil)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusBadRequest, status)
+	})
+}
+
 func TestDailyUsage(t *testing.T) {
 	testplanet.Run(t, testplanet.Config{
 		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 1,
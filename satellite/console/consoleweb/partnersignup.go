@@ -0,0 +1,153 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoleweb
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/pflag"
+	"go.uber.org/zap"
+
+	"storj.io/storj/private/web"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/console/consoleweb/consoleapi"
+)
+
+// PartnerSignupConfig contains configuration for the public partner signup API.
+type PartnerSignupConfig struct {
+	Enabled bool           `help:"whether partner portals can create accounts through the public signup API" default:"false"`
+	APIKeys PartnerAPIKeys `help:"semicolon-separated partner API keys in the format partner:key"`
+	// RateLimit limits the signups per partner. The requests are limited by
+	// IP address as well.
+	RateLimit web.RateLimiterConfig
+
+	EmailInterval time.Duration `help:"minimum time between emails sent to the same address through the public signup API" default:"15m"`
+}
+
+// EmailRateLimit returns the configuration of the rate limiter which
+// throttles the emails sent to a single address.
+func (config PartnerSignupConfig) EmailRateLimit() web.RateLimiterConfig {
+	return web.RateLimiterConfig{
+		Duration:  config.EmailInterval,
+		Burst:     1,
+		NumLimits: config.RateLimit.NumLimits,
+	}
+}
+
+// Ensure that PartnerAPIKeys implements pflag.Value.
+var _ pflag.Value = (*PartnerAPIKeys)(nil)
+
+// PartnerAPIKeys represents a mapping between partners and the API keys they
+// use for the public signup API.
+type PartnerAPIKeys struct {
+	keyMap map[string]string
+}
+
+// Type returns the type of the pflag.Value.
+func (PartnerAPIKeys) Type() string { return "consoleweb.PartnerAPIKeys" }
+
+// String returns the string representation of the partner API keys. The
+// keys themselves are redacted, so the value can be logged safely.
+func (keys *PartnerAPIKeys) String() string {
+	if keys == nil {
+		return ""
+	}
+
+	partners := make([]string, 0, len(keys.keyMap))
+	for partner := range keys.keyMap {
+		partners = append(partners, partner)
+	}
+	sort.Strings(partners)
+
+	var s strings.Builder
+	for i, partner := range partners {
+		if i > 0 {
+			s.WriteRune(';')
+		}
+		s.WriteString(partner + ":xxxxx")
+	}
+	return s.String()
+}
+
+// Set sets the partner API keys to the parsed string.
+func (keys *PartnerAPIKeys) Set(s string) error {
+	keyMap := make(map[string]string)
+	for _, keyStr := range strings.Split(s, ";") {
+		if keyStr == "" {
+			continue
+		}
+
+		partner, key, ok := strings.Cut(keyStr, ":")
+		if !ok {
+			return Error.New("Invalid partner API key (expected format partner:key)")
+		}
+
+		partner = strings.TrimSpace(partner)
+		if partner == "" {
+			return Error.New("Partner name must not be empty")
+		}
+		key = strings.TrimSpace(key)
+		if key == "" {
+			return Error.New("API key of partner %q must not be empty", partner)
+		}
+		if _, ok := keyMap[partner]; ok {
+			return Error.New("Duplicate API key of partner %q", partner)
+		}
+
+		keyMap[partner] = key
+	}
+	keys.keyMap = keyMap
+	return nil
+}
+
+// Partner returns the partner which the API key belongs to.
+func (keys *PartnerAPIKeys) Partner(key string) (partner string, ok bool) {
+	if key == "" {
+		return "", false
+	}
+	for name, partnerKey := range keys.keyMap {
+		if subtle.ConstantTimeCompare([]byte(key), []byte(partnerKey)) == 1 {
+			partner, ok = name, true
+		}
+	}
+	return partner, ok
+}
+
+// withPartnerKey authenticates the partner by the API key in the Authorization
+// header and stores the partner in the request context.
+func (server *Server) withPartnerKey(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		key, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !ok {
+			web.ServeJSONError(ctx, server.log, w, http.StatusUnauthorized, console.ErrUnauthorized.New("partner API key missing"))
+			return
+		}
+
+		partner, ok := server.config.PartnerSignup.APIKeys.Partner(strings.TrimSpace(key))
+		if !ok {
+			web.ServeJSONError(ctx, server.log, w, http.StatusUnauthorized, console.ErrUnauthorized.New("invalid partner API key"))
+			return
+		}
+
+		handler.ServeHTTP(w, r.Clone(consoleapi.WithPartner(ctx, partner)))
+	})
+}
+
+// NewPartnerRateLimiter constructs a RateLimiter that limits based on the
+// partner authenticated by its signup API key.
+func NewPartnerRateLimiter(config web.RateLimiterConfig, log *zap.Logger) *web.RateLimiter {
+	return web.NewRateLimiter(config, log, func(r *http.Request) (string, error) {
+		partner, ok := consoleapi.GetPartner(r.Context())
+		if !ok {
+			return "", console.ErrUnauthorized.New("partner API key missing")
+		}
+		return partner, nil
+	})
+}
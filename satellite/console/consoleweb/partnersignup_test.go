@@ -0,0 +1,35 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoleweb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPartnerAPIKeys(t *testing.T) {
+	var keys PartnerAPIKeys
+	require.NoError(t, keys.Set("partnerB:keyB; partnerA : keyA"))
+	// the keys are redacted from the string representation.
+	require.Equal(t, "partnerA:xxxxx;partnerB:xxxxx", keys.String())
+
+	partner, ok := keys.Partner("keyA")
+	require.True(t, ok)
+	require.Equal(t, "partnerA", partner)
+
+	_, ok = keys.Partner("unknown")
+	require.False(t, ok)
+	_, ok = keys.Partner("")
+	require.False(t, ok)
+
+	for _, invalid := range []string{
+		"partner",
+		":key",
+		"partner:",
+		"partner:key1;partner:key2",
+	} {
+		require.Error(t, keys.Set(invalid), invalid)
+	}
+}
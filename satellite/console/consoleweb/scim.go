@@ -0,0 +1,134 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoleweb
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/spf13/pflag"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/private/web"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/console/consoleweb/consoleapi"
+)
+
+// SCIMConfig contains configuration for the SCIM provisioning API.
+type SCIMConfig struct {
+	Enabled   bool          `help:"whether identity providers can provision users and project members through the SCIM API" default:"false"`
+	Providers SCIMProviders `help:"semicolon-separated SCIM providers in the format provider:owner-id:token, where owner-id is the ID of the user owning the projects managed by the provider"`
+}
+
+// Ensure that SCIMProviders implements pflag.Value.
+var _ pflag.Value = (*SCIMProviders)(nil)
+
+// SCIMProviders represents the identity providers allowed to use the SCIM
+// API, the accounts they manage and the bearer tokens they authenticate with.
+type SCIMProviders struct {
+	providers map[string]scimProvider
+}
+
+type scimProvider struct {
+	ownerID uuid.UUID
+	token   string
+}
+
+// Type returns the type of the pflag.Value.
+func (SCIMProviders) Type() string { return "consoleweb.SCIMProviders" }
+
+// String returns the string representation of the SCIM providers. The tokens
+// are redacted, so the value can be logged safely.
+func (providers *SCIMProviders) String() string {
+	if providers == nil {
+		return ""
+	}
+
+	names := make([]string, 0, len(providers.providers))
+	for name := range providers.providers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var s strings.Builder
+	for i, name := range names {
+		if i > 0 {
+			s.WriteRune(';')
+		}
+		s.WriteString(name + ":" + providers.providers[name].ownerID.String() + ":xxxxx")
+	}
+	return s.String()
+}
+
+// Set sets the SCIM providers to the parsed string.
+func (providers *SCIMProviders) Set(s string) error {
+	providerMap := make(map[string]scimProvider)
+	for _, providerStr := range strings.Split(s, ";") {
+		if providerStr == "" {
+			continue
+		}
+
+		parts := strings.SplitN(providerStr, ":", 3)
+		if len(parts) != 3 {
+			return Error.New("Invalid SCIM provider (expected format provider:owner-id:token)")
+		}
+
+		name := strings.TrimSpace(parts[0])
+		if name == "" {
+			return Error.New("SCIM provider name must not be empty")
+		}
+		ownerID, err := uuid.FromString(strings.TrimSpace(parts[1]))
+		if err != nil {
+			return Error.New("Invalid owner ID of SCIM provider %q: %v", name, err)
+		}
+		token := strings.TrimSpace(parts[2])
+		if token == "" {
+			return Error.New("Token of SCIM provider %q must not be empty", name)
+		}
+		if _, ok := providerMap[name]; ok {
+			return Error.New("Duplicate SCIM provider %q", name)
+		}
+
+		providerMap[name] = scimProvider{ownerID: ownerID, token: token}
+	}
+	providers.providers = providerMap
+	return nil
+}
+
+// Provider returns the SCIM provider which the token belongs to.
+func (providers *SCIMProviders) Provider(token string) (provider console.SCIMProvider, ok bool) {
+	if token == "" {
+		return console.SCIMProvider{}, false
+	}
+	for name, p := range providers.providers {
+		if subtle.ConstantTimeCompare([]byte(token), []byte(p.token)) == 1 {
+			provider, ok = console.SCIMProvider{Name: name, OwnerID: p.ownerID}, true
+		}
+	}
+	return provider, ok
+}
+
+// withSCIMToken authenticates the SCIM provider by the bearer token in the
+// Authorization header and stores the provider in the request context.
+func (server *Server) withSCIMToken(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !ok {
+			web.ServeJSONError(ctx, server.log, w, http.StatusUnauthorized, console.ErrUnauthorized.New("SCIM token missing"))
+			return
+		}
+
+		provider, ok := server.config.SCIM.Providers.Provider(strings.TrimSpace(token))
+		if !ok {
+			web.ServeJSONError(ctx, server.log, w, http.StatusUnauthorized, console.ErrUnauthorized.New("invalid SCIM token"))
+			return
+		}
+
+		handler.ServeHTTP(w, r.Clone(consoleapi.WithSCIMProvider(ctx, provider)))
+	})
+}
@@ -0,0 +1,42 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoleweb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testrand"
+)
+
+func TestSCIMProviders(t *testing.T) {
+	ownerA, ownerB := testrand.UUID(), testrand.UUID()
+
+	var providers SCIMProviders
+	require.NoError(t, providers.Set("okta:"+ownerB.String()+":tokenB; azure : "+ownerA.String()+" : token:A"))
+	// the tokens are redacted from the string representation.
+	require.Equal(t, "azure:"+ownerA.String()+":xxxxx;okta:"+ownerB.String()+":xxxxx", providers.String())
+
+	provider, ok := providers.Provider("token:A")
+	require.True(t, ok)
+	require.Equal(t, "azure", provider.Name)
+	require.Equal(t, ownerA, provider.OwnerID)
+
+	_, ok = providers.Provider("unknown")
+	require.False(t, ok)
+	_, ok = providers.Provider("")
+	require.False(t, ok)
+
+	for _, invalid := range []string{
+		"okta",
+		"okta:token",
+		"okta:not-a-uuid:token",
+		":" + ownerA.String() + ":token",
+		"okta:" + ownerA.String() + ":",
+		"okta:" + ownerA.String() + ":token1;okta:" + ownerB.String() + ":token2",
+	} {
+		require.Error(t, providers.Set(invalid), invalid)
+	}
+}
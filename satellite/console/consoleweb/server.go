@@ -40,6 +40,7 @@ import (
 	"storj.io/storj/satellite/console/consoleweb/consoleapi"
 	"storj.io/storj/satellite/console/consoleweb/consolewebauth"
 	"storj.io/storj/satellite/mailservice"
+	"storj.io/storj/satellite/maintenance"
 	"storj.io/storj/satellite/oidc"
 	"storj.io/storj/satellite/payments/paymentsconfig"
 )
@@ -71,6 +72,8 @@ type Config struct {
 	AuthTokenSecret  string `help:"secret used to sign auth tokens" releaseDefault:"" devDefault:"my-suppa-secret-key"`
 	AuthCookieDomain string `help:"optional domain for cookies to use" default:""`
 
+	LinksharingBrandingSecret string `help:"secret used to sign project branding settings served to the linksharing service, the endpoint is disabled when empty" default:""`
+
 	ContactInfoURL                  string        `help:"url link to contacts page" default:"https://forum.storj.io"`
 	ScheduleMeetingURL              string        `help:"url link to schedule a meeting with a storj representative" default:"https://meetings.hubspot.com/tom144/free-trial"`
 	LetUsKnowURL                    string        `help:"url link to let us know page" default:"https://storjlabs.atlassian.net/servicedesk/customer/portals"`
@@ -128,6 +131,9 @@ type Config struct {
 	RateLimit web.RateLimiterConfig
 	ABTesting abtesting.Config
 
+	PartnerSignup PartnerSignupConfig
+	SCIM          SCIMConfig
+
 	console.Config
 }
 
@@ -142,6 +148,7 @@ type Server struct {
 	mailService *mailservice.Service
 	analytics   *analytics.Service
 	abTesting   *abtesting.Service
+	maintenance *maintenance.Service
 
 	listener          net.Listener
 	server            http.Server
@@ -149,7 +156,12 @@ type Server struct {
 	cookieAuth        *consolewebauth.CookieAuth
 	ipRateLimiter     *web.RateLimiter
 	userIDRateLimiter *web.RateLimiter
-	nodeURL           storj.NodeURL
+	// partnerRateLimiter limits the signups through the partner signup API.
+	partnerRateLimiter *web.RateLimiter
+	// partnerEmailLimiter throttles the emails sent through the partner
+	// signup API to a single address.
+	partnerEmailLimiter *web.RateLimiter
+	nodeURL             storj.NodeURL
 
 	stripePublicKey                 string
 	neededTokenPaymentConfirmations int
@@ -198,7 +210,15 @@ func (a *apiAuth) cookieAuth(ctx context.Context, r *http.Request) (context.Cont
 		return nil, err
 	}
 
-	return a.server.service.TokenAuth(ctx, tokenInfo.Token, time.Now())
+	ctx, err = a.server.service.TokenAuth(ctx, tokenInfo.Token, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if console.IsReadOnlySession(ctx) && !isReadOnlyMethod(r.Method) {
+		return nil, console.ErrForbidden.New(readOnlySessionErrMsg)
+	}
+
+	return ctx, nil
 }
 
 // cookieAuth returns an authenticated context by api key.
@@ -218,7 +238,7 @@ func (a *apiAuth) RemoveAuthCookie(w http.ResponseWriter) {
 }
 
 // NewServer creates new instance of console server.
-func NewServer(logger *zap.Logger, config Config, service *console.Service, oidcService *oidc.Service, mailService *mailservice.Service, analytics *analytics.Service, abTesting *abtesting.Service, accountFreezeService *console.AccountFreezeService, listener net.Listener, stripePublicKey string, neededTokenPaymentConfirmations int, nodeURL storj.NodeURL, analyticsConfig analytics.Config, packagePlans paymentsconfig.PackagePlans) *Server {
+func NewServer(logger *zap.Logger, config Config, service *console.Service, oidcService *oidc.Service, mailService *mailservice.Service, analytics *analytics.Service, abTesting *abtesting.Service, maintenanceService *maintenance.Service, accountFreezeService *console.AccountFreezeService, listener net.Listener, stripePublicKey string, neededTokenPaymentConfirmations int, nodeURL storj.NodeURL, analyticsConfig analytics.Config, packagePlans paymentsconfig.PackagePlans) *Server {
 	initAdditionalMimeTypes()
 
 	server := Server{
@@ -229,10 +249,13 @@ func NewServer(logger *zap.Logger, config Config, service *console.Service, oidc
 		mailService:                     mailService,
 		analytics:                       analytics,
 		abTesting:                       abTesting,
+		maintenance:                     maintenanceService,
 		stripePublicKey:                 stripePublicKey,
 		neededTokenPaymentConfirmations: neededTokenPaymentConfirmations,
 		ipRateLimiter:                   web.NewIPRateLimiter(config.RateLimit, logger),
 		userIDRateLimiter:               NewUserIDRateLimiter(config.RateLimit, logger),
+		partnerRateLimiter:              NewPartnerRateLimiter(config.PartnerSignup.RateLimit, logger),
+		partnerEmailLimiter:             web.NewRateLimiter(config.PartnerSignup.EmailRateLimit(), logger, nil),
 		nodeURL:                         nodeURL,
 		AnalyticsConfig:                 analyticsConfig,
 		packagePlans:                    packagePlans,
@@ -298,17 +321,26 @@ func NewServer(logger *zap.Logger, config Config, service *console.Service, oidc
 	projectsRouter.Handle("/{id}/invite/{email}", server.userIDRateLimiter.Limit(http.HandlerFunc(projectsController.InviteUser))).Methods(http.MethodPost, http.MethodOptions)
 	projectsRouter.Handle("/{id}/reinvite", server.userIDRateLimiter.Limit(http.HandlerFunc(projectsController.ReinviteUsers))).Methods(http.MethodPost, http.MethodOptions)
 	projectsRouter.Handle("/{id}/invite-link", http.HandlerFunc(projectsController.GetInviteLink)).Methods(http.MethodGet, http.MethodOptions)
+	projectsRouter.Handle("/{id}/bulk-invite", server.userIDRateLimiter.Limit(http.HandlerFunc(projectsController.BulkInviteUsers))).Methods(http.MethodPost, http.MethodOptions)
+	projectsRouter.Handle("/{id}/invitation-policy", http.HandlerFunc(projectsController.GetInvitationPolicy)).Methods(http.MethodGet, http.MethodOptions)
+	projectsRouter.Handle("/{id}/invitation-policy", http.HandlerFunc(projectsController.UpdateInvitationPolicy)).Methods(http.MethodPut, http.MethodOptions)
 	projectsRouter.Handle("/{id}/emission", http.HandlerFunc(projectsController.GetEmissionImpact)).Methods(http.MethodGet, http.MethodOptions)
 	projectsRouter.Handle("/{id}/config", http.HandlerFunc(projectsController.GetConfig)).Methods(http.MethodGet, http.MethodOptions)
 	projectsRouter.Handle("/{id}/versioning-opt-{status}", http.HandlerFunc(projectsController.OptInToVersioning)).Methods(http.MethodPatch, http.MethodOptions)
+	linksharingBrandingController := consoleapi.NewLinksharingBranding(logger, service, config.LinksharingBrandingSecret)
+	projectsRouter.Handle("/{id}/linksharing-branding", http.HandlerFunc(linksharingBrandingController.Get)).Methods(http.MethodGet, http.MethodOptions)
+	projectsRouter.Handle("/{id}/linksharing-branding", http.HandlerFunc(linksharingBrandingController.Update)).Methods(http.MethodPut, http.MethodOptions)
+	projectsRouter.Handle("/{id}/linksharing-branding", http.HandlerFunc(linksharingBrandingController.Delete)).Methods(http.MethodDelete, http.MethodOptions)
 	projectsRouter.Handle("/invitations", http.HandlerFunc(projectsController.GetUserInvitations)).Methods(http.MethodGet, http.MethodOptions)
 	projectsRouter.Handle("/invitations/{id}/respond", http.HandlerFunc(projectsController.RespondToInvitation)).Methods(http.MethodPost, http.MethodOptions)
 
 	usageLimitsController := consoleapi.NewUsageLimits(logger, service, server.config.AllowedUsageReportDateRange)
 	projectsRouter.Handle("/{id}/usage-limits", http.HandlerFunc(usageLimitsController.ProjectUsageLimits)).Methods(http.MethodGet, http.MethodOptions)
 	projectsRouter.Handle("/usage-limits", http.HandlerFunc(usageLimitsController.TotalUsageLimits)).Methods(http.MethodGet, http.MethodOptions)
+	projectsRouter.Handle("/account-usage", http.HandlerFunc(usageLimitsController.AccountUsage)).Methods(http.MethodGet, http.MethodOptions)
 	projectsRouter.Handle("/{id}/daily-usage", http.HandlerFunc(usageLimitsController.DailyUsage)).Methods(http.MethodGet, http.MethodOptions)
 	projectsRouter.Handle("/usage-report", server.userIDRateLimiter.Limit(http.HandlerFunc(usageLimitsController.UsageReport))).Methods(http.MethodGet, http.MethodOptions)
+	projectsRouter.Handle("/usage-report/api-keys", server.userIDRateLimiter.Limit(http.HandlerFunc(usageLimitsController.APIKeyUsageReport))).Methods(http.MethodGet, http.MethodOptions)
 
 	badPasswords, err := server.loadBadPasswords()
 	if err != nil {
@@ -325,6 +357,8 @@ func NewServer(logger *zap.Logger, config Config, service *console.Service, oidc
 	authRouter.Handle("/account/freezestatus", server.withAuth(http.HandlerFunc(authController.GetFreezeStatus))).Methods(http.MethodGet, http.MethodOptions)
 	authRouter.Handle("/account/settings", server.withAuth(http.HandlerFunc(authController.GetUserSettings))).Methods(http.MethodGet, http.MethodOptions)
 	authRouter.Handle("/account/settings", server.withAuth(http.HandlerFunc(authController.SetUserSettings))).Methods(http.MethodPatch, http.MethodOptions)
+	authRouter.Handle("/account/devices", server.withAuth(http.HandlerFunc(authController.GetTrustedDevices))).Methods(http.MethodGet, http.MethodOptions)
+	authRouter.Handle("/account/devices/{id}", server.withAuth(http.HandlerFunc(authController.RemoveTrustedDevice))).Methods(http.MethodDelete, http.MethodOptions)
 	authRouter.Handle("/account/onboarding", server.withAuth(http.HandlerFunc(authController.SetOnboardingStatus))).Methods(http.MethodPatch, http.MethodOptions)
 	authRouter.Handle("/mfa/enable", server.withAuth(http.HandlerFunc(authController.EnableUserMFA))).Methods(http.MethodPost, http.MethodOptions)
 	authRouter.Handle("/mfa/disable", server.withAuth(server.userIDRateLimiter.Limit(http.HandlerFunc(authController.DisableUserMFA)))).Methods(http.MethodPost, http.MethodOptions)
@@ -335,6 +369,10 @@ func NewServer(logger *zap.Logger, config Config, service *console.Service, oidc
 	authRouter.Handle("/token", server.ipRateLimiter.Limit(http.HandlerFunc(authController.Token))).Methods(http.MethodPost, http.MethodOptions)
 	authRouter.Handle("/token-by-api-key", server.ipRateLimiter.Limit(http.HandlerFunc(authController.TokenByAPIKey))).Methods(http.MethodPost, http.MethodOptions)
 	authRouter.Handle("/register", server.ipRateLimiter.Limit(http.HandlerFunc(authController.Register))).Methods(http.MethodPost, http.MethodOptions)
+	if config.PartnerSignup.Enabled {
+		authController.PartnerEmailLimiter = server.partnerEmailLimiter
+		authRouter.Handle("/partner/register", server.ipRateLimiter.Limit(server.withPartnerKey(server.partnerRateLimiter.Limit(http.HandlerFunc(authController.PartnerRegister))))).Methods(http.MethodPost, http.MethodOptions)
+	}
 	authRouter.Handle("/code-activation", server.ipRateLimiter.Limit(http.HandlerFunc(authController.ActivateAccount))).Methods(http.MethodPatch, http.MethodOptions)
 	authRouter.Handle("/forgot-password", server.ipRateLimiter.Limit(http.HandlerFunc(authController.ForgotPassword))).Methods(http.MethodPost, http.MethodOptions)
 	authRouter.Handle("/resend-email/{email}", server.ipRateLimiter.Limit(http.HandlerFunc(authController.ResendEmail))).Methods(http.MethodPost, http.MethodOptions)
@@ -342,6 +380,24 @@ func NewServer(logger *zap.Logger, config Config, service *console.Service, oidc
 	authRouter.Handle("/refresh-session", server.withAuth(http.HandlerFunc(authController.RefreshSession))).Methods(http.MethodPost, http.MethodOptions)
 	authRouter.Handle("/limit-increase", server.withAuth(http.HandlerFunc(authController.RequestLimitIncrease))).Methods(http.MethodPatch, http.MethodOptions)
 
+	if config.SCIM.Enabled {
+		scimController := consoleapi.NewSCIM(logger, service)
+		scimRouter := router.PathPrefix("/api/v0/scim/v2").Subrouter()
+		scimRouter.Use(server.withSCIMToken)
+		scimRouter.Handle("/ServiceProviderConfig", http.HandlerFunc(scimController.GetServiceProviderConfig)).Methods(http.MethodGet)
+		scimRouter.Handle("/Users", http.HandlerFunc(scimController.ListUsers)).Methods(http.MethodGet)
+		scimRouter.Handle("/Users", http.HandlerFunc(scimController.CreateUser)).Methods(http.MethodPost)
+		scimRouter.Handle("/Users/{id}", http.HandlerFunc(scimController.GetUser)).Methods(http.MethodGet)
+		scimRouter.Handle("/Users/{id}", http.HandlerFunc(scimController.ReplaceUser)).Methods(http.MethodPut)
+		scimRouter.Handle("/Users/{id}", http.HandlerFunc(scimController.PatchUser)).Methods(http.MethodPatch)
+		scimRouter.Handle("/Users/{id}", http.HandlerFunc(scimController.DeleteUser)).Methods(http.MethodDelete)
+		scimRouter.Handle("/Groups", http.HandlerFunc(scimController.ListGroups)).Methods(http.MethodGet)
+		scimRouter.Handle("/Groups", http.HandlerFunc(scimController.UnsupportedGroupOperation)).Methods(http.MethodPost)
+		scimRouter.Handle("/Groups/{id}", http.HandlerFunc(scimController.GetGroup)).Methods(http.MethodGet)
+		scimRouter.Handle("/Groups/{id}", http.HandlerFunc(scimController.PatchGroup)).Methods(http.MethodPatch)
+		scimRouter.Handle("/Groups/{id}", http.HandlerFunc(scimController.UnsupportedGroupOperation)).Methods(http.MethodPut, http.MethodDelete)
+	}
+
 	if config.ABTesting.Enabled {
 		abController := consoleapi.NewABTesting(logger, abTesting)
 		abRouter := router.PathPrefix("/api/v0/ab").Subrouter()
@@ -351,6 +407,17 @@ func NewServer(logger *zap.Logger, config Config, service *console.Service, oidc
 		abRouter.Handle("/hit/{action}", http.HandlerFunc(abController.SendHit)).Methods(http.MethodPost, http.MethodOptions)
 	}
 
+	if config.LinksharingBrandingSecret != "" {
+		brandingRouter := router.PathPrefix("/api/v0/linksharing/branding").Subrouter()
+		brandingRouter.Use(server.withCORS)
+		brandingRouter.Handle("/{publicID}", server.ipRateLimiter.Limit(http.HandlerFunc(linksharingBrandingController.GetSigned))).Methods(http.MethodGet, http.MethodOptions)
+	}
+
+	maintenanceController := consoleapi.NewMaintenance(logger, maintenanceService)
+	maintenanceRouter := router.PathPrefix("/api/v0/maintenance").Subrouter()
+	maintenanceRouter.Use(server.withCORS)
+	maintenanceRouter.Handle("/active", http.HandlerFunc(maintenanceController.GetActive)).Methods(http.MethodGet, http.MethodOptions)
+
 	if config.BillingFeaturesEnabled {
 		paymentController := consoleapi.NewPayments(logger, service, accountFreezeService, packagePlans)
 		paymentsRouter := router.PathPrefix("/api/v0/payments").Subrouter()
@@ -388,9 +455,18 @@ func NewServer(logger *zap.Logger, config Config, service *console.Service, oidc
 			paymentsRouter.HandleFunc("/purchase-package", paymentController.PurchasePackage).Methods(http.MethodPost, http.MethodOptions)
 			paymentsRouter.HandleFunc("/package-available", paymentController.PackageAvailable).Methods(http.MethodGet, http.MethodOptions)
 		}
+		paymentsRouter.HandleFunc("/billing-contacts", paymentController.GetBillingContacts).Methods(http.MethodGet, http.MethodOptions)
+		paymentsRouter.Handle("/billing-contacts", server.userIDRateLimiter.Limit(http.HandlerFunc(paymentController.AddBillingContact))).Methods(http.MethodPost, http.MethodOptions)
+		paymentsRouter.Handle("/billing-contacts/{id}", server.userIDRateLimiter.Limit(http.HandlerFunc(paymentController.UpdateBillingContact))).Methods(http.MethodPatch, http.MethodOptions)
+		paymentsRouter.HandleFunc("/billing-contacts/{id}", paymentController.RemoveBillingContact).Methods(http.MethodDelete, http.MethodOptions)
+
+		billingContactsRouter := router.PathPrefix("/api/v0/billing-contacts").Subrouter()
+		billingContactsRouter.Use(server.withCORS)
+		billingContactsRouter.Handle("/view", server.ipRateLimiter.Limit(http.HandlerFunc(paymentController.GetBillingContactView))).Methods(http.MethodGet, http.MethodOptions)
+		billingContactsRouter.Handle("/links", server.ipRateLimiter.Limit(http.HandlerFunc(paymentController.RequestBillingContactLinks))).Methods(http.MethodPost, http.MethodOptions)
 	}
 
-	bucketsController := consoleapi.NewBuckets(logger, service)
+	bucketsController := consoleapi.NewBuckets(logger, service, server.nodeURL)
 	bucketsRouter := router.PathPrefix("/api/v0/buckets").Subrouter()
 	bucketsRouter.Use(server.withCORS)
 	bucketsRouter.Use(server.withAuth)
@@ -398,6 +474,14 @@ func NewServer(logger *zap.Logger, config Config, service *console.Service, oidc
 	bucketsRouter.HandleFunc("/bucket-placements", bucketsController.GetBucketMetadata).Methods(http.MethodGet, http.MethodOptions)
 	bucketsRouter.HandleFunc("/bucket-metadata", bucketsController.GetBucketMetadata).Methods(http.MethodGet, http.MethodOptions)
 	bucketsRouter.HandleFunc("/usage-totals", bucketsController.GetBucketTotals).Methods(http.MethodGet, http.MethodOptions)
+	bucketsRouter.HandleFunc("/inventory", bucketsController.GetInventory).Methods(http.MethodGet, http.MethodOptions)
+	bucketsRouter.HandleFunc("/inventory", bucketsController.SetInventory).Methods(http.MethodPut, http.MethodOptions)
+	bucketsRouter.HandleFunc("/inventory", bucketsController.DeleteInventory).Methods(http.MethodDelete, http.MethodOptions)
+	bucketsRouter.HandleFunc("/inventory/diff", bucketsController.DiffInventory).Methods(http.MethodGet, http.MethodOptions)
+	bucketsRouter.HandleFunc("/pins", bucketsController.ListObjectPins).Methods(http.MethodGet, http.MethodOptions)
+	bucketsRouter.HandleFunc("/pins", bucketsController.CreateObjectPin).Methods(http.MethodPost, http.MethodOptions)
+	bucketsRouter.HandleFunc("/pins/{name}", bucketsController.GetObjectPin).Methods(http.MethodGet, http.MethodOptions)
+	bucketsRouter.HandleFunc("/pins/{name}", bucketsController.ReleaseObjectPin).Methods(http.MethodDelete, http.MethodOptions)
 
 	apiKeysController := consoleapi.NewAPIKeys(logger, service)
 	apiKeysRouter := router.PathPrefix("/api/v0/api-keys").Subrouter()
@@ -406,6 +490,7 @@ func NewServer(logger *zap.Logger, config Config, service *console.Service, oidc
 	apiKeysRouter.Handle("/create/{projectID}", http.HandlerFunc(apiKeysController.CreateAPIKey)).Methods(http.MethodPost, http.MethodOptions)
 	apiKeysRouter.Handle("/delete-by-name", http.HandlerFunc(apiKeysController.DeleteByNameAndProjectID)).Methods(http.MethodDelete, http.MethodOptions)
 	apiKeysRouter.Handle("/delete-by-ids", http.HandlerFunc(apiKeysController.DeleteByIDs)).Methods(http.MethodDelete, http.MethodOptions)
+	apiKeysRouter.Handle("/revoke-everywhere/{id}", http.HandlerFunc(apiKeysController.RevokeEverywhere)).Methods(http.MethodPost, http.MethodOptions)
 	apiKeysRouter.HandleFunc("/list-paged", apiKeysController.GetProjectAPIKeys).Methods(http.MethodGet, http.MethodOptions)
 	apiKeysRouter.HandleFunc("/api-key-names", apiKeysController.GetAllAPIKeyNames).Methods(http.MethodGet, http.MethodOptions)
 
@@ -488,6 +573,14 @@ func (server *Server) Run(ctx context.Context) (err error) {
 		server.ipRateLimiter.Run(ctx)
 		return nil
 	})
+	group.Go(func() error {
+		server.partnerRateLimiter.Run(ctx)
+		return nil
+	})
+	group.Go(func() error {
+		server.partnerEmailLimiter.Run(ctx)
+		return nil
+	})
 	group.Go(func() error {
 		defer cancel()
 		err := server.server.Serve(server.listener)
@@ -809,10 +902,24 @@ func (server *Server) withAuth(handler http.Handler) http.Handler {
 		}
 		ctx = newCtx
 
+		// the session stays valid, so the cookie isn't removed.
+		if console.IsReadOnlySession(ctx) && !isReadOnlyMethod(r.Method) {
+			web.ServeJSONError(ctx, server.log, w, http.StatusForbidden, console.ErrForbidden.New(readOnlySessionErrMsg))
+			return
+		}
+
 		handler.ServeHTTP(w, r.Clone(ctx))
 	})
 }
 
+// readOnlySessionErrMsg is the error message of the changes attempted by read-only sessions.
+const readOnlySessionErrMsg = "This session is read-only and can't make changes"
+
+// isReadOnlyMethod returns whether requests with the method don't change anything.
+func isReadOnlyMethod(method string) bool {
+	return method == http.MethodGet || method == http.MethodHead || method == http.MethodOptions
+}
+
 // withRequest ensures the http request itself is reachable from the context.
 func (server *Server) withRequest(handler http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -31,6 +31,16 @@ type DB interface {
 	WebappSessions() consoleauth.WebappSessions
 	// AccountFreezeEvents is a getter for AccountFreezeEvents repository.
 	AccountFreezeEvents() AccountFreezeEvents
+	// LinksharingBrandings is a getter for LinksharingBrandings repository.
+	LinksharingBrandings() LinksharingBrandings
+	// ProjectInvitationPolicies is a getter for ProjectInvitationPolicies repository.
+	ProjectInvitationPolicies() ProjectInvitationPolicies
+	// TrustedDevices is a getter for TrustedDevices repository.
+	TrustedDevices() TrustedDevices
+	// BillingContacts is a getter for BillingContacts repository.
+	BillingContacts() BillingContacts
+	// SCIMUsers is a getter for SCIMUsers repository.
+	SCIMUsers() SCIMUsers
 
 	// WithTx is a method for executing transactions with retrying as necessary.
 	WithTx(ctx context.Context, fn func(ctx context.Context, tx DBTx) error) error
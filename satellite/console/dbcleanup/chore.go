@@ -25,6 +25,7 @@ type Config struct {
 	PageSize               int           `help:"maximum number of database records to scan at once" default:"1000"`
 
 	MaxUnverifiedUserAge time.Duration `help:"maximum lifetime of unverified user account records" default:"168h"`
+	MaxTrustedDeviceAge  time.Duration `help:"maximum time since the last login from a trusted device before it is forgotten" default:"2160h"`
 }
 
 // Chore periodically removes unwanted records from the satellite console database.
@@ -67,6 +68,12 @@ func (chore *Chore) Run(ctx context.Context) (err error) {
 			chore.log.Error("Error deleting expired API keys", zap.Error(err))
 		}
 
+		before = time.Now().Add(-chore.config.MaxTrustedDeviceAge)
+		err = chore.db.TrustedDevices().DeleteLastUsedBefore(ctx, before, chore.config.AsOfSystemTimeInterval, chore.config.PageSize)
+		if err != nil {
+			chore.log.Error("Error deleting unused trusted devices", zap.Error(err))
+		}
+
 		return nil
 	})
 }
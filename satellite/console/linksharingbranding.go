@@ -0,0 +1,69 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console
+
+import (
+	"context"
+	"net/url"
+	"regexp"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/uuid"
+)
+
+const (
+	maxBrandingLogoURLLength    = 2048
+	maxBrandingFooterLength     = 500
+	maxBrandingDisclaimerLength = 1000
+)
+
+var brandingColorRegexp = regexp.MustCompile(`^#[0-9a-fA-F]{6}$`)
+
+// LinksharingBrandings exposes methods to manage linksharing presentation settings in the database.
+//
+// architecture: Database
+type LinksharingBrandings interface {
+	// Get returns the linksharing branding settings of a project.
+	Get(ctx context.Context, projectID uuid.UUID) (*LinksharingBranding, error)
+	// Upsert updates the linksharing branding settings of a project if they exist and inserts them otherwise.
+	Upsert(ctx context.Context, branding LinksharingBranding) (*LinksharingBranding, error)
+	// Delete removes the linksharing branding settings of a project.
+	Delete(ctx context.Context, projectID uuid.UUID) error
+}
+
+// LinksharingBranding contains the presentation settings of a project's shared object pages.
+type LinksharingBranding struct {
+	ProjectID          uuid.UUID `json:"-"`
+	LogoURL            string    `json:"logoURL"`
+	PrimaryColor       string    `json:"primaryColor"`
+	Footer             string    `json:"footer"`
+	DownloadDisclaimer string    `json:"downloadDisclaimer"`
+	UpdatedAt          time.Time `json:"updatedAt"`
+}
+
+// Validate checks that the branding settings are well-formed.
+func (branding *LinksharingBranding) Validate() error {
+	var group errs.Group
+
+	if branding.LogoURL != "" {
+		if len(branding.LogoURL) > maxBrandingLogoURLLength {
+			group.Add(errs.New("logo URL must not be longer than %d characters", maxBrandingLogoURLLength))
+		} else if u, err := url.Parse(branding.LogoURL); err != nil || u.Scheme != "https" || u.Host == "" {
+			group.Add(errs.New("logo URL must be an absolute https URL"))
+		}
+	}
+	if branding.PrimaryColor != "" && !brandingColorRegexp.MatchString(branding.PrimaryColor) {
+		group.Add(errs.New("primary color must be in #rrggbb format"))
+	}
+	if len(branding.Footer) > maxBrandingFooterLength {
+		group.Add(errs.New("footer must not be longer than %d characters", maxBrandingFooterLength))
+	}
+	if len(branding.DownloadDisclaimer) > maxBrandingDisclaimerLength {
+		group.Add(errs.New("download disclaimer must not be longer than %d characters", maxBrandingDisclaimerLength))
+	}
+
+	return group.Err()
+}
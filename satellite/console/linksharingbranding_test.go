@@ -0,0 +1,75 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestLinksharingBrandingValidate(t *testing.T) {
+	valid := console.LinksharingBranding{
+		LogoURL:            "https://example.test/logo.png",
+		PrimaryColor:       "#0149FF",
+		Footer:             "Example footer",
+		DownloadDisclaimer: "Files are provided as-is.",
+	}
+	require.NoError(t, valid.Validate())
+	require.NoError(t, (&console.LinksharingBranding{}).Validate())
+
+	for _, invalid := range []console.LinksharingBranding{
+		{LogoURL: "http://example.test/logo.png"},
+		{LogoURL: "/logo.png"},
+		{LogoURL: "https://example.test/" + strings.Repeat("a", 2048)},
+		{PrimaryColor: "blue"},
+		{PrimaryColor: "#12345"},
+		{Footer: strings.Repeat("a", 501)},
+		{DownloadDisclaimer: strings.Repeat("a", 1001)},
+	} {
+		require.Error(t, invalid.Validate(), invalid)
+	}
+}
+
+func TestLinksharingBrandingsDB(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		brandings := db.Console().LinksharingBrandings()
+		projectID := testrand.UUID()
+
+		_, err := brandings.Get(ctx, projectID)
+		require.Error(t, err)
+
+		inserted, err := brandings.Upsert(ctx, console.LinksharingBranding{
+			ProjectID:    projectID,
+			LogoURL:      "https://example.test/logo.png",
+			PrimaryColor: "#0149ff",
+		})
+		require.NoError(t, err)
+		require.Equal(t, "https://example.test/logo.png", inserted.LogoURL)
+
+		updated, err := brandings.Upsert(ctx, console.LinksharingBranding{
+			ProjectID: projectID,
+			Footer:    "Example footer",
+		})
+		require.NoError(t, err)
+		require.Empty(t, updated.LogoURL)
+		require.Equal(t, "Example footer", updated.Footer)
+
+		got, err := brandings.Get(ctx, projectID)
+		require.NoError(t, err)
+		require.Equal(t, updated.Footer, got.Footer)
+		require.Equal(t, projectID, got.ProjectID)
+
+		require.NoError(t, brandings.Delete(ctx, projectID))
+		_, err = brandings.Get(ctx, projectID)
+		require.Error(t, err)
+	})
+}
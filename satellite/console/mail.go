@@ -198,6 +198,33 @@ func (*LoginLockAccountEmail) Template() string { return "LoginLockAccount" }
 // Subject gets email subject.
 func (*LoginLockAccountEmail) Subject() string { return "Account Lock" }
 
+// NewDeviceLoginEmail is mailservice template with data about a login from a device the user has not used before.
+type NewDeviceLoginEmail struct {
+	LoginTime   string
+	IPAddress   string
+	UserAgent   string
+	DevicesLink string
+}
+
+// Template returns email template name.
+func (*NewDeviceLoginEmail) Template() string { return "NewDeviceLogin" }
+
+// Subject gets email subject.
+func (*NewDeviceLoginEmail) Subject() string { return "New login to your account" }
+
+// BillingContactAccessEmail is mailservice template with a link to the billing view of a billing contact.
+type BillingContactAccessEmail struct {
+	OwnerEmail  string
+	BillingLink string
+	ExpiresAt   string
+}
+
+// Template returns email template name.
+func (*BillingContactAccessEmail) Template() string { return "BillingContactAccess" }
+
+// Subject gets email subject.
+func (*BillingContactAccessEmail) Subject() string { return "You were added as a billing contact" }
+
 // ActivationLockAccountEmail is mailservice template with activation lock account data.
 type ActivationLockAccountEmail struct {
 	LockoutDuration time.Duration
@@ -0,0 +1,110 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/uuid"
+)
+
+const (
+	maxInvitationPolicyDomains = 100
+	// MaxBulkInvitations is the maximum number of email addresses in a single bulk invitation.
+	MaxBulkInvitations = 500
+)
+
+// ErrInvitationPolicy occurs when an invitation is not allowed by the project invitation policy.
+var ErrInvitationPolicy = errs.Class("invitation policy violation")
+
+// ProjectInvitationPolicies exposes methods to manage project invitation policies in the database.
+//
+// architecture: Database
+type ProjectInvitationPolicies interface {
+	// Get returns the invitation policy of a project.
+	Get(ctx context.Context, projectID uuid.UUID) (*ProjectInvitationPolicy, error)
+	// Upsert updates the invitation policy of a project if it exists and inserts it otherwise.
+	Upsert(ctx context.Context, policy ProjectInvitationPolicy) (*ProjectInvitationPolicy, error)
+	// Delete removes the invitation policy of a project.
+	Delete(ctx context.Context, projectID uuid.UUID) error
+}
+
+// ProjectInvitationPolicy restricts which users may be invited to a project and
+// which role they get when accepting the invitation.
+type ProjectInvitationPolicy struct {
+	ProjectID uuid.UUID `json:"-"`
+	// AllowedEmailDomains lists the email domains which may be invited. Empty allows any domain.
+	AllowedEmailDomains []string `json:"allowedEmailDomains"`
+	// MaxPendingInvitations limits the number of unexpired invitations. Zero means unlimited.
+	MaxPendingInvitations int `json:"maxPendingInvitations"`
+	// DefaultRole is assigned to users who accept an invitation.
+	DefaultRole ProjectMemberRole `json:"defaultRole"`
+	UpdatedAt   time.Time         `json:"updatedAt"`
+}
+
+// Normalize lower-cases and deduplicates the allowed email domains.
+func (policy *ProjectInvitationPolicy) Normalize() {
+	domains := make([]string, 0, len(policy.AllowedEmailDomains))
+	seen := make(map[string]struct{}, len(policy.AllowedEmailDomains))
+	for _, domain := range policy.AllowedEmailDomains {
+		domain = strings.ToLower(strings.TrimPrefix(strings.TrimSpace(domain), "@"))
+		if _, ok := seen[domain]; ok {
+			continue
+		}
+		seen[domain] = struct{}{}
+		domains = append(domains, domain)
+	}
+	policy.AllowedEmailDomains = domains
+}
+
+// Validate checks that the invitation policy is well-formed.
+func (policy *ProjectInvitationPolicy) Validate() error {
+	var group errs.Group
+
+	if len(policy.AllowedEmailDomains) > maxInvitationPolicyDomains {
+		group.Add(errs.New("at most %d email domains may be allowed", maxInvitationPolicyDomains))
+	}
+	for _, domain := range policy.AllowedEmailDomains {
+		if domain == "" || strings.ContainsAny(domain, "@,; ") || !strings.Contains(domain, ".") {
+			group.Add(errs.New("invalid email domain %q", domain))
+		}
+	}
+	if policy.MaxPendingInvitations < 0 {
+		group.Add(errs.New("max pending invitations must not be negative"))
+	}
+	if policy.DefaultRole != RoleAdmin && policy.DefaultRole != RoleMember {
+		group.Add(errs.New("invalid default role %d", policy.DefaultRole))
+	}
+
+	return group.Err()
+}
+
+// AllowsEmail returns whether the email address belongs to one of the allowed domains.
+func (policy *ProjectInvitationPolicy) AllowsEmail(email string) bool {
+	if len(policy.AllowedEmailDomains) == 0 {
+		return true
+	}
+	at := strings.LastIndexByte(email, '@')
+	if at < 0 {
+		return false
+	}
+	domain := strings.ToLower(email[at+1:])
+	for _, allowed := range policy.AllowedEmailDomains {
+		if domain == allowed {
+			return true
+		}
+	}
+	return false
+}
+
+// BulkInvitationResult is the outcome of inviting a single address of a bulk invitation.
+type BulkInvitationResult struct {
+	Email   string `json:"email"`
+	Invited bool   `json:"invited"`
+	Error   string `json:"error,omitempty"`
+}
@@ -0,0 +1,96 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite/console"
+)
+
+func TestProjectInvitationPolicyValidate(t *testing.T) {
+	policy := console.ProjectInvitationPolicy{
+		AllowedEmailDomains: []string{" Example.TEST ", "@example.test", "corp.example.test"},
+		DefaultRole:         console.RoleMember,
+	}
+	policy.Normalize()
+	require.Equal(t, []string{"example.test", "corp.example.test"}, policy.AllowedEmailDomains)
+	require.NoError(t, policy.Validate())
+
+	require.True(t, policy.AllowsEmail("user@EXAMPLE.test"))
+	require.True(t, policy.AllowsEmail("user@corp.example.test"))
+	require.False(t, policy.AllowsEmail("user@other.test"))
+	require.False(t, policy.AllowsEmail("user"))
+	require.True(t, (&console.ProjectInvitationPolicy{}).AllowsEmail("user@other.test"))
+
+	for _, invalid := range []console.ProjectInvitationPolicy{
+		{AllowedEmailDomains: []string{"localhost"}},
+		{AllowedEmailDomains: []string{"a@b.test"}},
+		{MaxPendingInvitations: -1},
+		{DefaultRole: 5},
+	} {
+		require.Error(t, invalid.Validate(), invalid)
+	}
+}
+
+func TestBulkInviteProjectMembers(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{SatelliteCount: 1}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		service := sat.API.Console.Service
+
+		owner, err := sat.AddUser(ctx, console.CreateUser{
+			FullName: "Owner",
+			Email:    "owner@example.test",
+		}, 1)
+		require.NoError(t, err)
+		ownerCtx, err := sat.UserContext(ctx, owner.ID)
+		require.NoError(t, err)
+
+		project, err := sat.AddProject(ownerCtx, owner.ID, "Test Project")
+		require.NoError(t, err)
+
+		policy, err := service.GetProjectInvitationPolicy(ownerCtx, project.ID)
+		require.NoError(t, err)
+		require.Empty(t, policy.AllowedEmailDomains)
+		require.Equal(t, console.RoleMember, policy.DefaultRole)
+
+		_, err = service.UpdateProjectInvitationPolicy(ownerCtx, project.ID, console.ProjectInvitationPolicy{
+			AllowedEmailDomains:   []string{"example.test"},
+			DefaultRole:           console.RoleMember,
+			MaxPendingInvitations: 2,
+		})
+		require.NoError(t, err)
+
+		results, err := service.BulkInviteProjectMembers(ownerCtx, project.ID, []string{
+			"first@example.test",
+			"other@elsewhere.test",
+			"not an email",
+			"FIRST@example.test",
+			"second@example.test",
+			"third@example.test",
+		})
+		require.NoError(t, err)
+		require.Len(t, results, 5)
+
+		require.Equal(t, console.BulkInvitationResult{Email: "first@example.test", Invited: true}, results[0])
+		require.False(t, results[1].Invited)
+		require.NotEmpty(t, results[1].Error)
+		require.False(t, results[2].Invited)
+		require.NotEmpty(t, results[2].Error)
+		require.Equal(t, console.BulkInvitationResult{Email: "second@example.test", Invited: true}, results[3])
+		// the policy allows only two pending invitations.
+		require.False(t, results[4].Invited)
+		require.NotEmpty(t, results[4].Error)
+
+		_, err = service.InviteNewProjectMember(ownerCtx, project.ID, "fourth@elsewhere.test")
+		require.True(t, console.ErrInvitationPolicy.Has(err), err)
+
+		_, err = service.BulkInviteProjectMembers(ownerCtx, project.ID, nil)
+		require.True(t, console.ErrValidation.Has(err), err)
+	})
+}
@@ -3,6 +3,12 @@
 
 package console
 
+import (
+	"time"
+
+	"storj.io/common/uuid"
+)
+
 // ProjectUsageLimits holds project usage limits and current usage.
 type ProjectUsageLimits struct {
 	StorageLimit          int64  `json:"storageLimit"`
@@ -29,3 +35,34 @@ type UsageLimits struct {
 	RateLimit  *int  `json:"rateLimit"`
 	BurstLimit *int  `json:"burstLimit"`
 }
+
+// AccountUsage holds the usage of every project a user is a member of for a period.
+type AccountUsage struct {
+	Since  time.Time `json:"since"`
+	Before time.Time `json:"before"`
+
+	Storage      float64 `json:"storage"`
+	Egress       int64   `json:"egress"`
+	SegmentCount float64 `json:"segmentCount"`
+	ObjectCount  float64 `json:"objectCount"`
+
+	// CostCents is the estimated cost of the projects owned by the user.
+	CostCents int64 `json:"costCents"`
+
+	Projects []AccountProjectUsage `json:"projects"`
+}
+
+// AccountProjectUsage holds the usage of a single project for AccountUsage.
+type AccountProjectUsage struct {
+	ProjectID uuid.UUID `json:"projectID"`
+	Name      string    `json:"name"`
+	Owned     bool      `json:"owned"`
+
+	Storage      float64 `json:"storage"`
+	Egress       int64   `json:"egress"`
+	SegmentCount float64 `json:"segmentCount"`
+	ObjectCount  float64 `json:"objectCount"`
+
+	// CostCents is the estimated cost of the project. It's only known for owned projects.
+	CostCents *int64 `json:"costCents,omitempty"`
+}
@@ -0,0 +1,24 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console
+
+import (
+	"context"
+)
+
+// readOnlySessionKey is context key for read-only sessions.
+const readOnlySessionKey key = 2
+
+// WithReadOnlySession creates new context, which is authenticated by a
+// read-only session.
+func WithReadOnlySession(ctx context.Context) context.Context {
+	return context.WithValue(ctx, readOnlySessionKey, true)
+}
+
+// IsReadOnlySession returns whether the context is authenticated by a
+// read-only session, e.g. one issued to an admin impersonating the user.
+func IsReadOnlySession(ctx context.Context) bool {
+	readOnly, _ := ctx.Value(readOnlySessionKey).(bool)
+	return readOnly
+}
@@ -0,0 +1,539 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	"golang.org/x/crypto/bcrypt"
+
+	"storj.io/common/uuid"
+)
+
+// ErrSCIMNotFound occurs when a user or a group doesn't exist or isn't managed
+// by the SCIM provider.
+var ErrSCIMNotFound = errs.Class("scim resource not found")
+
+// SCIMProvider is an identity provider, which provisions the users of an
+// account and their project memberships through the SCIM API.
+type SCIMProvider struct {
+	// Name identifies the provider. It's recorded with the users that the
+	// provider provisions, and a provider can only manage those users.
+	Name string
+	// OwnerID is the ID of the user who owns the account. The groups of the
+	// provider are the projects owned by this user.
+	OwnerID uuid.UUID
+}
+
+// SCIMUserInfo contains the attributes of a user provisioned through the SCIM API.
+type SCIMUserInfo struct {
+	Email      string
+	FullName   string
+	ShortName  string
+	ExternalID string
+	Active     bool
+}
+
+// SCIMUserUpdate contains the attributes of a provisioned user to change. The
+// nil ones aren't changed.
+type SCIMUserUpdate struct {
+	Email      *string
+	FullName   *string
+	ShortName  *string
+	ExternalID *string
+	Active     *bool
+}
+
+// SCIMUserFilter filters the provisioned users. Only one of the fields is
+// expected to be set, when none is, all the users are returned.
+type SCIMUserFilter struct {
+	Email      string
+	ExternalID string
+}
+
+// ProvisionedUser is a user provisioned through the SCIM API.
+type ProvisionedUser struct {
+	User          *User
+	ExternalID    string
+	ProvisionedAt time.Time
+}
+
+// Active returns whether the provisioned user is active.
+func (user *ProvisionedUser) Active() bool {
+	return user.User.Status == Active
+}
+
+// SCIMGroup is a project of the account managed by a SCIM provider.
+type SCIMGroup struct {
+	Project *Project
+	// MemberIDs are the IDs of the members of the project provisioned by the
+	// provider. The owner and the members added by other means aren't included.
+	MemberIDs []uuid.UUID
+}
+
+// ProvisionUser creates an account for a user of the SCIM provider.
+//
+// The account doesn't need to be activated through email and it doesn't have
+// a password; the user sets one through the password reset flow.
+func (s *Service) ProvisionUser(ctx context.Context, provider SCIMProvider, info SCIMUserInfo) (_ *ProvisionedUser, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	info.Email = strings.TrimSpace(info.Email)
+	if info.Email == "" {
+		return nil, ErrValidation.New("userName is required")
+	}
+	if strings.TrimSpace(info.FullName) == "" {
+		return nil, ErrValidation.New("name is required")
+	}
+
+	if err := s.checkSCIMEmailUnused(ctx, info.Email, uuid.UUID{}); err != nil {
+		return nil, err
+	}
+
+	// the password is never revealed, it only makes the account usable
+	// with the password reset flow.
+	password := make([]byte, 32)
+	if _, err := rand.Read(password); err != nil {
+		return nil, Error.Wrap(err)
+	}
+	hash, err := bcrypt.GenerateFromPassword(password, s.config.PasswordCost)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	userID, err := uuid.New()
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	status := Active
+	if !info.Active {
+		status = Deactivated
+	}
+
+	newUser := &User{
+		ID:                    userID,
+		Email:                 info.Email,
+		FullName:              info.FullName,
+		ShortName:             info.ShortName,
+		PasswordHash:          hash,
+		ProjectLimit:          s.config.UsageLimits.Project.Free,
+		ProjectStorageLimit:   s.config.UsageLimits.Storage.Free.Int64(),
+		ProjectBandwidthLimit: s.config.UsageLimits.Bandwidth.Free.Int64(),
+		ProjectSegmentLimit:   s.config.UsageLimits.Segment.Free,
+	}
+	if s.config.FreeTrialDuration != 0 {
+		expiration := s.nowFn().Add(s.config.FreeTrialDuration)
+		newUser.TrialExpiration = &expiration
+	}
+
+	var provisioned *ProvisionedUser
+	err = s.store.WithTx(ctx, func(ctx context.Context, tx DBTx) error {
+		user, err := tx.Users().Insert(ctx, newUser)
+		if err != nil {
+			return err
+		}
+
+		err = tx.Users().Update(ctx, user.ID, UpdateUserRequest{Status: &status})
+		if err != nil {
+			return err
+		}
+		user.Status = status
+
+		record, err := tx.SCIMUsers().Insert(ctx, SCIMUser{
+			UserID:     user.ID,
+			Provider:   provider.Name,
+			ExternalID: info.ExternalID,
+		})
+		if err != nil {
+			return err
+		}
+
+		provisioned = &ProvisionedUser{
+			User:          user,
+			ExternalID:    record.ExternalID,
+			ProvisionedAt: record.CreatedAt,
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	s.auditLog(ctx, "scim: provision user", &userID, info.Email, zap.String("provider", provider.Name))
+
+	return provisioned, nil
+}
+
+// GetProvisionedUser returns a user provisioned by the SCIM provider.
+func (s *Service) GetProvisionedUser(ctx context.Context, provider SCIMProvider, id uuid.UUID) (_ *ProvisionedUser, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	record, err := s.store.SCIMUsers().Get(ctx, provider.Name, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrSCIMNotFound.New("user %s not found", id)
+		}
+		return nil, Error.Wrap(err)
+	}
+
+	return s.getProvisionedUser(ctx, record)
+}
+
+// ListProvisionedUsers returns the users provisioned by the SCIM provider
+// which match the filter, starting at offset, and the total number of them.
+func (s *Service) ListProvisionedUsers(ctx context.Context, provider SCIMProvider, filter SCIMUserFilter, offset, limit int) (_ []ProvisionedUser, total int, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var records []SCIMUser
+	switch {
+	case filter.Email != "":
+		verified, unverified, err := s.store.Users().GetByEmailWithUnverified(ctx, filter.Email)
+		if err != nil {
+			return nil, 0, Error.Wrap(err)
+		}
+		if verified != nil {
+			unverified = append(unverified, *verified)
+		}
+		for _, user := range unverified {
+			record, err := s.store.SCIMUsers().Get(ctx, provider.Name, user.ID)
+			if err != nil {
+				if errors.Is(err, sql.ErrNoRows) {
+					continue
+				}
+				return nil, 0, Error.Wrap(err)
+			}
+			records = append(records, *record)
+		}
+		total = len(records)
+		records = pageSCIMUsers(records, offset, limit)
+	case filter.ExternalID != "":
+		record, err := s.store.SCIMUsers().GetByExternalID(ctx, provider.Name, filter.ExternalID)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return nil, 0, nil
+			}
+			return nil, 0, Error.Wrap(err)
+		}
+		records = pageSCIMUsers([]SCIMUser{*record}, offset, limit)
+		total = 1
+	default:
+		records, total, err = s.store.SCIMUsers().List(ctx, provider.Name, offset, limit)
+		if err != nil {
+			return nil, 0, Error.Wrap(err)
+		}
+	}
+
+	users := make([]ProvisionedUser, 0, len(records))
+	for i := range records {
+		user, err := s.getProvisionedUser(ctx, &records[i])
+		if err != nil {
+			return nil, 0, err
+		}
+		users = append(users, *user)
+	}
+
+	return users, total, nil
+}
+
+// UpdateProvisionedUser changes the attributes of a user provisioned by the
+// SCIM provider.
+//
+// Deactivating the user signs it out of all its sessions. The provider can
+// only switch the user between active and deactivated, so other statuses set
+// by the satellite operators, e.g. legal hold, are kept.
+func (s *Service) UpdateProvisionedUser(ctx context.Context, provider SCIMProvider, id uuid.UUID, update SCIMUserUpdate) (_ *ProvisionedUser, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.GetProvisionedUser(ctx, provider, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var request UpdateUserRequest
+	if update.Email != nil {
+		email := strings.TrimSpace(*update.Email)
+		if email == "" {
+			return nil, ErrValidation.New("userName is required")
+		}
+		if !strings.EqualFold(email, user.User.Email) {
+			if err := s.checkSCIMEmailUnused(ctx, email, id); err != nil {
+				return nil, err
+			}
+		}
+		request.Email = &email
+	}
+	if update.FullName != nil {
+		if strings.TrimSpace(*update.FullName) == "" {
+			return nil, ErrValidation.New("name is required")
+		}
+		request.FullName = update.FullName
+	}
+	if update.ShortName != nil {
+		request.ShortName = &update.ShortName
+	}
+
+	deactivate := false
+	if update.Active != nil && (user.User.Status == Active || user.User.Status == Deactivated) {
+		status := Deactivated
+		if *update.Active {
+			status = Active
+		}
+		if status != user.User.Status {
+			request.Status = &status
+			deactivate = status == Deactivated
+		}
+	}
+
+	err = s.store.WithTx(ctx, func(ctx context.Context, tx DBTx) error {
+		// the update may only change the external ID, and an empty update
+		// of the user fails.
+		if request.Email != nil || request.FullName != nil || request.ShortName != nil || request.Status != nil {
+			err := tx.Users().Update(ctx, id, request)
+			if err != nil {
+				return err
+			}
+		}
+
+		if update.ExternalID != nil && *update.ExternalID != user.ExternalID {
+			err := tx.SCIMUsers().UpdateExternalID(ctx, provider.Name, id, *update.ExternalID)
+			if err != nil {
+				return err
+			}
+		}
+
+		if deactivate {
+			_, err := tx.WebappSessions().DeleteAllByUserID(ctx, id)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	if deactivate {
+		s.auditLog(ctx, "scim: deactivate user", &id, user.User.Email, zap.String("provider", provider.Name))
+	} else {
+		s.auditLog(ctx, "scim: update user", &id, user.User.Email, zap.String("provider", provider.Name))
+	}
+
+	return s.GetProvisionedUser(ctx, provider, id)
+}
+
+// DeprovisionUser deactivates a user provisioned by the SCIM provider, removes
+// it from the projects of the account and forgets that the provider
+// provisioned it. The account and its data are kept, so the satellite
+// operators can restore or delete it.
+func (s *Service) DeprovisionUser(ctx context.Context, provider SCIMProvider, id uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.GetProvisionedUser(ctx, provider, id)
+	if err != nil {
+		return err
+	}
+
+	memberships, err := s.store.ProjectMembers().GetByMemberID(ctx, id)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	var projectIDs []uuid.UUID
+	for _, membership := range memberships {
+		project, err := s.store.Projects().Get(ctx, membership.ProjectID)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		if project.OwnerID == provider.OwnerID {
+			projectIDs = append(projectIDs, project.ID)
+		}
+	}
+
+	err = s.store.WithTx(ctx, func(ctx context.Context, tx DBTx) error {
+		if user.User.Status == Active {
+			status := Deactivated
+			err := tx.Users().Update(ctx, id, UpdateUserRequest{Status: &status})
+			if err != nil {
+				return err
+			}
+		}
+
+		_, err := tx.WebappSessions().DeleteAllByUserID(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		for _, projectID := range projectIDs {
+			err = tx.ProjectMembers().Delete(ctx, id, projectID)
+			if err != nil {
+				return err
+			}
+		}
+
+		return tx.SCIMUsers().Delete(ctx, provider.Name, id)
+	})
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	s.auditLog(ctx, "scim: deprovision user", &id, user.User.Email, zap.String("provider", provider.Name))
+
+	return nil
+}
+
+// ListSCIMGroups returns the projects of the account managed by the SCIM
+// provider ordered by creation time.
+func (s *Service) ListSCIMGroups(ctx context.Context, provider SCIMProvider) (_ []SCIMGroup, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	projects, err := s.store.Projects().GetOwn(ctx, provider.OwnerID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	groups := make([]SCIMGroup, 0, len(projects))
+	for i := range projects {
+		memberIDs, err := s.store.SCIMUsers().ListProjectMembers(ctx, provider.Name, projects[i].ID)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		groups = append(groups, SCIMGroup{Project: &projects[i], MemberIDs: memberIDs})
+	}
+
+	return groups, nil
+}
+
+// GetSCIMGroup returns the project of the account managed by the SCIM provider
+// with the public ID.
+func (s *Service) GetSCIMGroup(ctx context.Context, provider SCIMProvider, publicID uuid.UUID) (_ *SCIMGroup, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	project, err := s.store.Projects().GetByPublicID(ctx, publicID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrSCIMNotFound.New("group %s not found", publicID)
+		}
+		return nil, Error.Wrap(err)
+	}
+	if project.OwnerID != provider.OwnerID {
+		return nil, ErrSCIMNotFound.New("group %s not found", publicID)
+	}
+
+	memberIDs, err := s.store.SCIMUsers().ListProjectMembers(ctx, provider.Name, project.ID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return &SCIMGroup{Project: project, MemberIDs: memberIDs}, nil
+}
+
+// UpdateSCIMGroupMembers adds and removes users provisioned by the SCIM
+// provider to and from a project of the account. Adding a member which already
+// is one, or removing a user which isn't, is a no-op.
+func (s *Service) UpdateSCIMGroupMembers(ctx context.Context, provider SCIMProvider, publicID uuid.UUID, add, remove []uuid.UUID) (_ *SCIMGroup, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	group, err := s.GetSCIMGroup(ctx, provider, publicID)
+	if err != nil {
+		return nil, err
+	}
+	projectID := group.Project.ID
+
+	for _, id := range append(append([]uuid.UUID{}, add...), remove...) {
+		if _, err := s.store.SCIMUsers().Get(ctx, provider.Name, id); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return nil, ErrValidation.New("member %s is not a user of the provider", id)
+			}
+			return nil, Error.Wrap(err)
+		}
+	}
+
+	err = s.store.WithTx(ctx, func(ctx context.Context, tx DBTx) error {
+		for _, id := range add {
+			_, err := tx.ProjectMembers().GetByMemberIDAndProjectID(ctx, id, projectID)
+			if err == nil {
+				continue
+			}
+			if !errors.Is(err, sql.ErrNoRows) {
+				return err
+			}
+
+			_, err = tx.ProjectMembers().Insert(ctx, id, projectID, RoleMember)
+			if err != nil {
+				return err
+			}
+		}
+
+		for _, id := range remove {
+			err := tx.ProjectMembers().Delete(ctx, id, projectID)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	s.auditLog(ctx, "scim: update project members", &provider.OwnerID, "",
+		zap.String("provider", provider.Name),
+		zap.Stringer("project", publicID),
+		zap.Int("added", len(add)),
+		zap.Int("removed", len(remove)),
+	)
+
+	return s.GetSCIMGroup(ctx, provider, publicID)
+}
+
+// checkSCIMEmailUnused returns an error if the email is used by an account
+// other than the user with the given ID.
+func (s *Service) checkSCIMEmailUnused(ctx context.Context, email string, id uuid.UUID) error {
+	verified, unverified, err := s.store.Users().GetByEmailWithUnverified(ctx, email)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if verified != nil && verified.ID != id {
+		return ErrEmailUsed.New(emailUsedErrMsg)
+	}
+	for _, user := range unverified {
+		if user.ID != id {
+			return ErrEmailUsed.New(emailUsedErrMsg)
+		}
+	}
+	return nil
+}
+
+func (s *Service) getProvisionedUser(ctx context.Context, record *SCIMUser) (*ProvisionedUser, error) {
+	user, err := s.store.Users().Get(ctx, record.UserID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return &ProvisionedUser{
+		User:          user,
+		ExternalID:    record.ExternalID,
+		ProvisionedAt: record.CreatedAt,
+	}, nil
+}
+
+func pageSCIMUsers(records []SCIMUser, offset, limit int) []SCIMUser {
+	if offset >= len(records) {
+		return nil
+	}
+	records = records[offset:]
+	if limit < len(records) {
+		records = records[:limit]
+	}
+	return records
+}
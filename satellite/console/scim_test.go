@@ -0,0 +1,148 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/common/uuid"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite/console"
+)
+
+func TestSCIMProvisioning(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		service := sat.API.Console.Service
+
+		owner, err := sat.AddUser(ctx, console.CreateUser{
+			FullName: "Account Owner",
+			Email:    "owner@example.test",
+		}, 1)
+		require.NoError(t, err)
+
+		project, err := sat.AddProject(ctx, owner.ID, "scim project")
+		require.NoError(t, err)
+
+		provider := console.SCIMProvider{Name: "okta", OwnerID: owner.ID}
+		other := console.SCIMProvider{Name: "other", OwnerID: owner.ID}
+
+		provisioned, err := service.ProvisionUser(ctx, provider, console.SCIMUserInfo{
+			Email:      "alice@example.test",
+			FullName:   "Alice Doe",
+			ShortName:  "Alice",
+			ExternalID: "00u1",
+			Active:     true,
+		})
+		require.NoError(t, err)
+		require.True(t, provisioned.Active())
+		require.Equal(t, "00u1", provisioned.ExternalID)
+		userID := provisioned.User.ID
+
+		_, err = service.ProvisionUser(ctx, provider, console.SCIMUserInfo{
+			Email:    owner.Email,
+			FullName: "Duplicate",
+			Active:   true,
+		})
+		require.True(t, console.ErrEmailUsed.Has(err))
+
+		t.Run("get and list", func(t *testing.T) {
+			got, err := service.GetProvisionedUser(ctx, provider, userID)
+			require.NoError(t, err)
+			require.Equal(t, "alice@example.test", got.User.Email)
+
+			// other providers can't see the user.
+			_, err = service.GetProvisionedUser(ctx, other, userID)
+			require.True(t, console.ErrSCIMNotFound.Has(err))
+			_, err = service.GetProvisionedUser(ctx, provider, owner.ID)
+			require.True(t, console.ErrSCIMNotFound.Has(err))
+
+			users, total, err := service.ListProvisionedUsers(ctx, provider, console.SCIMUserFilter{}, 0, 10)
+			require.NoError(t, err)
+			require.Equal(t, 1, total)
+			require.Len(t, users, 1)
+
+			users, total, err = service.ListProvisionedUsers(ctx, provider, console.SCIMUserFilter{ExternalID: "00u1"}, 0, 10)
+			require.NoError(t, err)
+			require.Equal(t, 1, total)
+			require.Equal(t, userID, users[0].User.ID)
+
+			users, total, err = service.ListProvisionedUsers(ctx, provider, console.SCIMUserFilter{Email: "alice@example.test"}, 0, 10)
+			require.NoError(t, err)
+			require.Equal(t, 1, total)
+			require.Equal(t, userID, users[0].User.ID)
+
+			users, total, err = service.ListProvisionedUsers(ctx, provider, console.SCIMUserFilter{Email: owner.Email}, 0, 10)
+			require.NoError(t, err)
+			require.Zero(t, total)
+			require.Empty(t, users)
+		})
+
+		t.Run("groups", func(t *testing.T) {
+			groups, err := service.ListSCIMGroups(ctx, provider)
+			require.NoError(t, err)
+			require.Len(t, groups, 1)
+			require.Equal(t, project.PublicID, groups[0].Project.PublicID)
+			// the owner wasn't provisioned by the provider.
+			require.Empty(t, groups[0].MemberIDs)
+
+			group, err := service.UpdateSCIMGroupMembers(ctx, provider, project.PublicID, []uuid.UUID{userID, userID}, nil)
+			require.NoError(t, err)
+			require.Equal(t, []uuid.UUID{userID}, group.MemberIDs)
+
+			member, err := sat.DB.Console().ProjectMembers().GetByMemberIDAndProjectID(ctx, userID, project.ID)
+			require.NoError(t, err)
+			require.Equal(t, console.RoleMember, member.Role)
+
+			// only the users of the provider can be added.
+			_, err = service.UpdateSCIMGroupMembers(ctx, provider, project.PublicID, []uuid.UUID{owner.ID}, nil)
+			require.True(t, console.ErrValidation.Has(err))
+
+			_, err = service.GetSCIMGroup(ctx, provider, testrand.UUID())
+			require.True(t, console.ErrSCIMNotFound.Has(err))
+		})
+
+		t.Run("deactivate", func(t *testing.T) {
+			deactivate := false
+			externalID := "00u2"
+			updated, err := service.UpdateProvisionedUser(ctx, provider, userID, console.SCIMUserUpdate{
+				Active:     &deactivate,
+				ExternalID: &externalID,
+			})
+			require.NoError(t, err)
+			require.False(t, updated.Active())
+			require.Equal(t, console.Deactivated, updated.User.Status)
+			require.Equal(t, "00u2", updated.ExternalID)
+
+			activate := true
+			updated, err = service.UpdateProvisionedUser(ctx, provider, userID, console.SCIMUserUpdate{Active: &activate})
+			require.NoError(t, err)
+			require.True(t, updated.Active())
+
+			usedEmail := owner.Email
+			_, err = service.UpdateProvisionedUser(ctx, provider, userID, console.SCIMUserUpdate{Email: &usedEmail})
+			require.True(t, console.ErrEmailUsed.Has(err))
+		})
+
+		t.Run("deprovision", func(t *testing.T) {
+			require.NoError(t, service.DeprovisionUser(ctx, provider, userID))
+
+			user, err := sat.DB.Console().Users().Get(ctx, userID)
+			require.NoError(t, err)
+			require.Equal(t, console.Deactivated, user.Status)
+
+			_, err = sat.DB.Console().ProjectMembers().GetByMemberIDAndProjectID(ctx, userID, project.ID)
+			require.Error(t, err)
+
+			_, err = service.GetProvisionedUser(ctx, provider, userID)
+			require.True(t, console.ErrSCIMNotFound.Has(err))
+		})
+	})
+}
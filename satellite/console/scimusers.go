@@ -0,0 +1,43 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console
+
+import (
+	"context"
+	"time"
+
+	"storj.io/common/uuid"
+)
+
+// SCIMUsers exposes methods to manage the records of the users provisioned by
+// identity providers through the SCIM API.
+//
+// architecture: Database
+type SCIMUsers interface {
+	// Insert records that the user was provisioned by the provider.
+	Insert(ctx context.Context, user SCIMUser) (*SCIMUser, error)
+	// Get returns the record of the user provisioned by the provider.
+	Get(ctx context.Context, provider string, userID uuid.UUID) (*SCIMUser, error)
+	// GetByExternalID returns the record of the user provisioned by the provider
+	// with the ID of the user in the provider.
+	GetByExternalID(ctx context.Context, provider, externalID string) (*SCIMUser, error)
+	// List returns the records of the users provisioned by the provider ordered
+	// by provisioning time, and the total number of them.
+	List(ctx context.Context, provider string, offset, limit int) (_ []SCIMUser, total int, err error)
+	// ListProjectMembers returns the IDs of the members of the project which
+	// were provisioned by the provider.
+	ListProjectMembers(ctx context.Context, provider string, projectID uuid.UUID) ([]uuid.UUID, error)
+	// UpdateExternalID changes the ID of the user in the provider.
+	UpdateExternalID(ctx context.Context, provider string, userID uuid.UUID, externalID string) error
+	// Delete removes the record of the user provisioned by the provider.
+	Delete(ctx context.Context, provider string, userID uuid.UUID) error
+}
+
+// SCIMUser is the record of a user provisioned by an identity provider.
+type SCIMUser struct {
+	UserID     uuid.UUID
+	Provider   string
+	ExternalID string
+	CreatedAt  time.Time
+}
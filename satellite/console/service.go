@@ -15,6 +15,7 @@ import (
 	"math/big"
 	mathrand "math/rand"
 	"net/http"
+	"net/mail"
 	"sort"
 	"strconv"
 	"strings"
@@ -31,6 +32,7 @@ import (
 	"storj.io/common/http/requestid"
 	"storj.io/common/macaroon"
 	"storj.io/common/memory"
+	"storj.io/common/storj"
 	"storj.io/common/uuid"
 	"storj.io/storj/private/api"
 	"storj.io/storj/private/blockchain"
@@ -42,9 +44,13 @@ import (
 	"storj.io/storj/satellite/emission"
 	"storj.io/storj/satellite/kms"
 	"storj.io/storj/satellite/mailservice"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/bucketinventory"
+	"storj.io/storj/satellite/metabase/objectpins"
 	"storj.io/storj/satellite/nodeselection"
 	"storj.io/storj/satellite/payments"
 	"storj.io/storj/satellite/payments/billing"
+	"storj.io/storj/satellite/revocation"
 	"storj.io/storj/satellite/satellitedb/dbx"
 )
 
@@ -54,6 +60,10 @@ const (
 	// maxLimit specifies the limit for all paged queries.
 	maxLimit = 300
 
+	// defaultInventoryDiffLimit and maxInventoryDiffLimit bound the entries of every kind in an inventory diff.
+	defaultInventoryDiffLimit = 1000
+	maxInventoryDiffLimit     = 10000
+
 	// TestPasswordCost is the hashing complexity to use for testing.
 	TestPasswordCost = bcrypt.MinCost
 )
@@ -75,6 +85,7 @@ const (
 	projectOwnerDeletionForbiddenErrMsg  = "%s is a project owner and can not be deleted"
 	apiKeyWithNameExistsErrMsg           = "An API Key with this name already exists in this project, please use a different name"
 	apiKeyWithNameDoesntExistErrMsg      = "An API Key with this name doesn't exist in this project."
+	apiKeyDoesntExistErrMsg              = "This API Key doesn't exist"
 	teamMemberDoesNotExistErrMsg         = "There are no team members with the email '%s'. Please try again."
 	activationTokenExpiredErrMsg         = "This activation token has expired, please request another one"
 	usedRegTokenErrMsg                   = "This registration token has already been used"
@@ -214,6 +225,8 @@ type Service struct {
 	accountFreezeService       *AccountFreezeService
 	emission                   *emission.Service
 	kmsService                 *kms.Service
+	objectPins                 *objectpins.Service
+	revocations                *revocation.Propagator
 
 	satelliteAddress string
 	satelliteName    string
@@ -251,7 +264,7 @@ type Payments struct {
 func NewService(log *zap.Logger, store DB, restKeys RESTKeys, projectAccounting accounting.ProjectAccounting,
 	projectUsage *accounting.Service, buckets buckets.DB, accounts payments.Accounts, depositWallets payments.DepositWallets,
 	billingDb billing.TransactionsDB, analytics *analytics.Service, tokens *consoleauth.Service, mailService *mailservice.Service,
-	accountFreezeService *AccountFreezeService, emission *emission.Service, kmsService *kms.Service, satelliteAddress string,
+	accountFreezeService *AccountFreezeService, emission *emission.Service, kmsService *kms.Service, objectPins *objectpins.Service, satelliteAddress string,
 	satelliteName string, maxProjectBuckets int, placements nodeselection.PlacementDefinitions,
 	versioning VersioningConfig, config Config) (*Service, error) {
 	if store == nil {
@@ -322,6 +335,8 @@ func NewService(log *zap.Logger, store DB, restKeys RESTKeys, projectAccounting
 		accountFreezeService:       accountFreezeService,
 		emission:                   emission,
 		kmsService:                 kmsService,
+		objectPins:                 objectPins,
+		revocations:                revocation.NewPropagator(log.Named("revocation"), config.RevocationPropagation),
 		satelliteAddress:           satelliteAddress,
 		satelliteName:              satelliteName,
 		maxProjectBuckets:          maxProjectBuckets,
@@ -761,6 +776,292 @@ func (payment Payments) InvoiceHistory(ctx context.Context, cursor payments.Invo
 	}, nil
 }
 
+// GetBillingContacts returns the billing contacts of the user.
+func (payment Payments) GetBillingContacts(ctx context.Context) (_ []BillingContact, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := payment.service.getUserAndAuditLog(ctx, "get billing contacts")
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	contacts, err := payment.service.store.BillingContacts().ListByUserID(ctx, user.ID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return contacts, nil
+}
+
+// AddBillingContact adds a billing contact to the user and sends it a link to the billing view.
+func (payment Payments) AddBillingContact(ctx context.Context, email string) (_ *BillingContact, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	email = strings.ToLower(strings.TrimSpace(email))
+
+	user, err := payment.service.getUserAndAuditLog(ctx, "add billing contact", zap.String("Billing Contact", email))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	if err := payment.validateBillingContactEmail(user, email); err != nil {
+		return nil, err
+	}
+
+	contacts, err := payment.service.store.BillingContacts().ListByUserID(ctx, user.ID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	if len(contacts) >= payment.service.config.MaxBillingContacts {
+		return nil, ErrValidation.New("an account can't have more than %d billing contacts", payment.service.config.MaxBillingContacts)
+	}
+	for _, contact := range contacts {
+		if contact.Email == email {
+			return nil, ErrConflict.New("%s is already a billing contact", email)
+		}
+	}
+
+	id, err := uuid.New()
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	contact, err := payment.service.store.BillingContacts().Insert(ctx, BillingContact{
+		ID:     id,
+		UserID: user.ID,
+		Email:  email,
+	})
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	payment.service.sendBillingContactLink(ctx, user.Email, *contact)
+
+	return contact, nil
+}
+
+// UpdateBillingContact changes the email of a billing contact of the user. Links
+// sent to the previous email stop working.
+func (payment Payments) UpdateBillingContact(ctx context.Context, id uuid.UUID, email string) (_ *BillingContact, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	email = strings.ToLower(strings.TrimSpace(email))
+
+	user, err := payment.service.getUserAndAuditLog(ctx, "update billing contact",
+		zap.Stringer("Billing Contact ID", id), zap.String("Billing Contact", email))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	if err := payment.validateBillingContactEmail(user, email); err != nil {
+		return nil, err
+	}
+
+	contacts, err := payment.service.store.BillingContacts().ListByUserID(ctx, user.ID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	for _, contact := range contacts {
+		if contact.Email == email && contact.ID != id {
+			return nil, ErrConflict.New("%s is already a billing contact", email)
+		}
+	}
+
+	contact, err := payment.service.store.BillingContacts().UpdateEmail(ctx, user.ID, id, email)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrValidation.New("billing contact does not exist")
+		}
+		return nil, Error.Wrap(err)
+	}
+
+	payment.service.sendBillingContactLink(ctx, user.Email, *contact)
+
+	return contact, nil
+}
+
+// RemoveBillingContact removes a billing contact of the user. Links sent to
+// the contact stop working.
+func (payment Payments) RemoveBillingContact(ctx context.Context, id uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := payment.service.getUserAndAuditLog(ctx, "remove billing contact", zap.Stringer("Billing Contact ID", id))
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	return Error.Wrap(payment.service.store.BillingContacts().Delete(ctx, user.ID, id))
+}
+
+func (payment Payments) validateBillingContactEmail(user *User, email string) error {
+	if address, err := mail.ParseAddress(email); err != nil || address.Address != email {
+		return ErrValidation.New("invalid email address")
+	}
+	if strings.EqualFold(email, user.Email) {
+		return ErrValidation.New("the account owner can't be a billing contact")
+	}
+	return nil
+}
+
+// RequestBillingContactLinks sends new links to the billing view to every billing
+// contact with the given email. Nothing is sent, when there is none.
+func (s *Service) RequestBillingContactLinks(ctx context.Context, email string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	email = strings.ToLower(strings.TrimSpace(email))
+	s.auditLog(ctx, "request billing contact links", nil, email)
+
+	contacts, err := s.store.BillingContacts().ListByEmail(ctx, email)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	for _, contact := range contacts {
+		owner, err := s.store.Users().Get(ctx, contact.UserID)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		s.sendBillingContactLink(ctx, owner.Email, contact)
+	}
+
+	return nil
+}
+
+// GetBillingContactView returns the billing view of the billing contact the
+// token was sent to. It only contains the invoices of the account.
+func (s *Service) GetBillingContactView(ctx context.Context, token string, cursor payments.InvoiceCursor) (_ *BillingContactView, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	contact, err := s.parseBillingContactToken(ctx, token)
+	if err != nil {
+		s.auditLog(ctx, "get billing contact view: invalid token", nil, "", zap.Error(err))
+		return nil, err
+	}
+	s.auditLog(ctx, "get billing contact view", &contact.UserID, contact.Email, zap.Stringer("Billing Contact ID", contact.ID))
+
+	owner, err := s.store.Users().Get(ctx, contact.UserID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	page, err := s.accounts.Invoices().ListPaged(ctx, contact.UserID, cursor)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	invoices := &BillingHistoryPage{
+		Items:    make([]BillingHistoryItem, 0, len(page.Invoices)),
+		Next:     page.Next,
+		Previous: page.Previous,
+	}
+	for _, invoice := range page.Invoices {
+		invoices.Items = append(invoices.Items, BillingHistoryItem{
+			ID:          invoice.ID,
+			Description: invoice.Description,
+			Amount:      invoice.Amount,
+			Status:      invoice.Status,
+			Link:        invoice.Link,
+			End:         invoice.End,
+			Start:       invoice.Start,
+			Type:        Invoice,
+		})
+	}
+
+	return &BillingContactView{
+		OwnerEmail: owner.Email,
+		Invoices:   invoices,
+	}, nil
+}
+
+// createBillingContactToken creates a token granting the billing contact access
+// to the billing view until it expires or the contact is changed.
+func (s *Service) createBillingContactToken(contact BillingContact, expiration time.Time) (_ string, err error) {
+	claims := consoleauth.Claims{
+		ID:         contact.ID,
+		Email:      contact.Email,
+		Expiration: expiration,
+	}
+
+	claimJSON, err := claims.JSON()
+	if err != nil {
+		return "", err
+	}
+
+	token := consoleauth.Token{Payload: claimJSON}
+	token.Signature, err = s.tokens.SignToken(token)
+	if err != nil {
+		return "", err
+	}
+
+	return token.String(), nil
+}
+
+// parseBillingContactToken returns the billing contact of a valid token.
+func (s *Service) parseBillingContactToken(ctx context.Context, token string) (_ *BillingContact, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	parsedToken, err := consoleauth.FromBase64URLString(token)
+	if err != nil {
+		return nil, ErrTokenInvalid.Wrap(err)
+	}
+
+	valid, err := s.tokens.ValidateToken(parsedToken)
+	if err != nil {
+		return nil, ErrTokenInvalid.Wrap(err)
+	}
+	if !valid {
+		return nil, ErrTokenInvalid.New("incorrect signature")
+	}
+
+	claims, err := consoleauth.FromJSON(parsedToken.Payload)
+	if err != nil {
+		return nil, ErrTokenInvalid.New("JSON decoder: %w", err)
+	}
+
+	if s.nowFn().After(claims.Expiration) {
+		return nil, ErrTokenExpiration.New("billing contact token expired")
+	}
+
+	contact, err := s.store.BillingContacts().Get(ctx, claims.ID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrTokenInvalid.New("billing contact was removed")
+		}
+		return nil, Error.Wrap(err)
+	}
+	if contact.Email != claims.Email {
+		return nil, ErrTokenInvalid.New("billing contact was changed")
+	}
+
+	return contact, nil
+}
+
+// sendBillingContactLink emails a link to the billing view to the billing contact.
+func (s *Service) sendBillingContactLink(ctx context.Context, ownerEmail string, contact BillingContact) {
+	expiration := s.nowFn().Add(s.config.BillingContactLinkExpiration)
+
+	token, err := s.createBillingContactToken(contact, expiration)
+	if err != nil {
+		s.log.Error("failed to create billing contact token", zap.Stringer("Billing Contact ID", contact.ID), zap.Error(err))
+		return
+	}
+
+	address := s.satelliteAddress
+	if !strings.HasSuffix(address, "/") {
+		address += "/"
+	}
+
+	s.mailService.SendRenderedAsync(
+		ctx,
+		[]post.Address{{Address: contact.Email}},
+		&BillingContactAccessEmail{
+			OwnerEmail:  ownerEmail,
+			BillingLink: address + "billing-access?token=" + token,
+			ExpiresAt:   expiration.UTC().Format(time.RFC1123),
+		},
+	)
+}
+
 // checkProjectInvoicingStatus returns error if for the given project there are outstanding project records and/or usage
 // which have not been applied/invoiced yet (meaning sent over to stripe).
 func (payment Payments) checkProjectInvoicingStatus(ctx context.Context, projectID uuid.UUID) (err error) {
@@ -951,6 +1252,7 @@ func (s *Service) CreateUser(ctx context.Context, user CreateUser, tokenSecret R
 			EmployeeCount:    user.EmployeeCount,
 			HaveSalesContact: user.HaveSalesContact,
 			SignupPromoCode:  user.SignupPromoCode,
+			SignupReferral:   user.SignupReferral,
 			SignupCaptcha:    captchaScore,
 			ActivationCode:   user.ActivationCode,
 			SignupId:         user.SignupId,
@@ -1150,6 +1452,12 @@ func (s *Service) ActivateAccount(ctx context.Context, activationToken string) (
 		return nil, Error.Wrap(err)
 	}
 
+	// the identity provider which deactivated the user is the only one
+	// allowed to activate it again.
+	if user.Status == Deactivated {
+		return nil, ErrTokenInvalid.New("user was deactivated by its identity provider")
+	}
+
 	err = s.SetAccountActive(ctx, user)
 	if err != nil {
 		return nil, err
@@ -1551,11 +1859,122 @@ func (s *Service) Token(ctx context.Context, request AuthUser) (response *TokenI
 		return nil, err
 	}
 
+	s.recordLoginDevice(ctx, user, request.IP, request.UserAgent, now)
+
 	mon.Counter("login_success").Inc(1) //mon:locked
 
 	return response, nil
 }
 
+// recordLoginDevice remembers the device a user has logged in from and, if the user
+// has opted in, notifies them by email about logins from devices not seen before.
+// Failures are logged rather than returned since they must not prevent the login.
+func (s *Service) recordLoginDevice(ctx context.Context, user *User, ip, userAgent string, now time.Time) {
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	devices := s.store.TrustedDevices()
+
+	id, err := uuid.New()
+	if err != nil {
+		s.log.Warn("failed to generate trusted device ID", zap.Error(err))
+		return
+	}
+
+	isNew, err := devices.Upsert(ctx, TrustedDevice{
+		ID:          id,
+		UserID:      user.ID,
+		Fingerprint: DeviceFingerprint(userAgent, ip),
+		UserAgent:   userAgent,
+		IPAddress:   ip,
+	})
+	if err != nil {
+		s.log.Warn("failed to record trusted device", zap.Stringer("User ID", user.ID), zap.Error(err))
+		return
+	}
+
+	// logins from known devices only need the single upsert above.
+	if !isNew {
+		return
+	}
+
+	count, err := devices.CountByUserID(ctx, user.ID)
+	if err != nil {
+		s.log.Warn("failed to count trusted devices", zap.Stringer("User ID", user.ID), zap.Error(err))
+		return
+	}
+	if s.config.MaxTrustedDevices > 0 && count > s.config.MaxTrustedDevices {
+		err = devices.DeleteLeastRecentlyUsed(ctx, user.ID, s.config.MaxTrustedDevices)
+		if err != nil {
+			s.log.Warn("failed to forget trusted devices", zap.Stringer("User ID", user.ID), zap.Error(err))
+		}
+	}
+
+	// the first device a user logs in from is not worth an alert.
+	if count <= 1 {
+		return
+	}
+	mon.Counter("login_new_device").Inc(1)
+
+	settings, err := s.store.Users().GetSettings(ctx, user.ID)
+	if err != nil {
+		if !errs.Is(err, sql.ErrNoRows) {
+			s.log.Warn("failed to get user settings", zap.Stringer("User ID", user.ID), zap.Error(err))
+		}
+		return
+	}
+	if !settings.LoginAlerts {
+		return
+	}
+
+	address := s.satelliteAddress
+	if !strings.HasSuffix(address, "/") {
+		address += "/"
+	}
+
+	s.mailService.SendRenderedAsync(
+		ctx,
+		[]post.Address{{Address: user.Email, Name: user.FullName}},
+		&NewDeviceLoginEmail{
+			LoginTime:   now.UTC().Format(time.RFC1123),
+			IPAddress:   ip,
+			UserAgent:   userAgent,
+			DevicesLink: address + "account/settings",
+		},
+	)
+	s.auditLog(ctx, "login: new device alert sent", &user.ID, user.Email)
+}
+
+// GetTrustedDevices returns the devices the user has logged in from.
+func (s *Service) GetTrustedDevices(ctx context.Context) (_ []TrustedDevice, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "get trusted devices")
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	devices, err := s.store.TrustedDevices().ListByUserID(ctx, user.ID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return devices, nil
+}
+
+// RemoveTrustedDevice removes one of the devices the user has logged in from.
+// The next login from that device is treated as a login from a new device.
+func (s *Service) RemoveTrustedDevice(ctx context.Context, id uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "remove trusted device", zap.Stringer("Device ID", id))
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	return Error.Wrap(s.store.TrustedDevices().Delete(ctx, user.ID, id))
+}
+
 // TokenByAPIKey authenticates User by API Key and returns session token.
 func (s *Service) TokenByAPIKey(ctx context.Context, userAgent string, ip string, apiKey string) (response *TokenInfo, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -2442,35 +2861,123 @@ func (s *Service) UpdateVersioningOptInStatus(ctx context.Context, projectID uui
 	return Error.Wrap(s.store.Projects().UpdateDefaultVersioning(ctx, project.ID, versioning))
 }
 
-// RequestLimitIncrease is a method for requesting limit increase for a project.
-func (s *Service) RequestLimitIncrease(ctx context.Context, projectID uuid.UUID, info LimitRequestInfo) (err error) {
+// GetLinksharingBranding returns the linksharing presentation settings of a project.
+// Projects without custom settings get empty settings.
+func (s *Service) GetLinksharingBranding(ctx context.Context, projectID uuid.UUID) (_ *LinksharingBranding, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	user, err := s.getUserAndAuditLog(ctx, "request limit increase", zap.String("projectID", projectID.String()))
+	user, err := s.getUserAndAuditLog(ctx, "get linksharing branding", zap.String("projectID", projectID.String()))
 	if err != nil {
-		return Error.Wrap(err)
+		return nil, Error.Wrap(err)
 	}
 
-	_, project, err := s.isProjectOwner(ctx, user.ID, projectID)
+	isMember, err := s.isProjectMember(ctx, user.ID, projectID)
 	if err != nil {
-		return Error.Wrap(err)
+		return nil, Error.Wrap(err)
 	}
 
-	s.analytics.TrackRequestLimitIncrease(user.ID, user.Email, analytics.LimitRequestInfo{
-		ProjectName:  project.Name,
-		LimitType:    info.LimitType,
-		CurrentLimit: info.CurrentLimit.String(),
-		DesiredLimit: info.DesiredLimit.String(),
-	})
-
-	return nil
+	return s.getLinksharingBranding(ctx, isMember.project.ID)
 }
 
-// RequestProjectLimitIncrease is a method for requesting to increase max number of projects for a user.
-func (s *Service) RequestProjectLimitIncrease(ctx context.Context, limit string) (err error) {
+// UpdateLinksharingBranding validates and stores the linksharing presentation settings of a project.
+func (s *Service) UpdateLinksharingBranding(ctx context.Context, projectID uuid.UUID, branding LinksharingBranding) (_ *LinksharingBranding, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	user, err := s.getUserAndAuditLog(ctx, "request project limit increase")
+	user, err := s.getUserAndAuditLog(ctx, "update linksharing branding", zap.String("projectID", projectID.String()))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	_, project, err := s.isProjectOwner(ctx, user.ID, projectID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	branding.LogoURL = strings.TrimSpace(branding.LogoURL)
+	branding.PrimaryColor = strings.TrimSpace(branding.PrimaryColor)
+	if err = branding.Validate(); err != nil {
+		return nil, ErrValidation.Wrap(err)
+	}
+
+	branding.ProjectID = project.ID
+	updated, err := s.store.LinksharingBrandings().Upsert(ctx, branding)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return updated, nil
+}
+
+// DeleteLinksharingBranding resets the linksharing presentation settings of a project to the defaults.
+func (s *Service) DeleteLinksharingBranding(ctx context.Context, projectID uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "delete linksharing branding", zap.String("projectID", projectID.String()))
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	_, project, err := s.isProjectOwner(ctx, user.ID, projectID)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	return Error.Wrap(s.store.LinksharingBrandings().Delete(ctx, project.ID))
+}
+
+// GetLinksharingBrandingNoAuth returns the linksharing presentation settings of a project
+// without checking user permissions. It is used to serve the settings to the linksharing service.
+func (s *Service) GetLinksharingBrandingNoAuth(ctx context.Context, publicProjectID uuid.UUID) (_ *LinksharingBranding, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	project, err := s.store.Projects().GetByPublicID(ctx, publicProjectID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return s.getLinksharingBranding(ctx, project.ID)
+}
+
+func (s *Service) getLinksharingBranding(ctx context.Context, projectID uuid.UUID) (_ *LinksharingBranding, err error) {
+	branding, err := s.store.LinksharingBrandings().Get(ctx, projectID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return &LinksharingBranding{ProjectID: projectID}, nil
+		}
+		return nil, Error.Wrap(err)
+	}
+	return branding, nil
+}
+
+// RequestLimitIncrease is a method for requesting limit increase for a project.
+func (s *Service) RequestLimitIncrease(ctx context.Context, projectID uuid.UUID, info LimitRequestInfo) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "request limit increase", zap.String("projectID", projectID.String()))
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	_, project, err := s.isProjectOwner(ctx, user.ID, projectID)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	s.analytics.TrackRequestLimitIncrease(user.ID, user.Email, analytics.LimitRequestInfo{
+		ProjectName:  project.Name,
+		LimitType:    info.LimitType,
+		CurrentLimit: info.CurrentLimit.String(),
+		DesiredLimit: info.DesiredLimit.String(),
+	})
+
+	return nil
+}
+
+// RequestProjectLimitIncrease is a method for requesting to increase max number of projects for a user.
+func (s *Service) RequestProjectLimitIncrease(ctx context.Context, limit string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "request project limit increase")
 	if err != nil {
 		return Error.Wrap(err)
 	}
@@ -3050,6 +3557,7 @@ func (s *Service) DeleteAPIKeys(ctx context.Context, ids []uuid.UUID) (err error
 	}
 
 	var keysErr errs.Group
+	var revocations []revocation.Revocation
 
 	for _, keyID := range ids {
 		key, err := s.store.APIKeys().Get(ctx, keyID)
@@ -3068,6 +3576,11 @@ func (s *Service) DeleteAPIKeys(ctx context.Context, ids []uuid.UUID) (err error
 			keysErr.Add(ErrForbidden.Wrap(errs.New("you do not have permission to delete this API key: %s", key.Name)))
 			continue
 		}
+
+		revocations = append(revocations, revocation.Revocation{
+			ProjectID:  key.ProjectID,
+			APIKeyHead: key.Head,
+		})
 	}
 
 	if err = keysErr.Err(); err != nil {
@@ -3084,7 +3597,13 @@ func (s *Service) DeleteAPIKeys(ctx context.Context, ids []uuid.UUID) (err error
 
 		return nil
 	})
-	return Error.Wrap(err)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	s.queueRevocations(revocations)
+
+	return nil
 }
 
 // GetAllAPIKeyNamesByProjectID returns all api key names by project ID.
@@ -3138,9 +3657,90 @@ func (s *Service) DeleteAPIKeyByNameAndProjectID(ctx context.Context, name strin
 		return Error.Wrap(err)
 	}
 
+	s.queueRevocations([]revocation.Revocation{{
+		ProjectID:  key.ProjectID,
+		APIKeyHead: key.Head,
+	}})
+
 	return nil
 }
 
+// APIKeyRevocationResult is the result of revoking an API key everywhere.
+type APIKeyRevocationResult struct {
+	// Confirmed is true when all the edges have invalidated the credentials
+	// derived from the API key.
+	Confirmed bool                    `json:"confirmed"`
+	Edges     []revocation.EdgeStatus `json:"edges"`
+}
+
+// RevokeAPIKeyEverywhere deletes an API key and waits for the edge services
+// to invalidate the credentials derived from it, e.g. the access keys of
+// the S3 gateway, instead of letting them work until their caches expire.
+func (s *Service) RevokeAPIKeyEverywhere(ctx context.Context, keyID uuid.UUID) (result APIKeyRevocationResult, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "revoke api key everywhere", zap.String("apiKeyID", keyID.String()))
+	if err != nil {
+		return APIKeyRevocationResult{}, Error.Wrap(err)
+	}
+
+	key, err := s.store.APIKeys().Get(ctx, keyID)
+	if err != nil {
+		if errs.Is(err, sql.ErrNoRows) {
+			return APIKeyRevocationResult{}, ErrNoAPIKey.New(apiKeyDoesntExistErrMsg)
+		}
+		return APIKeyRevocationResult{}, Error.Wrap(err)
+	}
+
+	pm, err := s.isProjectMember(ctx, user.ID, key.ProjectID)
+	if err != nil {
+		return APIKeyRevocationResult{}, ErrUnauthorized.Wrap(err)
+	}
+
+	if pm.membership.Role != RoleAdmin && key.CreatedBy != pm.membership.MemberID {
+		return APIKeyRevocationResult{}, ErrForbidden.Wrap(errs.New("you do not have permission to revoke this API key"))
+	}
+
+	err = s.store.APIKeys().Delete(ctx, key.ID)
+	if err != nil {
+		return APIKeyRevocationResult{}, Error.Wrap(err)
+	}
+
+	result.Edges = s.propagateRevocations(ctx, []revocation.Revocation{{
+		ProjectID:  key.ProjectID,
+		APIKeyHead: key.Head,
+	}})
+	result.Confirmed = revocation.AllConfirmed(result.Edges)
+
+	return result, nil
+}
+
+// queueRevocations queues the revocations of deleted API keys, which are sent
+// to the edge services in batches by RunRevocationPropagation.
+func (s *Service) queueRevocations(revocations []revocation.Revocation) {
+	now := s.nowFn()
+	for i := range revocations {
+		revocations[i].RevokedAt = now
+	}
+	s.revocations.Queue(revocations...)
+}
+
+// RunRevocationPropagation sends the queued revocations of deleted API keys
+// to the edge services until ctx is canceled.
+func (s *Service) RunRevocationPropagation(ctx context.Context) error {
+	return s.revocations.Run(ctx)
+}
+
+// propagateRevocations notifies the edge services about deleted API keys.
+// Failures are only logged, because the API keys are already deleted.
+func (s *Service) propagateRevocations(ctx context.Context, revocations []revocation.Revocation) []revocation.EdgeStatus {
+	now := s.nowFn()
+	for i := range revocations {
+		revocations[i].RevokedAt = now
+	}
+	return s.revocations.Propagate(ctx, revocations)
+}
+
 // GetAPIKeys returns paged api key list for given Project.
 func (s *Service) GetAPIKeys(ctx context.Context, reqProjectID uuid.UUID, cursor APIKeyCursor) (page *APIKeyPage, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -3339,6 +3939,312 @@ func (s *Service) GetBucketMetadata(ctx context.Context, projectID uuid.UUID) (l
 	return list, nil
 }
 
+// GetBucketInventory returns the inventory report configuration of a bucket, or nil when it has none.
+// The issued API key is never returned and only the project owner gets the passphrase of the reports.
+func (s *Service) GetBucketInventory(ctx context.Context, projectID uuid.UUID, bucketName string) (_ *buckets.InventoryConfiguration, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "get bucket inventory", zap.String("projectID", projectID.String()), zap.String("bucket", bucketName))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	isMember, err := s.isProjectMember(ctx, user.ID, projectID)
+	if err != nil {
+		return nil, ErrUnauthorized.Wrap(err)
+	}
+
+	config, err := s.buckets.GetBucketInventory(ctx, []byte(bucketName), isMember.project.ID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	if config != nil {
+		config.APIKey = ""
+		if isMember.project.OwnerID != user.ID {
+			config.ReportPassphrase = ""
+		}
+	}
+
+	return config, nil
+}
+
+// SetBucketInventory validates and stores the inventory report configuration of a bucket.
+// Only the project owner can configure inventory reports.
+//
+// The reports are written with an API key issued by the satellite, which is
+// restricted to the destination bucket, and encrypted with a passphrase
+// generated by the satellite. The returned configuration contains the
+// passphrase, which the owner needs to read the reports.
+func (s *Service) SetBucketInventory(ctx context.Context, projectID uuid.UUID, bucketName string, config buckets.InventoryConfiguration) (_ *buckets.InventoryConfiguration, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "set bucket inventory", zap.String("projectID", projectID.String()), zap.String("bucket", bucketName))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	isMember, err := s.isProjectMember(ctx, user.ID, projectID)
+	if err != nil {
+		return nil, ErrUnauthorized.Wrap(err)
+	}
+	if isMember.project.OwnerID != user.ID {
+		return nil, ErrForbidden.New("only the project owner can configure inventory reports")
+	}
+
+	// credentials are only ever issued by the satellite.
+	config.APIKey, config.ReportPassphrase = "", ""
+	if err := config.Validate(); err != nil {
+		return nil, ErrValidation.Wrap(err)
+	}
+
+	previous, err := s.buckets.GetBucketInventory(ctx, []byte(bucketName), isMember.project.ID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	// the passphrase is kept, so the previous reports can still be read.
+	if previous != nil {
+		config.ReportPassphrase = previous.ReportPassphrase
+	}
+	if config.ReportPassphrase == "" {
+		config.ReportPassphrase, err = bucketinventory.NewReportPassphrase()
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+	}
+
+	config.APIKey, err = s.issueInventoryAPIKey(ctx, user, isMember.project.ID, bucketName, config)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.buckets.SetBucketInventory(ctx, []byte(bucketName), isMember.project.ID, config)
+	if err != nil {
+		if buckets.ErrBucketNotFound.Has(err) {
+			return nil, err
+		}
+		return nil, Error.Wrap(err)
+	}
+
+	config.APIKey = ""
+	return &config, nil
+}
+
+// issueInventoryAPIKey replaces the API key used for writing the inventory reports of a bucket.
+func (s *Service) issueInventoryAPIKey(ctx context.Context, user *User, projectID uuid.UUID, bucketName string, config buckets.InventoryConfiguration) (_ string, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := s.revokeInventoryAPIKey(ctx, projectID, bucketName); err != nil {
+		return "", err
+	}
+
+	secret, err := macaroon.NewSecret()
+	if err != nil {
+		return "", Error.Wrap(err)
+	}
+
+	key, err := macaroon.NewAPIKey(secret)
+	if err != nil {
+		return "", Error.Wrap(err)
+	}
+
+	_, err = s.store.APIKeys().Create(ctx, key.Head(), APIKeyInfo{
+		Name:      bucketinventory.APIKeyName(bucketName),
+		ProjectID: projectID,
+		CreatedBy: user.ID,
+		Secret:    secret,
+		UserAgent: user.UserAgent,
+	})
+	if err != nil {
+		return "", Error.Wrap(err)
+	}
+
+	restricted, err := bucketinventory.RestrictAPIKey(key, config)
+	if err != nil {
+		return "", Error.Wrap(err)
+	}
+
+	return restricted.Serialize(), nil
+}
+
+// revokeInventoryAPIKey deletes the API key used for writing the inventory reports of a bucket, if there is one.
+func (s *Service) revokeInventoryAPIKey(ctx context.Context, projectID uuid.UUID, bucketName string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	key, err := s.store.APIKeys().GetByNameAndProjectID(ctx, bucketinventory.APIKeyName(bucketName), projectID)
+	if err != nil {
+		if errs.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+		return Error.Wrap(err)
+	}
+
+	return Error.Wrap(s.store.APIKeys().Delete(ctx, key.ID))
+}
+
+// DiffBucketInventory compares the inventory reports of a bucket created at the specified times.
+// It returns at most limit entries of every kind, while the counts cover the whole reports.
+// The reports are only ever downloaded through the specified local satellite.
+func (s *Service) DiffBucketInventory(ctx context.Context, satellite storj.NodeURL, projectID uuid.UUID, bucketName string, from, to time.Time, limit int) (_ *bucketinventory.Diff, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "diff bucket inventory", zap.String("projectID", projectID.String()), zap.String("bucket", bucketName))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	isMember, err := s.isProjectMember(ctx, user.ID, projectID)
+	if err != nil {
+		return nil, ErrUnauthorized.Wrap(err)
+	}
+
+	switch {
+	case limit <= 0:
+		limit = defaultInventoryDiffLimit
+	case limit > maxInventoryDiffLimit:
+		limit = maxInventoryDiffLimit
+	}
+
+	config, err := s.buckets.GetBucketInventory(ctx, []byte(bucketName), isMember.project.ID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	if config == nil {
+		return nil, ErrValidation.New("bucket %q has no inventory configuration", bucketName)
+	}
+
+	diff, err := bucketinventory.DiffReports(ctx, satellite, bucketName, *config, from, to, limit)
+	if err != nil {
+		if bucketinventory.ErrReportNotFound.Has(err) || bucketinventory.ErrReportAccessDenied.Has(err) {
+			return nil, err
+		}
+		return nil, Error.Wrap(err)
+	}
+
+	return diff, nil
+}
+
+// DeleteBucketInventory stops the inventory reports of a bucket and revokes the API key issued for them.
+// Only the project owner can configure inventory reports.
+func (s *Service) DeleteBucketInventory(ctx context.Context, projectID uuid.UUID, bucketName string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "delete bucket inventory", zap.String("projectID", projectID.String()), zap.String("bucket", bucketName))
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	isMember, err := s.isProjectMember(ctx, user.ID, projectID)
+	if err != nil {
+		return ErrUnauthorized.Wrap(err)
+	}
+	if isMember.project.OwnerID != user.ID {
+		return ErrForbidden.New("only the project owner can configure inventory reports")
+	}
+
+	if err := s.buckets.DeleteBucketInventory(ctx, []byte(bucketName), isMember.project.ID); err != nil {
+		return Error.Wrap(err)
+	}
+
+	return s.revokeInventoryAPIKey(ctx, isMember.project.ID, bucketName)
+}
+
+// ListObjectPins returns the pins of a bucket, without their versions.
+func (s *Service) ListObjectPins(ctx context.Context, projectID uuid.UUID, bucketName string) (_ []metabase.ObjectPin, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "list object pins", zap.String("projectID", projectID.String()), zap.String("bucket", bucketName))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	isMember, err := s.isProjectMember(ctx, user.ID, projectID)
+	if err != nil {
+		return nil, ErrUnauthorized.Wrap(err)
+	}
+
+	pins, err := s.objectPins.List(ctx, isMember.project.ID, bucketName)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return pins, nil
+}
+
+// GetObjectPin returns a pin of a bucket together with its versions.
+func (s *Service) GetObjectPin(ctx context.Context, projectID uuid.UUID, bucketName, name string) (_ metabase.ObjectPin, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "get object pin", zap.String("projectID", projectID.String()), zap.String("bucket", bucketName), zap.String("pin", name))
+	if err != nil {
+		return metabase.ObjectPin{}, Error.Wrap(err)
+	}
+
+	isMember, err := s.isProjectMember(ctx, user.ID, projectID)
+	if err != nil {
+		return metabase.ObjectPin{}, ErrUnauthorized.Wrap(err)
+	}
+
+	pin, err := s.objectPins.Get(ctx, metabase.ObjectPinLocation{
+		ProjectID:  isMember.project.ID,
+		BucketName: bucketName,
+		Name:       name,
+	})
+	if err != nil {
+		return metabase.ObjectPin{}, Error.Wrap(err)
+	}
+	return pin, nil
+}
+
+// CreateObjectPin pins object versions of a bucket under a name.
+func (s *Service) CreateObjectPin(ctx context.Context, projectID uuid.UUID, bucketName, name string, versions []metabase.ObjectPinVersion) (_ metabase.ObjectPin, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "create object pin", zap.String("projectID", projectID.String()), zap.String("bucket", bucketName), zap.String("pin", name))
+	if err != nil {
+		return metabase.ObjectPin{}, Error.Wrap(err)
+	}
+
+	isMember, err := s.isProjectMember(ctx, user.ID, projectID)
+	if err != nil {
+		return metabase.ObjectPin{}, ErrUnauthorized.Wrap(err)
+	}
+
+	pin, err := s.objectPins.Create(ctx, objectpins.Actor{Source: "console", Email: user.Email}, metabase.CreateObjectPin{
+		ObjectPinLocation: metabase.ObjectPinLocation{
+			ProjectID:  isMember.project.ID,
+			BucketName: bucketName,
+			Name:       name,
+		},
+		Versions: versions,
+	})
+	if err != nil {
+		return metabase.ObjectPin{}, Error.Wrap(err)
+	}
+	return pin, nil
+}
+
+// ReleaseObjectPin releases a pin of a bucket.
+func (s *Service) ReleaseObjectPin(ctx context.Context, projectID uuid.UUID, bucketName, name string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "release object pin", zap.String("projectID", projectID.String()), zap.String("bucket", bucketName), zap.String("pin", name))
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	isMember, err := s.isProjectMember(ctx, user.ID, projectID)
+	if err != nil {
+		return ErrUnauthorized.Wrap(err)
+	}
+
+	err = s.objectPins.Release(ctx, objectpins.Actor{Source: "console", Email: user.Email}, metabase.ObjectPinLocation{
+		ProjectID:  isMember.project.ID,
+		BucketName: bucketName,
+		Name:       name,
+	})
+	return Error.Wrap(err)
+}
+
 // GetUsageReport retrieves usage rollups for every bucket of a single or all the user owned projects for a given period.
 func (s *Service) GetUsageReport(ctx context.Context, since, before time.Time, projectID uuid.UUID) ([]accounting.ProjectReportItem, error) {
 	var err error
@@ -3393,6 +4299,68 @@ func (s *Service) GetUsageReport(ctx context.Context, since, before time.Time, p
 	return usage, nil
 }
 
+// GetAPIKeyUsageReport retrieves the egress attributed to every API key of a single or all the user owned projects for a given period.
+func (s *Service) GetAPIKeyUsageReport(ctx context.Context, since, before time.Time, projectID uuid.UUID) ([]accounting.APIKeyReportItem, error) {
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "get api key usage report")
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	var projects []Project
+
+	if projectID.IsZero() {
+		pr, err := s.store.Projects().GetOwn(ctx, user.ID)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+
+		projects = append(projects, pr...)
+	} else {
+		_, pr, err := s.isProjectOwner(ctx, user.ID, projectID)
+		if err != nil {
+			return nil, ErrUnauthorized.Wrap(err)
+		}
+
+		projects = append(projects, *pr)
+	}
+
+	usage := make([]accounting.APIKeyReportItem, 0)
+
+	for _, p := range projects {
+		egress, err := s.projectAccounting.GetAPIKeyEgress(ctx, p.ID, since, before)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+
+		for _, e := range egress {
+			// keys deleted since the egress was recorded are reported without a name.
+			var name string
+			key, err := s.store.APIKeys().Get(ctx, e.APIKeyID)
+			switch {
+			case err == nil:
+				name = key.Name
+			case !errs.Is(err, sql.ErrNoRows):
+				return nil, Error.Wrap(err)
+			}
+
+			usage = append(usage, accounting.APIKeyReportItem{
+				ProjectName: p.Name,
+				ProjectID:   p.PublicID,
+				APIKeyID:    e.APIKeyID,
+				APIKeyName:  name,
+				Egress:      memory.Size(e.Settled + e.Inline).GB(),
+				Since:       since,
+				Before:      before,
+			})
+		}
+	}
+
+	return usage, nil
+}
+
 // GenGetBucketUsageRollups retrieves summed usage rollups for every bucket of particular project for a given period for generated api.
 func (s *Service) GenGetBucketUsageRollups(ctx context.Context, reqProjectID uuid.UUID, since, before time.Time) (rollups []accounting.BucketUsageRollup, httpError api.HTTPError) {
 	var err error
@@ -3566,6 +4534,67 @@ func (s *Service) GetTotalUsageLimits(ctx context.Context) (_ *ProjectUsageLimit
 	}, nil
 }
 
+// GetAccountUsage returns the usage of every project the user owns or is a member of for a given period,
+// along with the estimated cost of the owned projects.
+func (s *Service) GetAccountUsage(ctx context.Context, since, before time.Time) (_ *AccountUsage, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "get account usage")
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	projects, err := s.store.Projects().GetByUserID(ctx, user.ID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	charges, err := s.accounts.ProjectCharges(ctx, user.ID, since, before)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	accountUsage := &AccountUsage{
+		Since:    since,
+		Before:   before,
+		Projects: make([]AccountProjectUsage, 0, len(projects)),
+	}
+
+	for _, project := range projects {
+		usage, err := s.projectAccounting.GetProjectTotal(ctx, project.ID, since, before)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+
+		projectUsage := AccountProjectUsage{
+			ProjectID:    project.PublicID,
+			Name:         project.Name,
+			Owned:        project.OwnerID == user.ID,
+			Storage:      usage.Storage,
+			Egress:       usage.Egress,
+			SegmentCount: usage.SegmentCount,
+			ObjectCount:  usage.ObjectCount,
+		}
+
+		if projectUsage.Owned {
+			var cost int64
+			for _, charge := range charges[project.PublicID] {
+				cost += charge.StorageMBMonthCents + charge.EgressMBCents + charge.SegmentMonthCents
+			}
+			projectUsage.CostCents = &cost
+			accountUsage.CostCents += cost
+		}
+
+		accountUsage.Storage += usage.Storage
+		accountUsage.Egress += usage.Egress
+		accountUsage.SegmentCount += usage.SegmentCount
+		accountUsage.ObjectCount += usage.ObjectCount
+		accountUsage.Projects = append(accountUsage.Projects, projectUsage)
+	}
+
+	return accountUsage, nil
+}
+
 func (s *Service) getStorageAndBandwidthUse(ctx context.Context, projectID uuid.UUID) (storage, bandwidth int64, err error) {
 	defer mon.Task()(&ctx)(&err)
 
@@ -3666,6 +4695,10 @@ func (s *Service) TokenAuth(ctx context.Context, token consoleauth.Token, authTi
 		return nil, err
 	}
 
+	if session.Status == consoleauth.SessionStatusReadOnly {
+		ctx = WithReadOnlySession(ctx)
+	}
+
 	return ctx, nil
 }
 
@@ -4401,8 +5434,14 @@ func (s *Service) RespondToProjectInvitation(ctx context.Context, projectID uuid
 		return Error.Wrap(s.store.ProjectInvitations().Delete(ctx, projectID, user.Email))
 	}
 
-	// All the new team members have regular Member role, which can be updated by the project owner later.
-	_, err = s.store.ProjectMembers().Insert(ctx, user.ID, projectID, RoleMember)
+	// New team members get the role from the project invitation policy, which is the regular
+	// Member role by default. It can be updated by the project owner later.
+	policy, err := s.getProjectInvitationPolicy(ctx, projectID)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	_, err = s.store.ProjectMembers().Insert(ctx, user.ID, projectID, policy.DefaultRole)
 	if err != nil {
 		return Error.Wrap(err)
 	}
@@ -4478,6 +5517,14 @@ func (s *Service) inviteProjectMembers(ctx context.Context, sender *User, projec
 
 	projectID = isMember.project.ID
 
+	policy, err := s.getProjectInvitationPolicy(ctx, projectID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	if err := s.checkProjectInvitationPolicy(ctx, policy, emails, opt); err != nil {
+		return nil, err
+	}
+
 	var users []*User
 	var newUserEmails []string
 	var unverifiedUsers []User
@@ -4614,6 +5661,154 @@ func (s *Service) inviteProjectMembers(ctx context.Context, sender *User, projec
 	return invites, nil
 }
 
+// BulkInviteProjectMembers invites users by email to the project specified by the given ID,
+// which may be its public or internal ID. Unlike inviting a single member, a failure to invite
+// one of the addresses does not prevent inviting the others; the outcome of every address is returned.
+func (s *Service) BulkInviteProjectMembers(ctx context.Context, projectID uuid.UUID, emails []string) (results []BulkInvitationResult, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx,
+		"bulk invite project members",
+		zap.String("projectID", projectID.String()),
+		zap.Int("emails", len(emails)),
+	)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	if len(emails) == 0 {
+		return nil, ErrValidation.New("no email addresses provided")
+	}
+	if len(emails) > MaxBulkInvitations {
+		return nil, ErrValidation.New("at most %d email addresses may be invited at once", MaxBulkInvitations)
+	}
+
+	isMember, err := s.isProjectMember(ctx, user.ID, projectID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	if isMember.membership.Role != RoleAdmin {
+		return nil, ErrForbidden.New("only project Owner or Admin can invite other members")
+	}
+
+	seen := make(map[string]struct{}, len(emails))
+	for _, email := range emails {
+		email = strings.ToLower(strings.TrimSpace(email))
+		if _, ok := seen[email]; ok {
+			continue
+		}
+		seen[email] = struct{}{}
+
+		result := BulkInvitationResult{Email: email}
+		if address, err := mail.ParseAddress(email); err != nil || address.Address != email {
+			result.Error = "invalid email address"
+			results = append(results, result)
+			continue
+		}
+
+		_, err := s.inviteProjectMembers(ctx, user, isMember.project.ID, []string{email}, ProjectInvitationCreate)
+		switch {
+		case err == nil:
+			result.Invited = true
+		case ErrAlreadyInvited.Has(err), ErrAlreadyMember.Has(err), ErrInvitationPolicy.Has(err):
+			result.Error = errs.Unwrap(err).Error()
+		default:
+			s.log.Error("failed to invite project member",
+				zap.Stringer("projectID", isMember.project.ID), zap.Error(err))
+			result.Error = "internal error"
+		}
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+// GetProjectInvitationPolicy returns the invitation policy of a project.
+// Projects without a policy allow inviting any address and assign the regular Member role.
+func (s *Service) GetProjectInvitationPolicy(ctx context.Context, projectID uuid.UUID) (_ *ProjectInvitationPolicy, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "get project invitation policy", zap.String("projectID", projectID.String()))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	isMember, err := s.isProjectMember(ctx, user.ID, projectID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return s.getProjectInvitationPolicy(ctx, isMember.project.ID)
+}
+
+// UpdateProjectInvitationPolicy validates and stores the invitation policy of a project.
+func (s *Service) UpdateProjectInvitationPolicy(ctx context.Context, projectID uuid.UUID, policy ProjectInvitationPolicy) (_ *ProjectInvitationPolicy, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "update project invitation policy", zap.String("projectID", projectID.String()))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	_, project, err := s.isProjectOwner(ctx, user.ID, projectID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	policy.Normalize()
+	if err = policy.Validate(); err != nil {
+		return nil, ErrValidation.Wrap(err)
+	}
+
+	policy.ProjectID = project.ID
+	updated, err := s.store.ProjectInvitationPolicies().Upsert(ctx, policy)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return updated, nil
+}
+
+func (s *Service) getProjectInvitationPolicy(ctx context.Context, projectID uuid.UUID) (_ *ProjectInvitationPolicy, err error) {
+	policy, err := s.store.ProjectInvitationPolicies().Get(ctx, projectID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return &ProjectInvitationPolicy{ProjectID: projectID, DefaultRole: RoleMember}, nil
+		}
+		return nil, Error.Wrap(err)
+	}
+	return policy, nil
+}
+
+// checkProjectInvitationPolicy returns an error when inviting the emails would violate the policy.
+func (s *Service) checkProjectInvitationPolicy(ctx context.Context, policy *ProjectInvitationPolicy, emails []string, opt ProjectInvitationOption) (err error) {
+	for _, email := range emails {
+		if !policy.AllowsEmail(email) {
+			return ErrInvitationPolicy.New("the email domain of '%s' is not allowed", email)
+		}
+	}
+
+	// resending invitations does not increase the number of pending invitations.
+	if policy.MaxPendingInvitations == 0 || opt == ProjectInvitationResend {
+		return nil
+	}
+
+	invites, err := s.store.ProjectInvitations().GetByProjectID(ctx, policy.ProjectID)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	pending := 0
+	for i := range invites {
+		if !s.IsProjectInvitationExpired(&invites[i]) {
+			pending++
+		}
+	}
+	if pending+len(emails) > policy.MaxPendingInvitations {
+		return ErrInvitationPolicy.New("the project may not have more than %d pending invitations", policy.MaxPendingInvitations)
+	}
+	return nil
+}
+
 // IsProjectInvitationExpired returns whether the project member invitation has expired.
 func (s *Service) IsProjectInvitationExpired(invite *ProjectInvitation) bool {
 	return time.Now().After(invite.CreatedAt.Add(s.config.ProjectInvitationExpiration))
@@ -10,8 +10,11 @@ import (
 	"encoding/json"
 	"fmt"
 	"math/rand"
+	"net/http"
+	"net/http/httptest"
 	"sort"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -37,6 +40,7 @@ import (
 	"storj.io/storj/satellite/buckets"
 	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/console/consoleweb/consoleapi"
+	"storj.io/storj/satellite/metabase/bucketinventory"
 	"storj.io/storj/satellite/nodeselection"
 	"storj.io/storj/satellite/payments"
 	"storj.io/storj/satellite/payments/billing"
@@ -44,6 +48,7 @@ import (
 	"storj.io/storj/satellite/payments/storjscan"
 	"storj.io/storj/satellite/payments/storjscan/blockchaintest"
 	"storj.io/storj/satellite/payments/stripe"
+	"storj.io/storj/satellite/revocation"
 )
 
 func TestService(t *testing.T) {
@@ -3564,3 +3569,152 @@ func TestDelayedBotFreeze(t *testing.T) {
 		require.Nil(t, event)
 	})
 }
+
+func TestBucketInventoryOwnerOnly(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, UplinkCount: 2,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		service := sat.API.Console.Service
+
+		project, err := sat.API.DB.Console().Projects().Get(ctx, planet.Uplinks[0].Projects[0].ID)
+		require.NoError(t, err)
+		require.NoError(t, planet.Uplinks[0].CreateBucket(ctx, sat, "source"))
+
+		member := planet.Uplinks[1].Projects[0].Owner
+		_, err = sat.API.DB.Console().ProjectMembers().Insert(ctx, member.ID, project.ID, console.RoleMember)
+		require.NoError(t, err)
+
+		ownerCtx, err := sat.UserContext(ctx, project.OwnerID)
+		require.NoError(t, err)
+		memberCtx, err := sat.UserContext(ctx, member.ID)
+		require.NoError(t, err)
+
+		config := buckets.InventoryConfiguration{
+			ID:                "daily",
+			DestinationBucket: "reports",
+			Format:            buckets.InventoryCSV,
+			Frequency:         buckets.InventoryDaily,
+			IncludedVersions:  buckets.InventoryCurrentVersions,
+			// credentials are never taken from the request.
+			APIKey:           "customer-key",
+			ReportPassphrase: "customer-passphrase",
+		}
+
+		_, err = service.SetBucketInventory(memberCtx, project.ID, "source", config)
+		require.True(t, console.ErrForbidden.Has(err), err)
+
+		stored, err := service.SetBucketInventory(ownerCtx, project.ID, "source", config)
+		require.NoError(t, err)
+		require.Empty(t, stored.APIKey)
+		require.NotEmpty(t, stored.ReportPassphrase)
+		require.NotEqual(t, "customer-passphrase", stored.ReportPassphrase)
+
+		saved, err := sat.API.DB.Buckets().GetBucketInventory(ctx, []byte("source"), project.ID)
+		require.NoError(t, err)
+		require.NotEqual(t, "customer-key", saved.APIKey)
+
+		key, err := sat.API.DB.Console().APIKeys().GetByNameAndProjectID(ctx, bucketinventory.APIKeyName("source"), project.ID)
+		require.NoError(t, err)
+
+		// members don't see the passphrase of the reports.
+		got, err := service.GetBucketInventory(memberCtx, project.ID, "source")
+		require.NoError(t, err)
+		require.Empty(t, got.APIKey)
+		require.Empty(t, got.ReportPassphrase)
+
+		// replacing the configuration keeps the passphrase but issues a new key.
+		replaced, err := service.SetBucketInventory(ownerCtx, project.ID, "source", config)
+		require.NoError(t, err)
+		require.Equal(t, stored.ReportPassphrase, replaced.ReportPassphrase)
+		_, err = sat.API.DB.Console().APIKeys().Get(ctx, key.ID)
+		require.Error(t, err)
+
+		require.True(t, console.ErrForbidden.Has(service.DeleteBucketInventory(memberCtx, project.ID, "source")))
+		require.NoError(t, service.DeleteBucketInventory(ownerCtx, project.ID, "source"))
+
+		_, err = sat.API.DB.Console().APIKeys().GetByNameAndProjectID(ctx, bucketinventory.APIKeyName("source"), project.ID)
+		require.True(t, errs.Is(err, sql.ErrNoRows), err)
+	})
+}
+
+func TestRevokeAPIKeyEverywhere(t *testing.T) {
+	var mu sync.Mutex
+	var revokedHeads [][]byte
+	revoked := func() [][]byte {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([][]byte(nil), revokedHeads...)
+	}
+	edge := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Revocations []revocation.Revocation `json:"revocations"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		for _, revoked := range body.Revocations {
+			revokedHeads = append(revokedHeads, revoked.APIKeyHead)
+		}
+	}))
+	defer edge.Close()
+
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Console.RevocationPropagation.Endpoints = []string{edge.URL}
+				config.Console.RevocationPropagation.Timeout = 5 * time.Second
+				config.Console.RevocationPropagation.MaxDelay = 10 * time.Millisecond
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		service := sat.API.Console.Service
+		project := planet.Uplinks[0].Projects[0]
+
+		owner, err := sat.AddUser(ctx, console.CreateUser{
+			FullName: "Owner Name",
+			Email:    "revoke_owner@example.com",
+		}, 1)
+		require.NoError(t, err)
+		pr, err := sat.AddProject(ctx, owner.ID, "Revoke Keys Project")
+		require.NoError(t, err)
+
+		ownerCtx, err := sat.UserContext(ctx, owner.ID)
+		require.NoError(t, err)
+
+		key, _, err := service.CreateAPIKey(ownerCtx, pr.ID, "revoked key")
+		require.NoError(t, err)
+
+		// only project members can revoke the key.
+		otherCtx, err := sat.UserContext(ctx, project.Owner.ID)
+		require.NoError(t, err)
+		_, err = service.RevokeAPIKeyEverywhere(otherCtx, key.ID)
+		require.True(t, console.ErrUnauthorized.Has(err))
+
+		result, err := service.RevokeAPIKeyEverywhere(ownerCtx, key.ID)
+		require.NoError(t, err)
+		require.True(t, result.Confirmed)
+		require.Equal(t, []revocation.EdgeStatus{{Endpoint: edge.URL, Confirmed: true}}, result.Edges)
+		require.Equal(t, [][]byte{key.Head}, revoked())
+
+		_, err = sat.DB.Console().APIKeys().Get(ctx, key.ID)
+		require.True(t, errs.Is(err, sql.ErrNoRows))
+
+		_, err = service.RevokeAPIKeyEverywhere(ownerCtx, key.ID)
+		require.True(t, console.ErrNoAPIKey.Has(err))
+
+		// deleting keys propagates the revocation in the background.
+		key, _, err = service.CreateAPIKey(ownerCtx, pr.ID, "deleted key")
+		require.NoError(t, err)
+		require.NoError(t, service.DeleteAPIKeys(ownerCtx, []uuid.UUID{key.ID}))
+		require.Eventually(t, func() bool {
+			return len(revoked()) == 2
+		}, 5*time.Second, 10*time.Millisecond)
+		require.Equal(t, key.Head, revoked()[1])
+	})
+}
@@ -0,0 +1,64 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console
+
+import (
+	"context"
+	"crypto/sha256"
+	"net"
+	"time"
+
+	"storj.io/common/uuid"
+)
+
+// TrustedDevices exposes methods to manage the devices a user has logged in from.
+//
+// architecture: Database
+type TrustedDevices interface {
+	// Upsert records a login from the device, inserting it if it has not been seen before
+	// and bumping its last used time otherwise. isNew reports whether the device was inserted.
+	Upsert(ctx context.Context, device TrustedDevice) (isNew bool, err error)
+	// ListByUserID returns the devices of a user, most recently used first.
+	ListByUserID(ctx context.Context, userID uuid.UUID) ([]TrustedDevice, error)
+	// CountByUserID returns the number of devices of a user.
+	CountByUserID(ctx context.Context, userID uuid.UUID) (int, error)
+	// Delete removes a device of a user.
+	Delete(ctx context.Context, userID, id uuid.UUID) error
+	// DeleteLeastRecentlyUsed removes the devices of a user except for the keep most recently used ones.
+	DeleteLeastRecentlyUsed(ctx context.Context, userID uuid.UUID, keep int) error
+	// DeleteLastUsedBefore removes the devices of all users that were last used before the given time.
+	DeleteLastUsedBefore(ctx context.Context, before time.Time, asOfSystemTimeInterval time.Duration, pageSize int) error
+}
+
+// TrustedDevice is a device a user has successfully logged in from.
+type TrustedDevice struct {
+	ID          uuid.UUID `json:"id"`
+	UserID      uuid.UUID `json:"-"`
+	Fingerprint []byte    `json:"-"`
+	UserAgent   string    `json:"userAgent"`
+	IPAddress   string    `json:"ipAddress"`
+	CreatedAt   time.Time `json:"createdAt"`
+	LastUsedAt  time.Time `json:"lastUsedAt"`
+}
+
+// DeviceFingerprint derives the fingerprint of a login from its user agent and
+// the network its IP address belongs to. IPv4 addresses are reduced to their /24
+// and IPv6 addresses to their /64 so that ordinary address churn within the same
+// network does not look like a new device.
+func DeviceFingerprint(userAgent, ip string) []byte {
+	network := ip
+	if parsed := net.ParseIP(ip); parsed != nil {
+		if v4 := parsed.To4(); v4 != nil {
+			network = v4.Mask(net.CIDRMask(24, 32)).String()
+		} else {
+			network = parsed.Mask(net.CIDRMask(64, 128)).String()
+		}
+	}
+
+	hash := sha256.New()
+	_, _ = hash.Write([]byte(userAgent))
+	_, _ = hash.Write([]byte{0})
+	_, _ = hash.Write([]byte(network))
+	return hash.Sum(nil)
+}
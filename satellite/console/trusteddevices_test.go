@@ -0,0 +1,129 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestDeviceFingerprint(t *testing.T) {
+	const agent = "Mozilla/5.0 (X11; Linux x86_64)"
+
+	// addresses within the same network share a fingerprint.
+	require.Equal(t, console.DeviceFingerprint(agent, "203.0.113.10"), console.DeviceFingerprint(agent, "203.0.113.200"))
+	require.Equal(t, console.DeviceFingerprint(agent, "2001:db8:1:2::1"), console.DeviceFingerprint(agent, "2001:db8:1:2:ffff::1"))
+
+	require.NotEqual(t, console.DeviceFingerprint(agent, "203.0.113.10"), console.DeviceFingerprint(agent, "198.51.100.10"))
+	require.NotEqual(t, console.DeviceFingerprint(agent, "203.0.113.10"), console.DeviceFingerprint("curl/8.0", "203.0.113.10"))
+	require.NotEqual(t, console.DeviceFingerprint(agent, "2001:db8:1:2::1"), console.DeviceFingerprint(agent, "2001:db8:1:3::1"))
+}
+
+func TestTrustedDevicesDB(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		devices := db.Console().TrustedDevices()
+		userID := testrand.UUID()
+
+		list, err := devices.ListByUserID(ctx, userID)
+		require.NoError(t, err)
+		require.Empty(t, list)
+
+		laptop := console.TrustedDevice{
+			ID:          testrand.UUID(),
+			UserID:      userID,
+			Fingerprint: console.DeviceFingerprint("laptop", "203.0.113.10"),
+			UserAgent:   "laptop",
+			IPAddress:   "203.0.113.10",
+		}
+		isNew, err := devices.Upsert(ctx, laptop)
+		require.NoError(t, err)
+		require.True(t, isNew)
+
+		// logging in again from the same network updates the existing device.
+		again := laptop
+		again.ID = testrand.UUID()
+		again.IPAddress = "203.0.113.11"
+		isNew, err = devices.Upsert(ctx, again)
+		require.NoError(t, err)
+		require.False(t, isNew)
+
+		phone := console.TrustedDevice{
+			ID:          testrand.UUID(),
+			UserID:      userID,
+			Fingerprint: console.DeviceFingerprint("phone", "198.51.100.7"),
+			UserAgent:   "phone",
+			IPAddress:   "198.51.100.7",
+		}
+		isNew, err = devices.Upsert(ctx, phone)
+		require.NoError(t, err)
+		require.True(t, isNew)
+
+		list, err = devices.ListByUserID(ctx, userID)
+		require.NoError(t, err)
+		require.Len(t, list, 2)
+		for _, device := range list {
+			if device.ID == laptop.ID {
+				require.Equal(t, "203.0.113.11", device.IPAddress)
+			} else {
+				require.Equal(t, phone.ID, device.ID)
+			}
+		}
+
+		// devices of other users are not affected.
+		require.NoError(t, devices.Delete(ctx, testrand.UUID(), laptop.ID))
+		list, err = devices.ListByUserID(ctx, userID)
+		require.NoError(t, err)
+		require.Len(t, list, 2)
+
+		require.NoError(t, devices.Delete(ctx, userID, laptop.ID))
+		list, err = devices.ListByUserID(ctx, userID)
+		require.NoError(t, err)
+		require.Len(t, list, 1)
+		require.Equal(t, phone.ID, list[0].ID)
+
+		// a removed device is new again on the next login.
+		isNew, err = devices.Upsert(ctx, again)
+		require.NoError(t, err)
+		require.True(t, isNew)
+
+		count, err := devices.CountByUserID(ctx, userID)
+		require.NoError(t, err)
+		require.Equal(t, 2, count)
+
+		// only the most recently used devices are kept.
+		require.NoError(t, devices.DeleteLeastRecentlyUsed(ctx, userID, 1))
+		list, err = devices.ListByUserID(ctx, userID)
+		require.NoError(t, err)
+		require.Len(t, list, 1)
+		require.Equal(t, again.ID, list[0].ID)
+
+		other := phone
+		other.ID = testrand.UUID()
+		other.UserID = testrand.UUID()
+		isNew, err = devices.Upsert(ctx, other)
+		require.NoError(t, err)
+		require.True(t, isNew)
+
+		require.NoError(t, devices.DeleteLastUsedBefore(ctx, time.Now().Add(-time.Hour), 0, 1))
+		count, err = devices.CountByUserID(ctx, userID)
+		require.NoError(t, err)
+		require.Equal(t, 1, count)
+
+		require.NoError(t, devices.DeleteLastUsedBefore(ctx, time.Now().Add(time.Hour), 0, 1))
+		for _, id := range []uuid.UUID{userID, other.UserID} {
+			count, err = devices.CountByUserID(ctx, id)
+			require.NoError(t, err)
+			require.Zero(t, count)
+		}
+	})
+}
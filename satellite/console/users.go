@@ -120,6 +120,7 @@ type CreateUser struct {
 	CaptchaResponse  string `json:"captchaResponse"`
 	IP               string `json:"ip"`
 	SignupPromoCode  string `json:"signupPromoCode"`
+	SignupReferral   string `json:"-"`
 	ActivationCode   string `json:"-"`
 	SignupId         string `json:"-"`
 	AllowNoName      bool   `json:"-"`
@@ -188,6 +189,8 @@ const (
 	LegalHold UserStatus = 4
 	// PendingBotVerification is a status that user receives after account activation but with high captcha score.
 	PendingBotVerification UserStatus = 5
+	// Deactivated is a status that user receives when the identity provider which provisioned it deactivates it.
+	Deactivated UserStatus = 6
 )
 
 // String returns a string representation of the user status.
@@ -205,6 +208,8 @@ func (s UserStatus) String() string {
 		return "Legal Hold"
 	case PendingBotVerification:
 		return "Pending Bot Verification"
+	case Deactivated:
+		return "Deactivated"
 	default:
 		return ""
 	}
@@ -245,6 +250,7 @@ type User struct {
 	MFARecoveryCodes []string `json:"-"`
 
 	SignupPromoCode string `json:"signupPromoCode"`
+	SignupReferral  string `json:"-"`
 
 	VerificationReminders int `json:"verificationReminders"`
 	TrialNotifications    int `json:"trialNotifications"`
@@ -351,6 +357,7 @@ type UserSettings struct {
 	PassphrasePrompt bool            `json:"passphrasePrompt"`
 	OnboardingStep   *string         `json:"onboardingStep"`
 	NoticeDismissal  NoticeDismissal `json:"noticeDismissal"`
+	LoginAlerts      bool            `json:"loginAlerts"`
 }
 
 // UpsertUserSettingsRequest contains all user settings which are configurable via Users.UpsertSettings.
@@ -362,6 +369,7 @@ type UpsertUserSettingsRequest struct {
 	PassphrasePrompt *bool
 	OnboardingStep   *string
 	NoticeDismissal  *NoticeDismissal
+	LoginAlerts      *bool
 }
 
 // NoticeDismissal contains whether notices should be shown to a user.
@@ -35,9 +35,18 @@ import (
 	"storj.io/storj/satellite/console/dbcleanup"
 	"storj.io/storj/satellite/console/emailreminders"
 	"storj.io/storj/satellite/emission"
+	"storj.io/storj/satellite/escrow"
 	"storj.io/storj/satellite/gc/sender"
+	"storj.io/storj/satellite/kms"
+	"storj.io/storj/satellite/limitschedule"
 	"storj.io/storj/satellite/mailservice"
 	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/abortmultipart"
+	"storj.io/storj/satellite/metabase/avroexport"
+	"storj.io/storj/satellite/metabase/bucketinventory"
+	"storj.io/storj/satellite/metabase/lifecycledeletion"
+	"storj.io/storj/satellite/metabase/objectevents"
+	"storj.io/storj/satellite/metabase/segmentdeletion"
 	"storj.io/storj/satellite/metabase/zombiedeletion"
 	"storj.io/storj/satellite/metainfo/expireddeletion"
 	"storj.io/storj/satellite/nodeevents"
@@ -122,6 +131,30 @@ type Core struct {
 		Chore *zombiedeletion.Chore
 	}
 
+	AbortMultipart struct {
+		Chore *abortmultipart.Chore
+	}
+
+	SegmentDeletion struct {
+		Chore *segmentdeletion.Chore
+	}
+
+	LifecycleDeletion struct {
+		Chore *lifecycledeletion.Chore
+	}
+
+	BucketInventory struct {
+		Chore *bucketinventory.Chore
+	}
+
+	ObjectEvents struct {
+		Publisher *objectevents.Publisher
+	}
+
+	AvroExport struct {
+		Chore *avroexport.Chore
+	}
+
 	Accounting struct {
 		Tally                 *tally.Service
 		Rollup                *rollup.Service
@@ -133,6 +166,20 @@ type Core struct {
 		Cache accounting.Cache
 	}
 
+	LimitSchedule struct {
+		Service *limitschedule.Service
+		Chore   *limitschedule.Chore
+	}
+
+	KeyManagement struct {
+		Service *kms.Service
+	}
+
+	PassphraseEscrow struct {
+		Service *escrow.Service
+		Chore   *escrow.Chore
+	}
+
 	Payments struct {
 		AccountFreeze    *accountfreeze.Chore
 		Accounts         payments.Accounts
@@ -384,6 +431,7 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB,
 			peer.Log.Named("core-zombie-deletion"),
 			config.ZombieDeletion,
 			peer.Metainfo.Metabase,
+			peer.DB.PendingObjectGracePeriods(),
 		)
 		peer.Services.Add(lifecycle.Item{
 			Name:  "zombiedeletion:chore",
@@ -394,6 +442,164 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB,
 			debug.Cycle("Zombie Objects Chore", peer.ZombieDeletion.Chore.Loop))
 	}
 
+	{ // setup incomplete multipart upload abort
+		peer.AbortMultipart.Chore = abortmultipart.NewChore(
+			peer.Log.Named("core-abort-multipart"),
+			config.AbortMultipart,
+			peer.Metainfo.Metabase,
+		)
+		peer.Services.Add(lifecycle.Item{
+			Name:  "abortmultipart:chore",
+			Run:   peer.AbortMultipart.Chore.Run,
+			Close: peer.AbortMultipart.Chore.Close,
+		})
+		peer.Debug.Server.Panel.Add(
+			debug.Cycle("Abort Incomplete Multipart Chore", peer.AbortMultipart.Chore.Loop))
+	}
+
+	{ // setup deferred segment deletion
+		peer.SegmentDeletion.Chore = segmentdeletion.NewChore(
+			peer.Log.Named("core-segment-deletion"),
+			config.SegmentDeletion,
+			peer.Metainfo.Metabase,
+		)
+		peer.Services.Add(lifecycle.Item{
+			Name:  "segmentdeletion:chore",
+			Run:   peer.SegmentDeletion.Chore.Run,
+			Close: peer.SegmentDeletion.Chore.Close,
+		})
+		peer.Debug.Server.Panel.Add(
+			debug.Cycle("Deferred Segment Deletion Chore", peer.SegmentDeletion.Chore.Loop))
+	}
+
+	{ // setup bucket lifecycle rules
+		peer.LifecycleDeletion.Chore = lifecycledeletion.NewChore(
+			peer.Log.Named("core-lifecycle-deletion"),
+			config.LifecycleDeletion,
+			peer.Metainfo.Metabase,
+			peer.DB.Buckets(),
+		)
+		peer.Services.Add(lifecycle.Item{
+			Name:  "lifecycledeletion:chore",
+			Run:   peer.LifecycleDeletion.Chore.Run,
+			Close: peer.LifecycleDeletion.Chore.Close,
+		})
+		peer.Debug.Server.Panel.Add(
+			debug.Cycle("Bucket Lifecycle Chore", peer.LifecycleDeletion.Chore.Loop))
+	}
+
+	{ // setup bucket inventory reports
+		peer.BucketInventory.Chore = bucketinventory.NewChore(
+			peer.Log.Named("core-bucket-inventory"),
+			config.BucketInventory,
+			peer.Metainfo.Metabase,
+			peer.DB.Buckets(),
+			config.Metainfo.StorageClasses,
+			storj.NodeURL{ID: peer.ID(), Address: config.Contact.ExternalAddress},
+		)
+		peer.Services.Add(lifecycle.Item{
+			Name:  "bucketinventory:chore",
+			Run:   peer.BucketInventory.Chore.Run,
+			Close: peer.BucketInventory.Chore.Close,
+		})
+		peer.Debug.Server.Panel.Add(
+			debug.Cycle("Bucket Inventory Chore", peer.BucketInventory.Chore.Loop))
+	}
+
+	{ // setup object events publisher
+		// the publisher also runs when disabled, to stop capturing the events.
+		var sink objectevents.Sink
+		if config.ObjectEvents.Enabled {
+			redisSink, err := objectevents.OpenRedisStreamSink(config.ObjectEvents.SinkURL, config.ObjectEvents.Stream, config.ObjectEvents.MaxLen)
+			if err != nil {
+				return nil, errs.Combine(err, peer.Close())
+			}
+			sink = redisSink
+		}
+		peer.ObjectEvents.Publisher = objectevents.NewPublisher(
+			peer.Log.Named("core-object-events"),
+			config.ObjectEvents,
+			peer.Metainfo.Metabase,
+			sink,
+		)
+		peer.Services.Add(lifecycle.Item{
+			Name:  "objectevents:publisher",
+			Run:   peer.ObjectEvents.Publisher.Run,
+			Close: peer.ObjectEvents.Publisher.Close,
+		})
+		peer.Debug.Server.Panel.Add(
+			debug.Cycle("Object Events Publisher", peer.ObjectEvents.Publisher.Loop))
+	}
+
+	{ // setup avro export
+		peer.AvroExport.Chore = avroexport.NewChore(
+			peer.Log.Named("core-avro-export"),
+			config.AvroExport,
+			peer.Metainfo.Metabase,
+			peer.DB.Buckets(),
+		)
+		peer.Services.Add(lifecycle.Item{
+			Name:  "avroexport:chore",
+			Run:   peer.AvroExport.Chore.Run,
+			Close: peer.AvroExport.Chore.Close,
+		})
+		peer.Debug.Server.Panel.Add(
+			debug.Cycle("Avro Export Chore", peer.AvroExport.Chore.Loop))
+	}
+
+	{ // setup scheduled limit changes
+		peer.LimitSchedule.Service = limitschedule.NewService(
+			peer.Log.Named("limit-schedule"),
+			peer.DB.ProjectLimitChanges(),
+		)
+		peer.LimitSchedule.Chore = limitschedule.NewChore(
+			peer.Log.Named("limit-schedule:chore"),
+			peer.LimitSchedule.Service,
+			config.LimitSchedule,
+		)
+		peer.Services.Add(lifecycle.Item{
+			Name:  "limit-schedule:chore",
+			Run:   peer.LimitSchedule.Chore.Run,
+			Close: peer.LimitSchedule.Chore.Close,
+		})
+		peer.Debug.Server.Panel.Add(
+			debug.Cycle("Limit Schedule Chore", peer.LimitSchedule.Chore.Loop))
+	}
+
+	{ // setup passphrase escrow
+		if config.PassphraseEscrow.Enabled {
+			if config.KeyManagement.EscrowThreshold <= 0 {
+				return nil, errs.Combine(errs.New("passphrase escrow requires key-management.escrow-threshold"), peer.Close())
+			}
+
+			peer.KeyManagement.Service = kms.NewService(config.KeyManagement)
+			peer.Services.Add(lifecycle.Item{
+				Name:  "kms:service",
+				Run:   peer.KeyManagement.Service.Initialize,
+				Close: peer.KeyManagement.Service.Close,
+			})
+
+			peer.PassphraseEscrow.Service = escrow.NewService(
+				peer.Log.Named("passphrase-escrow"),
+				peer.DB.PassphraseEscrows(),
+				peer.KeyManagement.Service,
+				config.PassphraseEscrow,
+			)
+			peer.PassphraseEscrow.Chore = escrow.NewChore(
+				peer.Log.Named("passphrase-escrow:chore"),
+				peer.PassphraseEscrow.Service,
+				config.PassphraseEscrow,
+			)
+			peer.Services.Add(lifecycle.Item{
+				Name:  "passphrase-escrow:chore",
+				Run:   peer.PassphraseEscrow.Chore.Run,
+				Close: peer.PassphraseEscrow.Chore.Close,
+			})
+			peer.Debug.Server.Panel.Add(
+				debug.Cycle("Passphrase Escrow Chore", peer.PassphraseEscrow.Chore.Loop))
+		}
+	}
+
 	{ // setup accounting
 		peer.Accounting.Tally = tally.New(peer.Log.Named("accounting:tally"), peer.DB.StoragenodeAccounting(), peer.DB.ProjectAccounting(), peer.LiveAccounting.Cache, peer.Metainfo.Metabase, peer.DB.Buckets(), config.Tally)
 		peer.Services.Add(lifecycle.Item{
@@ -563,6 +769,7 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB,
 				peer.DB.StripeCoinPayments(),
 				peer.Payments.Accounts,
 				peer.DB.Console().Users(),
+				peer.DB.Console().BillingContacts(),
 				peer.DB.Wallets(),
 				peer.DB.StorjscanPayments(),
 				console.NewAccountFreezeService(db.Console(), peer.Analytics.Service, config.Console.AccountFreeze),
@@ -0,0 +1,226 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package errcatalog
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/storj/satellite/buckets"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/metabase"
+)
+
+var mon = monkit.Package()
+
+// Code is a stable machine-readable error code. Codes must never be renamed,
+// since clients depend on them.
+type Code string
+
+// Error codes known to the satellite.
+const (
+	Internal           Code = "internal"
+	Canceled           Code = "canceled"
+	DeadlineExceeded   Code = "deadline_exceeded"
+	InvalidArgument    Code = "invalid_argument"
+	NotFound           Code = "not_found"
+	AlreadyExists      Code = "already_exists"
+	FailedPrecondition Code = "failed_precondition"
+	Conflict           Code = "conflict"
+	PermissionDenied   Code = "permission_denied"
+	Unauthenticated    Code = "unauthenticated"
+	ResourceExhausted  Code = "resource_exhausted"
+	Unimplemented      Code = "unimplemented"
+	Unavailable        Code = "unavailable"
+
+	ObjectNotFound       Code = "object_not_found"
+	SegmentNotFound      Code = "segment_not_found"
+	PendingObjectMissing Code = "pending_object_missing"
+	ObjectAlreadyExists  Code = "object_already_exists"
+	MethodNotAllowed     Code = "method_not_allowed"
+	BucketNotFound       Code = "bucket_not_found"
+	BucketAlreadyExists  Code = "bucket_already_exists"
+	BucketNotEmpty       Code = "bucket_not_empty"
+	UsageLimitExceeded   Code = "usage_limit_exceeded"
+	ObjectPinned         Code = "object_pinned"
+	ObjectLocked         Code = "object_locked"
+)
+
+// Entry describes how an error code is surfaced to the clients.
+type Entry struct {
+	Code Code
+	RPC  rpcstatus.StatusCode
+	HTTP int
+}
+
+// RPCError returns a DRPC error with the status of the entry.
+func (entry Entry) RPCError(message string) error {
+	return rpcstatus.Error(entry.RPC, message)
+}
+
+// entries contains the status mapping of every code.
+var entries = map[Code]Entry{}
+
+func init() {
+	for _, entry := range []Entry{
+		{Internal, rpcstatus.Internal, http.StatusInternalServerError},
+		{Canceled, rpcstatus.Canceled, 499},
+		{DeadlineExceeded, rpcstatus.DeadlineExceeded, http.StatusGatewayTimeout},
+		{InvalidArgument, rpcstatus.InvalidArgument, http.StatusBadRequest},
+		{NotFound, rpcstatus.NotFound, http.StatusNotFound},
+		{AlreadyExists, rpcstatus.AlreadyExists, http.StatusConflict},
+		{FailedPrecondition, rpcstatus.FailedPrecondition, http.StatusPreconditionFailed},
+		{Conflict, rpcstatus.Aborted, http.StatusConflict},
+		{PermissionDenied, rpcstatus.PermissionDenied, http.StatusForbidden},
+		{Unauthenticated, rpcstatus.Unauthenticated, http.StatusUnauthorized},
+		{ResourceExhausted, rpcstatus.ResourceExhausted, http.StatusTooManyRequests},
+		{Unimplemented, rpcstatus.Unimplemented, http.StatusNotImplemented},
+		{Unavailable, rpcstatus.Unavailable, http.StatusServiceUnavailable},
+
+		{ObjectNotFound, rpcstatus.NotFound, http.StatusNotFound},
+		{SegmentNotFound, rpcstatus.NotFound, http.StatusNotFound},
+		{PendingObjectMissing, rpcstatus.NotFound, http.StatusNotFound},
+		{ObjectAlreadyExists, rpcstatus.AlreadyExists, http.StatusConflict},
+		{MethodNotAllowed, rpcstatus.MethodNotAllowed, http.StatusMethodNotAllowed},
+		{BucketNotFound, rpcstatus.NotFound, http.StatusNotFound},
+		{BucketAlreadyExists, rpcstatus.AlreadyExists, http.StatusConflict},
+		{BucketNotEmpty, rpcstatus.FailedPrecondition, http.StatusConflict},
+		{UsageLimitExceeded, rpcstatus.ResourceExhausted, http.StatusPaymentRequired},
+		{ObjectPinned, rpcstatus.PermissionDenied, http.StatusForbidden},
+		{ObjectLocked, rpcstatus.PermissionDenied, http.StatusForbidden},
+	} {
+		entries[entry.Code] = entry
+	}
+}
+
+// classes maps the domain error classes to their codes. More specific classes
+// must come before the generic ones.
+var classes = []struct {
+	class *errs.Class
+	code  Code
+}{
+	{&metabase.ErrObjectNotFound, ObjectNotFound},
+	{&metabase.ErrSegmentNotFound, SegmentNotFound},
+	{&metabase.ErrPendingObjectMissing, PendingObjectMissing},
+	{&metabase.ErrObjectAlreadyExists, ObjectAlreadyExists},
+	{&metabase.ErrMethodNotAllowed, MethodNotAllowed},
+	{&metabase.ErrInvalidRequest, InvalidArgument},
+	{&metabase.ErrProjectQuotaExceeded, UsageLimitExceeded},
+	{&metabase.ErrFailedPrecondition, FailedPrecondition},
+	{&metabase.ErrConflict, Conflict},
+	{&metabase.ErrValueChanged, Conflict},
+	{&metabase.ErrPermissionDenied, PermissionDenied},
+	{&metabase.ErrObjectPinned, ObjectPinned},
+	{&metabase.ErrObjectPinNotFound, NotFound},
+	{&metabase.ErrObjectPinExists, AlreadyExists},
+	{&metabase.ErrObjectLock, ObjectLocked},
+
+	{&buckets.ErrBucketNotFound, BucketNotFound},
+	{&buckets.ErrBucketAlreadyExists, BucketAlreadyExists},
+	{&buckets.ErrBucketNotEmpty, BucketNotEmpty},
+	{&buckets.ErrNoBucket, InvalidArgument},
+	{&buckets.ErrInvalidLifecycle, InvalidArgument},
+	{&buckets.ErrInvalidSettings, InvalidArgument},
+	{&buckets.ErrStorageClass, FailedPrecondition},
+
+	{&console.ErrUnauthorized, Unauthenticated},
+	{&console.ErrForbidden, PermissionDenied},
+	{&console.ErrNoMembership, PermissionDenied},
+	{&console.ErrConflict, Conflict},
+	{&console.ErrUsage, UsageLimitExceeded},
+	{&console.ErrProjLimit, UsageLimitExceeded},
+	{&console.ErrTooManyAttempts, ResourceExhausted},
+}
+
+// rpcCodes maps the DRPC statuses to the generic codes, for errors which
+// already carry a status.
+var rpcCodes = map[rpcstatus.StatusCode]Code{
+	rpcstatus.Canceled:           Canceled,
+	rpcstatus.DeadlineExceeded:   DeadlineExceeded,
+	rpcstatus.InvalidArgument:    InvalidArgument,
+	rpcstatus.NotFound:           NotFound,
+	rpcstatus.AlreadyExists:      AlreadyExists,
+	rpcstatus.FailedPrecondition: FailedPrecondition,
+	rpcstatus.Aborted:            Conflict,
+	rpcstatus.PermissionDenied:   PermissionDenied,
+	rpcstatus.Unauthenticated:    Unauthenticated,
+	rpcstatus.ResourceExhausted:  ResourceExhausted,
+	rpcstatus.Unimplemented:      Unimplemented,
+	rpcstatus.Unavailable:        Unavailable,
+	rpcstatus.MethodNotAllowed:   MethodNotAllowed,
+}
+
+// httpCodes maps the HTTP statuses to the generic codes, for errors without
+// a domain class, which were given an explicit status by the handler.
+var httpCodes = map[int]Code{
+	http.StatusBadRequest:          InvalidArgument,
+	http.StatusUnauthorized:        Unauthenticated,
+	http.StatusPaymentRequired:     UsageLimitExceeded,
+	http.StatusForbidden:           PermissionDenied,
+	http.StatusNotFound:            NotFound,
+	http.StatusMethodNotAllowed:    MethodNotAllowed,
+	http.StatusConflict:            Conflict,
+	http.StatusPreconditionFailed:  FailedPrecondition,
+	http.StatusTooManyRequests:     ResourceExhausted,
+	http.StatusNotImplemented:      Unimplemented,
+	http.StatusServiceUnavailable:  Unavailable,
+	http.StatusGatewayTimeout:      DeadlineExceeded,
+	http.StatusInternalServerError: Internal,
+}
+
+// Lookup returns the catalog entry of the error. Unknown errors are reported as Internal.
+func Lookup(err error) Entry {
+	entry, _ := lookup(err)
+	return entry
+}
+
+// LookupHTTP returns the catalog entry of the error served with the specified
+// HTTP status. The status is used to choose the code when the error isn't known.
+func LookupHTTP(err error, status int) Entry {
+	if entry, ok := lookup(err); ok {
+		return entry
+	}
+	if code, ok := httpCodes[status]; ok {
+		return Entry{Code: code, RPC: entries[code].RPC, HTTP: status}
+	}
+	return Entry{Code: Internal, RPC: rpcstatus.Internal, HTTP: status}
+}
+
+func lookup(err error) (Entry, bool) {
+	switch {
+	case err == nil:
+		return Entry{}, false
+	case errors.Is(err, context.Canceled):
+		return entries[Canceled], true
+	case errors.Is(err, context.DeadlineExceeded):
+		return entries[DeadlineExceeded], true
+	}
+
+	for _, class := range classes {
+		if class.class.Has(err) {
+			return entries[class.code], true
+		}
+	}
+
+	if code, ok := rpcCodes[rpcstatus.Code(err)]; ok {
+		return entries[code], true
+	}
+
+	return entries[Internal], false
+}
+
+// Tag returns the series tag of the error code, to be used in metrics.
+func Tag(code Code) monkit.SeriesTag {
+	return monkit.NewSeriesTag("error_code", string(code))
+}
+
+// Record counts the error returned to a client by the specified component.
+func Record(component string, code Code) {
+	mon.Counter("client_errors", monkit.NewSeriesTag("component", component), Tag(code)).Inc(1)
+}
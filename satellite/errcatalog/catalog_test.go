@@ -0,0 +1,55 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package errcatalog_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zeebo/errs"
+
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/storj/satellite/buckets"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/errcatalog"
+	"storj.io/storj/satellite/metabase"
+)
+
+func TestLookup(t *testing.T) {
+	for _, tc := range []struct {
+		err   error
+		entry errcatalog.Entry
+	}{
+		{metabase.ErrObjectNotFound.New("x"), errcatalog.Entry{Code: errcatalog.ObjectNotFound, RPC: rpcstatus.NotFound, HTTP: http.StatusNotFound}},
+		{metabase.ErrFailedPrecondition.New("x"), errcatalog.Entry{Code: errcatalog.FailedPrecondition, RPC: rpcstatus.FailedPrecondition, HTTP: http.StatusPreconditionFailed}},
+		{metabase.ErrProjectQuotaExceeded.New("x"), errcatalog.Entry{Code: errcatalog.UsageLimitExceeded, RPC: rpcstatus.ResourceExhausted, HTTP: http.StatusPaymentRequired}},
+		{metabase.ErrPermissionDenied.New("x"), errcatalog.Entry{Code: errcatalog.PermissionDenied, RPC: rpcstatus.PermissionDenied, HTTP: http.StatusForbidden}},
+		{buckets.ErrBucketNotFound.New("x"), errcatalog.Entry{Code: errcatalog.BucketNotFound, RPC: rpcstatus.NotFound, HTTP: http.StatusNotFound}},
+		{console.ErrUnauthorized.Wrap(errs.New("x")), errcatalog.Entry{Code: errcatalog.Unauthenticated, RPC: rpcstatus.Unauthenticated, HTTP: http.StatusUnauthorized}},
+		{rpcstatus.Error(rpcstatus.ResourceExhausted, "x"), errcatalog.Entry{Code: errcatalog.ResourceExhausted, RPC: rpcstatus.ResourceExhausted, HTTP: http.StatusTooManyRequests}},
+		{errs.Wrap(context.Canceled), errcatalog.Entry{Code: errcatalog.Canceled, RPC: rpcstatus.Canceled, HTTP: 499}},
+		{errs.New("x"), errcatalog.Entry{Code: errcatalog.Internal, RPC: rpcstatus.Internal, HTTP: http.StatusInternalServerError}},
+	} {
+		require.Equal(t, tc.entry, errcatalog.Lookup(tc.err), tc.err.Error())
+	}
+
+	// metabase errors are classified before the wrapping class.
+	require.Equal(t, errcatalog.ObjectNotFound, errcatalog.Lookup(metabase.Error.Wrap(metabase.ErrObjectNotFound.New("x"))).Code)
+}
+
+func TestLookupHTTP(t *testing.T) {
+	entry := errcatalog.LookupHTTP(errs.New("invalid id"), http.StatusBadRequest)
+	require.Equal(t, errcatalog.InvalidArgument, entry.Code)
+	require.Equal(t, http.StatusBadRequest, entry.HTTP)
+
+	entry = errcatalog.LookupHTTP(errs.New("teapot"), http.StatusTeapot)
+	require.Equal(t, errcatalog.Internal, entry.Code)
+	require.Equal(t, http.StatusTeapot, entry.HTTP)
+
+	// known errors keep their code.
+	entry = errcatalog.LookupHTTP(console.ErrForbidden.New("x"), http.StatusUnauthorized)
+	require.Equal(t, errcatalog.PermissionDenied, entry.Code)
+}
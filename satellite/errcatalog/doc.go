@@ -0,0 +1,9 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package errcatalog maps satellite domain errors to stable machine-readable codes.
+//
+// Every code has a single DRPC status and HTTP status, so the same failure is
+// reported the same way by metainfo and by the REST APIs, and clients can branch
+// on the code instead of parsing messages.
+package errcatalog
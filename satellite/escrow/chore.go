@@ -0,0 +1,59 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package escrow
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"storj.io/common/sync2"
+)
+
+// Chore stores the recovery envelopes of projects which don't have one yet,
+// e.g. projects created since the last run or before escrow was enabled.
+//
+// architecture: Chore
+type Chore struct {
+	log     *zap.Logger
+	service *Service
+	config  Config
+
+	Loop *sync2.Cycle
+}
+
+// NewChore creates a new instance of the escrow chore.
+func NewChore(log *zap.Logger, service *Service, config Config) *Chore {
+	return &Chore{
+		log:     log,
+		service: service,
+		config:  config,
+
+		Loop: sync2.NewCycle(config.Interval),
+	}
+}
+
+// Run starts storing the missing recovery envelopes.
+func (chore *Chore) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return chore.Loop.Run(ctx, func(ctx context.Context) error {
+		for {
+			escrowed, err := chore.service.EscrowPending(ctx, chore.config.BatchSize)
+			if err != nil {
+				chore.log.Error("storing recovery envelopes failed", zap.Error(err))
+				return nil
+			}
+			if escrowed < chore.config.BatchSize {
+				return nil
+			}
+		}
+	})
+}
+
+// Close stops the escrow chore.
+func (chore *Chore) Close() error {
+	chore.Loop.Close()
+	return nil
+}
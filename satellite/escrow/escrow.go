@@ -0,0 +1,96 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package escrow implements the escrow of satellite managed project
+// passphrases. Recovery envelopes of the passphrases are split across escrow
+// keys, and can only be opened after several operators approved a recovery.
+package escrow
+
+import (
+	"context"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+
+	"storj.io/common/uuid"
+)
+
+var (
+	mon = monkit.Package()
+
+	// Error is the default error class for the escrow package.
+	Error = errs.Class("escrow")
+
+	// ErrNotFound is returned when a recovery or a recovery envelope does not exist.
+	ErrNotFound = errs.Class("escrow not found")
+
+	// ErrValidation is returned when a recovery request is invalid.
+	ErrValidation = errs.Class("escrow validation")
+
+	// ErrConflict is returned when a recovery can't be approved or used in its current state.
+	ErrConflict = errs.Class("escrow conflict")
+)
+
+// Project is a project with a satellite managed passphrase.
+type Project struct {
+	ID            uuid.UUID
+	PassphraseEnc []byte
+}
+
+// Approval is the approval of a recovery by an operator.
+type Approval struct {
+	ApprovedBy string
+	CreatedAt  time.Time
+}
+
+// Recovery is a request to recover the passphrase of a project.
+type Recovery struct {
+	ID          uuid.UUID
+	ProjectID   uuid.UUID
+	RequestedBy string
+	Reason      string
+	CreatedAt   time.Time
+	Approvals   []Approval
+	// RecoveredAt is set once the passphrase was recovered, a recovery can
+	// only be used once.
+	RecoveredAt *time.Time
+}
+
+// Recovered returns whether the recovery was used already.
+func (recovery Recovery) Recovered() bool {
+	return recovery.RecoveredAt != nil
+}
+
+// ApprovedBy returns whether the operator approved the recovery.
+func (recovery Recovery) ApprovedBy(operator string) bool {
+	for _, approval := range recovery.Approvals {
+		if approval.ApprovedBy == operator {
+			return true
+		}
+	}
+	return false
+}
+
+// DB is the interface for storing recovery envelopes and recoveries.
+//
+// architecture: Database
+type DB interface {
+	// InsertEnvelope stores the recovery envelope of a project, unless it has one already.
+	InsertEnvelope(ctx context.Context, projectID uuid.UUID, envelope []byte) error
+	// GetEnvelope returns the recovery envelope of a project.
+	GetEnvelope(ctx context.Context, projectID uuid.UUID) ([]byte, error)
+	// ListUnescrowed returns at most limit projects with a satellite managed passphrase and no recovery envelope.
+	ListUnescrowed(ctx context.Context, limit int) ([]Project, error)
+
+	// InsertRecovery inserts a new recovery.
+	InsertRecovery(ctx context.Context, recovery Recovery) (Recovery, error)
+	// GetRecovery returns the recovery with the specified ID, including its approvals.
+	GetRecovery(ctx context.Context, id uuid.UUID) (Recovery, error)
+	// ListRecoveries returns the recoveries of a project, including their approvals, ordered by creation time.
+	ListRecoveries(ctx context.Context, projectID uuid.UUID) ([]Recovery, error)
+	// InsertApproval records the approval of an unused recovery by an operator.
+	InsertApproval(ctx context.Context, id uuid.UUID, approvedBy string) error
+	// MarkRecovered marks an unused recovery as used.
+	MarkRecovered(ctx context.Context, id uuid.UUID, recoveredAt time.Time) error
+}
@@ -0,0 +1,273 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package escrow
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/kms"
+)
+
+// Config contains configurable values for the passphrase escrow.
+type Config struct {
+	Enabled            bool          `help:"whether recovery envelopes of satellite managed project passphrases are stored, requires key-management escrow keys" default:"false"`
+	Interval           time.Duration `help:"how often to store the recovery envelopes of projects which don't have one" releaseDefault:"1h" devDefault:"10s"`
+	BatchSize          int           `help:"how many recovery envelopes are stored in a single iteration" default:"100"`
+	RequiredApprovals  int           `help:"how many operators, other than the requester, have to approve a passphrase recovery" default:"2"`
+	RecoveryExpiration time.Duration `help:"how long a passphrase recovery can be approved and used after it was requested" default:"72h"`
+}
+
+// Service stores recovery envelopes of project passphrases and recovers
+// them once enough operators approved. Every recovery activity is written to
+// the audit log.
+//
+// architecture: Service
+type Service struct {
+	log      *zap.Logger
+	auditLog *zap.Logger
+	db       DB
+	kms      *kms.Service
+	config   Config
+
+	nowFn func() time.Time
+}
+
+// NewService creates a new escrow service.
+func NewService(log *zap.Logger, db DB, kmsService *kms.Service, config Config) *Service {
+	return &Service{
+		log:      log,
+		auditLog: log.Named("auditlog"),
+		db:       db,
+		kms:      kmsService,
+		config:   config,
+
+		nowFn: time.Now,
+	}
+}
+
+// TestingSetNow allows tests to have the service act as if the current time is whatever they want.
+func (service *Service) TestingSetNow(nowFn func() time.Time) {
+	service.nowFn = nowFn
+}
+
+// EscrowPending stores the recovery envelopes of at most limit projects which
+// don't have one yet, and returns how many of them were stored. A project
+// failing to be escrowed is logged and retried on the next call.
+func (service *Service) EscrowPending(ctx context.Context, limit int) (escrowed int, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	projects, err := service.db.ListUnescrowed(ctx, limit)
+	if err != nil {
+		return 0, Error.Wrap(err)
+	}
+
+	for _, project := range projects {
+		if err := service.escrow(ctx, project); err != nil {
+			mon.Counter("passphrase_escrow_failures").Inc(1)
+			service.log.Error("storing recovery envelope failed",
+				zap.Stringer("Project ID", project.ID),
+				zap.Error(err))
+			continue
+		}
+		escrowed++
+	}
+
+	mon.IntVal("passphrases_escrowed").Observe(int64(escrowed))
+	return escrowed, nil
+}
+
+func (service *Service) escrow(ctx context.Context, project Project) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	passphrase, err := service.kms.DecryptPassphrase(ctx, project.PassphraseEnc)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	envelope, err := service.kms.SealEscrow(ctx, passphrase)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	return Error.Wrap(service.db.InsertEnvelope(ctx, project.ID, envelope))
+}
+
+// Request requests the recovery of the passphrase of a project.
+func (service *Service) Request(ctx context.Context, operator string, projectID uuid.UUID, reason string) (_ Recovery, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if operator == "" {
+		return Recovery{}, ErrValidation.New("operator is required")
+	}
+	if strings.TrimSpace(reason) == "" {
+		return Recovery{}, ErrValidation.New("reason is required")
+	}
+
+	if _, err := service.db.GetEnvelope(ctx, projectID); err != nil {
+		if ErrNotFound.Has(err) {
+			return Recovery{}, ErrNotFound.New("project passphrase is not escrowed")
+		}
+		return Recovery{}, Error.Wrap(err)
+	}
+
+	id, err := uuid.New()
+	if err != nil {
+		return Recovery{}, Error.Wrap(err)
+	}
+
+	recovery, err := service.db.InsertRecovery(ctx, Recovery{
+		ID:          id,
+		ProjectID:   projectID,
+		RequestedBy: operator,
+		Reason:      reason,
+	})
+	if err != nil {
+		return Recovery{}, Error.Wrap(err)
+	}
+
+	service.audit(operator, "request passphrase recovery", recovery, nil)
+	return recovery, nil
+}
+
+// Get returns the recovery with the specified ID.
+func (service *Service) Get(ctx context.Context, id uuid.UUID) (_ Recovery, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	recovery, err := service.db.GetRecovery(ctx, id)
+	if err != nil {
+		return Recovery{}, Error.Wrap(err)
+	}
+	return recovery, nil
+}
+
+// List returns the recoveries of a project.
+func (service *Service) List(ctx context.Context, projectID uuid.UUID) (_ []Recovery, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	recoveries, err := service.db.ListRecoveries(ctx, projectID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return recoveries, nil
+}
+
+// Approve approves a recovery. The requester of a recovery can't approve it.
+func (service *Service) Approve(ctx context.Context, operator string, id uuid.UUID) (_ Recovery, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	recovery, err := service.db.GetRecovery(ctx, id)
+	if err != nil {
+		return Recovery{}, Error.Wrap(err)
+	}
+
+	switch {
+	case operator == "":
+		err = ErrValidation.New("operator is required")
+	case operator == recovery.RequestedBy:
+		err = ErrValidation.New("the requester can't approve the recovery")
+	case recovery.ApprovedBy(operator):
+		err = ErrConflict.New("recovery was already approved by %s", operator)
+	default:
+		err = service.checkUsable(recovery)
+	}
+	if err != nil {
+		service.audit(operator, "deny passphrase recovery approval", recovery, err)
+		return Recovery{}, err
+	}
+
+	if err := service.db.InsertApproval(ctx, id, operator); err != nil {
+		return Recovery{}, Error.Wrap(err)
+	}
+
+	recovery, err = service.db.GetRecovery(ctx, id)
+	if err != nil {
+		return Recovery{}, Error.Wrap(err)
+	}
+
+	service.audit(operator, "approve passphrase recovery", recovery, nil)
+	return recovery, nil
+}
+
+// Recover returns the passphrase of an approved recovery. Only the requester
+// can recover the passphrase, and only once.
+func (service *Service) Recover(ctx context.Context, operator string, id uuid.UUID) (_ []byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	recovery, err := service.db.GetRecovery(ctx, id)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	switch {
+	case operator == "" || operator != recovery.RequestedBy:
+		err = ErrValidation.New("only the requester can recover the passphrase")
+	case len(recovery.Approvals) < service.config.RequiredApprovals:
+		err = ErrConflict.New("recovery has %d of %d required approvals", len(recovery.Approvals), service.config.RequiredApprovals)
+	default:
+		err = service.checkUsable(recovery)
+	}
+	if err != nil {
+		service.audit(operator, "deny passphrase recovery", recovery, err)
+		return nil, err
+	}
+
+	envelope, err := service.db.GetEnvelope(ctx, recovery.ProjectID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	// the recovery is marked as used before the envelope is opened, so that
+	// concurrent requests can't use it twice.
+	now := service.nowFn()
+	if err := service.db.MarkRecovered(ctx, id, now); err != nil {
+		return nil, Error.Wrap(err)
+	}
+	recovery.RecoveredAt = &now
+
+	passphrase, err := service.kms.OpenEscrow(ctx, envelope)
+	if err != nil {
+		service.audit(operator, "fail passphrase recovery", recovery, err)
+		return nil, Error.Wrap(err)
+	}
+
+	service.audit(operator, "recover passphrase", recovery, nil)
+	return passphrase, nil
+}
+
+// checkUsable returns an error when the recovery was used or expired.
+func (service *Service) checkUsable(recovery Recovery) error {
+	if recovery.Recovered() {
+		return ErrConflict.New("recovery was already used")
+	}
+	if !service.nowFn().Before(recovery.CreatedAt.Add(service.config.RecoveryExpiration)) {
+		return ErrConflict.New("recovery expired")
+	}
+	return nil
+}
+
+func (service *Service) audit(operator, operation string, recovery Recovery, reason error) {
+	approvers := make([]string, 0, len(recovery.Approvals))
+	for _, approval := range recovery.Approvals {
+		approvers = append(approvers, approval.ApprovedBy)
+	}
+
+	fields := []zap.Field{
+		zap.String("operation", operation),
+		zap.String("operator", operator),
+		zap.Stringer("recovery", recovery.ID),
+		zap.Stringer("project", recovery.ProjectID),
+		zap.String("requestedBy", recovery.RequestedBy),
+		zap.String("reason", recovery.Reason),
+		zap.Strings("approvedBy", approvers),
+	}
+	if reason != nil {
+		fields = append(fields, zap.NamedError("denied", reason))
+	}
+	service.auditLog.Info("passphrase recovery activity", fields...)
+}
@@ -0,0 +1,125 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package escrow_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/escrow"
+	"storj.io/storj/satellite/kms"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestService(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		kmsService := kms.NewService(kms.Config{
+			TestMasterKey:   "test-master-key",
+			TestEscrowKeys:  []string{"escrow-key-1", "escrow-key-2", "escrow-key-3"},
+			EscrowThreshold: 2,
+		})
+		require.NoError(t, kmsService.Initialize(ctx))
+		defer ctx.Check(kmsService.Close)
+
+		service := escrow.NewService(zaptest.NewLogger(t), db.PassphraseEscrows(), kmsService, escrow.Config{
+			RequiredApprovals:  2,
+			RecoveryExpiration: 24 * time.Hour,
+		})
+
+		now := time.Now()
+		service.TestingSetNow(func() time.Time { return now })
+
+		passphraseEnc, err := kmsService.GenerateEncryptedPassphrase(ctx)
+		require.NoError(t, err)
+		passphrase, err := kmsService.DecryptPassphrase(ctx, passphraseEnc)
+		require.NoError(t, err)
+
+		managed, err := db.Console().Projects().Insert(ctx, &console.Project{ID: testrand.UUID(), Name: "managed", PassphraseEnc: passphraseEnc})
+		require.NoError(t, err)
+		unmanaged, err := db.Console().Projects().Insert(ctx, &console.Project{ID: testrand.UUID(), Name: "unmanaged"})
+		require.NoError(t, err)
+
+		// a project can't be recovered before it was escrowed.
+		_, err = service.Request(ctx, "requester@storj.test", managed.ID, "customer lost access")
+		require.True(t, escrow.ErrNotFound.Has(err), err)
+
+		escrowed, err := service.EscrowPending(ctx, 10)
+		require.NoError(t, err)
+		require.Equal(t, 1, escrowed)
+
+		escrowed, err = service.EscrowPending(ctx, 10)
+		require.NoError(t, err)
+		require.Zero(t, escrowed)
+
+		_, err = service.Request(ctx, "requester@storj.test", unmanaged.ID, "customer lost access")
+		require.True(t, escrow.ErrNotFound.Has(err), err)
+
+		_, err = service.Request(ctx, "requester@storj.test", managed.ID, " ")
+		require.True(t, escrow.ErrValidation.Has(err), err)
+
+		recovery, err := service.Request(ctx, "requester@storj.test", managed.ID, "customer lost access")
+		require.NoError(t, err)
+
+		// the requester can't approve or recover without approvals.
+		_, err = service.Approve(ctx, "requester@storj.test", recovery.ID)
+		require.True(t, escrow.ErrValidation.Has(err), err)
+
+		_, err = service.Recover(ctx, "requester@storj.test", recovery.ID)
+		require.True(t, escrow.ErrConflict.Has(err), err)
+
+		recovery, err = service.Approve(ctx, "approver-1@storj.test", recovery.ID)
+		require.NoError(t, err)
+		require.Len(t, recovery.Approvals, 1)
+
+		_, err = service.Approve(ctx, "approver-1@storj.test", recovery.ID)
+		require.True(t, escrow.ErrConflict.Has(err), err)
+
+		_, err = service.Recover(ctx, "requester@storj.test", recovery.ID)
+		require.True(t, escrow.ErrConflict.Has(err), err)
+
+		recovery, err = service.Approve(ctx, "approver-2@storj.test", recovery.ID)
+		require.NoError(t, err)
+		require.Len(t, recovery.Approvals, 2)
+
+		// only the requester can recover the passphrase.
+		_, err = service.Recover(ctx, "approver-1@storj.test", recovery.ID)
+		require.True(t, escrow.ErrValidation.Has(err), err)
+
+		recovered, err := service.Recover(ctx, "requester@storj.test", recovery.ID)
+		require.NoError(t, err)
+		require.Equal(t, passphrase, recovered)
+
+		// a recovery can only be used once.
+		_, err = service.Recover(ctx, "requester@storj.test", recovery.ID)
+		require.True(t, escrow.ErrConflict.Has(err), err)
+
+		_, err = service.Approve(ctx, "approver-3@storj.test", recovery.ID)
+		require.True(t, escrow.ErrConflict.Has(err), err)
+
+		// an expired recovery can't be approved.
+		expired, err := service.Request(ctx, "requester@storj.test", managed.ID, "customer lost access again")
+		require.NoError(t, err)
+
+		now = now.Add(25 * time.Hour)
+		_, err = service.Approve(ctx, "approver-1@storj.test", expired.ID)
+		require.True(t, escrow.ErrConflict.Has(err), err)
+
+		recoveries, err := service.List(ctx, managed.ID)
+		require.NoError(t, err)
+		require.Len(t, recoveries, 2)
+		require.Equal(t, recovery.ID, recoveries[0].ID)
+		require.True(t, recoveries[0].Recovered())
+		require.Len(t, recoveries[0].Approvals, 2)
+		require.Equal(t, expired.ID, recoveries[1].ID)
+		require.False(t, recoveries[1].Recovered())
+		require.Empty(t, recoveries[1].Approvals)
+	})
+}
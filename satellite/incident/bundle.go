@@ -0,0 +1,321 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package incident collects the diagnostic bundle which support gathers
+// during incidents.
+package incident
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/memory"
+	"storj.io/common/version"
+)
+
+var (
+	mon = monkit.Package()
+
+	// Error is the error class for incident bundles.
+	Error = errs.Class("incident")
+)
+
+// redacted replaces the configuration values, which aren't known to be safe.
+const redacted = "[redacted]"
+
+// publicFields contains the names of configuration fields, whose text values
+// are included in the bundle. Text values of any other field may contain
+// secrets, e.g. credentials in a database URL, and are redacted.
+var publicFields = map[string]bool{
+	"Address":         true,
+	"ExternalAddress": true,
+	"Level":           true,
+	"Name":            true,
+	"PrivateAddress":  true,
+	"PublicAddress":   true,
+	"SatelliteName":   true,
+	"StaticDir":       true,
+}
+
+// publicTypes contains the types, which are included in the bundle by their
+// string representation regardless of the field name.
+var publicTypes = map[reflect.Type]bool{
+	reflect.TypeOf(time.Duration(0)): true,
+	reflect.TypeOf(time.Time{}):      true,
+	reflect.TypeOf(memory.Size(0)):   true,
+}
+
+// Collector collects diagnostic bundles from the monitoring registry and
+// the configuration of the process.
+type Collector struct {
+	log      *zap.Logger
+	registry *monkit.Registry
+	config   interface{}
+
+	nowFn func() time.Time
+}
+
+// NewCollector creates a new bundle collector. config is the configuration
+// of the process, which is included as returned by Redact.
+func NewCollector(log *zap.Logger, registry *monkit.Registry, config interface{}) *Collector {
+	return &Collector{
+		log:      log,
+		registry: registry,
+		config:   config,
+		nowFn:    time.Now,
+	}
+}
+
+// Info describes the process which the bundle was collected from.
+type Info struct {
+	CollectedAt time.Time    `json:"collectedAt"`
+	Hostname    string       `json:"hostname"`
+	Version     version.Info `json:"version"`
+}
+
+// FuncHealth contains the call statistics of a monitored function, e.g. a chore
+// or an endpoint.
+type FuncHealth struct {
+	Name      string           `json:"name"`
+	Current   int64            `json:"current"`
+	Highwater int64            `json:"highwater"`
+	Successes int64            `json:"successes"`
+	Errors    map[string]int64 `json:"errors,omitempty"`
+	Panics    int64            `json:"panics"`
+	ErrorRate float64          `json:"errorRate"`
+}
+
+// DBPool contains the connection pool statistics of a database.
+type DBPool struct {
+	Name               string  `json:"name"`
+	MaxOpenConnections float64 `json:"maxOpenConnections"`
+	OpenConnections    float64 `json:"openConnections"`
+	InUse              float64 `json:"inUse"`
+	WaitCount          float64 `json:"waitCount"`
+	// Saturation is the ratio of the used and the maximum connections.
+	Saturation float64 `json:"saturation"`
+}
+
+// WriteBundle writes the diagnostic bundle as a zip archive to w.
+func (collector *Collector) WriteBundle(ctx context.Context, w io.Writer) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	archive := zip.NewWriter(w)
+	defer func() { err = errs.Combine(err, Error.Wrap(archive.Close())) }()
+
+	hostname, err := os.Hostname()
+	if err != nil {
+		collector.log.Debug("unable to get hostname", zap.Error(err))
+	}
+
+	if err := writeJSON(archive, "info.json", Info{
+		CollectedAt: collector.nowFn(),
+		Hostname:    hostname,
+		Version:     version.Build,
+	}); err != nil {
+		return err
+	}
+	if err := writeJSON(archive, "functions.json", collector.funcHealth()); err != nil {
+		return err
+	}
+	if err := writeJSON(archive, "db-pools.json", collector.dbPools()); err != nil {
+		return err
+	}
+	if err := writeJSON(archive, "config.json", Redact(collector.config)); err != nil {
+		return err
+	}
+	return collector.writeMetrics(archive)
+}
+
+// funcHealth returns the statistics of all called functions, the ones with
+// the highest error rate first.
+func (collector *Collector) funcHealth() []FuncHealth {
+	var funcs []FuncHealth
+	collector.registry.Funcs(func(f *monkit.Func) {
+		health := FuncHealth{
+			Name:      f.FullName(),
+			Current:   f.Current(),
+			Highwater: f.Highwater(),
+			Successes: f.Success(),
+			Errors:    f.Errors(),
+			Panics:    f.Panics(),
+		}
+
+		failures := health.Panics
+		for _, count := range health.Errors {
+			failures += count
+		}
+		if total := failures + health.Successes; total > 0 {
+			health.ErrorRate = float64(failures) / float64(total)
+		} else if health.Current == 0 {
+			return
+		}
+
+		funcs = append(funcs, health)
+	})
+
+	sort.SliceStable(funcs, func(i, k int) bool {
+		if funcs[i].ErrorRate != funcs[k].ErrorRate {
+			return funcs[i].ErrorRate > funcs[k].ErrorRate
+		}
+		return funcs[i].Name < funcs[k].Name
+	})
+	return funcs
+}
+
+// dbPools returns the connection pool statistics reported by the databases
+// through the db_stats series.
+func (collector *Collector) dbPools() []DBPool {
+	pools := map[string]*DBPool{}
+	collector.registry.Stats(func(key monkit.SeriesKey, field string, val float64) {
+		if key.Measurement != "db_stats" {
+			return
+		}
+		name := key.Tags.Get("db_name")
+		pool, ok := pools[name]
+		if !ok {
+			pool = &DBPool{Name: name}
+			pools[name] = pool
+		}
+		switch field {
+		case "MaxOpenConnections":
+			pool.MaxOpenConnections = val
+		case "OpenConnections":
+			pool.OpenConnections = val
+		case "InUse":
+			pool.InUse = val
+		case "WaitCount":
+			pool.WaitCount = val
+		}
+	})
+
+	result := make([]DBPool, 0, len(pools))
+	for _, pool := range pools {
+		if pool.MaxOpenConnections > 0 {
+			pool.Saturation = pool.InUse / pool.MaxOpenConnections
+		}
+		result = append(result, *pool)
+	}
+	sort.Slice(result, func(i, k int) bool { return result[i].Name < result[k].Name })
+	return result
+}
+
+// writeMetrics writes all the metrics of the registry, e.g. error meters and
+// ranged loop progress, in the same format as the debug endpoint.
+func (collector *Collector) writeMetrics(archive *zip.Writer) error {
+	var lines []string
+	collector.registry.Stats(func(key monkit.SeriesKey, field string, val float64) {
+		lines = append(lines, fmt.Sprintf("%s %s %v", key.WithField(field), field, val))
+	})
+	sort.Strings(lines)
+
+	file, err := archive.Create("metrics.txt")
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	_, err = io.WriteString(file, strings.Join(lines, "\n")+"\n")
+	return Error.Wrap(err)
+}
+
+func writeJSON(archive *zip.Writer, name string, value interface{}) error {
+	file, err := archive.Create(name)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	encoder := json.NewEncoder(file)
+	encoder.SetIndent("", "  ")
+	return Error.Wrap(encoder.Encode(value))
+}
+
+// Redact converts the configuration into a map. Numbers, booleans and the
+// values of publicTypes are included as they are. Any other value, e.g. a
+// string or a type with its own String method, is only included when the
+// field is listed in publicFields; otherwise it's redacted.
+func Redact(config interface{}) interface{} {
+	return redact(reflect.ValueOf(config), false)
+}
+
+// redact converts the value, public tells whether text values are included.
+func redact(value reflect.Value, public bool) interface{} {
+	for value.Kind() == reflect.Pointer || value.Kind() == reflect.Interface {
+		if value.IsNil() {
+			return nil
+		}
+		value = value.Elem()
+	}
+	if !value.IsValid() {
+		return nil
+	}
+
+	if publicTypes[value.Type()] {
+		if stringer, ok := value.Interface().(fmt.Stringer); ok {
+			return stringer.String()
+		}
+	}
+
+	switch value.Kind() {
+	case reflect.Struct:
+		// the fields are walked even when the type has a String method,
+		// which could print secrets stored in the fields.
+		fields := map[string]interface{}{}
+		for i := 0; i < value.NumField(); i++ {
+			field := value.Type().Field(i)
+			if !field.IsExported() {
+				continue
+			}
+			fields[field.Name] = redact(value.Field(i), publicFields[field.Name])
+		}
+		return fields
+	case reflect.Slice, reflect.Array:
+		if value.Type().Elem().Kind() == reflect.Uint8 {
+			return redactText(value.Len() == 0, public, fmt.Sprintf("%x", value.Interface()))
+		}
+		items := make([]interface{}, 0, value.Len())
+		for i := 0; i < value.Len(); i++ {
+			items = append(items, redact(value.Index(i), public))
+		}
+		return items
+	case reflect.Map:
+		items := map[string]interface{}{}
+		iter := value.MapRange()
+		for iter.Next() {
+			name := fmt.Sprint(iter.Key().Interface())
+			items[name] = redact(iter.Value(), public || publicFields[name])
+		}
+		return items
+	// the plain values are returned, so custom JSON marshalers of the
+	// types aren't used.
+	case reflect.Bool:
+		return value.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return value.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return value.Uint()
+	case reflect.Float32, reflect.Float64:
+		return value.Float()
+	case reflect.String:
+		return redactText(value.Len() == 0, public, value.String())
+	default:
+		return nil
+	}
+}
+
+// redactText returns the text, when it's empty or public.
+func redactText(empty, public bool, text string) interface{} {
+	if empty || public {
+		return text
+	}
+	return redacted
+}
@@ -0,0 +1,281 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package integrity cross-checks the segments table against the objects table.
+package integrity
+
+import (
+	"context"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/rangedloop"
+)
+
+var (
+	// Error is a standard error class for this package.
+	Error = errs.Class("segment integrity")
+	mon   = monkit.Package()
+
+	// check if Observer and Partial interfaces are satisfied.
+	_ rangedloop.Observer = (*Observer)(nil)
+	_ rangedloop.Partial  = (*observerFork)(nil)
+)
+
+// Config contains configurable values for the segment integrity observer.
+type Config struct {
+	Enabled   bool `help:"whether to cross-check segments against objects (rangedloop observer)" default:"false"`
+	BatchSize int  `help:"how many streams to look up in the objects table at once" default:"1000"`
+}
+
+// Observer implements the segment integrity ranged loop observer.
+//
+// Every stream seen by the ranged loop is compared against the object owning it,
+// finding segments without an object and committed objects whose segment count
+// or total encrypted size do not match their segments. Discrepancies are written
+// into the queue for repair or cleanup.
+//
+// Objects without any segment are invisible to the segments loop, so only objects
+// which lost some of their segments are reported as missing segments.
+type Observer struct {
+	log      *zap.Logger
+	config   Config
+	metabase *metabase.DB
+	queue    Queue
+
+	startTime time.Time
+	found     map[Kind]int64
+	checked   int64
+}
+
+// NewObserver creates a new segment integrity ranged loop observer.
+func NewObserver(log *zap.Logger, metabaseDB *metabase.DB, queue Queue, config Config) *Observer {
+	return &Observer{
+		log:      log,
+		config:   config,
+		metabase: metabaseDB,
+		queue:    queue,
+	}
+}
+
+// Start implements ranged loop observer start method.
+func (observer *Observer) Start(ctx context.Context, startTime time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	observer.startTime = startTime
+	observer.found = map[Kind]int64{}
+	observer.checked = 0
+	return nil
+}
+
+// Fork implements ranged loop observer fork method.
+func (observer *Observer) Fork(ctx context.Context) (_ rangedloop.Partial, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return &observerFork{
+		log:       observer.log,
+		metabase:  observer.metabase,
+		queue:     observer.queue,
+		batchSize: observer.config.BatchSize,
+		startTime: observer.startTime,
+		found:     map[Kind]int64{},
+	}, nil
+}
+
+// Join checks the streams which are still buffered by the partial and merges its counts.
+func (observer *Observer) Join(ctx context.Context, partial rangedloop.Partial) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	fork, ok := partial.(*observerFork)
+	if !ok {
+		return Error.New("expected %T but got %T", fork, partial)
+	}
+
+	fork.flushStream()
+	if err := fork.check(ctx); err != nil {
+		return err
+	}
+
+	for kind, count := range fork.found {
+		observer.found[kind] += count
+	}
+	observer.checked += fork.checked
+	return nil
+}
+
+// Finish reports the number of discrepancies found during the loop.
+func (observer *Observer) Finish(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	for _, kind := range []Kind{KindOrphanedSegments, KindMissingSegments, KindExtraSegments, KindSizeMismatch} {
+		mon.IntVal("segment_integrity_discrepancies", monkit.NewSeriesTag("kind", string(kind))).Observe(observer.found[kind])
+	}
+	mon.IntVal("segment_integrity_checked_streams").Observe(observer.checked)
+
+	observer.log.Info("segment integrity check finished",
+		zap.Int64("checked streams", observer.checked),
+		zap.Int64("orphaned segments", observer.found[KindOrphanedSegments]),
+		zap.Int64("missing segments", observer.found[KindMissingSegments]),
+		zap.Int64("extra segments", observer.found[KindExtraSegments]),
+		zap.Int64("size mismatches", observer.found[KindSizeMismatch]))
+	return nil
+}
+
+// TestingFound returns the number of discrepancies of the kind found during the last loop.
+func (observer *Observer) TestingFound(kind Kind) int64 {
+	return observer.found[kind]
+}
+
+// streamSummary aggregates the segments of a single stream.
+type streamSummary struct {
+	streamID      uuid.UUID
+	segments      int32
+	encryptedSize int64
+	// expired is set when a segment has expired, since expired segments may be
+	// deleted one by one and would otherwise show up as missing.
+	expired bool
+}
+
+type observerFork struct {
+	log       *zap.Logger
+	metabase  *metabase.DB
+	queue     Queue
+	batchSize int
+	startTime time.Time
+
+	stream  streamSummary
+	pending []streamSummary
+
+	found   map[Kind]int64
+	checked int64
+}
+
+// Process aggregates the segments of each stream and checks the streams in batches.
+// Segments of a stream are always consecutive within a range.
+func (fork *observerFork) Process(ctx context.Context, segments []rangedloop.Segment) (err error) {
+	for _, segment := range segments {
+		if segment.StreamID != fork.stream.streamID {
+			fork.flushStream()
+			fork.stream.streamID = segment.StreamID
+
+			if len(fork.pending) >= fork.batchSize {
+				if err := fork.check(ctx); err != nil {
+					return err
+				}
+			}
+		}
+
+		fork.stream.segments++
+		fork.stream.encryptedSize += int64(segment.EncryptedSize)
+		if segment.Expired(fork.startTime) {
+			fork.stream.expired = true
+		}
+	}
+	return nil
+}
+
+// flushStream moves the current stream into the batch of streams to check.
+func (fork *observerFork) flushStream() {
+	if fork.stream.segments > 0 && !fork.stream.expired {
+		fork.pending = append(fork.pending, fork.stream)
+	}
+	fork.stream = streamSummary{}
+}
+
+// check compares the pending streams against their objects and queues the discrepancies.
+func (fork *observerFork) check(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(fork.pending) == 0 {
+		return nil
+	}
+	defer func() { fork.pending = fork.pending[:0] }()
+
+	streamIDs := make([]uuid.UUID, len(fork.pending))
+	for i, stream := range fork.pending {
+		streamIDs[i] = stream.streamID
+	}
+
+	objects, err := fork.metabase.GetStreamObjects(ctx, metabase.GetStreamObjects{StreamIDs: streamIDs})
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	byStreamID := make(map[uuid.UUID]metabase.StreamObject, len(objects))
+	for _, object := range objects {
+		byStreamID[object.StreamID] = object
+	}
+
+	now := time.Now()
+	var discrepancies []Discrepancy
+	var orphans []uuid.UUID
+	orphanStreams := map[uuid.UUID]streamSummary{}
+
+	for _, stream := range fork.pending {
+		object, ok := byStreamID[stream.streamID]
+		if !ok {
+			orphans = append(orphans, stream.streamID)
+			orphanStreams[stream.streamID] = stream
+			continue
+		}
+		// segment count and size are only set once the object is committed.
+		if object.Status == metabase.Pending || object.Expired(fork.startTime) {
+			continue
+		}
+
+		discrepancy := Discrepancy{
+			StreamID:         stream.streamID,
+			Object:           object.ObjectStream,
+			ExpectedSegments: object.SegmentCount,
+			ActualSegments:   stream.segments,
+			ExpectedSize:     object.TotalEncryptedSize,
+			ActualSize:       stream.encryptedSize,
+			DetectedAt:       now,
+		}
+		switch {
+		case stream.segments < object.SegmentCount:
+			discrepancy.Kind = KindMissingSegments
+			discrepancies = append(discrepancies, discrepancy)
+		case stream.segments > object.SegmentCount:
+			discrepancy.Kind = KindExtraSegments
+			discrepancies = append(discrepancies, discrepancy)
+		}
+		if stream.encryptedSize != object.TotalEncryptedSize {
+			discrepancy.Kind = KindSizeMismatch
+			discrepancies = append(discrepancies, discrepancy)
+		}
+	}
+
+	if len(orphans) > 0 {
+		// the object may have been deleted together with its segments after the
+		// loop read them, so only the streams which still have segments are orphaned.
+		orphans, err = fork.metabase.FilterStreamsWithSegments(ctx, orphans)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		for _, streamID := range orphans {
+			stream := orphanStreams[streamID]
+			discrepancies = append(discrepancies, Discrepancy{
+				StreamID:       streamID,
+				Kind:           KindOrphanedSegments,
+				ActualSegments: stream.segments,
+				ActualSize:     stream.encryptedSize,
+				DetectedAt:     now,
+			})
+		}
+	}
+
+	fork.checked += int64(len(fork.pending))
+	for _, discrepancy := range discrepancies {
+		fork.found[discrepancy.Kind]++
+	}
+
+	if len(discrepancies) == 0 {
+		return nil
+	}
+	return Error.Wrap(fork.queue.Insert(ctx, discrepancies))
+}
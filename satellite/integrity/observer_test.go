@@ -0,0 +1,119 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package integrity_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/integrity"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/metabasetest"
+)
+
+func TestObserver(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.SegmentIntegrity.Enabled = true
+				config.SegmentIntegrity.BatchSize = 2
+				config.RangedLoop.Parallelism = 2
+				config.RangedLoop.BatchSize = 3
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+
+		var objects []metabase.RawObject
+		var segments []metabase.RawSegment
+		addObject := func(status metabase.ObjectStatus, segmentCount int32, totalEncryptedSize int64, actualSegments int) metabase.ObjectStream {
+			stream := metabasetest.RandObjectStream()
+			objects = append(objects, metabase.RawObject{
+				ObjectStream:       stream,
+				CreatedAt:          time.Now(),
+				Status:             status,
+				SegmentCount:       segmentCount,
+				TotalEncryptedSize: totalEncryptedSize,
+				Encryption:         metabasetest.DefaultEncryption,
+			})
+			for i := 0; i < actualSegments; i++ {
+				segments = append(segments, metabasetest.DefaultRawSegment(stream, metabase.SegmentPosition{Index: uint32(i)}))
+			}
+			return stream
+		}
+
+		addObject(metabase.CommittedVersioned, 2, 2048, 2)
+		addObject(metabase.Pending, 0, 0, 1)
+		missing := addObject(metabase.CommittedUnversioned, 3, 3072, 2)
+		mismatch := addObject(metabase.CommittedUnversioned, 1, 999, 1)
+
+		orphan := metabase.ObjectStream{StreamID: testrand.UUID()}
+		segments = append(segments,
+			metabasetest.DefaultRawSegment(orphan, metabase.SegmentPosition{Index: 0}),
+			metabasetest.DefaultRawSegment(orphan, metabase.SegmentPosition{Index: 1}),
+		)
+
+		require.NoError(t, sat.Metabase.DB.TestingBatchInsertObjects(ctx, objects))
+		require.NoError(t, sat.Metabase.DB.TestingBatchInsertSegments(ctx, segments))
+
+		_, err := sat.RangedLoop.RangedLoop.Service.RunOnce(ctx)
+		require.NoError(t, err)
+
+		observer := sat.RangedLoop.SegmentIntegrity.Observer
+		require.EqualValues(t, 1, observer.TestingFound(integrity.KindOrphanedSegments))
+		require.EqualValues(t, 1, observer.TestingFound(integrity.KindMissingSegments))
+		require.EqualValues(t, 0, observer.TestingFound(integrity.KindExtraSegments))
+		require.EqualValues(t, 2, observer.TestingFound(integrity.KindSizeMismatch))
+
+		queue := sat.DB.SegmentIntegrityQueue()
+
+		orphaned, err := queue.Select(ctx, integrity.KindOrphanedSegments, 10)
+		require.NoError(t, err)
+		require.Len(t, orphaned, 1)
+		require.Equal(t, orphan.StreamID, orphaned[0].StreamID)
+		require.True(t, orphaned[0].Object.ProjectID.IsZero())
+		require.EqualValues(t, 2, orphaned[0].ActualSegments)
+		require.EqualValues(t, 2048, orphaned[0].ActualSize)
+
+		missingSegments, err := queue.Select(ctx, integrity.KindMissingSegments, 10)
+		require.NoError(t, err)
+		require.Len(t, missingSegments, 1)
+		require.Equal(t, missing, missingSegments[0].Object)
+		require.EqualValues(t, 3, missingSegments[0].ExpectedSegments)
+		require.EqualValues(t, 2, missingSegments[0].ActualSegments)
+
+		sizeMismatches, err := queue.Select(ctx, integrity.KindSizeMismatch, 10)
+		require.NoError(t, err)
+		require.Len(t, sizeMismatches, 2)
+
+		streams := map[metabase.ObjectStream]integrity.Discrepancy{}
+		for _, discrepancy := range sizeMismatches {
+			streams[discrepancy.Object] = discrepancy
+		}
+		require.Contains(t, streams, missing)
+		require.EqualValues(t, 999, streams[mismatch].ExpectedSize)
+		require.EqualValues(t, 1024, streams[mismatch].ActualSize)
+
+		// finding the same discrepancies again doesn't duplicate them.
+		_, err = sat.RangedLoop.RangedLoop.Service.RunOnce(ctx)
+		require.NoError(t, err)
+
+		sizeMismatches, err = queue.Select(ctx, integrity.KindSizeMismatch, 10)
+		require.NoError(t, err)
+		require.Len(t, sizeMismatches, 2)
+
+		require.NoError(t, queue.Delete(ctx, orphan.StreamID, integrity.KindOrphanedSegments))
+		orphaned, err = queue.Select(ctx, integrity.KindOrphanedSegments, 10)
+		require.NoError(t, err)
+		require.Empty(t, orphaned)
+	})
+}
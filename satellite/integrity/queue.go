@@ -0,0 +1,55 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package integrity
+
+import (
+	"context"
+	"time"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/metabase"
+)
+
+// Kind is the kind of discrepancy found between segments and objects.
+type Kind string
+
+const (
+	// KindOrphanedSegments is a stream which has segments, but no object.
+	KindOrphanedSegments Kind = "orphaned_segments"
+	// KindMissingSegments is a committed object which has fewer segments than its segment count.
+	KindMissingSegments Kind = "missing_segments"
+	// KindExtraSegments is a committed object which has more segments than its segment count.
+	KindExtraSegments Kind = "extra_segments"
+	// KindSizeMismatch is a committed object whose total encrypted size differs from the sum of its segments.
+	KindSizeMismatch Kind = "size_mismatch"
+)
+
+// Discrepancy is an inconsistency between the segments of a stream and the object owning it.
+type Discrepancy struct {
+	StreamID uuid.UUID
+	Kind     Kind
+	// Object is the object owning the stream. It is zero for orphaned segments.
+	Object metabase.ObjectStream
+
+	ExpectedSegments int32
+	ActualSegments   int32
+	ExpectedSize     int64
+	ActualSize       int64
+
+	DetectedAt time.Time
+}
+
+// Queue stores the discrepancies found by the observer until they are repaired or
+// cleaned up. A stream has at most one entry per kind, and finding it again only
+// updates the entry.
+//
+// architecture: Database
+type Queue interface {
+	// Insert adds or updates discrepancies.
+	Insert(ctx context.Context, discrepancies []Discrepancy) error
+	// Select returns up to limit discrepancies of the kind, oldest first.
+	Select(ctx context.Context, kind Kind, limit int) ([]Discrepancy, error)
+	// Delete removes a discrepancy once it has been handled.
+	Delete(ctx context.Context, streamID uuid.UUID, kind Kind) error
+}
@@ -27,6 +27,7 @@ type OrderLimitMetadata struct {
 	BucketId                   []byte   `protobuf:"bytes,1,opt,name=bucket_id,json=bucketId,proto3" json:"bucket_id,omitempty"`
 	ProjectBucketPrefix        []byte   `protobuf:"bytes,2,opt,name=project_bucket_prefix,json=projectBucketPrefix,proto3" json:"project_bucket_prefix,omitempty"`
 	CompactProjectBucketPrefix []byte   `protobuf:"bytes,3,opt,name=compact_project_bucket_prefix,json=compactProjectBucketPrefix,proto3" json:"compact_project_bucket_prefix,omitempty"`
+	ApiKeyId                   []byte   `protobuf:"bytes,4,opt,name=api_key_id,json=apiKeyId,proto3" json:"api_key_id,omitempty"`
 	XXX_NoUnkeyedLiteral       struct{} `json:"-"`
 	XXX_unrecognized           []byte   `json:"-"`
 	XXX_sizecache              int32    `json:"-"`
@@ -77,6 +78,13 @@ func (m *OrderLimitMetadata) GetCompactProjectBucketPrefix() []byte {
 	return nil
 }
 
+func (m *OrderLimitMetadata) GetApiKeyId() []byte {
+	if m != nil {
+		return m.ApiKeyId
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*OrderLimitMetadata)(nil), "satellite.ordersmeta.OrderLimitMetadata")
 }
@@ -84,17 +92,18 @@ func init() {
 func init() { proto.RegisterFile("ordersmeta.proto", fileDescriptor_e00ef1afe54bb544) }
 
 var fileDescriptor_e00ef1afe54bb544 = []byte{
-	// 192 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0xc8, 0x2f, 0x4a, 0x49,
-	0x2d, 0x2a, 0xce, 0x4d, 0x2d, 0x49, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x29, 0x4e,
-	0x2c, 0x49, 0xcd, 0xc9, 0xc9, 0x2c, 0x49, 0xd5, 0x43, 0xc8, 0x29, 0xad, 0x60, 0xe4, 0x12, 0xf2,
-	0x07, 0x71, 0x7d, 0x32, 0x73, 0x33, 0x4b, 0x7c, 0x53, 0x4b, 0x12, 0x53, 0x12, 0x4b, 0x12, 0x85,
-	0xa4, 0xb9, 0x38, 0x93, 0x4a, 0x93, 0xb3, 0x53, 0x4b, 0xe2, 0x33, 0x53, 0x24, 0x18, 0x15, 0x18,
-	0x35, 0x78, 0x82, 0x38, 0x20, 0x02, 0x9e, 0x29, 0x42, 0x46, 0x5c, 0xa2, 0x05, 0x45, 0xf9, 0x59,
-	0xa9, 0xc9, 0x25, 0xf1, 0x50, 0x45, 0x05, 0x45, 0xa9, 0x69, 0x99, 0x15, 0x12, 0x4c, 0x60, 0x85,
-	0xc2, 0x50, 0x49, 0x27, 0xb0, 0x5c, 0x00, 0x58, 0x4a, 0xc8, 0x91, 0x4b, 0x36, 0x39, 0x3f, 0xb7,
-	0x20, 0x31, 0x19, 0xa4, 0x18, 0x9b, 0x5e, 0x66, 0xb0, 0x5e, 0x29, 0xa8, 0xa2, 0x00, 0x4c, 0x23,
-	0x9c, 0x54, 0xa3, 0x94, 0x8b, 0x4b, 0xf2, 0x8b, 0xb2, 0xf4, 0x32, 0xf3, 0xf5, 0xc1, 0x0c, 0x7d,
-	0xb8, 0x8f, 0xf4, 0x33, 0xf3, 0x4a, 0x52, 0x8b, 0xf2, 0x12, 0x73, 0x0a, 0x92, 0x92, 0xd8, 0xc0,
-	0xde, 0x35, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0x00, 0xcc, 0x15, 0xb0, 0x02, 0x01, 0x00, 0x00,
+	// 207 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6d, 0x50, 0x4b, 0x0a, 0xc2, 0x30,
+	0x10, 0xa5, 0x2a, 0xa2, 0xc1, 0x85, 0x44, 0x05, 0xf1, 0x03, 0xa2, 0x08, 0xae, 0x5a, 0xd0, 0x13,
+	0xd8, 0x5d, 0x51, 0x51, 0x5c, 0xba, 0x29, 0x69, 0x33, 0x42, 0xfa, 0x4b, 0x48, 0x47, 0xb0, 0x17,
+	0xf4, 0x5c, 0xb6, 0xb1, 0xe8, 0xc6, 0xdd, 0xcc, 0xfb, 0xf1, 0x66, 0x48, 0x5f, 0x6a, 0x0e, 0x3a,
+	0x4f, 0x01, 0x99, 0xad, 0xb4, 0x44, 0x49, 0x87, 0x39, 0x43, 0x48, 0x12, 0x81, 0x60, 0xff, 0xb8,
+	0xe5, 0xcb, 0x22, 0xf4, 0x5c, 0xad, 0x47, 0x91, 0x0a, 0x3c, 0x95, 0x10, 0x67, 0xc8, 0xe8, 0x94,
+	0x74, 0x83, 0x47, 0x18, 0x03, 0xfa, 0x82, 0x8f, 0xad, 0x85, 0xb5, 0xe9, 0x5d, 0x3b, 0x1f, 0xc0,
+	0xe3, 0x74, 0x4b, 0x46, 0x65, 0x64, 0x04, 0x21, 0xfa, 0xb5, 0x48, 0x69, 0xb8, 0x8b, 0xe7, 0xb8,
+	0x61, 0x84, 0x83, 0x9a, 0x74, 0x0d, 0x77, 0x31, 0x14, 0xdd, 0x93, 0x79, 0x28, 0x53, 0xc5, 0xc2,
+	0x4a, 0xfc, 0xcf, 0xdb, 0x34, 0xde, 0x49, 0x2d, 0xba, 0xfc, 0x89, 0x98, 0x11, 0xc2, 0x94, 0xf0,
+	0x63, 0x28, 0xaa, 0x52, 0xad, 0x4f, 0xa9, 0x12, 0x39, 0x40, 0xe1, 0x71, 0x77, 0x7d, 0x5b, 0xe5,
+	0x28, 0x75, 0x64, 0x0b, 0xe9, 0x98, 0xc1, 0xf9, 0xde, 0xeb, 0x88, 0x0c, 0x41, 0x67, 0x2c, 0x51,
+	0x41, 0xd0, 0x36, 0xcf, 0xd8, 0xbd, 0x01, 0x28, 0x1a, 0x32, 0xd6, 0x20, 0x01, 0x00, 0x00,
 }
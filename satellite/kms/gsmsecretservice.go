@@ -19,7 +19,8 @@ type gsmService struct {
 
 	config Config
 
-	masterKey *storj.Key
+	masterKey  *storj.Key
+	escrowKeys []*storj.Key
 }
 
 // newGsmService creates new gsmService for encrypting/decrypting project passphrases.
@@ -59,6 +60,25 @@ func (s *gsmService) Initialize(ctx context.Context) error {
 		return Error.Wrap(err)
 	}
 
+	for _, version := range s.config.EscrowSecretVersions {
+		escrowKey, err := s.client.AccessSecretVersion(ctx, &secretmanagerpb.AccessSecretVersionRequest{
+			Name: version,
+		})
+		if err != nil {
+			return Error.Wrap(err)
+		}
+
+		if len(escrowKey.Payload.Data) == 0 {
+			return Error.New("no escrow key found in secret manager: %s", version)
+		}
+
+		key, err := storj.NewKey(escrowKey.Payload.Data)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		s.escrowKeys = append(s.escrowKeys, key)
+	}
+
 	return nil
 }
 
@@ -70,6 +90,14 @@ func (s *gsmService) getMasterKey() (*storj.Key, error) {
 	return s.masterKey, nil
 }
 
+// getEscrowKeys returns the keys recovery envelopes are split across.
+func (s *gsmService) getEscrowKeys() ([]*storj.Key, error) {
+	if len(s.escrowKeys) != len(s.config.EscrowSecretVersions) {
+		return nil, Error.New("escrow keys not initialized")
+	}
+	return s.escrowKeys, nil
+}
+
 // Close closes the service.
 func (s *gsmService) Close() error {
 	return s.client.Close()
@@ -12,8 +12,9 @@ import (
 // mockSecretService is a service for encrypting/decrypting project passphrases.
 // it is intended to be used in tests.
 type mockSecretService struct {
-	config    Config
-	masterKey *storj.Key
+	config     Config
+	masterKey  *storj.Key
+	escrowKeys []*storj.Key
 }
 
 // newMockSecretService returns a mockSecretService.
@@ -29,6 +30,14 @@ func (s *mockSecretService) Initialize(_ context.Context) (err error) {
 	if err != nil {
 		return Error.Wrap(err)
 	}
+
+	for _, testKey := range s.config.TestEscrowKeys {
+		escrowKey, err := storj.NewKey([]byte(testKey))
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		s.escrowKeys = append(s.escrowKeys, escrowKey)
+	}
 	return nil
 }
 
@@ -37,6 +46,11 @@ func (s *mockSecretService) getMasterKey() (*storj.Key, error) {
 	return s.masterKey, nil
 }
 
+// getEscrowKeys returns the keys recovery envelopes are split across.
+func (s *mockSecretService) getEscrowKeys() ([]*storj.Key, error) {
+	return s.escrowKeys, nil
+}
+
 // Close closes the service.
 func (s *mockSecretService) Close() error {
 	return nil
@@ -17,6 +17,8 @@ type SecretsService interface {
 	Initialize(ctx context.Context) error
 	// getMasterKey returns the master key.
 	getMasterKey() (*storj.Key, error)
+	// getEscrowKeys returns the keys recovery envelopes are split across.
+	getEscrowKeys() ([]*storj.Key, error)
 	// Close closes the service.
 	Close() error
 }
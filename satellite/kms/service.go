@@ -7,6 +7,7 @@ import (
 	"context"
 	"crypto/rand"
 	"encoding/base64"
+	"encoding/json"
 
 	"github.com/zeebo/errs"
 
@@ -23,6 +24,10 @@ type Config struct {
 	SecretVersion  string `help:"version name of the master key in Google Secret Manager. E.g.: projects/{projectID}/secrets/{secretName}/versions/{latest}" default:""`
 	SecretChecksum int64  `help:"checksum of the master key in Google Secret Manager" default:"0"`
 	TestMasterKey  string `help:"a fake master key to be used for the purpose of testing" default:"test-master-key" hidden:"true"`
+
+	EscrowSecretVersions []string `help:"version names of the keys in Google Secret Manager which recovery envelopes of project passphrases are split across" default:""`
+	EscrowThreshold      int      `help:"how many of the escrow keys are required to open a recovery envelope. Escrow is disabled when 0" default:"0"`
+	TestEscrowKeys       []string `help:"fake escrow keys to be used for the purpose of testing" default:"" hidden:"true"`
 }
 
 // Service is a service for encrypting/decrypting project passphrases.
@@ -56,6 +61,18 @@ func (s *Service) Initialize(ctx context.Context) (err error) {
 		return err
 	}
 
+	if s.EscrowEnabled() {
+		escrowKeys, err := secretService.getEscrowKeys()
+		if err != nil {
+			return errs.Combine(err, secretService.Close())
+		}
+		if s.config.EscrowThreshold > len(escrowKeys) {
+			return errs.Combine(
+				Error.New("escrow threshold %d is larger than the number of escrow keys %d", s.config.EscrowThreshold, len(escrowKeys)),
+				secretService.Close())
+		}
+	}
+
 	s.secretsService = secretService
 
 	s.initialized.Release()
@@ -85,46 +102,153 @@ func (s *Service) EncryptPassphrase(ctx context.Context, passphrase []byte) ([]b
 		return nil, Error.New("service not initialized")
 	}
 
-	var nonce storj.Nonce
-	_, err := rand.Read(nonce[:])
+	masterKey, err := s.secretsService.getMasterKey()
 	if err != nil {
 		return nil, Error.Wrap(err)
 	}
 
+	return encrypt(passphrase, masterKey)
+}
+
+// DecryptPassphrase decrypts the provided encrypted passphrase using
+// the masterKey.
+func (s *Service) DecryptPassphrase(ctx context.Context, encryptedPassphrase []byte) ([]byte, error) {
+	if !s.initialized.Wait(ctx) {
+		return nil, Error.New("service not initialized")
+	}
+
 	masterKey, err := s.secretsService.getMasterKey()
 	if err != nil {
 		return nil, Error.Wrap(err)
 	}
 
-	cipherText, err := encryption.EncryptSecretBox(passphrase, masterKey, &nonce)
+	return decrypt(encryptedPassphrase, masterKey)
+}
+
+// EscrowEnabled returns whether recovery envelopes of passphrases can be
+// sealed and opened.
+func (s *Service) EscrowEnabled() bool {
+	return s.config.EscrowThreshold > 0
+}
+
+// escrowEnvelope is a passphrase split into shares, each of them encrypted
+// with a different escrow key. Shares are in the order of the escrow keys.
+type escrowEnvelope struct {
+	Threshold int      `json:"threshold"`
+	Shares    [][]byte `json:"shares"`
+}
+
+// SealEscrow splits the provided passphrase across the escrow keys, returning
+// a recovery envelope which needs the threshold of the keys to be opened.
+func (s *Service) SealEscrow(ctx context.Context, passphrase []byte) ([]byte, error) {
+	if !s.initialized.Wait(ctx) {
+		return nil, Error.New("service not initialized")
+	}
+	if !s.EscrowEnabled() {
+		return nil, Error.New("escrow is not enabled")
+	}
+
+	escrowKeys, err := s.secretsService.getEscrowKeys()
 	if err != nil {
 		return nil, Error.Wrap(err)
 	}
 
-	// Prepend the nonce to the encrypted passphrase.
-	encryptedPassphrase := make([]byte, storj.NonceSize+len(cipherText))
-	copy(encryptedPassphrase[:storj.NonceSize], nonce[:])
-	copy(encryptedPassphrase[storj.NonceSize:], cipherText)
+	shares, err := splitSecret(passphrase, len(escrowKeys), s.config.EscrowThreshold)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := escrowEnvelope{
+		Threshold: s.config.EscrowThreshold,
+		Shares:    make([][]byte, len(shares)),
+	}
+	for i, share := range shares {
+		envelope.Shares[i], err = encrypt(share, escrowKeys[i])
+		if err != nil {
+			return nil, err
+		}
+	}
 
-	return encryptedPassphrase, nil
+	data, err := json.Marshal(envelope)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return data, nil
 }
 
-// DecryptPassphrase decrypts the provided encrypted passphrase using
-// the masterKey.
-func (s *Service) DecryptPassphrase(ctx context.Context, encryptedPassphrase []byte) ([]byte, error) {
+// OpenEscrow recovers the passphrase from a recovery envelope created by
+// SealEscrow. Shares which can't be decrypted, e.g. because their key was
+// rotated, are skipped as long as the threshold of them is left.
+func (s *Service) OpenEscrow(ctx context.Context, data []byte) ([]byte, error) {
 	if !s.initialized.Wait(ctx) {
 		return nil, Error.New("service not initialized")
 	}
+	if !s.EscrowEnabled() {
+		return nil, Error.New("escrow is not enabled")
+	}
 
-	masterKey, err := s.secretsService.getMasterKey()
+	var envelope escrowEnvelope
+	if err := json.Unmarshal(data, &envelope); err != nil {
+		return nil, Error.New("invalid recovery envelope: %v", err)
+	}
+	if envelope.Threshold < 1 {
+		return nil, Error.New("invalid recovery envelope threshold %d", envelope.Threshold)
+	}
+
+	escrowKeys, err := s.secretsService.getEscrowKeys()
 	if err != nil {
 		return nil, Error.Wrap(err)
 	}
 
+	var shares [][]byte
+	for i, encryptedShare := range envelope.Shares {
+		if i >= len(escrowKeys) {
+			break
+		}
+		share, err := decrypt(encryptedShare, escrowKeys[i])
+		if err != nil {
+			continue
+		}
+		shares = append(shares, share)
+		if len(shares) == envelope.Threshold {
+			return combineShares(shares)
+		}
+	}
+
+	return nil, Error.New("only %d of %d required shares could be decrypted", len(shares), envelope.Threshold)
+}
+
+// encrypt encrypts plaintext with key in an XSalsa20 and Poly1305 encryption,
+// prepending the random nonce to the ciphertext.
+func encrypt(plaintext []byte, key *storj.Key) ([]byte, error) {
 	var nonce storj.Nonce
-	copy(nonce[:], encryptedPassphrase[:storj.NonceSize])
+	_, err := rand.Read(nonce[:])
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
 
-	plaintext, err := encryption.DecryptSecretBox(encryptedPassphrase[storj.NonceSize:], masterKey, &nonce)
+	cipherText, err := encryption.EncryptSecretBox(plaintext, key, &nonce)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	encrypted := make([]byte, storj.NonceSize+len(cipherText))
+	copy(encrypted[:storj.NonceSize], nonce[:])
+	copy(encrypted[storj.NonceSize:], cipherText)
+
+	return encrypted, nil
+}
+
+// decrypt decrypts data created by encrypt with key.
+func decrypt(encrypted []byte, key *storj.Key) ([]byte, error) {
+	if len(encrypted) < storj.NonceSize {
+		return nil, Error.New("encrypted data too short")
+	}
+
+	var nonce storj.Nonce
+	copy(nonce[:], encrypted[:storj.NonceSize])
+
+	plaintext, err := encryption.DecryptSecretBox(encrypted[storj.NonceSize:], key, &nonce)
 	if err != nil {
 		return nil, Error.Wrap(err)
 	}
@@ -134,5 +258,8 @@ func (s *Service) DecryptPassphrase(ctx context.Context, encryptedPassphrase []b
 
 // Close closes the service.
 func (s *Service) Close() error {
+	if s.secretsService == nil {
+		return nil
+	}
 	return s.secretsService.Close()
 }
@@ -61,3 +61,60 @@ func TestService(t *testing.T) {
 	_, err = service.DecryptPassphrase(ctx, encryptedPassphrase)
 	require.Error(t, err)
 }
+
+func TestService_Escrow(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	escrowKeys := []string{"escrow-key-1", "escrow-key-2", "escrow-key-3"}
+	newService := func(threshold int, keys ...string) *kms.Service {
+		service := kms.NewService(kms.Config{
+			TestMasterKey:   "test-master-key",
+			TestEscrowKeys:  keys,
+			EscrowThreshold: threshold,
+		})
+		require.NoError(t, service.Initialize(ctx))
+		return service
+	}
+
+	service := newService(2, escrowKeys...)
+	require.True(t, service.EscrowEnabled())
+
+	encryptedPassphrase, err := service.GenerateEncryptedPassphrase(ctx)
+	require.NoError(t, err)
+	passphrase, err := service.DecryptPassphrase(ctx, encryptedPassphrase)
+	require.NoError(t, err)
+
+	envelope, err := service.SealEscrow(ctx, passphrase)
+	require.NoError(t, err)
+	require.NotContains(t, string(envelope), string(passphrase))
+
+	recovered, err := service.OpenEscrow(ctx, envelope)
+	require.NoError(t, err)
+	require.Equal(t, passphrase, recovered)
+
+	// the envelope can be opened as long as the threshold of keys is left.
+	recovered, err = newService(2, "escrow-key-1", "rotated-key", "escrow-key-3").OpenEscrow(ctx, envelope)
+	require.NoError(t, err)
+	require.Equal(t, passphrase, recovered)
+
+	_, err = newService(2, "escrow-key-1", "rotated-key-2", "rotated-key-3").OpenEscrow(ctx, envelope)
+	require.Error(t, err)
+
+	_, err = service.OpenEscrow(ctx, []byte("malformed"))
+	require.Error(t, err)
+
+	// escrow is disabled without a threshold.
+	disabled := newService(0, escrowKeys...)
+	require.False(t, disabled.EscrowEnabled())
+	_, err = disabled.SealEscrow(ctx, passphrase)
+	require.Error(t, err)
+
+	// the threshold can't be larger than the number of keys.
+	invalid := kms.NewService(kms.Config{
+		TestMasterKey:   "test-master-key",
+		TestEscrowKeys:  escrowKeys,
+		EscrowThreshold: 4,
+	})
+	require.Error(t, invalid.Initialize(ctx))
+	require.NoError(t, invalid.Close())
+}
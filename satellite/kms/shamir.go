@@ -0,0 +1,121 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package kms
+
+import (
+	"crypto/rand"
+)
+
+// gfExp and gfLog are the exponent and logarithm tables of GF(2^8) with the
+// AES polynomial, using 3 as the generator. gfExp is doubled, so that the sum
+// of two logarithms can be used as an index without a modulo.
+var gfExp, gfLog = func() (exp [510]byte, log [256]byte) {
+	x := byte(1)
+	for i := 0; i < 255; i++ {
+		exp[i] = x
+		log[x] = byte(i)
+
+		// multiply x by the generator 3, i.e. x*2 + x.
+		double := x << 1
+		if x&0x80 != 0 {
+			double ^= 0x1b
+		}
+		x ^= double
+	}
+	copy(exp[255:], exp[:255])
+	return exp, log
+}()
+
+func gfMul(a, b byte) byte {
+	if a == 0 || b == 0 {
+		return 0
+	}
+	return gfExp[int(gfLog[a])+int(gfLog[b])]
+}
+
+func gfDiv(a, b byte) byte {
+	if a == 0 {
+		return 0
+	}
+	return gfExp[int(gfLog[a])+255-int(gfLog[b])]
+}
+
+// splitSecret splits secret into n shares using Shamir's secret sharing,
+// such that any threshold of them recover the secret with combineShares and
+// fewer reveal nothing about it. Every share starts with its x coordinate,
+// followed by one y coordinate per byte of the secret.
+func splitSecret(secret []byte, n, threshold int) ([][]byte, error) {
+	switch {
+	case len(secret) == 0:
+		return nil, Error.New("empty secret")
+	case threshold < 1 || threshold > n:
+		return nil, Error.New("invalid threshold %d for %d shares", threshold, n)
+	case n > 255:
+		return nil, Error.New("too many shares: %d", n)
+	}
+
+	shares := make([][]byte, n)
+	for i := range shares {
+		shares[i] = make([]byte, 1+len(secret))
+		shares[i][0] = byte(i + 1)
+	}
+
+	// coefficients of the random polynomial, the constant term is the secret byte.
+	coefficients := make([]byte, threshold)
+	for pos, b := range secret {
+		coefficients[0] = b
+		if _, err := rand.Read(coefficients[1:]); err != nil {
+			return nil, Error.Wrap(err)
+		}
+
+		for _, share := range shares {
+			x := share[0]
+			var y byte
+			for i := threshold - 1; i >= 0; i-- {
+				y = gfMul(y, x) ^ coefficients[i]
+			}
+			share[1+pos] = y
+		}
+	}
+
+	return shares, nil
+}
+
+// combineShares recovers the secret from shares created by splitSecret. The
+// result is only correct when at least the threshold of shares is provided.
+func combineShares(shares [][]byte) ([]byte, error) {
+	if len(shares) == 0 {
+		return nil, Error.New("no shares")
+	}
+
+	size := len(shares[0])
+	seen := map[byte]bool{}
+	for _, share := range shares {
+		switch {
+		case len(share) < 2 || len(share) != size:
+			return nil, Error.New("invalid share length")
+		case share[0] == 0 || seen[share[0]]:
+			return nil, Error.New("invalid share index %d", share[0])
+		}
+		seen[share[0]] = true
+	}
+
+	// evaluate the Lagrange interpolation of the shares at x = 0.
+	secret := make([]byte, size-1)
+	for i, share := range shares {
+		basis := byte(1)
+		for j, other := range shares {
+			if i == j {
+				continue
+			}
+			basis = gfMul(basis, gfDiv(other[0], other[0]^share[0]))
+		}
+
+		for pos, y := range share[1:] {
+			secret[pos] ^= gfMul(y, basis)
+		}
+	}
+
+	return secret, nil
+}
@@ -0,0 +1,65 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package limitschedule
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/common/sync2"
+)
+
+// Config contains configurable values for applying scheduled limit changes.
+type Config struct {
+	Interval  time.Duration `help:"how often to apply the scheduled project limit changes which became effective" releaseDefault:"5m" devDefault:"10s"`
+	BatchSize int           `help:"how many limit changes are applied in a single iteration" default:"100"`
+}
+
+// Chore applies the scheduled limit changes once they become effective.
+//
+// architecture: Chore
+type Chore struct {
+	log     *zap.Logger
+	service *Service
+	config  Config
+
+	Loop *sync2.Cycle
+}
+
+// NewChore creates a new instance of the limit schedule chore.
+func NewChore(log *zap.Logger, service *Service, config Config) *Chore {
+	return &Chore{
+		log:     log,
+		service: service,
+		config:  config,
+
+		Loop: sync2.NewCycle(config.Interval),
+	}
+}
+
+// Run starts applying the due limit changes.
+func (chore *Chore) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return chore.Loop.Run(ctx, func(ctx context.Context) error {
+		for {
+			applied, err := chore.service.ApplyDue(ctx, chore.config.BatchSize)
+			if err != nil {
+				chore.log.Error("applying scheduled limit changes failed", zap.Error(err))
+				return nil
+			}
+			if applied < chore.config.BatchSize {
+				return nil
+			}
+		}
+	})
+}
+
+// Close stops the limit schedule chore.
+func (chore *Chore) Close() error {
+	chore.Loop.Close()
+	return nil
+}
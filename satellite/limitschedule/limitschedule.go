@@ -0,0 +1,102 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package limitschedule implements project limit changes which are scheduled
+// to take effect at a future time, e.g. at the start or renewal of a contract.
+package limitschedule
+
+import (
+	"context"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+
+	"storj.io/common/memory"
+	"storj.io/common/uuid"
+)
+
+var (
+	mon = monkit.Package()
+
+	// Error is the default error class for the limit schedule package.
+	Error = errs.Class("limit schedule")
+
+	// ErrNotFound is returned when a pending limit change does not exist.
+	ErrNotFound = errs.Class("limit change not found")
+
+	// ErrValidation is returned when a limit change has invalid fields.
+	ErrValidation = errs.Class("limit change validation")
+)
+
+// Limits are the project limits set by a change. Limits which are nil are
+// left unchanged. A negative rate, burst or bucket limit resets the limit to
+// the satellite default, the same way as updating the limits right away.
+type Limits struct {
+	Usage     *memory.Size
+	Bandwidth *memory.Size
+	Segments  *int64
+	Rate      *int
+	Burst     *int
+	Buckets   *int
+}
+
+// IsEmpty returns whether no limit is changed.
+func (limits Limits) IsEmpty() bool {
+	return limits.Usage == nil && limits.Bandwidth == nil && limits.Segments == nil &&
+		limits.Rate == nil && limits.Burst == nil && limits.Buckets == nil
+}
+
+// Validate checks whether the limits can be applied.
+func (limits Limits) Validate() error {
+	switch {
+	case limits.IsEmpty():
+		return ErrValidation.New("no limit is changed")
+	case limits.Usage != nil && *limits.Usage < 0:
+		return ErrValidation.New("negative usage limit")
+	case limits.Bandwidth != nil && *limits.Bandwidth < 0:
+		return ErrValidation.New("negative bandwidth limit")
+	case limits.Segments != nil && *limits.Segments < 0:
+		return ErrValidation.New("negative segment limit")
+	}
+	return nil
+}
+
+// Change is a change of project limits which takes effect at EffectiveAt.
+type Change struct {
+	ID          uuid.UUID
+	ProjectID   uuid.UUID
+	EffectiveAt time.Time
+	Limits      Limits
+	CreatedBy   string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	// AppliedAt is set once the limits were applied to the project.
+	AppliedAt *time.Time
+}
+
+// Pending returns whether the change wasn't applied yet.
+func (change Change) Pending() bool {
+	return change.AppliedAt == nil
+}
+
+// DB is the interface for storing scheduled limit changes.
+//
+// architecture: Database
+type DB interface {
+	// Insert inserts a new limit change.
+	Insert(ctx context.Context, change Change) (Change, error)
+	// Get returns the limit change with the specified ID.
+	Get(ctx context.Context, id uuid.UUID) (Change, error)
+	// Update updates the effective time and the limits of a pending limit change.
+	Update(ctx context.Context, change Change) (Change, error)
+	// Delete deletes the pending limit change with the specified ID.
+	Delete(ctx context.Context, id uuid.UUID) error
+	// ListByProject returns the limit changes of a project, ordered by effective time.
+	ListByProject(ctx context.Context, projectID uuid.UUID) ([]Change, error)
+	// ListDue returns at most limit pending changes which are effective at the specified time, ordered by effective time.
+	ListDue(ctx context.Context, now time.Time, limit int) ([]Change, error)
+	// Apply sets the limits of a pending limit change on its project and
+	// marks the change as applied, in a single transaction.
+	Apply(ctx context.Context, change Change, appliedAt time.Time) error
+}
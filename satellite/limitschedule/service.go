@@ -0,0 +1,209 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package limitschedule
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/common/uuid"
+)
+
+// Service schedules, edits and applies project limit changes. Every change
+// made by an operator is written to the audit log.
+//
+// architecture: Service
+type Service struct {
+	log      *zap.Logger
+	auditLog *zap.Logger
+	db       DB
+
+	nowFn func() time.Time
+}
+
+// NewService creates a new limit schedule service.
+func NewService(log *zap.Logger, db DB) *Service {
+	return &Service{
+		log:      log,
+		auditLog: log.Named("auditlog"),
+		db:       db,
+
+		nowFn: time.Now,
+	}
+}
+
+// TestingSetNow allows tests to have the service act as if the current time is whatever they want.
+func (service *Service) TestingSetNow(nowFn func() time.Time) {
+	service.nowFn = nowFn
+}
+
+// Schedule schedules a change of the project limits.
+func (service *Service) Schedule(ctx context.Context, operator string, projectID uuid.UUID, effectiveAt time.Time, limits Limits) (_ Change, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := service.validate(effectiveAt, limits); err != nil {
+		return Change{}, err
+	}
+
+	id, err := uuid.New()
+	if err != nil {
+		return Change{}, Error.Wrap(err)
+	}
+
+	change, err := service.db.Insert(ctx, Change{
+		ID:          id,
+		ProjectID:   projectID,
+		EffectiveAt: effectiveAt,
+		Limits:      limits,
+		CreatedBy:   operator,
+	})
+	if err != nil {
+		return Change{}, Error.Wrap(err)
+	}
+
+	service.audit(operator, "schedule limit change", change)
+	return change, nil
+}
+
+// Get returns the limit change with the specified ID.
+func (service *Service) Get(ctx context.Context, id uuid.UUID) (_ Change, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	change, err := service.db.Get(ctx, id)
+	if err != nil {
+		return Change{}, Error.Wrap(err)
+	}
+	return change, nil
+}
+
+// List returns the applied and pending limit changes of a project.
+func (service *Service) List(ctx context.Context, projectID uuid.UUID) (_ []Change, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	changes, err := service.db.ListByProject(ctx, projectID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return changes, nil
+}
+
+// Edit changes the effective time and the limits of a pending limit change.
+func (service *Service) Edit(ctx context.Context, operator string, id uuid.UUID, effectiveAt time.Time, limits Limits) (_ Change, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := service.validate(effectiveAt, limits); err != nil {
+		return Change{}, err
+	}
+
+	change, err := service.db.Update(ctx, Change{
+		ID:          id,
+		EffectiveAt: effectiveAt,
+		Limits:      limits,
+	})
+	if err != nil {
+		return Change{}, Error.Wrap(err)
+	}
+
+	service.audit(operator, "edit limit change", change)
+	return change, nil
+}
+
+// Cancel deletes a pending limit change.
+func (service *Service) Cancel(ctx context.Context, operator string, id uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	change, err := service.db.Get(ctx, id)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	if err := service.db.Delete(ctx, id); err != nil {
+		return Error.Wrap(err)
+	}
+
+	service.audit(operator, "cancel limit change", change)
+	return nil
+}
+
+// ApplyDue applies at most limit pending changes which are effective now,
+// and returns how many of them were applied. A change failing to apply is
+// logged and retried on the next call.
+func (service *Service) ApplyDue(ctx context.Context, limit int) (applied int, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	now := service.nowFn()
+
+	changes, err := service.db.ListDue(ctx, now, limit)
+	if err != nil {
+		return 0, Error.Wrap(err)
+	}
+
+	for _, change := range changes {
+		if err := service.apply(ctx, change, now); err != nil {
+			mon.Counter("limit_change_apply_failures").Inc(1)
+			service.log.Error("applying scheduled limit change failed",
+				zap.Stringer("Change ID", change.ID),
+				zap.Stringer("Project ID", change.ProjectID),
+				zap.Error(err))
+			continue
+		}
+		applied++
+	}
+
+	mon.IntVal("limit_changes_applied").Observe(int64(applied))
+	return applied, nil
+}
+
+func (service *Service) apply(ctx context.Context, change Change, now time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	// the limits and the applied mark are stored together, so a failure
+	// never leaves a change half applied or applied twice.
+	if err := service.db.Apply(ctx, change, now); err != nil {
+		return Error.Wrap(err)
+	}
+
+	change.AppliedAt = &now
+	service.audit("limit-schedule-chore", "apply limit change", change)
+	return nil
+}
+
+func (service *Service) validate(effectiveAt time.Time, limits Limits) error {
+	if !effectiveAt.After(service.nowFn()) {
+		return ErrValidation.New("effective time must be in the future")
+	}
+	return limits.Validate()
+}
+
+func (service *Service) audit(operator, operation string, change Change) {
+	fields := []zap.Field{
+		zap.String("operation", operation),
+		zap.String("operator", operator),
+		zap.Stringer("change", change.ID),
+		zap.Stringer("project", change.ProjectID),
+		zap.Time("effectiveAt", change.EffectiveAt),
+	}
+	limits := change.Limits
+	if limits.Usage != nil {
+		fields = append(fields, zap.Int64("usage", limits.Usage.Int64()))
+	}
+	if limits.Bandwidth != nil {
+		fields = append(fields, zap.Int64("bandwidth", limits.Bandwidth.Int64()))
+	}
+	if limits.Segments != nil {
+		fields = append(fields, zap.Int64("segments", *limits.Segments))
+	}
+	if limits.Rate != nil {
+		fields = append(fields, zap.Int("rate", *limits.Rate))
+	}
+	if limits.Burst != nil {
+		fields = append(fields, zap.Int("burst", *limits.Burst))
+	}
+	if limits.Buckets != nil {
+		fields = append(fields, zap.Int("buckets", *limits.Buckets))
+	}
+	service.auditLog.Info("project limit change activity", fields...)
+}
@@ -0,0 +1,155 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package limitschedule_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/memory"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/limitschedule"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestService(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		service := limitschedule.NewService(zaptest.NewLogger(t), db.ProjectLimitChanges())
+
+		now := time.Now().Truncate(time.Second)
+		service.TestingSetNow(func() time.Time { return now })
+
+		project, err := db.Console().Projects().Insert(ctx, &console.Project{ID: testrand.UUID(), Name: "limits"})
+		require.NoError(t, err)
+
+		usage := 10 * memory.TB
+		segments := int64(1000)
+		buckets := 50
+
+		_, err = service.Schedule(ctx, "admin@storj.test", project.ID, now.Add(-time.Hour), limitschedule.Limits{Usage: &usage})
+		require.True(t, limitschedule.ErrValidation.Has(err), err)
+
+		_, err = service.Schedule(ctx, "admin@storj.test", project.ID, now.Add(time.Hour), limitschedule.Limits{})
+		require.True(t, limitschedule.ErrValidation.Has(err), err)
+
+		increase, err := service.Schedule(ctx, "admin@storj.test", project.ID, now.Add(time.Hour), limitschedule.Limits{Usage: &usage})
+		require.NoError(t, err)
+		require.True(t, increase.Pending())
+
+		canceled, err := service.Schedule(ctx, "admin@storj.test", project.ID, now.Add(2*time.Hour), limitschedule.Limits{Buckets: &buckets})
+		require.NoError(t, err)
+
+		increase, err = service.Edit(ctx, "admin@storj.test", increase.ID, now.Add(time.Hour), limitschedule.Limits{Usage: &usage, Segments: &segments})
+		require.NoError(t, err)
+		require.Equal(t, &segments, increase.Limits.Segments)
+
+		require.NoError(t, service.Cancel(ctx, "admin@storj.test", canceled.ID))
+
+		changes, err := service.List(ctx, project.ID)
+		require.NoError(t, err)
+		require.Len(t, changes, 1)
+		require.Equal(t, increase.ID, changes[0].ID)
+
+		applied, err := service.ApplyDue(ctx, 10)
+		require.NoError(t, err)
+		require.Zero(t, applied)
+
+		now = now.Add(3 * time.Hour)
+		applied, err = service.ApplyDue(ctx, 10)
+		require.NoError(t, err)
+		require.Equal(t, 1, applied)
+
+		storageLimit, err := db.ProjectAccounting().GetProjectStorageLimit(ctx, project.ID)
+		require.NoError(t, err)
+		require.NotNil(t, storageLimit)
+		require.Equal(t, usage.Int64(), *storageLimit)
+
+		segmentLimit, err := db.ProjectAccounting().GetProjectSegmentLimit(ctx, project.ID)
+		require.NoError(t, err)
+		require.NotNil(t, segmentLimit)
+		require.Equal(t, segments, *segmentLimit)
+
+		got, err := service.Get(ctx, increase.ID)
+		require.NoError(t, err)
+		require.False(t, got.Pending())
+
+		_, err = service.Edit(ctx, "admin@storj.test", increase.ID, now.Add(time.Hour), limitschedule.Limits{Usage: &usage})
+		require.True(t, limitschedule.ErrNotFound.Has(err), err)
+
+		err = service.Cancel(ctx, "admin@storj.test", increase.ID)
+		require.True(t, limitschedule.ErrNotFound.Has(err), err)
+
+		applied, err = service.ApplyDue(ctx, 10)
+		require.NoError(t, err)
+		require.Zero(t, applied)
+	})
+}
+
+func TestService_ApplyFailure(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		service := limitschedule.NewService(zaptest.NewLogger(t), db.ProjectLimitChanges())
+
+		now := time.Now().Truncate(time.Second)
+		service.TestingSetNow(func() time.Time { return now })
+
+		project, err := db.Console().Projects().Insert(ctx, &console.Project{ID: testrand.UUID(), Name: "limits"})
+		require.NoError(t, err)
+
+		usage := 10 * memory.TB
+		rate := 100
+		missing, err := service.Schedule(ctx, "admin@storj.test", testrand.UUID(), now.Add(time.Hour), limitschedule.Limits{Usage: &usage})
+		require.NoError(t, err)
+		change, err := service.Schedule(ctx, "admin@storj.test", project.ID, now.Add(2*time.Hour), limitschedule.Limits{Usage: &usage, Rate: &rate})
+		require.NoError(t, err)
+
+		// a change failing to apply is kept pending and doesn't block the others.
+		now = now.Add(3 * time.Hour)
+		applied, err := service.ApplyDue(ctx, 10)
+		require.NoError(t, err)
+		require.Equal(t, 1, applied)
+
+		got, err := service.Get(ctx, missing.ID)
+		require.NoError(t, err)
+		require.True(t, got.Pending())
+
+		got, err = service.Get(ctx, change.ID)
+		require.NoError(t, err)
+		require.False(t, got.Pending())
+
+		updated, err := db.Console().Projects().Get(ctx, project.ID)
+		require.NoError(t, err)
+		require.Equal(t, &rate, updated.RateLimit)
+
+		// applying a change again fails without touching the project limits.
+		require.NoError(t, db.Console().Projects().UpdateRateLimit(ctx, project.ID, nil))
+
+		err = db.ProjectLimitChanges().Apply(ctx, change, now)
+		require.True(t, limitschedule.ErrNotFound.Has(err), err)
+
+		updated, err = db.Console().Projects().Get(ctx, project.ID)
+		require.NoError(t, err)
+		require.Nil(t, updated.RateLimit)
+
+		// a negative limit resets the project to the satellite default.
+		reset := -1
+		_, err = service.Schedule(ctx, "admin@storj.test", project.ID, now.Add(time.Hour), limitschedule.Limits{Rate: &reset})
+		require.NoError(t, err)
+		require.NoError(t, db.Console().Projects().UpdateRateLimit(ctx, project.ID, &rate))
+
+		now = now.Add(2 * time.Hour)
+		applied, err = service.ApplyDue(ctx, 10)
+		require.NoError(t, err)
+		require.Equal(t, 1, applied)
+
+		updated, err = db.Console().Projects().Get(ctx, project.ID)
+		require.NoError(t, err)
+		require.Nil(t, updated.RateLimit)
+	})
+}
@@ -0,0 +1,131 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package maintenance implements scheduled maintenance announcements.
+package maintenance
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+
+	"storj.io/common/uuid"
+)
+
+var (
+	mon = monkit.Package()
+
+	// Error is the default error class for the maintenance package.
+	Error = errs.Class("maintenance")
+
+	// ErrNotFound is returned when a maintenance window does not exist.
+	ErrNotFound = errs.Class("maintenance window not found")
+
+	// ErrValidation is returned when a maintenance window has invalid fields.
+	ErrValidation = errs.Class("maintenance window validation")
+)
+
+// Components is a set of satellite components affected by a maintenance window.
+type Components int
+
+const (
+	// ComponentConsole indicates that the web console is affected.
+	ComponentConsole Components = 1 << iota
+	// ComponentStoragenode indicates that storage node facing services are affected.
+	ComponentStoragenode
+	// ComponentUplink indicates that uplink facing services (uploads, downloads, listing) are affected.
+	ComponentUplink
+
+	// ComponentAll is the set of all known components.
+	ComponentAll = ComponentConsole | ComponentStoragenode | ComponentUplink
+)
+
+var componentNames = map[Components]string{
+	ComponentConsole:     "console",
+	ComponentStoragenode: "storagenode",
+	ComponentUplink:      "uplink",
+}
+
+// Has returns whether c contains all of the components in other.
+func (c Components) Has(other Components) bool {
+	return other != 0 && c&other == other
+}
+
+// Names returns the names of the components in the set.
+func (c Components) Names() []string {
+	names := []string{}
+	for component, name := range componentNames {
+		if c.Has(component) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// String implements fmt.Stringer.
+func (c Components) String() string {
+	return strings.Join(c.Names(), ",")
+}
+
+// ParseComponents parses a list of component names.
+func ParseComponents(names []string) (c Components, err error) {
+	for _, name := range names {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" {
+			continue
+		}
+
+		found := false
+		for component, componentName := range componentNames {
+			if name == componentName {
+				c |= component
+				found = true
+				break
+			}
+		}
+		if !found {
+			return 0, ErrValidation.New("unknown component %q", name)
+		}
+	}
+	return c, nil
+}
+
+// Window is a scheduled maintenance window.
+type Window struct {
+	ID         uuid.UUID
+	StartsAt   time.Time
+	EndsAt     time.Time
+	Components Components
+	Message    string
+	CreatedBy  string
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// ActiveAt returns whether the window is in progress at the specified time.
+func (w Window) ActiveAt(now time.Time) bool {
+	return !now.Before(w.StartsAt) && now.Before(w.EndsAt)
+}
+
+// DB is the interface for storing maintenance windows.
+//
+// architecture: Database
+type DB interface {
+	// Insert inserts a new maintenance window.
+	Insert(ctx context.Context, window Window) (Window, error)
+	// Get returns the maintenance window with the specified ID.
+	Get(ctx context.Context, id uuid.UUID) (Window, error)
+	// Update updates the schedule, components and message of a maintenance window.
+	Update(ctx context.Context, window Window) (Window, error)
+	// Delete deletes the maintenance window with the specified ID.
+	Delete(ctx context.Context, id uuid.UUID) error
+	// List returns maintenance windows which end after the specified time, ordered by start time.
+	List(ctx context.Context, endsAfter time.Time) ([]Window, error)
+	// ListOverlapping returns maintenance windows overlapping the [from, to) interval, affecting any of the components.
+	ListOverlapping(ctx context.Context, from, to time.Time, components Components) ([]Window, error)
+}
@@ -0,0 +1,39 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package maintenance_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/satellite/maintenance"
+)
+
+func TestParseComponents(t *testing.T) {
+	components, err := maintenance.ParseComponents([]string{"Console", " uplink ", ""})
+	require.NoError(t, err)
+	require.Equal(t, maintenance.ComponentConsole|maintenance.ComponentUplink, components)
+	require.Equal(t, []string{"console", "uplink"}, components.Names())
+	require.Equal(t, "console,uplink", components.String())
+	require.True(t, components.Has(maintenance.ComponentConsole))
+	require.False(t, components.Has(maintenance.ComponentStoragenode))
+
+	_, err = maintenance.ParseComponents([]string{"console", "unknown"})
+	require.True(t, maintenance.ErrValidation.Has(err))
+}
+
+func TestWindowActiveAt(t *testing.T) {
+	now := time.Now()
+	window := maintenance.Window{
+		StartsAt: now,
+		EndsAt:   now.Add(time.Hour),
+	}
+
+	require.False(t, window.ActiveAt(now.Add(-time.Second)))
+	require.True(t, window.ActiveAt(now))
+	require.True(t, window.ActiveAt(now.Add(time.Minute)))
+	require.False(t, window.ActiveAt(now.Add(time.Hour)))
+}
@@ -0,0 +1,184 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package maintenance
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/shared/lrucache"
+)
+
+// MaxMessageLength is the maximum length of a maintenance window message.
+const MaxMessageLength = 1000
+
+// Config contains configurable values for maintenance announcements.
+type Config struct {
+	CacheExpiration time.Duration `help:"how long active maintenance windows are cached before querying the database again" default:"1m"`
+}
+
+// Service handles maintenance window management and lookups.
+//
+// architecture: Service
+type Service struct {
+	log    *zap.Logger
+	db     DB
+	active *lrucache.ExpiringLRUOf[[]Window]
+
+	cacheExpiration time.Duration
+
+	nowFn func() time.Time
+}
+
+// NewService creates a new maintenance window service.
+func NewService(log *zap.Logger, db DB, config Config) *Service {
+	return &Service{
+		log: log,
+		db:  db,
+		active: lrucache.NewOf[[]Window](lrucache.Options{
+			Expiration: config.CacheExpiration,
+			Capacity:   int(ComponentAll) + 1,
+			Name:       "maintenance-active-windows",
+		}),
+		cacheExpiration: config.CacheExpiration,
+		nowFn:           time.Now,
+	}
+}
+
+// TestSetNow allows tests to have the service act as if the current time is whatever they want.
+func (service *Service) TestSetNow(nowFn func() time.Time) {
+	service.nowFn = nowFn
+}
+
+// Create validates and stores a new maintenance window.
+func (service *Service) Create(ctx context.Context, window Window) (_ Window, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	window.Message = strings.TrimSpace(window.Message)
+	if err := validate(window); err != nil {
+		return Window{}, err
+	}
+
+	window.ID, err = uuid.New()
+	if err != nil {
+		return Window{}, Error.Wrap(err)
+	}
+
+	window, err = service.db.Insert(ctx, window)
+	if err != nil {
+		return Window{}, Error.Wrap(err)
+	}
+
+	service.log.Info("maintenance window scheduled",
+		zap.Stringer("ID", window.ID),
+		zap.Time("Starts At", window.StartsAt),
+		zap.Time("Ends At", window.EndsAt),
+		zap.Stringer("Components", window.Components),
+		zap.String("Created By", window.CreatedBy))
+
+	return window, nil
+}
+
+// Update validates and updates an existing maintenance window.
+func (service *Service) Update(ctx context.Context, window Window) (_ Window, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	window.Message = strings.TrimSpace(window.Message)
+	if err := validate(window); err != nil {
+		return Window{}, err
+	}
+
+	window, err = service.db.Update(ctx, window)
+	if err != nil {
+		if ErrNotFound.Has(err) {
+			return Window{}, err
+		}
+		return Window{}, Error.Wrap(err)
+	}
+
+	return window, nil
+}
+
+// Get returns a maintenance window by ID.
+func (service *Service) Get(ctx context.Context, id uuid.UUID) (_ Window, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	window, err := service.db.Get(ctx, id)
+	if err != nil {
+		if ErrNotFound.Has(err) {
+			return Window{}, err
+		}
+		return Window{}, Error.Wrap(err)
+	}
+	return window, nil
+}
+
+// Delete removes a maintenance window.
+func (service *Service) Delete(ctx context.Context, id uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = service.db.Delete(ctx, id)
+	if err != nil {
+		if ErrNotFound.Has(err) {
+			return err
+		}
+		return Error.Wrap(err)
+	}
+	return nil
+}
+
+// ListUpcoming returns maintenance windows which are either in progress or scheduled.
+func (service *Service) ListUpcoming(ctx context.Context) (_ []Window, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	windows, err := service.db.List(ctx, service.nowFn())
+	return windows, Error.Wrap(err)
+}
+
+// Active returns maintenance windows in progress which affect any of the specified components.
+// Results are cached for a short period, since this is called on hot paths.
+func (service *Service) Active(ctx context.Context, components Components) (_ []Window, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	now := service.nowFn()
+	windows, err := service.active.Get(ctx, strconv.Itoa(int(components)), func() ([]Window, error) {
+		// include windows starting before the cached entry expires.
+		return service.db.ListOverlapping(ctx, now, now.Add(service.cacheExpiration), components)
+	})
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	// cached entries may contain windows which have not started or have ended in the meantime.
+	active := make([]Window, 0, len(windows))
+	for _, window := range windows {
+		if window.ActiveAt(now) {
+			active = append(active, window)
+		}
+	}
+	return active, nil
+}
+
+func validate(window Window) error {
+	switch {
+	case window.StartsAt.IsZero() || window.EndsAt.IsZero():
+		return ErrValidation.New("start and end time must be set")
+	case !window.EndsAt.After(window.StartsAt):
+		return ErrValidation.New("end time must be after start time")
+	case window.Components == 0:
+		return ErrValidation.New("at least one affected component must be specified")
+	case window.Components&^ComponentAll != 0:
+		return ErrValidation.New("unknown components specified")
+	case window.Message == "":
+		return ErrValidation.New("message must not be empty")
+	case len(window.Message) > MaxMessageLength:
+		return ErrValidation.New("message must not be longer than %d characters", MaxMessageLength)
+	}
+	return nil
+}
@@ -0,0 +1,118 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package abortmultipart
+
+import (
+	"context"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/sync2"
+	"storj.io/storj/satellite/metabase"
+)
+
+var (
+	// Error defines the abortmultipart chore errors class.
+	Error = errs.Class("abort incomplete multipart chore")
+	mon   = monkit.Package()
+)
+
+// Config contains configurable values for aborting incomplete multipart uploads.
+type Config struct {
+	Enabled            bool          `help:"set if incomplete multipart uploads are aborted after max-age" default:"false"`
+	Interval           time.Duration `help:"the time between each attempt to go through the db and abort incomplete multipart uploads" releaseDefault:"24h" devDefault:"10s"`
+	MaxAge             time.Duration `help:"how long after it was started an incomplete multipart upload is aborted" default:"720h"`
+	ListLimit          int           `help:"how many pending objects to query in a batch" default:"100"`
+	AsOfSystemInterval time.Duration `help:"as of system interval" releaseDefault:"-5m" devDefault:"-1us" testDefault:"-1us"`
+}
+
+// Chore implements the incomplete multipart upload abort chore.
+//
+// architecture: Chore
+type Chore struct {
+	log      *zap.Logger
+	config   Config
+	metabase *metabase.DB
+
+	nowFn func() time.Time
+	Loop  *sync2.Cycle
+}
+
+// NewChore creates a new instance of the abortmultipart chore.
+func NewChore(log *zap.Logger, config Config, metabase *metabase.DB) *Chore {
+	return &Chore{
+		log:      log,
+		config:   config,
+		metabase: metabase,
+
+		nowFn: time.Now,
+		Loop:  sync2.NewCycle(config.Interval),
+	}
+}
+
+// Run starts the abortmultipart loop.
+func (chore *Chore) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if !chore.config.Enabled {
+		return nil
+	}
+
+	return chore.Loop.Run(ctx, func(ctx context.Context) error {
+		if err := chore.AbortIncomplete(ctx); err != nil {
+			chore.log.Error("aborting incomplete multipart uploads failed", zap.Error(err))
+		}
+		return nil
+	})
+}
+
+// Close stops the abortmultipart chore.
+func (chore *Chore) Close() error {
+	chore.Loop.Close()
+	return nil
+}
+
+// TestingSetNow allows tests to have the chore act as if the current time is whatever they want.
+func (chore *Chore) TestingSetNow(nowFn func() time.Time) {
+	chore.nowFn = nowFn
+}
+
+// AbortIncomplete deletes all pending objects which were started more than
+// MaxAge ago, together with their uploaded segments.
+func (chore *Chore) AbortIncomplete(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var aborted int64
+	err = chore.metabase.ListPendingObjects(ctx, metabase.ListPendingObjects{
+		CreatedBefore:      chore.nowFn().Add(-chore.config.MaxAge),
+		BatchSize:          chore.config.ListLimit,
+		AsOfSystemInterval: chore.config.AsOfSystemInterval,
+	}, func(ctx context.Context, objects []metabase.PendingObject) error {
+		for _, object := range objects {
+			// the listing may be stale, DeletePendingObject only deletes the
+			// object when it is still pending.
+			result, err := chore.metabase.DeletePendingObject(ctx, metabase.DeletePendingObject{
+				ObjectStream: object.ObjectStream,
+			})
+			if err != nil {
+				if metabase.ErrObjectNotFound.Has(err) {
+					continue
+				}
+				return Error.Wrap(err)
+			}
+
+			aborted += int64(len(result.Removed))
+		}
+		return nil
+	})
+
+	mon.Meter("incomplete_multipart_aborted").Mark64(aborted)
+	if aborted > 0 {
+		chore.log.Info("aborted incomplete multipart uploads", zap.Int64("Objects", aborted))
+	}
+	return Error.Wrap(err)
+}
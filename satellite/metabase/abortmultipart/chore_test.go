@@ -0,0 +1,63 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package abortmultipart_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/memory"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite/metabase"
+)
+
+func TestAbortIncomplete(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		upl := planet.Uplinks[0]
+		chore := sat.Core.AbortMultipart.Chore
+
+		require.NoError(t, upl.CreateBucket(ctx, sat, "testbucket"))
+		require.NoError(t, upl.Upload(ctx, sat, "testbucket", "committed", testrand.Bytes(memory.KiB)))
+
+		project, err := upl.OpenProject(ctx, sat)
+		require.NoError(t, err)
+		defer ctx.Check(project.Close)
+
+		info, err := project.BeginUpload(ctx, "testbucket", "incomplete", nil)
+		require.NoError(t, err)
+		part, err := project.UploadPart(ctx, "testbucket", "incomplete", info.UploadID, 1)
+		require.NoError(t, err)
+		_, err = part.Write(testrand.Bytes(memory.KiB))
+		require.NoError(t, err)
+		require.NoError(t, part.Commit())
+
+		// the upload is not old enough yet.
+		require.NoError(t, chore.AbortIncomplete(ctx))
+
+		objects, err := sat.Metabase.DB.TestingAllObjects(ctx)
+		require.NoError(t, err)
+		require.Len(t, objects, 2)
+
+		chore.TestingSetNow(func() time.Time {
+			return time.Now().Add(31 * 24 * time.Hour)
+		})
+		require.NoError(t, chore.AbortIncomplete(ctx))
+
+		objects, err = sat.Metabase.DB.TestingAllObjects(ctx)
+		require.NoError(t, err)
+		require.Len(t, objects, 1)
+		require.Equal(t, metabase.CommittedUnversioned, objects[0].Status)
+
+		segments, err := sat.Metabase.DB.TestingAllSegments(ctx)
+		require.NoError(t, err)
+		require.Len(t, segments, 1)
+	})
+}
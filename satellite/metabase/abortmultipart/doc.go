@@ -0,0 +1,12 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+/*
+Package abortmultipart contains the chore which aborts incomplete multipart uploads.
+
+Unlike the zombiedeletion chore, which only deletes uploads without recent
+activity, the abort chore deletes every pending object which was started more
+than the configured age ago, similar to the S3 AbortIncompleteMultipartUpload
+lifecycle rule.
+*/
+package abortmultipart
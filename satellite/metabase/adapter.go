@@ -5,6 +5,7 @@ package metabase
 
 import (
 	"context"
+	"time"
 
 	"github.com/storj/exp-spanner"
 	"go.uber.org/zap"
@@ -21,6 +22,7 @@ type Adapter interface {
 
 	BeginObjectNextVersion(context.Context, BeginObjectNextVersion, *Object) error
 	GetObjectLastCommitted(ctx context.Context, opts GetObjectLastCommitted, object *Object) error
+	GetObjectsLastCommitted(ctx context.Context, projectID uuid.UUID, bucketName string, objectKeys [][]byte) (objects []Object, err error)
 	IterateLoopSegments(ctx context.Context, aliasCache *NodeAliasCache, opts IterateLoopSegments, fn func(context.Context, LoopSegmentsIterator) error) error
 	PendingObjectExists(ctx context.Context, opts BeginSegment) (exists bool, err error)
 	CommitPendingObjectSegment(ctx context.Context, opts CommitSegment, aliasPieces AliasPieces) error
@@ -31,6 +33,8 @@ type Adapter interface {
 	BucketEmpty(ctx context.Context, opts BucketEmpty) (empty bool, err error)
 
 	WithTx(ctx context.Context, f func(context.Context, TransactionAdapter) error) error
+	withTxStats(ctx context.Context, operation string, f func(context.Context, TransactionAdapter) error) error
+	TransactionStats() []TransactionStats
 
 	GetSegmentByPosition(ctx context.Context, opts GetSegmentByPosition) (segment Segment, aliasPieces AliasPieces, err error)
 	GetObjectExactVersion(ctx context.Context, opts GetObjectExactVersion) (_ Object, err error)
@@ -48,20 +52,34 @@ type Adapter interface {
 	DeletePendingObject(ctx context.Context, opts DeletePendingObject) (result DeleteObjectResult, err error)
 	DeleteObjectsAllVersions(ctx context.Context, projectID uuid.UUID, bucketName string, objectKeys [][]byte) (result DeleteObjectResult, err error)
 	DeleteObjectLastCommittedPlain(ctx context.Context, opts DeleteObjectLastCommitted) (result DeleteObjectResult, err error)
-	DeleteObjectLastCommittedSuspended(ctx context.Context, opts DeleteObjectLastCommitted, deleterMarkerStreamID uuid.UUID) (result DeleteObjectResult, err error)
+	DeleteObjectLastCommittedSuspended(ctx context.Context, opts DeleteObjectLastCommitted, deleterMarkerStreamID uuid.UUID, trace *transactionTrace) (result DeleteObjectResult, err error)
 	DeleteObjectLastCommittedVersioned(ctx context.Context, opts DeleteObjectLastCommitted, deleterMarkerStreamID uuid.UUID) (result DeleteObjectResult, err error)
 
-	FindExpiredObjects(ctx context.Context, opts DeleteExpiredObjects, startAfter ObjectStream, batchSize int) (expiredObjects []ObjectStream, err error)
+	FindExpiredObjects(ctx context.Context, opts DeleteExpiredObjects, startAfter ExpiredObject, batchSize int) (expiredObjects []ExpiredObject, err error)
+	DeleteExpiredObjectsAndSegments(ctx context.Context, opts DeleteExpiredObjects, objects []ObjectStream) (objectsDeleted, segmentsDeleted int64, err error)
 	DeleteObjectsAndSegments(ctx context.Context, objects []ObjectStream) (objectsDeleted, segmentsDeleted int64, err error)
 	FindZombieObjects(ctx context.Context, opts DeleteZombieObjects, startAfter ObjectStream, batchSize int) (objects []ObjectStream, err error)
-	DeleteInactiveObjectsAndSegments(ctx context.Context, objects []ObjectStream, opts DeleteZombieObjects) (objectsDeleted, segmentsDeleted int64, err error)
+	FindNoncurrentVersions(ctx context.Context, opts PurgeNoncurrentVersions, now time.Time, startAfter ObjectStream, batchSize int) (versions []ObjectStream, err error)
+	DeleteInactiveObjectsAndSegments(ctx context.Context, objects []ObjectStream, opts DeleteZombieObjects) (objectsDeleted, segmentsDeleted, bytesDeleted int64, err error)
+	ListPendingObjects(ctx context.Context, opts ListPendingObjects, startAfter ObjectStream, batchSize int) (objects []PendingObject, err error)
+
+	deleteBucketObjectsBatch(ctx context.Context, opts DeleteBucketObjects, after deleteBucketObjectsCursor, end ObjectKey) (batch deletedBucketObjectsBatch, err error)
+	deleteOrphanedObjectTags(ctx context.Context, batchSize int) (deleted int64, err error)
+	listDeferredSegmentDeletions(ctx context.Context, limit int) (streams []deferredSegmentDeletion, err error)
+	deleteDeferredSegmentsBatch(ctx context.Context, streamID uuid.UUID, batchSize int) (deleted int64, done bool, err error)
+
+	ListObjectPins(ctx context.Context, opts ListObjectPins) (pins []ObjectPin, err error)
+	GetObjectLockReport(ctx context.Context, opts GetObjectLockReport) (report []ObjectLockBucketReport, err error)
 
 	EnsureNodeAliases(ctx context.Context, opts EnsureNodeAliases) error
 	ListNodeAliases(ctx context.Context) (_ []NodeAliasEntry, err error)
+	ResolveNodeAliases(ctx context.Context, aliases []NodeAlias) (_ []NodeAliasEntry, err error)
+	RetireNodeAliases(ctx context.Context, opts RetireNodeAliases) (retired int64, err error)
 
 	doNextQueryAllVersionsWithStatus(ctx context.Context, it *objectsIterator) (_ tagsql.Rows, err error)
 	doNextQueryAllVersionsWithStatusAscending(ctx context.Context, it *objectsIterator) (_ tagsql.Rows, err error)
 	doNextQueryPendingObjectsByKey(ctx context.Context, it *objectsIterator) (_ tagsql.Rows, err error)
+	doNextQueryListObjects(ctx context.Context, it *listObjectsIterator) (_ tagsql.Rows, err error)
 
 	TestingBatchInsertSegments(ctx context.Context, aliasCache *NodeAliasCache, segments []RawSegment) (err error)
 	TestingGetAllObjects(ctx context.Context) (_ []RawObject, err error)
@@ -75,6 +93,8 @@ type PostgresAdapter struct {
 	log  *zap.Logger
 	db   tagsql.DB
 	impl dbutil.Implementation
+
+	txStats transactionStats
 }
 
 // Name returns the name of the adapter.
@@ -103,6 +123,9 @@ type TransactionAdapter interface {
 	copyObjectTransactionAdapter
 	moveObjectTransactionAdapter
 	deleteTransactionAdapter
+	objectTagsTransactionAdapter
+	objectPinsTransactionAdapter
+	undeleteTransactionAdapter
 }
 
 type postgresTransactionAdapter struct {
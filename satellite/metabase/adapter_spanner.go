@@ -5,6 +5,7 @@ package metabase
 
 import (
 	"context"
+	"time"
 
 	"github.com/storj/exp-spanner"
 	"github.com/zeebo/errs"
@@ -14,31 +15,71 @@ import (
 // SpannerConfig includes all the configuration required by using spanner.
 type SpannerConfig struct {
 	Database string `help:"Database definition for spanner connection in the form  projects/P/instances/I/databases/DB"`
+	// MaxStaleness makes the listing and loop queries read with bounded staleness,
+	// which allows them to be served by read-only replicas.
+	MaxStaleness time.Duration
+
+	// readOnly is set for the read replica adapter, see spannerClientConfig.
+	readOnly bool
 }
 
 // SpannerAdapter implements Adapter for Google Spanner connections..
 type SpannerAdapter struct {
 	log    *zap.Logger
 	client *spanner.Client
+
+	maxStaleness time.Duration
+
+	txStats transactionStats
 }
 
 // NewSpannerAdapter creates a new Spanner adapter.
 func NewSpannerAdapter(ctx context.Context, cfg SpannerConfig, log *zap.Logger) (*SpannerAdapter, error) {
 	log = log.Named("spanner")
-	client, err := spanner.NewClientWithConfig(ctx, cfg.Database,
-		spanner.ClientConfig{
-			Logger:               zap.NewStdLog(log.Named("stdlog")),
-			SessionPoolConfig:    spanner.DefaultSessionPoolConfig,
-			DisableRouteToLeader: false})
+	client, err := spanner.NewClientWithConfig(ctx, cfg.Database, spannerClientConfig(cfg, log))
 	if err != nil {
 		return nil, errs.Wrap(err)
 	}
 	return &SpannerAdapter{
-		client: client,
-		log:    log,
+		client:       client,
+		log:          log,
+		maxStaleness: cfg.MaxStaleness,
 	}, nil
 }
 
+// spannerClientConfig returns the client configuration for the adapter.
+//
+// By default, the client routes read-write transactions and partitioned DML
+// to the leader region, which avoids an extra round trip for their reads.
+// The read replica adapter only runs stale single reads, which any replica can
+// serve, so leader routing is disabled for it to serve them from the nearest
+// replica. It must stay enabled for every other adapter, because they write.
+func spannerClientConfig(cfg SpannerConfig, log *zap.Logger) spanner.ClientConfig {
+	return spanner.ClientConfig{
+		Logger:               zap.NewStdLog(log.Named("stdlog")),
+		SessionPoolConfig:    spanner.DefaultSessionPoolConfig,
+		DisableRouteToLeader: cfg.readOnly,
+	}
+}
+
+// singleRead returns a single use read-only transaction for listing and loop
+// queries, which reads with bounded staleness when it is configured.
+func (s *SpannerAdapter) singleRead() *spanner.ReadOnlyTransaction {
+	if s.maxStaleness > 0 {
+		return s.client.Single().WithTimestampBound(spanner.MaxStaleness(s.maxStaleness))
+	}
+	return s.client.Single()
+}
+
+// singleReadAt returns a single use read-only transaction which reads at the
+// specified timestamp, or singleRead when the timestamp is zero.
+func (s *SpannerAdapter) singleReadAt(timestamp time.Time) *spanner.ReadOnlyTransaction {
+	if timestamp.IsZero() {
+		return s.singleRead()
+	}
+	return s.client.Single().WithTimestampBound(spanner.ReadTimestamp(timestamp))
+}
+
 // Close closes the internal client.
 func (s *SpannerAdapter) Close() error {
 	s.client.Close()
@@ -0,0 +1,31 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestSpannerClientConfig(t *testing.T) {
+	log := zaptest.NewLogger(t)
+
+	// the primary adapter writes, so it keeps routing to the leader, even
+	// when stale reads are configured.
+	primary := spannerClientConfig(SpannerConfig{
+		Database:     "projects/P/instances/I/databases/DB",
+		MaxStaleness: 10 * time.Second,
+	}, log)
+	require.False(t, primary.DisableRouteToLeader)
+
+	replica := spannerClientConfig(SpannerConfig{
+		Database:     "projects/P/instances/I/databases/DB",
+		MaxStaleness: 10 * time.Second,
+		readOnly:     true,
+	}, log)
+	require.True(t, replica.DisableRouteToLeader)
+}
@@ -46,11 +46,68 @@ CREATE TABLE IF NOT EXISTS
  object_key,
  version);
 
+CREATE INDEX IF NOT EXISTS objects_stream_id_index ON objects (stream_id);
+CREATE INDEX IF NOT EXISTS objects_expires_at_index ON objects (expires_at);
+
+CREATE TABLE IF NOT EXISTS
+    object_tags
+(
+    project_id  BYTES(MAX)  NOT NULL,
+    bucket_name STRING(MAX) NOT NULL,
+    object_key  BYTES(MAX)  NOT NULL,
+    version     INT64       NOT NULL,
+    tag_key     BYTES(MAX)  NOT NULL,
+    tag_value   BYTES(MAX)  NOT NULL,
+    ) PRIMARY KEY
+(project_id,
+ bucket_name,
+ object_key,
+ version,
+ tag_key),
+    INTERLEAVE IN PARENT objects ON DELETE CASCADE;
+
+CREATE TABLE IF NOT EXISTS
+    object_pins
+(
+    project_id  BYTES(MAX)  NOT NULL,
+    bucket_name STRING(MAX) NOT NULL,
+    object_key  BYTES(MAX)  NOT NULL,
+    version     INT64       NOT NULL,
+    pin_name    STRING(MAX) NOT NULL,
+    created_at  TIMESTAMP   NOT NULL,
+    ) PRIMARY KEY
+(project_id,
+ bucket_name,
+ object_key,
+ version,
+ pin_name);
+
+CREATE INDEX IF NOT EXISTS object_pins_pin_name_index ON object_pins (project_id, bucket_name, pin_name);
+
 CREATE TABLE IF NOT EXISTS
     node_aliases
 (
     node_id     BYTES(MAX) NOT NULL,
     node_alias  INT64      NOT NULL,
+    retired_at  TIMESTAMP,
     ) PRIMARY KEY
 (node_id);
-CREATE UNIQUE INDEX IF NOT EXISTS node_aliases_node_alias_key ON node_aliases(node_alias);
\ No newline at end of file
+CREATE UNIQUE INDEX IF NOT EXISTS node_aliases_node_alias_key ON node_aliases(node_alias);
+
+CREATE TABLE IF NOT EXISTS
+    project_quota_counters
+(
+    project_id    BYTES(MAX) NOT NULL,
+    object_count  INT64      NOT NULL,
+    segment_count INT64      NOT NULL,
+    recounted_at  TIMESTAMP,
+    ) PRIMARY KEY
+(project_id);
+CREATE TABLE IF NOT EXISTS
+    deferred_segment_deletions
+(
+    stream_id  BYTES(MAX) NOT NULL,
+    created_at TIMESTAMP  NOT NULL,
+    ) PRIMARY KEY
+(stream_id);
+CREATE INDEX IF NOT EXISTS deferred_segment_deletions_created_at_index ON deferred_segment_deletions (created_at);
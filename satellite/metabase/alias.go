@@ -18,6 +18,7 @@ import (
 	"storj.io/common/uuid"
 	"storj.io/storj/shared/dbutil/pgutil"
 	"storj.io/storj/shared/dbutil/spannerutil"
+	"storj.io/storj/shared/tagsql"
 )
 
 // NodeAlias is a metabase local alias for NodeID-s to reduce segment table size.
@@ -49,15 +50,21 @@ func (db *DB) EnsureNodeAliases(ctx context.Context, opts EnsureNodeAliases) (er
 func (p *PostgresAdapter) EnsureNodeAliases(ctx context.Context, opts EnsureNodeAliases) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	const tag = queryTag("ensure_node_aliases")
+	done := tag.observe(p.impl.String())
+	defer func() { done(-1, err) }()
+
 	unique, err := ensureNodesUniqueness(opts.Nodes)
 	if err != nil {
 		return err
 	}
 
+	// a retired alias is revived, so the node keeps its previous alias.
 	_, err = p.db.ExecContext(ctx, `
 		INSERT INTO node_aliases(node_id)
 		SELECT unnest($1::BYTEA[])
-		ON CONFLICT DO NOTHING
+		ON CONFLICT (node_id) DO UPDATE SET retired_at = NULL
+		WHERE node_aliases.retired_at IS NOT NULL
 	`, pgutil.NodeIDArray(unique))
 	return Error.Wrap(err)
 }
@@ -66,11 +73,36 @@ func (p *PostgresAdapter) EnsureNodeAliases(ctx context.Context, opts EnsureNode
 func (s *SpannerAdapter) EnsureNodeAliases(ctx context.Context, opts EnsureNodeAliases) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	const tag = queryTag("ensure_node_aliases")
+	done := tag.observe(s.Name())
+	defer func() { done(-1, err) }()
+
 	unique, err := ensureNodesUniqueness(opts.Nodes)
 	if err != nil {
 		return err
 	}
 
+	// a retired alias is revived, so the node keeps its previous alias.
+	nodeIDs := make([][]byte, len(unique))
+	for i, node := range unique {
+		nodeIDs[i] = node.Bytes()
+	}
+	_, err = s.client.ReadWriteTransaction(ctx, func(ctx context.Context, tx *spanner.ReadWriteTransaction) error {
+		_, err := tx.Update(ctx, spanner.Statement{
+			SQL: `
+				UPDATE node_aliases SET retired_at = NULL
+				WHERE node_id IN UNNEST(@node_ids) AND retired_at IS NOT NULL
+			`,
+			Params: map[string]interface{}{
+				"node_ids": nodeIDs,
+			},
+		})
+		return err
+	})
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
 	// TODO(spanner) this is not prod ready implementation
 	// TODO(spanner) limited alias value to avoid out of memory
 	maxAliasValue := int64(10000)
@@ -121,7 +153,7 @@ func ensureNodesUniqueness(nodes []storj.NodeID) ([]storj.NodeID, error) {
 	return unique, nil
 }
 
-// ListNodeAliases lists all node alias mappings.
+// ListNodeAliases lists all node alias mappings, except the retired ones.
 func (db *DB) ListNodeAliases(ctx context.Context) (_ []NodeAliasEntry, err error) {
 	defer mon.Task()(&ctx)(&err)
 
@@ -129,13 +161,17 @@ func (db *DB) ListNodeAliases(ctx context.Context) (_ []NodeAliasEntry, err erro
 }
 
 // ListNodeAliases implements Adapter.
-func (p *PostgresAdapter) ListNodeAliases(ctx context.Context) (_ []NodeAliasEntry, err error) {
+func (p *PostgresAdapter) ListNodeAliases(ctx context.Context) (aliases []NodeAliasEntry, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var aliases []NodeAliasEntry
+	const tag = queryTag("list_node_aliases")
+	done := tag.observe(p.impl.String())
+	defer func() { done(int64(len(aliases)), err) }()
+
 	rows, err := p.db.Query(ctx, `
 		SELECT node_id, node_alias
 		FROM node_aliases
+		WHERE retired_at IS NULL
 	`)
 	if err != nil {
 		return nil, Error.New("ListNodeAliases query: %w", err)
@@ -161,16 +197,174 @@ func (p *PostgresAdapter) ListNodeAliases(ctx context.Context) (_ []NodeAliasEnt
 func (s *SpannerAdapter) ListNodeAliases(ctx context.Context) (aliases []NodeAliasEntry, err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	const tag = queryTag("list_node_aliases")
+	done := tag.observe(s.Name())
+	defer func() { done(int64(len(aliases)), err) }()
+
 	return spannerutil.CollectRows(
 		s.client.Single().Query(ctx,
 			spanner.Statement{SQL: `
 				SELECT node_id, node_alias FROM node_aliases
+				WHERE retired_at IS NULL
 			`}),
 		func(row *spanner.Row, item *NodeAliasEntry) error {
 			return Error.Wrap(row.Columns(&item.ID, spannerutil.Int(&item.Alias)))
 		})
 }
 
+// ResolveNodeAliases returns the mappings of the specified aliases, including the retired ones.
+func (db *DB) ResolveNodeAliases(ctx context.Context, aliases []NodeAlias) (_ []NodeAliasEntry, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(aliases) == 0 {
+		return nil, nil
+	}
+	return db.ChooseAdapter(uuid.UUID{}).ResolveNodeAliases(ctx, aliases)
+}
+
+// ResolveNodeAliases implements Adapter.
+func (p *PostgresAdapter) ResolveNodeAliases(ctx context.Context, aliases []NodeAlias) (entries []NodeAliasEntry, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	const tag = queryTag("resolve_node_aliases")
+	done := tag.observe(p.impl.String())
+	defer func() { done(int64(len(entries)), err) }()
+
+	values := make([]int32, len(aliases))
+	for i, alias := range aliases {
+		values[i] = int32(alias)
+	}
+
+	err = withRows(p.db.QueryContext(ctx, `
+		SELECT node_id, node_alias
+		FROM node_aliases
+		WHERE node_alias = ANY($1::INT4[])
+	`, pgutil.Int4Array(values)))(func(rows tagsql.Rows) error {
+		for rows.Next() {
+			var entry NodeAliasEntry
+			if err := rows.Scan(&entry.ID, &entry.Alias); err != nil {
+				return err
+			}
+			entries = append(entries, entry)
+		}
+		return nil
+	})
+	return entries, Error.Wrap(err)
+}
+
+// ResolveNodeAliases implements Adapter.
+func (s *SpannerAdapter) ResolveNodeAliases(ctx context.Context, aliases []NodeAlias) (entries []NodeAliasEntry, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	const tag = queryTag("resolve_node_aliases")
+	done := tag.observe(s.Name())
+	defer func() { done(int64(len(entries)), err) }()
+
+	values := make([]int64, len(aliases))
+	for i, alias := range aliases {
+		values[i] = int64(alias)
+	}
+
+	return spannerutil.CollectRows(
+		s.client.Single().Query(ctx, spanner.Statement{
+			SQL: `
+				SELECT node_id, node_alias FROM node_aliases
+				WHERE node_alias IN UNNEST(@aliases)
+			`,
+			Params: map[string]interface{}{
+				"aliases": values,
+			},
+		}),
+		func(row *spanner.Row, item *NodeAliasEntry) error {
+			return Error.Wrap(row.Columns(&item.ID, spannerutil.Int(&item.Alias)))
+		})
+}
+
+// RetireNodeAliases contains arguments necessary for retiring NodeAlias-es.
+type RetireNodeAliases struct {
+	Aliases []NodeAlias
+}
+
+// RetireNodeAliases marks the given aliases as retired and rebuilds the alias cache
+// without them.
+//
+// The caller must ensure that no segment references the aliases and that the
+// nodes cannot receive new pieces, e.g. because they were disqualified or have
+// exited.
+//
+// Retired aliases are kept in the table as tombstones, so an alias value is never
+// reused, including on Spanner, which picks random alias values. Other processes
+// may still have a retired alias cached and may write it to a segment. NodeAliasCache
+// resolves such aliases with ResolveNodeAliases, and EnsureNodeAliases revives the
+// alias of a retired node, so the node keeps its previous alias.
+func (db *DB) RetireNodeAliases(ctx context.Context, opts RetireNodeAliases) (retired int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(opts.Aliases) == 0 {
+		return 0, nil
+	}
+
+	retired, err = db.ChooseAdapter(uuid.UUID{}).RetireNodeAliases(ctx, opts)
+	if err != nil {
+		return 0, err
+	}
+
+	return retired, db.aliasCache.rebuild(ctx)
+}
+
+// RetireNodeAliases implements Adapter.
+func (p *PostgresAdapter) RetireNodeAliases(ctx context.Context, opts RetireNodeAliases) (retired int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	const tag = queryTag("retire_node_aliases")
+	done := tag.observe(p.impl.String())
+	defer func() { done(retired, err) }()
+
+	aliases := make([]int32, len(opts.Aliases))
+	for i, alias := range opts.Aliases {
+		aliases[i] = int32(alias)
+	}
+
+	result, err := p.db.ExecContext(ctx, `
+		UPDATE node_aliases SET retired_at = now()
+		WHERE node_alias = ANY($1::INT4[]) AND retired_at IS NULL
+	`, pgutil.Int4Array(aliases))
+	if err != nil {
+		return 0, Error.Wrap(err)
+	}
+
+	retired, err = result.RowsAffected()
+	return retired, Error.Wrap(err)
+}
+
+// RetireNodeAliases implements Adapter.
+func (s *SpannerAdapter) RetireNodeAliases(ctx context.Context, opts RetireNodeAliases) (retired int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	const tag = queryTag("retire_node_aliases")
+	done := tag.observe(s.Name())
+	defer func() { done(retired, err) }()
+
+	aliases := make([]int64, len(opts.Aliases))
+	for i, alias := range opts.Aliases {
+		aliases[i] = int64(alias)
+	}
+
+	_, err = s.client.ReadWriteTransaction(ctx, func(ctx context.Context, tx *spanner.ReadWriteTransaction) error {
+		retired, err = tx.Update(ctx, spanner.Statement{
+			SQL: `
+				UPDATE node_aliases SET retired_at = CURRENT_TIMESTAMP()
+				WHERE node_alias IN UNNEST(@aliases) AND retired_at IS NULL
+			`,
+			Params: map[string]interface{}{
+				"aliases": aliases,
+			},
+		})
+		return err
+	})
+	return retired, Error.Wrap(err)
+}
+
 // LatestNodesAliasMap returns the latest mapping between storj.NodeID and NodeAlias.
 func (db *DB) LatestNodesAliasMap(ctx context.Context) (_ *NodeAliasMap, err error) {
 	defer mon.Task()(&ctx)(&err)
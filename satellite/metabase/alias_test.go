@@ -231,6 +231,72 @@ func TestNodeAliases(t *testing.T) {
 			}
 			require.NoError(t, group.Wait())
 		})
+
+		t.Run("Retire", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			kept, retired := testrand.NodeID(), testrand.NodeID()
+			metabasetest.EnsureNodeAliases{
+				Opts: metabase.EnsureNodeAliases{
+					Nodes: []storj.NodeID{kept, retired},
+				},
+			}.Check(ctx, t, db)
+
+			aliasMap, err := db.LatestNodesAliasMap(ctx)
+			require.NoError(t, err)
+			retiredAlias, ok := aliasMap.Alias(retired)
+			require.True(t, ok)
+
+			count, err := db.RetireNodeAliases(ctx, metabase.RetireNodeAliases{
+				Aliases: []metabase.NodeAlias{retiredAlias},
+			})
+			require.NoError(t, err)
+			require.EqualValues(t, 1, count)
+
+			aliases := metabasetest.ListNodeAliases{}.Check(ctx, t, db)
+			require.Len(t, aliases, 1)
+			require.Equal(t, kept, aliases[0].ID)
+
+			aliasMap, err = db.LatestNodesAliasMap(ctx)
+			require.NoError(t, err)
+			_, ok = aliasMap.Alias(retired)
+			require.False(t, ok)
+			_, ok = aliasMap.Node(retiredAlias)
+			require.False(t, ok)
+
+			count, err = db.RetireNodeAliases(ctx, metabase.RetireNodeAliases{
+				Aliases: []metabase.NodeAlias{retiredAlias},
+			})
+			require.NoError(t, err)
+			require.Zero(t, count)
+
+			// the retired alias is kept as a tombstone, so segments written by a
+			// process with a stale cache can still be resolved.
+			entries, err := db.ResolveNodeAliases(ctx, []metabase.NodeAlias{retiredAlias})
+			require.NoError(t, err)
+			require.Equal(t, []metabase.NodeAliasEntry{{ID: retired, Alias: retiredAlias}}, entries)
+
+			cache := metabase.NewNodeAliasCache(db)
+			nodes, err := cache.Nodes(ctx, []metabase.NodeAlias{retiredAlias})
+			require.NoError(t, err)
+			require.Equal(t, []storj.NodeID{retired}, nodes)
+
+			// ensuring the node again revives its previous alias.
+			metabasetest.EnsureNodeAliases{
+				Opts: metabase.EnsureNodeAliases{
+					Nodes: []storj.NodeID{retired},
+				},
+			}.Check(ctx, t, db)
+
+			aliasMap, err = db.LatestNodesAliasMap(ctx)
+			require.NoError(t, err)
+			revivedAlias, ok := aliasMap.Alias(retired)
+			require.True(t, ok)
+			require.Equal(t, retiredAlias, revivedAlias)
+
+			aliases = metabasetest.ListNodeAliases{}.Check(ctx, t, db)
+			require.Len(t, aliases, 2)
+		})
 	}, metabasetest.WithSpanner())
 }
 
@@ -15,6 +15,7 @@ import (
 type NodeAliasDB interface {
 	EnsureNodeAliases(ctx context.Context, opts EnsureNodeAliases) error
 	ListNodeAliases(ctx context.Context) (_ []NodeAliasEntry, err error)
+	ResolveNodeAliases(ctx context.Context, aliases []NodeAlias) (_ []NodeAliasEntry, err error)
 }
 
 // NodeAliasCache is a write-through cache for looking up node ID and alias mapping.
@@ -59,6 +60,18 @@ func (cache *NodeAliasCache) Nodes(ctx context.Context, aliases []NodeAlias) ([]
 		return nodes, nil
 	}
 
+	// Retired aliases are not listed, however a process with a stale cache may have
+	// written them to a segment.
+	latest, err = cache.resolve(ctx, missing)
+	if err != nil {
+		return nil, Error.New("failed to resolve node aliases: %w", err)
+	}
+
+	nodes, missing = latest.Nodes(aliases)
+	if len(missing) == 0 {
+		return nodes, nil
+	}
+
 	return nil, Error.New("aliases missing in database: %v", missing)
 }
 
@@ -156,8 +169,8 @@ func (cache *NodeAliasCache) refresh(ctx context.Context, missingNodes []storj.N
 		return nil, err
 	}
 
-	// Since we never remove node aliases we can assume that the alias map that contains more
-	// entries is the latest one.
+	// Aliases are only retired when they are not used anymore, so we can assume that the alias
+	// map that contains more entries is the latest one.
 	//
 	// Note: we merge the maps here rather than directly replacing.
 	// This is not ideal from performance side, however it should reduce possible consistency issues.
@@ -169,6 +182,47 @@ func (cache *NodeAliasCache) refresh(ctx context.Context, missingNodes []storj.N
 	return xs, nil
 }
 
+// resolve adds the specified aliases to the cache, including the retired ones.
+func (cache *NodeAliasCache) resolve(ctx context.Context, aliases []NodeAlias) (_ *NodeAliasMap, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	cache.refreshing.Lock()
+	defer cache.refreshing.Unlock()
+
+	latest := cache.getLatest()
+
+	entries, err := cache.db.ResolveNodeAliases(ctx, aliases)
+	if err != nil {
+		return nil, err
+	}
+	if len(entries) == 0 {
+		return latest, nil
+	}
+
+	xs := NewNodeAliasMap(entries)
+	xs.Merge(latest)
+	cache.latest.Store(xs)
+
+	return xs, nil
+}
+
+// rebuild replaces the cache with the current state of the database, which drops
+// the retired aliases.
+func (cache *NodeAliasCache) rebuild(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	cache.refreshing.Lock()
+	defer cache.refreshing.Unlock()
+
+	entries, err := cache.db.ListNodeAliases(ctx)
+	if err != nil {
+		return err
+	}
+
+	cache.latest.Store(NewNodeAliasMap(entries))
+	return nil
+}
+
 // EnsurePiecesToAliases converts pieces to alias pieces and automatically adds storage node
 // to alias table when necessary.
 func (cache *NodeAliasCache) EnsurePiecesToAliases(ctx context.Context, pieces Pieces) (_ AliasPieces, err error) {
@@ -403,6 +403,25 @@ func (db *NodeAliasDB) ListNodeAliases(ctx context.Context) (_ []metabase.NodeAl
 	return xs, nil
 }
 
+func (db *NodeAliasDB) ResolveNodeAliases(ctx context.Context, aliases []metabase.NodeAlias) (_ []metabase.NodeAliasEntry, err error) {
+	if err := db.ShouldFail(); err != nil {
+		return nil, err
+	}
+
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	var xs []metabase.NodeAliasEntry
+	for _, entry := range db.entries {
+		for _, alias := range aliases {
+			if entry.Alias == alias {
+				xs = append(xs, entry)
+			}
+		}
+	}
+	return xs, nil
+}
+
 func (db *NodeAliasDB) ListNodeAliasesCount() int64 {
 	return atomic.LoadInt64(&db.listNodeAliasesCount)
 }
@@ -0,0 +1,174 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package aliasgc retires node aliases which are not used by any segment anymore.
+package aliasgc
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/storj"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/rangedloop"
+	"storj.io/storj/satellite/overlay"
+)
+
+var (
+	// Error is a standard error class for this package.
+	Error = errs.Class("node alias gc")
+	mon   = monkit.Package()
+
+	// check if Observer and Partial interfaces are satisfied.
+	_ rangedloop.Observer = (*Observer)(nil)
+	_ rangedloop.Partial  = (*observerFork)(nil)
+)
+
+// Config contains configurable values for the node alias gc observer.
+type Config struct {
+	Enabled    bool `help:"whether to retire node aliases of departed nodes, which are not referenced by any segment (rangedloop observer)" default:"false"`
+	MaxRetired int  `help:"the maximum number of node aliases retired after a single ranged loop run" default:"1000"`
+}
+
+// Observer implements the node alias gc ranged loop observer.
+//
+// Nodes which were disqualified or have exited don't get new pieces, so once
+// repair moved all their pieces away, their aliases are only kept in the alias
+// table and in the alias caches. The observer collects the aliases referenced by
+// the segments and retires the aliases of departed nodes which are not referenced.
+//
+// An alias is only retired when it was unreferenced in two consecutive runs, so
+// that segments created during a run, e.g. by server-side copy of a segment
+// which was deleted before its range was processed, are seen by the next run.
+type Observer struct {
+	log      *zap.Logger
+	config   Config
+	metabase *metabase.DB
+	overlay  overlay.DB
+
+	referenced map[metabase.NodeAlias]struct{}
+	// candidates are the unreferenced aliases of departed nodes found by the previous run.
+	candidates map[metabase.NodeAlias]storj.NodeID
+}
+
+// NewObserver creates a new node alias gc ranged loop observer.
+func NewObserver(log *zap.Logger, metabaseDB *metabase.DB, overlay overlay.DB, config Config) *Observer {
+	return &Observer{
+		log:      log,
+		config:   config,
+		metabase: metabaseDB,
+		overlay:  overlay,
+
+		candidates: map[metabase.NodeAlias]storj.NodeID{},
+	}
+}
+
+// Start implements ranged loop observer start method.
+func (observer *Observer) Start(ctx context.Context, startTime time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	observer.referenced = map[metabase.NodeAlias]struct{}{}
+	return nil
+}
+
+// Fork implements ranged loop observer fork method.
+func (observer *Observer) Fork(ctx context.Context) (_ rangedloop.Partial, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return &observerFork{referenced: map[metabase.NodeAlias]struct{}{}}, nil
+}
+
+// Join merges the aliases referenced in the range into the observer.
+func (observer *Observer) Join(ctx context.Context, partial rangedloop.Partial) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	fork, ok := partial.(*observerFork)
+	if !ok {
+		return Error.New("expected %T but got %T", fork, partial)
+	}
+
+	for alias := range fork.referenced {
+		observer.referenced[alias] = struct{}{}
+	}
+	return nil
+}
+
+// Finish retires the aliases which were unreferenced in this and the previous run.
+func (observer *Observer) Finish(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	entries, err := observer.metabase.ListNodeAliases(ctx)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	participating, err := observer.overlay.GetParticipatingNodes(ctx, time.Hour, 0)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	active := make(map[storj.NodeID]struct{}, len(participating))
+	for _, node := range participating {
+		active[node.ID] = struct{}{}
+	}
+
+	candidates := map[metabase.NodeAlias]storj.NodeID{}
+	var retire []metabase.NodeAlias
+	for _, entry := range entries {
+		if _, ok := observer.referenced[entry.Alias]; ok {
+			continue
+		}
+		if _, ok := active[entry.ID]; ok {
+			continue
+		}
+
+		candidates[entry.Alias] = entry.ID
+		if previous, ok := observer.candidates[entry.Alias]; ok && previous == entry.ID {
+			retire = append(retire, entry.Alias)
+		}
+	}
+
+	sort.Slice(retire, func(i, k int) bool { return retire[i] < retire[k] })
+	if len(retire) > observer.config.MaxRetired {
+		retire = retire[:observer.config.MaxRetired]
+	}
+
+	mon.IntVal("node_alias_total").Observe(int64(len(entries)))
+	mon.IntVal("node_alias_unreferenced").Observe(int64(len(candidates)))
+
+	retired, err := observer.metabase.RetireNodeAliases(ctx, metabase.RetireNodeAliases{Aliases: retire})
+	if err != nil {
+		// keep the candidates, so that the next run retries the retirement.
+		return Error.Wrap(err)
+	}
+	for _, alias := range retire {
+		delete(candidates, alias)
+	}
+	observer.candidates = candidates
+
+	mon.IntVal("node_alias_retired").Observe(retired)
+	if retired > 0 {
+		observer.log.Info("retired unreferenced node aliases",
+			zap.Int64("retired", retired),
+			zap.Int("remaining", len(entries)-int(retired)))
+	}
+	return nil
+}
+
+type observerFork struct {
+	referenced map[metabase.NodeAlias]struct{}
+}
+
+// Process collects the aliases referenced by the segments.
+func (fork *observerFork) Process(ctx context.Context, segments []rangedloop.Segment) error {
+	for _, segment := range segments {
+		for _, piece := range segment.AliasPieces {
+			fork.referenced[piece.Alias] = struct{}{}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package aliasgc_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/metabasetest"
+)
+
+func TestObserver(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.NodeAliasGC.Enabled = true
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		db := sat.Metabase.DB
+
+		// neither of the nodes is in the overlay, so both count as departed.
+		referenced, unreferenced := testrand.NodeID(), testrand.NodeID()
+
+		stream := metabasetest.RandObjectStream()
+		segment := metabasetest.DefaultRawSegment(stream, metabase.SegmentPosition{})
+		segment.Pieces = metabase.Pieces{{Number: 0, StorageNode: referenced}}
+		require.NoError(t, db.TestingBatchInsertSegments(ctx, []metabase.RawSegment{segment}))
+
+		require.NoError(t, db.EnsureNodeAliases(ctx, metabase.EnsureNodeAliases{
+			Nodes: []storj.NodeID{unreferenced},
+		}))
+
+		aliasedNodes := func() (nodes []storj.NodeID) {
+			entries, err := db.ListNodeAliases(ctx)
+			require.NoError(t, err)
+			for _, entry := range entries {
+				nodes = append(nodes, entry.ID)
+			}
+			return nodes
+		}
+		require.ElementsMatch(t, []storj.NodeID{referenced, unreferenced}, aliasedNodes())
+
+		// the first run only finds the candidates.
+		_, err := sat.RangedLoop.RangedLoop.Service.RunOnce(ctx)
+		require.NoError(t, err)
+		require.ElementsMatch(t, []storj.NodeID{referenced, unreferenced}, aliasedNodes())
+
+		_, err = sat.RangedLoop.RangedLoop.Service.RunOnce(ctx)
+		require.NoError(t, err)
+		require.ElementsMatch(t, []storj.NodeID{referenced}, aliasedNodes())
+	})
+}
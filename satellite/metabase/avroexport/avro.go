@@ -0,0 +1,125 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package avroexport
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/binary"
+	"io"
+	"time"
+)
+
+// avroMagic starts every Avro object container file.
+var avroMagic = []byte{'O', 'b', 'j', 1}
+
+// maxBlockRecords is the number of records after which a block is flushed.
+const maxBlockRecords = 4096
+
+// encoder implements the Avro binary encoding of the primitive types used by the
+// export schemas.
+type encoder struct {
+	buf     bytes.Buffer
+	scratch [binary.MaxVarintLen64]byte
+}
+
+func (enc *encoder) long(v int64) {
+	n := binary.PutVarint(enc.scratch[:], v)
+	enc.buf.Write(enc.scratch[:n])
+}
+
+func (enc *encoder) int(v int32) { enc.long(int64(v)) }
+
+func (enc *encoder) boolean(v bool) {
+	if v {
+		enc.buf.WriteByte(1)
+	} else {
+		enc.buf.WriteByte(0)
+	}
+}
+
+func (enc *encoder) bytes(v []byte) {
+	enc.long(int64(len(v)))
+	enc.buf.Write(v)
+}
+
+func (enc *encoder) string(v string) {
+	enc.long(int64(len(v)))
+	enc.buf.WriteString(v)
+}
+
+// timestamp encodes a timestamp-micros logical type.
+func (enc *encoder) timestamp(v time.Time) { enc.long(v.UnixMicro()) }
+
+// nullableTimestamp encodes a ["null", timestamp-micros] union.
+func (enc *encoder) nullableTimestamp(v *time.Time) {
+	if v == nil {
+		enc.long(0)
+		return
+	}
+	enc.long(1)
+	enc.timestamp(*v)
+}
+
+// containerWriter writes records to an uncompressed Avro object container file.
+type containerWriter struct {
+	w      io.Writer
+	sync   [16]byte
+	header encoder
+	block  encoder
+	count  int64
+}
+
+// newContainerWriter writes the file header with the schema.
+func newContainerWriter(w io.Writer, schema string) (*containerWriter, error) {
+	writer := &containerWriter{w: w}
+	if _, err := rand.Read(writer.sync[:]); err != nil {
+		return nil, err
+	}
+
+	header := &writer.header
+	header.buf.Write(avroMagic)
+	// file metadata is a map with a single block.
+	header.long(2)
+	header.string("avro.schema")
+	header.bytes([]byte(schema))
+	header.string("avro.codec")
+	header.bytes([]byte("null"))
+	header.long(0)
+	header.buf.Write(writer.sync[:])
+
+	_, err := w.Write(header.buf.Bytes())
+	return writer, err
+}
+
+// append adds a record encoded by fn.
+func (writer *containerWriter) append(fn func(enc *encoder)) error {
+	fn(&writer.block)
+	writer.count++
+	if writer.count >= maxBlockRecords {
+		return writer.flush()
+	}
+	return nil
+}
+
+// flush writes the buffered records as a block.
+func (writer *containerWriter) flush() error {
+	if writer.count == 0 {
+		return nil
+	}
+
+	var header encoder
+	header.long(writer.count)
+	header.long(int64(writer.block.buf.Len()))
+
+	for _, data := range [][]byte{header.buf.Bytes(), writer.block.buf.Bytes(), writer.sync[:]} {
+		if _, err := writer.w.Write(data); err != nil {
+			return err
+		}
+	}
+
+	writer.block.buf.Reset()
+	writer.count = 0
+	return nil
+}
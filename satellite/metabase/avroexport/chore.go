@@ -0,0 +1,193 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package avroexport
+
+import (
+	"bufio"
+	"context"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/sync2"
+	"storj.io/storj/satellite/buckets"
+	"storj.io/storj/satellite/metabase"
+)
+
+var (
+	// Error defines the avro export chore errors class.
+	Error = errs.Class("avro export")
+	mon   = monkit.Package()
+)
+
+// Config contains configurable values for the avro export chore.
+type Config struct {
+	Enabled            bool          `help:"set if the objects table and bucket metadata are periodically exported to avro files" default:"false"`
+	Interval           time.Duration `help:"how frequently the export is made" default:"24h"`
+	Dir                string        `help:"directory where every export is written to a subdirectory named by its time" default:""`
+	BatchSize          int           `help:"how many objects to query in a batch" default:"1000"`
+	AsOfSystemInterval time.Duration `help:"as of system interval" releaseDefault:"-5m" devDefault:"-1us" testDefault:"-1us"`
+}
+
+// Chore implements the chore which exports the objects and buckets to avro files.
+//
+// architecture: Chore
+type Chore struct {
+	log      *zap.Logger
+	config   Config
+	metabase *metabase.DB
+	buckets  buckets.DB
+
+	nowFn func() time.Time
+	Loop  *sync2.Cycle
+}
+
+// NewChore creates a new instance of the avro export chore.
+func NewChore(log *zap.Logger, config Config, metabase *metabase.DB, buckets buckets.DB) *Chore {
+	return &Chore{
+		log:      log,
+		config:   config,
+		metabase: metabase,
+		buckets:  buckets,
+
+		nowFn: time.Now,
+		Loop:  sync2.NewCycle(config.Interval),
+	}
+}
+
+// Run starts the avro export loop service.
+func (chore *Chore) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if !chore.config.Enabled {
+		return nil
+	}
+
+	return chore.Loop.Run(ctx, func(ctx context.Context) error {
+		if _, err := chore.Export(ctx); err != nil {
+			chore.log.Error("export failed", zap.Error(err))
+		}
+		return nil
+	})
+}
+
+// Close stops the avro export chore.
+func (chore *Chore) Close() error {
+	chore.Loop.Close()
+	return nil
+}
+
+// TestingSetNow allows tests to have the server act as if the current time is whatever they want.
+func (chore *Chore) TestingSetNow(nowFn func() time.Time) {
+	chore.nowFn = nowFn
+}
+
+// TestingSetConfigDir sets the directory where the exports are written.
+func (chore *Chore) TestingSetConfigDir(dir string) {
+	chore.config.Dir = dir
+}
+
+// Export writes objects.avro and buckets.avro into a new subdirectory of the configured
+// directory and returns its path. The subdirectory only appears once the export is complete.
+func (chore *Chore) Export(ctx context.Context) (dir string, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	now := chore.nowFn().UTC()
+	dir = filepath.Join(chore.config.Dir, now.Format("20060102T150405Z"))
+
+	tmpDir := dir + ".tmp"
+	if err := os.MkdirAll(tmpDir, 0755); err != nil {
+		return "", Error.Wrap(err)
+	}
+	defer func() {
+		if err != nil {
+			err = errs.Combine(err, Error.Wrap(os.RemoveAll(tmpDir)))
+		}
+	}()
+
+	var objectCount, bucketCount int64
+	err = writeContainer(filepath.Join(tmpDir, "objects.avro"), objectSchema, func(writer *containerWriter) error {
+		return chore.metabase.IterateObjectsForExport(ctx, metabase.IterateObjectsForExport{
+			BatchSize:          chore.config.BatchSize,
+			AsOfSystemTime:     now,
+			AsOfSystemInterval: chore.config.AsOfSystemInterval,
+		}, func(ctx context.Context, objects []metabase.ExportedObject) error {
+			for _, object := range objects {
+				if err := writer.append(func(enc *encoder) { encodeObject(enc, object) }); err != nil {
+					return err
+				}
+			}
+			objectCount += int64(len(objects))
+			return nil
+		})
+	})
+	if err != nil {
+		return "", Error.Wrap(err)
+	}
+
+	err = writeContainer(filepath.Join(tmpDir, "buckets.avro"), bucketSchema, func(writer *containerWriter) error {
+		return chore.buckets.IterateBucketLocations(ctx, chore.config.BatchSize, func(locations []metabase.BucketLocation) error {
+			for _, location := range locations {
+				bucket, err := chore.buckets.GetBucket(ctx, []byte(location.BucketName), location.ProjectID)
+				if err != nil {
+					if buckets.ErrBucketNotFound.Has(err) {
+						// deleted in the meantime.
+						continue
+					}
+					return err
+				}
+				if err := writer.append(func(enc *encoder) { encodeBucket(enc, bucket) }); err != nil {
+					return err
+				}
+				bucketCount++
+			}
+			return nil
+		})
+	})
+	if err != nil {
+		return "", Error.Wrap(err)
+	}
+
+	if err := os.Rename(tmpDir, dir); err != nil {
+		return "", Error.Wrap(err)
+	}
+
+	mon.IntVal("avro_export_objects").Observe(objectCount)
+	mon.IntVal("avro_export_buckets").Observe(bucketCount)
+	chore.log.Info("export finished",
+		zap.String("dir", dir),
+		zap.Int64("objects", objectCount),
+		zap.Int64("buckets", bucketCount))
+
+	return dir, nil
+}
+
+// writeContainer creates an avro container file with the records written by fn.
+func writeContainer(path, schema string, fn func(*containerWriter) error) (err error) {
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer func() { err = errs.Combine(err, file.Close()) }()
+
+	buffered := bufio.NewWriter(file)
+	writer, err := newContainerWriter(buffered, schema)
+	if err != nil {
+		return err
+	}
+	if err := fn(writer); err != nil {
+		return err
+	}
+	if err := writer.flush(); err != nil {
+		return err
+	}
+	if err := buffered.Flush(); err != nil {
+		return err
+	}
+	return file.Sync()
+}
@@ -0,0 +1,116 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package avroexport_test
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/common/memory"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite"
+)
+
+func TestExport(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.AvroExport.BatchSize = 2
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		upl := planet.Uplinks[0]
+
+		for _, bucket := range []string{"bucket1", "bucket2"} {
+			for _, key := range []string{"a", "b", "c"} {
+				require.NoError(t, upl.Upload(ctx, sat, bucket, key, testrand.Bytes(memory.KiB)))
+			}
+		}
+		require.NoError(t, upl.CreateBucket(ctx, sat, "empty"))
+
+		chore := sat.Core.AvroExport.Chore
+		chore.TestingSetConfigDir(ctx.Dir("export"))
+
+		dir, err := chore.Export(ctx)
+		require.NoError(t, err)
+
+		schema, count := readContainer(t, filepath.Join(dir, "objects.avro"))
+		require.Contains(t, schema, `"name": "Object"`)
+		require.EqualValues(t, 6, count)
+
+		schema, count = readContainer(t, filepath.Join(dir, "buckets.avro"))
+		require.Contains(t, schema, `"name": "Bucket"`)
+		require.EqualValues(t, 3, count)
+
+		// no temporary directories are left behind.
+		entries, err := os.ReadDir(ctx.Dir("export"))
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+	})
+}
+
+// readContainer returns the schema and the number of records of an avro container file.
+func readContainer(t *testing.T, path string) (schema string, count int64) {
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	r := bufio.NewReader(bytes.NewReader(data))
+
+	magic := make([]byte, 4)
+	_, err = io.ReadFull(r, magic)
+	require.NoError(t, err)
+	require.Equal(t, []byte{'O', 'b', 'j', 1}, magic)
+
+	readLong := func() int64 {
+		v, err := binary.ReadVarint(r)
+		require.NoError(t, err)
+		return v
+	}
+	readBytes := func() []byte {
+		b := make([]byte, readLong())
+		_, err := io.ReadFull(r, b)
+		require.NoError(t, err)
+		return b
+	}
+
+	metadata := map[string]string{}
+	for n := readLong(); n != 0; n = readLong() {
+		for ; n > 0; n-- {
+			key := string(readBytes())
+			metadata[key] = string(readBytes())
+		}
+	}
+	require.Equal(t, "null", metadata["avro.codec"])
+
+	sync := make([]byte, 16)
+	_, err = io.ReadFull(r, sync)
+	require.NoError(t, err)
+
+	for {
+		if _, err := r.Peek(1); err == io.EOF {
+			break
+		}
+		count += readLong()
+		_ = readBytes()
+
+		marker := make([]byte, 16)
+		_, err = io.ReadFull(r, marker)
+		require.NoError(t, err)
+		require.Equal(t, sync, marker)
+	}
+
+	return metadata["avro.schema"], count
+}
@@ -0,0 +1,7 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package avroexport implements a chore which periodically exports the metabase
+// objects table and the bucket metadata to Avro object container files, so
+// analytics can run offline without querying the production databases.
+package avroexport
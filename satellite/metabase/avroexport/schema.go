@@ -0,0 +1,74 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package avroexport
+
+import (
+	"storj.io/storj/satellite/buckets"
+	"storj.io/storj/satellite/metabase"
+)
+
+// objectSchema is the schema of the exported objects. The fields match the objects table,
+// except that the encrypted metadata is replaced with its size.
+const objectSchema = `{
+	"type": "record",
+	"name": "Object",
+	"namespace": "io.storj.metabase",
+	"fields": [
+		{"name": "project_id", "type": "bytes"},
+		{"name": "bucket_name", "type": "string"},
+		{"name": "object_key", "type": "bytes"},
+		{"name": "version", "type": "long"},
+		{"name": "stream_id", "type": "bytes"},
+		{"name": "created_at", "type": {"type": "long", "logicalType": "timestamp-micros"}},
+		{"name": "expires_at", "type": ["null", {"type": "long", "logicalType": "timestamp-micros"}], "default": null},
+		{"name": "status", "type": "int"},
+		{"name": "segment_count", "type": "int"},
+		{"name": "total_plain_size", "type": "long"},
+		{"name": "total_encrypted_size", "type": "long"},
+		{"name": "fixed_segment_size", "type": "int"},
+		{"name": "encrypted_metadata_size", "type": "long"}
+	]
+}`
+
+func encodeObject(enc *encoder, object metabase.ExportedObject) {
+	enc.bytes(object.ProjectID[:])
+	enc.string(object.BucketName)
+	enc.bytes([]byte(object.ObjectKey))
+	enc.long(int64(object.Version))
+	enc.bytes(object.StreamID[:])
+	enc.timestamp(object.CreatedAt)
+	enc.nullableTimestamp(object.ExpiresAt)
+	enc.int(int32(object.Status))
+	enc.int(object.SegmentCount)
+	enc.long(object.TotalPlainSize)
+	enc.long(object.TotalEncryptedSize)
+	enc.int(object.FixedSegmentSize)
+	enc.long(object.EncryptedMetadataSize)
+}
+
+// bucketSchema is the schema of the exported bucket metadata.
+const bucketSchema = `{
+	"type": "record",
+	"name": "Bucket",
+	"namespace": "io.storj.metabase",
+	"fields": [
+		{"name": "project_id", "type": "bytes"},
+		{"name": "bucket_name", "type": "string"},
+		{"name": "created_at", "type": {"type": "long", "logicalType": "timestamp-micros"}},
+		{"name": "placement", "type": "int"},
+		{"name": "versioning", "type": "int"},
+		{"name": "object_lock_enabled", "type": "boolean"},
+		{"name": "user_agent", "type": "bytes"}
+	]
+}`
+
+func encodeBucket(enc *encoder, bucket buckets.Bucket) {
+	enc.bytes(bucket.ProjectID[:])
+	enc.string(bucket.Name)
+	enc.timestamp(bucket.Created)
+	enc.int(int32(bucket.Placement))
+	enc.int(int32(bucket.Versioning))
+	enc.boolean(bucket.ObjectLockEnabled)
+	enc.bytes(bucket.UserAgent)
+}
@@ -0,0 +1,325 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/zeebo/errs"
+)
+
+// ErrCopySkipped is the result of the copies after a failed one, when
+// BatchCopyObjects.StopAtFailure is set.
+var ErrCopySkipped = errs.Class("copy skipped")
+
+// errStopCopies rolls back the transaction of a batch, which has to stop at a failed copy.
+var errStopCopies = errors.New("stop copies")
+
+// batchCopyObjectsLimit is the maximum number of objects copied in a single transaction.
+const batchCopyObjectsLimit = intLimitRange(100)
+
+// BatchCopyObjects contains the objects to copy in batches.
+type BatchCopyObjects struct {
+	// Objects are the copies to make, all of them must be in the same project.
+	Objects []FinishCopyObject
+
+	// BatchSize is the number of objects copied in a single transaction.
+	BatchSize int
+
+	// StopAtFailure stops at the first copy which fails, as if the copies were made one by
+	// one. The copies after it are not made and have ErrCopySkipped as their result.
+	StopAtFailure bool
+}
+
+// BatchCopyObjectResult is the result of copying a single object of a batch.
+type BatchCopyObjectResult struct {
+	// Object is the object at the destination location, when the copy succeeded.
+	Object Object
+	Err    error
+}
+
+// Verify verifies the request fields.
+func (opts BatchCopyObjects) Verify() error {
+	for i := range opts.Objects {
+		if opts.Objects[i].ProjectID != opts.Objects[0].ProjectID {
+			return ErrInvalidRequest.New("all objects must be in the same project")
+		}
+	}
+	return nil
+}
+
+// BatchCopyObjects copies many objects with a transaction per batch, which is much faster
+// than calling FinishCopyObject for every small object. The result of each copy is returned
+// at the index of the object in the request.
+//
+// A copy that is rejected (e.g. the source object is missing or the limits are exceeded) doesn't
+// affect the rest of the batch. Any other failure rolls back the whole batch and is reported for
+// every object of it.
+func (db *DB) BatchCopyObjects(ctx context.Context, opts BatchCopyObjects) (results []BatchCopyObjectResult, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := opts.Verify(); err != nil {
+		return nil, err
+	}
+	batchCopyObjectsLimit.Ensure(&opts.BatchSize)
+
+	results = make([]BatchCopyObjectResult, len(opts.Objects))
+	pending := make([]int, 0, len(opts.Objects))
+	for i, object := range opts.Objects {
+		if err := object.Verify(); err != nil {
+			results[i].Err = err
+			if opts.StopAtFailure {
+				skipCopies(results[i+1:])
+				break
+			}
+			continue
+		}
+		pending = append(pending, i)
+	}
+
+	for len(pending) > 0 {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		var batch []int
+		batch, pending = nextCopyBatch(opts.Objects, pending, opts.BatchSize)
+
+		if stopped := db.batchCopyObjects(ctx, opts.Objects, batch, results, opts.StopAtFailure); stopped {
+			for _, index := range pending {
+				results[index].Err = ErrCopySkipped.New("a previous copy failed")
+			}
+			break
+		}
+	}
+
+	return results, nil
+}
+
+func skipCopies(results []BatchCopyObjectResult) {
+	for i := range results {
+		results[i].Err = ErrCopySkipped.New("a previous copy failed")
+	}
+}
+
+// nextCopyBatch takes at most batchSize pending copies, which can be made in a single
+// transaction. The precommit constraint is enforced for the whole batch at once, so a
+// batch ends before a copy to a location that is already a destination in the batch, or
+// from such a location.
+func nextCopyBatch(objects []FinishCopyObject, pending []int, batchSize int) (batch, rest []int) {
+	destinations := make(map[ObjectLocation]struct{}, batchSize)
+	for i, index := range pending {
+		if i >= batchSize {
+			return pending[:i], pending[i:]
+		}
+		_, copyTo := destinations[objects[index].NewLocation()]
+		_, copyFrom := destinations[objects[index].Location()]
+		if copyTo || copyFrom {
+			return pending[:i], pending[i:]
+		}
+		destinations[objects[index].NewLocation()] = struct{}{}
+	}
+	return pending, nil
+}
+
+// batchCopyObjects copies the objects at the batch indexes in a single transaction. When
+// stopAtFailure is set and a copy fails, the transaction is rolled back and repeated with
+// the copies before the failed one; stopped is true in that case.
+func (db *DB) batchCopyObjects(ctx context.Context, objects []FinishCopyObject, batch []int, results []BatchCopyObjectResult, stopAtFailure bool) (stopped bool) {
+	for len(batch) > 0 {
+		copied, failedAt, err := db.batchCopyObjectsTx(ctx, objects, batch, stopAtFailure)
+		if err == nil && failedAt >= 0 {
+			results[batch[failedAt]] = copied[failedAt]
+			for _, index := range batch[failedAt+1:] {
+				results[index].Err = ErrCopySkipped.New("a previous copy failed")
+			}
+			batch = batch[:failedAt]
+			stopped = true
+			continue
+		}
+
+		for k, index := range batch {
+			if err != nil {
+				results[index].Err = err
+				continue
+			}
+			results[index] = copied[k]
+		}
+		return stopped || (err != nil && stopAtFailure)
+	}
+	return stopped
+}
+
+// batchCopyObjectsTx makes the copies of the batch in a single transaction.
+//
+// The sources are read and validated first, then the precommit constraint is enforced
+// for all valid copies with PrecommitConstraintBatch, and finally the copies are inserted.
+// With stopAtFailure the transaction is rolled back at the first failed copy, and failedAt
+// is its index within the batch.
+func (db *DB) batchCopyObjectsTx(ctx context.Context, objects []FinishCopyObject, batch []int, stopAtFailure bool) (copied []BatchCopyObjectResult, failedAt int, err error) {
+	var deletedObjects, deletedSegments int
+
+	// reject records a copy, which failed without modifying anything.
+	reject := func(k int, err error) error {
+		copied[k].Err = err
+		if stopAtFailure {
+			failedAt = k
+			return errStopCopies
+		}
+		return nil
+	}
+
+	adapter := db.ChooseAdapter(objects[batch[0]].ProjectID)
+	err = adapter.withTxStats(ctx, "batch_copy_objects", func(ctx context.Context, adapter TransactionAdapter) error {
+		// the transaction may be retried.
+		copied = make([]BatchCopyObjectResult, len(batch))
+		failedAt = -1
+		deletedObjects, deletedSegments = 0, 0
+
+		sources := make([]copyObjectSource, len(batch))
+		prepared := make([]int, 0, len(batch))
+		for k, index := range batch {
+			opts := objects[index]
+
+			var limitsErr error
+			if verifyLimits := opts.VerifyLimits; verifyLimits != nil {
+				opts.VerifyLimits = func(encryptedObjectSize int64, nSegments int64) error {
+					limitsErr = verifyLimits(encryptedObjectSize, nSegments)
+					return limitsErr
+				}
+			}
+
+			source, err := db.prepareCopyObject(ctx, adapter, opts)
+			if err != nil {
+				if limitsErr == nil && !isCopyRejected(err) {
+					return err
+				}
+				if err := reject(k, err); err != nil {
+					return err
+				}
+				continue
+			}
+			sources[k] = source
+			prepared = append(prepared, k)
+		}
+
+		versions := make([]Version, len(batch))
+		for _, group := range groupCopyDestinations(objects, batch, prepared) {
+			precommit, err := db.PrecommitConstraintBatch(ctx, group.opts, adapter)
+			if err == nil {
+				for _, k := range group.items {
+					versions[k] = precommit.HighestVersions[objects[batch[k]].NewEncryptedObjectKey] + 1
+				}
+				deletedObjects += precommit.DeletedObjectCount
+				deletedSegments += precommit.DeletedSegmentCount
+				continue
+			}
+			if !isCopyRejected(err) {
+				return err
+			}
+
+			// the batch only checks the destinations before rejecting them, so they
+			// can be checked one by one, to reject only the affected copies.
+			for _, k := range group.items {
+				precommit, err := db.PrecommitConstraint(ctx, PrecommitConstraint{
+					Location:       objects[batch[k]].NewLocation(),
+					Versioned:      group.opts.Versioned,
+					DisallowDelete: group.opts.DisallowDelete,
+				}, adapter)
+				if err != nil {
+					if !isCopyRejected(err) {
+						return err
+					}
+					if err := reject(k, err); err != nil {
+						return err
+					}
+					continue
+				}
+				versions[k] = precommit.HighestVersion + 1
+				deletedObjects += precommit.DeletedObjectCount
+				deletedSegments += precommit.DeletedSegmentCount
+			}
+		}
+
+		for _, k := range prepared {
+			if copied[k].Err != nil {
+				continue
+			}
+			newObject, err := db.completeCopyObject(ctx, adapter, objects[batch[k]], sources[k], versions[k])
+			if err != nil {
+				return err
+			}
+			copied[k].Object = newObject
+		}
+		return nil
+	})
+	if errors.Is(err, errStopCopies) {
+		return copied, failedAt, nil
+	}
+	if err != nil {
+		return nil, -1, err
+	}
+
+	mon.Meter("object_delete").Mark(deletedObjects)
+	mon.Meter("segment_delete").Mark(deletedSegments)
+	for _, result := range copied {
+		if result.Err == nil {
+			mon.Meter("finish_copy_object").Mark(1)
+		}
+	}
+	return copied, -1, nil
+}
+
+// copyDestinationGroup are the copies of a batch, whose precommit constraint is enforced together.
+type copyDestinationGroup struct {
+	opts PrecommitConstraintBatch
+	// items are the indexes of the copies within the batch.
+	items []int
+}
+
+// groupCopyDestinations groups the prepared copies of the batch by the destination bucket and
+// the precommit options, keeping the order of the copies.
+func groupCopyDestinations(objects []FinishCopyObject, batch []int, prepared []int) []copyDestinationGroup {
+	type groupKey struct {
+		bucket         BucketLocation
+		versioned      bool
+		disallowDelete bool
+	}
+
+	var groups []copyDestinationGroup
+	byKey := map[groupKey]int{}
+	for _, k := range prepared {
+		opts := objects[batch[k]]
+		key := groupKey{
+			bucket:         BucketLocation{ProjectID: opts.ProjectID, BucketName: opts.NewBucket},
+			versioned:      opts.NewVersioned,
+			disallowDelete: opts.NewDisallowDelete,
+		}
+		i, ok := byKey[key]
+		if !ok {
+			i = len(groups)
+			byKey[key] = i
+			groups = append(groups, copyDestinationGroup{
+				opts: PrecommitConstraintBatch{
+					Bucket:         key.bucket,
+					Versioned:      key.versioned,
+					DisallowDelete: key.disallowDelete,
+				},
+			})
+		}
+		groups[i].opts.ObjectKeys = append(groups[i].opts.ObjectKeys, opts.NewEncryptedObjectKey)
+		groups[i].items = append(groups[i].items, k)
+	}
+	return groups
+}
+
+// isCopyRejected returns whether the copy failed without modifying anything, which
+// means the transaction can continue with the other copies.
+func isCopyRejected(err error) bool {
+	return ErrObjectNotFound.Has(err) ||
+		ErrInvalidRequest.Has(err) ||
+		ErrMethodNotAllowed.Has(err) ||
+		ErrPermissionDenied.Has(err)
+}
@@ -0,0 +1,196 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/metabasetest"
+)
+
+func TestBatchCopyObjects(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		t.Run("mixed projects", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			_, err := db.BatchCopyObjects(ctx, metabase.BatchCopyObjects{
+				Objects: []metabase.FinishCopyObject{
+					{ObjectStream: metabasetest.RandObjectStream()},
+					{ObjectStream: metabasetest.RandObjectStream()},
+				},
+			})
+			require.True(t, metabase.ErrInvalidRequest.Has(err), err)
+		})
+
+		t.Run("per object results", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			projectID := testrand.UUID()
+
+			var sources []metabase.Object
+			for i := 0; i < 5; i++ {
+				obj := metabasetest.RandObjectStream()
+				obj.ProjectID = projectID
+				sources = append(sources, metabasetest.CreateObject(ctx, t, db, obj, 2))
+			}
+
+			copyOf := func(source metabase.Object) metabase.FinishCopyObject {
+				keys := make([]metabase.EncryptedKeyAndNonce, source.SegmentCount)
+				for i := range keys {
+					keys[i] = metabasetest.RandEncryptedKeyAndNonce(i)
+				}
+				return metabase.FinishCopyObject{
+					ObjectStream:          source.ObjectStream,
+					NewBucket:             source.BucketName,
+					NewEncryptedObjectKey: source.ObjectKey + "-copy",
+					NewStreamID:           testrand.UUID(),
+					NewSegmentKeys:        keys,
+				}
+			}
+
+			var copies []metabase.FinishCopyObject
+			for _, source := range sources {
+				copies = append(copies, copyOf(source))
+			}
+
+			// a missing source object.
+			copies[1].StreamID = testrand.UUID()
+			// an invalid request.
+			copies[2].NewStreamID = copies[2].StreamID
+			// exceeding the limits.
+			errLimits := errors.New("limits exceeded")
+			copies[3].VerifyLimits = func(encryptedObjectSize int64, nSegments int64) error {
+				return errLimits
+			}
+
+			results, err := db.BatchCopyObjects(ctx, metabase.BatchCopyObjects{
+				Objects:   copies,
+				BatchSize: 2,
+			})
+			require.NoError(t, err)
+			require.Len(t, results, len(copies))
+
+			require.True(t, metabase.ErrObjectNotFound.Has(results[1].Err), results[1].Err)
+			require.True(t, metabase.ErrInvalidRequest.Has(results[2].Err), results[2].Err)
+			require.ErrorIs(t, results[3].Err, errLimits)
+
+			for _, i := range []int{0, 4} {
+				require.NoError(t, results[i].Err)
+				require.Equal(t, copies[i].NewEncryptedObjectKey, results[i].Object.ObjectKey)
+				require.Equal(t, copies[i].NewStreamID, results[i].Object.StreamID)
+
+				copied, err := db.GetObjectLastCommitted(ctx, metabase.GetObjectLastCommitted{
+					ObjectLocation: copies[i].NewLocation(),
+				})
+				require.NoError(t, err)
+				require.Equal(t, sources[i].SegmentCount, copied.SegmentCount)
+				require.Equal(t, sources[i].TotalEncryptedSize, copied.TotalEncryptedSize)
+			}
+
+			objects, err := db.TestingAllObjects(ctx)
+			require.NoError(t, err)
+			require.Len(t, objects, len(sources)+2)
+		})
+
+		t.Run("existing destinations", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			projectID := testrand.UUID()
+			create := func(key metabase.ObjectKey) metabase.Object {
+				obj := metabasetest.RandObjectStream()
+				obj.ProjectID, obj.BucketName, obj.ObjectKey = projectID, "bucket", key
+				return metabasetest.CreateObject(ctx, t, db, obj, 1)
+			}
+			copyTo := func(source metabase.Object, key metabase.ObjectKey) metabase.FinishCopyObject {
+				return metabase.FinishCopyObject{
+					ObjectStream:          source.ObjectStream,
+					NewBucket:             source.BucketName,
+					NewEncryptedObjectKey: key,
+					NewStreamID:           testrand.UUID(),
+					NewSegmentKeys:        []metabase.EncryptedKeyAndNonce{metabasetest.RandEncryptedKeyAndNonce(0)},
+				}
+			}
+
+			a, b, c := create("a"), create("b"), create("c")
+
+			results, err := db.BatchCopyObjects(ctx, metabase.BatchCopyObjects{
+				Objects: []metabase.FinishCopyObject{
+					// replaces the existing object.
+					copyTo(a, "c"),
+					copyTo(a, "x"),
+					// copies to the same destination are made in order.
+					copyTo(b, "x"),
+					// the source was replaced by the first copy.
+					copyTo(c, "y"),
+				},
+			})
+			require.NoError(t, err)
+			require.Len(t, results, 4)
+			for _, result := range results[:3] {
+				require.NoError(t, result.Err)
+			}
+			require.True(t, metabase.ErrObjectNotFound.Has(results[3].Err), results[3].Err)
+
+			for key, expected := range map[metabase.ObjectKey]metabase.Object{
+				"a": a, "b": b, "c": results[0].Object, "x": results[2].Object,
+			} {
+				object, err := db.GetObjectLastCommitted(ctx, metabase.GetObjectLastCommitted{
+					ObjectLocation: metabase.ObjectLocation{ProjectID: projectID, BucketName: "bucket", ObjectKey: key},
+				})
+				require.NoError(t, err)
+				require.Equal(t, expected.StreamID, object.StreamID, key)
+			}
+
+			// the replaced objects are deleted.
+			objects, err := db.TestingAllObjects(ctx)
+			require.NoError(t, err)
+			require.Len(t, objects, 4)
+		})
+
+		t.Run("stop at failure", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			projectID := testrand.UUID()
+			var copies []metabase.FinishCopyObject
+			for i := 0; i < 5; i++ {
+				obj := metabasetest.RandObjectStream()
+				obj.ProjectID = projectID
+				source := metabasetest.CreateObject(ctx, t, db, obj, 1)
+				copies = append(copies, metabase.FinishCopyObject{
+					ObjectStream:          source.ObjectStream,
+					NewBucket:             source.BucketName,
+					NewEncryptedObjectKey: source.ObjectKey + "-copy",
+					NewStreamID:           testrand.UUID(),
+					NewSegmentKeys:        []metabase.EncryptedKeyAndNonce{metabasetest.RandEncryptedKeyAndNonce(0)},
+				})
+			}
+			copies[2].StreamID = testrand.UUID()
+
+			results, err := db.BatchCopyObjects(ctx, metabase.BatchCopyObjects{
+				Objects:       copies,
+				BatchSize:     4,
+				StopAtFailure: true,
+			})
+			require.NoError(t, err)
+			require.Len(t, results, len(copies))
+
+			require.NoError(t, results[0].Err)
+			require.NoError(t, results[1].Err)
+			require.True(t, metabase.ErrObjectNotFound.Has(results[2].Err), results[2].Err)
+			require.True(t, metabase.ErrCopySkipped.Has(results[3].Err), results[3].Err)
+			require.True(t, metabase.ErrCopySkipped.Has(results[4].Err), results[4].Err)
+
+			// only the copies before the failed one were made.
+			objects, err := db.TestingAllObjects(ctx)
+			require.NoError(t, err)
+			require.Len(t, objects, len(copies)+2)
+		})
+	})
+}
@@ -190,7 +190,7 @@ func (s *expiredScenario) run(ctx *testcontext.Context, b *testing.B, db *metaba
 			}
 
 			m.Record(func() {
-				err := db.DeleteExpiredObjects(ctx, metabase.DeleteExpiredObjects{
+				_, err := db.DeleteExpiredObjects(ctx, metabase.DeleteExpiredObjects{
 					ExpiredBefore: now,
 				})
 				require.NoError(b, err)
@@ -0,0 +1,175 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase_test
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/metabasetest"
+)
+
+var benchHotPaths = flag.Bool("hotpaths", false, "benchmark precommit and delete hot paths")
+
+// BenchmarkHotPaths measures the latency of the precommit constraint and the
+// bucket deletion queries at different object and version cardinalities.
+//
+// It runs against all configured test databases, e.g.:
+//
+//	go test -run - -bench BenchmarkHotPaths -hotpaths ./satellite/metabase
+func BenchmarkHotPaths(b *testing.B) {
+	if !*benchHotPaths {
+		b.Skip(`Use "go test -run - -bench BenchmarkHotPaths -hotpaths" to run this benchmark.`)
+	}
+
+	if testing.Short() {
+		hotPathScenario{objects: 10, versions: 2}.Run(b)
+		return
+	}
+	for _, scenario := range []hotPathScenario{
+		{objects: 100, versions: 1},
+		{objects: 100, versions: 10},
+		{objects: 1000, versions: 1},
+		{objects: 1000, versions: 10},
+	} {
+		scenario.Run(b)
+	}
+}
+
+type hotPathScenario struct {
+	// objects is the number of object keys in the bucket.
+	objects int
+	// versions is the number of committed versions per object key.
+	versions int
+}
+
+// Run runs the scenario as a subtest.
+func (s hotPathScenario) Run(b *testing.B) {
+	b.Run(fmt.Sprintf("objects=%d,versions=%d", s.objects, s.versions), func(b *testing.B) {
+		metabasetest.Bench(b, s.run)
+	})
+}
+
+// populate creates the objects and versions of the scenario in the bucket.
+func (s hotPathScenario) populate(ctx *testcontext.Context, b *testing.B, db *metabase.DB, bucket metabase.BucketLocation) []metabase.ObjectKey {
+	keys := make([]metabase.ObjectKey, s.objects)
+	for i := range keys {
+		keys[i] = metabase.ObjectKey("prefix/" + strconv.Itoa(i) + "/" + testrand.Path())
+		for version := 1; version <= s.versions; version++ {
+			metabasetest.CreateObjectVersioned(ctx, b, db, metabase.ObjectStream{
+				ProjectID:  bucket.ProjectID,
+				BucketName: bucket.BucketName,
+				ObjectKey:  keys[i],
+				Version:    metabase.Version(version),
+				StreamID:   testrand.UUID(),
+			}, 1)
+		}
+	}
+	return keys
+}
+
+//nolint:scopelint // This heavily uses loop variables without goroutines, avoiding these would add lots of boilerplate.
+func (s hotPathScenario) run(ctx *testcontext.Context, b *testing.B, db *metabase.DB) {
+	bucket := metabase.BucketLocation{
+		ProjectID:  testrand.UUID(),
+		BucketName: "hotpaths",
+	}
+	keys := s.populate(ctx, b, db, bucket)
+	adapter := db.ChooseAdapter(bucket.ProjectID)
+
+	precommit := func(opts metabase.PrecommitConstraint) (result metabase.PrecommitConstraintResult) {
+		err := adapter.WithTx(ctx, func(ctx context.Context, adapter metabase.TransactionAdapter) error {
+			var err error
+			result, err = db.PrecommitConstraint(ctx, opts, adapter)
+			return err
+		})
+		require.NoError(b, err)
+		return result
+	}
+
+	location := func(key metabase.ObjectKey) metabase.ObjectLocation {
+		return metabase.ObjectLocation{
+			ProjectID:  bucket.ProjectID,
+			BucketName: bucket.BucketName,
+			ObjectKey:  key,
+		}
+	}
+
+	for _, variant := range []struct {
+		name string
+		opts metabase.PrecommitConstraint
+	}{
+		{name: "versioned", opts: metabase.PrecommitConstraint{Versioned: true}},
+		{name: "disallow-delete", opts: metabase.PrecommitConstraint{DisallowDelete: true}},
+		{name: "unversioned-missing", opts: metabase.PrecommitConstraint{}},
+	} {
+		b.Run("PrecommitConstraint/"+variant.name, func(b *testing.B) {
+			latency := make(Metrics, 0, b.N)
+			defer latency.ReportPercentiles(b, "ns/precommit")
+
+			for i := 0; i < b.N; i++ {
+				opts := variant.opts
+				if variant.name == "unversioned-missing" {
+					opts.Location = location(metabase.ObjectKey("missing/" + strconv.Itoa(i)))
+				} else {
+					opts.Location = location(keys[i%len(keys)])
+				}
+				latency.Record(func() { precommit(opts) })
+			}
+		})
+	}
+
+	b.Run("PrecommitConstraint/unversioned-delete", func(b *testing.B) {
+		latency := make(Metrics, 0, b.N)
+		defer latency.ReportPercentiles(b, "ns/precommit")
+
+		for i := 0; i < b.N; i++ {
+			b.StopTimer()
+			obj := metabasetest.CreateObject(ctx, b, db, metabase.ObjectStream{
+				ProjectID:  bucket.ProjectID,
+				BucketName: bucket.BucketName,
+				ObjectKey:  metabase.ObjectKey("unversioned/" + strconv.Itoa(i)),
+				Version:    1,
+				StreamID:   testrand.UUID(),
+			}, 1)
+			b.StartTimer()
+
+			latency.Record(func() {
+				result := precommit(metabase.PrecommitConstraint{Location: obj.Location()})
+				require.Equal(b, 1, result.DeletedObjectCount)
+			})
+		}
+	})
+
+	b.Run("DeleteBucketObjects", func(b *testing.B) {
+		latency := make(Metrics, 0, b.N)
+		defer latency.ReportPercentiles(b, "ns/delete")
+
+		for i := 0; i < b.N; i++ {
+			b.StopTimer()
+			deleted := metabase.BucketLocation{
+				ProjectID:  bucket.ProjectID,
+				BucketName: "deleted-" + strconv.Itoa(i),
+			}
+			s.populate(ctx, b, db, deleted)
+			b.StartTimer()
+
+			latency.Record(func() {
+				count, err := db.DeleteBucketObjects(ctx, metabase.DeleteBucketObjects{
+					Bucket: deleted,
+				})
+				require.NoError(b, err)
+				require.EqualValues(b, s.objects*s.versions, count)
+			})
+		}
+	})
+}
@@ -370,6 +370,22 @@ func (m *Metrics) Report(b *testing.B, name string) {
 	b.ReportMetric(hist.P50, name)
 }
 
+// ReportPercentiles reports the 50th, 90th and 99th percentile of the metric with the specified name.
+func (m *Metrics) ReportPercentiles(b *testing.B, name string) {
+	if len(*m) == 0 {
+		return
+	}
+	hist := hrtime.NewDurationHistogram(*m, &hrtime.HistogramOptions{
+		BinCount:        1,
+		NiceRange:       true,
+		ClampMaximum:    0,
+		ClampPercentile: 0.999,
+	})
+	b.ReportMetric(hist.P50, "p50-"+name)
+	b.ReportMetric(hist.P90, "p90-"+name)
+	b.ReportMetric(hist.P99, "p99-"+name)
+}
+
 // randPieces returns randomized pieces.
 func randPieces(count int, nodes []storj.NodeID) metabase.Pieces {
 	pieces := make(metabase.Pieces, count)
@@ -0,0 +1,286 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package bucketinventory writes S3 style inventory reports of buckets into
+// destination buckets owned by the customer.
+package bucketinventory
+
+import (
+	"compress/gzip"
+	"context"
+	"crypto/md5"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/storj"
+	"storj.io/common/sync2"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/buckets"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/uplink"
+)
+
+var (
+	// Error defines the bucket inventory chore errors class.
+	Error = errs.Class("bucket inventory")
+	mon   = monkit.Package()
+)
+
+// Config contains configurable values for writing bucket inventory reports.
+type Config struct {
+	Interval  time.Duration `help:"how often to check which bucket inventory reports are due" releaseDefault:"1h" devDefault:"10s"`
+	Enabled   bool          `help:"set if writing bucket inventory reports is enabled or not" default:"false"`
+	ListLimit int           `help:"how many buckets and objects to query in a batch" default:"1000"`
+}
+
+// Chore writes the inventory reports of buckets which are due.
+//
+// architecture: Chore
+type Chore struct {
+	log            *zap.Logger
+	config         Config
+	metabase       *metabase.DB
+	buckets        buckets.DB
+	storageClasses buckets.StorageClasses
+	satellite      storj.NodeURL
+
+	nowFn func() time.Time
+	Loop  *sync2.Cycle
+}
+
+// NewChore creates a new instance of the bucket inventory chore.
+//
+// The reports are always uploaded through the specified satellite, which must be
+// the local one.
+func NewChore(log *zap.Logger, config Config, metabase *metabase.DB, bucketsDB buckets.DB, storageClasses buckets.StorageClasses, satellite storj.NodeURL) *Chore {
+	return &Chore{
+		log:            log,
+		config:         config,
+		metabase:       metabase,
+		buckets:        bucketsDB,
+		storageClasses: storageClasses,
+		satellite:      satellite,
+
+		nowFn: time.Now,
+		Loop:  sync2.NewCycle(config.Interval),
+	}
+}
+
+// Run starts the bucket inventory loop service.
+func (chore *Chore) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if !chore.config.Enabled {
+		return nil
+	}
+
+	return chore.Loop.Run(ctx, chore.writeReports)
+}
+
+// Close stops the bucket inventory chore.
+func (chore *Chore) Close() error {
+	chore.Loop.Close()
+	return nil
+}
+
+// TestingSetSatelliteURL sets the URL of the local satellite, which isn't known
+// before its API is listening in tests.
+func (chore *Chore) TestingSetSatelliteURL(satellite storj.NodeURL) {
+	chore.satellite = satellite
+}
+
+// TestingSetNow allows tests to have the server act as if the current time is whatever they want.
+func (chore *Chore) TestingSetNow(nowFn func() time.Time) {
+	chore.nowFn = nowFn
+}
+
+func (chore *Chore) writeReports(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	now := chore.nowFn()
+
+	err = chore.buckets.IterateBucketInventories(ctx, chore.config.ListLimit, func(page []buckets.BucketInventory) error {
+		for _, inventory := range page {
+			if !inventory.Due(now) {
+				continue
+			}
+
+			if err := chore.writeReport(ctx, inventory, now); err != nil {
+				mon.Counter("bucket_inventory_failures").Inc(1)
+				// a destination which is not reachable anymore should not block the others.
+				chore.log.Error("writing bucket inventory report failed",
+					zap.Stringer("Project ID", inventory.Bucket.ProjectID),
+					zap.String("Bucket", inventory.Bucket.BucketName),
+					zap.String("Inventory ID", inventory.Configuration.ID),
+					zap.Error(err))
+			}
+		}
+		return nil
+	})
+	return Error.Wrap(err)
+}
+
+func (chore *Chore) writeReport(ctx context.Context, inventory buckets.BucketInventory, now time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	bucket := inventory.Bucket
+	config := inventory.Configuration
+
+	placement, err := chore.buckets.GetBucketPlacement(ctx, []byte(bucket.BucketName), bucket.ProjectID)
+	if err != nil {
+		if buckets.ErrBucketNotFound.Has(err) {
+			return nil
+		}
+		return err
+	}
+
+	project, err := openProject(ctx, chore.satellite, config)
+	if err != nil {
+		return err
+	}
+	defer func() { err = errs.Combine(err, project.Close()) }()
+
+	dataID, err := uuid.New()
+	if err != nil {
+		return err
+	}
+	dataKey := ReportPrefix(bucket.BucketName, config) + "data/" + dataID.String() + ".csv.gz"
+
+	file, count, err := chore.writeData(ctx, project, inventory, placement, dataKey)
+	if err != nil {
+		return err
+	}
+
+	manifest := Manifest{
+		SourceBucket:      bucket.BucketName,
+		DestinationBucket: config.DestinationBucket,
+		Version:           ManifestVersion,
+		CreationTimestamp: strconv.FormatInt(now.UnixMilli(), 10),
+		FileFormat:        "CSV",
+		FileSchema:        fileSchema(),
+		Files:             []ManifestFile{file},
+	}
+	data, err := json.Marshal(manifest)
+	if err != nil {
+		return err
+	}
+
+	err = upload(ctx, project, config.DestinationBucket, ManifestKey(bucket.BucketName, config, now), func(w io.Writer) error {
+		_, err := w.Write(data)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	mon.Counter("bucket_inventory_reports").Inc(1)
+	mon.IntVal("bucket_inventory_report_objects").Observe(count)
+
+	return chore.buckets.SetBucketInventoryLastRun(ctx, []byte(bucket.BucketName), bucket.ProjectID, now)
+}
+
+// writeData uploads the gzip compressed CSV listing of the bucket and returns its description
+// together with the number of listed objects.
+func (chore *Chore) writeData(ctx context.Context, project *uplink.Project, inventory buckets.BucketInventory, placement storj.PlacementConstraint, key string) (file ManifestFile, count int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	bucket := inventory.Bucket
+	storageClass := strconv.Itoa(int(placement))
+	if class, ok := chore.storageClasses.ByPlacement(placement); ok {
+		storageClass = class.Name
+	}
+
+	counter := &countingWriter{}
+	checksum := md5.New()
+
+	err = upload(ctx, project, inventory.Configuration.DestinationBucket, key, func(w io.Writer) error {
+		gz := gzip.NewWriter(io.MultiWriter(w, checksum, counter))
+		rows := csv.NewWriter(gz)
+
+		err := chore.metabase.IterateObjectsAllVersionsWithStatus(ctx, metabase.IterateObjectsWithStatus{
+			ProjectID:  bucket.ProjectID,
+			BucketName: bucket.BucketName,
+			Recursive:  true,
+			BatchSize:  chore.config.ListLimit,
+		}, func(ctx context.Context, it metabase.ObjectsIterator) error {
+			var entry metabase.ObjectEntry
+			var previousKey metabase.ObjectKey
+			first := true
+			for it.Next(ctx, &entry) {
+				// versions are iterated from the newest, so the first one of a key is the latest.
+				isLatest := first || entry.ObjectKey != previousKey
+				first, previousKey = false, entry.ObjectKey
+
+				if inventory.Configuration.IncludedVersions == buckets.InventoryCurrentVersions &&
+					(!isLatest || entry.Status.IsDeleteMarker()) {
+					continue
+				}
+
+				record := Record{
+					Bucket:           bucket.BucketName,
+					Key:              entry.ObjectKey,
+					VersionID:        entry.StreamVersionID().Bytes(),
+					IsLatest:         isLatest,
+					IsDeleteMarker:   entry.Status.IsDeleteMarker(),
+					Size:             entry.TotalEncryptedSize,
+					LastModified:     entry.CreatedAt,
+					StorageClass:     storageClass,
+					EncryptionStatus: entry.Encryption.CipherSuite.String(),
+				}
+				if err := rows.Write(record.Fields()); err != nil {
+					return err
+				}
+				count++
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		rows.Flush()
+		return errs.Combine(rows.Error(), gz.Close())
+	})
+	if err != nil {
+		return ManifestFile{}, 0, err
+	}
+
+	return ManifestFile{
+		Key:         key,
+		Size:        counter.n,
+		MD5Checksum: hex.EncodeToString(checksum.Sum(nil)),
+	}, count, nil
+}
+
+// upload writes an object into the destination bucket, aborting the upload when fn fails.
+func upload(ctx context.Context, project *uplink.Project, bucket, key string, fn func(w io.Writer) error) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	upload, err := project.UploadObject(ctx, bucket, key, nil)
+	if err != nil {
+		return err
+	}
+
+	if err := fn(upload); err != nil {
+		return errs.Combine(err, upload.Abort())
+	}
+	return upload.Commit()
+}
+
+// countingWriter counts the bytes written to it.
+type countingWriter struct {
+	n int64
+}
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	w.n += int64(len(p))
+	return len(p), nil
+}
@@ -0,0 +1,151 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package bucketinventory_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/md5"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/common/memory"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/buckets"
+	"storj.io/storj/satellite/metabase/bucketinventory"
+	"storj.io/uplink"
+)
+
+func TestChore(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.BucketInventory.Enabled = true
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		upl := planet.Uplinks[0]
+		chore := sat.Core.BucketInventory.Chore
+
+		chore.Loop.Pause()
+
+		now := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+		chore.TestingSetNow(func() time.Time { return now })
+		chore.TestingSetSatelliteURL(sat.NodeURL())
+
+		require.NoError(t, upl.CreateBucket(ctx, sat, "source"))
+		require.NoError(t, upl.CreateBucket(ctx, sat, "reports"))
+		require.NoError(t, upl.Upload(ctx, sat, "source", "a", testrand.Bytes(1*memory.KiB)))
+		require.NoError(t, upl.Upload(ctx, sat, "source", "b/c", testrand.Bytes(2*memory.KiB)))
+
+		apiKey, err := bucketinventory.RestrictAPIKey(upl.APIKey[sat.ID()], buckets.InventoryConfiguration{DestinationBucket: "reports"})
+		require.NoError(t, err)
+
+		config := buckets.InventoryConfiguration{
+			ID:                "daily",
+			DestinationBucket: "reports",
+			DestinationPrefix: "inventory/",
+			Format:            buckets.InventoryCSV,
+			Frequency:         buckets.InventoryDaily,
+			IncludedVersions:  buckets.InventoryCurrentVersions,
+			APIKey:            apiKey.Serialize(),
+			ReportPassphrase:  "passphrase",
+		}
+		require.NoError(t, sat.DB.Buckets().SetBucketInventory(ctx, []byte("source"), upl.Projects[0].ID, config))
+
+		chore.Loop.TriggerWait()
+
+		// the reports are encrypted with the passphrase of the configuration.
+		access, err := uplink.RequestAccessWithPassphrase(ctx, sat.URL(), upl.APIKey[sat.ID()].Serialize(), config.ReportPassphrase)
+		require.NoError(t, err)
+		project, err := uplink.OpenProject(ctx, access)
+		require.NoError(t, err)
+		defer ctx.Check(project.Close)
+
+		download := func(key string) ([]byte, error) {
+			download, err := project.DownloadObject(ctx, "reports", key, nil)
+			if err != nil {
+				return nil, err
+			}
+			defer ctx.Check(download.Close)
+			return io.ReadAll(download)
+		}
+
+		data, err := download("inventory/source/daily/2024-05-01T10-00Z/manifest.json")
+		require.NoError(t, err)
+
+		var manifest bucketinventory.Manifest
+		require.NoError(t, json.Unmarshal(data, &manifest))
+		require.Equal(t, "source", manifest.SourceBucket)
+		require.Equal(t, "reports", manifest.DestinationBucket)
+		require.Equal(t, "CSV", manifest.FileFormat)
+		require.Len(t, manifest.Files, 1)
+
+		compressed, err := download(manifest.Files[0].Key)
+		require.NoError(t, err)
+		require.EqualValues(t, len(compressed), manifest.Files[0].Size)
+		checksum := md5.Sum(compressed)
+		require.Equal(t, hex.EncodeToString(checksum[:]), manifest.Files[0].MD5Checksum)
+
+		gz, err := gzip.NewReader(bytes.NewReader(compressed))
+		require.NoError(t, err)
+		csvData, err := io.ReadAll(gz)
+		require.NoError(t, err)
+
+		rows, err := csv.NewReader(bytes.NewReader(csvData)).ReadAll()
+		require.NoError(t, err)
+		require.Len(t, rows, 2)
+		for _, row := range rows {
+			require.Len(t, row, len(bucketinventory.FileSchema))
+			require.Equal(t, "source", row[0])
+			require.Equal(t, "true", row[3])
+			require.Equal(t, "false", row[4])
+		}
+
+		// the next report isn't due until a day has passed.
+		lastRun := now
+		now = now.Add(time.Hour)
+		chore.Loop.TriggerWait()
+
+		_, err = download("inventory/source/daily/2024-05-01T11-00Z/manifest.json")
+		require.Error(t, err)
+
+		// a day later one object was replaced by another.
+		require.NoError(t, upl.DeleteObject(ctx, sat, "source", "a"))
+		require.NoError(t, upl.Upload(ctx, sat, "source", "d", testrand.Bytes(1*memory.KiB)))
+
+		now = lastRun.Add(24 * time.Hour)
+		chore.Loop.TriggerWait()
+
+		diff, err := bucketinventory.DiffReports(ctx, sat.NodeURL(), "source", config, lastRun, now, 10)
+		require.NoError(t, err)
+		require.EqualValues(t, 1, diff.AddedCount)
+		require.EqualValues(t, 1, diff.RemovedCount)
+		require.EqualValues(t, 0, diff.ChangedCount)
+
+		_, err = bucketinventory.DiffReports(ctx, sat.NodeURL(), "source", config, lastRun.Add(time.Hour), now, 10)
+		require.True(t, bucketinventory.ErrReportNotFound.Has(err), err)
+
+		inventories := []buckets.BucketInventory{}
+		require.NoError(t, sat.DB.Buckets().IterateBucketInventories(ctx, 10, func(page []buckets.BucketInventory) error {
+			inventories = append(inventories, page...)
+			return nil
+		}))
+		require.Len(t, inventories, 1)
+		require.NotNil(t, inventories[0].LastRunAt)
+		require.True(t, now.Equal(*inventories[0].LastRunAt))
+	})
+}
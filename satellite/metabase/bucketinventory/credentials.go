@@ -0,0 +1,71 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package bucketinventory
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+
+	"storj.io/common/macaroon"
+	"storj.io/common/storj"
+	"storj.io/storj/satellite/buckets"
+	"storj.io/uplink"
+)
+
+// APIKeyNamePrefix is the name prefix of the API keys issued for writing inventory reports.
+const APIKeyNamePrefix = ".storj-bucket-inventory-"
+
+// APIKeyName returns the name of the API key issued for the inventory reports of a bucket.
+func APIKeyName(bucketName string) string {
+	return APIKeyNamePrefix + bucketName
+}
+
+// RestrictAPIKey restricts an API key issued by the satellite to reading,
+// listing and writing in the destination bucket of the reports. Deleting
+// objects is not allowed.
+func RestrictAPIKey(key *macaroon.APIKey, config buckets.InventoryConfiguration) (*macaroon.APIKey, error) {
+	caveat, err := macaroon.WithNonce(macaroon.Caveat{
+		DisallowDeletes: true,
+		AllowedPaths: []*macaroon.Caveat_Path{{
+			Bucket: []byte(config.DestinationBucket),
+		}},
+	})
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	restricted, err := key.Restrict(caveat)
+	return restricted, Error.Wrap(err)
+}
+
+// NewReportPassphrase generates a random passphrase for encrypting the reports.
+func NewReportPassphrase() (string, error) {
+	var data [32]byte
+	if _, err := rand.Read(data[:]); err != nil {
+		return "", Error.Wrap(err)
+	}
+	return base64.RawURLEncoding.EncodeToString(data[:]), nil
+}
+
+// openProject opens the project of the destination bucket with the issued
+// credentials of the configuration. Only the local satellite is dialed.
+func openProject(ctx context.Context, satellite storj.NodeURL, config buckets.InventoryConfiguration) (_ *uplink.Project, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if satellite.ID.IsZero() || satellite.Address == "" {
+		return nil, Error.New("satellite address is not configured")
+	}
+	if err := config.ValidateCredentials(); err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	access, err := uplink.RequestAccessWithPassphrase(ctx, satellite.String(), config.APIKey, config.ReportPassphrase)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	project, err := uplink.OpenProject(ctx, access)
+	return project, Error.Wrap(err)
+}
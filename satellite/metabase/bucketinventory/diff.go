@@ -0,0 +1,361 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package bucketinventory
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/storj"
+	"storj.io/storj/satellite/buckets"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/uplink"
+)
+
+var (
+	// ErrReportNotFound is returned when a report does not exist in the destination bucket.
+	ErrReportNotFound = errs.Class("inventory report not found")
+	// ErrReportAccessDenied is returned when the API key issued for the configuration does not allow reading reports.
+	ErrReportAccessDenied = errs.Class("inventory report access denied")
+)
+
+// ParseReportTime parses the creation time of a report in the format used for its directory name.
+func ParseReportTime(s string) (time.Time, error) {
+	return time.Parse(timestampLayout, s)
+}
+
+// ParseRecord parses a data file row in FileSchema order.
+func ParseRecord(fields []string) (record Record, err error) {
+	if len(fields) != len(FileSchema) {
+		return Record{}, Error.New("expected %d columns, got %d", len(FileSchema), len(fields))
+	}
+
+	key, err := url.QueryUnescape(fields[1])
+	if err != nil {
+		return Record{}, Error.Wrap(err)
+	}
+	record.Bucket = fields[0]
+	record.Key = metabase.ObjectKey(key)
+	if record.VersionID, err = hex.DecodeString(fields[2]); err != nil {
+		return Record{}, Error.Wrap(err)
+	}
+	if record.IsLatest, err = strconv.ParseBool(fields[3]); err != nil {
+		return Record{}, Error.Wrap(err)
+	}
+	if record.IsDeleteMarker, err = strconv.ParseBool(fields[4]); err != nil {
+		return Record{}, Error.Wrap(err)
+	}
+	if record.Size, err = strconv.ParseInt(fields[5], 10, 64); err != nil {
+		return Record{}, Error.Wrap(err)
+	}
+	if record.LastModified, err = time.Parse(time.RFC3339Nano, fields[6]); err != nil {
+		return Record{}, Error.Wrap(err)
+	}
+	record.StorageClass = fields[7]
+	record.EncryptionStatus = fields[8]
+	return record, nil
+}
+
+// RecordIterator iterates over the records of a report in the order they were written.
+type RecordIterator interface {
+	// Next returns the next record. ok is false when there are no more records.
+	Next() (record Record, ok bool, err error)
+}
+
+// DiffEntry describes an object version which differs between two reports.
+type DiffEntry struct {
+	// Key is the URL encoded encrypted object key, as it appears in the reports.
+	Key            string    `json:"key"`
+	VersionID      string    `json:"versionId"`
+	IsLatest       bool      `json:"isLatest"`
+	IsDeleteMarker bool      `json:"isDeleteMarker"`
+	Size           int64     `json:"size"`
+	LastModified   time.Time `json:"lastModified"`
+}
+
+// DiffChange is an object which is present in both reports with different attributes.
+type DiffChange struct {
+	Before DiffEntry `json:"before"`
+	After  DiffEntry `json:"after"`
+}
+
+// Diff is the difference between two reports. The counts always cover the whole
+// reports, while the lists contain at most the requested number of entries.
+type Diff struct {
+	AddedCount   int64 `json:"addedCount"`
+	RemovedCount int64 `json:"removedCount"`
+	ChangedCount int64 `json:"changedCount"`
+
+	Added     []DiffEntry  `json:"added"`
+	Removed   []DiffEntry  `json:"removed"`
+	Changed   []DiffChange `json:"changed"`
+	Truncated bool         `json:"truncated"`
+}
+
+// DiffRecords compares two reports of the same bucket. When byKey is set, records
+// are matched by object key only, so that an overwritten object shows up as changed.
+// Otherwise they are matched by object key and version.
+//
+// Both reports must be ordered by key ascending and version descending, which is
+// the order the chore writes them in, so the comparison needs a single pass.
+func DiffRecords(older, newer RecordIterator, byKey bool, limit int) (_ *Diff, err error) {
+	diff := &Diff{
+		Added:   []DiffEntry{},
+		Removed: []DiffEntry{},
+		Changed: []DiffChange{},
+	}
+	room := func(n int) bool {
+		if n < limit {
+			return true
+		}
+		diff.Truncated = true
+		return false
+	}
+
+	olderIt := &sortedIterator{it: older, byKey: byKey}
+	newerIt := &sortedIterator{it: newer, byKey: byKey}
+
+	before, hasBefore, err := olderIt.Next()
+	if err != nil {
+		return nil, err
+	}
+	after, hasAfter, err := newerIt.Next()
+	if err != nil {
+		return nil, err
+	}
+
+	for hasBefore || hasAfter {
+		cmp := 0
+		switch {
+		case !hasBefore:
+			cmp = 1
+		case !hasAfter:
+			cmp = -1
+		default:
+			cmp = compareRecords(before, after, byKey)
+		}
+
+		switch {
+		case cmp < 0:
+			diff.RemovedCount++
+			if room(len(diff.Removed)) {
+				diff.Removed = append(diff.Removed, before.diffEntry())
+			}
+		case cmp > 0:
+			diff.AddedCount++
+			if room(len(diff.Added)) {
+				diff.Added = append(diff.Added, after.diffEntry())
+			}
+		default:
+			if before.changed(after) {
+				diff.ChangedCount++
+				if room(len(diff.Changed)) {
+					diff.Changed = append(diff.Changed, DiffChange{Before: before.diffEntry(), After: after.diffEntry()})
+				}
+			}
+		}
+
+		if cmp <= 0 {
+			if before, hasBefore, err = olderIt.Next(); err != nil {
+				return nil, err
+			}
+		}
+		if cmp >= 0 {
+			if after, hasAfter, err = newerIt.Next(); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return diff, nil
+}
+
+// compareRecords orders records by key ascending and, unless byKey is set, by version descending.
+func compareRecords(a, b Record, byKey bool) int {
+	if cmp := bytes.Compare([]byte(a.Key), []byte(b.Key)); cmp != 0 || byKey {
+		return cmp
+	}
+	// version IDs start with the big endian version, so they sort like the version.
+	return -bytes.Compare(a.VersionID, b.VersionID)
+}
+
+func (record *Record) changed(other Record) bool {
+	return !bytes.Equal(record.VersionID, other.VersionID) ||
+		record.IsLatest != other.IsLatest ||
+		record.IsDeleteMarker != other.IsDeleteMarker ||
+		record.Size != other.Size
+}
+
+func (record *Record) diffEntry() DiffEntry {
+	return DiffEntry{
+		Key:            url.QueryEscape(string(record.Key)),
+		VersionID:      hex.EncodeToString(record.VersionID),
+		IsLatest:       record.IsLatest,
+		IsDeleteMarker: record.IsDeleteMarker,
+		Size:           record.Size,
+		LastModified:   record.LastModified,
+	}
+}
+
+// sortedIterator fails when the wrapped records are not in the order DiffRecords relies on.
+type sortedIterator struct {
+	it       RecordIterator
+	byKey    bool
+	previous Record
+	started  bool
+}
+
+func (sorted *sortedIterator) Next() (Record, bool, error) {
+	record, ok, err := sorted.it.Next()
+	if err != nil || !ok {
+		return Record{}, false, err
+	}
+	if sorted.started && compareRecords(sorted.previous, record, sorted.byKey) >= 0 {
+		return Record{}, false, Error.New("report is not sorted at key %q", url.QueryEscape(string(record.Key)))
+	}
+	sorted.previous, sorted.started = record, true
+	return record, true, nil
+}
+
+// DiffReports compares the reports of a bucket created at the specified times.
+// The reports are downloaded through the specified satellite, which must be the
+// local one, with the credentials issued for the configuration.
+func DiffReports(ctx context.Context, satellite storj.NodeURL, bucketName string, config buckets.InventoryConfiguration, from, to time.Time, limit int) (_ *Diff, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	project, err := openProject(ctx, satellite, config)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = errs.Combine(err, project.Close()) }()
+
+	older, err := openReport(ctx, project, config.DestinationBucket, ManifestKey(bucketName, config, from))
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = errs.Combine(err, older.Close()) }()
+
+	newer, err := openReport(ctx, project, config.DestinationBucket, ManifestKey(bucketName, config, to))
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = errs.Combine(err, newer.Close()) }()
+
+	return DiffRecords(older, newer, config.IncludedVersions == buckets.InventoryCurrentVersions, limit)
+}
+
+// reportReader reads the records of all data files of a report one after another.
+type reportReader struct {
+	ctx     context.Context
+	project *uplink.Project
+	bucket  string
+	files   []ManifestFile
+
+	download *uplink.Download
+	gz       *gzip.Reader
+	rows     *csv.Reader
+}
+
+func openReport(ctx context.Context, project *uplink.Project, bucket, manifestKey string) (_ *reportReader, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	download, err := project.DownloadObject(ctx, bucket, manifestKey, nil)
+	if err != nil {
+		if errors.Is(err, uplink.ErrObjectNotFound) {
+			return nil, ErrReportNotFound.New("%s", manifestKey)
+		}
+		if errors.Is(err, uplink.ErrPermissionDenied) {
+			return nil, ErrReportAccessDenied.New("%s", manifestKey)
+		}
+		return nil, Error.Wrap(err)
+	}
+	data, err := io.ReadAll(download)
+	err = errs.Combine(err, download.Close())
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	var manifest Manifest
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return &reportReader{
+		ctx:     ctx,
+		project: project,
+		bucket:  bucket,
+		files:   manifest.Files,
+	}, nil
+}
+
+// Next implements RecordIterator.
+func (reader *reportReader) Next() (Record, bool, error) {
+	for {
+		if reader.rows == nil {
+			if len(reader.files) == 0 {
+				return Record{}, false, nil
+			}
+			if err := reader.openFile(reader.files[0].Key); err != nil {
+				return Record{}, false, err
+			}
+			reader.files = reader.files[1:]
+		}
+
+		fields, err := reader.rows.Read()
+		if errors.Is(err, io.EOF) {
+			if err := reader.closeFile(); err != nil {
+				return Record{}, false, err
+			}
+			continue
+		}
+		if err != nil {
+			return Record{}, false, Error.Wrap(err)
+		}
+
+		record, err := ParseRecord(fields)
+		return record, err == nil, err
+	}
+}
+
+func (reader *reportReader) openFile(key string) (err error) {
+	reader.download, err = reader.project.DownloadObject(reader.ctx, reader.bucket, key, nil)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	reader.gz, err = gzip.NewReader(reader.download)
+	if err != nil {
+		return Error.Wrap(errs.Combine(err, reader.closeFile()))
+	}
+	reader.rows = csv.NewReader(reader.gz)
+	reader.rows.FieldsPerRecord = len(FileSchema)
+	return nil
+}
+
+func (reader *reportReader) closeFile() error {
+	var group errs.Group
+	if reader.gz != nil {
+		group.Add(reader.gz.Close())
+	}
+	if reader.download != nil {
+		group.Add(reader.download.Close())
+	}
+	reader.download, reader.gz, reader.rows = nil, nil, nil
+	return Error.Wrap(group.Err())
+}
+
+// Close closes the data file which is currently being read.
+func (reader *reportReader) Close() error {
+	return reader.closeFile()
+}
@@ -0,0 +1,104 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package bucketinventory_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/bucketinventory"
+)
+
+type sliceIterator []bucketinventory.Record
+
+func (it *sliceIterator) Next() (bucketinventory.Record, bool, error) {
+	if len(*it) == 0 {
+		return bucketinventory.Record{}, false, nil
+	}
+	record := (*it)[0]
+	*it = (*it)[1:]
+	return record, true, nil
+}
+
+func record(key string, version byte, size int64) bucketinventory.Record {
+	return bucketinventory.Record{
+		Bucket:       "bucket",
+		Key:          metabase.ObjectKey(key),
+		VersionID:    []byte{0, 0, 0, 0, 0, 0, 0, version, 1, 2, 3, 4, 5, 6, 7, 8},
+		IsLatest:     true,
+		Size:         size,
+		LastModified: time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestParseRecord(t *testing.T) {
+	original := record("a/b c?d", 3, 1024)
+	original.StorageClass = "STANDARD"
+	original.EncryptionStatus = "AES128-GCM"
+
+	parsed, err := bucketinventory.ParseRecord(original.Fields())
+	require.NoError(t, err)
+	require.Equal(t, original, parsed)
+
+	_, err = bucketinventory.ParseRecord([]string{"bucket"})
+	require.Error(t, err)
+}
+
+func TestDiffRecords(t *testing.T) {
+	t.Run("by key", func(t *testing.T) {
+		older := sliceIterator{record("a", 1, 10), record("b", 1, 10), record("c", 1, 10)}
+		newer := sliceIterator{record("b", 1, 10), record("c", 2, 20), record("d", 1, 10)}
+
+		diff, err := bucketinventory.DiffRecords(&older, &newer, true, 10)
+		require.NoError(t, err)
+		require.EqualValues(t, 1, diff.AddedCount)
+		require.EqualValues(t, 1, diff.RemovedCount)
+		require.EqualValues(t, 1, diff.ChangedCount)
+		require.False(t, diff.Truncated)
+
+		require.Equal(t, "d", diff.Added[0].Key)
+		require.Equal(t, "a", diff.Removed[0].Key)
+		require.Equal(t, "c", diff.Changed[0].Before.Key)
+		require.EqualValues(t, 10, diff.Changed[0].Before.Size)
+		require.EqualValues(t, 20, diff.Changed[0].After.Size)
+	})
+
+	t.Run("by version", func(t *testing.T) {
+		noncurrent := record("a", 1, 10)
+		noncurrent.IsLatest = false
+
+		older := sliceIterator{record("a", 1, 10)}
+		newer := sliceIterator{record("a", 2, 20), noncurrent}
+
+		diff, err := bucketinventory.DiffRecords(&older, &newer, false, 10)
+		require.NoError(t, err)
+		require.EqualValues(t, 1, diff.AddedCount)
+		require.EqualValues(t, 0, diff.RemovedCount)
+		require.EqualValues(t, 1, diff.ChangedCount)
+		require.True(t, diff.Changed[0].Before.IsLatest)
+		require.False(t, diff.Changed[0].After.IsLatest)
+	})
+
+	t.Run("limit", func(t *testing.T) {
+		older := sliceIterator{}
+		newer := sliceIterator{record("a", 1, 10), record("b", 1, 10), record("c", 1, 10)}
+
+		diff, err := bucketinventory.DiffRecords(&older, &newer, true, 2)
+		require.NoError(t, err)
+		require.EqualValues(t, 3, diff.AddedCount)
+		require.Len(t, diff.Added, 2)
+		require.True(t, diff.Truncated)
+	})
+
+	t.Run("unsorted", func(t *testing.T) {
+		older := sliceIterator{record("b", 1, 10), record("a", 1, 10)}
+		newer := sliceIterator{}
+
+		_, err := bucketinventory.DiffRecords(&older, &newer, true, 10)
+		require.Error(t, err)
+	})
+}
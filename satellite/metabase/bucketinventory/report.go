@@ -0,0 +1,92 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package bucketinventory
+
+import (
+	"encoding/hex"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"storj.io/storj/satellite/buckets"
+	"storj.io/storj/satellite/metabase"
+)
+
+// ManifestVersion is the version of the manifest format, matching S3 inventory.
+const ManifestVersion = "2016-11-30"
+
+// timestampLayout is the format of the directory names of the individual reports.
+const timestampLayout = "2006-01-02T15-04Z"
+
+// FileSchema lists the columns of every data file row.
+var FileSchema = []string{
+	"Bucket", "Key", "VersionId", "IsLatest", "IsDeleteMarker",
+	"Size", "LastModifiedDate", "StorageClass", "EncryptionStatus",
+}
+
+// Manifest describes a single inventory report, using the same fields as S3 inventory.
+type Manifest struct {
+	SourceBucket      string         `json:"sourceBucket"`
+	DestinationBucket string         `json:"destinationBucket"`
+	Version           string         `json:"version"`
+	CreationTimestamp string         `json:"creationTimestamp"`
+	FileFormat        string         `json:"fileFormat"`
+	FileSchema        string         `json:"fileSchema"`
+	Files             []ManifestFile `json:"files"`
+}
+
+// ManifestFile is a data file of an inventory report.
+type ManifestFile struct {
+	Key         string `json:"key"`
+	Size        int64  `json:"size"`
+	MD5Checksum string `json:"MD5checksum"`
+}
+
+// ReportPrefix returns the key prefix in the destination bucket under which the
+// reports of a bucket are written.
+func ReportPrefix(bucketName string, config buckets.InventoryConfiguration) string {
+	return config.DestinationPrefix + bucketName + "/" + config.ID + "/"
+}
+
+// ManifestKey returns the key of the manifest of the report created at the specified time.
+func ManifestKey(bucketName string, config buckets.InventoryConfiguration, createdAt time.Time) string {
+	return ReportPrefix(bucketName, config) + createdAt.UTC().Format(timestampLayout) + "/manifest.json"
+}
+
+// Record is a single row of an inventory report.
+type Record struct {
+	Bucket           string
+	Key              metabase.ObjectKey
+	VersionID        []byte
+	IsLatest         bool
+	IsDeleteMarker   bool
+	Size             int64
+	LastModified     time.Time
+	StorageClass     string
+	EncryptionStatus string
+}
+
+// Fields returns the columns of the record in FileSchema order.
+//
+// Keys are URL encoded, as in S3 inventory, since encrypted keys may contain
+// bytes which are not valid in CSV.
+func (record *Record) Fields() []string {
+	return []string{
+		record.Bucket,
+		url.QueryEscape(string(record.Key)),
+		hex.EncodeToString(record.VersionID),
+		strconv.FormatBool(record.IsLatest),
+		strconv.FormatBool(record.IsDeleteMarker),
+		strconv.FormatInt(record.Size, 10),
+		record.LastModified.UTC().Format(time.RFC3339Nano),
+		record.StorageClass,
+		record.EncryptionStatus,
+	}
+}
+
+// fileSchema returns FileSchema in the manifest representation.
+func fileSchema() string {
+	return strings.Join(FileSchema, ", ")
+}
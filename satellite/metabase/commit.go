@@ -27,7 +27,9 @@ import (
 // ValidatePlainSize determines whether we disable PlainSize validation for old uplinks.
 const ValidatePlainSize = false
 
-const defaultZombieDeletionPeriod = 24 * time.Hour
+// DefaultZombieDeletionPeriod is how long a pending object may stay inactive
+// before it becomes a zombie, unless the client requests another deadline.
+const DefaultZombieDeletionPeriod = 24 * time.Hour
 
 var (
 	// ErrObjectNotFound is used to indicate that the object does not exist.
@@ -89,7 +91,7 @@ func (db *DB) BeginObjectNextVersion(ctx context.Context, opts BeginObjectNextVe
 	}
 
 	if opts.ZombieDeletionDeadline == nil {
-		deadline := time.Now().Add(defaultZombieDeletionPeriod)
+		deadline := time.Now().Add(DefaultZombieDeletionPeriod)
 		opts.ZombieDeletionDeadline = &deadline
 	}
 
@@ -116,7 +118,11 @@ func (db *DB) BeginObjectNextVersion(ctx context.Context, opts BeginObjectNextVe
 }
 
 // BeginObjectNextVersion implements Adapter.
-func (p *PostgresAdapter) BeginObjectNextVersion(ctx context.Context, opts BeginObjectNextVersion, object *Object) error {
+func (p *PostgresAdapter) BeginObjectNextVersion(ctx context.Context, opts BeginObjectNextVersion, object *Object) (err error) {
+	const tag = queryTag("begin_object_next_version")
+	done := tag.observe(p.impl.String())
+	defer func() { done(rowsFound(err), err) }()
+
 	return p.db.QueryRowContext(ctx, `
 			INSERT INTO objects (
 				project_id, bucket_name, object_key, version, stream_id,
@@ -144,8 +150,12 @@ func (p *PostgresAdapter) BeginObjectNextVersion(ctx context.Context, opts Begin
 }
 
 // BeginObjectNextVersion implements Adapter.
-func (s *SpannerAdapter) BeginObjectNextVersion(ctx context.Context, opts BeginObjectNextVersion, object *Object) error {
-	_, err := s.client.ReadWriteTransaction(ctx, func(ctx context.Context, txn *spanner.ReadWriteTransaction) error {
+func (s *SpannerAdapter) BeginObjectNextVersion(ctx context.Context, opts BeginObjectNextVersion, object *Object) (err error) {
+	const tag = queryTag("begin_object_next_version")
+	done := tag.observe(s.Name())
+	defer func() { done(rowsFound(err), err) }()
+
+	_, err = s.client.ReadWriteTransaction(ctx, func(ctx context.Context, txn *spanner.ReadWriteTransaction) error {
 		enc, err := encryptionParameters{&opts.Encryption}.Value()
 		if err != nil {
 			return Error.Wrap(err)
@@ -243,7 +253,7 @@ func (db *DB) TestingBeginObjectExactVersion(ctx context.Context, opts BeginObje
 	}
 
 	if opts.ZombieDeletionDeadline == nil {
-		deadline := time.Now().Add(defaultZombieDeletionPeriod)
+		deadline := time.Now().Add(DefaultZombieDeletionPeriod)
 		opts.ZombieDeletionDeadline = &deadline
 	}
 
@@ -405,6 +415,10 @@ func (db *DB) BeginSegment(ctx context.Context, opts BeginSegment) (err error) {
 
 // PendingObjectExists checks whether an object already exists.
 func (p *PostgresAdapter) PendingObjectExists(ctx context.Context, opts BeginSegment) (exists bool, err error) {
+	const tag = queryTag("pending_object_exists")
+	done := tag.observe(p.impl.String())
+	defer func() { done(rowsExist(exists), err) }()
+
 	err = p.db.QueryRowContext(ctx, `
 		SELECT EXISTS (
 			SELECT 1
@@ -418,6 +432,10 @@ func (p *PostgresAdapter) PendingObjectExists(ctx context.Context, opts BeginSeg
 
 // PendingObjectExists checks whether an object already exists.
 func (s *SpannerAdapter) PendingObjectExists(ctx context.Context, opts BeginSegment) (exists bool, err error) {
+	const tag = queryTag("pending_object_exists")
+	done := tag.observe(s.Name())
+	defer func() { done(rowsExist(exists), err) }()
+
 	result := s.client.Single().Query(ctx, spanner.Statement{
 		SQL: `
 			SELECT EXISTS (
@@ -540,6 +558,10 @@ func (db *DB) CommitSegment(ctx context.Context, opts CommitSegment) (err error)
 func (p *PostgresAdapter) CommitPendingObjectSegment(ctx context.Context, opts CommitSegment, aliasPieces AliasPieces) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	const tag = queryTag("commit_pending_object_segment")
+	done := tag.observe(p.impl.String())
+	defer func() { done(rowsFound(err), err) }()
+
 	// Verify that object exists and is partial.
 	_, err = p.db.ExecContext(ctx, `
 		INSERT INTO segments (
@@ -590,6 +612,10 @@ func (p *PostgresAdapter) CommitPendingObjectSegment(ctx context.Context, opts C
 func (p *CockroachAdapter) CommitPendingObjectSegment(ctx context.Context, opts CommitSegment, aliasPieces AliasPieces) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	const tag = queryTag("commit_pending_object_segment")
+	done := tag.observe(p.impl.String())
+	defer func() { done(rowsFound(err), err) }()
+
 	switch opts.mode {
 	case commitSegmentModeTransaction:
 		err = txutil.WithTx(ctx, p.db, nil, func(ctx context.Context, tx tagsql.Tx) error {
@@ -701,6 +727,10 @@ func (p *CockroachAdapter) CommitPendingObjectSegment(ctx context.Context, opts
 func (s *SpannerAdapter) CommitPendingObjectSegment(ctx context.Context, opts CommitSegment, aliasPieces AliasPieces) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	const tag = queryTag("commit_pending_object_segment")
+	done := tag.observe(s.Name())
+	defer func() { done(rowsFound(err), err) }()
+
 	var numRows int64
 	_, err = s.client.ReadWriteTransaction(ctx, func(ctx context.Context, txn *spanner.ReadWriteTransaction) error {
 		stmt := spanner.Statement{
@@ -830,6 +860,10 @@ func (db *DB) CommitInlineSegment(ctx context.Context, opts CommitInlineSegment)
 
 // CommitInlineSegment commits inline segment to the database.
 func (p *PostgresAdapter) CommitInlineSegment(ctx context.Context, opts CommitInlineSegment) (err error) {
+	const tag = queryTag("commit_inline_segment")
+	done := tag.observe(p.impl.String())
+	defer func() { done(rowsFound(err), err) }()
+
 	_, err = p.db.ExecContext(ctx, `
 			INSERT INTO segments (
 				stream_id, position, expires_at,
@@ -871,6 +905,10 @@ func (p *PostgresAdapter) CommitInlineSegment(ctx context.Context, opts CommitIn
 
 // CommitInlineSegment commits inline segment to the database.
 func (p *CockroachAdapter) CommitInlineSegment(ctx context.Context, opts CommitInlineSegment) (err error) {
+	const tag = queryTag("commit_inline_segment")
+	done := tag.observe(p.impl.String())
+	defer func() { done(rowsFound(err), err) }()
+
 	switch opts.mode {
 	case commitSegmentModeTransaction:
 		err = txutil.WithTx(ctx, p.db, nil, func(ctx context.Context, tx tagsql.Tx) error {
@@ -970,6 +1008,10 @@ func (p *CockroachAdapter) CommitInlineSegment(ctx context.Context, opts CommitI
 
 // CommitInlineSegment commits inline segment to the database.
 func (s *SpannerAdapter) CommitInlineSegment(ctx context.Context, opts CommitInlineSegment) (err error) {
+	const tag = queryTag("commit_inline_segment")
+	done := tag.observe(s.Name())
+	defer func() { done(rowsFound(err), err) }()
+
 	_, err = s.client.ReadWriteTransaction(ctx, func(ctx context.Context, txn *spanner.ReadWriteTransaction) error {
 		stmt := spanner.Statement{
 			SQL: `
@@ -1038,6 +1080,9 @@ type CommitObject struct {
 
 	// Versioned indicates whether an object is allowed to have multiple versions.
 	Versioned bool
+
+	// Quota, when set, limits the number of objects and segments in the project.
+	Quota *PrecommitQuota
 }
 
 // Verify verifies request fields.
@@ -1086,8 +1131,11 @@ func (db *DB) CommitObject(ctx context.Context, opts CommitObject) (object Objec
 		return Object{}, err
 	}
 
+	adapter := db.ChooseAdapter(opts.ProjectID)
+	trace := db.tracePrecommit(adapter, "commit_object", opts.Location())
+
 	var precommit PrecommitConstraintResult
-	err = db.ChooseAdapter(opts.ProjectID).WithTx(ctx, func(ctx context.Context, adapter TransactionAdapter) error {
+	err = adapter.withTxStats(ctx, "commit_object", trace.wrap(func(ctx context.Context, adapter TransactionAdapter) error {
 		segments, err := adapter.fetchSegmentsForCommit(ctx, opts.StreamID)
 		if err != nil {
 			return Error.New("failed to fetch segments: %w", err)
@@ -1131,10 +1179,13 @@ func (db *DB) CommitObject(ctx context.Context, opts CommitObject) (object Objec
 			Versioned:           opts.Versioned,
 			DisallowDelete:      opts.DisallowDelete,
 			PrecommitDeleteMode: db.config.TestingPrecommitDeleteMode,
+			Quota:               opts.Quota,
+			NewSegments:         int64(len(finalSegments)),
 		}, adapter)
 		if err != nil {
 			return err
 		}
+		trace.deleted(precommit.DeletedObjectCount, precommit.DeletedSegmentCount)
 
 		nextVersion := opts.Version
 		if nextVersion < precommit.HighestVersion {
@@ -1157,7 +1208,8 @@ func (db *DB) CommitObject(ctx context.Context, opts CommitObject) (object Objec
 		object.TotalEncryptedSize = totalEncryptedSize
 		object.FixedSegmentSize = fixedSegmentSize
 		return nil
-	})
+	}))
+	trace.finish(err)
 	if err != nil {
 		return Object{}, err
 	}
@@ -1409,6 +1461,9 @@ type CommitInlineObject struct {
 
 	// Versioned indicates whether an object is allowed to have multiple versions.
 	Versioned bool
+
+	// Quota, when set, limits the number of objects and segments in the project.
+	Quota *PrecommitQuota
 }
 
 // Verify verifies reqest fields.
@@ -1442,16 +1497,22 @@ func (db *DB) CommitInlineObject(ctx context.Context, opts CommitInlineObject) (
 		return Object{}, err
 	}
 
+	adapter := db.ChooseAdapter(opts.ProjectID)
+	trace := db.tracePrecommit(adapter, "commit_inline_object", opts.Location())
+
 	var precommit PrecommitConstraintResult
-	err = db.ChooseAdapter(opts.ProjectID).WithTx(ctx, func(ctx context.Context, adapter TransactionAdapter) error {
+	err = adapter.withTxStats(ctx, "commit_inline_object", trace.wrap(func(ctx context.Context, adapter TransactionAdapter) error {
 		precommit, err = db.PrecommitConstraint(ctx, PrecommitConstraint{
 			Location:       opts.Location(),
 			Versioned:      opts.Versioned,
 			DisallowDelete: opts.DisallowDelete,
+			Quota:          opts.Quota,
+			NewSegments:    1,
 		}, adapter)
 		if err != nil {
 			return err
 		}
+		trace.deleted(precommit.DeletedObjectCount, precommit.DeletedSegmentCount)
 
 		nextVersion := precommit.HighestVersion + 1
 		nextStatus := committedWhereVersioned(opts.Versioned)
@@ -1484,7 +1545,8 @@ func (db *DB) CommitInlineObject(ctx context.Context, opts CommitInlineObject) (
 		}
 
 		return adapter.finalizeInlineObjectCommit(ctx, &object, segment)
-	})
+	}))
+	trace.finish(err)
 	if err != nil {
 		return Object{}, err
 	}
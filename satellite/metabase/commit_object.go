@@ -61,8 +61,11 @@ func (db *DB) CommitObjectWithSegments(ctx context.Context, opts CommitObjectWit
 		return Object{}, nil, err
 	}
 
+	adapter := db.ChooseAdapter(opts.ProjectID)
+	trace := db.tracePrecommit(adapter, "commit_object_with_segments", opts.Location())
+
 	var precommit PrecommitConstraintResult
-	err = db.ChooseAdapter(opts.ProjectID).WithTx(ctx, func(ctx context.Context, adapter TransactionAdapter) error {
+	err = adapter.withTxStats(ctx, "commit_object_with_segments", trace.wrap(func(ctx context.Context, adapter TransactionAdapter) error {
 		// TODO: should we prevent this from executing when the object has been committed
 		// currently this requires quite a lot of database communication, so invalid handling can be expensive.
 
@@ -75,6 +78,7 @@ func (db *DB) CommitObjectWithSegments(ctx context.Context, opts CommitObjectWit
 		if err != nil {
 			return err
 		}
+		trace.deleted(precommit.DeletedObjectCount, precommit.DeletedSegmentCount)
 
 		segmentsInDatabase, err := adapter.fetchSegmentsForCommit(ctx, opts.StreamID)
 		if err != nil {
@@ -143,7 +147,8 @@ func (db *DB) CommitObjectWithSegments(ctx context.Context, opts CommitObjectWit
 		object.TotalEncryptedSize = totalEncryptedSize
 		object.FixedSegmentSize = fixedSegmentSize
 		return nil
-	})
+	}))
+	trace.finish(err)
 	if err != nil {
 		return Object{}, nil, err
 	}
@@ -531,6 +531,11 @@ func (status ObjectStatus) IsDeleteMarker() bool {
 	return status == DeleteMarkerUnversioned || status == DeleteMarkerVersioned
 }
 
+// IsCommitted returns whether the status is a committed object, delete markers excluded.
+func (status ObjectStatus) IsCommitted() bool {
+	return status == CommittedUnversioned || status == CommittedVersioned
+}
+
 // String returns textual representation of status.
 func (status ObjectStatus) String() string {
 	switch status {
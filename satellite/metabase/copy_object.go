@@ -153,72 +153,115 @@ func (db *DB) FinishCopyObject(ctx context.Context, opts FinishCopyObject) (obje
 		return Object{}, err
 	}
 
-	newObject := Object{}
-	var copyMetadata []byte
-
+	var newObject Object
 	var precommit PrecommitConstraintResult
-	err = db.ChooseAdapter(opts.ProjectID).WithTx(ctx, func(ctx context.Context, adapter TransactionAdapter) error {
-		sourceObject, err := adapter.getObjectNonPendingExactVersion(ctx, opts)
-		if err != nil {
-			if ErrObjectNotFound.Has(err) {
-				return ErrObjectNotFound.New("source object not found")
-			}
-			return err
-		}
-		if sourceObject.StreamID != opts.StreamID {
-			return ErrObjectNotFound.New("object was changed during copy")
-		}
-		if sourceObject.Status.IsDeleteMarker() {
-			return ErrMethodNotAllowed.New("copying delete marker is not allowed")
-		}
+	err = db.ChooseAdapter(opts.ProjectID).withTxStats(ctx, "finish_copy_object", func(ctx context.Context, adapter TransactionAdapter) (err error) {
+		newObject, precommit, err = db.finishCopyObject(ctx, adapter, opts)
+		return err
+	})
 
-		if opts.VerifyLimits != nil {
-			err := opts.VerifyLimits(sourceObject.TotalEncryptedSize, int64(sourceObject.SegmentCount))
-			if err != nil {
-				return err
-			}
-		}
+	if err != nil {
+		return Object{}, err
+	}
+
+	precommit.submitMetrics()
+	mon.Meter("finish_copy_object").Mark(1)
+
+	return newObject, nil
+}
 
-		if int(sourceObject.SegmentCount) != len(opts.NewSegmentKeys) {
-			return ErrInvalidRequest.New("wrong number of segments keys received (received %d, need %d)", len(opts.NewSegmentKeys), sourceObject.SegmentCount)
+// finishCopyObject copies the object within the transaction of the adapter.
+func (db *DB) finishCopyObject(ctx context.Context, adapter TransactionAdapter, opts FinishCopyObject) (newObject Object, precommit PrecommitConstraintResult, err error) {
+	source, err := db.prepareCopyObject(ctx, adapter, opts)
+	if err != nil {
+		return Object{}, PrecommitConstraintResult{}, err
+	}
+
+	precommit, err = db.PrecommitConstraint(ctx, PrecommitConstraint{
+		Location:       opts.NewLocation(),
+		Versioned:      opts.NewVersioned,
+		DisallowDelete: opts.NewDisallowDelete,
+	}, adapter)
+	if err != nil {
+		return Object{}, PrecommitConstraintResult{}, err
+	}
+
+	newObject, err = db.completeCopyObject(ctx, adapter, opts, source, precommit.HighestVersion+1)
+	if err != nil {
+		return Object{}, PrecommitConstraintResult{}, err
+	}
+	return newObject, precommit, nil
+}
+
+// copyObjectSource is the source of a copy, as read within the transaction.
+type copyObjectSource struct {
+	object   Object
+	segments transposedSegmentList
+	metadata []byte
+}
+
+// prepareCopyObject reads and validates the source of the copy. It doesn't modify anything.
+func (db *DB) prepareCopyObject(ctx context.Context, adapter TransactionAdapter, opts FinishCopyObject) (source copyObjectSource, err error) {
+	sourceObject, err := adapter.getObjectNonPendingExactVersion(ctx, opts)
+	if err != nil {
+		if ErrObjectNotFound.Has(err) {
+			return copyObjectSource{}, ErrObjectNotFound.New("source object not found")
 		}
+		return copyObjectSource{}, err
+	}
+	if sourceObject.StreamID != opts.StreamID {
+		return copyObjectSource{}, ErrObjectNotFound.New("object was changed during copy")
+	}
+	if sourceObject.Status.IsDeleteMarker() {
+		return copyObjectSource{}, ErrMethodNotAllowed.New("copying delete marker is not allowed")
+	}
 
-		newSegments, err := adapter.getSegmentsForCopy(ctx, sourceObject)
+	if opts.VerifyLimits != nil {
+		err := opts.VerifyLimits(sourceObject.TotalEncryptedSize, int64(sourceObject.SegmentCount))
 		if err != nil {
-			return Error.New("unable to copy object: %w", err)
+			return copyObjectSource{}, err
 		}
+	}
 
-		newSegments.EncryptedKeys = make([][]byte, len(opts.NewSegmentKeys))
-		newSegments.EncryptedKeyNonces = make([][]byte, len(opts.NewSegmentKeys))
-		for index, u := range opts.NewSegmentKeys {
-			if int64(u.Position.Encode()) != newSegments.Positions[index] {
-				return Error.New("missing new segment keys for segment %d", newSegments.Positions[index])
-			}
-			newSegments.EncryptedKeys[index] = u.EncryptedKey
-			newSegments.EncryptedKeyNonces[index] = u.EncryptedKeyNonce
-		}
+	if int(sourceObject.SegmentCount) != len(opts.NewSegmentKeys) {
+		return copyObjectSource{}, ErrInvalidRequest.New("wrong number of segments keys received (received %d, need %d)", len(opts.NewSegmentKeys), sourceObject.SegmentCount)
+	}
 
-		if opts.OverrideMetadata {
-			copyMetadata = opts.NewEncryptedMetadata
-		} else {
-			copyMetadata = sourceObject.EncryptedMetadata
-		}
+	newSegments, err := adapter.getSegmentsForCopy(ctx, sourceObject)
+	if err != nil {
+		return copyObjectSource{}, Error.New("unable to copy object: %w", err)
+	}
 
-		precommit, err = db.PrecommitConstraint(ctx, PrecommitConstraint{
-			Location:       opts.NewLocation(),
-			Versioned:      opts.NewVersioned,
-			DisallowDelete: opts.NewDisallowDelete,
-		}, adapter)
-		if err != nil {
-			return err
+	newSegments.EncryptedKeys = make([][]byte, len(opts.NewSegmentKeys))
+	newSegments.EncryptedKeyNonces = make([][]byte, len(opts.NewSegmentKeys))
+	for index, u := range opts.NewSegmentKeys {
+		if int64(u.Position.Encode()) != newSegments.Positions[index] {
+			return copyObjectSource{}, Error.New("missing new segment keys for segment %d", newSegments.Positions[index])
 		}
+		newSegments.EncryptedKeys[index] = u.EncryptedKey
+		newSegments.EncryptedKeyNonces[index] = u.EncryptedKeyNonce
+	}
+
+	var copyMetadata []byte
+	if opts.OverrideMetadata {
+		copyMetadata = opts.NewEncryptedMetadata
+	} else {
+		copyMetadata = sourceObject.EncryptedMetadata
+	}
 
-		newStatus := committedWhereVersioned(opts.NewVersioned)
+	return copyObjectSource{
+		object:   sourceObject,
+		segments: newSegments,
+		metadata: copyMetadata,
+	}, nil
+}
 
-		newObject, err = adapter.finalizeObjectCopy(ctx, opts, precommit.HighestVersion+1, newStatus, sourceObject, copyMetadata, newSegments)
-		return err
-	})
+// completeCopyObject inserts the copy of the prepared source with the specified version. The
+// precommit constraint must already be enforced for the new location.
+func (db *DB) completeCopyObject(ctx context.Context, adapter TransactionAdapter, opts FinishCopyObject, source copyObjectSource, version Version) (newObject Object, err error) {
+	newStatus := committedWhereVersioned(opts.NewVersioned)
 
+	newObject, err = adapter.finalizeObjectCopy(ctx, opts, version, newStatus, source.object, source.metadata, source.segments)
 	if err != nil {
 		return Object{}, err
 	}
@@ -226,15 +269,12 @@ func (db *DB) FinishCopyObject(ctx context.Context, opts FinishCopyObject) (obje
 	newObject.StreamID = opts.NewStreamID
 	newObject.BucketName = opts.NewBucket
 	newObject.ObjectKey = opts.NewEncryptedObjectKey
-	newObject.EncryptedMetadata = copyMetadata
+	newObject.EncryptedMetadata = source.metadata
 	newObject.EncryptedMetadataEncryptedKey = opts.NewEncryptedMetadataKey
 	if !opts.NewEncryptedMetadataKeyNonce.IsZero() {
 		newObject.EncryptedMetadataNonce = opts.NewEncryptedMetadataKeyNonce[:]
 	}
 
-	precommit.submitMetrics()
-	mon.Meter("finish_copy_object").Mark(1)
-
 	return newObject, nil
 }
 
@@ -44,6 +44,15 @@ type Config struct {
 	ServerSideCopyDisabled bool
 	UseListObjectsIterator bool
 
+	ReadReplica ReadReplicaConfig
+
+	SlowTransactions SlowTransactionConfig
+
+	// DeferSegmentDeletion enqueues the segments of deleted objects into
+	// deferred_segment_deletions instead of deleting them in the same
+	// transaction. They are deleted later by DeleteDeferredSegments.
+	DeferSegmentDeletion bool
+
 	TestingUniqueUnversioned   bool
 	TestingCommitSegmentMode   string
 	TestingPrecommitDeleteMode int
@@ -66,6 +75,10 @@ type DB struct {
 	config Config
 
 	adapters []Adapter
+
+	replica           Adapter
+	replicaDB         tagsql.DB
+	replicaOperations map[ReadOperation]bool
 }
 
 // Open opens a connection to metabase.
@@ -137,6 +150,10 @@ func Open(ctx context.Context, log *zap.Logger, connstr string, config Config) (
 		return nil, Error.New("unsupported implementation: %s", connstr)
 	}
 
+	if err := db.openReadReplica(ctx, config.ReadReplica); err != nil {
+		return nil, errs.Combine(err, db.Close())
+	}
+
 	if log.Level() == zap.DebugLevel {
 		log.Debug("Connected", zap.String("db source", logging.Redacted(connstr)))
 	}
@@ -180,6 +197,12 @@ func (db *DB) Close() error {
 			err = errs.Combine(err, Error.Wrap(c.Close()))
 		}
 	}
+	if db.replicaDB != nil {
+		err = errs.Combine(err, Error.Wrap(db.replicaDB.Close()))
+	}
+	if c, isCloser := db.replica.(io.Closer); isCloser {
+		err = errs.Combine(err, Error.Wrap(c.Close()))
+	}
 	return errs.Combine(err, db.testCleanup())
 }
 
@@ -188,9 +211,14 @@ func (db *DB) Close() error {
 // TODO: remove this, only for bootstrapping.
 func (db *DB) DestroyTables(ctx context.Context) error {
 	_, err := db.db.ExecContext(ctx, `
+		DROP TABLE IF EXISTS object_pins;
+		DROP TABLE IF EXISTS object_tags;
 		DROP TABLE IF EXISTS objects;
 		DROP TABLE IF EXISTS segments;
 		DROP TABLE IF EXISTS node_aliases;
+		DROP TABLE IF EXISTS object_events;
+		DROP TABLE IF EXISTS project_quota_counters;
+		DROP TABLE IF EXISTS deferred_segment_deletions;
 		DROP TABLE IF EXISTS metabase_versions;
 		DROP SEQUENCE IF EXISTS node_alias_seq;
 	`)
@@ -368,15 +396,130 @@ func (db *DB) TestMigrateToLatest(ctx context.Context) error {
 					COMMENT ON COLUMN node_aliases.node_alias is 'node_alias is a unique integer value assigned for the node_id. It is used for compressing segments.remote_alias_pieces.';`,
 				},
 			},
+			{
+				DB:          &db.db,
+				Description: "add object_tags table",
+				Version:     21,
+				Action: migrate.SQL{`
+					CREATE TABLE object_tags (
+						project_id  BYTEA NOT NULL,
+						bucket_name BYTEA NOT NULL,
+						object_key  BYTEA NOT NULL,
+						version     INT8  NOT NULL,
+						stream_id   BYTEA NOT NULL,
+						tag_key     BYTEA NOT NULL,
+						tag_value   BYTEA NOT NULL,
+
+						PRIMARY KEY (project_id, bucket_name, object_key, version, tag_key)
+					);
+
+					COMMENT ON TABLE  object_tags           is 'object_tags table contains the tags of object versions. Tags of deleted versions are removed by the expired deletion chore.';
+					COMMENT ON COLUMN object_tags.stream_id is 'stream_id is the stream of the tagged version. Tags are only used while a version with the same location and stream exists.';
+					COMMENT ON COLUMN object_tags.tag_key   is 'tag_key is the key of the tag, as provided by the client.';
+					COMMENT ON COLUMN object_tags.tag_value is 'tag_value is the value of the tag, as provided by the client.';
+				`},
+			},
+			{
+				DB:          &db.db,
+				Description: "Object events outbox",
+				Version:     22,
+				Action:      objectEventsMigration(db.impl),
+			},
+			{
+				DB:          &db.db,
+				Description: "add index on objects.stream_id",
+				Version:     23,
+				Action: createIndexConcurrently(db.impl, "objects_stream_id_index",
+					`CREATE INDEX CONCURRENTLY IF NOT EXISTS objects_stream_id_index ON objects (stream_id)`,
+				),
+			},
+			{
+				DB:          &db.db,
+				Description: "add object_pins table",
+				Version:     24,
+				Action: migrate.SQL{`
+					CREATE TABLE object_pins (
+						project_id  BYTEA NOT NULL,
+						bucket_name BYTEA NOT NULL,
+						object_key  BYTEA NOT NULL,
+						version     INT8  NOT NULL,
+						pin_name    TEXT  NOT NULL,
+						created_at  TIMESTAMPTZ NOT NULL,
+
+						PRIMARY KEY (project_id, bucket_name, object_key, version, pin_name)
+					);
+
+					CREATE INDEX object_pins_pin_name_index ON object_pins (project_id, bucket_name, pin_name);
+
+					COMMENT ON TABLE  object_pins          is 'object_pins table contains the object versions held by named pins, which prevent them from being deleted or expiring.';
+					COMMENT ON COLUMN object_pins.pin_name is 'pin_name is the name of the pin, unique within the bucket.';
+				`},
+			},
+			{
+				DB:          &db.db,
+				Description: "add index on objects.expires_at",
+				Version:     25,
+				Action: createIndexConcurrently(db.impl, "objects_expires_at_index",
+					`CREATE INDEX CONCURRENTLY IF NOT EXISTS objects_expires_at_index ON objects (expires_at, project_id, bucket_name, object_key, version) WHERE expires_at IS NOT NULL`,
+				),
+			},
+			{
+				DB:          &db.db,
+				Description: "add project_quota_counters table",
+				Version:     26,
+				Action: migrate.SQL{`
+					CREATE TABLE project_quota_counters (
+						project_id    BYTEA NOT NULL,
+						object_count  INT8  NOT NULL DEFAULT 0,
+						segment_count INT8  NOT NULL DEFAULT 0,
+						recounted_at  TIMESTAMPTZ,
+
+						PRIMARY KEY (project_id)
+					);
+
+					COMMENT ON TABLE  project_quota_counters              is 'project_quota_counters table contains the approximate committed object and segment count of projects, used for enforcing the project quota on commit.';
+					COMMENT ON COLUMN project_quota_counters.recounted_at is 'recounted_at is when the counts were last recounted from the objects table.';
+				`},
+			},
+			{
+				DB:          &db.db,
+				Description: "add node_aliases.retired_at",
+				Version:     27,
+				Action: migrate.SQL{`
+					ALTER TABLE node_aliases ADD COLUMN retired_at TIMESTAMPTZ;
+
+					COMMENT ON COLUMN node_aliases.retired_at is 'retired_at is when the alias was retired, because no segment referenced it. Retired aliases are kept, so they are never reused.';
+				`},
+			},
+			{
+				DB:          &db.db,
+				Description: "add deferred_segment_deletions table",
+				Version:     28,
+				Action: migrate.SQL{`
+					CREATE TABLE deferred_segment_deletions (
+						stream_id  BYTEA       NOT NULL,
+						created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+
+						PRIMARY KEY (stream_id)
+					);
+
+					CREATE INDEX deferred_segment_deletions_created_at_index ON deferred_segment_deletions (created_at);
+
+					COMMENT ON TABLE  deferred_segment_deletions            is 'deferred_segment_deletions table contains the streams of deleted objects, whose segments are deleted later in batches.';
+					COMMENT ON COLUMN deferred_segment_deletions.stream_id  is 'stream_id is the stream of the deleted object. Stream IDs are never reused, so no new segments are added to it.';
+					COMMENT ON COLUMN deferred_segment_deletions.created_at is 'created_at is when the object was deleted, used for processing the oldest streams first.';
+				`},
+			},
 		},
 	}
 
 	if db.config.TestingUniqueUnversioned {
 		// This is only part of testing, because we do not want to affect the production performance.
+		// It always runs after the last regular step, so adding a step doesn't require renumbering it.
 		migration.Steps = append(migration.Steps, &migrate.Step{
 			DB:          &db.db,
 			Description: "Constraint for ensuring our metabase correctness.",
-			Version:     21,
+			Version:     migration.Steps[len(migration.Steps)-1].Version + 1,
 			Action: migrate.SQL{
 				`CREATE UNIQUE INDEX objects_one_unversioned_per_location ON objects (project_id, bucket_name, object_key) WHERE status IN ` + statusesUnversioned + `;`,
 			},
@@ -778,10 +921,153 @@ func (db *DB) PostgresMigration() *migrate.Migration {
 					`DROP TABLE IF EXISTS segment_copies`,
 				},
 			},
+			{
+				DB:          &db.db,
+				Description: "add object_tags table",
+				Version:     21,
+				Action: migrate.SQL{`
+					CREATE TABLE object_tags (
+						project_id  BYTEA NOT NULL,
+						bucket_name BYTEA NOT NULL,
+						object_key  BYTEA NOT NULL,
+						version     INT8  NOT NULL,
+						stream_id   BYTEA NOT NULL,
+						tag_key     BYTEA NOT NULL,
+						tag_value   BYTEA NOT NULL,
+
+						PRIMARY KEY (project_id, bucket_name, object_key, version, tag_key)
+					);
+
+					COMMENT ON TABLE  object_tags           is 'object_tags table contains the tags of object versions. Tags of deleted versions are removed by the expired deletion chore.';
+					COMMENT ON COLUMN object_tags.stream_id is 'stream_id is the stream of the tagged version. Tags are only used while a version with the same location and stream exists.';
+					COMMENT ON COLUMN object_tags.tag_key   is 'tag_key is the key of the tag, as provided by the client.';
+					COMMENT ON COLUMN object_tags.tag_value is 'tag_value is the value of the tag, as provided by the client.';
+				`},
+			},
+			{
+				DB:          &db.db,
+				Description: "add object_events outbox table",
+				Version:     22,
+				Action:      objectEventsMigration(db.impl),
+			},
+			{
+				DB:          &db.db,
+				Description: "add index on objects.stream_id",
+				Version:     23,
+				Action: createIndexConcurrently(db.impl, "objects_stream_id_index",
+					`CREATE INDEX CONCURRENTLY IF NOT EXISTS objects_stream_id_index ON objects (stream_id)`,
+				),
+			},
+			{
+				DB:          &db.db,
+				Description: "add object_pins table",
+				Version:     24,
+				Action: migrate.SQL{`
+					CREATE TABLE object_pins (
+						project_id  BYTEA NOT NULL,
+						bucket_name BYTEA NOT NULL,
+						object_key  BYTEA NOT NULL,
+						version     INT8  NOT NULL,
+						pin_name    TEXT  NOT NULL,
+						created_at  TIMESTAMPTZ NOT NULL,
+
+						PRIMARY KEY (project_id, bucket_name, object_key, version, pin_name)
+					);
+
+					CREATE INDEX object_pins_pin_name_index ON object_pins (project_id, bucket_name, pin_name);
+
+					COMMENT ON TABLE  object_pins          is 'object_pins table contains the object versions held by named pins, which prevent them from being deleted or expiring.';
+					COMMENT ON COLUMN object_pins.pin_name is 'pin_name is the name of the pin, unique within the bucket.';
+				`},
+			},
+			{
+				DB:          &db.db,
+				Description: "add index on objects.expires_at",
+				Version:     25,
+				Action: createIndexConcurrently(db.impl, "objects_expires_at_index",
+					`CREATE INDEX CONCURRENTLY IF NOT EXISTS objects_expires_at_index ON objects (expires_at, project_id, bucket_name, object_key, version) WHERE expires_at IS NOT NULL`,
+				),
+			},
+			{
+				DB:          &db.db,
+				Description: "add project_quota_counters table",
+				Version:     26,
+				Action: migrate.SQL{`
+					CREATE TABLE project_quota_counters (
+						project_id    BYTEA NOT NULL,
+						object_count  INT8  NOT NULL DEFAULT 0,
+						segment_count INT8  NOT NULL DEFAULT 0,
+						recounted_at  TIMESTAMPTZ,
+
+						PRIMARY KEY (project_id)
+					);
+
+					COMMENT ON TABLE  project_quota_counters              is 'project_quota_counters table contains the approximate committed object and segment count of projects, used for enforcing the project quota on commit.';
+					COMMENT ON COLUMN project_quota_counters.recounted_at is 'recounted_at is when the counts were last recounted from the objects table.';
+				`},
+			},
+			{
+				DB:          &db.db,
+				Description: "add node_aliases.retired_at",
+				Version:     27,
+				Action: migrate.SQL{`
+					ALTER TABLE node_aliases ADD COLUMN retired_at TIMESTAMPTZ;
+
+					COMMENT ON COLUMN node_aliases.retired_at is 'retired_at is when the alias was retired, because no segment referenced it. Retired aliases are kept, so they are never reused.';
+				`},
+			},
+			{
+				DB:          &db.db,
+				Description: "add deferred_segment_deletions table",
+				Version:     28,
+				Action: migrate.SQL{`
+					CREATE TABLE deferred_segment_deletions (
+						stream_id  BYTEA       NOT NULL,
+						created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+
+						PRIMARY KEY (stream_id)
+					);
+
+					CREATE INDEX deferred_segment_deletions_created_at_index ON deferred_segment_deletions (created_at);
+
+					COMMENT ON TABLE  deferred_segment_deletions            is 'deferred_segment_deletions table contains the streams of deleted objects, whose segments are deleted later in batches.';
+					COMMENT ON COLUMN deferred_segment_deletions.stream_id  is 'stream_id is the stream of the deleted object. Stream IDs are never reused, so no new segments are added to it.';
+					COMMENT ON COLUMN deferred_segment_deletions.created_at is 'created_at is when the object was deleted, used for processing the oldest streams first.';
+				`},
+			},
 		},
 	}
 }
 
+// createIndexConcurrently returns a migration action, which creates an index without blocking
+// writes to the table. CREATE INDEX CONCURRENTLY cannot run inside a transaction block, so the
+// statement is executed outside of the migration transaction. The statement must use IF NOT EXISTS,
+// so that the step can be rerun when the version wasn't recorded. On Postgres an interrupted build
+// leaves an invalid index behind, which is dropped before retrying. CockroachDB always builds
+// indexes online and accepts CONCURRENTLY for compatibility.
+func createIndexConcurrently(impl dbutil.Implementation, index, statement string) migrate.Action {
+	return migrate.Func(func(ctx context.Context, log *zap.Logger, db tagsql.DB, tx tagsql.Tx) error {
+		if impl == dbutil.Postgres {
+			var invalid bool
+			err := db.QueryRowContext(ctx, `
+				SELECT EXISTS (SELECT 1 FROM pg_index WHERE indexrelid = to_regclass($1) AND NOT indisvalid)
+			`, index).Scan(&invalid)
+			if err != nil {
+				return errs.Wrap(err)
+			}
+			if invalid {
+				log.Info("dropping invalid index", zap.String("index", index))
+				if _, err := db.ExecContext(ctx, `DROP INDEX CONCURRENTLY IF EXISTS `+index); err != nil {
+					return errs.Wrap(err)
+				}
+			}
+		}
+
+		_, err := db.ExecContext(ctx, statement)
+		return errs.Wrap(err)
+	})
+}
+
 // This is needed for migrate to work.
 // TODO: clean this up.
 type postgresRebind struct{ tagsql.DB }
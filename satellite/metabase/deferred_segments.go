@@ -0,0 +1,243 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase
+
+import (
+	"context"
+	"time"
+
+	spanner "github.com/storj/exp-spanner"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/shared/tagsql"
+)
+
+// Deleting the segments of a large object in the same transaction as the
+// object makes the transaction as large as the object. With
+// Config.DeferSegmentDeletion the stream of a deleted object is added to the
+// deferred_segment_deletions table instead, in the same transaction which
+// removes the object. DeleteDeferredSegments deletes the segments later in
+// batches and removes the stream from the table, once no segment is left.
+//
+// Stream IDs aren't reused, so no segment is added to a queued stream. Until
+// its segments are deleted, they are still seen by the segment loop and by
+// the segment listing of the stream, but not through any object.
+//
+// Only DeleteObjectExactVersion and the plain DeleteObjectLastCommitted defer
+// the deletion; the other deletes still delete the segments immediately.
+
+const deferredSegmentsStreamLimit = intLimitRange(1000)
+
+// DeleteDeferredSegments contains arguments for deleting the segments of
+// deleted objects.
+type DeleteDeferredSegments struct {
+	// StreamLimit is the maximum number of streams processed in one call.
+	StreamLimit int
+	// BatchSize is the maximum number of segments deleted in one transaction.
+	BatchSize int
+}
+
+// DeleteDeferredSegments deletes the segments of the oldest streams in
+// deferred_segment_deletions.
+func (db *DB) DeleteDeferredSegments(ctx context.Context, opts DeleteDeferredSegments) (deleted int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	deferredSegmentsStreamLimit.Ensure(&opts.StreamLimit)
+	deleteBatchSizeLimit.Ensure(&opts.BatchSize)
+
+	for _, adapter := range db.adapters {
+		streams, err := adapter.listDeferredSegmentDeletions(ctx, opts.StreamLimit)
+		if err != nil {
+			return deleted, err
+		}
+
+		mon.IntVal("metabase_deferred_segment_deletion_streams").Observe(int64(len(streams)))
+		if len(streams) > 0 {
+			// the streams are ordered by created_at.
+			mon.DurationVal("metabase_deferred_segment_deletion_lag").Observe(time.Since(streams[0].CreatedAt))
+		}
+
+		for _, stream := range streams {
+			for {
+				batch, done, err := adapter.deleteDeferredSegmentsBatch(ctx, stream.StreamID, opts.BatchSize)
+				deleted += batch
+				if err != nil {
+					mon.Meter("segment_deferred_delete").Mark64(deleted)
+					return deleted, err
+				}
+				if done {
+					break
+				}
+			}
+		}
+	}
+
+	mon.Meter("segment_deferred_delete").Mark64(deleted)
+	return deleted, nil
+}
+
+// deferredSegmentDeletion is a stream in deferred_segment_deletions.
+type deferredSegmentDeletion struct {
+	StreamID  uuid.UUID
+	CreatedAt time.Time
+}
+
+// deletedObjectSegmentsQuery returns the statement for the deleted_segments
+// part of an object delete query, which either deletes the segments of
+// deleted_objects or enqueues them into deferred_segment_deletions.
+func deletedObjectSegmentsQuery(deferSegments bool) string {
+	if deferSegments {
+		return `
+			INSERT INTO deferred_segment_deletions (stream_id)
+			SELECT deleted_objects.stream_id FROM deleted_objects
+			ON CONFLICT (stream_id) DO NOTHING
+			RETURNING stream_id
+		`
+	}
+	return `
+		DELETE FROM segments
+		WHERE segments.stream_id IN (SELECT deleted_objects.stream_id FROM deleted_objects)
+		RETURNING segments.stream_id
+	`
+}
+
+// deferSegmentDeletionMutations returns the mutations, which enqueue the
+// segments of the removed objects into deferred_segment_deletions.
+func deferSegmentDeletionMutations(removed []Object) []*spanner.Mutation {
+	now := time.Now()
+	mutations := make([]*spanner.Mutation, 0, len(removed))
+	for _, object := range removed {
+		mutations = append(mutations, spanner.InsertOrUpdate("deferred_segment_deletions",
+			[]string{"stream_id", "created_at"},
+			[]interface{}{object.StreamID, now},
+		))
+	}
+	return mutations
+}
+
+func (p *PostgresAdapter) listDeferredSegmentDeletions(ctx context.Context, limit int) (streams []deferredSegmentDeletion, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = withRows(p.db.QueryContext(ctx, `
+		SELECT stream_id, created_at
+		FROM deferred_segment_deletions
+		ORDER BY created_at
+		LIMIT $1
+	`, limit))(func(rows tagsql.Rows) error {
+		for rows.Next() {
+			var stream deferredSegmentDeletion
+			if err := rows.Scan(&stream.StreamID, &stream.CreatedAt); err != nil {
+				return err
+			}
+			streams = append(streams, stream)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, Error.New("unable to list deferred segment deletions: %w", err)
+	}
+	return streams, nil
+}
+
+func (p *PostgresAdapter) deleteDeferredSegmentsBatch(ctx context.Context, streamID uuid.UUID, batchSize int) (deleted int64, done bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	result, err := p.db.ExecContext(ctx, `
+		DELETE FROM segments
+		WHERE
+			stream_id = $1 AND
+			position IN (
+				SELECT position FROM segments
+				WHERE stream_id = $1
+				ORDER BY position
+				LIMIT $2
+			)
+	`, streamID, batchSize)
+	if err != nil {
+		return 0, false, Error.New("unable to delete deferred segments: %w", err)
+	}
+
+	deleted, err = result.RowsAffected()
+	if err != nil {
+		return 0, false, Error.New("unable to delete deferred segments: %w", err)
+	}
+	if deleted >= int64(batchSize) {
+		return deleted, false, nil
+	}
+
+	_, err = p.db.ExecContext(ctx, `
+		DELETE FROM deferred_segment_deletions
+		WHERE stream_id = $1
+	`, streamID)
+	if err != nil {
+		return deleted, false, Error.New("unable to delete deferred segment deletion: %w", err)
+	}
+	return deleted, true, nil
+}
+
+func (s *SpannerAdapter) listDeferredSegmentDeletions(ctx context.Context, limit int) (streams []deferredSegmentDeletion, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = s.client.Single().Query(ctx, spanner.Statement{
+		SQL: `
+			SELECT stream_id, created_at
+			FROM deferred_segment_deletions
+			ORDER BY created_at
+			LIMIT @limit
+		`,
+		Params: map[string]interface{}{
+			"limit": int64(limit),
+		},
+	}).Do(func(row *spanner.Row) error {
+		var stream deferredSegmentDeletion
+		if err := row.Columns(&stream.StreamID, &stream.CreatedAt); err != nil {
+			return err
+		}
+		streams = append(streams, stream)
+		return nil
+	})
+	if err != nil {
+		return nil, Error.New("unable to list deferred segment deletions: %w", err)
+	}
+	return streams, nil
+}
+
+func (s *SpannerAdapter) deleteDeferredSegmentsBatch(ctx context.Context, streamID uuid.UUID, batchSize int) (deleted int64, done bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = s.client.ReadWriteTransaction(ctx, func(ctx context.Context, tx *spanner.ReadWriteTransaction) error {
+		deleted, err = tx.Update(ctx, spanner.Statement{
+			SQL: `
+				DELETE FROM segments
+				WHERE
+					stream_id = @stream_id AND
+					position IN (
+						SELECT position FROM segments
+						WHERE stream_id = @stream_id
+						ORDER BY position
+						LIMIT @batch_size
+					)
+			`,
+			Params: map[string]interface{}{
+				"stream_id":  streamID,
+				"batch_size": int64(batchSize),
+			},
+		})
+		if err != nil {
+			return err
+		}
+
+		done = deleted < int64(batchSize)
+		if done {
+			return tx.BufferWrite([]*spanner.Mutation{
+				spanner.Delete("deferred_segment_deletions", spanner.Key{streamID}),
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, false, Error.New("unable to delete deferred segments: %w", err)
+	}
+	return deleted, done, nil
+}
@@ -0,0 +1,83 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/metabasetest"
+)
+
+func TestDeleteDeferredSegments(t *testing.T) {
+	metabasetest.RunWithConfig(t, metabase.Config{
+		ApplicationName:      "satellite-metabase-test",
+		DeferSegmentDeletion: true,
+	}, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		t.Run("exact version", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			obj := metabasetest.RandObjectStream()
+			object := metabasetest.CreateObject(ctx, t, db, obj, 5)
+
+			other := metabasetest.RandObjectStream()
+			metabasetest.CreateObject(ctx, t, db, other, 2)
+
+			result, err := db.DeleteObjectExactVersion(ctx, metabase.DeleteObjectExactVersion{
+				ObjectLocation: obj.Location(),
+				Version:        object.Version,
+			})
+			require.NoError(t, err)
+			require.Len(t, result.Removed, 1)
+
+			// the segments are kept until the deferred deletion.
+			segments, err := db.TestingAllSegments(ctx)
+			require.NoError(t, err)
+			require.Len(t, segments, 7)
+
+			deleted, err := db.DeleteDeferredSegments(ctx, metabase.DeleteDeferredSegments{BatchSize: 2})
+			require.NoError(t, err)
+			require.EqualValues(t, 5, deleted)
+
+			segments, err = db.TestingAllSegments(ctx)
+			require.NoError(t, err)
+			require.Len(t, segments, 2)
+			for _, segment := range segments {
+				require.Equal(t, other.StreamID, segment.StreamID)
+			}
+
+			// the stream was removed from the queue.
+			deleted, err = db.DeleteDeferredSegments(ctx, metabase.DeleteDeferredSegments{})
+			require.NoError(t, err)
+			require.Zero(t, deleted)
+		})
+
+		t.Run("last committed", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			obj := metabasetest.RandObjectStream()
+			metabasetest.CreateObject(ctx, t, db, obj, 4)
+
+			result, err := db.DeleteObjectLastCommitted(ctx, metabase.DeleteObjectLastCommitted{
+				ObjectLocation: obj.Location(),
+			})
+			require.NoError(t, err)
+			require.Len(t, result.Removed, 1)
+
+			segments, err := db.TestingAllSegments(ctx)
+			require.NoError(t, err)
+			require.Len(t, segments, 4)
+
+			// a full batch is followed by one, which finds no segments left.
+			deleted, err := db.DeleteDeferredSegments(ctx, metabase.DeleteDeferredSegments{BatchSize: 4})
+			require.NoError(t, err)
+			require.EqualValues(t, 4, deleted)
+
+			metabasetest.Verify{}.Check(ctx, t, db)
+		})
+	}, metabasetest.WithSpanner())
+}
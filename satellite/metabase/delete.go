@@ -31,6 +31,9 @@ type DeletedSegmentInfo struct {
 type DeleteObjectExactVersion struct {
 	Version Version
 	ObjectLocation
+
+	// deferSegments enqueues the segments for deletion, instead of deleting them.
+	deferSegments bool
 }
 
 // Verify delete object fields.
@@ -95,10 +98,20 @@ func (db *DB) DeleteObjectExactVersion(ctx context.Context, opts DeleteObjectExa
 	if err := opts.Verify(); err != nil {
 		return DeleteObjectResult{}, err
 	}
+	opts.deferSegments = db.config.DeferSegmentDeletion
+
 	result, err = db.ChooseAdapter(opts.ProjectID).DeleteObjectExactVersion(ctx, opts)
 	if err != nil {
 		return DeleteObjectResult{}, err
 	}
+	if len(result.Removed) == 0 {
+		err := db.checkObjectPinned(ctx, opts.ProjectID, opts.BucketName, [][]byte{[]byte(opts.ObjectKey)}, func(object Object) bool {
+			return object.Version == opts.Version
+		})
+		if err != nil {
+			return DeleteObjectResult{}, err
+		}
+	}
 
 	mon.Meter("object_delete").Mark(len(result.Removed))
 	for _, object := range result.Removed {
@@ -111,19 +124,23 @@ func (db *DB) DeleteObjectExactVersion(ctx context.Context, opts DeleteObjectExa
 func (p *PostgresAdapter) DeleteObjectExactVersion(ctx context.Context, opts DeleteObjectExactVersion) (result DeleteObjectResult, err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	const tag = queryTag("delete_object_exact_version")
+	done := tag.observe(p.impl.String())
+	defer func() { done(int64(len(result.Removed)), err) }()
+
 	err = withRows(
 		p.db.QueryContext(ctx, `
 			WITH deleted_objects AS (
 				DELETE FROM objects
-				WHERE (project_id, bucket_name, object_key, version) = ($1, $2, $3, $4)
+				WHERE
+					(project_id, bucket_name, object_key, version) = ($1, $2, $3, $4)
+					AND `+objectNotPinned+`
 				RETURNING
 					version, stream_id, created_at, expires_at, status, segment_count, encrypted_metadata_nonce,
 					encrypted_metadata, encrypted_metadata_encrypted_key, total_plain_size, total_encrypted_size,
 					fixed_segment_size, encryption
 			), deleted_segments AS (
-				DELETE FROM segments
-				WHERE segments.stream_id IN (SELECT deleted_objects.stream_id FROM deleted_objects)
-				RETURNING segments.stream_id
+				`+deletedObjectSegmentsQuery(opts.deferSegments)+`
 			)
 			SELECT
 				version, stream_id, created_at, expires_at, status, segment_count, encrypted_metadata_nonce,
@@ -142,11 +159,17 @@ func (p *PostgresAdapter) DeleteObjectExactVersion(ctx context.Context, opts Del
 func (s *SpannerAdapter) DeleteObjectExactVersion(ctx context.Context, opts DeleteObjectExactVersion) (result DeleteObjectResult, err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	const tag = queryTag("delete_object_exact_version")
+	done := tag.observe(s.Name())
+	defer func() { done(int64(len(result.Removed)), err) }()
+
 	_, err = s.client.ReadWriteTransaction(ctx, func(ctx context.Context, tx *spanner.ReadWriteTransaction) error {
 		objectDeletion := spanner.Statement{
 			SQL: `
 				DELETE FROM objects
-				WHERE (project_id, bucket_name, object_key, version) = (@project_id, @bucket_name, @object_key, @version)
+				WHERE
+					(project_id, bucket_name, object_key, version) = (@project_id, @bucket_name, @object_key, @version)
+					AND ` + objectNotPinned + `
 				THEN RETURN
 					version, stream_id, created_at, expires_at, status, segment_count, encrypted_metadata_nonce,
 					encrypted_metadata, encrypted_metadata_encrypted_key, total_plain_size, total_encrypted_size,
@@ -167,6 +190,10 @@ func (s *SpannerAdapter) DeleteObjectExactVersion(ctx context.Context, opts Dele
 			return Error.Wrap(err)
 		}
 
+		if opts.deferSegments {
+			return Error.Wrap(tx.BufferWrite(deferSegmentDeletionMutations(result.Removed)))
+		}
+
 		streamIDs := make([][]byte, 0, len(result.Removed))
 		for _, object := range result.Removed {
 			streamIDs = append(streamIDs, object.StreamID.Bytes())
@@ -226,6 +253,10 @@ func (db *DB) DeletePendingObject(ctx context.Context, opts DeletePendingObject)
 
 // DeletePendingObject deletes a pending object with specified version and streamID.
 func (p *PostgresAdapter) DeletePendingObject(ctx context.Context, opts DeletePendingObject) (result DeleteObjectResult, err error) {
+	const tag = queryTag("delete_pending_object")
+	done := tag.observe(p.impl.String())
+	defer func() { done(int64(len(result.Removed)), err) }()
+
 	err = withRows(p.db.QueryContext(ctx, `
 			WITH deleted_objects AS (
 				DELETE FROM objects
@@ -255,6 +286,10 @@ func (p *PostgresAdapter) DeletePendingObject(ctx context.Context, opts DeletePe
 
 // DeletePendingObject deletes a pending object with specified version and streamID.
 func (s *SpannerAdapter) DeletePendingObject(ctx context.Context, opts DeletePendingObject) (result DeleteObjectResult, err error) {
+	const tag = queryTag("delete_pending_object")
+	done := tag.observe(s.Name())
+	defer func() { done(int64(len(result.Removed)), err) }()
+
 	_, err = s.client.ReadWriteTransaction(ctx, func(ctx context.Context, tx *spanner.ReadWriteTransaction) error {
 		objectDeletion := spanner.Statement{
 			SQL: `
@@ -333,6 +368,12 @@ func (db *DB) DeleteObjectsAllVersions(ctx context.Context, opts DeleteObjectsAl
 	if err != nil {
 		return DeleteObjectResult{}, err
 	}
+	if len(result.Removed) == 0 {
+		err := db.checkObjectPinned(ctx, projectID, bucketName, objectKeys, func(Object) bool { return true })
+		if err != nil {
+			return DeleteObjectResult{}, err
+		}
+	}
 
 	mon.Meter("object_delete").Mark(len(result.Removed))
 	for _, object := range result.Removed {
@@ -344,6 +385,10 @@ func (db *DB) DeleteObjectsAllVersions(ctx context.Context, opts DeleteObjectsAl
 
 // DeleteObjectsAllVersions deletes all versions of multiple objects from the same bucket.
 func (p *PostgresAdapter) DeleteObjectsAllVersions(ctx context.Context, projectID uuid.UUID, bucketName string, objectKeys [][]byte) (result DeleteObjectResult, err error) {
+	const tag = queryTag("delete_objects_all_versions")
+	done := tag.observe(p.impl.String())
+	defer func() { done(int64(len(result.Removed)), err) }()
+
 	// Sorting the object keys just in case.
 	sort.Slice(objectKeys, func(i, j int) bool {
 		return bytes.Compare(objectKeys[i], objectKeys[j]) < 0
@@ -355,7 +400,12 @@ func (p *PostgresAdapter) DeleteObjectsAllVersions(ctx context.Context, projectI
 			WHERE
 				(project_id, bucket_name) = ($1, $2) AND
 				object_key = ANY ($3) AND
-				status <> `+statusPending+`
+				status <> `+statusPending+` AND
+				NOT EXISTS (
+					SELECT 1 FROM object_pins
+					WHERE (object_pins.project_id, object_pins.bucket_name) = ($1, $2)
+						AND object_pins.object_key = ANY ($3)
+				)
 			RETURNING
 				project_id, bucket_name, object_key, version, stream_id, created_at, expires_at,
 				status, segment_count, encrypted_metadata_nonce, encrypted_metadata,
@@ -377,11 +427,15 @@ func (p *PostgresAdapter) DeleteObjectsAllVersions(ctx context.Context, projectI
 		return err
 	})
 
-	return result, nil
+	return result, err
 }
 
 // DeleteObjectsAllVersions deletes all versions of multiple objects from the same bucket.
 func (s *SpannerAdapter) DeleteObjectsAllVersions(ctx context.Context, projectID uuid.UUID, bucketName string, objectKeys [][]byte) (result DeleteObjectResult, err error) {
+	const tag = queryTag("delete_objects_all_versions")
+	done := tag.observe(s.Name())
+	defer func() { done(int64(len(result.Removed)), err) }()
+
 	_, err = s.client.ReadWriteTransaction(ctx, func(ctx context.Context, tx *spanner.ReadWriteTransaction) error {
 		objectDeletion := spanner.Statement{
 			SQL: `
@@ -389,7 +443,12 @@ func (s *SpannerAdapter) DeleteObjectsAllVersions(ctx context.Context, projectID
 				WHERE
 					(project_id, bucket_name) = (@project_id, @bucket_name) AND
 					ARRAY_INCLUDES(@keys, object_key) AND
-					status <> ` + statusPending + `
+					status <> ` + statusPending + ` AND
+					NOT EXISTS (
+						SELECT 1 FROM object_pins
+						WHERE object_pins.project_id = @project_id AND object_pins.bucket_name = @bucket_name
+							AND ARRAY_INCLUDES(@keys, object_pins.object_key)
+					)
 				THEN RETURN
 					project_id, bucket_name, object_key, version, stream_id, created_at, expires_at,
 					status, segment_count, encrypted_metadata_nonce, encrypted_metadata,
@@ -564,6 +623,9 @@ type DeleteObjectLastCommitted struct {
 
 	Versioned bool
 	Suspended bool
+
+	// deferSegments enqueues the segments for deletion, instead of deleting them.
+	deferSegments bool
 }
 
 // Verify delete object last committed fields.
@@ -584,13 +646,19 @@ func (db *DB) DeleteObjectLastCommitted(
 		return DeleteObjectResult{}, err
 	}
 
+	adapter := db.ChooseAdapter(opts.ProjectID)
+
 	if opts.Suspended {
 		deleterMarkerStreamID, err := generateDeleteMarkerStreamID()
 		if err != nil {
 			return DeleteObjectResult{}, Error.Wrap(err)
 		}
 
-		return db.ChooseAdapter(opts.ProjectID).DeleteObjectLastCommittedSuspended(ctx, opts, deleterMarkerStreamID)
+		trace := db.traceDelete(adapter, "delete_object_last_committed_suspended", opts.ObjectLocation)
+		result, err = adapter.DeleteObjectLastCommittedSuspended(ctx, opts, deleterMarkerStreamID, trace)
+		trace.removed(result.Removed)
+		trace.finish(err)
+		return result, err
 	}
 	if opts.Versioned {
 		// Instead of deleting we insert a deletion marker.
@@ -599,13 +667,29 @@ func (db *DB) DeleteObjectLastCommitted(
 			return DeleteObjectResult{}, Error.Wrap(err)
 		}
 
-		return db.ChooseAdapter(opts.ProjectID).DeleteObjectLastCommittedVersioned(ctx, opts, deleterMarkerStreamID)
+		trace := db.traceDelete(adapter, "delete_object_last_committed_versioned", opts.ObjectLocation)
+		result, err = adapter.DeleteObjectLastCommittedVersioned(ctx, opts, deleterMarkerStreamID)
+		trace.finish(err)
+		return result, err
 	}
 
-	result, err = db.ChooseAdapter(opts.ProjectID).DeleteObjectLastCommittedPlain(ctx, opts)
+	opts.deferSegments = db.config.DeferSegmentDeletion
+
+	trace := db.traceDelete(adapter, "delete_object_last_committed_plain", opts.ObjectLocation)
+	result, err = adapter.DeleteObjectLastCommittedPlain(ctx, opts)
+	trace.removed(result.Removed)
+	trace.finish(err)
 	if err != nil {
 		return DeleteObjectResult{}, err
 	}
+	if len(result.Removed) == 0 {
+		err := db.checkObjectPinned(ctx, opts.ProjectID, opts.BucketName, [][]byte{[]byte(opts.ObjectKey)}, func(object Object) bool {
+			return object.Status == CommittedUnversioned
+		})
+		if err != nil {
+			return DeleteObjectResult{}, err
+		}
+	}
 
 	mon.Meter("object_delete").Mark(len(result.Removed))
 	for _, object := range result.Removed {
@@ -618,6 +702,10 @@ func (db *DB) DeleteObjectLastCommitted(
 // DeleteObjectLastCommittedPlain deletes an object last committed version when
 // opts.Suspended and opts.Versioned are both false.
 func (p *PostgresAdapter) DeleteObjectLastCommittedPlain(ctx context.Context, opts DeleteObjectLastCommitted) (result DeleteObjectResult, err error) {
+	const tag = queryTag("delete_object_last_committed_plain")
+	done := tag.observe(p.impl.String())
+	defer func() { done(int64(len(result.Removed)), err) }()
+
 	// TODO(ver): do we need to pretend here that `expires_at` matters?
 	// TODO(ver): should this report an error when the object doesn't exist?
 	err = withRows(
@@ -627,7 +715,8 @@ func (p *PostgresAdapter) DeleteObjectLastCommittedPlain(ctx context.Context, op
 				WHERE
 					(project_id, bucket_name, object_key) = ($1, $2, $3) AND
 					status = `+statusCommittedUnversioned+` AND
-					(expires_at IS NULL OR expires_at > now())
+					(expires_at IS NULL OR expires_at > now()) AND
+					`+objectNotPinned+`
 				RETURNING
 					version, stream_id,
 					created_at, expires_at,
@@ -636,9 +725,7 @@ func (p *PostgresAdapter) DeleteObjectLastCommittedPlain(ctx context.Context, op
 					total_plain_size, total_encrypted_size, fixed_segment_size,
 					encryption
 			), deleted_segments AS (
-				DELETE FROM segments
-				WHERE segments.stream_id IN (SELECT deleted_objects.stream_id FROM deleted_objects)
-				RETURNING segments.stream_id
+				`+deletedObjectSegmentsQuery(opts.deferSegments)+`
 			)
 			SELECT
 				version, stream_id, created_at, expires_at, status, segment_count, encrypted_metadata_nonce,
@@ -656,6 +743,10 @@ func (p *PostgresAdapter) DeleteObjectLastCommittedPlain(ctx context.Context, op
 // DeleteObjectLastCommittedPlain deletes an object last committed version when
 // opts.Suspended and opts.Versioned are both false.
 func (s *SpannerAdapter) DeleteObjectLastCommittedPlain(ctx context.Context, opts DeleteObjectLastCommitted) (result DeleteObjectResult, err error) {
+	const tag = queryTag("delete_object_last_committed_plain")
+	done := tag.observe(s.Name())
+	defer func() { done(int64(len(result.Removed)), err) }()
+
 	// TODO(ver): do we need to pretend here that `expires_at` matters?
 	// TODO(ver): should this report an error when the object doesn't exist?
 	_, err = s.client.ReadWriteTransaction(ctx, func(ctx context.Context, tx *spanner.ReadWriteTransaction) error {
@@ -666,7 +757,8 @@ func (s *SpannerAdapter) DeleteObjectLastCommittedPlain(ctx context.Context, opt
 				WHERE
 					(project_id, bucket_name, object_key) = (@project_id, @bucket_name, @object_key) AND
 					status = ` + statusCommittedUnversioned + ` AND
-					(expires_at IS NULL OR expires_at > CURRENT_TIMESTAMP)
+					(expires_at IS NULL OR expires_at > CURRENT_TIMESTAMP) AND
+					` + objectNotPinned + `
 				THEN RETURN
 					version, stream_id,
 					created_at, expires_at,
@@ -689,6 +781,10 @@ func (s *SpannerAdapter) DeleteObjectLastCommittedPlain(ctx context.Context, opt
 			return Error.Wrap(err)
 		}
 
+		if opts.deferSegments {
+			return Error.Wrap(tx.BufferWrite(deferSegmentDeletionMutations(result.Removed)))
+		}
+
 		streamIDs := make([][]byte, 0, len(result.Removed))
 		for _, object := range result.Removed {
 			streamIDs = append(streamIDs, object.StreamID.Bytes())
@@ -714,9 +810,13 @@ type deleteTransactionAdapter interface {
 }
 
 // DeleteObjectLastCommittedSuspended deletes an object last committed version when opts.Suspended is true.
-func (p *PostgresAdapter) DeleteObjectLastCommittedSuspended(ctx context.Context, opts DeleteObjectLastCommitted, deleterMarkerStreamID uuid.UUID) (result DeleteObjectResult, err error) {
+func (p *PostgresAdapter) DeleteObjectLastCommittedSuspended(ctx context.Context, opts DeleteObjectLastCommitted, deleterMarkerStreamID uuid.UUID, trace *transactionTrace) (result DeleteObjectResult, err error) {
+	const tag = queryTag("delete_object_last_committed_suspended")
+	done := tag.observe(p.impl.String())
+	defer func() { done(int64(len(result.Removed)), err) }()
+
 	var precommit PrecommitConstraintWithNonPendingResult
-	err = p.WithTx(ctx, func(ctx context.Context, tx TransactionAdapter) (err error) {
+	err = p.withTxStats(ctx, "delete_object_last_committed_suspended", trace.wrap(func(ctx context.Context, tx TransactionAdapter) (err error) {
 		precommit, err = tx.PrecommitDeleteUnversionedWithNonPending(ctx, opts.ObjectLocation)
 		if err != nil {
 			return Error.Wrap(err)
@@ -756,7 +856,7 @@ func (p *PostgresAdapter) DeleteObjectLastCommittedSuspended(ctx context.Context
 		result.Markers = append(result.Markers, marker)
 		result.Removed = precommit.Deleted
 		return nil
-	})
+	}))
 	if err != nil {
 		return result, err
 	}
@@ -765,9 +865,13 @@ func (p *PostgresAdapter) DeleteObjectLastCommittedSuspended(ctx context.Context
 }
 
 // DeleteObjectLastCommittedSuspended deletes an object last committed version when opts.Suspended is true.
-func (s *SpannerAdapter) DeleteObjectLastCommittedSuspended(ctx context.Context, opts DeleteObjectLastCommitted, deleterMarkerStreamID uuid.UUID) (result DeleteObjectResult, err error) {
+func (s *SpannerAdapter) DeleteObjectLastCommittedSuspended(ctx context.Context, opts DeleteObjectLastCommitted, deleterMarkerStreamID uuid.UUID, trace *transactionTrace) (result DeleteObjectResult, err error) {
+	const tag = queryTag("delete_object_last_committed_suspended")
+	done := tag.observe(s.Name())
+	defer func() { done(int64(len(result.Removed)), err) }()
+
 	var precommit PrecommitConstraintWithNonPendingResult
-	err = s.WithTx(ctx, func(ctx context.Context, atx TransactionAdapter) error {
+	err = s.withTxStats(ctx, "delete_object_last_committed_suspended", trace.wrap(func(ctx context.Context, atx TransactionAdapter) error {
 		stx := atx.(*spannerTransactionAdapter)
 
 		precommit, err = stx.PrecommitDeleteUnversionedWithNonPending(ctx, opts.ObjectLocation)
@@ -826,7 +930,7 @@ func (s *SpannerAdapter) DeleteObjectLastCommittedSuspended(ctx context.Context,
 		result.Markers = append(result.Markers, marker)
 		result.Removed = precommit.Deleted
 		return nil
-	})
+	}))
 
 	if err != nil {
 		return result, err
@@ -837,6 +941,10 @@ func (s *SpannerAdapter) DeleteObjectLastCommittedSuspended(ctx context.Context,
 
 // DeleteObjectLastCommittedVersioned deletes an object last committed version when opts.Versioned is true.
 func (p *PostgresAdapter) DeleteObjectLastCommittedVersioned(ctx context.Context, opts DeleteObjectLastCommitted, deleterMarkerStreamID uuid.UUID) (result DeleteObjectResult, err error) {
+	const tag = queryTag("delete_object_last_committed_versioned")
+	done := tag.observe(p.impl.String())
+	defer func() { done(int64(len(result.Removed)), err) }()
+
 	row := p.db.QueryRowContext(ctx, `
 			INSERT INTO objects (
 				project_id, bucket_name, object_key, version, stream_id,
@@ -877,6 +985,10 @@ func (p *PostgresAdapter) DeleteObjectLastCommittedVersioned(ctx context.Context
 
 // DeleteObjectLastCommittedVersioned deletes an object last committed version when opts.Versioned is true.
 func (s *SpannerAdapter) DeleteObjectLastCommittedVersioned(ctx context.Context, opts DeleteObjectLastCommitted, deleterMarkerStreamID uuid.UUID) (result DeleteObjectResult, err error) {
+	const tag = queryTag("delete_object_last_committed_versioned")
+	done := tag.observe(s.Name())
+	defer func() { done(int64(len(result.Removed)), err) }()
+
 	_, err = s.client.ReadWriteTransaction(ctx, func(ctx context.Context, tx *spanner.ReadWriteTransaction) error {
 		rowIterator := tx.Query(ctx, spanner.Statement{
 			SQL: `
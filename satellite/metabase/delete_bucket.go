@@ -5,7 +5,16 @@ package metabase
 
 import (
 	"context"
+	"database/sql"
+	"encoding/binary"
+	"sync"
+	"sync/atomic"
 
+	"github.com/storj/exp-spanner"
+	"golang.org/x/sync/errgroup"
+	"golang.org/x/time/rate"
+
+	"storj.io/common/uuid"
 	"storj.io/storj/shared/dbutil"
 )
 
@@ -17,57 +26,258 @@ const (
 type DeleteBucketObjects struct {
 	Bucket    BucketLocation
 	BatchSize int
+
+	// ContinuationToken continues the deletion after the last object deleted by
+	// a previous, interrupted call.
+	ContinuationToken ContinuationToken
+	// Progress is called after every deleted batch. Returning an error stops the deletion.
+	Progress func(ctx context.Context, progress DeleteBucketObjectsProgress) error
+
+	// Parallelism is the number of workers deleting separate parts of the bucket concurrently.
+	// When it's more than one, Progress does not receive a ContinuationToken.
+	Parallelism int
+	// MaxBatchesPerSecond limits the rate of deleted batches across all workers.
+	MaxBatchesPerSecond float64
+}
+
+// Verify verifies delete bucket objects request fields.
+func (opts *DeleteBucketObjects) Verify() error {
+	if err := opts.Bucket.Verify(); err != nil {
+		return err
+	}
+	if opts.Parallelism > 1 && len(opts.ContinuationToken) > 0 {
+		return ErrInvalidRequest.New("ContinuationToken cannot be used with Parallelism")
+	}
+	return nil
+}
+
+// DeleteBucketObjectsProgress describes the progress of DeleteBucketObjects after a deleted batch.
+type DeleteBucketObjectsProgress struct {
+	DeletedObjects  int64
+	DeletedSegments int64
+	// ContinuationToken can be used to continue the deletion after this batch.
+	ContinuationToken ContinuationToken
+}
+
+// ContinuationToken is an opaque token pointing to the last deleted object.
+type ContinuationToken []byte
+
+func newContinuationToken(cursor deleteBucketObjectsCursor) ContinuationToken {
+	token := make(ContinuationToken, 8, 8+len(cursor.key))
+	binary.BigEndian.PutUint64(token, uint64(cursor.version))
+	return append(token, cursor.key...)
+}
+
+func (token ContinuationToken) decode() (cursor deleteBucketObjectsCursor, err error) {
+	if len(token) == 0 {
+		return deleteBucketObjectsCursor{}, nil
+	}
+	if len(token) < 8 {
+		return deleteBucketObjectsCursor{}, ErrInvalidRequest.New("ContinuationToken is invalid")
+	}
+	return deleteBucketObjectsCursor{
+		key:     ObjectKey(token[8:]),
+		version: Version(binary.BigEndian.Uint64(token[:8])),
+	}, nil
 }
 
 // DeleteBucketObjects deletes all objects in the specified bucket.
 // Deletion performs in batches, so in case of error while processing,
 // this method will return the number of objects deleted to the moment
 // when an error occurs.
+//
+// The bucket is traversed once in object key order, so objects which are
+// inserted behind the current position during the deletion are not deleted.
 func (db *DB) DeleteBucketObjects(ctx context.Context, opts DeleteBucketObjects) (deletedObjectCount int64, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	if err := opts.Bucket.Verify(); err != nil {
+	if err := opts.Verify(); err != nil {
+		return 0, err
+	}
+
+	pins, err := db.ChooseAdapter(opts.Bucket.ProjectID).ListObjectPins(ctx, ListObjectPins{
+		ProjectID:  opts.Bucket.ProjectID,
+		BucketName: opts.Bucket.BucketName,
+	})
+	if err != nil {
+		return 0, err
+	}
+	if len(pins) > 0 {
+		return 0, ErrObjectPinned.New("bucket has %d pins", len(pins))
+	}
+
+	cursor, err := opts.ContinuationToken.decode()
+	if err != nil {
 		return 0, err
 	}
 
 	deleteBatchSizeLimit.Ensure(&opts.BatchSize)
 
-	deletedBatchCount := int64(opts.BatchSize)
-	for deletedBatchCount > 0 {
-		if err := ctx.Err(); err != nil {
-			return deletedObjectCount, err
+	limiter := rate.NewLimiter(rate.Inf, 1)
+	if opts.MaxBatchesPerSecond > 0 {
+		limiter = rate.NewLimiter(rate.Limit(opts.MaxBatchesPerSecond), 1)
+	}
+
+	if opts.Parallelism <= 1 {
+		return db.deleteBucketObjectsRange(ctx, opts, limiter, deleteBucketObjectsRange{cursor: cursor}, opts.Progress)
+	}
+
+	// progress of the concurrent workers is reported one at a time.
+	var mu sync.Mutex
+	progress := opts.Progress
+	if progress != nil {
+		progress = func(ctx context.Context, batch DeleteBucketObjectsProgress) error {
+			mu.Lock()
+			defer mu.Unlock()
+			// a single token cannot describe the position of all workers.
+			batch.ContinuationToken = nil
+			return opts.Progress(ctx, batch)
+		}
+	}
+
+	var deleted atomic.Int64
+	group, groupCtx := errgroup.WithContext(ctx)
+	for _, keyRange := range splitDeleteBucketObjectsRanges(opts.Parallelism) {
+		keyRange := keyRange
+		group.Go(func() error {
+			count, err := db.deleteBucketObjectsRange(groupCtx, opts, limiter, keyRange, progress)
+			deleted.Add(count)
+			return err
+		})
+	}
+	err = group.Wait()
+	return deleted.Load(), err
+}
+
+// deleteBucketObjectsCursor is the position of the last deleted object.
+type deleteBucketObjectsCursor struct {
+	key     ObjectKey
+	version Version
+}
+
+// deleteBucketObjectsRange is a part of the bucket keyspace deleted by a single worker.
+type deleteBucketObjectsRange struct {
+	// start is the first key of the range, empty for the start of the bucket.
+	start ObjectKey
+	// end is the key after the range, empty for the end of the bucket.
+	end ObjectKey
+
+	cursor deleteBucketObjectsCursor
+}
+
+// splitDeleteBucketObjectsRanges splits the keyspace into n ranges by the first byte of the object key.
+func splitDeleteBucketObjectsRanges(n int) []deleteBucketObjectsRange {
+	if n > 256 {
+		n = 256
+	}
+	ranges := make([]deleteBucketObjectsRange, n)
+	for i := range ranges {
+		if i > 0 {
+			ranges[i].start = ObjectKey([]byte{byte(256 * i / n)})
+		}
+		if i < n-1 {
+			ranges[i].end = ObjectKey([]byte{byte(256 * (i + 1) / n)})
 		}
+		ranges[i].cursor.key = ranges[i].start
+	}
+	return ranges
+}
+
+func (db *DB) deleteBucketObjectsRange(ctx context.Context, opts DeleteBucketObjects, limiter *rate.Limiter, keyRange deleteBucketObjectsRange, progress func(context.Context, DeleteBucketObjectsProgress) error) (deletedObjectCount int64, err error) {
+	defer mon.Task()(&ctx)(&err)
 
-		deletedBatchCount, err = db.deleteBucketObjects(ctx, opts)
-		deletedObjectCount += deletedBatchCount
+	cursor := keyRange.cursor
 
+	for {
+		if err := limiter.Wait(ctx); err != nil {
+			return deletedObjectCount, err
+		}
+
+		batch, err := db.deleteBucketObjects(ctx, opts, cursor, keyRange.end)
+		deletedObjectCount += batch.DeletedObjects
 		if err != nil {
 			return deletedObjectCount, err
 		}
+		if batch.DeletedObjects == 0 {
+			return deletedObjectCount, nil
+		}
+		cursor = batch.last
+
+		if progress != nil {
+			err := progress(ctx, DeleteBucketObjectsProgress{
+				DeletedObjects:    batch.DeletedObjects,
+				DeletedSegments:   batch.DeletedSegments,
+				ContinuationToken: newContinuationToken(cursor),
+			})
+			if err != nil {
+				return deletedObjectCount, err
+			}
+		}
 	}
+}
+
+type deletedBucketObjectsBatch struct {
+	DeletedObjects  int64
+	DeletedSegments int64
+
+	last deleteBucketObjectsCursor
+}
+
+func (db *DB) deleteBucketObjects(ctx context.Context, opts DeleteBucketObjects, after deleteBucketObjectsCursor, end ObjectKey) (batch deletedBucketObjectsBatch, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	batch, err = db.ChooseAdapter(opts.Bucket.ProjectID).deleteBucketObjectsBatch(ctx, opts, after, end)
+	if err != nil {
+		return deletedBucketObjectsBatch{}, err
+	}
+
+	mon.Meter("object_delete").Mark64(batch.DeletedObjects)
+	mon.Meter("segment_delete").Mark64(batch.DeletedSegments)
 
-	return deletedObjectCount, nil
+	return batch, nil
 }
 
-func (db *DB) deleteBucketObjects(ctx context.Context, opts DeleteBucketObjects) (deletedObjectCount int64, err error) {
+func (p *PostgresAdapter) deleteBucketObjectsBatch(ctx context.Context, opts DeleteBucketObjects, after deleteBucketObjectsCursor, end ObjectKey) (batch deletedBucketObjectsBatch, err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	const tag = queryTag("delete_bucket_objects")
+	done := tag.observe(p.impl.String())
+	defer func() { done(batch.DeletedObjects, err) }()
+
+	args := []any{
+		opts.Bucket.ProjectID, []byte(opts.Bucket.BucketName), opts.BatchSize,
+		[]byte(after.key), after.version,
+	}
+	endCondition := ""
+	if end != "" {
+		endCondition = "AND object_key < $6"
+		args = append(args, []byte(end))
+	}
+
 	var query string
 
-	switch db.impl {
+	switch p.impl {
 	case dbutil.Cockroach:
 		query = `
 		WITH deleted_objects AS (
 			DELETE FROM objects
-			WHERE (project_id, bucket_name) = ($1, $2)
+			WHERE
+				(project_id, bucket_name) = ($1, $2) AND
+				(object_key, version) > ($4, $5)
+				` + endCondition + `
+			ORDER BY object_key, version
 			LIMIT $3
-			RETURNING objects.stream_id, objects.segment_count
+			RETURNING objects.object_key, objects.version, objects.stream_id, objects.segment_count
 		), deleted_segments AS (
 			DELETE FROM segments
 			WHERE segments.stream_id IN (SELECT deleted_objects.stream_id FROM deleted_objects)
 			RETURNING segments.stream_id
 		)
-		SELECT COUNT(1), COALESCE(SUM(segment_count), 0) FROM deleted_objects
+		SELECT
+			COUNT(1), COALESCE(SUM(segment_count), 0),
+			(SELECT object_key FROM deleted_objects ORDER BY object_key DESC, version DESC LIMIT 1),
+			(SELECT version FROM deleted_objects ORDER BY object_key DESC, version DESC LIMIT 1)
+		FROM deleted_objects
 	`
 	case dbutil.Postgres:
 		query = `
@@ -75,29 +285,120 @@ func (db *DB) deleteBucketObjects(ctx context.Context, opts DeleteBucketObjects)
 			DELETE FROM objects
 			WHERE stream_id IN (
 				SELECT stream_id FROM objects
-				WHERE (project_id, bucket_name) = ($1, $2)
+				WHERE
+					(project_id, bucket_name) = ($1, $2) AND
+					(object_key, version) > ($4, $5)
+					` + endCondition + `
+				ORDER BY object_key, version
 				LIMIT $3
 			)
-			RETURNING objects.stream_id, objects.segment_count
+			RETURNING objects.object_key, objects.version, objects.stream_id, objects.segment_count
 		), deleted_segments AS (
 			DELETE FROM segments
 			WHERE segments.stream_id IN (SELECT deleted_objects.stream_id FROM deleted_objects)
 			RETURNING segments.stream_id
 		)
-		SELECT COUNT(1), COALESCE(SUM(segment_count), 0) FROM deleted_objects
+		SELECT
+			COUNT(1), COALESCE(SUM(segment_count), 0),
+			(SELECT object_key FROM deleted_objects ORDER BY object_key DESC, version DESC LIMIT 1),
+			(SELECT version FROM deleted_objects ORDER BY object_key DESC, version DESC LIMIT 1)
+		FROM deleted_objects
 	`
 	default:
-		return 0, Error.New("unhandled database: %v", db.impl)
+		return deletedBucketObjectsBatch{}, Error.New("unhandled database: %v", p.impl)
 	}
 
-	var deletedSegmentCount int64
-	err = db.db.QueryRowContext(ctx, query, opts.Bucket.ProjectID, []byte(opts.Bucket.BucketName), opts.BatchSize).Scan(&deletedObjectCount, &deletedSegmentCount)
+	var lastKey []byte
+	var lastVersion sql.NullInt64
+	err = p.db.QueryRowContext(ctx, tag.postgres(query), args...).
+		Scan(&batch.DeletedObjects, &batch.DeletedSegments, &lastKey, &lastVersion)
 	if err != nil {
-		return 0, Error.Wrap(err)
+		return deletedBucketObjectsBatch{}, Error.Wrap(err)
+	}
+	batch.last = deleteBucketObjectsCursor{
+		key:     ObjectKey(lastKey),
+		version: Version(lastVersion.Int64),
 	}
+	return batch, nil
+}
 
-	mon.Meter("object_delete").Mark64(deletedObjectCount)
-	mon.Meter("segment_delete").Mark64(deletedSegmentCount)
+func (s *SpannerAdapter) deleteBucketObjectsBatch(ctx context.Context, opts DeleteBucketObjects, after deleteBucketObjectsCursor, end ObjectKey) (batch deletedBucketObjectsBatch, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	const tag = queryTag("delete_bucket_objects")
+	done := tag.observe(s.Name())
+	defer func() { done(batch.DeletedObjects, err) }()
+
+	endCondition := ""
+	params := map[string]interface{}{
+		"project_id":    opts.Bucket.ProjectID,
+		"bucket_name":   opts.Bucket.BucketName,
+		"after_key":     after.key,
+		"after_version": after.version,
+		"batch_size":    int64(opts.BatchSize),
+	}
+	if end != "" {
+		endCondition = "AND object_key < @end_key"
+		params["end_key"] = end
+	}
 
-	return deletedObjectCount, nil
+	_, err = s.client.ReadWriteTransaction(ctx, func(ctx context.Context, tx *spanner.ReadWriteTransaction) error {
+		// the transaction may be retried, so the batch is collected from scratch.
+		batch = deletedBucketObjectsBatch{}
+
+		// DML statements can't be ordered or limited, hence the batch is selected first.
+		var mutations []*spanner.Mutation
+		var streamIDs [][]byte
+		err := tx.QueryWithOptions(ctx, spanner.Statement{
+			SQL: `
+				SELECT object_key, version, stream_id, segment_count
+				FROM objects
+				WHERE
+					project_id = @project_id AND bucket_name = @bucket_name
+					AND (object_key > @after_key OR (object_key = @after_key AND version > @after_version))
+					` + endCondition + `
+				ORDER BY object_key, version
+				LIMIT @batch_size
+			`,
+			Params: params,
+		}, tag.spanner()).Do(func(row *spanner.Row) error {
+			var streamID uuid.UUID
+			var segmentCount int64
+			if err := row.Columns(&batch.last.key, &batch.last.version, &streamID, &segmentCount); err != nil {
+				return err
+			}
+			mutations = append(mutations, spanner.Delete("objects", spanner.Key{
+				opts.Bucket.ProjectID, opts.Bucket.BucketName, batch.last.key, batch.last.version,
+			}))
+			streamIDs = append(streamIDs, streamID.Bytes())
+			batch.DeletedObjects++
+			batch.DeletedSegments += segmentCount
+			return nil
+		})
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		if len(mutations) == 0 {
+			return nil
+		}
+
+		_, err = tx.Update(ctx, spanner.Statement{
+			SQL: `
+				DELETE FROM segments
+				WHERE ARRAY_INCLUDES(@stream_ids, stream_id)
+			`,
+			Params: map[string]interface{}{
+				"stream_ids": streamIDs,
+			},
+		})
+		if err != nil {
+			return Error.Wrap(err)
+		}
+
+		return Error.Wrap(tx.BufferWrite(mutations))
+	})
+	if err != nil {
+		return deletedBucketObjectsBatch{}, Error.Wrap(err)
+	}
+	return batch, nil
 }
@@ -5,6 +5,7 @@ package metabase_test
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"testing"
 	"time"
@@ -238,7 +239,7 @@ func TestDeleteBucketObjects(t *testing.T) {
 
 			metabasetest.Verify{}.Check(ctx, t, db)
 		})
-	})
+	}, metabasetest.WithSpanner())
 }
 
 func TestDeleteBucketObjectsParallel(t *testing.T) {
@@ -270,7 +271,7 @@ func TestDeleteBucketObjectsParallel(t *testing.T) {
 		require.NoError(t, errgroup.Wait())
 
 		metabasetest.Verify{}.Check(ctx, t, db)
-	})
+	}, metabasetest.WithSpanner())
 }
 
 func TestDeleteBucketObjectsCancel(t *testing.T) {
@@ -293,7 +294,132 @@ func TestDeleteBucketObjectsCancel(t *testing.T) {
 				metabasetest.DefaultRawSegment(object.ObjectStream, metabase.SegmentPosition{}),
 			},
 		}.Check(ctx, t, db)
-	})
+	}, metabasetest.WithSpanner())
+}
+
+func TestDeleteBucketObjectsResume(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+		obj := metabasetest.RandObjectStream()
+		for i := 0; i < 5; i++ {
+			obj.ObjectKey = metabase.ObjectKey(fmt.Sprintf("object-%d", i))
+			obj.StreamID = testrand.UUID()
+			metabasetest.CreateObject(ctx, t, db, obj, 1)
+		}
+
+		errStop := errors.New("stop")
+		var token metabase.ContinuationToken
+		var batches int
+		deleted, err := db.DeleteBucketObjects(ctx, metabase.DeleteBucketObjects{
+			Bucket:    obj.Location().Bucket(),
+			BatchSize: 2,
+			Progress: func(ctx context.Context, progress metabase.DeleteBucketObjectsProgress) error {
+				batches++
+				require.EqualValues(t, 2, progress.DeletedObjects)
+				require.EqualValues(t, 2, progress.DeletedSegments)
+				token = progress.ContinuationToken
+				return errStop
+			},
+		})
+		require.ErrorIs(t, err, errStop)
+		require.EqualValues(t, 2, deleted)
+		require.Equal(t, 1, batches)
+		require.NotEmpty(t, token)
+
+		deleted, err = db.DeleteBucketObjects(ctx, metabase.DeleteBucketObjects{
+			Bucket:            obj.Location().Bucket(),
+			BatchSize:         2,
+			ContinuationToken: token,
+		})
+		require.NoError(t, err)
+		require.EqualValues(t, 3, deleted)
+
+		_, err = db.DeleteBucketObjects(ctx, metabase.DeleteBucketObjects{
+			Bucket:            obj.Location().Bucket(),
+			ContinuationToken: metabase.ContinuationToken{1, 2},
+		})
+		require.True(t, metabase.ErrInvalidRequest.Has(err), err)
+
+		metabasetest.Verify{}.Check(ctx, t, db)
+	}, metabasetest.WithSpanner())
+}
+
+func TestDeleteBucketObjectsSinglePass(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+		obj := metabasetest.RandObjectStream()
+		for i := 1; i <= 4; i++ {
+			obj.ObjectKey = metabase.ObjectKey(fmt.Sprintf("object-%d", i))
+			obj.StreamID = testrand.UUID()
+			metabasetest.CreateObject(ctx, t, db, obj, 1)
+		}
+
+		// an object inserted behind the cursor is not picked up again.
+		behind := obj
+		behind.ObjectKey = "object-0"
+		behind.StreamID = testrand.UUID()
+
+		var batches int
+		var inserted metabase.Object
+		deleted, err := db.DeleteBucketObjects(ctx, metabase.DeleteBucketObjects{
+			Bucket:    obj.Location().Bucket(),
+			BatchSize: 2,
+			Progress: func(_ context.Context, progress metabase.DeleteBucketObjectsProgress) error {
+				batches++
+				if batches == 1 {
+					inserted = metabasetest.CreateObject(ctx, t, db, behind, 0)
+				}
+				return nil
+			},
+		})
+		require.NoError(t, err)
+		require.EqualValues(t, 4, deleted)
+		require.Equal(t, 2, batches)
+
+		metabasetest.Verify{
+			Objects: []metabase.RawObject{metabase.RawObject(inserted)},
+		}.Check(ctx, t, db)
+	}, metabasetest.WithSpanner())
+}
+
+func TestDeleteBucketObjectsParallelism(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+		obj := metabasetest.RandObjectStream()
+		for _, key := range []string{"\x00a", "\x3fz", "\x40", "\x7f\xff", "\x80", "a/b/c", "\xff\xff"} {
+			obj.ObjectKey = metabase.ObjectKey(key)
+			obj.StreamID = testrand.UUID()
+			metabasetest.CreateObject(ctx, t, db, obj, 1)
+		}
+
+		var batches int
+		deleted, err := db.DeleteBucketObjects(ctx, metabase.DeleteBucketObjects{
+			Bucket:              obj.Location().Bucket(),
+			BatchSize:           2,
+			Parallelism:         4,
+			MaxBatchesPerSecond: 1000,
+			Progress: func(ctx context.Context, progress metabase.DeleteBucketObjectsProgress) error {
+				batches++
+				require.Empty(t, progress.ContinuationToken)
+				return nil
+			},
+		})
+		require.NoError(t, err)
+		require.EqualValues(t, 7, deleted)
+		require.NotZero(t, batches)
+
+		_, err = db.DeleteBucketObjects(ctx, metabase.DeleteBucketObjects{
+			Bucket:            obj.Location().Bucket(),
+			Parallelism:       2,
+			ContinuationToken: metabase.ContinuationToken{0, 0, 0, 0, 0, 0, 0, 1},
+		})
+		require.True(t, metabase.ErrInvalidRequest.Has(err), err)
+
+		metabasetest.Verify{}.Check(ctx, t, db)
+	}, metabasetest.WithSpanner())
 }
 
 func TestDeleteBucketWithCopies(t *testing.T) {
@@ -16,6 +16,7 @@ import (
 	"go.uber.org/zap"
 	"google.golang.org/api/iterator"
 
+	"storj.io/common/uuid"
 	"storj.io/storj/shared/dbutil/pgxutil"
 	"storj.io/storj/shared/tagsql"
 )
@@ -29,65 +30,129 @@ type DeleteExpiredObjects struct {
 	ExpiredBefore      time.Time
 	AsOfSystemInterval time.Duration
 	BatchSize          int
+
+	// SpannerPartitionedDML deletes the segments of the expired objects on
+	// Spanner with partitioned DML, outside of the transaction deleting the
+	// objects. The segments are deleted first, so a failed batch leaves
+	// expired objects behind, which are retried on the next run.
+	SpannerPartitionedDML bool
+}
+
+// DeleteExpiredObjectsResult contains the result of deleting expired objects.
+type DeleteExpiredObjectsResult struct {
+	DeletedObjects  int64
+	DeletedSegments int64
+	// DeletedBytes contains the encrypted size of the deleted objects per project.
+	DeletedBytes map[uuid.UUID]int64
+}
+
+// ExpiredObject is an object found for expiration.
+type ExpiredObject struct {
+	ObjectStream
+
+	ExpiresAt          time.Time
+	TotalEncryptedSize int64
 }
 
 // DeleteExpiredObjects deletes all objects that expired before expiredBefore.
-func (db *DB) DeleteExpiredObjects(ctx context.Context, opts DeleteExpiredObjects) (err error) {
+// Pinned object versions are kept until their pins are released.
+//
+// The objects are found through the expires_at index, in the order of their
+// expiration, so only the expired objects are scanned.
+func (db *DB) DeleteExpiredObjects(ctx context.Context, opts DeleteExpiredObjects) (result DeleteExpiredObjectsResult, err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	deleteBatchsizeLimit.Ensure(&opts.BatchSize)
+
+	result.DeletedBytes = map[uuid.UUID]int64{}
 	for _, a := range db.adapters {
-		err = db.deleteObjectsAndSegmentsBatch(ctx, opts.BatchSize, func(startAfter ObjectStream, batchsize int) (last ObjectStream, err error) {
-			expiredObjects, err := a.FindExpiredObjects(ctx, opts, startAfter, batchsize)
-			if err != nil {
-				return ObjectStream{}, Error.New("unable to select expired objects for deletion: %w", err)
-			}
+		err = db.deleteExpiredObjects(ctx, a, opts, &result)
+		if err != nil {
+			db.log.Error("failed to delete expired objects from DB", zap.Error(err), zap.String("adapter", fmt.Sprintf("%T", a)))
+		}
+	}
+	return result, nil
+}
 
-			if len(expiredObjects) == 0 {
-				return ObjectStream{}, nil
-			}
+func (db *DB) deleteExpiredObjects(ctx context.Context, adapter Adapter, opts DeleteExpiredObjects, result *DeleteExpiredObjectsResult) (err error) {
+	defer mon.Task()(&ctx)(&err)
 
-			objectsDeleted, segmentsDeleted, err := a.DeleteObjectsAndSegments(ctx, expiredObjects)
+	var startAfter ExpiredObject
+	for {
+		expiredObjects, err := adapter.FindExpiredObjects(ctx, opts, startAfter, opts.BatchSize)
+		if err != nil {
+			return Error.New("unable to select expired objects for deletion: %w", err)
+		}
+		if len(expiredObjects) == 0 {
+			return nil
+		}
 
-			mon.Meter("object_delete").Mark64(objectsDeleted)
-			mon.Meter("segment_delete").Mark64(segmentsDeleted)
+		streams := make([]ObjectStream, len(expiredObjects))
+		for i, object := range expiredObjects {
+			streams[i] = object.ObjectStream
+		}
 
-			return expiredObjects[len(expiredObjects)-1], err
-		})
+		objectsDeleted, segmentsDeleted, err := adapter.DeleteExpiredObjectsAndSegments(ctx, opts, streams)
 		if err != nil {
-			db.log.Error("failed to delete expired objects from DB", zap.Error(err), zap.String("adapter", fmt.Sprintf("%T", a)))
+			return err
+		}
+
+		mon.Meter("object_delete").Mark64(objectsDeleted)
+		mon.Meter("segment_delete").Mark64(segmentsDeleted)
+
+		result.DeletedObjects += objectsDeleted
+		result.DeletedSegments += segmentsDeleted
+		for _, object := range expiredObjects {
+			mon.Meter("expired_bytes_delete").Mark64(object.TotalEncryptedSize)
+			result.DeletedBytes[object.ProjectID] += object.TotalEncryptedSize
 		}
+
+		if len(expiredObjects) < opts.BatchSize {
+			return nil
+		}
+		startAfter = expiredObjects[len(expiredObjects)-1]
 	}
-	return nil
 }
 
-// FindExpiredObjects finds up to batchSize objects that expired before opts.ExpiredBefore.
-func (p *PostgresAdapter) FindExpiredObjects(ctx context.Context, opts DeleteExpiredObjects, startAfter ObjectStream, batchSize int) (expiredObjects []ObjectStream, err error) {
+// FindExpiredObjects finds up to batchSize objects that expired before opts.ExpiredBefore,
+// ordered by their expiration time.
+func (p *PostgresAdapter) FindExpiredObjects(ctx context.Context, opts DeleteExpiredObjects, startAfter ExpiredObject, batchSize int) (expiredObjects []ExpiredObject, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	const tag = queryTag("find_expired_objects")
+	done := tag.observe(p.impl.String())
+	defer func() { done(int64(len(expiredObjects)), err) }()
+
 	query := `
 		SELECT
 			project_id, bucket_name, object_key, version, stream_id,
-			expires_at
+			expires_at, total_encrypted_size
 		FROM objects
 		` + p.impl.AsOfSystemInterval(opts.AsOfSystemInterval) + `
 		WHERE
-			(project_id, bucket_name, object_key, version) > ($1, $2, $3, $4)
-			AND expires_at < $5
-			ORDER BY project_id, bucket_name, object_key, version
-		LIMIT $6;
+			expires_at < $6
+			AND (expires_at, project_id, bucket_name, object_key, version) > ($1, $2, $3, $4, $5)
+			AND NOT EXISTS (
+				SELECT 1 FROM object_pins
+				WHERE (object_pins.project_id, object_pins.bucket_name, object_pins.object_key, object_pins.version) =
+					(objects.project_id, objects.bucket_name, objects.object_key, objects.version)
+			)
+			ORDER BY expires_at, project_id, bucket_name, object_key, version
+		LIMIT $7;
 	`
 
-	expiredObjects = make([]ObjectStream, 0, batchSize)
+	expiredObjects = make([]ExpiredObject, 0, batchSize)
 
 	err = withRows(p.db.QueryContext(ctx, query,
-		startAfter.ProjectID, []byte(startAfter.BucketName), []byte(startAfter.ObjectKey), startAfter.Version,
+		startAfter.ExpiresAt, startAfter.ProjectID, []byte(startAfter.BucketName), []byte(startAfter.ObjectKey), startAfter.Version,
 		opts.ExpiredBefore,
 		batchSize),
 	)(func(rows tagsql.Rows) error {
-		var last ObjectStream
 		for rows.Next() {
-			var expiresAt time.Time
+			var last ExpiredObject
 			err = rows.Scan(
 				&last.ProjectID, &last.BucketName, &last.ObjectKey, &last.Version, &last.StreamID,
-				&expiresAt)
+				&last.ExpiresAt, &last.TotalEncryptedSize)
 			if err != nil {
 				return Error.Wrap(err)
 			}
@@ -98,7 +163,7 @@ func (p *PostgresAdapter) FindExpiredObjects(ctx context.Context, opts DeleteExp
 				zap.String("Object Key", string(last.ObjectKey)),
 				zap.Int64("Version", int64(last.Version)),
 				zap.String("StreamID", hex.EncodeToString(last.StreamID[:])),
-				zap.Time("Expired At", expiresAt),
+				zap.Time("Expired At", last.ExpiresAt),
 			)
 			expiredObjects = append(expiredObjects, last)
 		}
@@ -111,35 +176,51 @@ func (p *PostgresAdapter) FindExpiredObjects(ctx context.Context, opts DeleteExp
 	return expiredObjects, nil
 }
 
-// FindExpiredObjects finds up to batchSize objects that expired before opts.ExpiredBefore.
-func (s *SpannerAdapter) FindExpiredObjects(ctx context.Context, opts DeleteExpiredObjects, startAfter ObjectStream, batchSize int) (expiredObjects []ObjectStream, err error) {
-	// TODO(spanner): check whether this query is executed efficiently
+// FindExpiredObjects finds up to batchSize objects that expired before opts.ExpiredBefore,
+// ordered by their expiration time.
+func (s *SpannerAdapter) FindExpiredObjects(ctx context.Context, opts DeleteExpiredObjects, startAfter ExpiredObject, batchSize int) (expiredObjects []ExpiredObject, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	const tag = queryTag("find_expired_objects")
+	done := tag.observe(s.Name())
+	defer func() { done(int64(len(expiredObjects)), err) }()
+
 	query := `
 		SELECT
 			project_id, bucket_name, object_key, version, stream_id,
-			expires_at
+			expires_at, total_encrypted_size
 		FROM objects
 		WHERE
-			expires_at < @expires_at
+			expires_at < @expired_before
 			AND (
-				project_id > @project_id
-				OR (project_id = @project_id AND bucket_name > @bucket_name)
-				OR (project_id = @project_id AND bucket_name = @bucket_name AND object_key > @object_key)
-				OR (project_id = @project_id AND bucket_name = @bucket_name AND object_key = @object_key AND version > @version)
+				expires_at > @expires_at
+				OR (expires_at = @expires_at AND project_id > @project_id)
+				OR (expires_at = @expires_at AND project_id = @project_id AND bucket_name > @bucket_name)
+				OR (expires_at = @expires_at AND project_id = @project_id AND bucket_name = @bucket_name AND object_key > @object_key)
+				OR (expires_at = @expires_at AND project_id = @project_id AND bucket_name = @bucket_name AND object_key = @object_key AND version > @version)
+			)
+			AND NOT EXISTS (
+				SELECT 1 FROM object_pins
+				WHERE
+					object_pins.project_id = objects.project_id
+					AND object_pins.bucket_name = objects.bucket_name
+					AND object_pins.object_key = objects.object_key
+					AND object_pins.version = objects.version
 			)
-			ORDER BY project_id, bucket_name, object_key, version
+			ORDER BY expires_at, project_id, bucket_name, object_key, version
 		LIMIT @batch_size;
 	`
 
-	expiredObjects = make([]ObjectStream, 0, batchSize)
+	expiredObjects = make([]ExpiredObject, 0, batchSize)
 
 	rowIterator := s.client.Single().Query(ctx, spanner.Statement{SQL: query, Params: map[string]interface{}{
-		"project_id":  startAfter.ProjectID,
-		"bucket_name": startAfter.BucketName,
-		"object_key":  startAfter.ObjectKey,
-		"version":     startAfter.Version,
-		"expires_at":  opts.ExpiredBefore,
-		"batch_size":  batchSize,
+		"expires_at":     startAfter.ExpiresAt,
+		"project_id":     startAfter.ProjectID,
+		"bucket_name":    startAfter.BucketName,
+		"object_key":     startAfter.ObjectKey,
+		"version":        startAfter.Version,
+		"expired_before": opts.ExpiredBefore,
+		"batch_size":     batchSize,
 	}})
 	defer rowIterator.Stop()
 
@@ -152,11 +233,10 @@ func (s *SpannerAdapter) FindExpiredObjects(ctx context.Context, opts DeleteExpi
 			return nil, Error.Wrap(err)
 		}
 
-		var last ObjectStream
-		var expiresAt time.Time
+		var last ExpiredObject
 		err = row.Columns(
 			&last.ProjectID, &last.BucketName, &last.ObjectKey, &last.Version, &last.StreamID,
-			&expiresAt)
+			&last.ExpiresAt, &last.TotalEncryptedSize)
 		if err != nil {
 			return nil, Error.Wrap(err)
 		}
@@ -167,19 +247,127 @@ func (s *SpannerAdapter) FindExpiredObjects(ctx context.Context, opts DeleteExpi
 			zap.String("Object Key", string(last.ObjectKey)),
 			zap.Int64("Version", int64(last.Version)),
 			zap.String("StreamID", hex.EncodeToString(last.StreamID[:])),
-			zap.Time("Expired At", expiresAt),
+			zap.Time("Expired At", last.ExpiresAt),
 		)
 		expiredObjects = append(expiredObjects, last)
 	}
 	return expiredObjects, nil
 }
 
+// DeleteExpiredObjectsAndSegments deletes the expired objects and their segments.
+func (p *PostgresAdapter) DeleteExpiredObjectsAndSegments(ctx context.Context, opts DeleteExpiredObjects, objects []ObjectStream) (objectsDeleted, segmentsDeleted int64, err error) {
+	const tag = queryTag("delete_expired_objects_and_segments")
+	done := tag.observe(p.impl.String())
+	defer func() { done(objectsDeleted, err) }()
+
+	return p.DeleteObjectsAndSegments(ctx, objects)
+}
+
+// DeleteExpiredObjectsAndSegments deletes the expired objects and their segments.
+// When opts.SpannerPartitionedDML is set, the segments are deleted with partitioned DML.
+func (s *SpannerAdapter) DeleteExpiredObjectsAndSegments(ctx context.Context, opts DeleteExpiredObjects, objects []ObjectStream) (objectsDeleted, segmentsDeleted int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	const tag = queryTag("delete_expired_objects_and_segments")
+	done := tag.observe(s.Name())
+	defer func() { done(objectsDeleted, err) }()
+
+	if !opts.SpannerPartitionedDML {
+		return s.DeleteObjectsAndSegments(ctx, objects)
+	}
+
+	// partitioned DML runs outside of a transaction, so a pin created after
+	// this check isn't noticed. FindExpiredObjects already skips the pinned
+	// versions, this only narrows the window further.
+	objects, err = spannerExcludePinned(ctx, s.client.Single(), objects)
+	if err != nil {
+		return 0, 0, Error.New("unable to check object pins: %w", err)
+	}
+	if len(objects) == 0 {
+		return 0, 0, nil
+	}
+
+	streamIDs := make([][]byte, 0, len(objects))
+	for _, obj := range objects {
+		streamIDs = append(streamIDs, obj.StreamID.Bytes())
+	}
+	segmentsDeleted, err = s.client.PartitionedUpdate(ctx, spanner.Statement{
+		SQL: `
+			DELETE FROM segments
+			WHERE ARRAY_INCLUDES(@stream_ids, stream_id)
+		`,
+		Params: map[string]interface{}{
+			"stream_ids": streamIDs,
+		},
+	})
+	if err != nil {
+		return 0, 0, Error.New("unable to delete expired segments: %w", err)
+	}
+
+	_, err = s.client.ReadWriteTransaction(ctx, func(ctx context.Context, tx *spanner.ReadWriteTransaction) error {
+		statements := make([]spanner.Statement, 0, len(objects))
+		for _, obj := range objects {
+			statements = append(statements, spanner.Statement{
+				SQL: `
+					DELETE FROM objects
+					WHERE (project_id, bucket_name, object_key, version, stream_id) = (@project_id, @bucket_name, @object_key, @version, @stream_id)
+				`,
+				Params: map[string]interface{}{
+					"project_id":  obj.ProjectID,
+					"bucket_name": obj.BucketName,
+					"object_key":  obj.ObjectKey,
+					"version":     obj.Version,
+					"stream_id":   obj.StreamID,
+				},
+			})
+		}
+		numDeleteds, err := tx.BatchUpdate(ctx, statements)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		objectsDeleted = 0
+		for _, numDeleted := range numDeleteds {
+			objectsDeleted += numDeleted
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, segmentsDeleted, Error.New("unable to delete expired objects: %w", err)
+	}
+	return objectsDeleted, segmentsDeleted, nil
+}
+
 // DeleteZombieObjects contains all the information necessary to delete zombie objects and segments.
 type DeleteZombieObjects struct {
 	DeadlineBefore     time.Time
 	InactiveDeadline   time.Time
 	AsOfSystemInterval time.Duration
 	BatchSize          int
+
+	// ProjectID limits the deletion to a single project, and together with
+	// BucketName to a single bucket. The whole metabase is processed when it is zero.
+	ProjectID  uuid.UUID
+	BucketName string
+
+	// SkipProjects and SkipBuckets exclude the pending objects of locations
+	// which are cleaned up with a different grace period.
+	SkipProjects []uuid.UUID
+	SkipBuckets  []BucketLocation
+}
+
+// Verify verifies the request fields.
+func (opts *DeleteZombieObjects) Verify() error {
+	if opts.ProjectID.IsZero() && opts.BucketName != "" {
+		return ErrInvalidRequest.New("ProjectID missing")
+	}
+	return nil
+}
+
+// DeleteZombieObjectsResult contains the number of deleted zombie objects, segments and their encrypted bytes.
+type DeleteZombieObjectsResult struct {
+	ObjectsDeleted  int64
+	SegmentsDeleted int64
+	BytesDeleted    int64
 }
 
 // DeleteZombieObjects deletes all objects that zombie deletion deadline passed.
@@ -187,37 +375,113 @@ type DeleteZombieObjects struct {
 func (db *DB) DeleteZombieObjects(ctx context.Context, opts DeleteZombieObjects) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	if err := opts.Verify(); err != nil {
+		return err
+	}
+
 	for _, a := range db.adapters {
-		err = db.deleteObjectsAndSegmentsBatch(ctx, opts.BatchSize, func(startAfter ObjectStream, batchsize int) (last ObjectStream, err error) {
-			objects, err := a.FindZombieObjects(ctx, opts, startAfter, batchsize)
-			if err != nil {
-				return ObjectStream{}, Error.Wrap(err)
-			}
+		_, err = db.deleteZombieObjects(ctx, a, opts)
+		if err != nil {
+			db.log.Warn("delete from DB zombie objects", zap.Error(err))
+		}
+	}
+	return nil
+}
 
-			if len(objects) == 0 {
-				return ObjectStream{}, nil
-			}
-			objectsDeleted, segmentsDeleted, err := a.DeleteInactiveObjectsAndSegments(ctx, objects, opts)
-			if err != nil {
-				return ObjectStream{}, Error.Wrap(err)
-			}
+// DeleteBucketZombieObjects deletes the zombie objects of a single project or bucket
+// and reports how much was deleted. Unlike DeleteZombieObjects it returns the
+// first failure, so that callers triggering a targeted cleanup see it.
+func (db *DB) DeleteBucketZombieObjects(ctx context.Context, opts DeleteZombieObjects) (result DeleteZombieObjectsResult, err error) {
+	defer mon.Task()(&ctx)(&err)
 
-			mon.Meter("zombie_object_delete").Mark64(objectsDeleted)
-			mon.Meter("object_delete").Mark64(objectsDeleted)
-			mon.Meter("zombie_segment_delete").Mark64(segmentsDeleted)
-			mon.Meter("segment_delete").Mark64(segmentsDeleted)
+	if opts.ProjectID.IsZero() {
+		return DeleteZombieObjectsResult{}, ErrInvalidRequest.New("ProjectID missing")
+	}
+	if err := opts.Verify(); err != nil {
+		return DeleteZombieObjectsResult{}, err
+	}
 
-			return objects[len(objects)-1], nil
-		})
+	for _, a := range db.adapters {
+		deleted, err := db.deleteZombieObjects(ctx, a, opts)
+		result.ObjectsDeleted += deleted.ObjectsDeleted
+		result.SegmentsDeleted += deleted.SegmentsDeleted
+		result.BytesDeleted += deleted.BytesDeleted
 		if err != nil {
-			db.log.Warn("delete from DB zombie objects", zap.Error(err))
+			return result, err
 		}
 	}
-	return nil
+	return result, nil
+}
+
+func (db *DB) deleteZombieObjects(ctx context.Context, a Adapter, opts DeleteZombieObjects) (result DeleteZombieObjectsResult, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	skipProjects := make(map[uuid.UUID]struct{}, len(opts.SkipProjects))
+	for _, projectID := range opts.SkipProjects {
+		skipProjects[projectID] = struct{}{}
+	}
+	skipBuckets := make(map[BucketLocation]struct{}, len(opts.SkipBuckets))
+	for _, bucket := range opts.SkipBuckets {
+		skipBuckets[bucket] = struct{}{}
+	}
+	skipped := func(object ObjectStream) bool {
+		if _, ok := skipProjects[object.ProjectID]; ok {
+			return true
+		}
+		_, ok := skipBuckets[object.Location().Bucket()]
+		return ok
+	}
+
+	// when the deletion is limited to a location, start right before it.
+	initial := ObjectStream{ProjectID: opts.ProjectID, BucketName: opts.BucketName}
+
+	err = db.deleteObjectsAndSegmentsBatchFrom(ctx, initial, opts.BatchSize, func(startAfter ObjectStream, batchsize int) (last ObjectStream, err error) {
+		objects, err := a.FindZombieObjects(ctx, opts, startAfter, batchsize)
+		if err != nil {
+			return ObjectStream{}, Error.Wrap(err)
+		}
+
+		if len(objects) == 0 {
+			return ObjectStream{}, nil
+		}
+		last = objects[len(objects)-1]
+
+		toDelete := objects[:0:0]
+		for _, object := range objects {
+			if !skipped(object) {
+				toDelete = append(toDelete, object)
+			}
+		}
+		if len(toDelete) == 0 {
+			return last, nil
+		}
+
+		objectsDeleted, segmentsDeleted, bytesDeleted, err := a.DeleteInactiveObjectsAndSegments(ctx, toDelete, opts)
+		if err != nil {
+			return ObjectStream{}, Error.Wrap(err)
+		}
+
+		result.ObjectsDeleted += objectsDeleted
+		result.SegmentsDeleted += segmentsDeleted
+		result.BytesDeleted += bytesDeleted
+
+		mon.Meter("zombie_object_delete").Mark64(objectsDeleted)
+		mon.Meter("object_delete").Mark64(objectsDeleted)
+		mon.Meter("zombie_segment_delete").Mark64(segmentsDeleted)
+		mon.Meter("segment_delete").Mark64(segmentsDeleted)
+		mon.Meter("zombie_bytes_reclaimed").Mark64(bytesDeleted)
+
+		return last, nil
+	})
+	return result, err
 }
 
 // FindZombieObjects locates up to batchSize zombie objects that need deletion.
 func (p *PostgresAdapter) FindZombieObjects(ctx context.Context, opts DeleteZombieObjects, startAfter ObjectStream, batchSize int) (objects []ObjectStream, err error) {
+	const tag = queryTag("find_zombie_objects")
+	done := tag.observe(p.impl.String())
+	defer func() { done(int64(len(objects)), err) }()
+
 	// pending objects migrated to metabase didn't have zombie_deletion_deadline column set, because
 	// of that we need to get into account also object with zombie_deletion_deadline set to NULL
 	query := `
@@ -229,15 +493,26 @@ func (p *PostgresAdapter) FindZombieObjects(ctx context.Context, opts DeleteZomb
 				(project_id, bucket_name, object_key, version) > ($1, $2, $3, $4)
 				AND status = ` + statusPending + `
 				AND (zombie_deletion_deadline IS NULL OR zombie_deletion_deadline < $5)
+				AND ($7::BYTEA IS NULL OR project_id = $7::BYTEA)
+				AND ($8::BYTEA IS NULL OR bucket_name = $8::BYTEA)
 				ORDER BY project_id, bucket_name, object_key, version
 			LIMIT $6;`
 
+	var scopeProjectID, scopeBucketName []byte
+	if !opts.ProjectID.IsZero() {
+		scopeProjectID = opts.ProjectID.Bytes()
+		if opts.BucketName != "" {
+			scopeBucketName = []byte(opts.BucketName)
+		}
+	}
+
 	objects = make([]ObjectStream, 0, batchSize)
 
 	err = withRows(p.db.QueryContext(ctx, query,
 		startAfter.ProjectID, []byte(startAfter.BucketName), []byte(startAfter.ObjectKey), startAfter.Version,
 		opts.DeadlineBefore,
-		batchSize),
+		batchSize,
+		scopeProjectID, scopeBucketName),
 	)(func(rows tagsql.Rows) error {
 		var last ObjectStream
 		for rows.Next() {
@@ -266,6 +541,10 @@ func (p *PostgresAdapter) FindZombieObjects(ctx context.Context, opts DeleteZomb
 
 // FindZombieObjects locates up to batchSize zombie objects that need deletion.
 func (s *SpannerAdapter) FindZombieObjects(ctx context.Context, opts DeleteZombieObjects, startAfter ObjectStream, batchSize int) (objects []ObjectStream, err error) {
+	const tag = queryTag("find_zombie_objects")
+	done := tag.observe(s.Name())
+	defer func() { done(int64(len(objects)), err) }()
+
 	// pending objects migrated to metabase didn't have zombie_deletion_deadline column set, because
 	// of that we need to get into account also object with zombie_deletion_deadline set to NULL
 	query := `
@@ -275,6 +554,8 @@ func (s *SpannerAdapter) FindZombieObjects(ctx context.Context, opts DeleteZombi
 		WHERE
 			status = ` + statusPending + `
 			AND (zombie_deletion_deadline IS NULL OR zombie_deletion_deadline < @deadline)
+			AND (@scope_project_id IS NULL OR project_id = @scope_project_id)
+			AND (@scope_bucket_name IS NULL OR bucket_name = @scope_bucket_name)
 			AND (
 				project_id > @project_id
 				OR (project_id = @project_id AND bucket_name > @bucket_name)
@@ -285,15 +566,26 @@ func (s *SpannerAdapter) FindZombieObjects(ctx context.Context, opts DeleteZombi
 		LIMIT @batch_size;
 	`
 
+	var scopeProjectID []byte
+	var scopeBucketName spanner.NullString
+	if !opts.ProjectID.IsZero() {
+		scopeProjectID = opts.ProjectID.Bytes()
+		if opts.BucketName != "" {
+			scopeBucketName = spanner.NullString{StringVal: opts.BucketName, Valid: true}
+		}
+	}
+
 	objects = make([]ObjectStream, 0, batchSize)
 
 	rowIterator := s.client.Single().Query(ctx, spanner.Statement{SQL: query, Params: map[string]interface{}{
-		"project_id":  startAfter.ProjectID,
-		"bucket_name": startAfter.BucketName,
-		"object_key":  startAfter.ObjectKey,
-		"version":     startAfter.Version,
-		"deadline":    opts.DeadlineBefore,
-		"batch_size":  batchSize,
+		"project_id":        startAfter.ProjectID,
+		"bucket_name":       startAfter.BucketName,
+		"object_key":        startAfter.ObjectKey,
+		"version":           startAfter.Version,
+		"deadline":          opts.DeadlineBefore,
+		"batch_size":        batchSize,
+		"scope_project_id":  scopeProjectID,
+		"scope_bucket_name": scopeBucketName,
 	}})
 	defer rowIterator.Stop()
 
@@ -325,11 +617,14 @@ func (s *SpannerAdapter) FindZombieObjects(ctx context.Context, opts DeleteZombi
 }
 
 func (db *DB) deleteObjectsAndSegmentsBatch(ctx context.Context, batchsize int, deleteBatch func(startAfter ObjectStream, batchsize int) (last ObjectStream, err error)) (err error) {
+	return db.deleteObjectsAndSegmentsBatchFrom(ctx, ObjectStream{}, batchsize, deleteBatch)
+}
+
+func (db *DB) deleteObjectsAndSegmentsBatchFrom(ctx context.Context, startAfter ObjectStream, batchsize int, deleteBatch func(startAfter ObjectStream, batchsize int) (last ObjectStream, err error)) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
 	deleteBatchsizeLimit.Ensure(&batchsize)
 
-	var startAfter ObjectStream
 	for {
 		lastDeleted, err := deleteBatch(startAfter, batchsize)
 		if err != nil {
@@ -346,6 +641,10 @@ func (db *DB) deleteObjectsAndSegmentsBatch(ctx context.Context, batchsize int,
 func (p *PostgresAdapter) DeleteObjectsAndSegments(ctx context.Context, objects []ObjectStream) (objectsDeleted, segmentsDeleted int64, err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	const tag = queryTag("delete_objects_and_segments")
+	done := tag.observe(p.impl.String())
+	defer func() { done(objectsDeleted, err) }()
+
 	if len(objects) == 0 {
 		return 0, 0, nil
 	}
@@ -356,13 +655,20 @@ func (p *PostgresAdapter) DeleteObjectsAndSegments(ctx context.Context, objects
 			obj := obj
 
 			batch.Queue(`
-				WITH deleted_objects AS (
+				WITH pinned AS (
+					SELECT 1 FROM object_pins
+					WHERE (project_id, bucket_name, object_key, version) = ($1::BYTEA, $2, $3, $4)
+				), deleted_objects AS (
 					DELETE FROM objects
-					WHERE (project_id, bucket_name, object_key, version, stream_id) = ($1::BYTEA, $2, $3, $4, $5::BYTEA)
+					WHERE
+						(project_id, bucket_name, object_key, version, stream_id) = ($1::BYTEA, $2, $3, $4, $5::BYTEA)
+						AND NOT EXISTS (SELECT 1 FROM pinned)
 					RETURNING stream_id
 				)
 				DELETE FROM segments
-				WHERE segments.stream_id = $5::BYTEA
+				WHERE
+					segments.stream_id = $5::BYTEA
+					AND NOT EXISTS (SELECT 1 FROM pinned)
 			`, obj.ProjectID, []byte(obj.BucketName), []byte(obj.ObjectKey), obj.Version, obj.StreamID)
 		}
 
@@ -396,11 +702,23 @@ func (p *PostgresAdapter) DeleteObjectsAndSegments(ctx context.Context, objects
 func (s *SpannerAdapter) DeleteObjectsAndSegments(ctx context.Context, objects []ObjectStream) (objectsDeleted, segmentsDeleted int64, err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	const tag = queryTag("delete_objects_and_segments")
+	done := tag.observe(s.Name())
+	defer func() { done(objectsDeleted, err) }()
+
 	if len(objects) == 0 {
 		return 0, 0, nil
 	}
 
 	_, err = s.client.ReadWriteTransaction(ctx, func(ctx context.Context, tx *spanner.ReadWriteTransaction) error {
+		objects, err := spannerExcludePinned(ctx, tx, objects)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		if len(objects) == 0 {
+			return nil
+		}
+
 		// can't use Mutations here, since we only want to delete objects by the specified keys
 		// if and only if the stream_id matches.
 		var statements []spanner.Statement
@@ -453,11 +771,15 @@ func (s *SpannerAdapter) DeleteObjectsAndSegments(ctx context.Context, objects [
 }
 
 // DeleteInactiveObjectsAndSegments deletes inactive objects and associated segments.
-func (p *PostgresAdapter) DeleteInactiveObjectsAndSegments(ctx context.Context, objects []ObjectStream, opts DeleteZombieObjects) (objectsDeleted, segmentsDeleted int64, err error) {
+func (p *PostgresAdapter) DeleteInactiveObjectsAndSegments(ctx context.Context, objects []ObjectStream, opts DeleteZombieObjects) (objectsDeleted, segmentsDeleted, bytesDeleted int64, err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	const tag = queryTag("delete_inactive_objects_and_segments")
+	done := tag.observe(p.impl.String())
+	defer func() { done(objectsDeleted, err) }()
+
 	if len(objects) == 0 {
-		return 0, 0, nil
+		return 0, 0, 0, nil
 	}
 
 	err = pgxutil.Conn(ctx, p.db, func(conn *pgx.Conn) error {
@@ -473,40 +795,53 @@ func (p *PostgresAdapter) DeleteInactiveObjectsAndSegments(ctx context.Context,
 						(project_id, bucket_name, object_key, version) = ($1::BYTEA, $2::BYTEA, $3::BYTEA, $4) AND
 						NOT EXISTS (SELECT 1 FROM check_segments)
 					RETURNING stream_id
+				), deleted_segments AS (
+					DELETE FROM segments
+					WHERE segments.stream_id IN (SELECT stream_id FROM deleted_objects)
+					RETURNING encrypted_size
 				)
-				DELETE FROM segments
-				WHERE segments.stream_id IN (SELECT stream_id FROM deleted_objects)
+				SELECT
+					(SELECT count(*) FROM deleted_objects),
+					count(*),
+					COALESCE(SUM(encrypted_size), 0)
+				FROM deleted_segments
 			`, obj.ProjectID, []byte(obj.BucketName), []byte(obj.ObjectKey), obj.Version, obj.StreamID, opts.InactiveDeadline)
 		}
 
 		results := conn.SendBatch(ctx, &batch)
 		defer func() { err = errs.Combine(err, results.Close()) }()
 
-		// TODO calculate deleted objects
 		var errList errs.Group
 		for i := 0; i < batch.Len(); i++ {
-			result, err := results.Exec()
+			var objects, segments, bytes int64
+			err := results.QueryRow().Scan(&objects, &segments, &bytes)
 			errList.Add(err)
 
 			if err == nil {
-				segmentsDeleted += result.RowsAffected()
+				objectsDeleted += objects
+				segmentsDeleted += segments
+				bytesDeleted += bytes
 			}
 		}
 
 		return errList.Err()
 	})
 	if err != nil {
-		return objectsDeleted, segmentsDeleted, Error.New("unable to delete zombie objects: %w", err)
+		return objectsDeleted, segmentsDeleted, bytesDeleted, Error.New("unable to delete zombie objects: %w", err)
 	}
-	return objectsDeleted, segmentsDeleted, nil
+	return objectsDeleted, segmentsDeleted, bytesDeleted, nil
 }
 
 // DeleteInactiveObjectsAndSegments deletes inactive objects and associated segments.
-func (s *SpannerAdapter) DeleteInactiveObjectsAndSegments(ctx context.Context, objects []ObjectStream, opts DeleteZombieObjects) (objectsDeleted, segmentsDeleted int64, err error) {
+func (s *SpannerAdapter) DeleteInactiveObjectsAndSegments(ctx context.Context, objects []ObjectStream, opts DeleteZombieObjects) (objectsDeleted, segmentsDeleted, bytesDeleted int64, err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	const tag = queryTag("delete_inactive_objects_and_segments")
+	done := tag.observe(s.Name())
+	defer func() { done(objectsDeleted, err) }()
+
 	if len(objects) == 0 {
-		return 0, 0, nil
+		return 0, 0, 0, nil
 	}
 
 	_, err = s.client.ReadWriteTransaction(ctx, func(ctx context.Context, tx *spanner.ReadWriteTransaction) error {
@@ -555,21 +890,34 @@ func (s *SpannerAdapter) DeleteInactiveObjectsAndSegments(ctx context.Context, o
 		//
 		// Alternatively, we might be able to do a single statement per object to delete both segments
 		// and object itself at the same time.
+		const inactiveSegments = `
+			FROM segments
+			WHERE ARRAY_INCLUDES(@stream_ids, stream_id)
+				AND NOT EXISTS (
+				    SELECT 1 FROM segments s2
+					WHERE
+						s2.stream_id = segments.stream_id
+						AND s2.created_at > @inactive_deadline
+				)
+		`
+		params := map[string]interface{}{
+			"stream_ids":        streamIDs,
+			"inactive_deadline": opts.InactiveDeadline,
+		}
+
+		err = tx.Query(ctx, spanner.Statement{
+			SQL:    `SELECT COALESCE(SUM(encrypted_size), 0) ` + inactiveSegments,
+			Params: params,
+		}).Do(func(row *spanner.Row) error {
+			return row.Columns(&bytesDeleted)
+		})
+		if err != nil {
+			return Error.Wrap(err)
+		}
+
 		numSegments, err := tx.Update(ctx, spanner.Statement{
-			SQL: `
-				DELETE FROM segments
-				WHERE ARRAY_INCLUDES(@stream_ids, stream_id)
-					AND NOT EXISTS (
-					    SELECT 1 FROM segments s2
-						WHERE
-							s2.stream_id = segments.stream_id
-							AND s2.created_at > @inactive_deadline
-					)
-			`,
-			Params: map[string]interface{}{
-				"stream_ids":        streamIDs,
-				"inactive_deadline": opts.InactiveDeadline,
-			},
+			SQL:    `DELETE ` + inactiveSegments,
+			Params: params,
 		})
 		if err != nil {
 			return Error.Wrap(err)
@@ -578,7 +926,64 @@ func (s *SpannerAdapter) DeleteInactiveObjectsAndSegments(ctx context.Context, o
 		return nil
 	})
 	if err != nil {
-		return objectsDeleted, segmentsDeleted, Error.New("unable to delete zombie objects: %w", err)
+		return objectsDeleted, segmentsDeleted, bytesDeleted, Error.New("unable to delete zombie objects: %w", err)
 	}
-	return objectsDeleted, segmentsDeleted, nil
+	return objectsDeleted, segmentsDeleted, bytesDeleted, nil
+}
+
+// spannerReader reads rows by key, within a read-only or a read-write transaction.
+type spannerReader interface {
+	Read(ctx context.Context, table string, keys spanner.KeySet, columns []string) *spanner.RowIterator
+}
+
+// spannerExcludePinned returns objects without the versions held by a pin.
+func spannerExcludePinned(ctx context.Context, reader spannerReader, objects []ObjectStream) (_ []ObjectStream, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(objects) == 0 {
+		return objects, nil
+	}
+
+	type objectVersion struct {
+		ProjectID  uuid.UUID
+		BucketName string
+		ObjectKey  ObjectKey
+		Version    Version
+	}
+
+	keys := make([]spanner.KeySet, 0, len(objects))
+	for _, obj := range objects {
+		keys = append(keys, spanner.Key{obj.ProjectID, obj.BucketName, obj.ObjectKey, obj.Version}.AsPrefix())
+	}
+
+	rows := reader.Read(ctx, "object_pins", spanner.KeySets(keys...), []string{"project_id", "bucket_name", "object_key", "version"})
+	defer rows.Stop()
+
+	pinned := map[objectVersion]struct{}{}
+	for {
+		row, err := rows.Next()
+		if err != nil {
+			if errors.Is(err, iterator.Done) {
+				break
+			}
+			return nil, err
+		}
+		var version objectVersion
+		if err := row.Columns(&version.ProjectID, &version.BucketName, &version.ObjectKey, &version.Version); err != nil {
+			return nil, err
+		}
+		pinned[version] = struct{}{}
+	}
+	if len(pinned) == 0 {
+		return objects, nil
+	}
+
+	unpinned := make([]ObjectStream, 0, len(objects))
+	for _, obj := range objects {
+		if _, ok := pinned[objectVersion{obj.ProjectID, obj.BucketName, obj.ObjectKey, obj.Version}]; ok {
+			continue
+		}
+		unpinned = append(unpinned, obj)
+	}
+	return unpinned, nil
 }
@@ -12,6 +12,7 @@ import (
 	"storj.io/common/storj"
 	"storj.io/common/testcontext"
 	"storj.io/common/testrand"
+	"storj.io/common/uuid"
 	"storj.io/storj/satellite/metabase"
 	"storj.io/storj/satellite/metabase/metabasetest"
 )
@@ -111,6 +112,38 @@ func TestDeleteExpiredObjects(t *testing.T) {
 			metabasetest.Verify{}.Check(ctx, t, db)
 		})
 
+		t.Run("deleted bytes per project", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			expiresAt := time.Now().Add(-time.Hour)
+
+			first := metabasetest.RandObjectStream()
+			second := metabasetest.RandObjectStream()
+			second.ProjectID = first.ProjectID
+			other := metabasetest.RandObjectStream()
+
+			object1 := metabasetest.CreateExpiredObject(ctx, t, db, first, 2, expiresAt)
+			object2 := metabasetest.CreateExpiredObject(ctx, t, db, second, 1, expiresAt.Add(-time.Minute))
+			object3 := metabasetest.CreateExpiredObject(ctx, t, db, other, 3, expiresAt)
+
+			metabasetest.DeleteExpiredObjects{
+				Opts: metabase.DeleteExpiredObjects{
+					ExpiredBefore: time.Now(),
+					BatchSize:     2,
+				},
+				Result: &metabase.DeleteExpiredObjectsResult{
+					DeletedObjects:  3,
+					DeletedSegments: 6,
+					DeletedBytes: map[uuid.UUID]int64{
+						first.ProjectID: object1.TotalEncryptedSize + object2.TotalEncryptedSize,
+						other.ProjectID: object3.TotalEncryptedSize,
+					},
+				},
+			}.Check(ctx, t, db)
+
+			metabasetest.Verify{}.Check(ctx, t, db)
+		})
+
 		t.Run("committed objects", func(t *testing.T) {
 			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
 
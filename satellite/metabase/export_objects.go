@@ -0,0 +1,97 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase
+
+import (
+	"context"
+	"time"
+
+	"storj.io/storj/shared/tagsql"
+)
+
+// ExportedObject contains the object fields used for offline analytics. The
+// encrypted metadata is left out, only its size is exported.
+type ExportedObject struct {
+	ObjectStream
+
+	CreatedAt time.Time
+	ExpiresAt *time.Time
+
+	Status       ObjectStatus
+	SegmentCount int32
+
+	TotalPlainSize        int64
+	TotalEncryptedSize    int64
+	FixedSegmentSize      int32
+	EncryptedMetadataSize int64
+}
+
+// IterateObjectsForExport contains arguments for iterating the whole objects table.
+type IterateObjectsForExport struct {
+	BatchSize int
+
+	// AsOfSystemTime makes all the batches read the same snapshot on CockroachDB.
+	AsOfSystemTime     time.Time
+	AsOfSystemInterval time.Duration
+}
+
+// IterateObjectsForExport iterates all the objects, including pending ones, in batches
+// ordered by the primary key.
+func (db *DB) IterateObjectsForExport(ctx context.Context, opts IterateObjectsForExport, fn func(context.Context, []ExportedObject) error) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	ListLimit.Ensure(&opts.BatchSize)
+	if opts.AsOfSystemTime.IsZero() {
+		opts.AsOfSystemTime = time.Now()
+	}
+
+	var cursor ObjectStream
+	for {
+		batch := make([]ExportedObject, 0, opts.BatchSize)
+		err := withRows(db.db.QueryContext(ctx, `
+			SELECT
+				project_id, bucket_name, object_key, version, stream_id,
+				created_at, expires_at,
+				status, segment_count,
+				total_plain_size, total_encrypted_size, fixed_segment_size,
+				COALESCE(length(encrypted_metadata), 0)
+			FROM objects
+			`+db.asOfTime(opts.AsOfSystemTime, opts.AsOfSystemInterval)+`
+			WHERE (project_id, bucket_name, object_key, version) > ($1, $2, $3, $4)
+			ORDER BY project_id, bucket_name, object_key, version
+			LIMIT $5
+		`, cursor.ProjectID, []byte(cursor.BucketName), cursor.ObjectKey, cursor.Version, opts.BatchSize,
+		))(func(rows tagsql.Rows) error {
+			for rows.Next() {
+				var object ExportedObject
+				if err := rows.Scan(
+					&object.ProjectID, &object.BucketName, &object.ObjectKey, &object.Version, &object.StreamID,
+					&object.CreatedAt, &object.ExpiresAt,
+					&object.Status, &object.SegmentCount,
+					&object.TotalPlainSize, &object.TotalEncryptedSize, &object.FixedSegmentSize,
+					&object.EncryptedMetadataSize,
+				); err != nil {
+					return err
+				}
+				batch = append(batch, object)
+			}
+			return nil
+		})
+		if err != nil {
+			return Error.New("unable to iterate objects: %w", err)
+		}
+		if len(batch) == 0 {
+			return nil
+		}
+
+		if err := fn(ctx, batch); err != nil {
+			return err
+		}
+
+		if len(batch) < opts.BatchSize {
+			return nil
+		}
+		cursor = batch[len(batch)-1].ObjectStream
+	}
+}
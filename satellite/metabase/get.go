@@ -92,6 +92,10 @@ func (db *DB) GetObjectExactVersion(ctx context.Context, opts GetObjectExactVers
 
 // GetObjectExactVersion returns object information for exact version.
 func (p *PostgresAdapter) GetObjectExactVersion(ctx context.Context, opts GetObjectExactVersion) (_ Object, err error) {
+	const tag = queryTag("get_object_exact_version")
+	done := tag.observe(p.impl.String())
+	defer func() { done(rowsFound(err), ignoreNotFound(err)) }()
+
 	object := Object{}
 	err = p.db.QueryRowContext(ctx, `
 		SELECT
@@ -132,6 +136,10 @@ func (p *PostgresAdapter) GetObjectExactVersion(ctx context.Context, opts GetObj
 
 // GetObjectExactVersion returns object information for exact version.
 func (s *SpannerAdapter) GetObjectExactVersion(ctx context.Context, opts GetObjectExactVersion) (object Object, err error) {
+	const tag = queryTag("get_object_exact_version")
+	done := tag.observe(s.Name())
+	defer func() { done(rowsFound(err), ignoreNotFound(err)) }()
+
 	result := s.client.Single().Query(ctx, spanner.Statement{
 		SQL: `
 			SELECT
@@ -210,8 +218,12 @@ func (db *DB) GetObjectLastCommitted(ctx context.Context, opts GetObjectLastComm
 }
 
 // GetObjectLastCommitted implements Adapter.
-func (p *PostgresAdapter) GetObjectLastCommitted(ctx context.Context, opts GetObjectLastCommitted, object *Object) error {
-	row := p.db.QueryRowContext(ctx, `
+func (p *PostgresAdapter) GetObjectLastCommitted(ctx context.Context, opts GetObjectLastCommitted, object *Object) (err error) {
+	const tag = queryTag("get_object_last_committed")
+	done := tag.observe(p.impl.String())
+	defer func() { done(rowsFound(err), ignoreNotFound(err)) }()
+
+	row := p.db.QueryRowContext(ctx, tag.postgres(`
 		SELECT
 			stream_id, version, status,
 			created_at, expires_at,
@@ -225,10 +237,10 @@ func (p *PostgresAdapter) GetObjectLastCommitted(ctx context.Context, opts GetOb
 			status <> `+statusPending+` AND
 			(expires_at IS NULL OR expires_at > now())
 		ORDER BY version DESC
-		LIMIT 1`,
+		LIMIT 1`),
 		opts.ProjectID, []byte(opts.BucketName), opts.ObjectKey)
 
-	err := row.Scan(
+	err = row.Scan(
 		&object.StreamID, &object.Version, &object.Status,
 		&object.CreatedAt, &object.ExpiresAt,
 		&object.SegmentCount,
@@ -244,8 +256,12 @@ func (p *PostgresAdapter) GetObjectLastCommitted(ctx context.Context, opts GetOb
 }
 
 // GetObjectLastCommitted implements Adapter.
-func (s *SpannerAdapter) GetObjectLastCommitted(ctx context.Context, opts GetObjectLastCommitted, object *Object) error {
-	result := s.client.Single().Query(ctx, spanner.Statement{
+func (s *SpannerAdapter) GetObjectLastCommitted(ctx context.Context, opts GetObjectLastCommitted, object *Object) (err error) {
+	const tag = queryTag("get_object_last_committed")
+	done := tag.observe(s.Name())
+	defer func() { done(rowsFound(err), ignoreNotFound(err)) }()
+
+	result := s.client.Single().QueryWithOptions(ctx, spanner.Statement{
 		SQL: `
 			SELECT
 				stream_id, version, status,
@@ -268,7 +284,7 @@ func (s *SpannerAdapter) GetObjectLastCommitted(ctx context.Context, opts GetObj
 			"bucket_name": opts.BucketName,
 			"object_key":  opts.ObjectKey,
 		},
-	})
+	}, tag.spanner())
 	defer result.Stop()
 
 	row, err := result.Next()
@@ -336,7 +352,11 @@ func (db *DB) GetSegmentByPosition(ctx context.Context, opts GetSegmentByPositio
 
 // GetSegmentByPosition returns information about segment on the specified position.
 func (p *PostgresAdapter) GetSegmentByPosition(ctx context.Context, opts GetSegmentByPosition) (segment Segment, aliasPieces AliasPieces, err error) {
-	err = p.db.QueryRowContext(ctx, `
+	const tag = queryTag("get_segment_by_position")
+	done := tag.observe(p.impl.String())
+	defer func() { done(rowsFound(err), ignoreNotFound(err)) }()
+
+	err = p.db.QueryRowContext(ctx, tag.postgres(`
 		SELECT
 			created_at, expires_at, repaired_at,
 			root_piece_id, encrypted_key_nonce, encrypted_key,
@@ -347,7 +367,7 @@ func (p *PostgresAdapter) GetSegmentByPosition(ctx context.Context, opts GetSegm
 			placement
 		FROM segments
 		WHERE (stream_id, position) = ($1, $2)
-	`, opts.StreamID, opts.Position.Encode()).
+	`), opts.StreamID, opts.Position.Encode()).
 		Scan(
 			&segment.CreatedAt, &segment.ExpiresAt, &segment.RepairedAt,
 			&segment.RootPieceID, &segment.EncryptedKeyNonce, &segment.EncryptedKey,
@@ -369,7 +389,11 @@ func (p *PostgresAdapter) GetSegmentByPosition(ctx context.Context, opts GetSegm
 
 // GetSegmentByPosition returns information about segment on the specified position.
 func (s *SpannerAdapter) GetSegmentByPosition(ctx context.Context, opts GetSegmentByPosition) (segment Segment, aliasPieces AliasPieces, err error) {
-	result := s.client.Single().Query(ctx, spanner.Statement{
+	const tag = queryTag("get_segment_by_position")
+	done := tag.observe(s.Name())
+	defer func() { done(rowsFound(err), ignoreNotFound(err)) }()
+
+	result := s.client.Single().QueryWithOptions(ctx, spanner.Statement{
 		SQL: `
 			SELECT
 				created_at, expires_at, repaired_at,
@@ -386,7 +410,7 @@ func (s *SpannerAdapter) GetSegmentByPosition(ctx context.Context, opts GetSegme
 			"stream_id": opts.StreamID,
 			"position":  opts.Position,
 		},
-	})
+	}, tag.spanner())
 	defer result.Stop()
 
 	row, err := result.Next()
@@ -442,6 +466,10 @@ func (db *DB) GetLatestObjectLastSegment(ctx context.Context, opts GetLatestObje
 
 // GetLatestObjectLastSegment returns an object last segment information.
 func (p *PostgresAdapter) GetLatestObjectLastSegment(ctx context.Context, opts GetLatestObjectLastSegment) (segment Segment, aliasPieces AliasPieces, err error) {
+	const tag = queryTag("get_latest_object_last_segment")
+	done := tag.observe(p.impl.String())
+	defer func() { done(rowsFound(err), ignoreNotFound(err)) }()
+
 	err = p.db.QueryRowContext(ctx, `
 		SELECT
 			stream_id, position,
@@ -487,6 +515,10 @@ func (p *PostgresAdapter) GetLatestObjectLastSegment(ctx context.Context, opts G
 
 // GetLatestObjectLastSegment returns an object last segment information.
 func (s *SpannerAdapter) GetLatestObjectLastSegment(ctx context.Context, opts GetLatestObjectLastSegment) (segment Segment, aliasPieces AliasPieces, err error) {
+	const tag = queryTag("get_latest_object_last_segment")
+	done := tag.observe(s.Name())
+	defer func() { done(rowsFound(err), ignoreNotFound(err)) }()
+
 	result := s.client.Single().Query(ctx, spanner.Statement{
 		SQL: `
 			SELECT
@@ -568,6 +600,10 @@ func (db *DB) BucketEmpty(ctx context.Context, opts BucketEmpty) (empty bool, er
 // BucketEmpty returns true if bucket does not contain objects (pending or committed).
 // This method doesn't check bucket existence.
 func (p *PostgresAdapter) BucketEmpty(ctx context.Context, opts BucketEmpty) (empty bool, err error) {
+	const tag = queryTag("bucket_empty")
+	done := tag.observe(p.impl.String())
+	defer func() { done(rowsExist(!empty), err) }()
+
 	var value bool
 	err = p.db.QueryRowContext(ctx, `
 		SELECT EXISTS (SELECT 1 FROM objects WHERE (project_id, bucket_name) = ($1, $2))
@@ -582,6 +618,10 @@ func (p *PostgresAdapter) BucketEmpty(ctx context.Context, opts BucketEmpty) (em
 // BucketEmpty returns true if bucket does not contain objects (pending or committed).
 // This method doesn't check bucket existence.
 func (s *SpannerAdapter) BucketEmpty(ctx context.Context, opts BucketEmpty) (empty bool, err error) {
+	const tag = queryTag("bucket_empty")
+	done := tag.observe(s.Name())
+	defer func() { done(rowsExist(!empty), err) }()
+
 	var value bool
 	result := s.client.Single().Query(ctx, spanner.Statement{
 		SQL: `
@@ -0,0 +1,222 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/storj/exp-spanner"
+	"google.golang.org/api/iterator"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/shared/dbutil/pgutil"
+	"storj.io/storj/shared/dbutil/spannerutil"
+	"storj.io/storj/shared/tagsql"
+)
+
+// GetObjectsLastCommittedMaxKeys is the maximum number of object keys which can be looked up at once.
+const GetObjectsLastCommittedMaxKeys = 1000
+
+// GetObjectsLastCommitted contains arguments necessary for fetching
+// the last committed versions of multiple objects from the same bucket.
+type GetObjectsLastCommitted struct {
+	ProjectID  uuid.UUID
+	BucketName string
+	ObjectKeys []ObjectKey
+}
+
+// Verify verifies get objects request fields.
+func (opts *GetObjectsLastCommitted) Verify() error {
+	switch {
+	case opts.ProjectID.IsZero():
+		return ErrInvalidRequest.New("ProjectID missing")
+	case opts.BucketName == "":
+		return ErrInvalidRequest.New("BucketName missing")
+	case len(opts.ObjectKeys) == 0:
+		return ErrInvalidRequest.New("ObjectKeys missing")
+	case len(opts.ObjectKeys) > GetObjectsLastCommittedMaxKeys:
+		return ErrInvalidRequest.New("ObjectKeys contains more than %d keys", GetObjectsLastCommittedMaxKeys)
+	}
+	for _, key := range opts.ObjectKeys {
+		if len(key) == 0 {
+			return ErrInvalidRequest.New("ObjectKeys contains empty key")
+		}
+	}
+	return nil
+}
+
+// LastCommittedObject is the result of looking up the last committed version of a single object key.
+type LastCommittedObject struct {
+	ObjectKey ObjectKey
+	// Found is false when the object does not exist, has expired, or its last version is a delete marker.
+	Found  bool
+	Object Object
+}
+
+// GetObjectsLastCommitted returns the last committed versions of multiple objects using a single
+// query. The results are in the same order as opts.ObjectKeys.
+func (db *DB) GetObjectsLastCommitted(ctx context.Context, opts GetObjectsLastCommitted) (_ []LastCommittedObject, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := opts.Verify(); err != nil {
+		return nil, err
+	}
+
+	objectKeys := make([][]byte, 0, len(opts.ObjectKeys))
+	requested := make(map[ObjectKey]struct{}, len(opts.ObjectKeys))
+	for _, key := range opts.ObjectKeys {
+		if _, ok := requested[key]; ok {
+			continue
+		}
+		requested[key] = struct{}{}
+		objectKeys = append(objectKeys, []byte(key))
+	}
+
+	objects, err := db.ChooseAdapter(opts.ProjectID).GetObjectsLastCommitted(ctx, opts.ProjectID, opts.BucketName, objectKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	byKey := make(map[ObjectKey]Object, len(objects))
+	for _, object := range objects {
+		if object.Status.IsDeleteMarker() {
+			continue
+		}
+		object.ProjectID = opts.ProjectID
+		object.BucketName = opts.BucketName
+		byKey[object.ObjectKey] = object
+	}
+
+	results := make([]LastCommittedObject, len(opts.ObjectKeys))
+	for i, key := range opts.ObjectKeys {
+		object, found := byKey[key]
+		results[i] = LastCommittedObject{
+			ObjectKey: key,
+			Found:     found,
+			Object:    object,
+		}
+	}
+
+	mon.IntVal("get_objects_last_committed_keys").Observe(int64(len(opts.ObjectKeys)))
+
+	return results, nil
+}
+
+// GetObjectsLastCommitted returns the highest committed version of each of the object keys.
+func (p *PostgresAdapter) GetObjectsLastCommitted(ctx context.Context, projectID uuid.UUID, bucketName string, objectKeys [][]byte) (objects []Object, err error) {
+	const tag = queryTag("get_objects_last_committed")
+	done := tag.observe(p.impl.String())
+	defer func() { done(int64(len(objects)), err) }()
+
+	err = withRows(p.db.QueryContext(ctx, `
+		SELECT DISTINCT ON (object_key)
+			object_key, stream_id, version, status,
+			created_at, expires_at,
+			segment_count,
+			encrypted_metadata_nonce, encrypted_metadata, encrypted_metadata_encrypted_key,
+			total_plain_size, total_encrypted_size, fixed_segment_size,
+			encryption
+		FROM objects
+		WHERE
+			(project_id, bucket_name) = ($1, $2) AND
+			object_key = ANY ($3) AND
+			status <> `+statusPending+` AND
+			(expires_at IS NULL OR expires_at > now())
+		ORDER BY object_key, version DESC
+	`, projectID, []byte(bucketName), pgutil.ByteaArray(objectKeys)))(func(rows tagsql.Rows) error {
+		for rows.Next() {
+			var object Object
+			err := rows.Scan(
+				&object.ObjectKey, &object.StreamID, &object.Version, &object.Status,
+				&object.CreatedAt, &object.ExpiresAt,
+				&object.SegmentCount,
+				&object.EncryptedMetadataNonce, &object.EncryptedMetadata, &object.EncryptedMetadataEncryptedKey,
+				&object.TotalPlainSize, &object.TotalEncryptedSize, &object.FixedSegmentSize,
+				encryptionParameters{&object.Encryption},
+			)
+			if err != nil {
+				return err
+			}
+			objects = append(objects, object)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, Error.New("unable to query objects: %w", err)
+	}
+	return objects, nil
+}
+
+// GetObjectsLastCommitted returns the highest committed version of each of the object keys.
+func (s *SpannerAdapter) GetObjectsLastCommitted(ctx context.Context, projectID uuid.UUID, bucketName string, objectKeys [][]byte) (objects []Object, err error) {
+	const tag = queryTag("get_objects_last_committed")
+	done := tag.observe(s.Name())
+	defer func() { done(int64(len(objects)), err) }()
+
+	// TODO(spanner): this issues a query per key, although within a single read-only transaction.
+	tx := s.client.ReadOnlyTransaction()
+	defer tx.Close()
+
+	for _, key := range objectKeys {
+		object, found, err := s.getObjectLastCommittedInTx(ctx, tx, projectID, bucketName, key)
+		if err != nil {
+			return nil, Error.New("unable to query objects: %w", err)
+		}
+		if found {
+			objects = append(objects, object)
+		}
+	}
+	return objects, nil
+}
+
+func (s *SpannerAdapter) getObjectLastCommittedInTx(ctx context.Context, tx *spanner.ReadOnlyTransaction, projectID uuid.UUID, bucketName string, objectKey []byte) (object Object, found bool, err error) {
+	result := tx.Query(ctx, spanner.Statement{
+		SQL: `
+			SELECT
+				stream_id, version, status,
+				created_at, expires_at,
+				segment_count,
+				encrypted_metadata_nonce, encrypted_metadata, encrypted_metadata_encrypted_key,
+				total_plain_size, total_encrypted_size, fixed_segment_size,
+				encryption
+			FROM objects
+			WHERE
+				project_id = @project_id AND
+				bucket_name = @bucket_name AND
+				object_key = @object_key AND
+				status <> ` + statusPending + ` AND
+				(expires_at IS NULL OR expires_at > CURRENT_TIMESTAMP)
+			ORDER BY version DESC
+			LIMIT 1`,
+		Params: map[string]interface{}{
+			"project_id":  projectID,
+			"bucket_name": bucketName,
+			"object_key":  objectKey,
+		},
+	})
+	defer result.Stop()
+
+	row, err := result.Next()
+	if err != nil {
+		if errors.Is(err, iterator.Done) {
+			return Object{}, false, nil
+		}
+		return Object{}, false, err
+	}
+
+	object.ObjectKey = ObjectKey(objectKey)
+	err = row.Columns(
+		&object.StreamID, &object.Version, &object.Status,
+		&object.CreatedAt, &object.ExpiresAt,
+		spannerutil.Int(&object.SegmentCount),
+		&object.EncryptedMetadataNonce, &object.EncryptedMetadata, &object.EncryptedMetadataEncryptedKey,
+		&object.TotalPlainSize, &object.TotalEncryptedSize, spannerutil.Int(&object.FixedSegmentSize),
+		encryptionParameters{&object.Encryption},
+	)
+	if err != nil {
+		return Object{}, false, err
+	}
+	return object, true, nil
+}
@@ -0,0 +1,134 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/metabasetest"
+)
+
+func TestGetObjectsLastCommitted(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		obj := metabasetest.RandObjectStream()
+
+		t.Run("invalid options", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			metabasetest.GetObjectsLastCommitted{
+				Opts: metabase.GetObjectsLastCommitted{
+					BucketName: obj.BucketName,
+					ObjectKeys: []metabase.ObjectKey{obj.ObjectKey},
+				},
+				ErrClass: &metabase.ErrInvalidRequest,
+				ErrText:  "ProjectID missing",
+			}.Check(ctx, t, db)
+
+			metabasetest.GetObjectsLastCommitted{
+				Opts: metabase.GetObjectsLastCommitted{
+					ProjectID:  obj.ProjectID,
+					ObjectKeys: []metabase.ObjectKey{obj.ObjectKey},
+				},
+				ErrClass: &metabase.ErrInvalidRequest,
+				ErrText:  "BucketName missing",
+			}.Check(ctx, t, db)
+
+			metabasetest.GetObjectsLastCommitted{
+				Opts: metabase.GetObjectsLastCommitted{
+					ProjectID:  obj.ProjectID,
+					BucketName: obj.BucketName,
+				},
+				ErrClass: &metabase.ErrInvalidRequest,
+				ErrText:  "ObjectKeys missing",
+			}.Check(ctx, t, db)
+
+			metabasetest.GetObjectsLastCommitted{
+				Opts: metabase.GetObjectsLastCommitted{
+					ProjectID:  obj.ProjectID,
+					BucketName: obj.BucketName,
+					ObjectKeys: []metabase.ObjectKey{obj.ObjectKey, ""},
+				},
+				ErrClass: &metabase.ErrInvalidRequest,
+				ErrText:  "ObjectKeys contains empty key",
+			}.Check(ctx, t, db)
+
+			metabasetest.GetObjectsLastCommitted{
+				Opts: metabase.GetObjectsLastCommitted{
+					ProjectID:  obj.ProjectID,
+					BucketName: obj.BucketName,
+					ObjectKeys: make([]metabase.ObjectKey, metabase.GetObjectsLastCommittedMaxKeys+1),
+				},
+				ErrClass: &metabase.ErrInvalidRequest,
+				ErrText:  "ObjectKeys contains more than 1000 keys",
+			}.Check(ctx, t, db)
+
+			metabasetest.Verify{}.Check(ctx, t, db)
+		})
+
+		t.Run("found and missing", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			first := obj
+			first.ObjectKey = "a"
+			first.Version = 1
+			metabasetest.CreateObject(ctx, t, db, first, 0)
+			first.Version = 2
+			first.StreamID = testrand.UUID()
+			firstV2 := metabasetest.CreateObjectVersioned(ctx, t, db, first, 0)
+
+			second := obj
+			second.ObjectKey = "b"
+			second.StreamID = testrand.UUID()
+			secondObject := metabasetest.CreateObject(ctx, t, db, second, 0)
+
+			pending := obj
+			pending.ObjectKey = "c"
+			pending.StreamID = testrand.UUID()
+			metabasetest.CreatePendingObject(ctx, t, db, pending, 0)
+
+			metabasetest.GetObjectsLastCommitted{
+				Opts: metabase.GetObjectsLastCommitted{
+					ProjectID:  obj.ProjectID,
+					BucketName: obj.BucketName,
+					ObjectKeys: []metabase.ObjectKey{"b", "missing", "a", "c", "b"},
+				},
+				Result: []metabase.LastCommittedObject{
+					{ObjectKey: "b", Found: true, Object: secondObject},
+					{ObjectKey: "missing"},
+					{ObjectKey: "a", Found: true, Object: firstV2},
+					{ObjectKey: "c"},
+					{ObjectKey: "b", Found: true, Object: secondObject},
+				},
+			}.Check(ctx, t, db)
+		})
+
+		t.Run("delete marker", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			metabasetest.CreateObjectVersioned(ctx, t, db, obj, 0)
+
+			_, err := db.DeleteObjectLastCommitted(ctx, metabase.DeleteObjectLastCommitted{
+				ObjectLocation: obj.Location(),
+				Versioned:      true,
+			})
+			require.NoError(t, err)
+
+			metabasetest.GetObjectsLastCommitted{
+				Opts: metabase.GetObjectsLastCommitted{
+					ProjectID:  obj.ProjectID,
+					BucketName: obj.BucketName,
+					ObjectKeys: []metabase.ObjectKey{obj.ObjectKey},
+				},
+				Result: []metabase.LastCommittedObject{
+					{ObjectKey: obj.ObjectKey},
+				},
+			}.Check(ctx, t, db)
+		})
+	})
+}
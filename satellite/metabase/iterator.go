@@ -253,6 +253,10 @@ func (it *objectsIterator) next(ctx context.Context, item *ObjectEntry) bool {
 func (p *PostgresAdapter) doNextQueryAllVersionsWithStatus(ctx context.Context, it *objectsIterator) (_ tagsql.Rows, err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	const tag = queryTag("iterate_all_versions_with_status")
+	done := tag.observe(p.impl.String())
+	defer func() { done(-1, err) }()
+
 	cursorCompare := ">"
 	if it.cursor.Inclusive {
 		cursorCompare = ">="
@@ -321,6 +325,10 @@ func (p *PostgresAdapter) doNextQueryAllVersionsWithStatus(ctx context.Context,
 }
 
 func (s *SpannerAdapter) doNextQueryAllVersionsWithStatus(ctx context.Context, it *objectsIterator) (_ tagsql.Rows, err error) {
+	const tag = queryTag("iterate_all_versions_with_status")
+	done := tag.observe(s.Name())
+	defer func() { done(-1, err) }()
+
 	// TODO: implement me
 	panic("implement me")
 }
@@ -328,6 +336,10 @@ func (s *SpannerAdapter) doNextQueryAllVersionsWithStatus(ctx context.Context, i
 func (p *PostgresAdapter) doNextQueryAllVersionsWithStatusAscending(ctx context.Context, it *objectsIterator) (_ tagsql.Rows, err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	const tag = queryTag("iterate_all_versions_with_status_ascending")
+	done := tag.observe(p.impl.String())
+	defer func() { done(-1, err) }()
+
 	cursorCompare := ">"
 	if it.cursor.Inclusive {
 		cursorCompare = ">="
@@ -384,6 +396,10 @@ func (p *PostgresAdapter) doNextQueryAllVersionsWithStatusAscending(ctx context.
 }
 
 func (s *SpannerAdapter) doNextQueryAllVersionsWithStatusAscending(ctx context.Context, it *objectsIterator) (_ tagsql.Rows, err error) {
+	const tag = queryTag("iterate_all_versions_with_status_ascending")
+	done := tag.observe(s.Name())
+	defer func() { done(-1, err) }()
+
 	// TODO: implement me
 	panic("implement me")
 }
@@ -426,6 +442,10 @@ func nextBucket(b []byte) []byte {
 func (p *PostgresAdapter) doNextQueryPendingObjectsByKey(ctx context.Context, it *objectsIterator) (_ tagsql.Rows, err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	const tag = queryTag("iterate_pending_objects_by_key")
+	done := tag.observe(p.impl.String())
+	defer func() { done(-1, err) }()
+
 	return p.db.QueryContext(ctx, `
 			SELECT
 				object_key, stream_id, version, status, encryption,
@@ -448,6 +468,10 @@ func (p *PostgresAdapter) doNextQueryPendingObjectsByKey(ctx context.Context, it
 }
 
 func (s *SpannerAdapter) doNextQueryPendingObjectsByKey(ctx context.Context, it *objectsIterator) (_ tagsql.Rows, err error) {
+	const tag = queryTag("iterate_pending_objects_by_key")
+	done := tag.observe(s.Name())
+	defer func() { done(-1, err) }()
+
 	// TODO: implement me
 	panic("implement me")
 }
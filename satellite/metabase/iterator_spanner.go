@@ -22,8 +22,6 @@ type spannerRows struct {
 	err         error
 }
 
-var _ = newSpannerRows // TODO(spanner): ignore staticcheck warning about unused func, remove afterwards
-
 func newSpannerRows(rowIterator *spanner.RowIterator) *spannerRows {
 	return &spannerRows{rowIterator: rowIterator}
 }
@@ -0,0 +1,207 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package lifecycledeletion applies the lifecycle rules of buckets to their objects.
+package lifecycledeletion
+
+import (
+	"context"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/sync2"
+	"storj.io/storj/satellite/buckets"
+	"storj.io/storj/satellite/metabase"
+)
+
+var (
+	// Error defines the lifecycle chore errors class.
+	Error = errs.Class("lifecycle chore")
+	mon   = monkit.Package()
+)
+
+// Config contains configurable values for applying bucket lifecycle rules.
+type Config struct {
+	Interval  time.Duration `help:"the time between each attempt to apply the bucket lifecycle rules" releaseDefault:"24h" devDefault:"10s"`
+	Enabled   bool          `help:"set if applying bucket lifecycle rules is enabled or not" default:"false"`
+	ListLimit int           `help:"how many buckets and objects to query in a batch" default:"100"`
+}
+
+// Chore deletes the objects which expired according to the lifecycle rules of their bucket.
+//
+// architecture: Chore
+type Chore struct {
+	log      *zap.Logger
+	config   Config
+	metabase *metabase.DB
+	buckets  buckets.DB
+
+	nowFn func() time.Time
+	Loop  *sync2.Cycle
+}
+
+// NewChore creates a new instance of the lifecycle chore.
+func NewChore(log *zap.Logger, config Config, metabase *metabase.DB, buckets buckets.DB) *Chore {
+	return &Chore{
+		log:      log,
+		config:   config,
+		metabase: metabase,
+		buckets:  buckets,
+
+		nowFn: time.Now,
+		Loop:  sync2.NewCycle(config.Interval),
+	}
+}
+
+// Run starts the lifecycle loop service.
+func (chore *Chore) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if !chore.config.Enabled {
+		return nil
+	}
+
+	return chore.Loop.Run(ctx, chore.applyLifecycles)
+}
+
+// Close stops the lifecycle chore.
+func (chore *Chore) Close() error {
+	chore.Loop.Close()
+	return nil
+}
+
+// TestingSetNow allows tests to have the server act as if the current time is whatever they want.
+func (chore *Chore) TestingSetNow(nowFn func() time.Time) {
+	chore.nowFn = nowFn
+}
+
+func (chore *Chore) applyLifecycles(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	chore.log.Debug("applying bucket lifecycle rules")
+
+	err = chore.buckets.IterateBucketLifecycles(ctx, chore.config.ListLimit, func(page []buckets.BucketLifecycle) error {
+		for _, lifecycle := range page {
+			if err := chore.applyLifecycle(ctx, lifecycle); err != nil {
+				// a single broken bucket should not block the others.
+				chore.log.Error("applying bucket lifecycle rules failed",
+					zap.Stringer("Project ID", lifecycle.Bucket.ProjectID),
+					zap.String("Bucket", lifecycle.Bucket.BucketName),
+					zap.Error(err))
+			}
+		}
+		return nil
+	})
+	return Error.Wrap(err)
+}
+
+func (chore *Chore) applyLifecycle(ctx context.Context, lifecycle buckets.BucketLifecycle) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	now := chore.nowFn()
+	bucket := lifecycle.Bucket
+	config := lifecycle.Configuration
+
+	versioning, err := chore.buckets.GetBucketVersioningState(ctx, []byte(bucket.BucketName), bucket.ProjectID)
+	if err != nil {
+		if buckets.ErrBucketNotFound.Has(err) {
+			return nil
+		}
+		return err
+	}
+
+	err = chore.metabase.IterateObjectsAllVersionsWithStatus(ctx, metabase.IterateObjectsWithStatus{
+		ProjectID:  bucket.ProjectID,
+		BucketName: bucket.BucketName,
+		Recursive:  true,
+		BatchSize:  chore.config.ListLimit,
+		Pending:    true,
+	}, func(ctx context.Context, it metabase.ObjectsIterator) error {
+		var entry metabase.ObjectEntry
+		for it.Next(ctx, &entry) {
+			action := config.Evaluate(buckets.LifecycleObject{
+				ObjectKey: entry.ObjectKey,
+				Status:    entry.Status,
+				CreatedAt: entry.CreatedAt,
+			}, now)
+			if action != buckets.LifecycleAbortUpload {
+				continue
+			}
+
+			_, err := chore.metabase.DeletePendingObject(ctx, metabase.DeletePendingObject{
+				ObjectStream: metabase.ObjectStream{
+					ProjectID:  bucket.ProjectID,
+					BucketName: bucket.BucketName,
+					ObjectKey:  entry.ObjectKey,
+					Version:    entry.Version,
+					StreamID:   entry.StreamID,
+				},
+			})
+			if err != nil && !metabase.ErrObjectNotFound.Has(err) {
+				return err
+			}
+			mon.Counter("lifecycle_aborted_uploads").Inc(1)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return chore.metabase.IterateObjectsAllVersionsWithStatus(ctx, metabase.IterateObjectsWithStatus{
+		ProjectID:  bucket.ProjectID,
+		BucketName: bucket.BucketName,
+		Recursive:  true,
+		BatchSize:  chore.config.ListLimit,
+	}, func(ctx context.Context, it metabase.ObjectsIterator) error {
+		// versions of a key are listed from the newest, so the first one is the current
+		// version and every other version became noncurrent when the previous one was created.
+		var previous metabase.ObjectEntry
+		var entry metabase.ObjectEntry
+		for it.Next(ctx, &entry) {
+			object := buckets.LifecycleObject{
+				ObjectKey: entry.ObjectKey,
+				Status:    entry.Status,
+				CreatedAt: entry.CreatedAt,
+			}
+			if previous.ObjectKey == entry.ObjectKey && !previous.CreatedAt.IsZero() {
+				object.NoncurrentSince = previous.CreatedAt
+			}
+			previous = entry
+
+			switch config.Evaluate(object, now) {
+			case buckets.LifecycleExpireCurrent:
+				_, err := chore.metabase.DeleteObjectLastCommitted(ctx, metabase.DeleteObjectLastCommitted{
+					ObjectLocation: metabase.ObjectLocation{
+						ProjectID:  bucket.ProjectID,
+						BucketName: bucket.BucketName,
+						ObjectKey:  entry.ObjectKey,
+					},
+					Versioned: versioning == buckets.VersioningEnabled,
+					Suspended: versioning == buckets.VersioningSuspended,
+				})
+				if err != nil && !metabase.ErrObjectNotFound.Has(err) {
+					return err
+				}
+				mon.Counter("lifecycle_expired_current_versions").Inc(1)
+			case buckets.LifecycleExpireNoncurrent:
+				_, err := chore.metabase.DeleteObjectExactVersion(ctx, metabase.DeleteObjectExactVersion{
+					ObjectLocation: metabase.ObjectLocation{
+						ProjectID:  bucket.ProjectID,
+						BucketName: bucket.BucketName,
+						ObjectKey:  entry.ObjectKey,
+					},
+					Version: entry.Version,
+				})
+				if err != nil && !metabase.ErrObjectNotFound.Has(err) {
+					return err
+				}
+				mon.Counter("lifecycle_expired_noncurrent_versions").Inc(1)
+			}
+		}
+		return nil
+	})
+}
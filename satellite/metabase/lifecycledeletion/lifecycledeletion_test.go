@@ -0,0 +1,74 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package lifecycledeletion_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/common/memory"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/buckets"
+	"storj.io/storj/satellite/metabase"
+)
+
+func TestLifecycleDeletion(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.LifecycleDeletion.Enabled = true
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		upl := planet.Uplinks[0]
+		chore := sat.Core.LifecycleDeletion.Chore
+
+		chore.Loop.Pause()
+
+		require.NoError(t, upl.CreateBucket(ctx, sat, "testbucket"))
+		require.NoError(t, upl.Upload(ctx, sat, "testbucket", "logs/expired", testrand.Bytes(1*memory.KiB)))
+		require.NoError(t, upl.Upload(ctx, sat, "testbucket", "data/kept", testrand.Bytes(1*memory.KiB)))
+
+		project, err := upl.OpenProject(ctx, sat)
+		require.NoError(t, err)
+		defer ctx.Check(project.Close)
+
+		_, err = project.BeginUpload(ctx, "testbucket", "data/pending", nil)
+		require.NoError(t, err)
+
+		err = sat.DB.Buckets().SetBucketLifecycle(ctx, []byte("testbucket"), upl.Projects[0].ID, buckets.LifecycleConfiguration{
+			Rules: []buckets.LifecycleRule{
+				{ID: "logs", Prefix: "logs/", Enabled: true, ExpireCurrentAfterDays: 30},
+				{ID: "uploads", Enabled: true, AbortIncompleteUploadAfterDays: 7},
+			},
+		})
+		require.NoError(t, err)
+
+		// nothing has expired yet.
+		chore.Loop.TriggerWait()
+
+		objects, err := sat.Metabase.DB.TestingAllObjects(ctx)
+		require.NoError(t, err)
+		require.Len(t, objects, 3)
+
+		chore.TestingSetNow(func() time.Time {
+			return time.Now().Add(31 * 24 * time.Hour)
+		})
+		chore.Loop.TriggerWait()
+
+		objects, err = sat.Metabase.DB.TestingAllObjects(ctx)
+		require.NoError(t, err)
+		require.Len(t, objects, 1)
+		require.Equal(t, metabase.ObjectKey("data/kept"), objects[0].ObjectKey)
+		require.Equal(t, metabase.CommittedUnversioned, objects[0].Status)
+	})
+}
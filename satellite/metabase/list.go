@@ -104,10 +104,14 @@ type IterateObjectsWithStatus struct {
 // IterateObjectsAllVersionsWithStatus iterates through all versions of all objects with specified status.
 func (db *DB) IterateObjectsAllVersionsWithStatus(ctx context.Context, opts IterateObjectsWithStatus, fn func(context.Context, ObjectsIterator) error) (err error) {
 	defer mon.Task()(&ctx)(&err)
+	return db.iterateObjectsAllVersionsWithStatus(ctx, db.ChooseAdapter(opts.ProjectID), opts, fn)
+}
+
+func (db *DB) iterateObjectsAllVersionsWithStatus(ctx context.Context, adapter Adapter, opts IterateObjectsWithStatus, fn func(context.Context, ObjectsIterator) error) (err error) {
 	if err = opts.Verify(); err != nil {
 		return err
 	}
-	return iterateAllVersionsWithStatusDescending(ctx, db.ChooseAdapter(opts.ProjectID), opts, fn)
+	return iterateAllVersionsWithStatusDescending(ctx, adapter, opts, fn)
 }
 
 // IterateObjectsAllVersionsWithStatusAscending iterates through all versions of all objects with specified status. Ordered from oldest to latest.
@@ -147,7 +151,7 @@ func (db *DB) ListObjectsWithIterator(ctx context.Context, opts ListObjects) (re
 
 	ListLimit.Ensure(&opts.Limit)
 
-	err = db.IterateObjectsAllVersionsWithStatus(ctx,
+	err = db.iterateObjectsAllVersionsWithStatus(ctx, db.ChooseReadAdapter(opts.ProjectID, ReadListObjects),
 		IterateObjectsWithStatus{
 			ProjectID:  opts.ProjectID,
 			BucketName: opts.BucketName,
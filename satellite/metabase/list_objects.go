@@ -5,16 +5,14 @@ package metabase
 
 import (
 	"context"
-	"database/sql"
-	"errors"
+	"strconv"
 	"strings"
+	"time"
 
 	spanner "github.com/storj/exp-spanner"
 	"github.com/zeebo/errs"
-	"google.golang.org/api/iterator"
 
 	"storj.io/common/uuid"
-	"storj.io/storj/shared/dbutil/spannerutil"
 	"storj.io/storj/shared/tagsql"
 )
 
@@ -39,6 +37,16 @@ type ListObjects struct {
 	AllVersions           bool
 	IncludeCustomMetadata bool
 	IncludeSystemMetadata bool
+
+	// TagConditions limits the listing to the objects having all of the tags.
+	// Prefixes of a non-recursive listing are always included.
+	TagConditions []ObjectTag
+
+	// AsOfSystemTime lists the objects as they were at the specified time,
+	// without blocking concurrent writes. It's a stale read on Spanner and
+	// AS OF SYSTEM TIME on CockroachDB. Postgres doesn't support it and lists
+	// the current objects, which weren't expired at that time.
+	AsOfSystemTime time.Time
 }
 
 // Verify verifies get object request fields.
@@ -50,9 +58,13 @@ func (opts *ListObjects) Verify() error {
 		return ErrInvalidRequest.New("BucketName missing")
 	case opts.Limit < 0:
 		return ErrInvalidRequest.New("Invalid limit: %d", opts.Limit)
+	case opts.Pending && len(opts.TagConditions) > 0:
+		return ErrInvalidRequest.New("pending objects cannot be listed by tags")
+	case opts.AsOfSystemTime.After(time.Now()):
+		return ErrInvalidRequest.New("AsOfSystemTime is in the future")
 	}
 
-	return nil
+	return verifyObjectTags(opts.TagConditions, MaxObjectTags)
 }
 
 // ListObjectsResult result of listing objects.
@@ -65,7 +77,8 @@ type ListObjectsResult struct {
 func (db *DB) ListObjects(ctx context.Context, opts ListObjects) (result ListObjectsResult, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	if db.config.UseListObjectsIterator {
+	// tag conditions and point-in-time listings are only supported by the queries of the adapters.
+	if db.config.UseListObjectsIterator && len(opts.TagConditions) == 0 && opts.AsOfSystemTime.IsZero() {
 		return db.ListObjectsWithIterator(ctx, opts)
 	}
 
@@ -75,400 +88,469 @@ func (db *DB) ListObjects(ctx context.Context, opts ListObjects) (result ListObj
 
 	ListLimit.Ensure(&opts.Limit)
 
-	return db.ChooseAdapter(opts.ProjectID).ListObjects(ctx, opts)
+	return db.ChooseReadAdapter(opts.ProjectID, ReadListObjects).ListObjects(ctx, opts)
+}
+
+// IterateListObjects streams the entries of a listing to fn, with the same
+// semantics as ListObjects. Instead of buffering a page, the entries are read
+// from the database in batches, so the listing can continue past opts.Limit,
+// which is only used to size the batches.
+func (db *DB) IterateListObjects(ctx context.Context, opts ListObjects, fn func(context.Context, ObjectsIterator) error) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := opts.Verify(); err != nil {
+		return err
+	}
+
+	ListLimit.Ensure(&opts.Limit)
+
+	return iterateListObjects(ctx, db.ChooseReadAdapter(opts.ProjectID, ReadListObjects), opts, fn)
 }
 
 // ListObjects lists objects.
 func (p *PostgresAdapter) ListObjects(ctx context.Context, opts ListObjects) (result ListObjectsResult, err error) {
-	// maxSkipVersionsUntilRequery is the limit on how many versions we query for a single object, until we requery.
-	const maxSkipVersionsUntilRequery = 100
+	const tag = queryTag("list_objects")
+	done := tag.observe(p.impl.String())
+	defer func() { done(int64(len(result.Objects)), err) }()
 
-	// maxSkipPrefixUntilRequery is the limit on how many entries we scan inside a prefix, until we requery.
-	const maxSkipPrefixUntilRequery = 10
-
-	// minQuerySize ensures that we list a more entries, as there's a significant overhead to a single query.
-	const minQuerySize = 100
+	return listObjects(ctx, p, opts)
+}
 
-	// requeryLimit is a safety net for invalid implementation.
-	requeryLimit := opts.Limit + 10 // we do some extra queries, but, roughly at most we should have one query per entry
+// ListObjects lists objects.
+func (s *SpannerAdapter) ListObjects(ctx context.Context, opts ListObjects) (result ListObjectsResult, err error) {
+	const tag = queryTag("list_objects")
+	done := tag.observe(s.Name())
+	defer func() { done(int64(len(result.Objects)), err) }()
 
-	// extraSkipEntries to avoid requerying in the common case of !AllVersions.
-	const extraSkipEntries = 10
-	// extraEntriesForMore is the additional entry we need for determining whether there are more entries.
-	const extraEntriesForMore = 1
-	batchSize := opts.Limit + extraEntriesForMore + extraSkipEntries
+	return listObjects(ctx, s, opts)
+}
 
-	if batchSize < minQuerySize {
-		batchSize = minQuerySize
-	}
+func listObjects(ctx context.Context, adapter Adapter, opts ListObjects) (result ListObjectsResult, err error) {
+	err = iterateListObjects(ctx, adapter, opts, func(ctx context.Context, it ObjectsIterator) error {
+		var entry ObjectEntry
+		for it.Next(ctx, &entry) {
+			if len(result.Objects) >= opts.Limit {
+				result.More = true
+				return nil
+			}
+			result.Objects = append(result.Objects, entry)
+		}
+		return nil
+	})
+	return result, err
+}
 
-	// lastEntry is used to keep track of the last entry put into the result.
-	var lastEntry struct {
+// listObjectsIterator iterates over the entries of a listing.
+//
+// Collapsing the keys of a non-recursive listing into prefixes is done by
+// the queries: on Postgres and Cockroach the query skips over a prefix as
+// soon as it finds the first key inside it, while on Spanner the query is
+// restarted past a prefix when the iteration lands inside one.
+type listObjectsIterator struct {
+	opts        ListObjects
+	batchSize   int
+	cursor      ListObjectsCursor
+	doNextQuery func(context.Context, *listObjectsIterator) (_ tagsql.Rows, err error)
+
+	curRows tagsql.Rows
+	scanned int
+	done    bool
+
+	// requeryLimit is a safety net for an invalid implementation, roughly
+	// at most one query should be needed per returned entry.
+	requeryLimit int
+	queries      int
+	returned     int
+
+	// lastEntry is the last entry read from the database.
+	lastEntry struct {
 		Set bool
 
 		ObjectKey ObjectKey
 		Version   Version
 		IsPrefix  bool
 	}
+	// skippedVersions counts the consecutive versions skipped for the last
+	// object, when listing only the latest versions.
+	skippedVersions int
 
-	// skipCounter keeps track on how many entries we have skipped either due to
-	// objects of similar version or due to a collapsed non-recursive prefix.
-	type skipCounter struct {
-		Prefix  int
-		Version int
-	}
-	var skipCount skipCounter
+	// failErr is set when either scan or next query fails during iteration.
+	failErr error
+}
 
-	cursor := opts.StartCursor()
+func iterateListObjects(ctx context.Context, adapter Adapter, opts ListObjects, fn func(context.Context, ObjectsIterator) error) (err error) {
+	defer mon.Task()(&ctx)(&err)
 
-	for repeat := 0; repeat < requeryLimit; repeat++ {
-		args := []any{
-			opts.ProjectID, []byte(opts.BucketName),
-			cursor.Key, cursor.Version,
-			batchSize, nextBucket([]byte(opts.BucketName)),
-		}
-		if opts.Prefix != "" {
-			args = append(args, len(opts.Prefix)+1, opts.stopKey())
-		}
+	// minQuerySize ensures that we list a more entries, as there's a significant overhead to a single query.
+	const minQuerySize = 100
+	// extraSkipEntries to avoid requerying in the common case of !AllVersions.
+	const extraSkipEntries = 10
+	// extraEntriesForMore is the additional entry we need for determining whether there are more entries.
+	const extraEntriesForMore = 1
 
-		var objectKey = `object_key`
-		if opts.Prefix != "" {
-			objectKey = `substring(object_key from $7) AS object_key`
-		}
+	it := &listObjectsIterator{
+		opts:        opts,
+		batchSize:   opts.Limit + extraEntriesForMore + extraSkipEntries,
+		cursor:      opts.StartCursor(),
+		doNextQuery: adapter.doNextQueryListObjects,
 
-		var statusCondition = `status != ` + statusPending
-		if opts.Pending {
-			statusCondition = `status = ` + statusPending
-		}
+		// we do some extra queries, but, roughly at most we should have one query per entry
+		requeryLimit: opts.Limit + 10,
+	}
+	if it.batchSize < minQuerySize {
+		it.batchSize = minQuerySize
+	}
 
-		rows, err := p.db.QueryContext(ctx, `SELECT
-			`+objectKey+`,
-			version
-			`+opts.selectedFields()+`
-			FROM objects
-			WHERE
-				`+opts.boundaryPostgres()+`
-				AND (project_id, bucket_name) < ($1, $6)
-				AND `+statusCondition+`
-				AND (expires_at IS NULL OR expires_at > now())
-			ORDER BY `+opts.orderBy()+`
-			LIMIT $5
-		`, args...)
-		if errors.Is(err, sql.ErrNoRows) {
-			return result, nil
-		}
-		if err != nil {
-			return result, Error.Wrap(err)
+	defer func() {
+		if it.curRows != nil {
+			err = errs.Combine(err, it.curRows.Err(), it.curRows.Close())
 		}
+		err = Error.Wrap(errs.Combine(err, it.failErr))
+	}()
 
-		scannedCount := 0
-		skipAhead := false
-	read_entries:
-		for rows.Next() {
-			entry, err := scanListObjectsEntryPostgres(rows, &opts)
-			if err != nil {
-				return result, Error.Wrap(errs.Combine(err, rows.Err(), rows.Close()))
+	return fn(ctx, it)
+}
+
+// Next returns true if there was another entry and copies it into item.
+func (it *listObjectsIterator) Next(ctx context.Context, item *ObjectEntry) bool {
+	// maxSkipVersionsUntilRequery is the limit on how many versions we query for a single object, until we requery.
+	const maxSkipVersionsUntilRequery = 100
+
+	opts := &it.opts
+	for {
+		if it.curRows == nil {
+			if it.done || it.failErr != nil {
+				return false
 			}
-			scannedCount++
-
-			// skip a duplicate prefix entry, which only happens with opts.Recursive
-			// TODO: does this need opts.AllVersions
-			skipPrefix := lastEntry.Set && opts.AllVersions && lastEntry.IsPrefix && entry.IsPrefix && lastEntry.ObjectKey == entry.ObjectKey
-			// skip duplicate object key with other versions, when !opts.AllVersions
-			skipVersion := lastEntry.Set && !opts.AllVersions && lastEntry.IsPrefix == entry.IsPrefix && lastEntry.ObjectKey == entry.ObjectKey
-
-			// we'll need to ensure that when we are iterating only latest objects that we don't
-			// emit an object entry when we start iterating from half-way in versions.
-			var skipCursorAllVersionsDoubleCheck bool
-			if !opts.AllVersions && entryKeyMatchesCursor(opts.Prefix, entry.ObjectKey, opts.Cursor.Key) {
-				if opts.VersionAscending() {
-					skipCursorAllVersionsDoubleCheck = entry.Version <= opts.Cursor.Version
-				} else {
-					skipCursorAllVersionsDoubleCheck = entry.Version >= opts.Cursor.Version
-				}
+			if it.queries >= it.returned+it.requeryLimit {
+				it.failErr = errs.New("too many requeries: %d queries for %d entries", it.queries, it.returned)
+				return false
 			}
+			it.queries++
 
-			lastEntry.Set = true
-			lastEntry.ObjectKey = entry.ObjectKey
-			lastEntry.Version = entry.Version
-			lastEntry.IsPrefix = entry.IsPrefix
-
-			if skipPrefix || skipVersion || skipCursorAllVersionsDoubleCheck {
-				if skipPrefix {
-					skipCount.Prefix++
-				}
-				if skipVersion {
-					skipCount.Version++
-				}
+			rows, err := it.doNextQuery(ctx, it)
+			if err != nil {
+				it.failErr = err
+				return false
+			}
+			it.curRows = rows
+			it.scanned = 0
+		}
 
-				if skipCount.Prefix >= maxSkipPrefixUntilRequery || skipCount.Version >= maxSkipVersionsUntilRequery {
-					skipAhead = true
-					skipCount = skipCounter{}
-					// we landed inside a large number of repeated items,
-					// either prefixes or versions, let's requery and skip
-					break read_entries
-				}
+		if !it.curRows.Next() {
+			exhausted := it.scanned < it.batchSize
+			if !it.closeRows() {
+				return false
+			}
+			if exhausted {
+				it.done = true
+				return false
+			}
+			it.advanceCursor()
+			continue
+		}
 
-				continue
+		entry, tagsMatch, err := scanListObjectsEntry(it.curRows, opts)
+		if err != nil {
+			it.failErr = err
+			return false
+		}
+		it.scanned++
+
+		last := it.lastEntry
+
+		// landing on a prefix we already returned, which only happens with !opts.Recursive
+		insidePrefix := last.Set && last.IsPrefix && entry.IsPrefix && last.ObjectKey == entry.ObjectKey
+		// skip duplicate object key with other versions, when !opts.AllVersions
+		skipVersion := last.Set && !opts.AllVersions && last.IsPrefix == entry.IsPrefix && last.ObjectKey == entry.ObjectKey
+
+		// we'll need to ensure that when we are iterating only latest objects that we don't
+		// emit an object entry when we start iterating from half-way in versions.
+		var skipCursorAllVersionsDoubleCheck bool
+		if !opts.AllVersions && entryKeyMatchesCursor(opts.Prefix, entry.ObjectKey, opts.Cursor.Key) {
+			if opts.VersionAscending() {
+				skipCursorAllVersionsDoubleCheck = entry.Version <= opts.Cursor.Version
+			} else {
+				skipCursorAllVersionsDoubleCheck = entry.Version >= opts.Cursor.Version
 			}
+		}
 
-			skipCount = skipCounter{}
+		it.lastEntry.Set = true
+		it.lastEntry.ObjectKey = entry.ObjectKey
+		it.lastEntry.Version = entry.Version
+		it.lastEntry.IsPrefix = entry.IsPrefix
 
-			// We don't want to include delete markers in the output, when we are listing only the latest version.
-			// We still set "lastEntry" so we skip any objects that are beyond the delete marker.
-			if !opts.AllVersions && entry.Status.IsDeleteMarker() {
-				continue
+		if insidePrefix || skipVersion || skipCursorAllVersionsDoubleCheck {
+			if skipVersion && !insidePrefix {
+				it.skippedVersions++
 			}
 
-			result.Objects = append(result.Objects, entry)
-			if len(result.Objects) >= opts.Limit+1 {
-				result.More = true
-				result.Objects = result.Objects[:opts.Limit]
-				return result, Error.Wrap(errs.Combine(err, rows.Err(), rows.Close()))
+			if insidePrefix || it.skippedVersions >= maxSkipVersionsUntilRequery {
+				// the rest of the prefix or the versions are not needed,
+				// so let's requery past them.
+				it.skippedVersions = 0
+				if !it.closeRows() {
+					return false
+				}
+				it.advanceCursor()
 			}
+			continue
 		}
 
-		if err := errs.Combine(rows.Err(), rows.Close()); err != nil {
-			return result, Error.Wrap(err)
-		}
+		it.skippedVersions = 0
 
-		if scannedCount == 0 {
-			result.More = false
-			return result, nil
-		}
-		if !skipAhead && scannedCount < batchSize {
-			result.More = false
-			return result, nil
+		// We don't want to include delete markers in the output, when we are listing only the latest version.
+		// We still set "lastEntry" so we skip any objects that are beyond the delete marker.
+		if !opts.AllVersions && entry.Status.IsDeleteMarker() {
+			continue
 		}
 
-		switch {
-		case lastEntry.IsPrefix: // can only be true if recursive listing
-			// skip over the prefix
-			cursor.Key = opts.Prefix + lastEntry.ObjectKey[:len(lastEntry.ObjectKey)-1] + DelimiterNext
-			cursor.Version = opts.FirstVersion()
-
-		case opts.AllVersions:
-			// continue where-ever we left off
-			cursor.Key = opts.Prefix + lastEntry.ObjectKey
-			cursor.Version = lastEntry.Version
-
-		case !opts.AllVersions:
-			// jump to the next object
-			cursor.Key = opts.Prefix + lastEntry.ObjectKey
-			cursor.Version = opts.lastVersion()
+		// Similarly, the latest version not matching the tags hides the older versions.
+		if !tagsMatch {
+			continue
 		}
+
+		it.returned++
+		*item = entry
+		return true
 	}
+}
 
-	panic("too many requeries")
+// closeRows closes the current query and reports whether it succeeded.
+func (it *listObjectsIterator) closeRows() bool {
+	err := errs.Combine(it.curRows.Err(), it.curRows.Close())
+	it.curRows = nil
+	if err != nil {
+		it.failErr = err
+		return false
+	}
+	return true
 }
 
-// ListObjects lists objects.
-func (s *SpannerAdapter) ListObjects(ctx context.Context, opts ListObjects) (result ListObjectsResult, err error) {
-	// TODO(spanner): retune all of these for Spanner. Also, can we use a smarter query now
-	// using some feature that wasn't in Cockroach? (e.g. windowed queries).
+// advanceCursor moves the cursor past the last entry, for the next query.
+func (it *listObjectsIterator) advanceCursor() {
+	opts := &it.opts
+	switch {
+	case it.lastEntry.IsPrefix: // can only be true if recursive listing
+		// skip over the prefix
+		it.cursor.Key = opts.Prefix + it.lastEntry.ObjectKey[:len(it.lastEntry.ObjectKey)-1] + DelimiterNext
+		it.cursor.Version = opts.FirstVersion()
 
-	// maxSkipVersionsUntilRequery is the limit on how many versions we query for a single object, until we requery.
-	const maxSkipVersionsUntilRequery = 100
+	case opts.AllVersions:
+		// continue where-ever we left off
+		it.cursor.Key = opts.Prefix + it.lastEntry.ObjectKey
+		it.cursor.Version = it.lastEntry.Version
 
-	// maxSkipPrefixUntilRequery is the limit on how many entries we scan inside a prefix, until we requery.
-	const maxSkipPrefixUntilRequery = 10
+	case !opts.AllVersions:
+		// jump to the next object
+		it.cursor.Key = opts.Prefix + it.lastEntry.ObjectKey
+		it.cursor.Version = opts.lastVersion()
+	}
+}
 
-	// minQuerySize ensures that we list a more entries, as there's a significant overhead to a single query.
-	const minQuerySize = 100
+func (p *PostgresAdapter) doNextQueryListObjects(ctx context.Context, it *listObjectsIterator) (_ tagsql.Rows, err error) {
+	defer mon.Task()(&ctx)(&err)
 
-	// requeryLimit is a safety net for invalid implementation.
-	requeryLimit := opts.Limit + 10 // we do some extra queries, but, roughly at most we should have one query per entry
+	const tag = queryTag("iterate_list_objects")
+	done := tag.observe(p.impl.String())
+	defer func() { done(-1, err) }()
 
-	// extraSkipEntries to avoid requerying in the common case of !AllVersions.
-	const extraSkipEntries = 10
-	// extraEntriesForMore is the additional entry we need for determining whether there are more entries.
-	const extraEntriesForMore = 1
-	batchSize := opts.Limit + extraEntriesForMore + extraSkipEntries
+	opts := &it.opts
 
-	if batchSize < minQuerySize {
-		batchSize = minQuerySize
+	args := []any{
+		opts.ProjectID, []byte(opts.BucketName),
+		it.cursor.Key, it.cursor.Version,
+		it.batchSize, nextBucket([]byte(opts.BucketName)),
+	}
+	if opts.Prefix != "" || !opts.Recursive {
+		args = append(args, len(opts.Prefix)+1)
+	}
+	if opts.Prefix != "" {
+		args = append(args, opts.stopKey())
 	}
 
-	// lastEntry is used to keep track of the last entry put into the result.
-	var lastEntry struct {
-		Set bool
+	var objectKey = `object_key`
+	if opts.Prefix != "" {
+		objectKey = `substring(object_key from $7) AS object_key`
+	}
 
-		ObjectKey ObjectKey
-		Version   Version
-		IsPrefix  bool
+	var statusCondition = `status != ` + statusPending
+	if opts.Pending {
+		statusCondition = `status = ` + statusPending
 	}
 
-	// skipCounter keeps track on how many entries we have skipped either due to
-	// objects of similar version or due to a collapsed non-recursive prefix.
-	type skipCounter struct {
-		Prefix  int
-		Version int
+	var tagsMatch string
+	if len(opts.TagConditions) > 0 {
+		condition, conditionArgs := tagConditionsPostgres(opts.TagConditions, len(args)+1)
+		tagsMatch = `, ` + condition + ` AS tags_match`
+		args = append(args, conditionArgs...)
 	}
-	var skipCount skipCounter
 
-	cursor := opts.StartCursor()
+	var notExpired = `(expires_at IS NULL OR expires_at > now())`
+	if !opts.AsOfSystemTime.IsZero() {
+		notExpired = `(expires_at IS NULL OR expires_at > $` + strconv.Itoa(len(args)+1) + `)`
+		args = append(args, opts.AsOfSystemTime)
+	}
+	asOf := p.impl.AsOfSystemTime(opts.AsOfSystemTime)
 
-	for repeat := 0; repeat < requeryLimit; repeat++ {
-		args := map[string]any{
-			"project_id":     opts.ProjectID,
-			"bucket_name":    opts.BucketName,
-			"cursor_key":     cursor.Key,
-			"cursor_version": cursor.Version,
-			"limit":          batchSize,
-			"next_bucket":    nextBucket([]byte(opts.BucketName)),
-		}
-		if opts.Prefix != "" {
-			args["prefix_len"] = len(opts.Prefix) + 1
-			args["stop_key"] = opts.stopKey()
-		}
+	if opts.Recursive {
+		return p.db.QueryContext(ctx, `SELECT
+			`+objectKey+`,
+			version
+			`+opts.selectedFields()+`
+			`+tagsMatch+`
+			FROM objects
+			`+asOf+`
+			WHERE
+				`+opts.boundaryPostgres()+`
+				AND (project_id, bucket_name) < ($1, $6)
+				AND `+statusCondition+`
+				AND `+notExpired+`
+			ORDER BY `+opts.orderBy()+`
+			LIMIT $5
+		`, args...)
+	}
 
-		var objectKey = `object_key`
-		if opts.Prefix != "" {
-			objectKey = `substr(object_key, @prefix_len) AS object_key`
-		}
+	// For a non-recursive listing, entry_keys walks the keys with a skip-scan:
+	// each step finds the first key after the previous entry, which is either
+	// an object or the first key of a prefix. After a prefix, the next step
+	// continues past all the keys of that prefix.
+	delimiter := "$" + strconv.Itoa(len(args)+1)
+	delimiterNext := "$" + strconv.Itoa(len(args)+2)
+	keyNext := "$" + strconv.Itoa(len(args)+3)
+	args = append(args, []byte{Delimiter}, []byte(DelimiterNext), []byte{0})
 
-		var statusCondition = `status != ` + statusPending
-		if opts.Pending {
-			statusCondition = `status = ` + statusPending
-		}
+	var prefixCondition string
+	if opts.Prefix != "" {
+		prefixCondition = `AND (project_id, bucket_name, object_key) < ($1, $2, $8)`
+	}
 
-		stmt := spanner.Statement{
-			SQL: `
+	return p.db.QueryContext(ctx, `
+		WITH RECURSIVE entry_keys (entry_key, entry_version, entry_is_prefix, depth) AS (
+			(
 				SELECT
-					` + objectKey + `,
-					version
-					` + opts.selectedFields() + `
+					object_key, version,
+					position(`+delimiter+`::BYTEA IN substring(object_key from $7)) > 0,
+					1
 				FROM objects
 				WHERE
-					` + opts.boundarySpanner() + `
-					AND ((project_id < @project_id) OR (project_id = @project_id AND bucket_name < CAST(@next_bucket AS STRING)))
-					AND ` + statusCondition + `
-					AND (expires_at IS NULL OR expires_at > CURRENT_TIMESTAMP)
-				ORDER BY ` + opts.orderBy() + `
-				LIMIT @limit
-			`,
-			Params: args,
-		}
+					`+opts.boundaryPostgres()+`
+					AND (project_id, bucket_name) < ($1, $6)
+					AND `+statusCondition+`
+					AND `+notExpired+`
+				ORDER BY `+opts.orderBy()+`
+				LIMIT 1
+			)
+			UNION ALL
+			(
+				SELECT
+					next_entry.object_key, next_entry.version,
+					position(`+delimiter+`::BYTEA IN substring(next_entry.object_key from $7)) > 0,
+					entry_keys.depth + 1
+				FROM entry_keys, LATERAL (
+					SELECT object_key, version
+					FROM objects
+					WHERE
+						(project_id, bucket_name, object_key) >= ($1, $2, CASE
+							WHEN entry_keys.entry_is_prefix THEN
+								substring(entry_keys.entry_key from 1 for $7 - 2 + position(`+delimiter+`::BYTEA IN substring(entry_keys.entry_key from $7))) || `+delimiterNext+`::BYTEA
+							ELSE
+								entry_keys.entry_key || `+keyNext+`::BYTEA
+							END)
+						`+prefixCondition+`
+						AND (project_id, bucket_name) < ($1, $6)
+						AND `+statusCondition+`
+						AND `+notExpired+`
+					ORDER BY `+opts.orderBy()+`
+					LIMIT 1
+				) AS next_entry
+				WHERE entry_keys.depth < $5
+			)
+		)
+		SELECT
+			`+objectKey+`,
+			version
+			`+opts.selectedFields()+`
+			`+tagsMatch+`
+		FROM entry_keys
+		JOIN objects ON (project_id, bucket_name, object_key) = ($1, $2, entry_keys.entry_key)
+		`+asOf+`
+		WHERE
+			(NOT entry_keys.entry_is_prefix OR version = entry_keys.entry_version)
+			AND `+opts.boundaryPostgres()+`
+			AND `+statusCondition+`
+			AND `+notExpired+`
+		ORDER BY `+opts.orderBy()+`
+		LIMIT $5
+	`, args...)
+}
 
-		scannedCount := 0
-		skipAhead := false
-		done := false
-
-		err := func() error {
-			rowIterator := s.client.Single().Query(ctx, stmt)
-			defer rowIterator.Stop()
-
-		readEntries:
-			for {
-				row, err := rowIterator.Next()
-				if err != nil {
-					if errors.Is(err, iterator.Done) {
-						done = true
-						return nil
-					}
-					return Error.Wrap(err)
-				}
+func (s *SpannerAdapter) doNextQueryListObjects(ctx context.Context, it *listObjectsIterator) (_ tagsql.Rows, err error) {
+	defer mon.Task()(&ctx)(&err)
 
-				entry, err := scanListObjectsEntrySpanner(row, &opts)
-				if err != nil {
-					return Error.Wrap(err)
-				}
-				scannedCount++
-
-				// skip a duplicate prefix entry, which only happens with opts.Recursive
-				// TODO: does this need opts.AllVersions
-				skipPrefix := lastEntry.Set && opts.AllVersions && lastEntry.IsPrefix && entry.IsPrefix && lastEntry.ObjectKey == entry.ObjectKey
-				// skip duplicate object key with other versions, when !opts.AllVersions
-				skipVersion := lastEntry.Set && !opts.AllVersions && lastEntry.IsPrefix == entry.IsPrefix && lastEntry.ObjectKey == entry.ObjectKey
-
-				// we'll need to ensure that when we are iterating only latest objects that we don't
-				// emit an object entry when we start iterating from half-way in versions.
-				var skipCursorAllVersionsDoubleCheck bool
-				if !opts.AllVersions && entryKeyMatchesCursor(opts.Prefix, entry.ObjectKey, opts.Cursor.Key) {
-					if opts.VersionAscending() {
-						skipCursorAllVersionsDoubleCheck = entry.Version <= opts.Cursor.Version
-					} else {
-						skipCursorAllVersionsDoubleCheck = entry.Version >= opts.Cursor.Version
-					}
-				}
+	const tag = queryTag("iterate_list_objects")
+	done := tag.observe(s.Name())
+	defer func() { done(-1, err) }()
 
-				lastEntry.Set = true
-				lastEntry.ObjectKey = entry.ObjectKey
-				lastEntry.Version = entry.Version
-				lastEntry.IsPrefix = entry.IsPrefix
-
-				if skipPrefix || skipVersion || skipCursorAllVersionsDoubleCheck {
-					if skipPrefix {
-						skipCount.Prefix++
-					}
-					if skipVersion {
-						skipCount.Version++
-					}
-
-					if skipCount.Prefix >= maxSkipPrefixUntilRequery || skipCount.Version >= maxSkipVersionsUntilRequery {
-						skipAhead = true
-						skipCount = skipCounter{}
-						// we landed inside a large number of repeated items,
-						// either prefixes or versions, let's requery and skip
-						break readEntries
-					}
-
-					continue
-				}
+	// TODO(spanner): retune the batch sizes for Spanner. Also, can we use a smarter query now
+	// using some feature that wasn't in Cockroach? (e.g. windowed queries).
 
-				skipCount = skipCounter{}
+	opts := &it.opts
 
-				// We don't want to include delete markers in the output, when we are listing only the latest version.
-				// We still set "lastEntry" so we skip any objects that are beyond the delete marker.
-				if !opts.AllVersions && entry.Status.IsDeleteMarker() {
-					continue
-				}
+	args := map[string]any{
+		"project_id":     opts.ProjectID,
+		"bucket_name":    opts.BucketName,
+		"cursor_key":     it.cursor.Key,
+		"cursor_version": it.cursor.Version,
+		"limit":          it.batchSize,
+		"next_bucket":    nextBucket([]byte(opts.BucketName)),
+	}
+	if opts.Prefix != "" {
+		args["prefix_len"] = len(opts.Prefix) + 1
+		args["stop_key"] = opts.stopKey()
+	}
 
-				result.Objects = append(result.Objects, entry)
-				if len(result.Objects) >= opts.Limit+1 {
-					result.More = true
-					result.Objects = result.Objects[:opts.Limit]
-					done = true
-					return nil
-				}
-			}
-			return nil
-		}()
-		if err != nil {
-			return result, Error.Wrap(err)
-		}
-		if done {
-			return result, nil
-		}
+	var objectKey = `object_key`
+	if opts.Prefix != "" {
+		objectKey = `substr(object_key, @prefix_len) AS object_key`
+	}
 
-		if scannedCount == 0 {
-			result.More = false
-			return result, nil
-		}
-		if !skipAhead && scannedCount < batchSize {
-			result.More = false
-			return result, nil
-		}
+	var statusCondition = `status != ` + statusPending
+	if opts.Pending {
+		statusCondition = `status = ` + statusPending
+	}
 
-		switch {
-		case lastEntry.IsPrefix: // can only be true if recursive listing
-			// skip over the prefix
-			cursor.Key = opts.Prefix + lastEntry.ObjectKey[:len(lastEntry.ObjectKey)-1] + DelimiterNext
-			cursor.Version = opts.FirstVersion()
-
-		case opts.AllVersions:
-			// continue where-ever we left off
-			cursor.Key = opts.Prefix + lastEntry.ObjectKey
-			cursor.Version = lastEntry.Version
-
-		case !opts.AllVersions:
-			// jump to the next object
-			cursor.Key = opts.Prefix + lastEntry.ObjectKey
-			cursor.Version = opts.lastVersion()
-		}
+	var tagsMatch string
+	if len(opts.TagConditions) > 0 {
+		tagsMatch = `, ` + tagConditionsSpanner(opts.TagConditions, args) + ` AS tags_match`
+	}
+
+	var notExpired = `(expires_at IS NULL OR expires_at > CURRENT_TIMESTAMP)`
+	if !opts.AsOfSystemTime.IsZero() {
+		notExpired = `(expires_at IS NULL OR expires_at > @as_of_system_time)`
+		args["as_of_system_time"] = opts.AsOfSystemTime
 	}
 
-	panic("too many requeries")
+	stmt := spanner.Statement{
+		SQL: `
+			SELECT
+				` + objectKey + `,
+				version
+				` + opts.selectedFields() + `
+				` + tagsMatch + `
+			FROM objects
+			WHERE
+				` + opts.boundarySpanner() + `
+				AND ((project_id < @project_id) OR (project_id = @project_id AND bucket_name < CAST(@next_bucket AS STRING)))
+				AND ` + statusCondition + `
+				AND ` + notExpired + `
+			ORDER BY ` + opts.orderBy() + `
+			LIMIT @limit
+		`,
+		Params: args,
+	}
+
+	return newSpannerRows(s.singleReadAt(opts.AsOfSystemTime).Query(ctx, stmt)), nil
 }
 
 func entryKeyMatchesCursor(prefix, entryKey, cursorKey ObjectKey) bool {
@@ -638,7 +720,8 @@ func (opts *ListObjects) StartCursor() ListObjectsCursor {
 	return opts.Cursor
 }
 
-func scanListObjectsEntryPostgres(rows tagsql.Rows, opts *ListObjects) (item ObjectEntry, err error) {
+func scanListObjectsEntry(rows tagsql.Rows, opts *ListObjects) (item ObjectEntry, tagsMatch bool, err error) {
+	tagsMatch = true
 	fields := []interface{}{
 		&item.ObjectKey,
 		&item.Version,
@@ -666,58 +749,12 @@ func scanListObjectsEntryPostgres(rows tagsql.Rows, opts *ListObjects) (item Obj
 		)
 	}
 
-	if err := rows.Scan(fields...); err != nil {
-		return item, err
-	}
-
-	if !opts.Recursive {
-		i := strings.IndexByte(string(item.ObjectKey), Delimiter)
-		if i >= 0 {
-			item.IsPrefix = true
-			item.ObjectKey = item.ObjectKey[:i+1]
-		}
-	}
-
-	if item.IsPrefix {
-		return ObjectEntry{
-			IsPrefix:  true,
-			ObjectKey: item.ObjectKey,
-			Status:    Prefix,
-		}, nil
-	}
-
-	return item, nil
-}
-func scanListObjectsEntrySpanner(row *spanner.Row, opts *ListObjects) (item ObjectEntry, err error) {
-	fields := []interface{}{
-		&item.ObjectKey,
-		&item.Version,
-		&item.StreamID,
-		&item.Status,
-		encryptionParameters{&item.Encryption},
-	}
-
-	if opts.IncludeSystemMetadata {
-		fields = append(fields,
-			&item.CreatedAt,
-			&item.ExpiresAt,
-			spannerutil.Int(&item.SegmentCount),
-			&item.TotalPlainSize,
-			&item.TotalEncryptedSize,
-			spannerutil.Int(&item.FixedSegmentSize),
-		)
-	}
-
-	if opts.IncludeCustomMetadata {
-		fields = append(fields,
-			&item.EncryptedMetadataNonce,
-			&item.EncryptedMetadata,
-			&item.EncryptedMetadataEncryptedKey,
-		)
+	if len(opts.TagConditions) > 0 {
+		fields = append(fields, &tagsMatch)
 	}
 
-	if err := row.Columns(fields...); err != nil {
-		return item, err
+	if err := rows.Scan(fields...); err != nil {
+		return item, false, err
 	}
 
 	if !opts.Recursive {
@@ -733,8 +770,8 @@ func scanListObjectsEntrySpanner(row *spanner.Row, opts *ListObjects) (item Obje
 			IsPrefix:  true,
 			ObjectKey: item.ObjectKey,
 			Status:    Prefix,
-		}, nil
+		}, true, nil
 	}
 
-	return item, nil
+	return item, tagsMatch, nil
 }
@@ -4,6 +4,8 @@
 package metabase_test
 
 import (
+	"context"
+	"fmt"
 	"strconv"
 	"strings"
 	"testing"
@@ -16,6 +18,7 @@ import (
 	"storj.io/common/uuid"
 	"storj.io/storj/satellite/metabase"
 	"storj.io/storj/satellite/metabase/metabasetest"
+	"storj.io/storj/shared/dbutil"
 )
 
 type listObjectsScenario struct {
@@ -2490,3 +2493,233 @@ func TestListObjects_Stress(t *testing.T) {
 		require.NoError(t, err)
 	}, metabasetest.WithSpanner())
 }
+
+func TestIterateListObjects(t *testing.T) {
+	var entries []metabase.ObjectEntry
+	streamID := uuid.UUID{1}
+	for i := 0; i < 120; i++ {
+		key := metabase.ObjectKey(fmt.Sprintf("%03d", i))
+		for _, dir := range []metabase.ObjectKey{"a/", "b/", "b/c/"} {
+			entries = append(entries, metabase.ObjectEntry{
+				ObjectKey: dir + key,
+				Version:   1,
+				StreamID:  streamID,
+				Status:    metabase.CommittedUnversioned,
+			})
+		}
+		entries = append(entries,
+			metabase.ObjectEntry{
+				ObjectKey: key,
+				Version:   1,
+				StreamID:  streamID,
+				Status:    metabase.CommittedVersioned,
+			},
+			metabase.ObjectEntry{
+				ObjectKey: key,
+				Version:   2,
+				StreamID:  streamID,
+				Status:    metabase.CommittedVersioned,
+			},
+		)
+	}
+	raw := objectEntriesToRawObjects(entries)
+	naive := NewNaiveObjectsDB(entries)
+
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		require.NoError(t, db.TestingBatchInsertObjects(ctx, raw))
+
+		var opts metabase.ListObjects
+		opts.ProjectID = uuid.UUID{1}
+		opts.BucketName = "b"
+		opts.Limit = 5
+		for _, opts.Prefix = range []metabase.ObjectKey{"", "b/"} {
+			for _, opts.AllVersions = range []bool{true, false} {
+				for _, opts.Recursive = range []bool{true, false} {
+					expOpts := opts
+					expOpts.Limit = len(entries)
+					expected, err := naive.ListObjects(ctx, expOpts)
+					require.NoError(t, err)
+					require.False(t, expected.More)
+
+					var got []metabase.ObjectEntry
+					err = db.IterateListObjects(ctx, opts, func(ctx context.Context, it metabase.ObjectsIterator) error {
+						var entry metabase.ObjectEntry
+						for it.Next(ctx, &entry) {
+							got = append(got, entry)
+						}
+						return nil
+					})
+					require.NoError(t, err)
+					require.Equal(t, expected.Objects, got, fmt.Sprintf("%#v", opts))
+				}
+			}
+		}
+	})
+}
+
+func TestListObjects_SkipScan(t *testing.T) {
+	streamID := uuid.UUID{1}
+
+	// listAll lists all entries page by page, the same way the metainfo endpoint does.
+	listAll := func(ctx context.Context, t *testing.T, db *metabase.DB, opts metabase.ListObjects) (all []metabase.ObjectEntry) {
+		for {
+			result, err := db.ListObjects(ctx, opts)
+			require.NoError(t, err)
+			all = append(all, result.Objects...)
+			if !result.More {
+				return all
+			}
+			require.NotEmpty(t, result.Objects)
+			last := result.Objects[len(result.Objects)-1]
+			opts.Cursor = metabase.ListObjectsCursor{
+				Key:     opts.Prefix + last.ObjectKey,
+				Version: last.Version,
+			}
+		}
+	}
+
+	check := func(ctx *testcontext.Context, t *testing.T, db *metabase.DB, entries []metabase.ObjectEntry, prefixes []metabase.ObjectKey, pending bool) {
+		require.NoError(t, db.TestingBatchInsertObjects(ctx, objectEntriesToRawObjects(entries)))
+		naive := NewNaiveObjectsDB(entries)
+
+		var opts metabase.ListObjects
+		opts.ProjectID = uuid.UUID{1}
+		opts.BucketName = "b"
+		opts.Pending = pending
+		for _, opts.Prefix = range prefixes {
+			for _, opts.AllVersions = range []bool{true, false} {
+				for _, opts.Recursive = range []bool{true, false} {
+					for _, opts.Limit = range []int{1, 3, 1000} {
+						expOpts := opts
+						expOpts.Limit = len(entries) + 1
+						expected, err := naive.ListObjects(ctx, expOpts)
+						require.NoError(t, err)
+						require.False(t, expected.More)
+
+						got := listAll(ctx, t, db, opts)
+						require.Equal(t, expected.Objects, got, fmt.Sprintf("%#v", opts))
+					}
+				}
+			}
+		}
+	}
+
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		t.Run("collapsed prefixes", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			var entries []metabase.ObjectEntry
+			for _, dir := range []metabase.ObjectKey{"a/", "a/b/", "a/b/c/", "b/", "c/d/"} {
+				for i := 0; i < 150; i++ {
+					entries = append(entries, metabase.ObjectEntry{
+						ObjectKey: dir + metabase.ObjectKey(fmt.Sprintf("%03d", i)),
+						Version:   1,
+						StreamID:  streamID,
+						Status:    metabase.CommittedUnversioned,
+					})
+				}
+			}
+			entries = append(entries,
+				metabase.ObjectEntry{ObjectKey: "a", Version: 1, StreamID: streamID, Status: metabase.CommittedUnversioned},
+				metabase.ObjectEntry{ObjectKey: "a0", Version: 1, StreamID: streamID, Status: metabase.CommittedUnversioned},
+				metabase.ObjectEntry{ObjectKey: "c", Version: 1, StreamID: streamID, Status: metabase.CommittedUnversioned},
+			)
+
+			check(ctx, t, db, entries, []metabase.ObjectKey{"", "a/", "a/b/"}, false)
+		})
+
+		t.Run("many versions per key", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			var entries []metabase.ObjectEntry
+			for _, key := range []metabase.ObjectKey{"a", "b/c", "d"} {
+				// more versions than a single query skips before requerying.
+				for version := metabase.Version(1); version <= 250; version++ {
+					entries = append(entries, metabase.ObjectEntry{
+						ObjectKey: key,
+						Version:   version,
+						StreamID:  streamID,
+						Status:    metabase.CommittedVersioned,
+					})
+				}
+			}
+			// the latest version of "d" is a delete marker, which hides it.
+			entries = append(entries, metabase.ObjectEntry{
+				ObjectKey: "d",
+				Version:   251,
+				StreamID:  streamID,
+				Status:    metabase.DeleteMarkerVersioned,
+			})
+
+			check(ctx, t, db, entries, []metabase.ObjectKey{"", "b/"}, false)
+		})
+
+		t.Run("pending objects", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			var entries []metabase.ObjectEntry
+			for _, dir := range []metabase.ObjectKey{"", "a/", "a/b/"} {
+				for i := 0; i < 50; i++ {
+					entries = append(entries,
+						metabase.ObjectEntry{ObjectKey: dir + metabase.ObjectKey(fmt.Sprintf("%03d", i)), Version: 1, StreamID: streamID, Status: metabase.CommittedUnversioned},
+						metabase.ObjectEntry{ObjectKey: dir + metabase.ObjectKey(fmt.Sprintf("p%03d", i)), Version: 1, StreamID: streamID, Status: metabase.Pending},
+					)
+				}
+			}
+			// prefixes containing only committed objects are not listed with pending objects.
+			for i := 0; i < 50; i++ {
+				entries = append(entries, metabase.ObjectEntry{
+					ObjectKey: metabase.ObjectKey(fmt.Sprintf("c/%03d", i)),
+					Version:   1,
+					StreamID:  streamID,
+					Status:    metabase.CommittedUnversioned,
+				})
+			}
+
+			check(ctx, t, db, entries, []metabase.ObjectKey{"", "a/"}, true)
+			require.NoError(t, db.TestingDeleteAll(ctx))
+			check(ctx, t, db, entries, []metabase.ObjectKey{"", "a/"}, false)
+		})
+	}, metabasetest.WithSpanner())
+}
+
+func TestListObjects_AsOfSystemTime(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		before := metabasetest.RandObjectStream()
+		metabasetest.CreateObject(ctx, t, db, before, 0)
+
+		// the snapshot must be strictly between the two commits.
+		time.Sleep(10 * time.Millisecond)
+		snapshot := time.Now()
+		time.Sleep(10 * time.Millisecond)
+
+		after := before
+		after.ObjectKey = before.ObjectKey + "-after"
+		after.StreamID = testrand.UUID()
+		metabasetest.CreateObject(ctx, t, db, after, 0)
+
+		opts := metabase.ListObjects{
+			ProjectID:      before.ProjectID,
+			BucketName:     before.BucketName,
+			Limit:          10,
+			AsOfSystemTime: snapshot,
+		}
+
+		for _, opts.Recursive = range []bool{true, false} {
+			result, err := db.ListObjects(ctx, opts)
+			require.NoError(t, err)
+
+			if db.Implementation() == dbutil.Postgres {
+				// Postgres doesn't support reading the past.
+				require.Len(t, result.Objects, 2)
+				continue
+			}
+			require.Len(t, result.Objects, 1)
+			require.Equal(t, before.ObjectKey, result.Objects[0].ObjectKey)
+		}
+
+		opts.AsOfSystemTime = time.Now().Add(time.Hour)
+		_, err := db.ListObjects(ctx, opts)
+		require.True(t, metabase.ErrInvalidRequest.Has(err), err)
+	}, metabasetest.WithSpanner())
+}
@@ -0,0 +1,99 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"storj.io/common/uuid"
+)
+
+// ListParts contains arguments necessary for listing the parts of a multipart upload.
+type ListParts struct {
+	ProjectID uuid.UUID
+	StreamID  uuid.UUID
+
+	// Cursor lists the parts with a number greater than it. Zero lists
+	// all the parts, including part number zero.
+	Cursor uint32
+	Limit  int
+}
+
+// ListPartsResult is the result of listing the parts of a multipart upload.
+type ListPartsResult struct {
+	Parts []Part
+	More  bool
+}
+
+// Part contains the information about an uploaded part, aggregated from its segments.
+type Part struct {
+	Number uint32
+	// Size is the plain size of the part.
+	Size         int64
+	SegmentCount int32
+	// LastIndex is the highest segment index of the part.
+	LastIndex     uint32
+	EncryptedETag []byte
+	// LastModified is the creation time of the most recent segment of the part.
+	LastModified time.Time
+}
+
+// ListParts lists the parts of a stream, which were uploaded so far. It's meant
+// for pending objects, which are still being uploaded; the caller is responsible
+// for verifying that the stream belongs to the multipart upload.
+func (db *DB) ListParts(ctx context.Context, opts ListParts) (result ListPartsResult, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if opts.StreamID.IsZero() {
+		return ListPartsResult{}, ErrInvalidRequest.New("StreamID missing")
+	}
+	if opts.Limit < 0 {
+		return ListPartsResult{}, ErrInvalidRequest.New("Invalid limit: %d", opts.Limit)
+	}
+	ListLimit.Ensure(&opts.Limit)
+
+	var cursor SegmentPosition
+	if opts.Cursor > 0 {
+		cursor = SegmentPosition{Part: opts.Cursor, Index: math.MaxUint32}
+	}
+
+	for {
+		positions, err := db.ListStreamPositions(ctx, ListStreamPositions{
+			ProjectID: opts.ProjectID,
+			StreamID:  opts.StreamID,
+			Cursor:    cursor,
+		})
+		if err != nil {
+			return ListPartsResult{}, err
+		}
+
+		for _, segment := range positions.Segments {
+			if len(result.Parts) == 0 || result.Parts[len(result.Parts)-1].Number != segment.Position.Part {
+				if len(result.Parts) == opts.Limit {
+					result.More = true
+					return result, nil
+				}
+				result.Parts = append(result.Parts, Part{Number: segment.Position.Part})
+			}
+
+			part := &result.Parts[len(result.Parts)-1]
+			part.Size += int64(segment.PlainSize)
+			part.SegmentCount++
+			part.LastIndex = segment.Position.Index
+			if len(segment.EncryptedETag) > 0 {
+				part.EncryptedETag = segment.EncryptedETag
+			}
+			if segment.CreatedAt != nil && segment.CreatedAt.After(part.LastModified) {
+				part.LastModified = *segment.CreatedAt
+			}
+		}
+
+		if !positions.More {
+			return result, nil
+		}
+		cursor = positions.Segments[len(positions.Segments)-1].Position
+	}
+}
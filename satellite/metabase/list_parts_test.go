@@ -0,0 +1,84 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/metabasetest"
+)
+
+func TestListParts(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		t.Run("invalid request", func(t *testing.T) {
+			_, err := db.ListParts(ctx, metabase.ListParts{})
+			require.True(t, metabase.ErrInvalidRequest.Has(err), err)
+
+			_, err = db.ListParts(ctx, metabase.ListParts{StreamID: testrand.UUID(), Limit: -1})
+			require.True(t, metabase.ErrInvalidRequest.Has(err), err)
+		})
+
+		t.Run("no parts", func(t *testing.T) {
+			result, err := db.ListParts(ctx, metabase.ListParts{StreamID: testrand.UUID()})
+			require.NoError(t, err)
+			require.Empty(t, result.Parts)
+			require.False(t, result.More)
+		})
+
+		t.Run("parts", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			obj := metabasetest.RandObjectStream()
+			now := time.Now().Truncate(time.Second)
+
+			var segments []metabase.RawSegment
+			addSegment := func(part, index uint32, etag []byte, createdAt time.Time) {
+				segment := metabasetest.DefaultRawSegment(obj, metabase.SegmentPosition{Part: part, Index: index})
+				segment.EncryptedETag = etag
+				segment.CreatedAt = createdAt
+				segments = append(segments, segment)
+			}
+			addSegment(0, 0, []byte{0}, now)
+			addSegment(1, 0, nil, now)
+			addSegment(1, 1, nil, now.Add(time.Minute))
+			addSegment(1, 2, []byte{1}, now.Add(time.Second))
+			addSegment(5, 0, []byte{5}, now)
+			require.NoError(t, db.TestingBatchInsertSegments(ctx, segments))
+
+			expected := []metabase.Part{
+				{Number: 0, Size: 512, SegmentCount: 1, LastIndex: 0, EncryptedETag: []byte{0}, LastModified: now},
+				{Number: 1, Size: 3 * 512, SegmentCount: 3, LastIndex: 2, EncryptedETag: []byte{1}, LastModified: now.Add(time.Minute)},
+				{Number: 5, Size: 512, SegmentCount: 1, LastIndex: 0, EncryptedETag: []byte{5}, LastModified: now},
+			}
+
+			result, err := db.ListParts(ctx, metabase.ListParts{ProjectID: obj.ProjectID, StreamID: obj.StreamID})
+			require.NoError(t, err)
+			require.False(t, result.More)
+			require.Len(t, result.Parts, len(expected))
+			for i := range expected {
+				require.WithinDuration(t, expected[i].LastModified, result.Parts[i].LastModified, time.Second)
+				result.Parts[i].LastModified = expected[i].LastModified
+			}
+			require.Equal(t, expected, result.Parts)
+
+			result, err = db.ListParts(ctx, metabase.ListParts{ProjectID: obj.ProjectID, StreamID: obj.StreamID, Limit: 2})
+			require.NoError(t, err)
+			require.True(t, result.More)
+			require.Len(t, result.Parts, 2)
+			require.EqualValues(t, 1, result.Parts[1].Number)
+
+			result, err = db.ListParts(ctx, metabase.ListParts{ProjectID: obj.ProjectID, StreamID: obj.StreamID, Cursor: 1})
+			require.NoError(t, err)
+			require.False(t, result.More)
+			require.Len(t, result.Parts, 1)
+			require.EqualValues(t, 5, result.Parts[0].Number)
+		})
+	})
+}
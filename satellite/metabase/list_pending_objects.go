@@ -0,0 +1,209 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	spanner "github.com/storj/exp-spanner"
+	"google.golang.org/api/iterator"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/shared/tagsql"
+)
+
+// ListPendingObjects contains arguments for streaming pending objects,
+// i.e. uploads which were started, but not committed or aborted.
+type ListPendingObjects struct {
+	// ProjectID limits the listing to a single project. All projects are
+	// listed when it is zero.
+	ProjectID uuid.UUID
+	// CreatedBefore limits the listing to uploads started before it. All
+	// pending objects are listed when it is zero.
+	CreatedBefore time.Time
+
+	BatchSize          int
+	AsOfSystemInterval time.Duration
+}
+
+// Verify verifies the request fields.
+func (opts *ListPendingObjects) Verify() error {
+	if opts.BatchSize < 0 {
+		return ErrInvalidRequest.New("BatchSize is negative")
+	}
+	return nil
+}
+
+// PendingObject contains the information about a pending object which is
+// needed to decide whether the upload should be aborted.
+type PendingObject struct {
+	ObjectStream
+
+	CreatedAt              time.Time
+	ExpiresAt              *time.Time
+	ZombieDeletionDeadline *time.Time
+}
+
+// ListPendingObjects streams the pending objects in batches ordered by the
+// primary key. The batches are read without holding a query open, so fn may
+// delete the listed objects.
+func (db *DB) ListPendingObjects(ctx context.Context, opts ListPendingObjects, fn func(context.Context, []PendingObject) error) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := opts.Verify(); err != nil {
+		return err
+	}
+	ListLimit.Ensure(&opts.BatchSize)
+
+	adapters := db.adapters
+	if !opts.ProjectID.IsZero() {
+		adapters = []Adapter{db.ChooseAdapter(opts.ProjectID)}
+	}
+
+	for _, adapter := range adapters {
+		startAfter := ObjectStream{ProjectID: opts.ProjectID}
+		for {
+			objects, err := adapter.ListPendingObjects(ctx, opts, startAfter, opts.BatchSize)
+			if err != nil {
+				return Error.Wrap(err)
+			}
+			if len(objects) == 0 {
+				break
+			}
+
+			if err := fn(ctx, objects); err != nil {
+				return err
+			}
+
+			if len(objects) < opts.BatchSize {
+				break
+			}
+			startAfter = objects[len(objects)-1].ObjectStream
+		}
+	}
+	return nil
+}
+
+// ListPendingObjects returns up to batchSize pending objects after startAfter.
+func (p *PostgresAdapter) ListPendingObjects(ctx context.Context, opts ListPendingObjects, startAfter ObjectStream, batchSize int) (objects []PendingObject, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	const tag = queryTag("list_pending_objects")
+	done := tag.observe(p.impl.String())
+	defer func() { done(int64(len(objects)), err) }()
+
+	var scopeProjectID []byte
+	if !opts.ProjectID.IsZero() {
+		scopeProjectID = opts.ProjectID.Bytes()
+	}
+	var createdBefore *time.Time
+	if !opts.CreatedBefore.IsZero() {
+		createdBefore = &opts.CreatedBefore
+	}
+
+	objects = make([]PendingObject, 0, batchSize)
+	err = withRows(p.db.QueryContext(ctx, `
+		SELECT
+			project_id, bucket_name, object_key, version, stream_id,
+			created_at, expires_at, zombie_deletion_deadline
+		FROM objects
+		`+p.impl.AsOfSystemInterval(opts.AsOfSystemInterval)+`
+		WHERE
+			(project_id, bucket_name, object_key, version) > ($1, $2, $3, $4)
+			AND status = `+statusPending+`
+			AND ($5::BYTEA IS NULL OR project_id = $5::BYTEA)
+			AND ($6::TIMESTAMPTZ IS NULL OR created_at < $6::TIMESTAMPTZ)
+		ORDER BY project_id, bucket_name, object_key, version
+		LIMIT $7
+	`, startAfter.ProjectID, []byte(startAfter.BucketName), []byte(startAfter.ObjectKey), startAfter.Version,
+		scopeProjectID, createdBefore, batchSize,
+	))(func(rows tagsql.Rows) error {
+		for rows.Next() {
+			var object PendingObject
+			err := rows.Scan(
+				&object.ProjectID, &object.BucketName, &object.ObjectKey, &object.Version, &object.StreamID,
+				&object.CreatedAt, &object.ExpiresAt, &object.ZombieDeletionDeadline,
+			)
+			if err != nil {
+				return err
+			}
+			objects = append(objects, object)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, Error.New("unable to list pending objects: %w", err)
+	}
+	return objects, nil
+}
+
+// ListPendingObjects returns up to batchSize pending objects after startAfter.
+func (s *SpannerAdapter) ListPendingObjects(ctx context.Context, opts ListPendingObjects, startAfter ObjectStream, batchSize int) (objects []PendingObject, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	const tag = queryTag("list_pending_objects")
+	done := tag.observe(s.Name())
+	defer func() { done(int64(len(objects)), err) }()
+
+	var scopeProjectID []byte
+	if !opts.ProjectID.IsZero() {
+		scopeProjectID = opts.ProjectID.Bytes()
+	}
+	var createdBefore spanner.NullTime
+	if !opts.CreatedBefore.IsZero() {
+		createdBefore = spanner.NullTime{Time: opts.CreatedBefore, Valid: true}
+	}
+
+	objects = make([]PendingObject, 0, batchSize)
+
+	rowIterator := s.client.Single().Query(ctx, spanner.Statement{SQL: `
+		SELECT
+			project_id, bucket_name, object_key, version, stream_id,
+			created_at, expires_at, zombie_deletion_deadline
+		FROM objects
+		WHERE
+			status = ` + statusPending + `
+			AND (@scope_project_id IS NULL OR project_id = @scope_project_id)
+			AND (@created_before IS NULL OR created_at < @created_before)
+			AND (
+				project_id > @project_id
+				OR (project_id = @project_id AND bucket_name > @bucket_name)
+				OR (project_id = @project_id AND bucket_name = @bucket_name AND object_key > @object_key)
+				OR (project_id = @project_id AND bucket_name = @bucket_name AND object_key = @object_key AND version > @version)
+			)
+		ORDER BY project_id, bucket_name, object_key, version
+		LIMIT @batch_size
+	`, Params: map[string]interface{}{
+		"project_id":       startAfter.ProjectID,
+		"bucket_name":      startAfter.BucketName,
+		"object_key":       startAfter.ObjectKey,
+		"version":          startAfter.Version,
+		"scope_project_id": scopeProjectID,
+		"created_before":   createdBefore,
+		"batch_size":       batchSize,
+	}})
+	defer rowIterator.Stop()
+
+	for {
+		row, err := rowIterator.Next()
+		if err != nil {
+			if errors.Is(err, iterator.Done) {
+				return objects, nil
+			}
+			return nil, Error.New("unable to list pending objects: %w", err)
+		}
+
+		var object PendingObject
+		err = row.Columns(
+			&object.ProjectID, &object.BucketName, &object.ObjectKey, &object.Version, &object.StreamID,
+			&object.CreatedAt, &object.ExpiresAt, &object.ZombieDeletionDeadline,
+		)
+		if err != nil {
+			return nil, Error.New("unable to list pending objects: %w", err)
+		}
+		objects = append(objects, object)
+	}
+}
@@ -0,0 +1,82 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase_test
+
+import (
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/metabasetest"
+)
+
+func TestListPendingObjectsByAge(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		list := func(t *testing.T, opts metabase.ListPendingObjects) (streams []metabase.ObjectStream) {
+			err := db.ListPendingObjects(ctx, opts, func(ctx context.Context, objects []metabase.PendingObject) error {
+				require.LessOrEqual(t, len(objects), opts.BatchSize)
+				for _, object := range objects {
+					require.NotNil(t, object.ZombieDeletionDeadline)
+					streams = append(streams, object.ObjectStream)
+				}
+				return nil
+			})
+			require.NoError(t, err)
+			return streams
+		}
+
+		t.Run("negative batch size", func(t *testing.T) {
+			err := db.ListPendingObjects(ctx, metabase.ListPendingObjects{BatchSize: -1}, func(context.Context, []metabase.PendingObject) error {
+				return nil
+			})
+			require.True(t, metabase.ErrInvalidRequest.Has(err), err)
+		})
+
+		t.Run("only pending", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			var expected []metabase.ObjectStream
+			for i := 0; i < 5; i++ {
+				obj := metabasetest.RandObjectStream()
+				metabasetest.CreatePendingObject(ctx, t, db, obj, 1)
+				expected = append(expected, obj)
+			}
+			metabasetest.CreateObject(ctx, t, db, metabasetest.RandObjectStream(), 1)
+
+			sort.Slice(expected, func(i, k int) bool { return expected[i].LessVersionAsc(expected[k]) })
+
+			require.Equal(t, expected, list(t, metabase.ListPendingObjects{BatchSize: 2}))
+		})
+
+		t.Run("project and age", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			obj := metabasetest.RandObjectStream()
+			metabasetest.CreatePendingObject(ctx, t, db, obj, 0)
+			metabasetest.CreatePendingObject(ctx, t, db, metabasetest.RandObjectStream(), 0)
+
+			require.Equal(t, []metabase.ObjectStream{obj}, list(t, metabase.ListPendingObjects{
+				ProjectID: obj.ProjectID,
+				BatchSize: 10,
+			}))
+
+			require.Empty(t, list(t, metabase.ListPendingObjects{
+				ProjectID:     obj.ProjectID,
+				CreatedBefore: time.Now().Add(-time.Hour),
+				BatchSize:     10,
+			}))
+
+			require.Equal(t, []metabase.ObjectStream{obj}, list(t, metabase.ListPendingObjects{
+				ProjectID:     obj.ProjectID,
+				CreatedBefore: time.Now().Add(time.Hour),
+				BatchSize:     10,
+			}))
+		})
+	})
+}
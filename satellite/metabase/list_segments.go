@@ -53,11 +53,15 @@ func (db *DB) ListSegments(ctx context.Context, opts ListSegments) (result ListS
 		}
 	}
 
-	return db.ChooseAdapter(opts.ProjectID).ListSegments(ctx, opts, db.aliasCache)
+	return db.ChooseReadAdapter(opts.ProjectID, ReadListSegments).ListSegments(ctx, opts, db.aliasCache)
 }
 
 // ListSegments lists specified stream segments.
 func (p *PostgresAdapter) ListSegments(ctx context.Context, opts ListSegments, aliasCache *NodeAliasCache) (result ListSegmentsResult, err error) {
+	const tag = queryTag("list_segments")
+	done := tag.observe(p.impl.String())
+	defer func() { done(int64(len(result.Segments)), err) }()
+
 	var rows tagsql.Rows
 	var rowsErr error
 	if opts.Range == nil {
@@ -136,6 +140,10 @@ func (p *PostgresAdapter) ListSegments(ctx context.Context, opts ListSegments, a
 
 // ListSegments lists specified stream segments.
 func (s *SpannerAdapter) ListSegments(ctx context.Context, opts ListSegments, aliasCache *NodeAliasCache) (result ListSegmentsResult, err error) {
+	const tag = queryTag("list_segments")
+	done := tag.observe(s.Name())
+	defer func() { done(int64(len(result.Segments)), err) }()
+
 	var stmt spanner.Statement
 	if opts.Range == nil {
 		stmt = spanner.Statement{
@@ -184,7 +192,7 @@ func (s *SpannerAdapter) ListSegments(ctx context.Context, opts ListSegments, al
 		}
 	}
 
-	rowIterator := s.client.Single().Query(ctx, stmt)
+	rowIterator := s.singleRead().Query(ctx, stmt)
 	defer rowIterator.Stop()
 
 	for {
@@ -288,6 +296,10 @@ func (db *DB) ListStreamPositions(ctx context.Context, opts ListStreamPositions)
 
 // ListStreamPositions lists specified stream segment positions.
 func (p *PostgresAdapter) ListStreamPositions(ctx context.Context, opts ListStreamPositions) (result ListStreamPositionsResult, err error) {
+	const tag = queryTag("list_stream_positions")
+	done := tag.observe(p.impl.String())
+	defer func() { done(int64(len(result.Segments)), err) }()
+
 	var rows tagsql.Rows
 	var rowsErr error
 	if opts.Range == nil {
@@ -348,6 +360,10 @@ func (p *PostgresAdapter) ListStreamPositions(ctx context.Context, opts ListStre
 
 // ListStreamPositions lists specified stream segment positions.
 func (s *SpannerAdapter) ListStreamPositions(ctx context.Context, opts ListStreamPositions) (result ListStreamPositionsResult, err error) {
+	const tag = queryTag("list_stream_positions")
+	done := tag.observe(s.Name())
+	defer func() { done(int64(len(result.Segments)), err) }()
+
 	var stmt spanner.Statement
 	if opts.Range == nil {
 		stmt = spanner.Statement{
@@ -262,11 +262,15 @@ func (db *DB) IterateLoopSegments(ctx context.Context, opts IterateLoopSegments,
 
 	loopIteratorBatchSizeLimit.Ensure(&opts.BatchSize)
 
-	return db.ChooseAdapter(uuid.UUID{}).IterateLoopSegments(ctx, db.aliasCache, opts, fn)
+	return db.ChooseReadAdapter(uuid.UUID{}, ReadRangedLoop).IterateLoopSegments(ctx, db.aliasCache, opts, fn)
 }
 
 // IterateLoopSegments implements Adapter.
 func (p *PostgresAdapter) IterateLoopSegments(ctx context.Context, aliasCache *NodeAliasCache, opts IterateLoopSegments, fn func(context.Context, LoopSegmentsIterator) error) (err error) {
+	const tag = queryTag("iterate_loop_segments")
+	done := tag.observe(p.impl.String())
+	defer func() { done(-1, err) }()
+
 	it := &postgresLoopSegmentIterator{
 		db:         p,
 		aliasCache: aliasCache,
@@ -515,11 +519,15 @@ func (it *spannerLoopSegmentIterator) doNextQuery(ctx context.Context) (_ *spann
 			"endstreamid": it.cursor.EndStreamID.Bytes(),
 			"batchsize":   it.batchSize,
 		}}
-	return it.db.client.Single().Query(ctx, stmt)
+	return it.db.singleRead().Query(ctx, stmt)
 }
 
 // IterateLoopSegments implements Adapter.
 func (s *SpannerAdapter) IterateLoopSegments(ctx context.Context, aliasCache *NodeAliasCache, opts IterateLoopSegments, fn func(context.Context, LoopSegmentsIterator) error) (err error) {
+	const tag = queryTag("iterate_loop_segments")
+	done := tag.observe(s.Name())
+	defer func() { done(-1, err) }()
+
 	it := &spannerLoopSegmentIterator{
 		db:         s,
 		aliasCache: aliasCache,
@@ -140,6 +140,8 @@ func TestModule(ball *mud.Ball, dbinfo satellitedbtest.SatelliteDatabases, confi
 			ServerSideCopy:         config.ServerSideCopy,
 			ServerSideCopyDisabled: config.ServerSideCopyDisabled,
 
+			DeferSegmentDeletion: config.DeferSegmentDeletion,
+
 			TestingUniqueUnversioned:   true,
 			TestingCommitSegmentMode:   config.TestingCommitSegmentMode,
 			TestingPrecommitDeleteMode: config.TestingPrecommitDeleteMode,
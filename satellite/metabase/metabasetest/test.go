@@ -265,6 +265,22 @@ func (step GetObjectLastCommitted) Check(ctx *testcontext.Context, t testing.TB,
 	require.Zero(t, diff)
 }
 
+// GetObjectsLastCommitted is for testing metabase.GetObjectsLastCommitted.
+type GetObjectsLastCommitted struct {
+	Opts     metabase.GetObjectsLastCommitted
+	Result   []metabase.LastCommittedObject
+	ErrClass *errs.Class
+	ErrText  string
+}
+
+// Check runs the test.
+func (step GetObjectsLastCommitted) Check(ctx *testcontext.Context, t testing.TB, db *metabase.DB) {
+	result, err := db.GetObjectsLastCommitted(ctx, step.Opts)
+	checkError(t, err, step.ErrClass, step.ErrText)
+	diff := cmp.Diff(step.Result, result, DefaultTimeDiff(), cmpopts.EquateEmpty())
+	require.Zero(t, diff)
+}
+
 // GetSegmentByPosition is for testing metabase.GetSegmentByPosition.
 type GetSegmentByPosition struct {
 	Opts     metabase.GetSegmentByPosition
@@ -508,6 +524,8 @@ func (step DeleteObjectsAllVersions) Check(ctx *testcontext.Context, t testing.T
 // DeleteExpiredObjects is for testing metabase.DeleteExpiredObjects.
 type DeleteExpiredObjects struct {
 	Opts metabase.DeleteExpiredObjects
+	// Result is checked when it is set.
+	Result *metabase.DeleteExpiredObjectsResult
 
 	ErrClass *errs.Class
 	ErrText  string
@@ -515,8 +533,11 @@ type DeleteExpiredObjects struct {
 
 // Check runs the test.
 func (step DeleteExpiredObjects) Check(ctx *testcontext.Context, t testing.TB, db *metabase.DB) {
-	err := db.DeleteExpiredObjects(ctx, step.Opts)
+	result, err := db.DeleteExpiredObjects(ctx, step.Opts)
 	checkError(t, err, step.ErrClass, step.ErrText)
+	if step.Result != nil {
+		require.Equal(t, *step.Result, result)
+	}
 }
 
 // DeleteZombieObjects is for testing metabase.DeleteZombieObjects.
@@ -64,6 +64,10 @@ func (db *DB) UpdateObjectLastCommittedMetadata(ctx context.Context, opts Update
 func (p *PostgresAdapter) UpdateObjectLastCommittedMetadata(ctx context.Context, opts UpdateObjectLastCommittedMetadata) (affected int64, err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	const tag = queryTag("update_object_last_committed_metadata")
+	done := tag.observe(p.impl.String())
+	defer func() { done(affected, err) }()
+
 	// TODO So the issue is that during a multipart upload of an object,
 	// uplink can update object metadata. If we add the arguments EncryptedMetadata
 	// to CommitObject, they will need to account for them being optional.
@@ -100,6 +104,10 @@ func (p *PostgresAdapter) UpdateObjectLastCommittedMetadata(ctx context.Context,
 
 // UpdateObjectLastCommittedMetadata updates an object metadata.
 func (s *SpannerAdapter) UpdateObjectLastCommittedMetadata(ctx context.Context, opts UpdateObjectLastCommittedMetadata) (affected int64, err error) {
+	const tag = queryTag("update_object_last_committed_metadata")
+	done := tag.observe(s.Name())
+	defer func() { done(affected, err) }()
+
 	// TODO implement me
 	panic("implement me")
 }
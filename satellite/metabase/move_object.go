@@ -15,12 +15,24 @@ import (
 	"storj.io/common/storj"
 	"storj.io/common/uuid"
 	"storj.io/storj/shared/dbutil/pgutil"
+	"storj.io/storj/shared/dbutil/spannerutil"
 	"storj.io/storj/shared/tagsql"
 )
 
 type moveObjectTransactionAdapter interface {
-	objectMove(ctx context.Context, opts FinishMoveObject, newStatus ObjectStatus, nextVersion Version) (oldStatus ObjectStatus, segmentsCount int, hasMetadata bool, streamID uuid.UUID, err error)
+	objectMove(ctx context.Context, opts FinishMoveObject, newStatus ObjectStatus, nextVersion Version) (moved movedObject, err error)
 	objectMoveEncryption(ctx context.Context, opts FinishMoveObject, positions []int64, encryptedKeys [][]byte, encryptedKeyNonces [][]byte) (numAffected int64, err error)
+	countSegmentsOutsidePlacement(ctx context.Context, streamID uuid.UUID, placement storj.PlacementConstraint) (count int64, err error)
+}
+
+// movedObject is the state of an object version before it was moved.
+type movedObject struct {
+	StreamID      uuid.UUID
+	Status        ObjectStatus
+	SegmentCount  int
+	HasMetadata   bool
+	RetentionMode int
+	RetainUntil   *time.Time
 }
 
 // BeginMoveObjectResult holds data needed to begin move object.
@@ -117,6 +129,10 @@ func (db *DB) beginMoveCopyObject(ctx context.Context, location ObjectLocation,
 // GetSegmentPositionsAndKeys fetches the Position, EncryptedKeyNonce, and EncryptedKey for all
 // segments in the db for the given stream ID, ordered by position.
 func (p *PostgresAdapter) GetSegmentPositionsAndKeys(ctx context.Context, streamID uuid.UUID) (keysNonces []EncryptedKeyAndNonce, err error) {
+	const tag = queryTag("get_segment_positions_and_keys")
+	done := tag.observe(p.impl.String())
+	defer func() { done(int64(len(keysNonces)), err) }()
+
 	err = withRows(p.db.QueryContext(ctx, `
 		SELECT
 			position, encrypted_key_nonce, encrypted_key
@@ -145,6 +161,10 @@ func (p *PostgresAdapter) GetSegmentPositionsAndKeys(ctx context.Context, stream
 // GetSegmentPositionsAndKeys fetches the Position, EncryptedKeyNonce, and EncryptedKey for all
 // segments in the db for the given stream ID, ordered by position.
 func (s *SpannerAdapter) GetSegmentPositionsAndKeys(ctx context.Context, streamID uuid.UUID) (keysNonces []EncryptedKeyAndNonce, err error) {
+	const tag = queryTag("get_segment_positions_and_keys")
+	done := tag.observe(s.Name())
+	defer func() { done(int64(len(keysNonces)), err) }()
+
 	result := s.client.Single().Query(ctx, spanner.Statement{
 		SQL: `
 			SELECT
@@ -194,6 +214,13 @@ type FinishMoveObject struct {
 
 	// NewVersioned indicates that the object allows multiple versions.
 	NewVersioned bool
+
+	// NewPlacement is the placement of the target bucket. When the object is
+	// moved to another bucket, all of its segments must have this placement.
+	NewPlacement storj.PlacementConstraint
+	// NewObjectLockEnabled indicates that the target bucket has Object Lock
+	// enabled, so the object can only be moved into it as a new version.
+	NewObjectLockEnabled bool
 }
 
 // NewLocation returns the new object location.
@@ -216,12 +243,18 @@ func (finishMove FinishMoveObject) Verify() error {
 		return ErrInvalidRequest.New("NewBucket is missing")
 	case len(finishMove.NewEncryptedObjectKey) == 0:
 		return ErrInvalidRequest.New("NewEncryptedObjectKey is missing")
+	case finishMove.NewObjectLockEnabled && !finishMove.NewVersioned:
+		return ErrMethodNotAllowed.New("objects can only be moved into a bucket with Object Lock enabled as new versions")
 	}
 
 	return nil
 }
 
 // FinishMoveObject accepts new encryption keys for moved object and updates the corresponding object ObjectKey and segments EncryptedKey.
+//
+// The object may be moved to another bucket of the same project. The placement
+// of the target bucket is verified within the same transaction, so the object
+// can't end up in a bucket with a different placement than its segments.
 func (db *DB) FinishMoveObject(ctx context.Context, opts FinishMoveObject) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
@@ -230,7 +263,7 @@ func (db *DB) FinishMoveObject(ctx context.Context, opts FinishMoveObject) (err
 	}
 
 	var precommit PrecommitConstraintResult
-	err = db.ChooseAdapter(opts.ProjectID).WithTx(ctx, func(ctx context.Context, adapter TransactionAdapter) error {
+	err = db.ChooseAdapter(opts.ProjectID).withTxStats(ctx, "finish_move_object", func(ctx context.Context, adapter TransactionAdapter) error {
 		precommit, err = db.PrecommitConstraint(ctx, PrecommitConstraint{
 			Location:       opts.NewLocation(),
 			Versioned:      opts.NewVersioned,
@@ -240,24 +273,45 @@ func (db *DB) FinishMoveObject(ctx context.Context, opts FinishMoveObject) (err
 			return err
 		}
 
+		pinned, err := adapter.pinnedObjectVersions(ctx, opts.ProjectID, opts.BucketName, [][]byte{[]byte(opts.ObjectKey)})
+		if err != nil {
+			return err
+		}
+		if err := pinnedObjectError(pinned, func(object Object) bool { return object.Version == opts.Version }); err != nil {
+			return err
+		}
+
 		newStatus := committedWhereVersioned(opts.NewVersioned)
 		nextVersion := precommit.HighestVersion + 1
 
-		oldStatus, segmentsCount, hasMetadata, streamID, err := adapter.objectMove(ctx, opts, newStatus, nextVersion)
+		moved, err := adapter.objectMove(ctx, opts, newStatus, nextVersion)
 		if err != nil {
 			// purposefully not wrapping the error here, so as not to break expected error text in tests
 			return err
 		}
-		if streamID != opts.StreamID {
+		if moved.StreamID != opts.StreamID {
 			return ErrObjectNotFound.New("object was changed during move")
 		}
-		if segmentsCount != len(opts.NewSegmentKeys) {
+		if moved.SegmentCount != len(opts.NewSegmentKeys) {
 			return ErrInvalidRequest.New("wrong number of segments keys received")
 		}
-		if oldStatus.IsDeleteMarker() {
+		if moved.Status.IsDeleteMarker() {
 			return ErrMethodNotAllowed.New("moving delete marker is not allowed")
 		}
-		if hasMetadata {
+		// moving removes the version from its original location.
+		if retentionActive(moved.RetentionMode, moved.RetainUntil, time.Now()) {
+			return ErrObjectLock.New("the object is retained until %s", moved.RetainUntil.Format(time.RFC3339))
+		}
+		if opts.NewBucket != opts.BucketName {
+			outside, err := adapter.countSegmentsOutsidePlacement(ctx, opts.StreamID, opts.NewPlacement)
+			if err != nil {
+				return err
+			}
+			if outside > 0 {
+				return ErrInvalidRequest.New("moving object to bucket with different placement policy is not supported")
+			}
+		}
+		if moved.HasMetadata {
 			switch {
 			case opts.NewEncryptedMetadataKeyNonce.IsZero() && len(opts.NewEncryptedMetadataKey) != 0:
 				return ErrInvalidRequest.New("EncryptedMetadataKeyNonce is missing")
@@ -298,7 +352,7 @@ func (db *DB) FinishMoveObject(ctx context.Context, opts FinishMoveObject) (err
 	return nil
 }
 
-func (ptx *postgresTransactionAdapter) objectMove(ctx context.Context, opts FinishMoveObject, newStatus ObjectStatus, nextVersion Version) (oldStatus ObjectStatus, segmentsCount int, hasMetadata bool, streamID uuid.UUID, err error) {
+func (ptx *postgresTransactionAdapter) objectMove(ctx context.Context, opts FinishMoveObject, newStatus ObjectStatus, nextVersion Version) (moved movedObject, err error) {
 	err = ptx.tx.QueryRowContext(ctx, `
 			UPDATE objects SET
 				bucket_name = $1,
@@ -325,22 +379,24 @@ func (ptx *postgresTransactionAdapter) objectMove(ctx context.Context, opts Fini
 				),
 				segment_count,
 				objects.encrypted_metadata IS NOT NULL AND LENGTH(objects.encrypted_metadata) > 0 AS has_metadata,
-				stream_id
+				stream_id,
+				retention_mode, retain_until
 		`, []byte(opts.NewBucket), opts.NewEncryptedObjectKey, opts.NewEncryptedMetadataKey,
 		opts.NewEncryptedMetadataKeyNonce, opts.ProjectID, []byte(opts.BucketName),
 		opts.ObjectKey, opts.Version, newStatus, nextVersion).
-		Scan(&oldStatus, &segmentsCount, &hasMetadata, &streamID)
+		Scan(&moved.Status, &moved.SegmentCount, &moved.HasMetadata, &moved.StreamID,
+			&moved.RetentionMode, &moved.RetainUntil)
 
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return 0, 0, false, uuid.UUID{}, ErrObjectNotFound.New("object not found")
+			return movedObject{}, ErrObjectNotFound.New("object not found")
 		}
-		return 0, 0, false, uuid.UUID{}, Error.New("unable to update object: %w", err)
+		return movedObject{}, Error.New("unable to update object: %w", err)
 	}
-	return oldStatus, segmentsCount, hasMetadata, streamID, nil
+	return moved, nil
 }
 
-func (stx *spannerTransactionAdapter) objectMove(ctx context.Context, opts FinishMoveObject, newStatus ObjectStatus, nextVersion Version) (oldStatus ObjectStatus, segmentsCount int, hasMetadata bool, streamID uuid.UUID, err error) {
+func (stx *spannerTransactionAdapter) objectMove(ctx context.Context, opts FinishMoveObject, newStatus ObjectStatus, nextVersion Version) (moved movedObject, err error) {
 	// We cannot UPDATE the object record in place, because some of the columns we need to update are
 	// part of the primary key. We must DELETE and INSERT instead.
 
@@ -356,7 +412,8 @@ func (stx *spannerTransactionAdapter) objectMove(ctx context.Context, opts Finis
 				encrypted_metadata_nonce, encrypted_metadata, encrypted_metadata_encrypted_key,
 				total_plain_size, total_encrypted_size, fixed_segment_size,
 				encryption,
-				zombie_deletion_deadline
+				zombie_deletion_deadline,
+				retention_mode, retain_until
 		`,
 		Params: map[string]interface{}{
 			"project_id":  opts.ProjectID,
@@ -370,9 +427,9 @@ func (stx *spannerTransactionAdapter) objectMove(ctx context.Context, opts Finis
 	row, err := result.Next()
 	if err != nil {
 		if errors.Is(err, iterator.Done) {
-			return 0, 0, false, uuid.UUID{}, ErrObjectNotFound.New("object not found")
+			return movedObject{}, ErrObjectNotFound.New("object not found")
 		}
-		return 0, 0, false, uuid.UUID{}, Error.New("unable to remove old object record: %w", err)
+		return movedObject{}, Error.New("unable to remove old object record: %w", err)
 	}
 
 	var (
@@ -389,16 +446,18 @@ func (stx *spannerTransactionAdapter) objectMove(ctx context.Context, opts Finis
 		zombieDeletionDeadline        *time.Time
 	)
 	err = row.Columns(
-		&streamID, &createdAt, &expiresAt, &oldStatus, &segmentCount,
+		&moved.StreamID, &createdAt, &expiresAt, &moved.Status, &segmentCount,
 		&encryptedMetadataNonce, &encryptedMetadata, &encryptedMetadataEncryptedKey,
 		&totalPlainSize, &totalEncryptedSize, &fixedSegmentSize,
 		encryptionParameters{&encryption},
 		&zombieDeletionDeadline,
+		spannerutil.Int(&moved.RetentionMode), &moved.RetainUntil,
 	)
 	if err != nil {
-		return 0, 0, false, uuid.UUID{}, Error.New("unable to read old object record: %w", err)
+		return movedObject{}, Error.New("unable to read old object record: %w", err)
 	}
-	segmentsCount = int(segmentCount)
+	moved.SegmentCount = int(segmentCount)
+	moved.HasMetadata = len(encryptedMetadata) > 0
 
 	if encryptedMetadata != nil {
 		encryptedMetadataEncryptedKey = opts.NewEncryptedMetadataKey
@@ -413,14 +472,16 @@ func (stx *spannerTransactionAdapter) objectMove(ctx context.Context, opts Finis
 			    encrypted_metadata_nonce, encrypted_metadata, encrypted_metadata_encrypted_key,
 				total_plain_size, total_encrypted_size, fixed_segment_size,
 				encryption,
-				zombie_deletion_deadline
+				zombie_deletion_deadline,
+				retention_mode, retain_until
 			) VALUES (
 			    @project_id, @bucket_name, @object_key, @version,
 				@stream_id, @created_at, @expires_at, @status, @segment_count,
 			    @encrypted_metadata_nonce, @encrypted_metadata, @encrypted_metadata_encrypted_key,
 				@total_plain_size, @total_encrypted_size, @fixed_segment_size,
 				@encryption,
-				@zombie_deletion_deadline
+				@zombie_deletion_deadline,
+				@retention_mode, @retain_until
 			)
 		`,
 		Params: map[string]interface{}{
@@ -428,11 +489,11 @@ func (stx *spannerTransactionAdapter) objectMove(ctx context.Context, opts Finis
 			"bucket_name":                      opts.NewBucket,
 			"object_key":                       opts.NewEncryptedObjectKey,
 			"version":                          nextVersion,
-			"stream_id":                        streamID,
+			"stream_id":                        moved.StreamID,
 			"created_at":                       createdAt,
 			"expires_at":                       expiresAt,
 			"status":                           newStatus,
-			"segment_count":                    segmentsCount,
+			"segment_count":                    moved.SegmentCount,
 			"encrypted_metadata_nonce":         encryptedMetadataNonce,
 			"encrypted_metadata":               encryptedMetadata,
 			"encrypted_metadata_encrypted_key": encryptedMetadataEncryptedKey,
@@ -441,13 +502,15 @@ func (stx *spannerTransactionAdapter) objectMove(ctx context.Context, opts Finis
 			"fixed_segment_size":               fixedSegmentSize,
 			"encryption":                       encryptionParameters{&encryption},
 			"zombie_deletion_deadline":         zombieDeletionDeadline,
+			"retention_mode":                   int64(moved.RetentionMode),
+			"retain_until":                     moved.RetainUntil,
 		},
 	})
 	if err != nil {
-		return 0, 0, false, uuid.UUID{}, Error.New("unable to create new object record: %w", err)
+		return movedObject{}, Error.New("unable to create new object record: %w", err)
 	}
 
-	return oldStatus, segmentsCount, len(encryptedMetadata) > 0, streamID, nil
+	return moved, nil
 }
 
 func (ptx *postgresTransactionAdapter) objectMoveEncryption(ctx context.Context, opts FinishMoveObject, positions []int64, encryptedKeys [][]byte, encryptedKeyNonces [][]byte) (numAffected int64, err error) {
@@ -504,3 +567,35 @@ func (stx *spannerTransactionAdapter) objectMoveEncryption(ctx context.Context,
 	}
 	return totalFound, nil
 }
+
+func (ptx *postgresTransactionAdapter) countSegmentsOutsidePlacement(ctx context.Context, streamID uuid.UUID, placement storj.PlacementConstraint) (count int64, err error) {
+	err = ptx.tx.QueryRowContext(ctx, `
+		SELECT count(*)
+		FROM segments
+		WHERE stream_id = $1 AND COALESCE(placement, 0) <> $2
+	`, streamID, placement).Scan(&count)
+	if err != nil {
+		return 0, Error.New("unable to check segments placement: %w", err)
+	}
+	return count, nil
+}
+
+func (stx *spannerTransactionAdapter) countSegmentsOutsidePlacement(ctx context.Context, streamID uuid.UUID, placement storj.PlacementConstraint) (count int64, err error) {
+	err = stx.tx.Query(ctx, spanner.Statement{
+		SQL: `
+			SELECT count(*)
+			FROM segments
+			WHERE stream_id = @stream_id AND COALESCE(placement, 0) <> @placement
+		`,
+		Params: map[string]interface{}{
+			"stream_id": streamID,
+			"placement": int64(placement),
+		},
+	}).Do(func(row *spanner.Row) error {
+		return row.Columns(&count)
+	})
+	if err != nil {
+		return 0, Error.New("unable to check segments placement: %w", err)
+	}
+	return count, nil
+}
@@ -7,11 +7,14 @@ import (
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/require"
+
 	"storj.io/common/storj"
 	"storj.io/common/testcontext"
 	"storj.io/common/testrand"
 	"storj.io/storj/satellite/metabase"
 	"storj.io/storj/satellite/metabase/metabasetest"
+	"storj.io/storj/shared/dbutil"
 )
 
 func TestBeginMoveObject(t *testing.T) {
@@ -836,3 +839,107 @@ func TestFinishMoveObject(t *testing.T) {
 		})
 	}, metabasetest.WithSpanner())
 }
+
+func TestFinishMoveObject_OtherBucket(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		newBucketName := "other bucket"
+
+		moveOpts := func(object metabase.Object, segments []metabase.Segment) metabase.FinishMoveObject {
+			keys := make([]metabase.EncryptedKeyAndNonce, len(segments))
+			for i, segment := range segments {
+				keys[i] = metabase.EncryptedKeyAndNonce{
+					Position:          segment.Position,
+					EncryptedKeyNonce: segment.EncryptedKeyNonce,
+					EncryptedKey:      segment.EncryptedKey,
+				}
+			}
+			return metabase.FinishMoveObject{
+				ObjectStream:          object.ObjectStream,
+				NewBucket:             newBucketName,
+				NewEncryptedObjectKey: object.ObjectKey,
+				NewSegmentKeys:        keys,
+			}
+		}
+
+		t.Run("different placement", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			obj := metabasetest.RandObjectStream()
+			object, segments := metabasetest.CreateTestObject{}.Run(ctx, t, db, obj, 2)
+
+			opts := moveOpts(object, segments)
+			opts.NewPlacement = storj.EU
+			metabasetest.FinishMoveObject{
+				Opts:     opts,
+				ErrClass: &metabase.ErrInvalidRequest,
+				ErrText:  "moving object to bucket with different placement policy is not supported",
+			}.Check(ctx, t, db)
+
+			metabasetest.Verify{
+				Objects:  []metabase.RawObject{metabase.RawObject(object)},
+				Segments: metabasetest.SegmentsToRaw(segments),
+			}.Check(ctx, t, db)
+		})
+
+		t.Run("object lock requires versioned move", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			obj := metabasetest.RandObjectStream()
+			object, segments := metabasetest.CreateTestObject{}.Run(ctx, t, db, obj, 1)
+
+			opts := moveOpts(object, segments)
+			opts.NewObjectLockEnabled = true
+			metabasetest.FinishMoveObject{
+				Opts:     opts,
+				ErrClass: &metabase.ErrMethodNotAllowed,
+				ErrText:  "objects can only be moved into a bucket with Object Lock enabled as new versions",
+			}.Check(ctx, t, db)
+
+			opts.NewVersioned = true
+			metabasetest.FinishMoveObject{Opts: opts}.Check(ctx, t, db)
+
+			object.BucketName = newBucketName
+			object.Version = 1
+			object.Status = metabase.CommittedVersioned
+			metabasetest.Verify{
+				Objects:  []metabase.RawObject{metabase.RawObject(object)},
+				Segments: metabasetest.SegmentsToRaw(segments),
+			}.Check(ctx, t, db)
+		})
+
+		if db.Implementation() == dbutil.Spanner {
+			// retention can't be set in tests on Spanner yet.
+			return
+		}
+
+		t.Run("retained object", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			obj := metabasetest.RandObjectStream()
+			object, segments := metabasetest.CreateTestObject{}.Run(ctx, t, db, obj, 1)
+
+			_, err := db.UnderlyingTagSQL().ExecContext(ctx, `
+				UPDATE objects SET retention_mode = 1, retain_until = $2
+				WHERE stream_id = $1
+			`, obj.StreamID, time.Now().Add(time.Hour))
+			require.NoError(t, err)
+
+			metabasetest.FinishMoveObject{
+				Opts:     moveOpts(object, segments),
+				ErrClass: &metabase.ErrObjectLock,
+			}.Check(ctx, t, db)
+
+			// the move is allowed once the retention has ended.
+			_, err = db.UnderlyingTagSQL().ExecContext(ctx, `
+				UPDATE objects SET retain_until = $2
+				WHERE stream_id = $1
+			`, obj.StreamID, time.Now().Add(-time.Hour))
+			require.NoError(t, err)
+
+			metabasetest.FinishMoveObject{Opts: moveOpts(object, segments)}.Check(ctx, t, db)
+
+			_, err = db.UnderlyingTagSQL().ExecContext(ctx, `UPDATE objects SET retention_mode = 0, retain_until = NULL`)
+			require.NoError(t, err)
+		})
+	}, metabasetest.WithSpanner())
+}
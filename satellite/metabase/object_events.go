@@ -0,0 +1,211 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/storj/private/migrate"
+	"storj.io/storj/shared/dbutil"
+	"storj.io/storj/shared/dbutil/pgutil"
+	"storj.io/storj/shared/tagsql"
+)
+
+// ObjectEventType is the kind of change of an object.
+type ObjectEventType int
+
+const (
+	// ObjectCreated is emitted when an object or a delete marker is committed.
+	ObjectCreated ObjectEventType = 1
+	// ObjectDeleted is emitted when a committed object or a delete marker is removed.
+	ObjectDeleted ObjectEventType = 2
+)
+
+// String returns the name of the event type.
+func (typ ObjectEventType) String() string {
+	switch typ {
+	case ObjectCreated:
+		return "created"
+	case ObjectDeleted:
+		return "deleted"
+	default:
+		return "unknown"
+	}
+}
+
+// ObjectEvent is a change of an object captured in the object_events outbox table.
+type ObjectEvent struct {
+	ID   int64
+	Type ObjectEventType
+
+	ObjectStream
+	Status    ObjectStatus
+	CreatedAt time.Time
+}
+
+// ListObjectEvents contains arguments for listing object events.
+type ListObjectEvents struct {
+	// After is the ID of the last event which was already processed.
+	After int64
+	Limit int
+}
+
+// objectEventsMigration creates the object_events outbox table. On Postgres the table is
+// filled by a trigger on the objects table, which is disabled until EnableObjectEvents is
+// called. CockroachDB and Spanner have their own change data capture.
+func objectEventsMigration(impl dbutil.Implementation) migrate.Action {
+	return migrate.Func(func(ctx context.Context, log *zap.Logger, db tagsql.DB, tx tagsql.Tx) error {
+		_, err := tx.ExecContext(ctx, `
+			CREATE TABLE object_events (
+				id          BIGSERIAL NOT NULL,
+				event       INT2  NOT NULL,
+				project_id  BYTEA NOT NULL,
+				bucket_name BYTEA NOT NULL,
+				object_key  BYTEA NOT NULL,
+				version     INT8  NOT NULL,
+				stream_id   BYTEA NOT NULL,
+				status      INT2  NOT NULL,
+				created_at  TIMESTAMPTZ NOT NULL default now(),
+
+				PRIMARY KEY (id)
+			);
+
+			COMMENT ON TABLE  object_events       is 'object_events table is an outbox of object changes, which are published to downstream consumers and deleted afterwards.';
+			COMMENT ON COLUMN object_events.event is 'event is the kind of the change. See metabase.ObjectEventType for the values.';
+		`)
+		if err != nil || impl != dbutil.Postgres {
+			return err
+		}
+
+		_, err = tx.ExecContext(ctx, `
+			CREATE OR REPLACE FUNCTION emit_object_event() RETURNS trigger AS $$
+			BEGIN
+				IF TG_OP IN ('UPDATE', 'DELETE') AND OLD.status <> `+statusPending+` AND (
+					TG_OP = 'DELETE' OR
+					(OLD.project_id, OLD.bucket_name, OLD.object_key, OLD.version) IS DISTINCT FROM
+					(NEW.project_id, NEW.bucket_name, NEW.object_key, NEW.version)
+				) THEN
+					INSERT INTO object_events (event, project_id, bucket_name, object_key, version, stream_id, status)
+					VALUES (2, OLD.project_id, OLD.bucket_name, OLD.object_key, OLD.version, OLD.stream_id, OLD.status);
+				END IF;
+
+				IF TG_OP IN ('INSERT', 'UPDATE') AND NEW.status <> `+statusPending+` AND (
+					TG_OP = 'INSERT' OR OLD.status = `+statusPending+` OR
+					(OLD.project_id, OLD.bucket_name, OLD.object_key, OLD.version) IS DISTINCT FROM
+					(NEW.project_id, NEW.bucket_name, NEW.object_key, NEW.version)
+				) THEN
+					INSERT INTO object_events (event, project_id, bucket_name, object_key, version, stream_id, status)
+					VALUES (1, NEW.project_id, NEW.bucket_name, NEW.object_key, NEW.version, NEW.stream_id, NEW.status);
+				END IF;
+
+				RETURN NULL;
+			END;
+			$$ LANGUAGE plpgsql;
+
+			CREATE TRIGGER objects_emit_events
+				AFTER INSERT OR UPDATE OR DELETE ON objects
+				FOR EACH ROW EXECUTE PROCEDURE emit_object_event();
+
+			ALTER TABLE objects DISABLE TRIGGER objects_emit_events;
+		`)
+		return err
+	})
+}
+
+// EnableObjectEvents enables or disables capturing object changes in the object_events table.
+// It's only supported on Postgres.
+//
+// Altering the trigger takes an ACCESS EXCLUSIVE lock on the objects table, hence the
+// trigger is only altered when its state differs.
+func (db *DB) EnableObjectEvents(ctx context.Context, enabled bool) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	current, err := db.ObjectEventsEnabled(ctx)
+	if err != nil {
+		return err
+	}
+	if current == enabled {
+		return nil
+	}
+
+	action := "DISABLE"
+	if enabled {
+		action = "ENABLE"
+	}
+	_, err = db.db.ExecContext(ctx, `ALTER TABLE objects `+action+` TRIGGER objects_emit_events`)
+	return Error.Wrap(err)
+}
+
+// ObjectEventsEnabled returns whether object changes are captured in the object_events table.
+// It's only supported on Postgres.
+func (db *DB) ObjectEventsEnabled(ctx context.Context) (enabled bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if db.impl != dbutil.Postgres {
+		return false, Error.New("object events are not supported on %s", db.impl)
+	}
+
+	err = db.db.QueryRowContext(ctx, `
+		SELECT tgenabled <> 'D'
+		FROM pg_trigger
+		WHERE tgrelid = 'objects'::regclass AND tgname = 'objects_emit_events'
+	`).Scan(&enabled)
+	return enabled, Error.Wrap(err)
+}
+
+// ListObjectEvents returns the captured object events in the order they happened.
+func (db *DB) ListObjectEvents(ctx context.Context, opts ListObjectEvents) (events []ObjectEvent, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	ListLimit.Ensure(&opts.Limit)
+
+	err = withRows(db.db.QueryContext(ctx, `
+		SELECT
+			id, event,
+			project_id, bucket_name, object_key, version, stream_id,
+			status, created_at
+		FROM object_events
+		WHERE id > $1
+		ORDER BY id
+		LIMIT $2
+	`, opts.After, opts.Limit))(func(rows tagsql.Rows) error {
+		for rows.Next() {
+			var event ObjectEvent
+			err := rows.Scan(
+				&event.ID, &event.Type,
+				&event.ProjectID, &event.BucketName, &event.ObjectKey, &event.Version, &event.StreamID,
+				&event.Status, &event.CreatedAt,
+			)
+			if err != nil {
+				return err
+			}
+			events = append(events, event)
+		}
+		return nil
+	})
+	return events, Error.Wrap(err)
+}
+
+// DeleteObjectEvents deletes the specified events, after they have been published.
+//
+// Event IDs are assigned when the change happens, but the event becomes visible only
+// when its transaction commits, so an event with a lower ID may appear after a later
+// one has been listed. Hence only the listed events are deleted, and not a range of IDs.
+func (db *DB) DeleteObjectEvents(ctx context.Context, ids []int64) (deleted int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(ids) == 0 {
+		return 0, nil
+	}
+
+	result, err := db.db.ExecContext(ctx, `DELETE FROM object_events WHERE id = ANY($1::INT8[])`, pgutil.Int8Array(ids))
+	if err != nil {
+		return 0, Error.Wrap(err)
+	}
+	deleted, err = result.RowsAffected()
+	return deleted, Error.Wrap(err)
+}
@@ -0,0 +1,94 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/metabasetest"
+	"storj.io/storj/shared/dbutil"
+)
+
+func TestObjectEvents(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		if db.Implementation() != dbutil.Postgres {
+			require.Error(t, db.EnableObjectEvents(ctx, true))
+			t.Skip("object events are only captured on postgres")
+		}
+
+		defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+		// changes before enabling are not captured.
+		metabasetest.CreateObject(ctx, t, db, metabasetest.RandObjectStream(), 0)
+
+		require.NoError(t, db.EnableObjectEvents(ctx, true))
+		defer func() { require.NoError(t, db.EnableObjectEvents(ctx, false)) }()
+
+		// enabling again doesn't alter the trigger.
+		require.NoError(t, db.EnableObjectEvents(ctx, true))
+		enabled, err := db.ObjectEventsEnabled(ctx)
+		require.NoError(t, err)
+		require.True(t, enabled)
+
+		obj := metabasetest.RandObjectStream()
+		metabasetest.BeginObjectExactVersion{
+			Opts: metabase.BeginObjectExactVersion{
+				ObjectStream: obj,
+				Encryption:   metabasetest.DefaultEncryption,
+			},
+		}.Check(ctx, t, db)
+
+		// pending objects are not captured.
+		events, err := db.ListObjectEvents(ctx, metabase.ListObjectEvents{})
+		require.NoError(t, err)
+		require.Empty(t, events)
+
+		metabasetest.CommitObject{
+			Opts: metabase.CommitObject{ObjectStream: obj},
+		}.Check(ctx, t, db)
+
+		_, err = db.DeleteObjectExactVersion(ctx, metabase.DeleteObjectExactVersion{
+			ObjectLocation: obj.Location(),
+			Version:        obj.Version,
+		})
+		require.NoError(t, err)
+
+		events, err = db.ListObjectEvents(ctx, metabase.ListObjectEvents{})
+		require.NoError(t, err)
+		require.Len(t, events, 2)
+		require.Equal(t, metabase.ObjectCreated, events[0].Type)
+		require.Equal(t, metabase.ObjectDeleted, events[1].Type)
+		for _, event := range events {
+			require.Equal(t, obj, event.ObjectStream)
+			require.Equal(t, metabase.CommittedUnversioned, event.Status)
+		}
+
+		events, err = db.ListObjectEvents(ctx, metabase.ListObjectEvents{After: events[0].ID})
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+
+		// only the specified events are deleted, an event with a lower ID may
+		// not have been published yet.
+		deleted, err := db.DeleteObjectEvents(ctx, []int64{events[0].ID})
+		require.NoError(t, err)
+		require.EqualValues(t, 1, deleted)
+
+		events, err = db.ListObjectEvents(ctx, metabase.ListObjectEvents{})
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+		require.Equal(t, metabase.ObjectCreated, events[0].Type)
+
+		deleted, err = db.DeleteObjectEvents(ctx, []int64{events[0].ID})
+		require.NoError(t, err)
+		require.EqualValues(t, 1, deleted)
+
+		events, err = db.ListObjectEvents(ctx, metabase.ListObjectEvents{})
+		require.NoError(t, err)
+		require.Empty(t, events)
+	})
+}
@@ -0,0 +1,266 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/storj/exp-spanner"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/shared/tagsql"
+)
+
+// GetObjectLockReport contains arguments for reporting the object versions
+// protected from deletion in a project.
+type GetObjectLockReport struct {
+	ProjectID uuid.UUID
+	// BucketName limits the report to a single bucket when set.
+	BucketName string
+	// Now is used to decide whether a retention is still active. Defaults
+	// to the current time.
+	Now time.Time
+}
+
+// Verify verifies get object lock report fields.
+func (opts GetObjectLockReport) Verify() error {
+	if opts.ProjectID.IsZero() {
+		return ErrInvalidRequest.New("ProjectID missing")
+	}
+	return nil
+}
+
+// ObjectLockBucketReport summarizes the protected object versions of a bucket.
+type ObjectLockBucketReport struct {
+	BucketName string
+
+	// RetainedObjects and RetainedBytes count the versions under an active
+	// compliance retention.
+	RetainedObjects int64
+	RetainedBytes   int64
+	// SoonestRetainUntil is the earliest end of an active retention. It's nil
+	// when the bucket has no retained versions.
+	SoonestRetainUntil *time.Time
+
+	// LegalHoldObjects and LegalHoldBytes count the versions held by at least one pin.
+	LegalHoldObjects int64
+	LegalHoldBytes   int64
+}
+
+// GetObjectLockReport returns, for every bucket of the project which has
+// protected versions, the versions under an active compliance retention and
+// the versions held by pins. Buckets are sorted by name.
+func (db *DB) GetObjectLockReport(ctx context.Context, opts GetObjectLockReport) (report []ObjectLockBucketReport, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := opts.Verify(); err != nil {
+		return nil, err
+	}
+	if opts.Now.IsZero() {
+		opts.Now = time.Now()
+	}
+
+	report, err = db.ChooseAdapter(opts.ProjectID).GetObjectLockReport(ctx, opts)
+	if err != nil {
+		return nil, Error.New("unable to get object lock report: %w", err)
+	}
+	return report, nil
+}
+
+// objectLockReportBuilder merges the retention and legal hold rows of the
+// buckets into a single report.
+type objectLockReportBuilder map[string]*ObjectLockBucketReport
+
+func (builder objectLockReportBuilder) bucket(name string) *ObjectLockBucketReport {
+	bucket, ok := builder[name]
+	if !ok {
+		bucket = &ObjectLockBucketReport{BucketName: name}
+		builder[name] = bucket
+	}
+	return bucket
+}
+
+func (bucket *ObjectLockBucketReport) setRetained(counts ObjectLockBucketReport) {
+	bucket.RetainedObjects = counts.RetainedObjects
+	bucket.RetainedBytes = counts.RetainedBytes
+	bucket.SoonestRetainUntil = counts.SoonestRetainUntil
+}
+
+func (bucket *ObjectLockBucketReport) setLegalHold(counts ObjectLockBucketReport) {
+	bucket.LegalHoldObjects = counts.LegalHoldObjects
+	bucket.LegalHoldBytes = counts.LegalHoldBytes
+}
+
+func (builder objectLockReportBuilder) report() []ObjectLockBucketReport {
+	report := make([]ObjectLockBucketReport, 0, len(builder))
+	for _, bucket := range builder {
+		report = append(report, *bucket)
+	}
+	sort.Slice(report, func(i, k int) bool {
+		return report[i].BucketName < report[k].BucketName
+	})
+	return report
+}
+
+// GetObjectLockReport implements Adapter.
+func (p *PostgresAdapter) GetObjectLockReport(ctx context.Context, opts GetObjectLockReport) (report []ObjectLockBucketReport, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	const tag = queryTag("get_object_lock_report")
+	done := tag.observe(p.impl.String())
+	defer func() { done(int64(len(report)), err) }()
+
+	bucketFilter := ""
+	args := []interface{}{opts.ProjectID}
+	if opts.BucketName != "" {
+		bucketFilter = "AND bucket_name = $2"
+		args = append(args, []byte(opts.BucketName))
+	}
+
+	builder := objectLockReportBuilder{}
+
+	retentionArgs := append(append([]interface{}{}, args...), retentionModeCompliance, opts.Now)
+	err = withRows(p.db.QueryContext(ctx, `
+		SELECT bucket_name, count(*), coalesce(sum(total_encrypted_size), 0), min(retain_until)
+		FROM objects
+		WHERE
+			project_id = $1 `+bucketFilter+`
+			AND retention_mode = $`+strconv.Itoa(len(args)+1)+`
+			AND retain_until > $`+strconv.Itoa(len(args)+2)+`
+		GROUP BY bucket_name
+	`, retentionArgs...))(func(rows tagsql.Rows) error {
+		for rows.Next() {
+			var bucketName []byte
+			var retained ObjectLockBucketReport
+			if err := rows.Scan(&bucketName, &retained.RetainedObjects, &retained.RetainedBytes, &retained.SoonestRetainUntil); err != nil {
+				return err
+			}
+			builder.bucket(string(bucketName)).setRetained(retained)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = withRows(p.db.QueryContext(ctx, `
+		SELECT bucket_name, count(*), coalesce(sum(total_encrypted_size), 0)
+		FROM objects
+		WHERE
+			project_id = $1 `+bucketFilter+`
+			AND EXISTS (
+				SELECT 1 FROM object_pins
+				WHERE (object_pins.project_id, object_pins.bucket_name, object_pins.object_key, object_pins.version) =
+					(objects.project_id, objects.bucket_name, objects.object_key, objects.version)
+			)
+		GROUP BY bucket_name
+	`, args...))(func(rows tagsql.Rows) error {
+		for rows.Next() {
+			var bucketName []byte
+			var held ObjectLockBucketReport
+			if err := rows.Scan(&bucketName, &held.LegalHoldObjects, &held.LegalHoldBytes); err != nil {
+				return err
+			}
+			builder.bucket(string(bucketName)).setLegalHold(held)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return builder.report(), nil
+}
+
+// GetObjectLockReport implements Adapter.
+func (s *SpannerAdapter) GetObjectLockReport(ctx context.Context, opts GetObjectLockReport) (report []ObjectLockBucketReport, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	const tag = queryTag("get_object_lock_report")
+	done := tag.observe(s.Name())
+	defer func() { done(int64(len(report)), err) }()
+
+	bucketFilter := ""
+	params := map[string]interface{}{
+		"project_id": opts.ProjectID,
+	}
+	if opts.BucketName != "" {
+		bucketFilter = "AND bucket_name = @bucket_name"
+		params["bucket_name"] = opts.BucketName
+	}
+
+	builder := objectLockReportBuilder{}
+
+	retentionParams := map[string]interface{}{
+		"retention_mode": int64(retentionModeCompliance),
+		"now":            opts.Now,
+	}
+	for name, value := range params {
+		retentionParams[name] = value
+	}
+
+	retained := s.singleRead().Query(ctx, spanner.Statement{
+		SQL: `
+			SELECT bucket_name, count(*), coalesce(sum(total_encrypted_size), 0), min(retain_until)
+			FROM objects
+			WHERE
+				project_id = @project_id ` + bucketFilter + `
+				AND retention_mode = @retention_mode
+				AND retain_until > @now
+			GROUP BY bucket_name
+		`,
+		Params: retentionParams,
+	})
+	defer retained.Stop()
+
+	err = retained.Do(func(row *spanner.Row) error {
+		var bucketName string
+		var counts ObjectLockBucketReport
+		if err := row.Columns(&bucketName, &counts.RetainedObjects, &counts.RetainedBytes, &counts.SoonestRetainUntil); err != nil {
+			return err
+		}
+		builder.bucket(bucketName).setRetained(counts)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	held := s.singleRead().Query(ctx, spanner.Statement{
+		SQL: `
+			SELECT bucket_name, count(*), coalesce(sum(total_encrypted_size), 0)
+			FROM objects
+			WHERE
+				project_id = @project_id ` + bucketFilter + `
+				AND EXISTS (
+					SELECT 1 FROM object_pins
+					WHERE object_pins.project_id = objects.project_id
+						AND object_pins.bucket_name = objects.bucket_name
+						AND object_pins.object_key = objects.object_key
+						AND object_pins.version = objects.version
+				)
+			GROUP BY bucket_name
+		`,
+		Params: params,
+	})
+	defer held.Stop()
+
+	err = held.Do(func(row *spanner.Row) error {
+		var bucketName string
+		var counts ObjectLockBucketReport
+		if err := row.Columns(&bucketName, &counts.LegalHoldObjects, &counts.LegalHoldBytes); err != nil {
+			return err
+		}
+		builder.bucket(bucketName).setLegalHold(counts)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return builder.report(), nil
+}
@@ -0,0 +1,115 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/metabasetest"
+	"storj.io/storj/shared/dbutil"
+)
+
+func TestGetObjectLockReport(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		t.Run("missing project", func(t *testing.T) {
+			_, err := db.GetObjectLockReport(ctx, metabase.GetObjectLockReport{})
+			require.True(t, metabase.ErrInvalidRequest.Has(err), err)
+		})
+
+		t.Run("no protected versions", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			obj := metabasetest.RandObjectStream()
+			metabasetest.CreateObject(ctx, t, db, obj, 2)
+
+			report, err := db.GetObjectLockReport(ctx, metabase.GetObjectLockReport{ProjectID: obj.ProjectID})
+			require.NoError(t, err)
+			require.Empty(t, report)
+		})
+
+		t.Run("legal holds", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			obj := metabasetest.RandObjectStream()
+			held := metabasetest.CreateObject(ctx, t, db, obj, 2)
+
+			other := metabasetest.RandObjectStream()
+			other.ProjectID = obj.ProjectID
+			metabasetest.CreateObject(ctx, t, db, other, 1)
+
+			_, err := db.CreateObjectPin(ctx, metabase.CreateObjectPin{
+				ObjectPinLocation: metabase.ObjectPinLocation{ProjectID: obj.ProjectID, BucketName: obj.BucketName, Name: "hold-1"},
+				Versions:          []metabase.ObjectPinVersion{{ObjectKey: held.ObjectKey, Version: held.Version}},
+			})
+			require.NoError(t, err)
+			_, err = db.CreateObjectPin(ctx, metabase.CreateObjectPin{
+				ObjectPinLocation: metabase.ObjectPinLocation{ProjectID: obj.ProjectID, BucketName: obj.BucketName, Name: "hold-2"},
+				Versions:          []metabase.ObjectPinVersion{{ObjectKey: held.ObjectKey, Version: held.Version}},
+			})
+			require.NoError(t, err)
+
+			report, err := db.GetObjectLockReport(ctx, metabase.GetObjectLockReport{ProjectID: obj.ProjectID})
+			require.NoError(t, err)
+			require.Equal(t, []metabase.ObjectLockBucketReport{{
+				BucketName:       obj.BucketName,
+				LegalHoldObjects: 1,
+				LegalHoldBytes:   int64(held.TotalEncryptedSize),
+			}}, report)
+
+			report, err = db.GetObjectLockReport(ctx, metabase.GetObjectLockReport{ProjectID: obj.ProjectID, BucketName: other.BucketName})
+			require.NoError(t, err)
+			require.Empty(t, report)
+
+			require.NoError(t, db.DeleteObjectPin(ctx, metabase.DeleteObjectPin{ObjectPinLocation: metabase.ObjectPinLocation{ProjectID: obj.ProjectID, BucketName: obj.BucketName, Name: "hold-1"}}))
+			require.NoError(t, db.DeleteObjectPin(ctx, metabase.DeleteObjectPin{ObjectPinLocation: metabase.ObjectPinLocation{ProjectID: obj.ProjectID, BucketName: obj.BucketName, Name: "hold-2"}}))
+		})
+
+		if db.Implementation() == dbutil.Spanner {
+			return
+		}
+
+		t.Run("retention", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			now := time.Now().Truncate(time.Second)
+
+			obj := metabasetest.RandObjectStream()
+			soonest := metabasetest.CreateObjectVersioned(ctx, t, db, obj, 1)
+			obj.Version++
+			latest := metabasetest.CreateObjectVersioned(ctx, t, db, obj, 1)
+			obj.Version++
+			expired := metabasetest.CreateObjectVersioned(ctx, t, db, obj, 1)
+
+			for version, retainUntil := range map[metabase.Version]time.Time{
+				soonest.Version: now.Add(time.Hour),
+				latest.Version:  now.Add(48 * time.Hour),
+				expired.Version: now.Add(-time.Hour),
+			} {
+				_, err := db.UnderlyingTagSQL().ExecContext(ctx, `
+					UPDATE objects SET retention_mode = 1, retain_until = $5
+					WHERE (project_id, bucket_name, object_key, version) = ($1, $2, $3, $4)
+				`, obj.ProjectID, []byte(obj.BucketName), obj.ObjectKey, version, retainUntil)
+				require.NoError(t, err)
+			}
+
+			report, err := db.GetObjectLockReport(ctx, metabase.GetObjectLockReport{ProjectID: obj.ProjectID, Now: now})
+			require.NoError(t, err)
+			require.Len(t, report, 1)
+			require.Equal(t, obj.BucketName, report[0].BucketName)
+			require.EqualValues(t, 2, report[0].RetainedObjects)
+			require.EqualValues(t, soonest.TotalEncryptedSize+latest.TotalEncryptedSize, report[0].RetainedBytes)
+			require.NotNil(t, report[0].SoonestRetainUntil)
+			require.WithinDuration(t, now.Add(time.Hour), *report[0].SoonestRetainUntil, time.Second)
+			require.Zero(t, report[0].LegalHoldObjects)
+
+			_, err = db.UnderlyingTagSQL().ExecContext(ctx, `UPDATE objects SET retention_mode = 0, retain_until = NULL`)
+			require.NoError(t, err)
+		})
+	})
+}
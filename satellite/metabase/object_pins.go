@@ -0,0 +1,636 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/storj/exp-spanner"
+	"github.com/zeebo/errs"
+	"google.golang.org/api/iterator"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/shared/dbutil/pgutil"
+	"storj.io/storj/shared/tagsql"
+)
+
+const (
+	// MaxObjectPinVersions is the maximum number of object versions held by a single pin.
+	MaxObjectPinVersions = 10000
+	// MaxObjectPinNameLength is the maximum length of a pin name.
+	MaxObjectPinNameLength = 128
+)
+
+var (
+	// ErrObjectPinned is returned when an object version held by a pin would be deleted.
+	ErrObjectPinned = errs.Class("object version is pinned")
+	// ErrObjectPinNotFound is returned when the pin doesn't exist.
+	ErrObjectPinNotFound = errs.Class("object pin not found")
+	// ErrObjectPinExists is returned when a pin with the same name already exists in the bucket.
+	ErrObjectPinExists = errs.Class("object pin already exists")
+)
+
+// ObjectPinVersion identifies an object version held by a pin.
+type ObjectPinVersion struct {
+	ObjectKey ObjectKey
+	Version   Version
+}
+
+// ObjectPin is a named set of object versions of a bucket. The pinned versions
+// can't be deleted, overwritten, moved or expire until the pin is released,
+// regardless of the bucket settings. The set can't be changed after creation.
+type ObjectPin struct {
+	ProjectID  uuid.UUID
+	BucketName string
+	Name       string
+	CreatedAt  time.Time
+
+	// VersionCount is the number of pinned versions.
+	VersionCount int64
+	// Versions are the pinned versions. They are only returned by GetObjectPin.
+	Versions []ObjectPinVersion
+}
+
+// ObjectPinLocation specifies a pin of a bucket.
+type ObjectPinLocation struct {
+	ProjectID  uuid.UUID
+	BucketName string
+	Name       string
+}
+
+// Verify verifies object pin location fields.
+func (opts ObjectPinLocation) Verify() error {
+	switch {
+	case opts.ProjectID.IsZero():
+		return ErrInvalidRequest.New("ProjectID missing")
+	case opts.BucketName == "":
+		return ErrInvalidRequest.New("BucketName missing")
+	case opts.Name == "":
+		return ErrInvalidRequest.New("Name missing")
+	case len(opts.Name) > MaxObjectPinNameLength:
+		return ErrInvalidRequest.New("Name is longer than %d bytes", MaxObjectPinNameLength)
+	}
+	return nil
+}
+
+// CreateObjectPin contains arguments for creating a pin.
+type CreateObjectPin struct {
+	ObjectPinLocation
+	Versions []ObjectPinVersion
+}
+
+// Verify verifies create object pin fields.
+func (opts *CreateObjectPin) Verify() error {
+	if err := opts.ObjectPinLocation.Verify(); err != nil {
+		return err
+	}
+	if len(opts.Versions) == 0 {
+		return ErrInvalidRequest.New("Versions missing")
+	}
+	if len(opts.Versions) > MaxObjectPinVersions {
+		return ErrInvalidRequest.New("at most %d versions can be pinned", MaxObjectPinVersions)
+	}
+
+	seen := make(map[ObjectPinVersion]struct{}, len(opts.Versions))
+	for _, version := range opts.Versions {
+		switch {
+		case version.ObjectKey == "":
+			return ErrInvalidRequest.New("ObjectKey missing")
+		case version.Version <= 0:
+			return ErrInvalidRequest.New("Version invalid: %v", version.Version)
+		}
+		if _, ok := seen[version]; ok {
+			return ErrInvalidRequest.New("duplicate version %v of %q", version.Version, version.ObjectKey)
+		}
+		seen[version] = struct{}{}
+	}
+	return nil
+}
+
+// GetObjectPin contains arguments for getting a pin together with its versions.
+type GetObjectPin struct {
+	ObjectPinLocation
+}
+
+// DeleteObjectPin contains arguments for releasing a pin.
+type DeleteObjectPin struct {
+	ObjectPinLocation
+}
+
+// ListObjectPins contains arguments for listing the pins of a bucket.
+type ListObjectPins struct {
+	ProjectID  uuid.UUID
+	BucketName string
+}
+
+// Verify verifies list object pins fields.
+func (opts ListObjectPins) Verify() error {
+	switch {
+	case opts.ProjectID.IsZero():
+		return ErrInvalidRequest.New("ProjectID missing")
+	case opts.BucketName == "":
+		return ErrInvalidRequest.New("BucketName missing")
+	}
+	return nil
+}
+
+type objectPinsTransactionAdapter interface {
+	objectPinExists(ctx context.Context, location ObjectPinLocation) (bool, error)
+	insertObjectPin(ctx context.Context, opts CreateObjectPin, createdAt time.Time) (inserted int64, err error)
+	getObjectPinVersions(ctx context.Context, location ObjectPinLocation) (createdAt time.Time, versions []ObjectPinVersion, err error)
+	deleteObjectPin(ctx context.Context, location ObjectPinLocation) (deleted int64, err error)
+	pinnedObjectVersions(ctx context.Context, projectID uuid.UUID, bucketName string, objectKeys [][]byte) (pinned []Object, err error)
+}
+
+// CreateObjectPin pins committed object versions. It fails when any of the
+// versions doesn't exist, or when the bucket already has a pin with the same name.
+func (db *DB) CreateObjectPin(ctx context.Context, opts CreateObjectPin) (pin ObjectPin, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := opts.Verify(); err != nil {
+		return ObjectPin{}, err
+	}
+
+	createdAt := time.Now()
+	err = db.ChooseAdapter(opts.ProjectID).withTxStats(ctx, "create_object_pin", func(ctx context.Context, adapter TransactionAdapter) error {
+		exists, err := adapter.objectPinExists(ctx, opts.ObjectPinLocation)
+		if err != nil {
+			return err
+		}
+		if exists {
+			return ErrObjectPinExists.New("%q", opts.Name)
+		}
+
+		inserted, err := adapter.insertObjectPin(ctx, opts, createdAt)
+		if err != nil {
+			return err
+		}
+		if inserted != int64(len(opts.Versions)) {
+			return ErrObjectNotFound.New("%d of %d versions are not committed objects", int64(len(opts.Versions))-inserted, len(opts.Versions))
+		}
+		return nil
+	})
+	if err != nil {
+		return ObjectPin{}, err
+	}
+
+	mon.Meter("object_pin_create").Mark(1)
+	return ObjectPin{
+		ProjectID:    opts.ProjectID,
+		BucketName:   opts.BucketName,
+		Name:         opts.Name,
+		CreatedAt:    createdAt,
+		VersionCount: int64(len(opts.Versions)),
+		Versions:     opts.Versions,
+	}, nil
+}
+
+// GetObjectPin returns a pin together with its versions, sorted by key and version.
+func (db *DB) GetObjectPin(ctx context.Context, opts GetObjectPin) (pin ObjectPin, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := opts.Verify(); err != nil {
+		return ObjectPin{}, err
+	}
+
+	pin = ObjectPin{
+		ProjectID:  opts.ProjectID,
+		BucketName: opts.BucketName,
+		Name:       opts.Name,
+	}
+	err = db.ChooseAdapter(opts.ProjectID).WithTx(ctx, func(ctx context.Context, adapter TransactionAdapter) error {
+		pin.CreatedAt, pin.Versions, err = adapter.getObjectPinVersions(ctx, opts.ObjectPinLocation)
+		return err
+	})
+	if err != nil {
+		return ObjectPin{}, err
+	}
+	if len(pin.Versions) == 0 {
+		return ObjectPin{}, ErrObjectPinNotFound.New("%q", opts.Name)
+	}
+	pin.VersionCount = int64(len(pin.Versions))
+	return pin, nil
+}
+
+// DeleteObjectPin releases a pin. The versions stay in place and can be
+// deleted again, unless another pin holds them.
+func (db *DB) DeleteObjectPin(ctx context.Context, opts DeleteObjectPin) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := opts.Verify(); err != nil {
+		return err
+	}
+
+	return db.ChooseAdapter(opts.ProjectID).withTxStats(ctx, "delete_object_pin", func(ctx context.Context, adapter TransactionAdapter) error {
+		deleted, err := adapter.deleteObjectPin(ctx, opts.ObjectPinLocation)
+		if err != nil {
+			return err
+		}
+		if deleted == 0 {
+			return ErrObjectPinNotFound.New("%q", opts.Name)
+		}
+		return nil
+	})
+}
+
+// ListObjectPins returns the pins of a bucket, sorted by name, without their versions.
+func (db *DB) ListObjectPins(ctx context.Context, opts ListObjectPins) (pins []ObjectPin, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := opts.Verify(); err != nil {
+		return nil, err
+	}
+
+	return db.ChooseAdapter(opts.ProjectID).ListObjectPins(ctx, opts)
+}
+
+// objectPinned is a condition on the objects table, which matches the versions
+// held by a pin.
+//
+// Pins aren't enforced by a foreign key. Every query removing or renaming
+// committed versions excludes the pinned ones with objectNotPinned, so the
+// pin check is part of the same statement and doesn't cost anything when the
+// object isn't pinned.
+const objectPinned = `EXISTS (
+	SELECT 1 FROM object_pins
+	WHERE object_pins.project_id = objects.project_id
+		AND object_pins.bucket_name = objects.bucket_name
+		AND object_pins.object_key = objects.object_key
+		AND object_pins.version = objects.version
+)`
+
+// objectNotPinned is a condition on the objects table, which excludes the
+// versions held by a pin.
+const objectNotPinned = `NOT ` + objectPinned
+
+// checkObjectPinned returns ErrObjectPinned when any committed version of the
+// objects accepted by match is held by a pin. The delete queries skip the pinned
+// versions, so it's used to tell why nothing was deleted.
+func (db *DB) checkObjectPinned(ctx context.Context, projectID uuid.UUID, bucketName string, objectKeys [][]byte, match func(Object) bool) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var pinned []Object
+	err = db.ChooseAdapter(projectID).WithTx(ctx, func(ctx context.Context, adapter TransactionAdapter) error {
+		pinned, err = adapter.pinnedObjectVersions(ctx, projectID, bucketName, objectKeys)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	return pinnedObjectError(pinned, match)
+}
+
+// pinnedObjectError returns ErrObjectPinned for the first of the pinned versions accepted by match.
+func pinnedObjectError(pinned []Object, match func(Object) bool) error {
+	for _, object := range pinned {
+		if match(object) {
+			return ErrObjectPinned.New("version %d of %q", object.Version, object.ObjectKey)
+		}
+	}
+	return nil
+}
+
+func (ptx *postgresTransactionAdapter) objectPinExists(ctx context.Context, location ObjectPinLocation) (exists bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = ptx.tx.QueryRowContext(ctx, `
+		SELECT EXISTS (
+			SELECT 1 FROM object_pins
+			WHERE (project_id, bucket_name, pin_name) = ($1, $2, $3)
+		)
+	`, location.ProjectID, []byte(location.BucketName), location.Name).Scan(&exists)
+	if err != nil {
+		return false, Error.New("unable to query object pin: %w", err)
+	}
+	return exists, nil
+}
+
+func (ptx *postgresTransactionAdapter) insertObjectPin(ctx context.Context, opts CreateObjectPin, createdAt time.Time) (inserted int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	keys := make([][]byte, len(opts.Versions))
+	versions := make([]int64, len(opts.Versions))
+	for i, version := range opts.Versions {
+		keys[i], versions[i] = []byte(version.ObjectKey), int64(version.Version)
+	}
+
+	result, err := ptx.tx.ExecContext(ctx, `
+		INSERT INTO object_pins (project_id, bucket_name, object_key, version, pin_name, created_at)
+		SELECT objects.project_id, objects.bucket_name, objects.object_key, objects.version, $5, $6
+		FROM objects
+		JOIN UNNEST($3::BYTEA[], $4::INT8[]) AS pinned(object_key, version)
+			ON (objects.object_key, objects.version) = (pinned.object_key, pinned.version)
+		WHERE
+			(objects.project_id, objects.bucket_name) = ($1, $2)
+			AND objects.status IN `+statusesCommitted+`
+	`, opts.ProjectID, []byte(opts.BucketName), pgutil.ByteaArray(keys), pgutil.Int8Array(versions),
+		opts.Name, createdAt)
+	if err != nil {
+		return 0, Error.New("unable to insert object pin: %w", err)
+	}
+
+	inserted, err = result.RowsAffected()
+	if err != nil {
+		return 0, Error.New("unable to insert object pin: %w", err)
+	}
+	return inserted, nil
+}
+
+func (ptx *postgresTransactionAdapter) getObjectPinVersions(ctx context.Context, location ObjectPinLocation) (createdAt time.Time, versions []ObjectPinVersion, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = withRows(ptx.tx.QueryContext(ctx, `
+		SELECT object_key, version, created_at
+		FROM object_pins
+		WHERE (project_id, bucket_name, pin_name) = ($1, $2, $3)
+		ORDER BY object_key, version
+	`, location.ProjectID, []byte(location.BucketName), location.Name))(func(rows tagsql.Rows) error {
+		for rows.Next() {
+			var version ObjectPinVersion
+			if err := rows.Scan(&version.ObjectKey, &version.Version, &createdAt); err != nil {
+				return err
+			}
+			versions = append(versions, version)
+		}
+		return nil
+	})
+	if err != nil {
+		return time.Time{}, nil, Error.New("unable to query object pin: %w", err)
+	}
+	return createdAt, versions, nil
+}
+
+func (ptx *postgresTransactionAdapter) deleteObjectPin(ctx context.Context, location ObjectPinLocation) (deleted int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	result, err := ptx.tx.ExecContext(ctx, `
+		DELETE FROM object_pins
+		WHERE (project_id, bucket_name, pin_name) = ($1, $2, $3)
+	`, location.ProjectID, []byte(location.BucketName), location.Name)
+	if err != nil {
+		return 0, Error.New("unable to delete object pin: %w", err)
+	}
+
+	deleted, err = result.RowsAffected()
+	if err != nil {
+		return 0, Error.New("unable to delete object pin: %w", err)
+	}
+	return deleted, nil
+}
+
+func (ptx *postgresTransactionAdapter) pinnedObjectVersions(ctx context.Context, projectID uuid.UUID, bucketName string, objectKeys [][]byte) (pinned []Object, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = withRows(ptx.tx.QueryContext(ctx, `
+		SELECT object_key, version, status
+		FROM objects
+		WHERE
+			(project_id, bucket_name) = ($1, $2)
+			AND object_key = ANY ($3)
+			AND `+objectPinned+`
+		ORDER BY object_key, version
+	`, projectID, []byte(bucketName), pgutil.ByteaArray(objectKeys)))(func(rows tagsql.Rows) error {
+		for rows.Next() {
+			object := Object{ObjectStream: ObjectStream{ProjectID: projectID, BucketName: bucketName}}
+			if err := rows.Scan(&object.ObjectKey, &object.Version, &object.Status); err != nil {
+				return err
+			}
+			pinned = append(pinned, object)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, Error.New("unable to query pinned objects: %w", err)
+	}
+	return pinned, nil
+}
+
+// ListObjectPins implements Adapter.
+func (p *PostgresAdapter) ListObjectPins(ctx context.Context, opts ListObjectPins) (pins []ObjectPin, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	const tag = queryTag("list_object_pins")
+	done := tag.observe(p.impl.String())
+	defer func() { done(int64(len(pins)), err) }()
+
+	err = withRows(p.db.QueryContext(ctx, `
+		SELECT pin_name, MIN(created_at), COUNT(*)
+		FROM object_pins
+		WHERE (project_id, bucket_name) = ($1, $2)
+		GROUP BY pin_name
+		ORDER BY pin_name
+	`, opts.ProjectID, []byte(opts.BucketName)))(func(rows tagsql.Rows) error {
+		for rows.Next() {
+			pin := ObjectPin{
+				ProjectID:  opts.ProjectID,
+				BucketName: opts.BucketName,
+			}
+			if err := rows.Scan(&pin.Name, &pin.CreatedAt, &pin.VersionCount); err != nil {
+				return err
+			}
+			pins = append(pins, pin)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, Error.New("unable to list object pins: %w", err)
+	}
+	return pins, nil
+}
+
+func (stx *spannerTransactionAdapter) objectPinExists(ctx context.Context, location ObjectPinLocation) (exists bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	result := stx.tx.Query(ctx, spanner.Statement{
+		SQL: `
+			SELECT 1 FROM object_pins
+			WHERE project_id = @project_id AND bucket_name = @bucket_name AND pin_name = @pin_name
+			LIMIT 1
+		`,
+		Params: map[string]interface{}{
+			"project_id":  location.ProjectID,
+			"bucket_name": location.BucketName,
+			"pin_name":    location.Name,
+		},
+	})
+	defer result.Stop()
+
+	_, err = result.Next()
+	if err != nil {
+		if errors.Is(err, iterator.Done) {
+			return false, nil
+		}
+		return false, Error.New("unable to query object pin: %w", err)
+	}
+	return true, nil
+}
+
+func (stx *spannerTransactionAdapter) insertObjectPin(ctx context.Context, opts CreateObjectPin, createdAt time.Time) (inserted int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	keys := make([]spanner.Key, 0, len(opts.Versions))
+	for _, version := range opts.Versions {
+		keys = append(keys, spanner.Key{opts.ProjectID, opts.BucketName, version.ObjectKey, version.Version})
+	}
+
+	rows := stx.tx.Read(ctx, "objects", spanner.KeySetFromKeys(keys...), []string{"object_key", "version", "status"})
+	defer rows.Stop()
+
+	var mutations []*spanner.Mutation
+	err = rows.Do(func(row *spanner.Row) error {
+		var version ObjectPinVersion
+		var status ObjectStatus
+		if err := row.Columns(&version.ObjectKey, &version.Version, &status); err != nil {
+			return err
+		}
+		if !status.IsCommitted() {
+			return nil
+		}
+		mutations = append(mutations, spanner.Insert("object_pins",
+			[]string{"project_id", "bucket_name", "object_key", "version", "pin_name", "created_at"},
+			[]interface{}{opts.ProjectID, opts.BucketName, version.ObjectKey, version.Version, opts.Name, createdAt},
+		))
+		return nil
+	})
+	if err != nil {
+		return 0, Error.New("unable to query objects: %w", err)
+	}
+
+	if err := stx.tx.BufferWrite(mutations); err != nil {
+		return 0, Error.New("unable to insert object pin: %w", err)
+	}
+	return int64(len(mutations)), nil
+}
+
+func (stx *spannerTransactionAdapter) getObjectPinVersions(ctx context.Context, location ObjectPinLocation) (createdAt time.Time, versions []ObjectPinVersion, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	result := stx.tx.Query(ctx, spanner.Statement{
+		SQL: `
+			SELECT object_key, version, created_at
+			FROM object_pins
+			WHERE project_id = @project_id AND bucket_name = @bucket_name AND pin_name = @pin_name
+			ORDER BY object_key, version
+		`,
+		Params: map[string]interface{}{
+			"project_id":  location.ProjectID,
+			"bucket_name": location.BucketName,
+			"pin_name":    location.Name,
+		},
+	})
+	defer result.Stop()
+
+	err = result.Do(func(row *spanner.Row) error {
+		var version ObjectPinVersion
+		if err := row.Columns(&version.ObjectKey, &version.Version, &createdAt); err != nil {
+			return err
+		}
+		versions = append(versions, version)
+		return nil
+	})
+	if err != nil {
+		return time.Time{}, nil, Error.New("unable to query object pin: %w", err)
+	}
+	return createdAt, versions, nil
+}
+
+func (stx *spannerTransactionAdapter) deleteObjectPin(ctx context.Context, location ObjectPinLocation) (deleted int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	deleted, err = stx.tx.Update(ctx, spanner.Statement{
+		SQL: `
+			DELETE FROM object_pins
+			WHERE project_id = @project_id AND bucket_name = @bucket_name AND pin_name = @pin_name
+		`,
+		Params: map[string]interface{}{
+			"project_id":  location.ProjectID,
+			"bucket_name": location.BucketName,
+			"pin_name":    location.Name,
+		},
+	})
+	if err != nil {
+		return 0, Error.New("unable to delete object pin: %w", err)
+	}
+	return deleted, nil
+}
+
+func (stx *spannerTransactionAdapter) pinnedObjectVersions(ctx context.Context, projectID uuid.UUID, bucketName string, objectKeys [][]byte) (pinned []Object, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	result := stx.tx.Query(ctx, spanner.Statement{
+		SQL: `
+			SELECT object_key, version, status
+			FROM objects
+			WHERE
+				project_id = @project_id AND bucket_name = @bucket_name
+				AND object_key IN UNNEST(@object_keys)
+				AND ` + objectPinned + `
+			ORDER BY object_key, version
+		`,
+		Params: map[string]interface{}{
+			"project_id":  projectID,
+			"bucket_name": bucketName,
+			"object_keys": objectKeys,
+		},
+	})
+	defer result.Stop()
+
+	for {
+		row, err := result.Next()
+		if err != nil {
+			if errors.Is(err, iterator.Done) {
+				return pinned, nil
+			}
+			return nil, Error.New("unable to query pinned objects: %w", err)
+		}
+
+		object := Object{ObjectStream: ObjectStream{ProjectID: projectID, BucketName: bucketName}}
+		if err := row.Columns(&object.ObjectKey, &object.Version, &object.Status); err != nil {
+			return nil, Error.New("unable to read pinned objects: %w", err)
+		}
+		pinned = append(pinned, object)
+	}
+}
+
+// ListObjectPins implements Adapter.
+func (s *SpannerAdapter) ListObjectPins(ctx context.Context, opts ListObjectPins) (pins []ObjectPin, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	const tag = queryTag("list_object_pins")
+	done := tag.observe(s.Name())
+	defer func() { done(int64(len(pins)), err) }()
+
+	result := s.client.Single().Query(ctx, spanner.Statement{
+		SQL: `
+			SELECT pin_name, MIN(created_at), COUNT(*)
+			FROM object_pins
+			WHERE project_id = @project_id AND bucket_name = @bucket_name
+			GROUP BY pin_name
+			ORDER BY pin_name
+		`,
+		Params: map[string]interface{}{
+			"project_id":  opts.ProjectID,
+			"bucket_name": opts.BucketName,
+		},
+	})
+	defer result.Stop()
+
+	err = result.Do(func(row *spanner.Row) error {
+		pin := ObjectPin{
+			ProjectID:  opts.ProjectID,
+			BucketName: opts.BucketName,
+		}
+		if err := row.Columns(&pin.Name, &pin.CreatedAt, &pin.VersionCount); err != nil {
+			return err
+		}
+		pins = append(pins, pin)
+		return nil
+	})
+	if err != nil {
+		return nil, Error.New("unable to list object pins: %w", err)
+	}
+	return pins, nil
+}
@@ -0,0 +1,324 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/metabasetest"
+)
+
+func TestObjectPins(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		t.Run("invalid pins", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			obj := metabasetest.RandObjectStream()
+			location := metabase.ObjectPinLocation{ProjectID: obj.ProjectID, BucketName: obj.BucketName, Name: "hold"}
+			version := metabase.ObjectPinVersion{ObjectKey: obj.ObjectKey, Version: 1}
+
+			for _, invalid := range []metabase.CreateObjectPin{
+				{ObjectPinLocation: location},
+				{ObjectPinLocation: metabase.ObjectPinLocation{ProjectID: obj.ProjectID, BucketName: obj.BucketName}, Versions: []metabase.ObjectPinVersion{version}},
+				{ObjectPinLocation: location, Versions: []metabase.ObjectPinVersion{version, version}},
+				{ObjectPinLocation: location, Versions: []metabase.ObjectPinVersion{{ObjectKey: obj.ObjectKey}}},
+			} {
+				_, err := db.CreateObjectPin(ctx, invalid)
+				require.True(t, metabase.ErrInvalidRequest.Has(err), err)
+			}
+		})
+
+		t.Run("missing version", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			obj := metabasetest.RandObjectStream()
+			object := metabasetest.CreateObject(ctx, t, db, obj, 0)
+
+			_, err := db.CreateObjectPin(ctx, metabase.CreateObjectPin{
+				ObjectPinLocation: metabase.ObjectPinLocation{ProjectID: obj.ProjectID, BucketName: obj.BucketName, Name: "hold"},
+				Versions: []metabase.ObjectPinVersion{
+					{ObjectKey: object.ObjectKey, Version: object.Version},
+					{ObjectKey: object.ObjectKey, Version: object.Version + 1},
+				},
+			})
+			require.True(t, metabase.ErrObjectNotFound.Has(err), err)
+
+			pins, err := db.ListObjectPins(ctx, metabase.ListObjectPins{ProjectID: obj.ProjectID, BucketName: obj.BucketName})
+			require.NoError(t, err)
+			require.Empty(t, pins)
+		})
+
+		t.Run("pinned versions are kept", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			now := time.Now()
+			obj := metabasetest.RandObjectStream()
+			object := metabasetest.CreateExpiredObject(ctx, t, db, obj, 1, now.Add(-time.Hour))
+
+			location := metabase.ObjectPinLocation{ProjectID: obj.ProjectID, BucketName: obj.BucketName, Name: "case-1234"}
+			versions := []metabase.ObjectPinVersion{{ObjectKey: object.ObjectKey, Version: object.Version}}
+
+			pin, err := db.CreateObjectPin(ctx, metabase.CreateObjectPin{ObjectPinLocation: location, Versions: versions})
+			require.NoError(t, err)
+			require.EqualValues(t, 1, pin.VersionCount)
+
+			_, err = db.CreateObjectPin(ctx, metabase.CreateObjectPin{ObjectPinLocation: location, Versions: versions})
+			require.True(t, metabase.ErrObjectPinExists.Has(err), err)
+
+			stored, err := db.GetObjectPin(ctx, metabase.GetObjectPin{ObjectPinLocation: location})
+			require.NoError(t, err)
+			require.Equal(t, versions, stored.Versions)
+
+			pins, err := db.ListObjectPins(ctx, metabase.ListObjectPins{ProjectID: obj.ProjectID, BucketName: obj.BucketName})
+			require.NoError(t, err)
+			require.Len(t, pins, 1)
+			require.Equal(t, location.Name, pins[0].Name)
+			require.EqualValues(t, 1, pins[0].VersionCount)
+
+			_, err = db.DeleteObjectExactVersion(ctx, metabase.DeleteObjectExactVersion{
+				ObjectLocation: obj.Location(),
+				Version:        obj.Version,
+			})
+			require.True(t, metabase.ErrObjectPinned.Has(err), err)
+
+			_, err = db.DeleteBucketObjects(ctx, metabase.DeleteBucketObjects{Bucket: obj.Location().Bucket()})
+			require.True(t, metabase.ErrObjectPinned.Has(err), err)
+
+			_, err = db.DeleteExpiredObjects(ctx, metabase.DeleteExpiredObjects{
+				ExpiredBefore: now,
+				BatchSize:     10,
+			})
+			require.NoError(t, err)
+
+			objects, err := db.TestingAllObjects(ctx)
+			require.NoError(t, err)
+			require.Len(t, objects, 1)
+
+			require.NoError(t, db.DeleteObjectPin(ctx, metabase.DeleteObjectPin{ObjectPinLocation: location}))
+
+			err = db.DeleteObjectPin(ctx, metabase.DeleteObjectPin{ObjectPinLocation: location})
+			require.True(t, metabase.ErrObjectPinNotFound.Has(err), err)
+
+			_, err = db.DeleteExpiredObjects(ctx, metabase.DeleteExpiredObjects{
+				ExpiredBefore: now,
+				BatchSize:     10,
+			})
+			require.NoError(t, err)
+
+			objects, err = db.TestingAllObjects(ctx)
+			require.NoError(t, err)
+			require.Empty(t, objects)
+		})
+
+		pin := func(t *testing.T, object metabase.Object) metabase.ObjectPinLocation {
+			location := metabase.ObjectPinLocation{ProjectID: object.ProjectID, BucketName: object.BucketName, Name: "hold"}
+			_, err := db.CreateObjectPin(ctx, metabase.CreateObjectPin{
+				ObjectPinLocation: location,
+				Versions:          []metabase.ObjectPinVersion{{ObjectKey: object.ObjectKey, Version: object.Version}},
+			})
+			require.NoError(t, err)
+			return location
+		}
+
+		t.Run("delete", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			obj := metabasetest.RandObjectStream()
+			object := metabasetest.CreateObject(ctx, t, db, obj, 2)
+			location := pin(t, object)
+
+			_, err := db.DeleteObjectExactVersion(ctx, metabase.DeleteObjectExactVersion{
+				ObjectLocation: obj.Location(),
+				Version:        obj.Version,
+			})
+			require.True(t, metabase.ErrObjectPinned.Has(err), err)
+
+			_, err = db.DeleteObjectLastCommitted(ctx, metabase.DeleteObjectLastCommitted{
+				ObjectLocation: obj.Location(),
+			})
+			require.True(t, metabase.ErrObjectPinned.Has(err), err)
+
+			// deleting a version which doesn't exist isn't affected by the pin.
+			result, err := db.DeleteObjectExactVersion(ctx, metabase.DeleteObjectExactVersion{
+				ObjectLocation: obj.Location(),
+				Version:        obj.Version + 1,
+			})
+			require.NoError(t, err)
+			require.Empty(t, result.Removed)
+
+			segments, err := db.TestingAllSegments(ctx)
+			require.NoError(t, err)
+			require.Len(t, segments, 2)
+
+			require.NoError(t, db.DeleteObjectPin(ctx, metabase.DeleteObjectPin{ObjectPinLocation: location}))
+
+			result, err = db.DeleteObjectExactVersion(ctx, metabase.DeleteObjectExactVersion{
+				ObjectLocation: obj.Location(),
+				Version:        obj.Version,
+			})
+			require.NoError(t, err)
+			require.Len(t, result.Removed, 1)
+
+			metabasetest.Verify{}.Check(ctx, t, db)
+		})
+
+		t.Run("overwrite", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			obj := metabasetest.RandObjectStream()
+			object := metabasetest.CreateObject(ctx, t, db, obj, 1)
+			location := pin(t, object)
+
+			next := obj
+			next.Version = obj.Version + 1
+			next.StreamID = testrand.UUID()
+			metabasetest.CreatePendingObject(ctx, t, db, next, 0)
+
+			metabasetest.CommitObject{
+				Opts:     metabase.CommitObject{ObjectStream: next},
+				ErrClass: &metabase.ErrObjectPinned,
+			}.Check(ctx, t, db)
+
+			moved := metabasetest.RandObjectStream()
+			moved.ProjectID = obj.ProjectID
+			moved.BucketName = obj.BucketName
+			moved.Version = 1
+			movedObject := metabasetest.CreateObject(ctx, t, db, moved, 0)
+
+			// moving onto the pinned object would delete it.
+			metabasetest.FinishMoveObject{
+				Opts: metabase.FinishMoveObject{
+					ObjectStream:          moved,
+					NewBucket:             obj.BucketName,
+					NewEncryptedObjectKey: obj.ObjectKey,
+				},
+				ErrClass: &metabase.ErrObjectPinned,
+			}.Check(ctx, t, db)
+
+			// moving the pinned object would remove the pinned version.
+			metabasetest.FinishMoveObject{
+				Opts: metabase.FinishMoveObject{
+					ObjectStream:          obj,
+					NewBucket:             obj.BucketName,
+					NewEncryptedObjectKey: metabasetest.RandObjectKey(),
+				},
+				ErrClass: &metabase.ErrObjectPinned,
+			}.Check(ctx, t, db)
+
+			// a new version doesn't remove the pinned one.
+			metabasetest.CommitObject{
+				Opts: metabase.CommitObject{ObjectStream: next, Versioned: true},
+			}.Check(ctx, t, db)
+
+			objects, err := db.TestingAllObjects(ctx)
+			require.NoError(t, err)
+			require.Len(t, objects, 3)
+
+			require.NoError(t, db.DeleteObjectPin(ctx, metabase.DeleteObjectPin{ObjectPinLocation: location}))
+
+			metabasetest.FinishMoveObject{
+				Opts: metabase.FinishMoveObject{
+					ObjectStream:          movedObject.ObjectStream,
+					NewBucket:             obj.BucketName,
+					NewEncryptedObjectKey: obj.ObjectKey,
+				},
+			}.Check(ctx, t, db)
+
+			objects, err = db.TestingAllObjects(ctx)
+			require.NoError(t, err)
+			require.Len(t, objects, 2)
+			for _, object := range objects {
+				require.NotEqual(t, obj.StreamID, object.StreamID)
+			}
+		})
+
+		t.Run("expiry", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			now := time.Now()
+			pinnedStream := metabasetest.RandObjectStream()
+			pinnedObject := metabasetest.CreateExpiredObject(ctx, t, db, pinnedStream, 1, now.Add(-time.Hour))
+			expiredStream := metabasetest.RandObjectStream()
+			expiredStream.ProjectID = pinnedStream.ProjectID
+			expiredStream.BucketName = pinnedStream.BucketName
+			metabasetest.CreateExpiredObject(ctx, t, db, expiredStream, 1, now.Add(-time.Hour))
+
+			location := pin(t, pinnedObject)
+
+			_, err := db.DeleteExpiredObjects(ctx, metabase.DeleteExpiredObjects{
+				ExpiredBefore: now,
+				BatchSize:     1,
+			})
+			require.NoError(t, err)
+
+			objects, err := db.TestingAllObjects(ctx)
+			require.NoError(t, err)
+			require.Len(t, objects, 1)
+			require.Equal(t, pinnedStream.StreamID, objects[0].StreamID)
+
+			segments, err := db.TestingAllSegments(ctx)
+			require.NoError(t, err)
+			require.Len(t, segments, 1)
+			require.Equal(t, pinnedStream.StreamID, segments[0].StreamID)
+
+			require.NoError(t, db.DeleteObjectPin(ctx, metabase.DeleteObjectPin{ObjectPinLocation: location}))
+
+			_, err = db.DeleteExpiredObjects(ctx, metabase.DeleteExpiredObjects{
+				ExpiredBefore: now,
+				BatchSize:     1,
+			})
+			require.NoError(t, err)
+
+			metabasetest.Verify{}.Check(ctx, t, db)
+		})
+	})
+}
+
+func TestObjectPins_DeleteAllVersions(t *testing.T) {
+	metabasetest.RunWithConfig(t, noServerSideCopyConfig, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		obj := metabasetest.RandObjectStream()
+		object := metabasetest.CreateObject(ctx, t, db, obj, 1)
+		other := obj
+		other.ObjectKey = metabasetest.RandObjectKey()
+		other.StreamID = testrand.UUID()
+		otherObject := metabasetest.CreateObject(ctx, t, db, other, 1)
+
+		location := metabase.ObjectPinLocation{ProjectID: obj.ProjectID, BucketName: obj.BucketName, Name: "hold"}
+		_, err := db.CreateObjectPin(ctx, metabase.CreateObjectPin{
+			ObjectPinLocation: location,
+			Versions:          []metabase.ObjectPinVersion{{ObjectKey: object.ObjectKey, Version: object.Version}},
+		})
+		require.NoError(t, err)
+
+		// deleting a pinned object together with others doesn't delete any of them.
+		_, err = db.DeleteObjectsAllVersions(ctx, metabase.DeleteObjectsAllVersions{
+			Locations: []metabase.ObjectLocation{obj.Location(), other.Location()},
+		})
+		require.True(t, metabase.ErrObjectPinned.Has(err), err)
+
+		objects, err := db.TestingAllObjects(ctx)
+		require.NoError(t, err)
+		require.Len(t, objects, 2)
+
+		require.NoError(t, db.DeleteObjectPin(ctx, metabase.DeleteObjectPin{ObjectPinLocation: location}))
+
+		result, err := db.DeleteObjectsAllVersions(ctx, metabase.DeleteObjectsAllVersions{
+			Locations: []metabase.ObjectLocation{obj.Location(), other.Location()},
+		})
+		require.NoError(t, err)
+		require.Len(t, result.Removed, 2)
+
+		streamIDs := []uuid.UUID{result.Removed[0].StreamID, result.Removed[1].StreamID}
+		require.ElementsMatch(t, []uuid.UUID{object.StreamID, otherObject.StreamID}, streamIDs)
+
+		metabasetest.Verify{}.Check(ctx, t, db)
+	})
+}
@@ -0,0 +1,474 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/storj/exp-spanner"
+	"github.com/zeebo/errs"
+	"google.golang.org/api/iterator"
+
+	"storj.io/storj/shared/dbutil/pgutil"
+)
+
+const (
+	// MaxObjectTags is the maximum number of tags of a single object version.
+	MaxObjectTags = 10
+	// MaxObjectTagKeyLength is the maximum length of an encrypted tag key.
+	MaxObjectTagKeyLength = 1024
+	// MaxObjectTagValueLength is the maximum length of an encrypted tag value.
+	MaxObjectTagValueLength = 2048
+)
+
+// ObjectTag is a key-value pair attached to an object version.
+//
+// Tags are stored as provided by the client, so that listings can compare them
+// without knowing the encryption key.
+//
+// On Postgres and Cockroach the tags are stored together with the stream ID of
+// the version and only used while that version exists, since they aren't
+// deleted together with it. DeleteOrphanedObjectTags removes them later. On
+// Spanner the tags are interleaved in the objects table and deleted with it.
+type ObjectTag struct {
+	Key   []byte
+	Value []byte
+}
+
+func verifyObjectTags(tags []ObjectTag, max int) error {
+	if len(tags) > max {
+		return ErrInvalidRequest.New("at most %d tags are allowed", max)
+	}
+	keys := make(map[string]struct{}, len(tags))
+	for _, tag := range tags {
+		switch {
+		case len(tag.Key) == 0:
+			return ErrInvalidRequest.New("tag key missing")
+		case len(tag.Key) > MaxObjectTagKeyLength:
+			return ErrInvalidRequest.New("tag key is longer than %d bytes", MaxObjectTagKeyLength)
+		case len(tag.Value) > MaxObjectTagValueLength:
+			return ErrInvalidRequest.New("tag value is longer than %d bytes", MaxObjectTagValueLength)
+		}
+		if _, ok := keys[string(tag.Key)]; ok {
+			return ErrInvalidRequest.New("duplicate tag key")
+		}
+		keys[string(tag.Key)] = struct{}{}
+	}
+	return nil
+}
+
+// ObjectTagsLocation specifies the object version, whose tags are accessed.
+// Zero Version refers to the latest committed version.
+type ObjectTagsLocation struct {
+	ObjectLocation
+	Version Version
+}
+
+// Verify verifies object tags location fields.
+func (opts *ObjectTagsLocation) Verify() error {
+	if err := opts.ObjectLocation.Verify(); err != nil {
+		return err
+	}
+	if opts.Version < 0 {
+		return ErrInvalidRequest.New("Version invalid: %v", opts.Version)
+	}
+	return nil
+}
+
+// PutObjectTags contains arguments for replacing the tags of an object version.
+type PutObjectTags struct {
+	ObjectTagsLocation
+	Tags []ObjectTag
+}
+
+// Verify verifies put object tags fields.
+func (opts *PutObjectTags) Verify() error {
+	if err := opts.ObjectTagsLocation.Verify(); err != nil {
+		return err
+	}
+	return verifyObjectTags(opts.Tags, MaxObjectTags)
+}
+
+// GetObjectTags contains arguments for getting the tags of an object version.
+type GetObjectTags struct {
+	ObjectTagsLocation
+}
+
+// DeleteObjectTags contains arguments for removing all tags of an object version.
+type DeleteObjectTags struct {
+	ObjectTagsLocation
+}
+
+type objectTagsTransactionAdapter interface {
+	objectTagsVersion(ctx context.Context, opts ObjectTagsLocation) (ObjectStream, error)
+	replaceObjectTags(ctx context.Context, object ObjectStream, tags []ObjectTag) error
+	getObjectTags(ctx context.Context, object ObjectStream) ([]ObjectTag, error)
+}
+
+// DeleteOrphanedObjectTags contains arguments for deleting the tags of deleted object versions.
+type DeleteOrphanedObjectTags struct {
+	BatchSize int
+}
+
+// PutObjectTags replaces the tags of a committed object version. Delete markers cannot be tagged.
+func (db *DB) PutObjectTags(ctx context.Context, opts PutObjectTags) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := opts.Verify(); err != nil {
+		return err
+	}
+
+	return db.ChooseAdapter(opts.ProjectID).withTxStats(ctx, "put_object_tags", func(ctx context.Context, adapter TransactionAdapter) error {
+		object, err := adapter.objectTagsVersion(ctx, opts.ObjectTagsLocation)
+		if err != nil {
+			return err
+		}
+		return adapter.replaceObjectTags(ctx, object, opts.Tags)
+	})
+}
+
+// DeleteObjectTags removes all tags of a committed object version.
+func (db *DB) DeleteObjectTags(ctx context.Context, opts DeleteObjectTags) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := opts.Verify(); err != nil {
+		return err
+	}
+
+	return db.ChooseAdapter(opts.ProjectID).withTxStats(ctx, "delete_object_tags", func(ctx context.Context, adapter TransactionAdapter) error {
+		object, err := adapter.objectTagsVersion(ctx, opts.ObjectTagsLocation)
+		if err != nil {
+			return err
+		}
+		return adapter.replaceObjectTags(ctx, object, nil)
+	})
+}
+
+// GetObjectTags returns the tags of a committed object version, sorted by key.
+func (db *DB) GetObjectTags(ctx context.Context, opts GetObjectTags) (tags []ObjectTag, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := opts.Verify(); err != nil {
+		return nil, err
+	}
+
+	err = db.ChooseAdapter(opts.ProjectID).WithTx(ctx, func(ctx context.Context, adapter TransactionAdapter) error {
+		object, err := adapter.objectTagsVersion(ctx, opts.ObjectTagsLocation)
+		if err != nil {
+			return err
+		}
+		tags, err = adapter.getObjectTags(ctx, object)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(tags, func(i, k int) bool {
+		return bytes.Compare(tags[i].Key, tags[k].Key) < 0
+	})
+	return tags, nil
+}
+
+// DeleteOrphanedObjectTags deletes the tags, whose object version doesn't exist
+// anymore, in batches. It returns the number of deleted tags.
+func (db *DB) DeleteOrphanedObjectTags(ctx context.Context, opts DeleteOrphanedObjectTags) (deleted int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	deleteBatchSizeLimit.Ensure(&opts.BatchSize)
+
+	for _, adapter := range db.adapters {
+		for {
+			batch, err := adapter.deleteOrphanedObjectTags(ctx, opts.BatchSize)
+			deleted += batch
+			if err != nil {
+				return deleted, err
+			}
+			if batch < int64(opts.BatchSize) {
+				break
+			}
+		}
+	}
+
+	mon.Meter("object_tags_orphaned_delete").Mark64(deleted)
+	return deleted, nil
+}
+
+func (p *PostgresAdapter) deleteOrphanedObjectTags(ctx context.Context, batchSize int) (deleted int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	result, err := p.db.ExecContext(ctx, `
+		WITH orphaned AS (
+			SELECT project_id, bucket_name, object_key, version, tag_key
+			FROM object_tags
+			WHERE NOT EXISTS (
+				SELECT 1 FROM objects
+				WHERE
+					(objects.project_id, objects.bucket_name, objects.object_key, objects.version) =
+						(object_tags.project_id, object_tags.bucket_name, object_tags.object_key, object_tags.version)
+					AND objects.stream_id = object_tags.stream_id
+			)
+			LIMIT $1
+		)
+		DELETE FROM object_tags
+		WHERE (project_id, bucket_name, object_key, version, tag_key) IN (SELECT * FROM orphaned)
+	`, batchSize)
+	if err != nil {
+		return 0, Error.New("unable to delete orphaned object tags: %w", err)
+	}
+
+	deleted, err = result.RowsAffected()
+	if err != nil {
+		return 0, Error.New("unable to delete orphaned object tags: %w", err)
+	}
+	return deleted, nil
+}
+
+// deleteOrphanedObjectTags is a no-op on Spanner, where the tags are interleaved
+// in the objects table and deleted together with their object.
+func (s *SpannerAdapter) deleteOrphanedObjectTags(ctx context.Context, batchSize int) (deleted int64, err error) {
+	return 0, nil
+}
+
+func (ptx *postgresTransactionAdapter) objectTagsVersion(ctx context.Context, opts ObjectTagsLocation) (object ObjectStream, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	object.ProjectID = opts.ProjectID
+	object.BucketName = opts.BucketName
+	object.ObjectKey = opts.ObjectKey
+
+	var status ObjectStatus
+	err = ptx.tx.QueryRowContext(ctx, `
+		SELECT version, stream_id, status
+		FROM objects
+		WHERE
+			(project_id, bucket_name, object_key) = ($1, $2, $3)
+			AND ($4 = 0 OR version = $4)
+			AND status <> `+statusPending+`
+			AND (expires_at IS NULL OR expires_at > now())
+		ORDER BY version DESC
+		LIMIT 1
+		FOR UPDATE
+	`, opts.ProjectID, []byte(opts.BucketName), opts.ObjectKey, opts.Version).Scan(&object.Version, &object.StreamID, &status)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ObjectStream{}, ErrObjectNotFound.Wrap(Error.New("object not found"))
+		}
+		return ObjectStream{}, Error.New("unable to query object: %w", err)
+	}
+	if status.IsDeleteMarker() {
+		return ObjectStream{}, ErrMethodNotAllowed.New("delete markers cannot be tagged")
+	}
+	return object, nil
+}
+
+func (ptx *postgresTransactionAdapter) replaceObjectTags(ctx context.Context, object ObjectStream, tags []ObjectTag) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	// the tags of a deleted version with the same location are replaced as well.
+	_, err = ptx.tx.ExecContext(ctx, `
+		DELETE FROM object_tags
+		WHERE (project_id, bucket_name, object_key, version) = ($1, $2, $3, $4)
+	`, object.ProjectID, []byte(object.BucketName), object.ObjectKey, object.Version)
+	if err != nil {
+		return Error.New("unable to delete object tags: %w", err)
+	}
+
+	if len(tags) == 0 {
+		return nil
+	}
+
+	keys := make([][]byte, len(tags))
+	values := make([][]byte, len(tags))
+	for i, tag := range tags {
+		keys[i], values[i] = tag.Key, tag.Value
+	}
+
+	_, err = ptx.tx.ExecContext(ctx, `
+		INSERT INTO object_tags (project_id, bucket_name, object_key, version, stream_id, tag_key, tag_value)
+		SELECT $1, $2, $3, $4, $5, tags.tag_key, tags.tag_value
+		FROM UNNEST($6::BYTEA[], $7::BYTEA[]) AS tags(tag_key, tag_value)
+	`, object.ProjectID, []byte(object.BucketName), object.ObjectKey, object.Version, object.StreamID,
+		pgutil.ByteaArray(keys), pgutil.ByteaArray(values))
+	if err != nil {
+		return Error.New("unable to insert object tags: %w", err)
+	}
+	return nil
+}
+
+func (ptx *postgresTransactionAdapter) getObjectTags(ctx context.Context, object ObjectStream) (tags []ObjectTag, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := ptx.tx.QueryContext(ctx, `
+		SELECT tag_key, tag_value
+		FROM object_tags
+		WHERE
+			(project_id, bucket_name, object_key, version) = ($1, $2, $3, $4)
+			AND stream_id = $5
+	`, object.ProjectID, []byte(object.BucketName), object.ObjectKey, object.Version, object.StreamID)
+	if err != nil {
+		return nil, Error.New("unable to query object tags: %w", err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	for rows.Next() {
+		var tag ObjectTag
+		if err := rows.Scan(&tag.Key, &tag.Value); err != nil {
+			return nil, Error.New("unable to read object tags: %w", err)
+		}
+		tags = append(tags, tag)
+	}
+	return tags, Error.Wrap(rows.Err())
+}
+
+func (stx *spannerTransactionAdapter) objectTagsVersion(ctx context.Context, opts ObjectTagsLocation) (object ObjectStream, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	object.ProjectID = opts.ProjectID
+	object.BucketName = opts.BucketName
+	object.ObjectKey = opts.ObjectKey
+
+	result := stx.tx.Query(ctx, spanner.Statement{
+		SQL: `
+			SELECT version, stream_id, status
+			FROM objects
+			WHERE
+				(project_id, bucket_name, object_key) = (@project_id, @bucket_name, @object_key)
+				AND (@version = 0 OR version = @version)
+				AND status <> ` + statusPending + `
+				AND (expires_at IS NULL OR expires_at > CURRENT_TIMESTAMP)
+			ORDER BY version DESC
+			LIMIT 1
+		`,
+		Params: map[string]interface{}{
+			"project_id":  opts.ProjectID,
+			"bucket_name": opts.BucketName,
+			"object_key":  opts.ObjectKey,
+			"version":     opts.Version,
+		},
+	})
+	defer result.Stop()
+
+	row, err := result.Next()
+	if err != nil {
+		if errors.Is(err, iterator.Done) {
+			return ObjectStream{}, ErrObjectNotFound.Wrap(Error.New("object not found"))
+		}
+		return ObjectStream{}, Error.New("unable to query object: %w", err)
+	}
+
+	var status ObjectStatus
+	if err := row.Columns(&object.Version, &object.StreamID, &status); err != nil {
+		return ObjectStream{}, Error.New("unable to read object: %w", err)
+	}
+	if status.IsDeleteMarker() {
+		return ObjectStream{}, ErrMethodNotAllowed.New("delete markers cannot be tagged")
+	}
+	return object, nil
+}
+
+func (stx *spannerTransactionAdapter) replaceObjectTags(ctx context.Context, object ObjectStream, tags []ObjectTag) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	mutations := []*spanner.Mutation{
+		spanner.Delete("object_tags", spanner.KeyRange{
+			Start: spanner.Key{object.ProjectID, object.BucketName, object.ObjectKey, object.Version},
+			End:   spanner.Key{object.ProjectID, object.BucketName, object.ObjectKey, object.Version},
+			Kind:  spanner.ClosedClosed,
+		}),
+	}
+	for _, tag := range tags {
+		mutations = append(mutations, spanner.Insert("object_tags",
+			[]string{"project_id", "bucket_name", "object_key", "version", "tag_key", "tag_value"},
+			[]interface{}{object.ProjectID, object.BucketName, object.ObjectKey, object.Version, tag.Key, tag.Value},
+		))
+	}
+
+	return Error.Wrap(stx.tx.BufferWrite(mutations))
+}
+
+func (stx *spannerTransactionAdapter) getObjectTags(ctx context.Context, object ObjectStream) (tags []ObjectTag, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	result := stx.tx.Query(ctx, spanner.Statement{
+		SQL: `
+			SELECT tag_key, tag_value
+			FROM object_tags
+			WHERE (project_id, bucket_name, object_key, version) = (@project_id, @bucket_name, @object_key, @version)
+		`,
+		Params: map[string]interface{}{
+			"project_id":  object.ProjectID,
+			"bucket_name": object.BucketName,
+			"object_key":  object.ObjectKey,
+			"version":     object.Version,
+		},
+	})
+	defer result.Stop()
+
+	for {
+		row, err := result.Next()
+		if err != nil {
+			if errors.Is(err, iterator.Done) {
+				return tags, nil
+			}
+			return nil, Error.New("unable to query object tags: %w", err)
+		}
+
+		var tag ObjectTag
+		if err := row.Columns(&tag.Key, &tag.Value); err != nil {
+			return nil, Error.New("unable to read object tags: %w", err)
+		}
+		tags = append(tags, tag)
+	}
+}
+
+// tagConditionsPostgres returns a boolean expression, which is true when the listed object
+// has all the tags, together with its arguments numbered from firstArg.
+func tagConditionsPostgres(tags []ObjectTag, firstArg int) (condition string, args []any) {
+	conditions := make([]string, 0, len(tags))
+	for i, tag := range tags {
+		keyArg := "$" + strconv.Itoa(firstArg+2*i)
+		valueArg := "$" + strconv.Itoa(firstArg+2*i+1)
+		conditions = append(conditions, `EXISTS (
+			SELECT 1 FROM object_tags
+			WHERE
+				(object_tags.project_id, object_tags.bucket_name, object_tags.object_key, object_tags.version) =
+					(objects.project_id, objects.bucket_name, objects.object_key, objects.version)
+				AND object_tags.stream_id = objects.stream_id
+				AND object_tags.tag_key = `+keyArg+`
+				AND object_tags.tag_value = `+valueArg+`
+		)`)
+		args = append(args, tag.Key, tag.Value)
+	}
+	return strings.Join(conditions, " AND "), args
+}
+
+// tagConditionsSpanner returns a boolean expression, which is true when the listed object
+// has all the tags, and adds its parameters to params.
+func tagConditionsSpanner(tags []ObjectTag, params map[string]any) (condition string) {
+	conditions := make([]string, 0, len(tags))
+	for i, tag := range tags {
+		keyParam := "tag_key_" + strconv.Itoa(i)
+		valueParam := "tag_value_" + strconv.Itoa(i)
+		conditions = append(conditions, `EXISTS (
+			SELECT 1 FROM object_tags
+			WHERE
+				object_tags.project_id = objects.project_id
+				AND object_tags.bucket_name = objects.bucket_name
+				AND object_tags.object_key = objects.object_key
+				AND object_tags.version = objects.version
+				AND object_tags.tag_key = @`+keyParam+`
+				AND object_tags.tag_value = @`+valueParam+`
+		)`)
+		params[keyParam] = tag.Key
+		params[valueParam] = tag.Value
+	}
+	return strings.Join(conditions, " AND ")
+}
@@ -0,0 +1,219 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/metabasetest"
+	"storj.io/storj/shared/dbutil"
+)
+
+func TestObjectTags(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		tags := []metabase.ObjectTag{
+			{Key: []byte("project"), Value: []byte("apollo")},
+			{Key: []byte("environment"), Value: []byte("production")},
+		}
+
+		t.Run("invalid tags", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			obj := metabasetest.RandObjectStream()
+			location := metabase.ObjectTagsLocation{ObjectLocation: obj.Location()}
+
+			for _, invalid := range [][]metabase.ObjectTag{
+				{{Key: nil, Value: []byte("value")}},
+				{{Key: []byte("a")}, {Key: []byte("a")}},
+				make([]metabase.ObjectTag, metabase.MaxObjectTags+1),
+			} {
+				err := db.PutObjectTags(ctx, metabase.PutObjectTags{ObjectTagsLocation: location, Tags: invalid})
+				require.True(t, metabase.ErrInvalidRequest.Has(err), err)
+			}
+		})
+
+		t.Run("object not found", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			obj := metabasetest.RandObjectStream()
+			err := db.PutObjectTags(ctx, metabase.PutObjectTags{
+				ObjectTagsLocation: metabase.ObjectTagsLocation{ObjectLocation: obj.Location()},
+				Tags:               tags,
+			})
+			require.True(t, metabase.ErrObjectNotFound.Has(err), err)
+
+			_, err = db.GetObjectTags(ctx, metabase.GetObjectTags{
+				ObjectTagsLocation: metabase.ObjectTagsLocation{ObjectLocation: obj.Location()},
+			})
+			require.True(t, metabase.ErrObjectNotFound.Has(err), err)
+		})
+
+		t.Run("put, get and delete", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			obj := metabasetest.RandObjectStream()
+			metabasetest.CreateObject(ctx, t, db, obj, 0)
+			location := metabase.ObjectTagsLocation{ObjectLocation: obj.Location()}
+
+			stored, err := db.GetObjectTags(ctx, metabase.GetObjectTags{ObjectTagsLocation: location})
+			require.NoError(t, err)
+			require.Empty(t, stored)
+
+			require.NoError(t, db.PutObjectTags(ctx, metabase.PutObjectTags{ObjectTagsLocation: location, Tags: tags}))
+
+			stored, err = db.GetObjectTags(ctx, metabase.GetObjectTags{ObjectTagsLocation: location})
+			require.NoError(t, err)
+			require.Equal(t, []metabase.ObjectTag{tags[1], tags[0]}, stored)
+
+			// putting tags replaces all of them.
+			require.NoError(t, db.PutObjectTags(ctx, metabase.PutObjectTags{ObjectTagsLocation: location, Tags: tags[:1]}))
+
+			stored, err = db.GetObjectTags(ctx, metabase.GetObjectTags{ObjectTagsLocation: location})
+			require.NoError(t, err)
+			require.Equal(t, tags[:1], stored)
+
+			require.NoError(t, db.DeleteObjectTags(ctx, metabase.DeleteObjectTags{ObjectTagsLocation: location}))
+
+			stored, err = db.GetObjectTags(ctx, metabase.GetObjectTags{ObjectTagsLocation: location})
+			require.NoError(t, err)
+			require.Empty(t, stored)
+		})
+
+		t.Run("versions", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			obj := metabasetest.RandObjectStream()
+			first := metabasetest.CreateObjectVersioned(ctx, t, db, obj, 0)
+			obj.Version++
+			second := metabasetest.CreateObjectVersioned(ctx, t, db, obj, 0)
+
+			require.NoError(t, db.PutObjectTags(ctx, metabase.PutObjectTags{
+				ObjectTagsLocation: metabase.ObjectTagsLocation{ObjectLocation: obj.Location(), Version: first.Version},
+				Tags:               tags[:1],
+			}))
+			// zero version refers to the latest one.
+			require.NoError(t, db.PutObjectTags(ctx, metabase.PutObjectTags{
+				ObjectTagsLocation: metabase.ObjectTagsLocation{ObjectLocation: obj.Location()},
+				Tags:               tags[1:],
+			}))
+
+			stored, err := db.GetObjectTags(ctx, metabase.GetObjectTags{
+				ObjectTagsLocation: metabase.ObjectTagsLocation{ObjectLocation: obj.Location(), Version: first.Version},
+			})
+			require.NoError(t, err)
+			require.Equal(t, tags[:1], stored)
+
+			stored, err = db.GetObjectTags(ctx, metabase.GetObjectTags{
+				ObjectTagsLocation: metabase.ObjectTagsLocation{ObjectLocation: obj.Location(), Version: second.Version},
+			})
+			require.NoError(t, err)
+			require.Equal(t, tags[1:], stored)
+
+			// tags of a deleted version don't apply to a new one with the same version.
+			_, err = db.DeleteObjectExactVersion(ctx, metabase.DeleteObjectExactVersion{
+				ObjectLocation: obj.Location(),
+				Version:        second.Version,
+			})
+			require.NoError(t, err)
+
+			obj.Version = second.Version
+			obj.StreamID = metabasetest.RandObjectStream().StreamID
+			metabasetest.CreateObjectVersioned(ctx, t, db, obj, 0)
+
+			stored, err = db.GetObjectTags(ctx, metabase.GetObjectTags{
+				ObjectTagsLocation: metabase.ObjectTagsLocation{ObjectLocation: obj.Location(), Version: second.Version},
+			})
+			require.NoError(t, err)
+			require.Empty(t, stored)
+
+			stored, err = db.GetObjectTags(ctx, metabase.GetObjectTags{
+				ObjectTagsLocation: metabase.ObjectTagsLocation{ObjectLocation: obj.Location(), Version: first.Version},
+			})
+			require.NoError(t, err)
+			require.Equal(t, tags[:1], stored)
+		})
+
+		t.Run("delete orphaned", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			obj := metabasetest.RandObjectStream()
+			object := metabasetest.CreateObject(ctx, t, db, obj, 0)
+
+			require.NoError(t, db.PutObjectTags(ctx, metabase.PutObjectTags{
+				ObjectTagsLocation: metabase.ObjectTagsLocation{ObjectLocation: obj.Location(), Version: object.Version},
+				Tags:               tags,
+			}))
+
+			deleted, err := db.DeleteOrphanedObjectTags(ctx, metabase.DeleteOrphanedObjectTags{BatchSize: 1})
+			require.NoError(t, err)
+			require.Zero(t, deleted)
+
+			_, err = db.DeleteObjectExactVersion(ctx, metabase.DeleteObjectExactVersion{
+				ObjectLocation: obj.Location(),
+				Version:        object.Version,
+			})
+			require.NoError(t, err)
+
+			deleted, err = db.DeleteOrphanedObjectTags(ctx, metabase.DeleteOrphanedObjectTags{BatchSize: 1})
+			require.NoError(t, err)
+			if db.Implementation() != dbutil.Spanner {
+				require.EqualValues(t, len(tags), deleted)
+			}
+
+			// a recreated version doesn't get the old tags back.
+			metabasetest.CreateObject(ctx, t, db, obj, 0)
+
+			stored, err := db.GetObjectTags(ctx, metabase.GetObjectTags{
+				ObjectTagsLocation: metabase.ObjectTagsLocation{ObjectLocation: obj.Location(), Version: object.Version},
+			})
+			require.NoError(t, err)
+			require.Empty(t, stored)
+		})
+
+		t.Run("list with tag conditions", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			tagged := metabasetest.RandObjectStream()
+			untagged := tagged
+			untagged.ObjectKey += "-untagged"
+			untagged.StreamID = metabasetest.RandObjectStream().StreamID
+
+			metabasetest.CreateObject(ctx, t, db, tagged, 0)
+			metabasetest.CreateObject(ctx, t, db, untagged, 0)
+
+			require.NoError(t, db.PutObjectTags(ctx, metabase.PutObjectTags{
+				ObjectTagsLocation: metabase.ObjectTagsLocation{ObjectLocation: tagged.Location()},
+				Tags:               tags,
+			}))
+
+			result, err := db.ListObjects(ctx, metabase.ListObjects{
+				ProjectID:     tagged.ProjectID,
+				BucketName:    tagged.BucketName,
+				Recursive:     true,
+				Limit:         10,
+				TagConditions: tags[:1],
+			})
+			require.NoError(t, err)
+			require.Len(t, result.Objects, 1)
+			require.Equal(t, tagged.ObjectKey, result.Objects[0].ObjectKey)
+
+			result, err = db.ListObjects(ctx, metabase.ListObjects{
+				ProjectID:  tagged.ProjectID,
+				BucketName: tagged.BucketName,
+				Recursive:  true,
+				Limit:      10,
+				TagConditions: []metabase.ObjectTag{
+					tags[0],
+					{Key: tags[1].Key, Value: []byte("staging")},
+				},
+			})
+			require.NoError(t, err)
+			require.Empty(t, result.Objects)
+		})
+	})
+}
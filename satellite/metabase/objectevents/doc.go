@@ -0,0 +1,15 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+/*
+Package objectevents contains the change data capture publisher for Postgres metabase.
+
+Spanner deployments capture object changes with change streams. On Postgres a trigger
+records them in the object_events outbox table, and the publisher chore periodically
+sends them to the configured sink and deletes them afterwards. Events are delivered at
+least once, ordered by the time the change happened. An event of a transaction which
+commits late may be delivered after events of changes that happened after it.
+
+When the publisher is disabled, the trigger is disabled as well.
+*/
+package objectevents
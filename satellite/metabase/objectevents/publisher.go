@@ -0,0 +1,138 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package objectevents
+
+import (
+	"context"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/sync2"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/shared/dbutil"
+)
+
+var (
+	// Error defines the object events publisher errors class.
+	Error = errs.Class("object events")
+	mon   = monkit.Package()
+)
+
+// Config contains configurable values for the object events publisher.
+type Config struct {
+	Enabled   bool          `help:"capture object create and delete events in postgres metabase and publish them to the sink" default:"false"`
+	Interval  time.Duration `help:"how frequently the captured events are published" default:"10s"`
+	BatchSize int           `help:"how many events are published at once" default:"1000"`
+	SinkURL   string        `help:"redis URL of the sink, where the events are added to a stream" default:""`
+	Stream    string        `help:"name of the redis stream the events are added to" default:"metabase-object-events"`
+	MaxLen    int64         `help:"approximate maximum length of the stream, 0 means unlimited" default:"1000000"`
+}
+
+// Sink receives the published events.
+type Sink interface {
+	// Publish publishes the events in order. It must either publish all of them or fail.
+	Publish(ctx context.Context, events []metabase.ObjectEvent) error
+	// Close closes the sink.
+	Close() error
+}
+
+// Publisher implements the chore which publishes the captured object events.
+//
+// architecture: Chore
+type Publisher struct {
+	log      *zap.Logger
+	config   Config
+	metabase *metabase.DB
+	sink     Sink
+
+	Loop *sync2.Cycle
+}
+
+// NewPublisher creates a new instance of the object events publisher. The sink may be
+// nil when the publisher is disabled.
+func NewPublisher(log *zap.Logger, config Config, metabase *metabase.DB, sink Sink) *Publisher {
+	return &Publisher{
+		log:      log,
+		config:   config,
+		metabase: metabase,
+		sink:     sink,
+
+		Loop: sync2.NewCycle(config.Interval),
+	}
+}
+
+// Run starts capturing the object events and publishing them. When the publisher
+// is disabled, it stops capturing the events on Postgres, so the outbox doesn't grow
+// without anyone consuming it.
+func (publisher *Publisher) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if !publisher.config.Enabled {
+		if publisher.metabase.Implementation() != dbutil.Postgres {
+			return nil
+		}
+		return Error.Wrap(publisher.metabase.EnableObjectEvents(ctx, false))
+	}
+
+	if err := publisher.metabase.EnableObjectEvents(ctx, true); err != nil {
+		return Error.Wrap(err)
+	}
+
+	return publisher.Loop.Run(ctx, func(ctx context.Context) error {
+		if err := publisher.Publish(ctx); err != nil {
+			publisher.log.Error("failed to publish object events", zap.Error(err))
+		}
+		return nil
+	})
+}
+
+// Publish publishes all the events captured so far.
+func (publisher *Publisher) Publish(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	for {
+		events, err := publisher.metabase.ListObjectEvents(ctx, metabase.ListObjectEvents{
+			Limit: publisher.config.BatchSize,
+		})
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		if len(events) == 0 {
+			return nil
+		}
+
+		if err := publisher.sink.Publish(ctx, events); err != nil {
+			return Error.Wrap(err)
+		}
+
+		// events are deleted only after publishing, hence a failure here
+		// results in publishing them again.
+		ids := make([]int64, len(events))
+		for i, event := range events {
+			ids[i] = event.ID
+		}
+		if _, err := publisher.metabase.DeleteObjectEvents(ctx, ids); err != nil {
+			return Error.Wrap(err)
+		}
+
+		mon.Meter("object_events_published").Mark(len(events))
+		mon.DurationVal("object_events_delay").Observe(time.Since(events[0].CreatedAt))
+
+		if len(events) < publisher.config.BatchSize {
+			return nil
+		}
+	}
+}
+
+// Close stops the publisher.
+func (publisher *Publisher) Close() error {
+	publisher.Loop.Close()
+	if publisher.sink == nil {
+		return nil
+	}
+	return Error.Wrap(publisher.sink.Close())
+}
@@ -0,0 +1,100 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package objectevents_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/testcontext"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/metabasetest"
+	"storj.io/storj/satellite/metabase/objectevents"
+	"storj.io/storj/shared/dbutil"
+)
+
+type memorySink struct {
+	fail   bool
+	events []metabase.ObjectEvent
+}
+
+func (sink *memorySink) Publish(ctx context.Context, events []metabase.ObjectEvent) error {
+	if sink.fail {
+		return errors.New("sink unavailable")
+	}
+	sink.events = append(sink.events, events...)
+	return nil
+}
+
+func (sink *memorySink) Close() error { return nil }
+
+func TestPublisher(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		if db.Implementation() != dbutil.Postgres {
+			t.Skip("object events are only captured on postgres")
+		}
+
+		require.NoError(t, db.EnableObjectEvents(ctx, true))
+		defer func() { require.NoError(t, db.EnableObjectEvents(ctx, false)) }()
+
+		sink := &memorySink{fail: true}
+		publisher := objectevents.NewPublisher(zaptest.NewLogger(t), objectevents.Config{
+			Enabled:   true,
+			BatchSize: 2,
+		}, db, sink)
+		defer ctx.Check(publisher.Close)
+
+		for i := 0; i < 5; i++ {
+			metabasetest.CreateObject(ctx, t, db, metabasetest.RandObjectStream(), 0)
+		}
+
+		// events stay in the outbox until they are published.
+		require.Error(t, publisher.Publish(ctx))
+
+		sink.fail = false
+		require.NoError(t, publisher.Publish(ctx))
+		require.Len(t, sink.events, 5)
+		for i, event := range sink.events {
+			require.Equal(t, metabase.ObjectCreated, event.Type)
+			if i > 0 {
+				require.Greater(t, event.ID, sink.events[i-1].ID)
+			}
+		}
+
+		events, err := db.ListObjectEvents(ctx, metabase.ListObjectEvents{})
+		require.NoError(t, err)
+		require.Empty(t, events)
+	})
+}
+
+func TestPublisher_Disabled(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		if db.Implementation() != dbutil.Postgres {
+			t.Skip("object events are only captured on postgres")
+		}
+
+		require.NoError(t, db.EnableObjectEvents(ctx, true))
+		defer func() { require.NoError(t, db.EnableObjectEvents(ctx, false)) }()
+
+		publisher := objectevents.NewPublisher(zaptest.NewLogger(t), objectevents.Config{}, db, nil)
+		defer ctx.Check(publisher.Close)
+
+		// a disabled publisher stops capturing the events.
+		require.NoError(t, publisher.Run(ctx))
+
+		enabled, err := db.ObjectEventsEnabled(ctx)
+		require.NoError(t, err)
+		require.False(t, enabled)
+
+		metabasetest.CreateObject(ctx, t, db, metabasetest.RandObjectStream(), 0)
+
+		events, err := db.ListObjectEvents(ctx, metabase.ListObjectEvents{})
+		require.NoError(t, err)
+		require.Empty(t, events)
+	})
+}
@@ -0,0 +1,64 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package objectevents
+
+import (
+	"context"
+
+	"github.com/redis/go-redis/v9"
+
+	"storj.io/storj/satellite/metabase"
+)
+
+// RedisStreamSink adds the events to a redis stream.
+type RedisStreamSink struct {
+	client *redis.Client
+	stream string
+	maxLen int64
+}
+
+// OpenRedisStreamSink connects to the redis at the URL.
+func OpenRedisStreamSink(url, stream string, maxLen int64) (*RedisStreamSink, error) {
+	opts, err := redis.ParseURL(url)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return &RedisStreamSink{
+		client: redis.NewClient(opts),
+		stream: stream,
+		maxLen: maxLen,
+	}, nil
+}
+
+// Publish adds the events to the stream in a single round trip.
+func (sink *RedisStreamSink) Publish(ctx context.Context, events []metabase.ObjectEvent) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = sink.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		for _, event := range events {
+			pipe.XAdd(ctx, &redis.XAddArgs{
+				Stream: sink.stream,
+				MaxLen: sink.maxLen,
+				Approx: true,
+				Values: map[string]interface{}{
+					"event":       event.Type.String(),
+					"project_id":  event.ProjectID.String(),
+					"bucket_name": event.BucketName,
+					"object_key":  []byte(event.ObjectKey),
+					"version":     int64(event.Version),
+					"stream_id":   event.StreamID.String(),
+					"status":      int(event.Status),
+					"created_at":  event.CreatedAt.UnixNano(),
+				},
+			})
+		}
+		return nil
+	})
+	return Error.Wrap(err)
+}
+
+// Close closes the connection.
+func (sink *RedisStreamSink) Close() error {
+	return Error.Wrap(sink.client.Close())
+}
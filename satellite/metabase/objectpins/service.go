@@ -0,0 +1,161 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package objectpins manages named pins of object versions, which hold the
+// pinned versions of a bucket, e.g. for litigation holds, until released.
+// It also lets operators undelete objects which were deleted by mistake.
+package objectpins
+
+import (
+	"context"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/metabase"
+)
+
+var (
+	// Error is the default error class for object pins.
+	Error = errs.Class("object pins")
+
+	mon = monkit.Package()
+)
+
+// Actor identifies who manages a pin in the audit log.
+type Actor struct {
+	// Source is the interface used for the change, e.g. "admin" or "console".
+	Source string
+	// Email identifies the operator or user.
+	Email string
+}
+
+// Service creates, lists and releases object pins, and undeletes objects.
+// Every change is written to the audit log.
+//
+// architecture: Service
+type Service struct {
+	log      *zap.Logger
+	auditLog *zap.Logger
+	metabase *metabase.DB
+}
+
+// NewService creates a new object pins service.
+func NewService(log *zap.Logger, metabase *metabase.DB) *Service {
+	return &Service{
+		log:      log,
+		auditLog: log.Named("auditlog"),
+		metabase: metabase,
+	}
+}
+
+// Create pins the object versions under a name, which must be unique within the bucket.
+func (service *Service) Create(ctx context.Context, actor Actor, opts metabase.CreateObjectPin) (_ metabase.ObjectPin, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	pin, err := service.metabase.CreateObjectPin(ctx, opts)
+	if err != nil {
+		service.audit(actor, "create object pin failed", opts.ObjectPinLocation,
+			zap.Int("Versions", len(opts.Versions)), zap.Error(err))
+		return metabase.ObjectPin{}, Error.Wrap(err)
+	}
+
+	service.audit(actor, "create object pin", opts.ObjectPinLocation, zap.Int("Versions", len(opts.Versions)))
+	return pin, nil
+}
+
+// Get returns a pin together with its versions.
+func (service *Service) Get(ctx context.Context, location metabase.ObjectPinLocation) (_ metabase.ObjectPin, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	pin, err := service.metabase.GetObjectPin(ctx, metabase.GetObjectPin{ObjectPinLocation: location})
+	if err != nil {
+		return metabase.ObjectPin{}, Error.Wrap(err)
+	}
+	return pin, nil
+}
+
+// List returns the pins of a bucket without their versions.
+func (service *Service) List(ctx context.Context, projectID uuid.UUID, bucketName string) (_ []metabase.ObjectPin, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	pins, err := service.metabase.ListObjectPins(ctx, metabase.ListObjectPins{
+		ProjectID:  projectID,
+		BucketName: bucketName,
+	})
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return pins, nil
+}
+
+// ComplianceReport returns the versions under an active compliance retention
+// or held by pins for every bucket of the project, or only for the bucket
+// when bucketName is set.
+func (service *Service) ComplianceReport(ctx context.Context, projectID uuid.UUID, bucketName string) (_ []metabase.ObjectLockBucketReport, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	report, err := service.metabase.GetObjectLockReport(ctx, metabase.GetObjectLockReport{
+		ProjectID:  projectID,
+		BucketName: bucketName,
+	})
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return report, nil
+}
+
+// Release removes a pin, so its versions can be deleted or expire again.
+func (service *Service) Release(ctx context.Context, actor Actor, location metabase.ObjectPinLocation) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = service.metabase.DeleteObjectPin(ctx, metabase.DeleteObjectPin{ObjectPinLocation: location})
+	if err != nil {
+		service.audit(actor, "release object pin failed", location, zap.Error(err))
+		return Error.Wrap(err)
+	}
+
+	service.audit(actor, "release object pin", location)
+	return nil
+}
+
+// Undelete removes the delete marker, which is the latest version of the
+// object, so the version it hides becomes visible again.
+func (service *Service) Undelete(ctx context.Context, actor Actor, location metabase.ObjectLocation) (_ metabase.UndeleteObjectResult, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	result, err := service.metabase.UndeleteObject(ctx, metabase.UndeleteObject{ObjectLocation: location})
+	if err != nil {
+		service.auditObject(actor, "undelete object failed", location, zap.Error(err))
+		return metabase.UndeleteObjectResult{}, Error.Wrap(err)
+	}
+
+	service.auditObject(actor, "undelete object", location, zap.Int64("delete marker version", int64(result.DeleteMarker.Version)))
+	return result, nil
+}
+
+func (service *Service) auditObject(actor Actor, operation string, location metabase.ObjectLocation, extra ...zap.Field) {
+	fields := append([]zap.Field{
+		zap.String("operation", operation),
+		zap.String("source", actor.Source),
+		zap.String("email", actor.Email),
+		zap.Stringer("project", location.ProjectID),
+		zap.String("bucket", location.BucketName),
+		zap.Binary("encrypted key", []byte(location.ObjectKey)),
+	}, extra...)
+	service.auditLog.Info("object activity", fields...)
+}
+
+func (service *Service) audit(actor Actor, operation string, location metabase.ObjectPinLocation, extra ...zap.Field) {
+	fields := append([]zap.Field{
+		zap.String("operation", operation),
+		zap.String("source", actor.Source),
+		zap.String("email", actor.Email),
+		zap.Stringer("project", location.ProjectID),
+		zap.String("bucket", location.BucketName),
+		zap.String("pin", location.Name),
+	}, extra...)
+	service.auditLog.Info("object pin activity", fields...)
+}
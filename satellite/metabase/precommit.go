@@ -7,8 +7,10 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"time"
 
 	spanner "github.com/storj/exp-spanner"
+	"github.com/zeebo/errs"
 	"go.uber.org/zap"
 	"google.golang.org/api/iterator"
 
@@ -20,6 +22,11 @@ type precommitTransactionAdapter interface {
 	precommitQueryHighest(ctx context.Context, loc ObjectLocation) (highest Version, err error)
 	precommitQueryHighestAndUnversioned(ctx context.Context, loc ObjectLocation) (highest Version, unversionedExists bool, err error)
 	precommitDeleteUnversioned(ctx context.Context, loc ObjectLocation) (result PrecommitConstraintResult, err error)
+	precommitProjectUsage(ctx context.Context, projectID uuid.UUID) (objects, segments int64, err error)
+	precommitLockQuotaCounter(ctx context.Context, projectID uuid.UUID) (counter quotaCounter, err error)
+	precommitUpdateQuotaCounter(ctx context.Context, projectID uuid.UUID, counter quotaCounter) (err error)
+
+	precommitBatchTransactionAdapter
 }
 
 // PrecommitConstraint is arguments to ensure that a single unversioned object or delete marker exists in the
@@ -31,6 +38,22 @@ type PrecommitConstraint struct {
 	DisallowDelete bool
 
 	PrecommitDeleteMode int
+
+	// Quota, when set, is checked within the same transaction after the
+	// constraint has been applied, so concurrent commits cannot exceed it.
+	Quota *PrecommitQuota
+	// NewSegments is the number of segments the committed object adds to the project.
+	NewSegments int64
+}
+
+// ErrProjectQuotaExceeded is used to indicate that committing the object would exceed the PrecommitQuota.
+var ErrProjectQuotaExceeded = errs.Class("metabase: project quota exceeded")
+
+// PrecommitQuota contains the per-project limits checked by PrecommitConstraint.
+// A zero limit means that the limit is not enforced.
+type PrecommitQuota struct {
+	MaxObjects  int64
+	MaxSegments int64
 }
 
 // PrecommitConstraintResult returns the result of enforcing precommit constraint.
@@ -62,6 +85,21 @@ func (db *DB) PrecommitConstraint(ctx context.Context, opts PrecommitConstraint,
 		return result, Error.Wrap(err)
 	}
 
+	result, err = db.precommitConstraint(ctx, opts, adapter)
+	if err != nil {
+		return PrecommitConstraintResult{}, err
+	}
+
+	if opts.Quota != nil {
+		if err := db.precommitCheckQuota(ctx, opts, result, adapter); err != nil {
+			return PrecommitConstraintResult{}, err
+		}
+	}
+
+	return result, nil
+}
+
+func (db *DB) precommitConstraint(ctx context.Context, opts PrecommitConstraint, adapter precommitTransactionAdapter) (result PrecommitConstraintResult, err error) {
 	if opts.Versioned {
 		highest, err := adapter.precommitQueryHighest(ctx, opts.Location)
 		if err != nil {
@@ -91,6 +129,91 @@ func (db *DB) PrecommitConstraint(ctx context.Context, opts PrecommitConstraint,
 	}
 }
 
+// quotaCounterRecountInterval is how often the project usage counter used for
+// checking PrecommitQuota is recounted from the objects table. The counter is only
+// maintained by commits with a quota, so objects deleted or created by other means
+// are not reflected in it until the next recount.
+const quotaCounterRecountInterval = time.Hour
+
+// quotaCounter is the committed object and segment usage of a project, as tracked
+// by the project_quota_counters table.
+type quotaCounter struct {
+	Objects  int64
+	Segments int64
+
+	// RecountedAt is when the counter was last set from the objects table.
+	// It's zero when the counter has never been recounted.
+	RecountedAt time.Time
+}
+
+// precommitCheckQuota verifies that committing the object doesn't exceed the project quota.
+//
+// The project counter row is locked for the rest of the transaction, which serializes
+// the commits with a quota within a project. Otherwise two concurrent commits could both
+// see the usage right below the limit and both succeed.
+//
+// Deletions are not tracked by the counter, so it usually overestimates the usage.
+// When the limit would be exceeded according to the counter, the usage is recounted
+// before rejecting the commit. The object being committed isn't counted by the recount,
+// since it's still pending.
+func (db *DB) precommitCheckQuota(ctx context.Context, opts PrecommitConstraint, precommit PrecommitConstraintResult, adapter precommitTransactionAdapter) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	projectID := opts.Location.ProjectID
+
+	counter, err := adapter.precommitLockQuotaCounter(ctx, projectID)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	recount := func() error {
+		mon.Event("precommit_quota_recount")
+		objects, segments, err := adapter.precommitProjectUsage(ctx, projectID)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		counter = quotaCounter{Objects: objects, Segments: segments, RecountedAt: time.Now()}
+		return nil
+	}
+
+	recounted := false
+	if counter.RecountedAt.IsZero() || time.Since(counter.RecountedAt) > quotaCounterRecountInterval {
+		if err := recount(); err != nil {
+			return err
+		}
+		recounted = true
+	} else {
+		counter.Objects -= int64(precommit.DeletedObjectCount)
+		counter.Segments -= int64(precommit.DeletedSegmentCount)
+	}
+
+	exceedsObjects := func() bool {
+		return opts.Quota.MaxObjects > 0 && counter.Objects+1 > opts.Quota.MaxObjects
+	}
+	exceedsSegments := func() bool {
+		return opts.Quota.MaxSegments > 0 && counter.Segments+opts.NewSegments > opts.Quota.MaxSegments
+	}
+
+	if !recounted && (exceedsObjects() || exceedsSegments()) {
+		if err := recount(); err != nil {
+			return err
+		}
+	}
+
+	if exceedsObjects() {
+		mon.Event("precommit_quota_objects_exceeded")
+		return ErrProjectQuotaExceeded.New("exceeded object limit: %d", opts.Quota.MaxObjects)
+	}
+	if exceedsSegments() {
+		mon.Event("precommit_quota_segments_exceeded")
+		return ErrProjectQuotaExceeded.New("exceeded segment limit: %d", opts.Quota.MaxSegments)
+	}
+
+	counter.Objects++
+	counter.Segments += opts.NewSegments
+	return Error.Wrap(adapter.precommitUpdateQuotaCounter(ctx, projectID, counter))
+}
+
 // precommitQueryHighest queries the highest version for a given object.
 func (ptx *postgresTransactionAdapter) precommitQueryHighest(ctx context.Context, loc ObjectLocation) (highest Version, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -240,6 +363,7 @@ func (ptx *postgresTransactionAdapter) precommitDeleteUnversioned(ctx context.Co
 	var status sql.NullByte
 	var encryptionParams nullableValue[encryptionParameters]
 	encryptionParams.value.EncryptionParameters = &deleted.Encryption
+	var pinned bool
 
 	err = ptx.tx.QueryRowContext(ctx, `
 		WITH highest_object AS (
@@ -253,6 +377,7 @@ func (ptx *postgresTransactionAdapter) precommitDeleteUnversioned(ctx context.Co
 			WHERE
 				(project_id, bucket_name, object_key) = ($1, $2, $3)
 				AND status IN `+statusesUnversioned+`
+				AND `+objectNotPinned+`
 			RETURNING
 				version, stream_id,
 				created_at, expires_at,
@@ -281,7 +406,13 @@ func (ptx *postgresTransactionAdapter) precommitDeleteUnversioned(ctx context.Co
 			(SELECT encryption FROM deleted_objects),
 			(SELECT count(*) FROM deleted_objects),
 			(SELECT count(*) FROM deleted_segments),
-			coalesce((SELECT version FROM highest_object), 0)
+			coalesce((SELECT version FROM highest_object), 0),
+			EXISTS (
+				SELECT 1 FROM objects
+				WHERE (project_id, bucket_name, object_key) = ($1, $2, $3)
+					AND status IN `+statusesUnversioned+`
+					AND `+objectPinned+`
+			)
 	`, loc.ProjectID, []byte(loc.BucketName), loc.ObjectKey).
 		Scan(
 			&version,
@@ -300,11 +431,15 @@ func (ptx *postgresTransactionAdapter) precommitDeleteUnversioned(ctx context.Co
 			&result.DeletedObjectCount,
 			&result.DeletedSegmentCount,
 			&result.HighestVersion,
+			&pinned,
 		)
 
 	if err != nil {
 		return PrecommitConstraintResult{}, Error.Wrap(err)
 	}
+	if pinned {
+		return PrecommitConstraintResult{}, ErrObjectPinned.New("%q", loc.ObjectKey)
+	}
 
 	// If there are no objects with the given (project_id, bucket_name, object_key),
 	// all of the values queried from deleted_objects will be NULL. We must not
@@ -353,14 +488,24 @@ func (ptx *postgresTransactionAdapter) precommitDeleteUnversioned(ctx context.Co
 func (stx *spannerTransactionAdapter) precommitDeleteUnversioned(ctx context.Context, loc ObjectLocation) (result PrecommitConstraintResult, err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	var pinned bool
 	err = func() error {
 		iter := stx.tx.Query(ctx, spanner.Statement{
 			SQL: `
-				SELECT version
-				FROM objects
-				WHERE (project_id, bucket_name, object_key) = (@project_id, @bucket_name, @object_key)
-				ORDER BY version DESC
-				LIMIT 1
+				SELECT
+					COALESCE((
+						SELECT version
+						FROM objects
+						WHERE (project_id, bucket_name, object_key) = (@project_id, @bucket_name, @object_key)
+						ORDER BY version DESC
+						LIMIT 1
+					), 0),
+					EXISTS (
+						SELECT 1 FROM objects
+						WHERE (project_id, bucket_name, object_key) = (@project_id, @bucket_name, @object_key)
+							AND status IN ` + statusesUnversioned + `
+							AND ` + objectPinned + `
+					)
 			`,
 			Params: map[string]interface{}{
 				"project_id":  loc.ProjectID,
@@ -372,18 +517,17 @@ func (stx *spannerTransactionAdapter) precommitDeleteUnversioned(ctx context.Con
 
 		row, err := iter.Next()
 		if err != nil {
-			if errors.Is(err, iterator.Done) {
-				result.HighestVersion = 0
-				return nil
-			}
 			return Error.Wrap(err)
 		}
-		err = row.Columns(&result.HighestVersion)
+		err = row.Columns(&result.HighestVersion, &pinned)
 		return Error.Wrap(err)
 	}()
 	if err != nil {
 		return PrecommitConstraintResult{}, err
 	}
+	if pinned {
+		return PrecommitConstraintResult{}, ErrObjectPinned.New("%q", loc.ObjectKey)
+	}
 
 	err = func() error {
 		iter := stx.tx.Query(ctx, spanner.Statement{
@@ -394,6 +538,7 @@ func (stx *spannerTransactionAdapter) precommitDeleteUnversioned(ctx context.Con
 					AND bucket_name = @bucket_name
 					AND object_key  = @object_key
 					AND status IN ` + statusesUnversioned + `
+					AND ` + objectNotPinned + `
 				THEN RETURN
 					version, stream_id,
 					created_at, expires_at,
@@ -469,6 +614,141 @@ func (stx *spannerTransactionAdapter) precommitDeleteUnversioned(ctx context.Con
 	return result, Error.Wrap(err)
 }
 
+// precommitProjectUsage returns the number of committed objects and their segments in the project.
+func (ptx *postgresTransactionAdapter) precommitProjectUsage(ctx context.Context, projectID uuid.UUID) (objects, segments int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = ptx.tx.QueryRowContext(ctx, `
+		SELECT count(*), COALESCE(sum(segment_count), 0)
+		FROM objects
+		WHERE project_id = $1 AND status <> `+statusPending+`
+	`, projectID).Scan(&objects, &segments)
+	if err != nil {
+		return 0, 0, Error.Wrap(err)
+	}
+	return objects, segments, nil
+}
+
+func (stx *spannerTransactionAdapter) precommitProjectUsage(ctx context.Context, projectID uuid.UUID) (objects, segments int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	iter := stx.tx.Query(ctx, spanner.Statement{
+		SQL: `
+			SELECT count(*), COALESCE(sum(segment_count), 0)
+			FROM objects
+			WHERE project_id = @project_id AND status <> ` + statusPending + `
+		`,
+		Params: map[string]interface{}{
+			"project_id": projectID,
+		},
+	})
+	defer iter.Stop()
+
+	row, err := iter.Next()
+	if err != nil {
+		return 0, 0, Error.Wrap(err)
+	}
+	if err := row.Columns(&objects, &segments); err != nil {
+		return 0, 0, Error.Wrap(err)
+	}
+	return objects, segments, nil
+}
+
+// precommitLockQuotaCounter returns the quota counter of the project and locks it
+// until the end of the transaction.
+func (ptx *postgresTransactionAdapter) precommitLockQuotaCounter(ctx context.Context, projectID uuid.UUID) (counter quotaCounter, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	// the row needs to exist for SELECT ... FOR UPDATE to lock it.
+	_, err = ptx.tx.ExecContext(ctx, `
+		INSERT INTO project_quota_counters (project_id) VALUES ($1)
+		ON CONFLICT (project_id) DO NOTHING
+	`, projectID)
+	if err != nil {
+		return quotaCounter{}, Error.Wrap(err)
+	}
+
+	var recountedAt sql.NullTime
+	err = ptx.tx.QueryRowContext(ctx, `
+		SELECT object_count, segment_count, recounted_at
+		FROM project_quota_counters
+		WHERE project_id = $1
+		FOR UPDATE
+	`, projectID).Scan(&counter.Objects, &counter.Segments, &recountedAt)
+	if err != nil {
+		return quotaCounter{}, Error.Wrap(err)
+	}
+	if recountedAt.Valid {
+		counter.RecountedAt = recountedAt.Time
+	}
+	return counter, nil
+}
+
+func (stx *spannerTransactionAdapter) precommitLockQuotaCounter(ctx context.Context, projectID uuid.UUID) (counter quotaCounter, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	// reads within a read-write transaction acquire locks, so a concurrent
+	// commit updating the same counter row is aborted and retried.
+	iter := stx.tx.Query(ctx, spanner.Statement{
+		SQL: `
+			SELECT object_count, segment_count, recounted_at
+			FROM project_quota_counters
+			WHERE project_id = @project_id
+		`,
+		Params: map[string]interface{}{
+			"project_id": projectID,
+		},
+	})
+	defer iter.Stop()
+
+	row, err := iter.Next()
+	if err != nil {
+		if errors.Is(err, iterator.Done) {
+			return quotaCounter{}, nil
+		}
+		return quotaCounter{}, Error.Wrap(err)
+	}
+
+	var recountedAt spanner.NullTime
+	if err := row.Columns(&counter.Objects, &counter.Segments, &recountedAt); err != nil {
+		return quotaCounter{}, Error.Wrap(err)
+	}
+	if recountedAt.Valid {
+		counter.RecountedAt = recountedAt.Time
+	}
+	return counter, nil
+}
+
+// precommitUpdateQuotaCounter stores the quota counter of the project.
+func (ptx *postgresTransactionAdapter) precommitUpdateQuotaCounter(ctx context.Context, projectID uuid.UUID, counter quotaCounter) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = ptx.tx.ExecContext(ctx, `
+		UPDATE project_quota_counters
+		SET object_count = $2, segment_count = $3, recounted_at = $4
+		WHERE project_id = $1
+	`, projectID, counter.Objects, counter.Segments, counter.RecountedAt)
+	return Error.Wrap(err)
+}
+
+func (stx *spannerTransactionAdapter) precommitUpdateQuotaCounter(ctx context.Context, projectID uuid.UUID, counter quotaCounter) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = stx.tx.Update(ctx, spanner.Statement{
+		SQL: `
+			INSERT OR UPDATE INTO project_quota_counters (project_id, object_count, segment_count, recounted_at)
+			VALUES (@project_id, @object_count, @segment_count, @recounted_at)
+		`,
+		Params: map[string]interface{}{
+			"project_id":    projectID,
+			"object_count":  counter.Objects,
+			"segment_count": counter.Segments,
+			"recounted_at":  counter.RecountedAt,
+		},
+	})
+	return Error.Wrap(err)
+}
+
 // PrecommitConstraintWithNonPendingResult contains the result for enforcing precommit constraint.
 type PrecommitConstraintWithNonPendingResult struct {
 	Deleted []Object
@@ -512,6 +792,7 @@ func (ptx *postgresTransactionAdapter) PrecommitDeleteUnversionedWithNonPending(
 	var status sql.NullByte
 	var encryptionParams nullableValue[encryptionParameters]
 	encryptionParams.value.EncryptionParameters = &deleted.Encryption
+	var pinned bool
 
 	err = ptx.tx.QueryRowContext(ctx, `
 		WITH highest_object AS (
@@ -532,6 +813,7 @@ func (ptx *postgresTransactionAdapter) PrecommitDeleteUnversionedWithNonPending(
 			WHERE
 				(project_id, bucket_name, object_key) = ($1, $2, $3)
 				AND status IN `+statusesUnversioned+`
+				AND `+objectNotPinned+`
 			RETURNING
 				version, stream_id,
 				created_at, expires_at,
@@ -561,7 +843,13 @@ func (ptx *postgresTransactionAdapter) PrecommitDeleteUnversionedWithNonPending(
 			(SELECT count(*) FROM deleted_objects),
 			(SELECT count(*) FROM deleted_segments),
 			coalesce((SELECT version FROM highest_object), 0),
-			coalesce((SELECT version FROM highest_non_pending_object), 0)
+			coalesce((SELECT version FROM highest_non_pending_object), 0),
+			EXISTS (
+				SELECT 1 FROM objects
+				WHERE (project_id, bucket_name, object_key) = ($1, $2, $3)
+					AND status IN `+statusesUnversioned+`
+					AND `+objectPinned+`
+			)
 	`, loc.ProjectID, []byte(loc.BucketName), loc.ObjectKey).
 		Scan(
 			&version,
@@ -581,11 +869,15 @@ func (ptx *postgresTransactionAdapter) PrecommitDeleteUnversionedWithNonPending(
 			&result.DeletedSegmentCount,
 			&result.HighestVersion,
 			&result.HighestNonPendingVersion,
+			&pinned,
 		)
 
 	if err != nil {
 		return PrecommitConstraintWithNonPendingResult{}, Error.Wrap(err)
 	}
+	if pinned {
+		return PrecommitConstraintWithNonPendingResult{}, ErrObjectPinned.New("%q", loc.ObjectKey)
+	}
 
 	deleted.ProjectID = loc.ProjectID
 	deleted.BucketName = loc.BucketName
@@ -644,7 +936,13 @@ func (stx *spannerTransactionAdapter) PrecommitDeleteUnversionedWithNonPending(c
 			)
 			SELECT
 				COALESCE((SELECT version FROM highest_object), 0) AS highest,
-				COALESCE((SELECT version FROM highest_non_pending_object), 0) AS highest_non_pending
+				COALESCE((SELECT version FROM highest_non_pending_object), 0) AS highest_non_pending,
+				EXISTS (
+					SELECT 1 FROM objects
+					WHERE (project_id, bucket_name, object_key) = (@project_id, @bucket_name, @object_key)
+						AND status IN ` + statusesUnversioned + `
+						AND ` + objectPinned + `
+				) AS pinned
 		`,
 		Params: map[string]interface{}{
 			"project_id":  loc.ProjectID,
@@ -658,10 +956,14 @@ func (stx *spannerTransactionAdapter) PrecommitDeleteUnversionedWithNonPending(c
 	if err != nil {
 		return PrecommitConstraintWithNonPendingResult{}, Error.New("could not get existing object versions: %w", err)
 	}
-	err = row.Columns(&result.HighestVersion, &result.HighestNonPendingVersion)
+	var pinned bool
+	err = row.Columns(&result.HighestVersion, &result.HighestNonPendingVersion, &pinned)
 	if err != nil {
 		return PrecommitConstraintWithNonPendingResult{}, Error.New("could not read existing object versions: %w", err)
 	}
+	if pinned {
+		return PrecommitConstraintWithNonPendingResult{}, ErrObjectPinned.New("%q", loc.ObjectKey)
+	}
 
 	objectDeletion := spanner.Statement{
 		SQL: `
@@ -669,6 +971,7 @@ func (stx *spannerTransactionAdapter) PrecommitDeleteUnversionedWithNonPending(c
 			WHERE
 				(project_id, bucket_name, object_key) = (@project_id, @bucket_name, @object_key)
 				AND status IN ` + statusesUnversioned + `
+				AND ` + objectNotPinned + `
 			THEN RETURN
 				version, stream_id,
 				created_at, expires_at,
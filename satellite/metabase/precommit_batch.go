@@ -0,0 +1,481 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	spanner "github.com/storj/exp-spanner"
+	"go.uber.org/zap"
+	"google.golang.org/api/iterator"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/shared/dbutil/pgutil"
+	"storj.io/storj/shared/dbutil/spannerutil"
+	"storj.io/storj/shared/tagsql"
+)
+
+// PrecommitConstraintBatchMaxKeys is the maximum number of object keys handled by a single PrecommitConstraintBatch.
+const PrecommitConstraintBatchMaxKeys = 1000
+
+type precommitBatchTransactionAdapter interface {
+	precommitQueryHighestBatch(ctx context.Context, bucket BucketLocation, objectKeys [][]byte) (highest map[ObjectKey]Version, err error)
+	precommitQueryHighestAndUnversionedBatch(ctx context.Context, bucket BucketLocation, objectKeys [][]byte) (highest map[ObjectKey]Version, unversioned []ObjectKey, err error)
+	precommitDeleteUnversionedBatch(ctx context.Context, bucket BucketLocation, objectKeys [][]byte) (result PrecommitConstraintBatchResult, err error)
+	pinnedObjectVersions(ctx context.Context, projectID uuid.UUID, bucketName string, objectKeys [][]byte) (pinned []Object, err error)
+}
+
+// PrecommitConstraintBatch is arguments to ensure that a single unversioned object or delete marker exists
+// per object location, for multiple objects in the same bucket.
+type PrecommitConstraintBatch struct {
+	Bucket     BucketLocation
+	ObjectKeys []ObjectKey
+
+	Versioned      bool
+	DisallowDelete bool
+}
+
+// Verify verifies precommit constraint batch fields.
+func (opts *PrecommitConstraintBatch) Verify() error {
+	if err := opts.Bucket.Verify(); err != nil {
+		return err
+	}
+	switch {
+	case len(opts.ObjectKeys) == 0:
+		return ErrInvalidRequest.New("ObjectKeys missing")
+	case len(opts.ObjectKeys) > PrecommitConstraintBatchMaxKeys:
+		return ErrInvalidRequest.New("ObjectKeys contains more than %d keys", PrecommitConstraintBatchMaxKeys)
+	}
+	for _, key := range opts.ObjectKeys {
+		if len(key) == 0 {
+			return ErrInvalidRequest.New("ObjectKeys contains empty key")
+		}
+	}
+	return nil
+}
+
+// PrecommitConstraintBatchResult returns the result of enforcing precommit constraint for multiple objects.
+type PrecommitConstraintBatchResult struct {
+	Deleted []Object
+
+	// DeletedObjectCount returns how many objects were deleted.
+	DeletedObjectCount int
+	// DeletedSegmentCount returns how many segments were deleted.
+	DeletedSegmentCount int
+
+	// HighestVersions returns the highest version that was present in the table for each object key.
+	// Keys without any objects are missing from the map.
+	HighestVersions map[ObjectKey]Version
+}
+
+func (r *PrecommitConstraintBatchResult) submitMetrics() {
+	mon.Meter("object_delete").Mark(r.DeletedObjectCount)
+	mon.Meter("segment_delete").Mark(r.DeletedSegmentCount)
+}
+
+// PrecommitConstraintBatch ensures that only a single uncommitted object exists at each of the specified
+// locations. It's equivalent to calling PrecommitConstraint for each of the object keys, but uses a
+// constant number of queries.
+func (db *DB) PrecommitConstraintBatch(ctx context.Context, opts PrecommitConstraintBatch, adapter precommitTransactionAdapter) (result PrecommitConstraintBatchResult, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := opts.Verify(); err != nil {
+		return result, Error.Wrap(err)
+	}
+
+	objectKeys := make([][]byte, 0, len(opts.ObjectKeys))
+	seen := make(map[ObjectKey]struct{}, len(opts.ObjectKeys))
+	for _, key := range opts.ObjectKeys {
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+		objectKeys = append(objectKeys, []byte(key))
+	}
+
+	if opts.Versioned {
+		highest, err := adapter.precommitQueryHighestBatch(ctx, opts.Bucket, objectKeys)
+		if err != nil {
+			return PrecommitConstraintBatchResult{}, Error.Wrap(err)
+		}
+		result.HighestVersions = highest
+		return result, nil
+	}
+
+	if opts.DisallowDelete {
+		highest, unversioned, err := adapter.precommitQueryHighestAndUnversionedBatch(ctx, opts.Bucket, objectKeys)
+		if err != nil {
+			return PrecommitConstraintBatchResult{}, Error.Wrap(err)
+		}
+		if len(unversioned) > 0 {
+			return PrecommitConstraintBatchResult{}, ErrPermissionDenied.New("no permissions to delete existing object")
+		}
+		result.HighestVersions = highest
+		return result, nil
+	}
+
+	pinned, err := adapter.pinnedObjectVersions(ctx, opts.Bucket.ProjectID, opts.Bucket.BucketName, objectKeys)
+	if err != nil {
+		return PrecommitConstraintBatchResult{}, err
+	}
+	if err := pinnedObjectError(pinned, func(object Object) bool { return object.Status == CommittedUnversioned }); err != nil {
+		return PrecommitConstraintBatchResult{}, err
+	}
+
+	result, err = adapter.precommitDeleteUnversionedBatch(ctx, opts.Bucket, objectKeys)
+	if err != nil {
+		return PrecommitConstraintBatchResult{}, err
+	}
+	result.submitMetrics()
+	return result, nil
+}
+
+func (ptx *postgresTransactionAdapter) precommitQueryHighestBatch(ctx context.Context, bucket BucketLocation, objectKeys [][]byte) (highest map[ObjectKey]Version, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	highest = make(map[ObjectKey]Version, len(objectKeys))
+	err = withRows(ptx.tx.QueryContext(ctx, `
+		SELECT object_key, max(version)
+		FROM objects
+		WHERE
+			(project_id, bucket_name) = ($1, $2) AND
+			object_key = ANY ($3)
+		GROUP BY object_key
+	`, bucket.ProjectID, []byte(bucket.BucketName), pgutil.ByteaArray(objectKeys)))(func(rows tagsql.Rows) error {
+		for rows.Next() {
+			var key ObjectKey
+			var version Version
+			if err := rows.Scan(&key, &version); err != nil {
+				return err
+			}
+			highest[key] = version
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return highest, nil
+}
+
+func (stx *spannerTransactionAdapter) precommitQueryHighestBatch(ctx context.Context, bucket BucketLocation, objectKeys [][]byte) (highest map[ObjectKey]Version, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	highest = make(map[ObjectKey]Version, len(objectKeys))
+	err = stx.tx.Query(ctx, spanner.Statement{
+		SQL: `
+			SELECT object_key, MAX(version)
+			FROM objects
+			WHERE
+				(project_id, bucket_name) = (@project_id, @bucket_name) AND
+				ARRAY_INCLUDES(@keys, object_key)
+			GROUP BY object_key
+		`,
+		Params: map[string]interface{}{
+			"project_id":  bucket.ProjectID,
+			"bucket_name": bucket.BucketName,
+			"keys":        objectKeys,
+		},
+	}).Do(func(row *spanner.Row) error {
+		var key ObjectKey
+		var version Version
+		if err := row.Columns(&key, &version); err != nil {
+			return err
+		}
+		highest[key] = version
+		return nil
+	})
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return highest, nil
+}
+
+func (ptx *postgresTransactionAdapter) precommitQueryHighestAndUnversionedBatch(ctx context.Context, bucket BucketLocation, objectKeys [][]byte) (highest map[ObjectKey]Version, unversioned []ObjectKey, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	highest = make(map[ObjectKey]Version, len(objectKeys))
+	err = withRows(ptx.tx.QueryContext(ctx, `
+		SELECT object_key, max(version), bool_or(status IN `+statusesUnversioned+`)
+		FROM objects
+		WHERE
+			(project_id, bucket_name) = ($1, $2) AND
+			object_key = ANY ($3)
+		GROUP BY object_key
+	`, bucket.ProjectID, []byte(bucket.BucketName), pgutil.ByteaArray(objectKeys)))(func(rows tagsql.Rows) error {
+		for rows.Next() {
+			var key ObjectKey
+			var version Version
+			var unversionedExists bool
+			if err := rows.Scan(&key, &version, &unversionedExists); err != nil {
+				return err
+			}
+			highest[key] = version
+			if unversionedExists {
+				unversioned = append(unversioned, key)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, Error.Wrap(err)
+	}
+	return highest, unversioned, nil
+}
+
+func (stx *spannerTransactionAdapter) precommitQueryHighestAndUnversionedBatch(ctx context.Context, bucket BucketLocation, objectKeys [][]byte) (highest map[ObjectKey]Version, unversioned []ObjectKey, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	highest = make(map[ObjectKey]Version, len(objectKeys))
+	err = stx.tx.Query(ctx, spanner.Statement{
+		SQL: `
+			SELECT object_key, MAX(version), LOGICAL_OR(status IN ` + statusesUnversioned + `)
+			FROM objects
+			WHERE
+				(project_id, bucket_name) = (@project_id, @bucket_name) AND
+				ARRAY_INCLUDES(@keys, object_key)
+			GROUP BY object_key
+		`,
+		Params: map[string]interface{}{
+			"project_id":  bucket.ProjectID,
+			"bucket_name": bucket.BucketName,
+			"keys":        objectKeys,
+		},
+	}).Do(func(row *spanner.Row) error {
+		var key ObjectKey
+		var version Version
+		var unversionedExists bool
+		if err := row.Columns(&key, &version, &unversionedExists); err != nil {
+			return err
+		}
+		highest[key] = version
+		if unversionedExists {
+			unversioned = append(unversioned, key)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, Error.Wrap(err)
+	}
+	return highest, unversioned, nil
+}
+
+// precommitDeleteUnversionedBatch deletes the unversioned objects at the object keys and also returns the highest versions.
+func (ptx *postgresTransactionAdapter) precommitDeleteUnversionedBatch(ctx context.Context, bucket BucketLocation, objectKeys [][]byte) (result PrecommitConstraintBatchResult, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	result.HighestVersions = make(map[ObjectKey]Version, len(objectKeys))
+	deletedKeys := make(map[ObjectKey]struct{})
+
+	err = withRows(ptx.tx.QueryContext(ctx, `
+		WITH highest_objects AS (
+			SELECT object_key, max(version) AS version
+			FROM objects
+			WHERE
+				(project_id, bucket_name) = ($1, $2) AND
+				object_key = ANY ($3)
+			GROUP BY object_key
+		), deleted_objects AS (
+			DELETE FROM objects
+			WHERE
+				(project_id, bucket_name) = ($1, $2) AND
+				object_key = ANY ($3) AND
+				status IN `+statusesUnversioned+` AND
+				`+objectNotPinned+`
+			RETURNING
+				object_key, version, stream_id,
+				created_at, expires_at,
+				status, segment_count,
+				encrypted_metadata_nonce, encrypted_metadata, encrypted_metadata_encrypted_key,
+				total_plain_size, total_encrypted_size, fixed_segment_size,
+				encryption
+		), deleted_segments AS (
+			DELETE FROM segments
+			WHERE segments.stream_id IN (SELECT deleted_objects.stream_id FROM deleted_objects)
+			RETURNING segments.stream_id
+		)
+		SELECT
+			highest_objects.object_key, highest_objects.version,
+			deleted_objects.version, deleted_objects.stream_id,
+			deleted_objects.created_at, deleted_objects.expires_at,
+			deleted_objects.status, deleted_objects.segment_count,
+			deleted_objects.encrypted_metadata_nonce, deleted_objects.encrypted_metadata, deleted_objects.encrypted_metadata_encrypted_key,
+			deleted_objects.total_plain_size, deleted_objects.total_encrypted_size, deleted_objects.fixed_segment_size,
+			deleted_objects.encryption,
+			(SELECT count(*) FROM deleted_segments)
+		FROM highest_objects
+		LEFT JOIN deleted_objects ON deleted_objects.object_key = highest_objects.object_key
+	`, bucket.ProjectID, []byte(bucket.BucketName), pgutil.ByteaArray(objectKeys)))(func(rows tagsql.Rows) error {
+		for rows.Next() {
+			var key ObjectKey
+			var highest Version
+			var deleted Object
+
+			var version sql.NullInt64
+			var streamID uuid.NullUUID
+			var createdAt sql.NullTime
+			var segmentCount, fixedSegmentSize sql.NullInt32
+			var totalPlainSize, totalEncryptedSize sql.NullInt64
+			var status sql.NullByte
+			var encryptionParams nullableValue[encryptionParameters]
+			encryptionParams.value.EncryptionParameters = &deleted.Encryption
+
+			err := rows.Scan(
+				&key, &highest,
+				&version, &streamID,
+				&createdAt, &deleted.ExpiresAt,
+				&status, &segmentCount,
+				&deleted.EncryptedMetadataNonce, &deleted.EncryptedMetadata, &deleted.EncryptedMetadataEncryptedKey,
+				&totalPlainSize, &totalEncryptedSize, &fixedSegmentSize,
+				&encryptionParams,
+				&result.DeletedSegmentCount,
+			)
+			if err != nil {
+				return err
+			}
+
+			result.HighestVersions[key] = highest
+			if !version.Valid {
+				continue
+			}
+
+			if _, ok := deletedKeys[key]; ok {
+				ptx.postgresAdapter.log.Error("object with multiple committed versions were found!",
+					zap.Stringer("Project ID", bucket.ProjectID), zap.String("Bucket Name", bucket.BucketName),
+					zap.ByteString("Object Key", []byte(key)))
+
+				mon.Meter("multiple_committed_versions").Mark(1)
+
+				return Error.New("internal error: multiple committed unversioned objects")
+			}
+			deletedKeys[key] = struct{}{}
+
+			deleted.ProjectID = bucket.ProjectID
+			deleted.BucketName = bucket.BucketName
+			deleted.ObjectKey = key
+			deleted.Version = Version(version.Int64)
+
+			deleted.Status = ObjectStatus(status.Byte)
+			deleted.StreamID = streamID.UUID
+			deleted.CreatedAt = createdAt.Time
+			deleted.SegmentCount = segmentCount.Int32
+
+			deleted.TotalPlainSize = totalPlainSize.Int64
+			deleted.TotalEncryptedSize = totalEncryptedSize.Int64
+			deleted.FixedSegmentSize = fixedSegmentSize.Int32
+
+			result.Deleted = append(result.Deleted, deleted)
+		}
+		return nil
+	})
+	if err != nil {
+		return PrecommitConstraintBatchResult{}, Error.Wrap(err)
+	}
+
+	result.DeletedObjectCount = len(result.Deleted)
+	return result, nil
+}
+
+func (stx *spannerTransactionAdapter) precommitDeleteUnversionedBatch(ctx context.Context, bucket BucketLocation, objectKeys [][]byte) (result PrecommitConstraintBatchResult, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	result.HighestVersions, err = stx.precommitQueryHighestBatch(ctx, bucket, objectKeys)
+	if err != nil {
+		return PrecommitConstraintBatchResult{}, err
+	}
+
+	err = func() error {
+		iter := stx.tx.Query(ctx, spanner.Statement{
+			SQL: `
+				DELETE FROM objects
+				WHERE
+					(project_id, bucket_name) = (@project_id, @bucket_name) AND
+					ARRAY_INCLUDES(@keys, object_key) AND
+					status IN ` + statusesUnversioned + ` AND
+					` + objectNotPinned + `
+				THEN RETURN
+					object_key, version, stream_id,
+					created_at, expires_at,
+					status, segment_count,
+					encrypted_metadata_nonce, encrypted_metadata, encrypted_metadata_encrypted_key,
+					total_plain_size, total_encrypted_size, fixed_segment_size,
+					encryption
+			`,
+			Params: map[string]any{
+				"project_id":  bucket.ProjectID,
+				"bucket_name": bucket.BucketName,
+				"keys":        objectKeys,
+			},
+		})
+		defer iter.Stop()
+
+		deletedKeys := make(map[ObjectKey]struct{})
+		for {
+			row, err := iter.Next()
+			if err != nil {
+				if errors.Is(err, iterator.Done) {
+					return nil
+				}
+				return err
+			}
+
+			deleted := Object{}
+			deleted.ProjectID = bucket.ProjectID
+			deleted.BucketName = bucket.BucketName
+			err = row.Columns(
+				&deleted.ObjectKey, &deleted.Version, &deleted.StreamID,
+				&deleted.CreatedAt, &deleted.ExpiresAt,
+				&deleted.Status, spannerutil.Int(&deleted.SegmentCount),
+				&deleted.EncryptedMetadataNonce, &deleted.EncryptedMetadata, &deleted.EncryptedMetadataEncryptedKey,
+				&deleted.TotalPlainSize, &deleted.TotalEncryptedSize, spannerutil.Int(&deleted.FixedSegmentSize),
+				encryptionParameters{&deleted.Encryption},
+			)
+			if err != nil {
+				return err
+			}
+
+			if _, ok := deletedKeys[deleted.ObjectKey]; ok {
+				stx.spannerAdapter.log.Error("object with multiple committed versions were found!",
+					zap.Stringer("Project ID", bucket.ProjectID), zap.String("Bucket Name", bucket.BucketName),
+					zap.ByteString("Object Key", []byte(deleted.ObjectKey)))
+
+				mon.Meter("multiple_committed_versions").Mark(1)
+
+				return Error.New("internal error: multiple committed unversioned objects")
+			}
+			deletedKeys[deleted.ObjectKey] = struct{}{}
+
+			result.Deleted = append(result.Deleted, deleted)
+		}
+	}()
+	if err != nil {
+		return PrecommitConstraintBatchResult{}, Error.Wrap(err)
+	}
+	result.DeletedObjectCount = len(result.Deleted)
+
+	if len(result.Deleted) > 0 {
+		streamIDs := make([][]byte, 0, len(result.Deleted))
+		for _, object := range result.Deleted {
+			streamIDs = append(streamIDs, object.StreamID.Bytes())
+		}
+
+		rowCount, err := stx.tx.Update(ctx, spanner.Statement{
+			SQL: `
+				DELETE FROM segments
+				WHERE ARRAY_INCLUDES(@stream_ids, stream_id)
+			`,
+			Params: map[string]interface{}{
+				"stream_ids": streamIDs,
+			},
+		})
+		if err != nil {
+			return PrecommitConstraintBatchResult{}, Error.Wrap(err)
+		}
+		result.DeletedSegmentCount = int(rowCount)
+	}
+
+	return result, nil
+}
@@ -7,11 +7,14 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"sync/atomic"
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"golang.org/x/sync/errgroup"
 
 	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
 	"storj.io/storj/satellite/metabase"
 	"storj.io/storj/satellite/metabase/metabasetest"
 )
@@ -54,6 +57,145 @@ func TestPrecommitConstraint_Empty(t *testing.T) {
 	})
 }
 
+func TestPrecommitConstraint_Quota(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		obj := metabasetest.RandObjectStream()
+
+		commit := func(obj metabase.ObjectStream, segments byte, quota metabase.PrecommitQuota) error {
+			metabasetest.CreatePendingObject(ctx, t, db, obj, segments)
+			_, err := db.CommitObject(ctx, metabase.CommitObject{
+				ObjectStream: obj,
+				Quota:        &quota,
+			})
+			return err
+		}
+
+		t.Run("objects", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			quota := metabase.PrecommitQuota{MaxObjects: 2}
+
+			first := obj
+			first.ObjectKey = "first"
+			require.NoError(t, commit(first, 0, quota))
+
+			// overwriting an unversioned object doesn't increase the object count.
+			first.StreamID = testrand.UUID()
+			require.NoError(t, commit(first, 0, quota))
+
+			second := obj
+			second.ObjectKey = "second"
+			require.NoError(t, commit(second, 0, quota))
+
+			third := obj
+			third.ObjectKey = "third"
+			err := commit(third, 0, quota)
+			require.True(t, metabase.ErrProjectQuotaExceeded.Has(err), err)
+
+			// the failed commit keeps the object pending.
+			objects, err := db.TestingAllObjects(ctx)
+			require.NoError(t, err)
+			require.Len(t, objects, 3)
+		})
+
+		t.Run("segments", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			quota := metabase.PrecommitQuota{MaxSegments: 3}
+
+			first := obj
+			first.ObjectKey = "first"
+			require.NoError(t, commit(first, 2, quota))
+
+			second := obj
+			second.ObjectKey = "second"
+			err := commit(second, 2, quota)
+			require.True(t, metabase.ErrProjectQuotaExceeded.Has(err), err)
+
+			third := obj
+			third.ObjectKey = "third"
+			require.NoError(t, commit(third, 1, quota))
+		})
+
+		t.Run("recount after delete", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			quota := metabase.PrecommitQuota{MaxObjects: 2}
+
+			first := obj
+			first.ObjectKey = "first"
+			require.NoError(t, commit(first, 0, quota))
+
+			second := obj
+			second.ObjectKey = "second"
+			require.NoError(t, commit(second, 0, quota))
+
+			// deletes aren't tracked by the project counter, so the
+			// usage needs to be recounted before rejecting the commit.
+			_, err := db.DeleteObjectExactVersion(ctx, metabase.DeleteObjectExactVersion{
+				ObjectLocation: first.Location(),
+				Version:        first.Version,
+			})
+			require.NoError(t, err)
+
+			third := obj
+			third.ObjectKey = "third"
+			require.NoError(t, commit(third, 0, quota))
+
+			fourth := obj
+			fourth.ObjectKey = "fourth"
+			err = commit(fourth, 0, quota)
+			require.True(t, metabase.ErrProjectQuotaExceeded.Has(err), err)
+		})
+
+		t.Run("concurrent commits", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			const limit, uploads = 3, 10
+			quota := metabase.PrecommitQuota{MaxObjects: limit}
+
+			// start with a recounted counter right below the limit, so that
+			// the concurrent commits don't race for the initial recount.
+			initial := obj
+			initial.ObjectKey = "initial"
+			require.NoError(t, commit(initial, 0, quota))
+
+			streams := make([]metabase.ObjectStream, uploads)
+			for i := range streams {
+				streams[i] = obj
+				streams[i].ObjectKey = metabase.ObjectKey("concurrent/" + strconv.Itoa(i))
+				streams[i].StreamID = testrand.UUID()
+				metabasetest.CreatePendingObject(ctx, t, db, streams[i], 0)
+			}
+
+			var committed, rejected atomic.Int64
+			var group errgroup.Group
+			for _, stream := range streams {
+				stream := stream
+				group.Go(func() error {
+					_, err := db.CommitObject(ctx, metabase.CommitObject{
+						ObjectStream: stream,
+						Quota:        &quota,
+					})
+					switch {
+					case err == nil:
+						committed.Add(1)
+					case metabase.ErrProjectQuotaExceeded.Has(err):
+						rejected.Add(1)
+					default:
+						return err
+					}
+					return nil
+				})
+			}
+			require.NoError(t, group.Wait())
+
+			require.EqualValues(t, limit-1, committed.Load())
+			require.EqualValues(t, uploads-limit+1, rejected.Load())
+		})
+	})
+}
+
 func BenchmarkPrecommitConstraint(b *testing.B) {
 	metabasetest.Bench(b, func(ctx *testcontext.Context, b *testing.B, db *metabase.DB) {
 		baseObj := metabasetest.RandObjectStream()
@@ -116,3 +258,85 @@ func BenchmarkPrecommitConstraint(b *testing.B) {
 		})
 	})
 }
+
+func TestPrecommitConstraintBatch(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		obj := metabasetest.RandObjectStream()
+		bucket := obj.Location().Bucket()
+
+		precommit := func(opts metabase.PrecommitConstraintBatch) (result metabase.PrecommitConstraintBatchResult, err error) {
+			err = db.ChooseAdapter(obj.ProjectID).WithTx(ctx, func(ctx context.Context, adapter metabase.TransactionAdapter) error {
+				var err error
+				result, err = db.PrecommitConstraintBatch(ctx, opts, adapter)
+				return err
+			})
+			return result, err
+		}
+
+		t.Run("invalid", func(t *testing.T) {
+			_, err := precommit(metabase.PrecommitConstraintBatch{Bucket: bucket})
+			require.True(t, metabase.ErrInvalidRequest.Has(err), err)
+
+			_, err = precommit(metabase.PrecommitConstraintBatch{
+				Bucket:     bucket,
+				ObjectKeys: make([]metabase.ObjectKey, metabase.PrecommitConstraintBatchMaxKeys+1),
+			})
+			require.True(t, metabase.ErrInvalidRequest.Has(err), err)
+		})
+
+		t.Run("delete unversioned", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			first := obj
+			first.ObjectKey = "a"
+			firstObject := metabasetest.CreateObject(ctx, t, db, first, 2)
+
+			second := obj
+			second.ObjectKey = "b"
+			second.Version = 5
+			second.StreamID[0]++
+			secondObject := metabasetest.CreateObjectVersioned(ctx, t, db, second, 0)
+
+			keys := []metabase.ObjectKey{"a", "b", "missing", "a"}
+
+			result, err := precommit(metabase.PrecommitConstraintBatch{
+				Bucket:         bucket,
+				ObjectKeys:     keys,
+				DisallowDelete: true,
+			})
+			require.True(t, metabase.ErrPermissionDenied.Has(err), err)
+			require.Empty(t, result.Deleted)
+
+			result, err = precommit(metabase.PrecommitConstraintBatch{
+				Bucket:     bucket,
+				ObjectKeys: keys,
+				Versioned:  true,
+			})
+			require.NoError(t, err)
+			require.Equal(t, map[metabase.ObjectKey]metabase.Version{
+				"a": firstObject.Version,
+				"b": secondObject.Version,
+			}, result.HighestVersions)
+			require.Empty(t, result.Deleted)
+
+			result, err = precommit(metabase.PrecommitConstraintBatch{
+				Bucket:     bucket,
+				ObjectKeys: keys,
+			})
+			require.NoError(t, err)
+			require.Equal(t, 1, result.DeletedObjectCount)
+			require.Equal(t, 2, result.DeletedSegmentCount)
+			require.Equal(t, []metabase.Object{firstObject}, result.Deleted)
+			require.Equal(t, map[metabase.ObjectKey]metabase.Version{
+				"a": firstObject.Version,
+				"b": secondObject.Version,
+			}, result.HighestVersions)
+
+			metabasetest.Verify{
+				Objects: []metabase.RawObject{
+					metabase.RawObject(secondObject),
+				},
+			}.Check(ctx, t, db)
+		})
+	})
+}
@@ -0,0 +1,241 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase
+
+import (
+	"context"
+	"time"
+
+	"github.com/storj/exp-spanner"
+
+	"storj.io/storj/shared/tagsql"
+)
+
+// PurgeNoncurrentVersions contains arguments for deleting the noncurrent
+// versions of a bucket.
+type PurgeNoncurrentVersions struct {
+	Bucket BucketLocation
+	// CreatedBefore limits the purge to versions created before the time.
+	CreatedBefore time.Time
+	BatchSize     int
+
+	// ContinuationToken continues the purge after the last version deleted by
+	// a previous, interrupted call.
+	ContinuationToken ContinuationToken
+	// Progress is called after every deleted batch. Returning an error stops the purge.
+	Progress func(ctx context.Context, progress PurgeNoncurrentVersionsProgress) error
+}
+
+// Verify verifies purge noncurrent versions request fields.
+func (opts *PurgeNoncurrentVersions) Verify() error {
+	if err := opts.Bucket.Verify(); err != nil {
+		return err
+	}
+	if opts.CreatedBefore.IsZero() {
+		return ErrInvalidRequest.New("CreatedBefore missing")
+	}
+	return nil
+}
+
+// PurgeNoncurrentVersionsProgress describes the progress of PurgeNoncurrentVersions.
+type PurgeNoncurrentVersionsProgress struct {
+	DeletedObjects  int64
+	DeletedSegments int64
+	// ContinuationToken can be used to continue the purge after this batch.
+	ContinuationToken ContinuationToken
+}
+
+// PurgeNoncurrentVersions deletes the versions of the bucket objects, which
+// aren't the latest version of their object and were created before
+// opts.CreatedBefore. Delete markers are purged the same way as the other
+// versions. Pending objects, pinned versions and versions under an active
+// compliance retention are kept.
+//
+// Deletion is performed in batches, so in case of an error the returned
+// progress contains what was deleted until then.
+func (db *DB) PurgeNoncurrentVersions(ctx context.Context, opts PurgeNoncurrentVersions) (result PurgeNoncurrentVersionsProgress, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := opts.Verify(); err != nil {
+		return PurgeNoncurrentVersionsProgress{}, err
+	}
+
+	cursor, err := opts.ContinuationToken.decode()
+	if err != nil {
+		return PurgeNoncurrentVersionsProgress{}, err
+	}
+
+	deleteBatchsizeLimit.Ensure(&opts.BatchSize)
+
+	adapter := db.ChooseAdapter(opts.Bucket.ProjectID)
+	startAfter := ObjectStream{
+		ProjectID:  opts.Bucket.ProjectID,
+		BucketName: opts.Bucket.BucketName,
+		ObjectKey:  cursor.key,
+		Version:    cursor.version,
+	}
+	result.ContinuationToken = opts.ContinuationToken
+
+	for {
+		versions, err := adapter.FindNoncurrentVersions(ctx, opts, time.Now(), startAfter, opts.BatchSize)
+		if err != nil {
+			return result, Error.New("unable to select noncurrent versions: %w", err)
+		}
+		if len(versions) == 0 {
+			return result, nil
+		}
+
+		_, segmentsDeleted, err := adapter.DeleteObjectsAndSegments(ctx, versions)
+		if err != nil {
+			return result, err
+		}
+		// DeleteObjectsAndSegments doesn't count objects without segments,
+		// hence the selected versions are counted instead.
+		objectsDeleted := int64(len(versions))
+
+		mon.Meter("noncurrent_version_purge").Mark64(objectsDeleted)
+		mon.Meter("object_delete").Mark64(objectsDeleted)
+		mon.Meter("segment_delete").Mark64(segmentsDeleted)
+
+		startAfter = versions[len(versions)-1]
+		result.DeletedObjects += objectsDeleted
+		result.DeletedSegments += segmentsDeleted
+		result.ContinuationToken = newContinuationToken(deleteBucketObjectsCursor{
+			key:     startAfter.ObjectKey,
+			version: startAfter.Version,
+		})
+
+		if opts.Progress != nil {
+			if err := opts.Progress(ctx, result); err != nil {
+				return result, err
+			}
+		}
+
+		if len(versions) < opts.BatchSize {
+			return result, nil
+		}
+	}
+}
+
+// FindNoncurrentVersions finds up to batchSize noncurrent versions of the bucket, which can be purged.
+func (p *PostgresAdapter) FindNoncurrentVersions(ctx context.Context, opts PurgeNoncurrentVersions, now time.Time, startAfter ObjectStream, batchSize int) (versions []ObjectStream, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	const tag = queryTag("find_noncurrent_versions")
+	done := tag.observe(p.impl.String())
+	defer func() { done(int64(len(versions)), err) }()
+
+	versions = make([]ObjectStream, 0, batchSize)
+
+	err = withRows(p.db.QueryContext(ctx, `
+		SELECT object_key, version, stream_id
+		FROM objects
+		WHERE
+			(project_id, bucket_name) = ($1, $2)
+			AND (object_key, version) > ($3, $4)
+			AND status <> `+statusPending+`
+			AND created_at < $5
+			AND (retention_mode <> $6 OR retain_until IS NULL OR retain_until <= $7)
+			AND EXISTS (
+				SELECT 1 FROM objects AS newer
+				WHERE (newer.project_id, newer.bucket_name, newer.object_key) =
+					(objects.project_id, objects.bucket_name, objects.object_key)
+					AND newer.version > objects.version
+					AND newer.status <> `+statusPending+`
+			)
+			AND NOT EXISTS (
+				SELECT 1 FROM object_pins
+				WHERE (object_pins.project_id, object_pins.bucket_name, object_pins.object_key, object_pins.version) =
+					(objects.project_id, objects.bucket_name, objects.object_key, objects.version)
+			)
+		ORDER BY project_id, bucket_name, object_key, version
+		LIMIT $8
+	`, opts.Bucket.ProjectID, []byte(opts.Bucket.BucketName), []byte(startAfter.ObjectKey), startAfter.Version,
+		opts.CreatedBefore, retentionModeCompliance, now, batchSize,
+	))(func(rows tagsql.Rows) error {
+		for rows.Next() {
+			version := ObjectStream{
+				ProjectID:  opts.Bucket.ProjectID,
+				BucketName: opts.Bucket.BucketName,
+			}
+			if err := rows.Scan(&version.ObjectKey, &version.Version, &version.StreamID); err != nil {
+				return err
+			}
+			versions = append(versions, version)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return versions, nil
+}
+
+// FindNoncurrentVersions finds up to batchSize noncurrent versions of the bucket, which can be purged.
+func (s *SpannerAdapter) FindNoncurrentVersions(ctx context.Context, opts PurgeNoncurrentVersions, now time.Time, startAfter ObjectStream, batchSize int) (versions []ObjectStream, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	const tag = queryTag("find_noncurrent_versions")
+	done := tag.observe(s.Name())
+	defer func() { done(int64(len(versions)), err) }()
+
+	versions = make([]ObjectStream, 0, batchSize)
+
+	result := s.client.Single().Query(ctx, spanner.Statement{
+		SQL: `
+			SELECT object_key, version, stream_id
+			FROM objects
+			WHERE
+				project_id = @project_id AND bucket_name = @bucket_name
+				AND (object_key > @object_key OR (object_key = @object_key AND version > @version))
+				AND status <> ` + statusPending + `
+				AND created_at < @created_before
+				AND (retention_mode <> @retention_mode OR retain_until IS NULL OR retain_until <= @now)
+				AND EXISTS (
+					SELECT 1 FROM objects AS newer
+					WHERE newer.project_id = objects.project_id
+						AND newer.bucket_name = objects.bucket_name
+						AND newer.object_key = objects.object_key
+						AND newer.version > objects.version
+						AND newer.status <> ` + statusPending + `
+				)
+				AND NOT EXISTS (
+					SELECT 1 FROM object_pins
+					WHERE object_pins.project_id = objects.project_id
+						AND object_pins.bucket_name = objects.bucket_name
+						AND object_pins.object_key = objects.object_key
+						AND object_pins.version = objects.version
+				)
+			ORDER BY project_id, bucket_name, object_key, version
+			LIMIT @batch_size
+		`,
+		Params: map[string]interface{}{
+			"project_id":     opts.Bucket.ProjectID,
+			"bucket_name":    opts.Bucket.BucketName,
+			"object_key":     startAfter.ObjectKey,
+			"version":        startAfter.Version,
+			"created_before": opts.CreatedBefore,
+			"retention_mode": int64(retentionModeCompliance),
+			"now":            now,
+			"batch_size":     int64(batchSize),
+		},
+	})
+	defer result.Stop()
+
+	err = result.Do(func(row *spanner.Row) error {
+		version := ObjectStream{
+			ProjectID:  opts.Bucket.ProjectID,
+			BucketName: opts.Bucket.BucketName,
+		}
+		if err := row.Columns(&version.ObjectKey, &version.Version, &version.StreamID); err != nil {
+			return err
+		}
+		versions = append(versions, version)
+		return nil
+	})
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return versions, nil
+}
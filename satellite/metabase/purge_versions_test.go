@@ -0,0 +1,128 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/metabasetest"
+)
+
+func TestPurgeNoncurrentVersions(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		t.Run("invalid request", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			obj := metabasetest.RandObjectStream()
+
+			_, err := db.PurgeNoncurrentVersions(ctx, metabase.PurgeNoncurrentVersions{
+				CreatedBefore: time.Now(),
+			})
+			require.True(t, metabase.ErrInvalidRequest.Has(err), err)
+
+			_, err = db.PurgeNoncurrentVersions(ctx, metabase.PurgeNoncurrentVersions{
+				Bucket: obj.Location().Bucket(),
+			})
+			require.True(t, metabase.ErrInvalidRequest.Has(err), err)
+		})
+
+		t.Run("keeps latest, pending and pinned versions", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			obj := metabasetest.RandObjectStream()
+			obj.Version = 1
+			pinned := metabasetest.CreateObjectVersioned(ctx, t, db, obj, 0)
+			obj.Version = 2
+			metabasetest.CreateObjectVersioned(ctx, t, db, obj, 2)
+			obj.Version = 3
+			metabasetest.CreateObjectVersioned(ctx, t, db, obj, 0)
+			obj.Version = 4
+			latest := metabasetest.CreateObjectVersioned(ctx, t, db, obj, 0)
+			obj.Version = 5
+			pending := metabasetest.CreatePendingObject(ctx, t, db, obj, 0)
+
+			single := metabasetest.RandObjectStream()
+			single.ProjectID = obj.ProjectID
+			single.BucketName = obj.BucketName
+			only := metabasetest.CreateObjectVersioned(ctx, t, db, single, 0)
+
+			other := metabasetest.RandObjectStream()
+			other.ProjectID = obj.ProjectID
+			other.Version = 1
+			otherOld := metabasetest.CreateObjectVersioned(ctx, t, db, other, 0)
+			other.Version = 2
+			otherLatest := metabasetest.CreateObjectVersioned(ctx, t, db, other, 0)
+
+			pin := metabase.ObjectPinLocation{ProjectID: obj.ProjectID, BucketName: obj.BucketName, Name: "hold"}
+			_, err := db.CreateObjectPin(ctx, metabase.CreateObjectPin{
+				ObjectPinLocation: pin,
+				Versions:          []metabase.ObjectPinVersion{{ObjectKey: pinned.ObjectKey, Version: pinned.Version}},
+			})
+			require.NoError(t, err)
+
+			var batches int
+			result, err := db.PurgeNoncurrentVersions(ctx, metabase.PurgeNoncurrentVersions{
+				Bucket:        obj.Location().Bucket(),
+				CreatedBefore: time.Now().Add(time.Hour),
+				BatchSize:     1,
+				Progress: func(ctx context.Context, progress metabase.PurgeNoncurrentVersionsProgress) error {
+					batches++
+					require.NotEmpty(t, progress.ContinuationToken)
+					return nil
+				},
+			})
+			require.NoError(t, err)
+			require.EqualValues(t, 2, result.DeletedObjects)
+			require.EqualValues(t, 2, result.DeletedSegments)
+			require.Equal(t, 2, batches)
+
+			metabasetest.Verify{
+				Objects: []metabase.RawObject{
+					metabase.RawObject(pinned),
+					metabase.RawObject(latest),
+					metabase.RawObject(pending),
+					metabase.RawObject(only),
+					metabase.RawObject(otherOld),
+					metabase.RawObject(otherLatest),
+				},
+			}.Check(ctx, t, db)
+
+			require.NoError(t, db.DeleteObjectPin(ctx, metabase.DeleteObjectPin{ObjectPinLocation: pin}))
+		})
+
+		t.Run("created before", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			obj := metabasetest.RandObjectStream()
+			obj.Version = 1
+			old := metabasetest.CreateObjectVersioned(ctx, t, db, obj, 0)
+			obj.Version = 2
+			latest := metabasetest.CreateObjectVersioned(ctx, t, db, obj, 0)
+
+			result, err := db.PurgeNoncurrentVersions(ctx, metabase.PurgeNoncurrentVersions{
+				Bucket:        obj.Location().Bucket(),
+				CreatedBefore: old.CreatedAt.Add(-time.Hour),
+			})
+			require.NoError(t, err)
+			require.Zero(t, result.DeletedObjects)
+
+			result, err = db.PurgeNoncurrentVersions(ctx, metabase.PurgeNoncurrentVersions{
+				Bucket:        obj.Location().Bucket(),
+				CreatedBefore: time.Now().Add(time.Hour),
+			})
+			require.NoError(t, err)
+			require.EqualValues(t, 1, result.DeletedObjects)
+
+			metabasetest.Verify{
+				Objects: []metabase.RawObject{metabase.RawObject(latest)},
+			}.Check(ctx, t, db)
+		})
+	})
+}
@@ -0,0 +1,82 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase
+
+import (
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/storj/exp-spanner"
+)
+
+// queryTag names an adapter method, so its latency, row count and errors can be
+// tracked per adapter. Every Adapter method which queries the database records its
+// calls with observe. A method, which runs several queries, e.g. listing, or which
+// calls another adapter method, is recorded as a whole under its own tag.
+//
+// The tag can also be sent to the database: as a leading comment on Postgres and
+// Cockroach, which shows up in pg_stat_statements and crdb_internal.statement_statistics,
+// and as a request tag on Spanner, which shows up in the query statistics tables.
+// The hottest point lookups, GetObjectLastCommitted and GetSegmentByPosition, do so.
+type queryTag string
+
+// requestTag returns the tag as it's sent to the database.
+func (tag queryTag) requestTag() string {
+	return "metabase:" + string(tag)
+}
+
+// postgres prefixes the query with the tag comment.
+func (tag queryTag) postgres(query string) string {
+	return "/* " + tag.requestTag() + " */ " + query
+}
+
+// spanner returns the query options with the request tag.
+func (tag queryTag) spanner() spanner.QueryOptions {
+	return spanner.QueryOptions{RequestTag: tag.requestTag()}
+}
+
+// observe starts measuring the query on the adapter. The returned func must be called
+// with the number of returned or affected rows, once the query has finished. A negative
+// row count means that the count isn't known, e.g. for queries returning a cursor, and
+// only the latency and the error are recorded.
+func (tag queryTag) observe(adapter string) func(rows int64, err error) {
+	start := time.Now()
+	return func(rows int64, err error) {
+		tags := []monkit.SeriesTag{
+			monkit.NewSeriesTag("adapter", adapter),
+			monkit.NewSeriesTag("query", string(tag)),
+		}
+		mon.DurationVal("metabase_query_duration", tags...).Observe(time.Since(start))
+		if rows >= 0 {
+			mon.IntVal("metabase_query_rows", tags...).Observe(rows)
+		}
+		if err != nil {
+			mon.Counter("metabase_query_errors", tags...).Inc(1)
+		}
+	}
+}
+
+// rowsFound returns the row count of a query which returns a single row.
+func rowsFound(err error) int64 {
+	if err != nil {
+		return 0
+	}
+	return 1
+}
+
+// rowsExist returns the row count of a query which checks whether a row exists.
+func rowsExist(exists bool) int64 {
+	if exists {
+		return 1
+	}
+	return 0
+}
+
+// ignoreNotFound drops the errors of missing rows, which are an expected query outcome.
+func ignoreNotFound(err error) error {
+	if ErrObjectNotFound.Has(err) || ErrSegmentNotFound.Has(err) {
+		return nil
+	}
+	return err
+}
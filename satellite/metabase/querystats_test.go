@@ -0,0 +1,117 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/metabasetest"
+)
+
+// queryStats are the metrics recorded for a query tag.
+type queryStats struct {
+	Calls    int64
+	Rows     int64
+	Errors   int64
+	Duration float64
+}
+
+// collectQueryStats returns the metrics recorded for the adapter, by query tag.
+func collectQueryStats(adapter string) map[string]queryStats {
+	stats := map[string]queryStats{}
+	monkit.Default.Stats(func(key monkit.SeriesKey, field string, val float64) {
+		query := key.Tags.Get("query")
+		if query == "" || key.Tags.Get("adapter") != adapter {
+			return
+		}
+
+		s := stats[query]
+		switch {
+		case key.Measurement == "metabase_query_duration" && field == "count":
+			s.Calls = int64(val)
+		case key.Measurement == "metabase_query_duration" && field == "sum":
+			s.Duration = val
+		case key.Measurement == "metabase_query_rows" && field == "sum":
+			s.Rows = int64(val)
+		case key.Measurement == "metabase_query_errors" && field == "value":
+			s.Errors = int64(val)
+		default:
+			return
+		}
+		stats[query] = s
+	})
+	return stats
+}
+
+func TestQueryStats(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		obj := metabasetest.RandObjectStream()
+		adapter := db.ChooseAdapter(obj.ProjectID).Name()
+
+		metabasetest.CreateObject(ctx, t, db, obj, 2)
+
+		before := collectQueryStats(adapter)
+
+		for i := 0; i < 2; i++ {
+			_, err := db.GetObjectLastCommitted(ctx, metabase.GetObjectLastCommitted{
+				ObjectLocation: obj.Location(),
+			})
+			require.NoError(t, err)
+		}
+
+		// a missing object is an expected outcome, not an error.
+		_, err := db.GetObjectExactVersion(ctx, metabase.GetObjectExactVersion{
+			ObjectLocation: obj.Location(),
+			Version:        obj.Version + 1,
+		})
+		require.True(t, metabase.ErrObjectNotFound.Has(err), err)
+
+		segments, err := db.ListSegments(ctx, metabase.ListSegments{
+			ProjectID: obj.ProjectID,
+			StreamID:  obj.StreamID,
+		})
+		require.NoError(t, err)
+		require.Len(t, segments.Segments, 2)
+
+		canceled, cancel := context.WithCancel(ctx)
+		cancel()
+		_, err = db.GetObjectExactVersion(canceled, metabase.GetObjectExactVersion{
+			ObjectLocation: obj.Location(),
+			Version:        obj.Version,
+		})
+		require.Error(t, err)
+
+		after := collectQueryStats(adapter)
+
+		delta := func(query string) queryStats {
+			return queryStats{
+				Calls:    after[query].Calls - before[query].Calls,
+				Rows:     after[query].Rows - before[query].Rows,
+				Errors:   after[query].Errors - before[query].Errors,
+				Duration: after[query].Duration - before[query].Duration,
+			}
+		}
+
+		for query, expected := range map[string]queryStats{
+			"get_object_last_committed": {Calls: 2, Rows: 2},
+			"get_object_exact_version":  {Calls: 2, Rows: 0, Errors: 1},
+			"list_segments":             {Calls: 1, Rows: 2},
+		} {
+			recorded := delta(query)
+			require.Equal(t, expected.Calls, recorded.Calls, query)
+			require.Equal(t, expected.Rows, recorded.Rows, query)
+			require.Equal(t, expected.Errors, recorded.Errors, query)
+			require.Positive(t, recorded.Duration, query)
+		}
+
+		// queries which weren't run aren't affected.
+		require.Zero(t, delta("delete_object_exact_version").Calls)
+	})
+}
@@ -142,10 +142,15 @@ func (db *DB) TestingDeleteAll(ctx context.Context) (err error) {
 // TestingDeleteAll implements Adapter.
 func (p *PostgresAdapter) TestingDeleteAll(ctx context.Context) (err error) {
 	_, err = p.db.ExecContext(ctx, `
+		WITH ignore_full_scan_for_test AS (SELECT 1) DELETE FROM object_pins;
+		WITH ignore_full_scan_for_test AS (SELECT 1) DELETE FROM object_tags;
 		WITH ignore_full_scan_for_test AS (SELECT 1) DELETE FROM objects;
 		WITH ignore_full_scan_for_test AS (SELECT 1) DELETE FROM segments;
 		WITH ignore_full_scan_for_test AS (SELECT 1) DELETE FROM node_aliases;
 		WITH ignore_full_scan_for_test AS (SELECT 1) SELECT setval('node_alias_seq', 1, false);
+		WITH ignore_full_scan_for_test AS (SELECT 1) DELETE FROM object_events;
+		WITH ignore_full_scan_for_test AS (SELECT 1) DELETE FROM project_quota_counters;
+		WITH ignore_full_scan_for_test AS (SELECT 1) DELETE FROM deferred_segment_deletions;
 	`)
 	return Error.Wrap(err)
 }
@@ -153,9 +158,12 @@ func (p *PostgresAdapter) TestingDeleteAll(ctx context.Context) (err error) {
 // TestingDeleteAll implements Adapter.
 func (s *SpannerAdapter) TestingDeleteAll(ctx context.Context) (err error) {
 	_, err = s.client.Apply(ctx, []*spanner.Mutation{
+		spanner.Delete("object_pins", spanner.AllKeys()),
 		spanner.Delete("objects", spanner.AllKeys()),
 		spanner.Delete("segments", spanner.AllKeys()),
 		spanner.Delete("node_aliases", spanner.AllKeys()),
+		spanner.Delete("project_quota_counters", spanner.AllKeys()),
+		spanner.Delete("deferred_segment_deletions", spanner.AllKeys()),
 	})
 	return Error.Wrap(err)
 }
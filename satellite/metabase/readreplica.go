@@ -0,0 +1,150 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase
+
+import (
+	"context"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"go.uber.org/zap"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/shared/dbutil"
+	"storj.io/storj/shared/dbutil/pgutil"
+	"storj.io/storj/shared/tagsql"
+)
+
+// ReadOperation identifies a heavy read path, which can be served by the read
+// replica instead of the primary database.
+type ReadOperation string
+
+const (
+	// ReadListObjects is listing the objects of a bucket.
+	ReadListObjects ReadOperation = "list-objects"
+	// ReadListSegments is listing the segments of a single object.
+	ReadListSegments ReadOperation = "list-segments"
+	// ReadRangedLoop is iterating over all segments, as done by the ranged loop.
+	ReadRangedLoop ReadOperation = "ranged-loop"
+)
+
+// ReadReplicaConfig configures the optional read-only connection.
+//
+// The replica may lag behind the primary: a Postgres hot standby by its
+// replication delay and a Spanner replica by at most MaxStaleness. Only
+// operations which tolerate reading slightly outdated data should be routed
+// to it.
+type ReadReplicaConfig struct {
+	// DatabaseURL is the connection string of the replica. It must use the
+	// same implementation as the primary database.
+	DatabaseURL string
+	// Operations are the read operations which are served by the replica.
+	Operations []ReadOperation
+	// MaxStaleness bounds the staleness of Spanner reads.
+	MaxStaleness time.Duration
+}
+
+// Verify verifies the read replica configuration.
+func (config ReadReplicaConfig) Verify() error {
+	for _, op := range config.Operations {
+		switch op {
+		case ReadListObjects, ReadListSegments, ReadRangedLoop:
+		default:
+			return Error.New("unknown read replica operation: %q", op)
+		}
+	}
+	if len(config.Operations) > 0 && config.DatabaseURL == "" {
+		return Error.New("read replica operations are configured without a read replica")
+	}
+	if config.MaxStaleness < 0 {
+		return Error.New("read replica max staleness is negative")
+	}
+	return nil
+}
+
+// openReadReplica opens the read-only connection, when it is configured.
+func (db *DB) openReadReplica(ctx context.Context, config ReadReplicaConfig) (err error) {
+	if config.DatabaseURL == "" {
+		return nil
+	}
+	if err := config.Verify(); err != nil {
+		return err
+	}
+
+	_, source, impl, err := dbutil.SplitConnStr(config.DatabaseURL)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if impl != db.impl {
+		return Error.New("read replica implementation %s doesn't match %s", impl, db.impl)
+	}
+
+	switch impl {
+	case dbutil.Postgres, dbutil.Cockroach:
+		driverName := "pgx"
+		if impl == dbutil.Cockroach {
+			driverName = "cockroach"
+		}
+
+		connstr, err := pgutil.CheckApplicationName(config.DatabaseURL, db.config.ApplicationName+"-replica")
+		if err != nil {
+			return Error.Wrap(err)
+		}
+
+		var rawdb tagsql.DB
+		rawdb, err = tagsql.Open(ctx, driverName, connstr)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		dbutil.Configure(ctx, rawdb, "metabase-replica", mon)
+		rawdb = postgresRebind{rawdb}
+
+		if impl == dbutil.Cockroach {
+			db.replica = &CockroachAdapter{
+				PostgresAdapter{
+					log:  db.log.Named("replica"),
+					db:   rawdb,
+					impl: impl,
+				},
+			}
+		} else {
+			db.replica = &PostgresAdapter{
+				log:  db.log.Named("replica"),
+				db:   rawdb,
+				impl: impl,
+			}
+		}
+		db.replicaDB = rawdb
+	case dbutil.Spanner:
+		adapter, err := NewSpannerAdapter(ctx, SpannerConfig{
+			Database:     source,
+			MaxStaleness: config.MaxStaleness,
+			readOnly:     true,
+		}, db.log.Named("replica"))
+		if err != nil {
+			return err
+		}
+		db.replica = adapter
+	default:
+		return Error.New("unsupported read replica implementation: %s", impl)
+	}
+
+	db.replicaOperations = map[ReadOperation]bool{}
+	for _, op := range config.Operations {
+		db.replicaOperations[op] = true
+	}
+
+	db.log.Info("read replica configured", zap.Any("operations", config.Operations))
+	return nil
+}
+
+// ChooseReadAdapter selects the adapter for a read operation. The read
+// replica is used, when the operation is routed to it.
+func (db *DB) ChooseReadAdapter(projectID uuid.UUID, op ReadOperation) Adapter {
+	if db.replica != nil && db.replicaOperations[op] {
+		mon.Event("metabase_read_replica", monkit.NewSeriesTag("operation", string(op)))
+		return db.replica
+	}
+	return db.ChooseAdapter(projectID)
+}
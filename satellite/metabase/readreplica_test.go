@@ -0,0 +1,61 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/satellite/metabase"
+)
+
+func TestReadReplicaConfig_Verify(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		config metabase.ReadReplicaConfig
+		valid  bool
+	}{
+		{name: "disabled", config: metabase.ReadReplicaConfig{}, valid: true},
+		{
+			name: "all operations",
+			config: metabase.ReadReplicaConfig{
+				DatabaseURL:  "postgres://replica",
+				Operations:   []metabase.ReadOperation{metabase.ReadListObjects, metabase.ReadListSegments, metabase.ReadRangedLoop},
+				MaxStaleness: 10 * time.Second,
+			},
+			valid: true,
+		},
+		{
+			name: "unknown operation",
+			config: metabase.ReadReplicaConfig{
+				DatabaseURL: "postgres://replica",
+				Operations:  []metabase.ReadOperation{"get-object"},
+			},
+		},
+		{
+			name: "operations without replica",
+			config: metabase.ReadReplicaConfig{
+				Operations: []metabase.ReadOperation{metabase.ReadListObjects},
+			},
+		},
+		{
+			name: "negative staleness",
+			config: metabase.ReadReplicaConfig{
+				DatabaseURL:  "postgres://replica",
+				MaxStaleness: -time.Second,
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.config.Verify()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,88 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package segmentdeletion
+
+import (
+	"context"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/sync2"
+	"storj.io/storj/satellite/metabase"
+)
+
+var (
+	// Error defines the segmentdeletion chore errors class.
+	Error = errs.Class("deferred segment deletion chore")
+	mon   = monkit.Package()
+)
+
+// Config contains configurable values for deleting the segments of deleted objects.
+type Config struct {
+	Enabled   bool          `help:"set if the segments of deleted objects with deferred segment deletion are deleted" default:"false"`
+	Interval  time.Duration `help:"the time between each attempt to delete the queued segments" releaseDefault:"1m" devDefault:"10s"`
+	ListLimit int           `help:"how many queued streams to process in each attempt" default:"100"`
+	BatchSize int           `help:"how many segments to delete in a single transaction" default:"50"`
+}
+
+// Chore implements the deferred segment deletion chore.
+//
+// architecture: Chore
+type Chore struct {
+	log      *zap.Logger
+	config   Config
+	metabase *metabase.DB
+
+	Loop *sync2.Cycle
+}
+
+// NewChore creates a new instance of the segmentdeletion chore.
+func NewChore(log *zap.Logger, config Config, metabase *metabase.DB) *Chore {
+	return &Chore{
+		log:      log,
+		config:   config,
+		metabase: metabase,
+
+		Loop: sync2.NewCycle(config.Interval),
+	}
+}
+
+// Run starts the segmentdeletion loop.
+func (chore *Chore) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if !chore.config.Enabled {
+		return nil
+	}
+
+	return chore.Loop.Run(ctx, func(ctx context.Context) error {
+		if err := chore.DeleteSegments(ctx); err != nil {
+			chore.log.Error("deleting deferred segments failed", zap.Error(err))
+		}
+		return nil
+	})
+}
+
+// Close stops the segmentdeletion chore.
+func (chore *Chore) Close() error {
+	chore.Loop.Close()
+	return nil
+}
+
+// DeleteSegments deletes the segments of the oldest queued streams.
+func (chore *Chore) DeleteSegments(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	deleted, err := chore.metabase.DeleteDeferredSegments(ctx, metabase.DeleteDeferredSegments{
+		StreamLimit: chore.config.ListLimit,
+		BatchSize:   chore.config.BatchSize,
+	})
+	if deleted > 0 {
+		chore.log.Debug("deleted deferred segments", zap.Int64("Segments", deleted))
+	}
+	return Error.Wrap(err)
+}
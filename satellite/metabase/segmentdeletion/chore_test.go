@@ -0,0 +1,52 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package segmentdeletion_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/common/memory"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite"
+)
+
+func TestDeleteSegments(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Metainfo.DeferSegmentDeletion = true
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		upl := planet.Uplinks[0]
+		chore := sat.Core.SegmentDeletion.Chore
+
+		require.NoError(t, upl.Upload(ctx, sat, "testbucket", "deleted", testrand.Bytes(10*memory.KiB)))
+		require.NoError(t, upl.Upload(ctx, sat, "testbucket", "kept", testrand.Bytes(10*memory.KiB)))
+		require.NoError(t, upl.DeleteObject(ctx, sat, "testbucket", "deleted"))
+
+		// the segment of the deleted object is kept until the chore runs.
+		segments, err := sat.Metabase.DB.TestingAllSegments(ctx)
+		require.NoError(t, err)
+		require.Len(t, segments, 2)
+
+		require.NoError(t, chore.DeleteSegments(ctx))
+
+		objects, err := sat.Metabase.DB.TestingAllObjects(ctx)
+		require.NoError(t, err)
+		require.Len(t, objects, 1)
+
+		segments, err = sat.Metabase.DB.TestingAllSegments(ctx)
+		require.NoError(t, err)
+		require.Len(t, segments, 1)
+		require.Equal(t, objects[0].StreamID, segments[0].StreamID)
+	})
+}
@@ -0,0 +1,12 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+/*
+Package segmentdeletion contains the chore which deletes the segments of
+deleted objects, when the metabase defers their deletion.
+
+With metainfo.defer-segment-deletion the object delete only enqueues the
+stream of the object, so the delete transaction doesn't grow with the number
+of segments. The chore deletes the queued segments in batches of limited size.
+*/
+package segmentdeletion
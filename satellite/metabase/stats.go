@@ -38,6 +38,11 @@ func (db *DB) GetTableStats(ctx context.Context, opts GetTableStats) (result Tab
 // GetTableStats implements Adapter.
 func (p *PostgresAdapter) GetTableStats(ctx context.Context, opts GetTableStats) (result TableStats, err error) {
 	defer mon.Task()(&ctx)(&err)
+
+	const tag = queryTag("get_table_stats")
+	done := tag.observe(p.impl.String())
+	defer func() { done(rowsFound(err), err) }()
+
 	err = p.db.QueryRowContext(ctx, `SELECT count(1) FROM segments`).Scan(&result.SegmentCount)
 	if err != nil {
 		return TableStats{}, err
@@ -47,6 +52,10 @@ func (p *PostgresAdapter) GetTableStats(ctx context.Context, opts GetTableStats)
 
 // GetTableStats implements Adapter.
 func (c *CockroachAdapter) GetTableStats(ctx context.Context, opts GetTableStats) (result TableStats, err error) {
+	const tag = queryTag("get_table_stats")
+	done := tag.observe(c.impl.String())
+	defer func() { done(rowsFound(err), err) }()
+
 	// if it's cockroach and statistics are up to date we will use them to get segments count
 	var created time.Time
 	err = c.db.QueryRowContext(ctx, `WITH stats AS (SHOW STATISTICS FOR TABLE segments) SELECT row_count, created FROM stats ORDER BY created DESC LIMIT 1`).
@@ -67,6 +76,10 @@ func (c *CockroachAdapter) GetTableStats(ctx context.Context, opts GetTableStats
 
 // GetTableStats (will) implement Adapter.
 func (s *SpannerAdapter) GetTableStats(ctx context.Context, opts GetTableStats) (result TableStats, err error) {
+	const tag = queryTag("get_table_stats")
+	done := tag.observe(s.Name())
+	defer func() { done(rowsFound(err), err) }()
+
 	//TODO:spanner use https://cloud.google.com/spanner/docs/introspection/table-sizes-statistics
 	return TableStats{
 		SegmentCount: 0,
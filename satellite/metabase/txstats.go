@@ -0,0 +1,153 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	pgxerrcode "github.com/jackc/pgerrcode"
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/storj/exp-spanner"
+	"google.golang.org/grpc/codes"
+
+	"storj.io/storj/shared/dbutil/pgutil/pgerrcode"
+)
+
+// TransactionStats contains retry and abort statistics of the transactions of a single
+// metabase operation.
+type TransactionStats struct {
+	Adapter   string
+	Operation string
+
+	// Transactions is the number of finished transactions.
+	Transactions int64
+	// Retries is the number of times a transaction was restarted.
+	Retries int64
+	// MaxRetries is the highest number of restarts of a single transaction.
+	MaxRetries int64
+	// Conflicts is the number of attempts which failed due to contention with other transactions.
+	Conflicts int64
+	// Aborts is the number of transactions which eventually failed.
+	Aborts int64
+}
+
+// transactionStats collects TransactionStats per operation.
+type transactionStats struct {
+	mu          sync.Mutex
+	byOperation map[string]*TransactionStats
+}
+
+// record adds the outcome of a single transaction, which took the specified number of attempts.
+func (stats *transactionStats) record(adapter, operation string, attempts int, err error) {
+	retries := int64(attempts - 1)
+	if retries < 0 {
+		retries = 0
+	}
+	// every retry is caused by a conflict, the last attempt may have been one as well.
+	conflicts := retries
+	if isTransactionConflict(err) {
+		conflicts++
+	}
+
+	tags := []monkit.SeriesTag{
+		monkit.NewSeriesTag("adapter", adapter),
+		monkit.NewSeriesTag("operation", operation),
+	}
+	mon.IntVal("metabase_transaction_retries", tags...).Observe(retries)
+	mon.Counter("metabase_transaction_conflicts", tags...).Inc(conflicts)
+	if err != nil {
+		mon.Counter("metabase_transaction_aborts", tags...).Inc(1)
+	}
+
+	stats.mu.Lock()
+	defer stats.mu.Unlock()
+
+	if stats.byOperation == nil {
+		stats.byOperation = map[string]*TransactionStats{}
+	}
+	entry, ok := stats.byOperation[operation]
+	if !ok {
+		entry = &TransactionStats{Adapter: adapter, Operation: operation}
+		stats.byOperation[operation] = entry
+	}
+
+	entry.Transactions++
+	entry.Retries += retries
+	if retries > entry.MaxRetries {
+		entry.MaxRetries = retries
+	}
+	entry.Conflicts += conflicts
+	if err != nil {
+		entry.Aborts++
+	}
+}
+
+// snapshot returns a copy of the collected stats, sorted by operation.
+func (stats *transactionStats) snapshot() []TransactionStats {
+	stats.mu.Lock()
+	defer stats.mu.Unlock()
+
+	result := make([]TransactionStats, 0, len(stats.byOperation))
+	for _, entry := range stats.byOperation {
+		result = append(result, *entry)
+	}
+	sort.Slice(result, func(i, k int) bool {
+		return result[i].Operation < result[k].Operation
+	})
+	return result
+}
+
+// isTransactionConflict returns whether err is caused by contention with another transaction.
+func isTransactionConflict(err error) bool {
+	if err == nil {
+		return false
+	}
+	if code := pgerrcode.FromError(err); code == pgxerrcode.SerializationFailure || code == "CR000" {
+		return true
+	}
+	return spanner.ErrCode(err) == codes.Aborted
+}
+
+// withTxStats calls WithTx and records the retries and aborts of the transaction under operation.
+func (p *PostgresAdapter) withTxStats(ctx context.Context, operation string, f func(context.Context, TransactionAdapter) error) error {
+	attempts := 0
+	err := p.WithTx(ctx, func(ctx context.Context, tx TransactionAdapter) error {
+		attempts++
+		return f(ctx, tx)
+	})
+	p.txStats.record(p.impl.String(), operation, attempts, err)
+	return err
+}
+
+// TransactionStats returns retry and abort statistics of the transactions per operation.
+func (p *PostgresAdapter) TransactionStats() []TransactionStats {
+	return p.txStats.snapshot()
+}
+
+// withTxStats calls WithTx and records the retries and aborts of the transaction under operation.
+func (s *SpannerAdapter) withTxStats(ctx context.Context, operation string, f func(context.Context, TransactionAdapter) error) error {
+	attempts := 0
+	err := s.WithTx(ctx, func(ctx context.Context, tx TransactionAdapter) error {
+		attempts++
+		return f(ctx, tx)
+	})
+	s.txStats.record(s.Name(), operation, attempts, err)
+	return err
+}
+
+// TransactionStats returns retry and abort statistics of the transactions per operation.
+func (s *SpannerAdapter) TransactionStats() []TransactionStats {
+	return s.txStats.snapshot()
+}
+
+// TransactionStats returns retry and abort statistics of metabase transactions, per adapter and operation.
+func (db *DB) TransactionStats() []TransactionStats {
+	var result []TransactionStats
+	for _, adapter := range db.adapters {
+		result = append(result, adapter.TransactionStats()...)
+	}
+	return result
+}
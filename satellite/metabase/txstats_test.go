@@ -0,0 +1,37 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/metabasetest"
+)
+
+func TestTransactionStats(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+		for i := 0; i < 3; i++ {
+			metabasetest.CreateObject(ctx, t, db, metabasetest.RandObjectStream(), 1)
+		}
+
+		var commitStats *metabase.TransactionStats
+		for _, stats := range db.TransactionStats() {
+			if stats.Operation == "commit_object" {
+				stats := stats
+				commitStats = &stats
+			}
+		}
+		require.NotNil(t, commitStats)
+		require.Equal(t, db.ChooseAdapter(testrand.UUID()).Name(), commitStats.Adapter)
+		require.GreaterOrEqual(t, commitStats.Transactions, int64(3))
+		require.Zero(t, commitStats.Aborts)
+	})
+}
@@ -0,0 +1,114 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase
+
+import (
+	"context"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"go.uber.org/zap"
+)
+
+// SlowTransactionConfig configures when precommit and delete transactions are
+// considered slow. A zero threshold disables the logging of the respective
+// transactions, their duration is still measured.
+type SlowTransactionConfig struct {
+	PrecommitThreshold time.Duration
+	DeleteThreshold    time.Duration
+}
+
+// transactionTrace measures a single precommit or delete transaction. Every
+// transaction is observed in the metabase_transaction_duration histogram of its
+// operation. A transaction, which takes longer than the threshold, is also logged
+// together with its retries and the number of rows it deleted.
+type transactionTrace struct {
+	log       *zap.Logger
+	adapter   string
+	operation string
+	location  ObjectLocation
+	threshold time.Duration
+
+	start           time.Time
+	attempts        int
+	deletedObjects  int
+	deletedSegments int
+}
+
+// tracePrecommit starts tracing a transaction, which commits an object at location.
+func (db *DB) tracePrecommit(adapter Adapter, operation string, location ObjectLocation) *transactionTrace {
+	return db.traceTransaction(adapter, operation, location, db.config.SlowTransactions.PrecommitThreshold)
+}
+
+// traceDelete starts tracing a transaction, which deletes an object at location.
+func (db *DB) traceDelete(adapter Adapter, operation string, location ObjectLocation) *transactionTrace {
+	return db.traceTransaction(adapter, operation, location, db.config.SlowTransactions.DeleteThreshold)
+}
+
+func (db *DB) traceTransaction(adapter Adapter, operation string, location ObjectLocation, threshold time.Duration) *transactionTrace {
+	return &transactionTrace{
+		log:       db.log,
+		adapter:   adapter.Name(),
+		operation: operation,
+		location:  location,
+		threshold: threshold,
+		start:     time.Now(),
+	}
+}
+
+// wrap returns f, which additionally counts the attempts of the transaction.
+func (trace *transactionTrace) wrap(f func(context.Context, TransactionAdapter) error) func(context.Context, TransactionAdapter) error {
+	return func(ctx context.Context, tx TransactionAdapter) error {
+		trace.attempts++
+		return f(ctx, tx)
+	}
+}
+
+// deleted sets the number of rows deleted by the last attempt of the transaction.
+func (trace *transactionTrace) deleted(objects, segments int) {
+	trace.deletedObjects = objects
+	trace.deletedSegments = segments
+}
+
+// removed sets the number of rows deleted together with the objects.
+func (trace *transactionTrace) removed(objects []Object) {
+	segments := 0
+	for _, object := range objects {
+		segments += int(object.SegmentCount)
+	}
+	trace.deleted(len(objects), segments)
+}
+
+// finish records the duration of the transaction and logs it, when it was slow.
+func (trace *transactionTrace) finish(err error) {
+	duration := time.Since(trace.start)
+
+	tags := []monkit.SeriesTag{
+		monkit.NewSeriesTag("adapter", trace.adapter),
+		monkit.NewSeriesTag("operation", trace.operation),
+	}
+	mon.DurationVal("metabase_transaction_duration", tags...).Observe(duration)
+
+	if trace.threshold <= 0 || duration <= trace.threshold {
+		return
+	}
+	mon.Counter("metabase_slow_transactions", tags...).Inc(1)
+
+	fields := []zap.Field{
+		zap.String("Request Tag", queryTag(trace.operation).requestTag()),
+		zap.String("Adapter", trace.adapter),
+		zap.Stringer("Project", trace.location.ProjectID),
+		zap.String("Bucket", trace.location.BucketName),
+		zap.Duration("Duration", duration),
+		zap.Duration("Threshold", trace.threshold),
+		zap.Int("Deleted Objects", trace.deletedObjects),
+		zap.Int("Deleted Segments", trace.deletedSegments),
+		zap.Error(err),
+	}
+	// the retries are only known for transactions run through wrap.
+	if trace.attempts > 0 {
+		fields = append(fields, zap.Int("Retries", trace.attempts-1))
+	}
+	trace.log.Warn("slow metabase transaction", fields...)
+}
@@ -0,0 +1,96 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+
+	"storj.io/common/testrand"
+)
+
+func TestTransactionTrace(t *testing.T) {
+	core, logs := observer.New(zap.WarnLevel)
+
+	location := ObjectLocation{
+		ProjectID:  testrand.UUID(),
+		BucketName: "bucket",
+		ObjectKey:  "key",
+	}
+	newTrace := func(operation string, threshold, elapsed time.Duration) *transactionTrace {
+		return &transactionTrace{
+			log:       zap.New(core),
+			adapter:   "test-trace",
+			operation: operation,
+			location:  location,
+			threshold: threshold,
+			start:     time.Now().Add(-elapsed),
+		}
+	}
+
+	transactions := func(operation string) (count int64) {
+		monkit.Default.Stats(func(key monkit.SeriesKey, field string, val float64) {
+			if key.Measurement == "metabase_transaction_duration" && field == "count" &&
+				key.Tags.Get("adapter") == "test-trace" && key.Tags.Get("operation") == operation {
+				count = int64(val)
+			}
+		})
+		return count
+	}
+
+	// a fast transaction is only measured.
+	fast := newTrace("fast", time.Minute, 0)
+	fast.finish(nil)
+	require.Zero(t, logs.Len())
+	require.EqualValues(t, 1, transactions("fast"))
+
+	// a zero threshold disables logging.
+	disabled := newTrace("disabled", 0, time.Hour)
+	disabled.finish(nil)
+	require.Zero(t, logs.Len())
+	require.EqualValues(t, 1, transactions("disabled"))
+
+	// a slow transaction is logged with its retries and deleted rows.
+	slow := newTrace("slow", time.Second, time.Minute)
+	f := slow.wrap(func(ctx context.Context, tx TransactionAdapter) error {
+		slow.deleted(1, 3)
+		return nil
+	})
+	for i := 0; i < 3; i++ {
+		require.NoError(t, f(context.Background(), nil))
+	}
+	slow.finish(errors.New("conflict"))
+	require.EqualValues(t, 1, transactions("slow"))
+
+	entries := logs.TakeAll()
+	require.Len(t, entries, 1)
+	fields := entries[0].ContextMap()
+	require.Equal(t, "metabase:slow", fields["Request Tag"])
+	require.Equal(t, "test-trace", fields["Adapter"])
+	require.Equal(t, location.ProjectID.String(), fields["Project"])
+	require.Equal(t, "bucket", fields["Bucket"])
+	require.EqualValues(t, 2, fields["Retries"])
+	require.EqualValues(t, 1, fields["Deleted Objects"])
+	require.EqualValues(t, 3, fields["Deleted Segments"])
+	require.Equal(t, "conflict", fields["error"])
+
+	// the retries aren't known for transactions, which weren't wrapped.
+	plain := newTrace("plain", time.Second, time.Minute)
+	plain.removed([]Object{{SegmentCount: 2}, {SegmentCount: 5}})
+	plain.finish(nil)
+
+	entries = logs.TakeAll()
+	require.Len(t, entries, 1)
+	fields = entries[0].ContextMap()
+	require.NotContains(t, fields, "Retries")
+	require.EqualValues(t, 2, fields["Deleted Objects"])
+	require.EqualValues(t, 7, fields["Deleted Segments"])
+}
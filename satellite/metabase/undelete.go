@@ -0,0 +1,233 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/storj/exp-spanner"
+	"github.com/zeebo/errs"
+	"google.golang.org/api/iterator"
+
+	"storj.io/storj/shared/dbutil/spannerutil"
+)
+
+// ErrObjectLock is used when an object lock retention period prevents an operation.
+var ErrObjectLock = errs.Class("object lock")
+
+// retentionModeCompliance is the value of objects.retention_mode for the compliance mode.
+const retentionModeCompliance = 1
+
+// UndeleteObject contains arguments necessary for removing the latest
+// delete marker of an object.
+type UndeleteObject struct {
+	ObjectLocation
+}
+
+// UndeleteObjectResult is the result of removing a delete marker.
+type UndeleteObjectResult struct {
+	// DeleteMarker is the removed delete marker.
+	DeleteMarker Object
+	// Object is the version which became the latest committed version. It's
+	// nil when the delete marker didn't hide any object version.
+	Object *Object
+}
+
+// latestObjectVersion is the latest non-pending version of an object with
+// its retention settings.
+type latestObjectVersion struct {
+	Object
+
+	RetentionMode int
+	RetainUntil   *time.Time
+}
+
+// locked returns whether the version is under an active compliance retention.
+func (latest *latestObjectVersion) locked(now time.Time) bool {
+	return retentionActive(latest.RetentionMode, latest.RetainUntil, now)
+}
+
+// retentionActive returns whether the retention settings of an object version
+// are an active compliance retention.
+func retentionActive(mode int, retainUntil *time.Time, now time.Time) bool {
+	return mode == retentionModeCompliance && retainUntil != nil && retainUntil.After(now)
+}
+
+type undeleteTransactionAdapter interface {
+	getLatestObjectVersion(ctx context.Context, location ObjectLocation) (latest latestObjectVersion, err error)
+	deleteDeleteMarker(ctx context.Context, location ObjectLocation, version Version) (deleted int64, err error)
+}
+
+// UndeleteObject removes the delete marker, which is the latest version of
+// an object, so the previous version becomes visible again. It fails with
+// ErrFailedPrecondition when the latest version isn't a delete marker, and
+// with ErrObjectLock when the delete marker is under an object lock retention.
+func (db *DB) UndeleteObject(ctx context.Context, opts UndeleteObject) (result UndeleteObjectResult, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := opts.Verify(); err != nil {
+		return UndeleteObjectResult{}, err
+	}
+
+	now := time.Now()
+	err = db.ChooseAdapter(opts.ProjectID).withTxStats(ctx, "undelete_object", func(ctx context.Context, adapter TransactionAdapter) error {
+		latest, err := adapter.getLatestObjectVersion(ctx, opts.ObjectLocation)
+		if err != nil {
+			return err
+		}
+
+		if !latest.Status.IsDeleteMarker() {
+			return ErrFailedPrecondition.New("the latest version of the object is not a delete marker")
+		}
+		if latest.locked(now) {
+			return ErrObjectLock.New("the delete marker is retained until %s", latest.RetainUntil.Format(time.RFC3339))
+		}
+
+		deleted, err := adapter.deleteDeleteMarker(ctx, opts.ObjectLocation, latest.Version)
+		if err != nil {
+			return err
+		}
+		if deleted == 0 {
+			return ErrConflict.New("the delete marker was concurrently removed")
+		}
+
+		result.DeleteMarker = latest.Object
+		return nil
+	})
+	if err != nil {
+		return UndeleteObjectResult{}, err
+	}
+
+	restored, err := db.GetObjectLastCommitted(ctx, GetObjectLastCommitted{ObjectLocation: opts.ObjectLocation})
+	switch {
+	case ErrObjectNotFound.Has(err):
+	case err != nil:
+		return UndeleteObjectResult{}, err
+	default:
+		result.Object = &restored
+	}
+
+	mon.Meter("object_undelete").Mark(1)
+
+	return result, nil
+}
+
+func (ptx *postgresTransactionAdapter) getLatestObjectVersion(ctx context.Context, location ObjectLocation) (latest latestObjectVersion, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	latest.ProjectID = location.ProjectID
+	latest.BucketName = location.BucketName
+	latest.ObjectKey = location.ObjectKey
+
+	err = ptx.tx.QueryRowContext(ctx, `
+		SELECT version, stream_id, status, created_at, retention_mode, retain_until
+		FROM objects
+		WHERE
+			(project_id, bucket_name, object_key) = ($1, $2, $3)
+			AND status <> `+statusPending+`
+		ORDER BY version DESC
+		LIMIT 1
+		FOR UPDATE
+	`, location.ProjectID, []byte(location.BucketName), location.ObjectKey).Scan(
+		&latest.Version, &latest.StreamID, &latest.Status, &latest.CreatedAt,
+		&latest.RetentionMode, &latest.RetainUntil,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return latestObjectVersion{}, ErrObjectNotFound.Wrap(Error.Wrap(err))
+	}
+	if err != nil {
+		return latestObjectVersion{}, Error.New("unable to query latest object version: %w", err)
+	}
+	return latest, nil
+}
+
+func (ptx *postgresTransactionAdapter) deleteDeleteMarker(ctx context.Context, location ObjectLocation, version Version) (deleted int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	result, err := ptx.tx.ExecContext(ctx, `
+		DELETE FROM objects
+		WHERE
+			(project_id, bucket_name, object_key, version) = ($1, $2, $3, $4)
+			AND status IN `+statusesDeleteMarker+`
+	`, location.ProjectID, []byte(location.BucketName), location.ObjectKey, version)
+	if err != nil {
+		return 0, Error.New("unable to delete delete marker: %w", err)
+	}
+
+	deleted, err = result.RowsAffected()
+	if err != nil {
+		return 0, Error.New("unable to delete delete marker: %w", err)
+	}
+	return deleted, nil
+}
+
+func (stx *spannerTransactionAdapter) getLatestObjectVersion(ctx context.Context, location ObjectLocation) (latest latestObjectVersion, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	latest.ProjectID = location.ProjectID
+	latest.BucketName = location.BucketName
+	latest.ObjectKey = location.ObjectKey
+
+	result := stx.tx.Query(ctx, spanner.Statement{
+		SQL: `
+			SELECT version, stream_id, status, created_at, retention_mode, retain_until
+			FROM objects
+			WHERE
+				project_id = @project_id AND bucket_name = @bucket_name AND object_key = @object_key
+				AND status <> ` + statusPending + `
+			ORDER BY version DESC
+			LIMIT 1
+		`,
+		Params: map[string]interface{}{
+			"project_id":  location.ProjectID,
+			"bucket_name": location.BucketName,
+			"object_key":  location.ObjectKey,
+		},
+	})
+	defer result.Stop()
+
+	row, err := result.Next()
+	if err != nil {
+		if errors.Is(err, iterator.Done) {
+			return latestObjectVersion{}, ErrObjectNotFound.Wrap(Error.Wrap(sql.ErrNoRows))
+		}
+		return latestObjectVersion{}, Error.New("unable to query latest object version: %w", err)
+	}
+
+	err = row.Columns(
+		&latest.Version, &latest.StreamID, &latest.Status, &latest.CreatedAt,
+		spannerutil.Int(&latest.RetentionMode), &latest.RetainUntil,
+	)
+	if err != nil {
+		return latestObjectVersion{}, Error.New("unable to read latest object version: %w", err)
+	}
+	return latest, nil
+}
+
+func (stx *spannerTransactionAdapter) deleteDeleteMarker(ctx context.Context, location ObjectLocation, version Version) (deleted int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	deleted, err = stx.tx.Update(ctx, spanner.Statement{
+		SQL: `
+			DELETE FROM objects
+			WHERE
+				project_id = @project_id AND bucket_name = @bucket_name AND object_key = @object_key
+				AND version = @version
+				AND status IN ` + statusesDeleteMarker + `
+		`,
+		Params: map[string]interface{}{
+			"project_id":  location.ProjectID,
+			"bucket_name": location.BucketName,
+			"object_key":  location.ObjectKey,
+			"version":     version,
+		},
+	})
+	if err != nil {
+		return 0, Error.New("unable to delete delete marker: %w", err)
+	}
+	return deleted, nil
+}
@@ -0,0 +1,93 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/metabasetest"
+)
+
+func TestUndeleteObject(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		t.Run("invalid location", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			_, err := db.UndeleteObject(ctx, metabase.UndeleteObject{})
+			require.True(t, metabase.ErrInvalidRequest.Has(err), err)
+		})
+
+		t.Run("missing object", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			obj := metabasetest.RandObjectStream()
+			_, err := db.UndeleteObject(ctx, metabase.UndeleteObject{ObjectLocation: obj.Location()})
+			require.True(t, metabase.ErrObjectNotFound.Has(err), err)
+		})
+
+		t.Run("latest version is not a delete marker", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			obj := metabasetest.RandObjectStream()
+			object := metabasetest.CreateObjectVersioned(ctx, t, db, obj, 0)
+
+			_, err := db.UndeleteObject(ctx, metabase.UndeleteObject{ObjectLocation: obj.Location()})
+			require.True(t, metabase.ErrFailedPrecondition.Has(err), err)
+
+			metabasetest.Verify{
+				Objects: []metabase.RawObject{metabase.RawObject(object)},
+			}.Check(ctx, t, db)
+		})
+
+		t.Run("restores previous version", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			obj := metabasetest.RandObjectStream()
+			object := metabasetest.CreateObjectVersioned(ctx, t, db, obj, 0)
+
+			deleted, err := db.DeleteObjectLastCommitted(ctx, metabase.DeleteObjectLastCommitted{
+				ObjectLocation: obj.Location(),
+				Versioned:      true,
+			})
+			require.NoError(t, err)
+			require.Len(t, deleted.Markers, 1)
+
+			result, err := db.UndeleteObject(ctx, metabase.UndeleteObject{ObjectLocation: obj.Location()})
+			require.NoError(t, err)
+			require.Equal(t, deleted.Markers[0].Version, result.DeleteMarker.Version)
+			require.NotNil(t, result.Object)
+			require.Equal(t, object.Version, result.Object.Version)
+			require.Equal(t, object.StreamID, result.Object.StreamID)
+
+			_, err = db.UndeleteObject(ctx, metabase.UndeleteObject{ObjectLocation: obj.Location()})
+			require.True(t, metabase.ErrFailedPrecondition.Has(err), err)
+
+			metabasetest.Verify{
+				Objects: []metabase.RawObject{metabase.RawObject(object)},
+			}.Check(ctx, t, db)
+		})
+
+		t.Run("delete marker without previous version", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			obj := metabasetest.RandObjectStream()
+			_, err := db.DeleteObjectLastCommitted(ctx, metabase.DeleteObjectLastCommitted{
+				ObjectLocation: obj.Location(),
+				Versioned:      true,
+			})
+			require.NoError(t, err)
+
+			result, err := db.UndeleteObject(ctx, metabase.UndeleteObject{ObjectLocation: obj.Location()})
+			require.NoError(t, err)
+			require.True(t, result.DeleteMarker.Status.IsDeleteMarker())
+			require.Nil(t, result.Object)
+
+			metabasetest.Verify{}.Check(ctx, t, db)
+		})
+	})
+}
@@ -109,6 +109,10 @@ func (db *DB) UpdateSegmentPieces(ctx context.Context, opts UpdateSegmentPieces)
 
 // UpdateSegmentPieces updates pieces for specified segment, if pieces matches oldPieces.
 func (p *PostgresAdapter) UpdateSegmentPieces(ctx context.Context, opts UpdateSegmentPieces, oldPieces, newPieces AliasPieces) (resultPieces AliasPieces, err error) {
+	const tag = queryTag("update_segment_pieces")
+	done := tag.observe(p.impl.String())
+	defer func() { done(rowsFound(err), err) }()
+
 	updateRepairAt := !opts.NewRepairedAt.IsZero()
 
 	err = p.db.QueryRowContext(ctx, `
@@ -142,6 +146,10 @@ func (p *PostgresAdapter) UpdateSegmentPieces(ctx context.Context, opts UpdateSe
 
 // UpdateSegmentPieces updates pieces for specified segment, if pieces matches oldPieces.
 func (s *SpannerAdapter) UpdateSegmentPieces(ctx context.Context, opts UpdateSegmentPieces, oldPieces, newPieces AliasPieces) (resultPieces AliasPieces, err error) {
+	const tag = queryTag("update_segment_pieces")
+	done := tag.observe(s.Name())
+	defer func() { done(rowsFound(err), err) }()
+
 	updateRepairAt := !opts.NewRepairedAt.IsZero()
 
 	_, err = s.client.ReadWriteTransaction(ctx, func(ctx context.Context, tx *spanner.ReadWriteTransaction) error {
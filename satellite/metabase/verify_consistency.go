@@ -0,0 +1,238 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase
+
+import (
+	"context"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/shared/dbutil"
+	"storj.io/storj/shared/dbutil/pgutil"
+	"storj.io/storj/shared/tagsql"
+)
+
+// VerifyConsistency contains arguments necessary for checking the consistency
+// between objects and segments.
+type VerifyConsistency struct {
+	// StartStreamID and EndStreamID bound the checked streams, the range starts
+	// after StartStreamID and includes EndStreamID. A zero EndStreamID means
+	// there's no upper bound.
+	StartStreamID uuid.UUID
+	EndStreamID   uuid.UUID
+
+	// Limit is the maximum number of inconsistencies of each kind to report.
+	Limit int
+
+	// Fix deletes the orphaned segments and recomputes the segment counts
+	// of the reported objects. Otherwise nothing is changed.
+	Fix bool
+}
+
+// Verify verifies consistency request fields.
+func (opts *VerifyConsistency) Verify() error {
+	if opts.Limit < 0 {
+		return ErrInvalidRequest.New("Limit is negative")
+	}
+	if !opts.EndStreamID.IsZero() && !opts.StartStreamID.Less(opts.EndStreamID) {
+		return ErrInvalidRequest.New("EndStreamID must be bigger than StartStreamID")
+	}
+	return nil
+}
+
+// OrphanedStream is a stream which has segments, but no object.
+type OrphanedStream struct {
+	StreamID uuid.UUID
+	Segments int64
+}
+
+// SegmentCountMismatch is a committed object whose segment count differs
+// from the number of its segments.
+type SegmentCountMismatch struct {
+	ObjectStream
+
+	SegmentCount int32
+	Segments     int64
+}
+
+// ConsistencyReport is the result of VerifyConsistency.
+type ConsistencyReport struct {
+	OrphanedStreams        []OrphanedStream
+	SegmentCountMismatches []SegmentCountMismatch
+
+	// DeletedSegments and FixedObjects are only set when fixing.
+	DeletedSegments int64
+	FixedObjects    int64
+}
+
+// VerifyConsistency looks for segments without an object and for committed
+// objects with a wrong segment count in the specified stream range, and fixes
+// them when requested. It's only supported on Postgres and CockroachDB.
+func (db *DB) VerifyConsistency(ctx context.Context, opts VerifyConsistency) (report ConsistencyReport, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := opts.Verify(); err != nil {
+		return ConsistencyReport{}, err
+	}
+	if db.impl == dbutil.Spanner {
+		return ConsistencyReport{}, Error.New("verifying consistency is not supported on %s", db.impl)
+	}
+
+	ListVerifyLimit.Ensure(&opts.Limit)
+	if opts.EndStreamID.IsZero() {
+		opts.EndStreamID = uuid.Max()
+	}
+
+	report.OrphanedStreams, err = db.findOrphanedStreams(ctx, opts)
+	if err != nil {
+		return ConsistencyReport{}, err
+	}
+
+	report.SegmentCountMismatches, err = db.findSegmentCountMismatches(ctx, opts)
+	if err != nil {
+		return ConsistencyReport{}, err
+	}
+
+	if !opts.Fix {
+		return report, nil
+	}
+
+	report.DeletedSegments, err = db.deleteOrphanedStreams(ctx, report.OrphanedStreams)
+	if err != nil {
+		return report, err
+	}
+	mon.Meter("consistency_orphaned_segments_delete").Mark64(report.DeletedSegments)
+
+	report.FixedObjects, err = db.fixSegmentCounts(ctx, report.SegmentCountMismatches)
+	if err != nil {
+		return report, err
+	}
+	mon.Meter("consistency_segment_count_fix").Mark64(report.FixedObjects)
+
+	return report, nil
+}
+
+func (db *DB) findOrphanedStreams(ctx context.Context, opts VerifyConsistency) (streams []OrphanedStream, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = withRows(db.db.QueryContext(ctx, `
+		SELECT stream_id, count(*)
+		FROM segments
+		WHERE
+			stream_id > $1 AND stream_id <= $2
+			AND NOT EXISTS (
+				SELECT 1 FROM objects WHERE objects.stream_id = segments.stream_id
+			)
+		GROUP BY stream_id
+		ORDER BY stream_id
+		LIMIT $3
+	`, opts.StartStreamID, opts.EndStreamID, opts.Limit))(func(rows tagsql.Rows) error {
+		for rows.Next() {
+			var stream OrphanedStream
+			if err := rows.Scan(&stream.StreamID, &stream.Segments); err != nil {
+				return Error.Wrap(err)
+			}
+			streams = append(streams, stream)
+		}
+		return nil
+	})
+	return streams, Error.Wrap(err)
+}
+
+func (db *DB) findSegmentCountMismatches(ctx context.Context, opts VerifyConsistency) (mismatches []SegmentCountMismatch, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = withRows(db.db.QueryContext(ctx, `
+		SELECT
+			project_id, bucket_name, object_key, version, stream_id,
+			segment_count, actual_segments
+		FROM (
+			SELECT
+				project_id, bucket_name, object_key, version, stream_id,
+				segment_count,
+				(SELECT count(*) FROM segments WHERE segments.stream_id = objects.stream_id) AS actual_segments
+			FROM objects
+			WHERE
+				stream_id > $1 AND stream_id <= $2
+				AND status IN `+statusesCommitted+`
+		) AS counted
+		WHERE segment_count <> actual_segments
+		ORDER BY stream_id
+		LIMIT $3
+	`, opts.StartStreamID, opts.EndStreamID, opts.Limit))(func(rows tagsql.Rows) error {
+		for rows.Next() {
+			var mismatch SegmentCountMismatch
+			err := rows.Scan(
+				&mismatch.ProjectID, &mismatch.BucketName, &mismatch.ObjectKey, &mismatch.Version, &mismatch.StreamID,
+				&mismatch.SegmentCount, &mismatch.Segments,
+			)
+			if err != nil {
+				return Error.Wrap(err)
+			}
+			mismatches = append(mismatches, mismatch)
+		}
+		return nil
+	})
+	return mismatches, Error.Wrap(err)
+}
+
+// deleteOrphanedStreams deletes the segments of the streams, which still don't
+// have an object.
+func (db *DB) deleteOrphanedStreams(ctx context.Context, streams []OrphanedStream) (deleted int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(streams) == 0 {
+		return 0, nil
+	}
+
+	streamIDs := make([]uuid.UUID, 0, len(streams))
+	for _, stream := range streams {
+		streamIDs = append(streamIDs, stream.StreamID)
+	}
+
+	result, err := db.db.ExecContext(ctx, `
+		DELETE FROM segments
+		WHERE
+			stream_id = ANY($1)
+			AND NOT EXISTS (
+				SELECT 1 FROM objects WHERE objects.stream_id = segments.stream_id
+			)
+	`, pgutil.UUIDArray(streamIDs))
+	if err != nil {
+		return 0, Error.New("unable to delete orphaned segments: %w", err)
+	}
+
+	deleted, err = result.RowsAffected()
+	if err != nil {
+		return 0, Error.New("unable to delete orphaned segments: %w", err)
+	}
+	return deleted, nil
+}
+
+// fixSegmentCounts recomputes the segment counts of the objects, unless they
+// were changed since they were checked.
+func (db *DB) fixSegmentCounts(ctx context.Context, mismatches []SegmentCountMismatch) (fixed int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	for _, mismatch := range mismatches {
+		result, err := db.db.ExecContext(ctx, `
+			UPDATE objects SET
+				segment_count = (SELECT count(*) FROM segments WHERE segments.stream_id = objects.stream_id)
+			WHERE
+				(project_id, bucket_name, object_key, version) = ($1, $2, $3, $4)
+				AND stream_id = $5
+				AND segment_count = $6
+		`, mismatch.ProjectID, []byte(mismatch.BucketName), mismatch.ObjectKey, mismatch.Version, mismatch.StreamID,
+			mismatch.SegmentCount)
+		if err != nil {
+			return fixed, Error.New("unable to fix segment count: %w", err)
+		}
+
+		affected, err := result.RowsAffected()
+		if err != nil {
+			return fixed, Error.New("unable to fix segment count: %w", err)
+		}
+		fixed += affected
+	}
+	return fixed, nil
+}
@@ -0,0 +1,118 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/metabasetest"
+	"storj.io/storj/shared/dbutil"
+)
+
+func TestVerifyConsistency(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		if db.Implementation() == dbutil.Spanner {
+			_, err := db.VerifyConsistency(ctx, metabase.VerifyConsistency{})
+			require.Error(t, err)
+			t.Skip("verifying consistency is not supported on spanner")
+		}
+
+		defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+		_, err := db.VerifyConsistency(ctx, metabase.VerifyConsistency{Limit: -1})
+		require.True(t, metabase.ErrInvalidRequest.Has(err), err)
+
+		_, err = db.VerifyConsistency(ctx, metabase.VerifyConsistency{
+			StartStreamID: uuid.UUID{2},
+			EndStreamID:   uuid.UUID{1},
+		})
+		require.True(t, metabase.ErrInvalidRequest.Has(err), err)
+
+		consistent := metabasetest.RandObjectStream()
+		consistent.StreamID = uuid.UUID{1}
+		metabasetest.CreateObject(ctx, t, db, consistent, 2)
+
+		// segments of a stream without an object.
+		orphaned := metabasetest.RandObjectStream()
+		orphaned.StreamID = uuid.UUID{2}
+		require.NoError(t, db.TestingBatchInsertSegments(ctx, []metabase.RawSegment{
+			metabasetest.DefaultRawSegment(orphaned, metabase.SegmentPosition{Index: 0}),
+			metabasetest.DefaultRawSegment(orphaned, metabase.SegmentPosition{Index: 1}),
+		}))
+
+		// an object with more segments than its segment count.
+		miscounted := metabasetest.RandObjectStream()
+		miscounted.StreamID = uuid.UUID{3}
+		metabasetest.CreateObject(ctx, t, db, miscounted, 1)
+		require.NoError(t, db.TestingBatchInsertSegments(ctx, []metabase.RawSegment{
+			metabasetest.DefaultRawSegment(miscounted, metabase.SegmentPosition{Index: 1}),
+		}))
+
+		expected := metabase.ConsistencyReport{
+			OrphanedStreams: []metabase.OrphanedStream{
+				{StreamID: orphaned.StreamID, Segments: 2},
+			},
+			SegmentCountMismatches: []metabase.SegmentCountMismatch{
+				{ObjectStream: miscounted, SegmentCount: 1, Segments: 2},
+			},
+		}
+
+		// nothing is changed without fixing.
+		report, err := db.VerifyConsistency(ctx, metabase.VerifyConsistency{})
+		require.NoError(t, err)
+		require.Equal(t, expected, report)
+
+		report, err = db.VerifyConsistency(ctx, metabase.VerifyConsistency{})
+		require.NoError(t, err)
+		require.Equal(t, expected, report)
+
+		// only the streams in the range are checked.
+		report, err = db.VerifyConsistency(ctx, metabase.VerifyConsistency{
+			StartStreamID: uuid.UUID{1},
+			EndStreamID:   uuid.UUID{2},
+		})
+		require.NoError(t, err)
+		require.Equal(t, metabase.ConsistencyReport{
+			OrphanedStreams: expected.OrphanedStreams,
+		}, report)
+
+		report, err = db.VerifyConsistency(ctx, metabase.VerifyConsistency{
+			StartStreamID: uuid.UUID{2},
+		})
+		require.NoError(t, err)
+		require.Equal(t, metabase.ConsistencyReport{
+			SegmentCountMismatches: expected.SegmentCountMismatches,
+		}, report)
+
+		expected.DeletedSegments = 2
+		expected.FixedObjects = 1
+
+		report, err = db.VerifyConsistency(ctx, metabase.VerifyConsistency{Fix: true})
+		require.NoError(t, err)
+		require.Equal(t, expected, report)
+
+		report, err = db.VerifyConsistency(ctx, metabase.VerifyConsistency{})
+		require.NoError(t, err)
+		require.Equal(t, metabase.ConsistencyReport{}, report)
+
+		objects, err := db.TestingAllObjects(ctx)
+		require.NoError(t, err)
+		require.Len(t, objects, 2)
+		for _, object := range objects {
+			require.EqualValues(t, 2, object.SegmentCount)
+		}
+
+		segments, err := db.TestingAllSegments(ctx)
+		require.NoError(t, err)
+		require.Len(t, segments, 4)
+		for _, segment := range segments {
+			require.NotEqual(t, orphaned.StreamID, segment.StreamID)
+		}
+	})
+}
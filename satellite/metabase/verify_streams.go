@@ -0,0 +1,93 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase
+
+import (
+	"context"
+	"time"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/shared/dbutil/pgutil"
+	"storj.io/storj/shared/tagsql"
+)
+
+// GetStreamObjects contains arguments necessary for fetching the objects of streams.
+type GetStreamObjects struct {
+	StreamIDs []uuid.UUID
+}
+
+// StreamObject is the part of an object needed to verify it against its segments.
+type StreamObject struct {
+	ObjectStream
+
+	Status             ObjectStatus
+	ExpiresAt          *time.Time
+	SegmentCount       int32
+	TotalEncryptedSize int64
+}
+
+// Expired checks if object is expired relative to now.
+func (o StreamObject) Expired(now time.Time) bool {
+	return o.ExpiresAt != nil && o.ExpiresAt.Before(now)
+}
+
+// GetStreamObjects returns the objects which own the specified streams. Streams
+// without an object are not part of the result.
+func (db *DB) GetStreamObjects(ctx context.Context, opts GetStreamObjects) (result []StreamObject, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(opts.StreamIDs) == 0 {
+		return nil, nil
+	}
+	if len(opts.StreamIDs) > int(ListVerifyLimit) {
+		return nil, ErrInvalidRequest.New("too many stream IDs: %d", len(opts.StreamIDs))
+	}
+
+	err = withRows(db.db.QueryContext(ctx, `
+		SELECT
+			project_id, bucket_name, object_key, version, stream_id,
+			status, expires_at, segment_count, total_encrypted_size
+		FROM objects
+		WHERE stream_id = ANY($1)
+	`, pgutil.UUIDArray(opts.StreamIDs)))(func(rows tagsql.Rows) error {
+		for rows.Next() {
+			var object StreamObject
+			err := rows.Scan(
+				&object.ProjectID, &object.BucketName, &object.ObjectKey, &object.Version, &object.StreamID,
+				&object.Status, &object.ExpiresAt, &object.SegmentCount, &object.TotalEncryptedSize,
+			)
+			if err != nil {
+				return Error.Wrap(err)
+			}
+			result = append(result, object)
+		}
+		return nil
+	})
+	return result, Error.Wrap(err)
+}
+
+// FilterStreamsWithSegments returns the specified streams which still have at least one segment.
+func (db *DB) FilterStreamsWithSegments(ctx context.Context, streamIDs []uuid.UUID) (result []uuid.UUID, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(streamIDs) == 0 {
+		return nil, nil
+	}
+
+	err = withRows(db.db.QueryContext(ctx, `
+		SELECT DISTINCT stream_id
+		FROM segments
+		WHERE stream_id = ANY($1)
+	`, pgutil.UUIDArray(streamIDs)))(func(rows tagsql.Rows) error {
+		for rows.Next() {
+			var streamID uuid.UUID
+			if err := rows.Scan(&streamID); err != nil {
+				return Error.Wrap(err)
+			}
+			result = append(result, streamID)
+		}
+		return nil
+	})
+	return result, Error.Wrap(err)
+}
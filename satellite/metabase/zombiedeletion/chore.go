@@ -28,26 +28,30 @@ type Config struct {
 	ListLimit          int           `help:"how many objects to query in a batch" default:"100"`
 	InactiveFor        time.Duration `help:"after what time object will be deleted if there where no new upload activity" default:"24h"`
 	AsOfSystemInterval time.Duration `help:"as of system interval" releaseDefault:"-5m" devDefault:"-1us" testDefault:"-1us"`
+	MinGracePeriod     time.Duration `help:"the shortest grace period which can be configured for a project or bucket" default:"1h"`
+	MaxGracePeriod     time.Duration `help:"the longest grace period which can be configured for a project or bucket" default:"720h"`
 }
 
 // Chore implements the zombie objects cleanup chore.
 //
 // architecture: Chore
 type Chore struct {
-	log      *zap.Logger
-	config   Config
-	metabase *metabase.DB
+	log          *zap.Logger
+	config       Config
+	metabase     *metabase.DB
+	gracePeriods GracePeriods
 
 	nowFn func() time.Time
 	Loop  *sync2.Cycle
 }
 
 // NewChore creates a new instance of the zombiedeletion chore.
-func NewChore(log *zap.Logger, config Config, metabase *metabase.DB) *Chore {
+func NewChore(log *zap.Logger, config Config, metabase *metabase.DB, gracePeriods GracePeriods) *Chore {
 	return &Chore{
-		log:      log,
-		config:   config,
-		metabase: metabase,
+		log:          log,
+		config:       config,
+		metabase:     metabase,
+		gracePeriods: gracePeriods,
 
 		nowFn: time.Now,
 		Loop:  sync2.NewCycle(config.Interval),
@@ -80,17 +84,56 @@ func (chore *Chore) deleteZombieObjects(ctx context.Context) (err error) {
 	defer mon.Task()(&ctx)(&err)
 	chore.log.Debug("deleting zombie objects")
 
+	overrides, err := chore.gracePeriods.List(ctx)
+	if err != nil {
+		// without the overrides, objects with a longer grace period would be deleted too early.
+		return Error.Wrap(err)
+	}
+
+	now := chore.nowFn()
 	opts := metabase.DeleteZombieObjects{
-		DeadlineBefore:     chore.nowFn(),
-		InactiveDeadline:   chore.nowFn().Add(-chore.config.InactiveFor),
+		DeadlineBefore:     now,
+		InactiveDeadline:   now.Add(-chore.config.InactiveFor),
 		BatchSize:          chore.config.ListLimit,
 		AsOfSystemInterval: chore.config.AsOfSystemInterval,
 	}
+	for _, override := range overrides {
+		if override.BucketName == "" {
+			opts.SkipProjects = append(opts.SkipProjects, override.ProjectID)
+		} else {
+			opts.SkipBuckets = append(opts.SkipBuckets, metabase.BucketLocation{ProjectID: override.ProjectID, BucketName: override.BucketName})
+		}
+	}
 
 	err = chore.metabase.DeleteZombieObjects(ctx, opts)
 	if err != nil {
 		return err
 	}
 
+	var reclaimed int64
+	for _, override := range overrides {
+		overrideOpts := deleteOptions(override.Period, now, chore.config)
+		overrideOpts.ProjectID = override.ProjectID
+		overrideOpts.BucketName = override.BucketName
+		if override.BucketName == "" {
+			// buckets with their own override are handled separately.
+			for _, bucket := range opts.SkipBuckets {
+				if bucket.ProjectID == override.ProjectID {
+					overrideOpts.SkipBuckets = append(overrideOpts.SkipBuckets, bucket)
+				}
+			}
+		}
+
+		result, err := chore.metabase.DeleteBucketZombieObjects(ctx, overrideOpts)
+		reclaimed += result.BytesDeleted
+		if err != nil {
+			chore.log.Warn("deleting zombie objects with grace period override failed",
+				zap.Stringer("Project ID", override.ProjectID),
+				zap.String("Bucket", override.BucketName),
+				zap.Error(err))
+		}
+	}
+	mon.IntVal("zombie_override_bytes_reclaimed").Observe(reclaimed)
+
 	return nil
 }
@@ -0,0 +1,81 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package zombiedeletion
+
+import (
+	"context"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/metabase"
+)
+
+var (
+	// ErrInvalidGracePeriod is used when a grace period override is not valid.
+	ErrInvalidGracePeriod = errs.Class("invalid grace period")
+	// ErrGracePeriodNotFound is used when a grace period override does not exist.
+	ErrGracePeriodNotFound = errs.Class("grace period not found")
+)
+
+// GracePeriod overrides how long the pending objects of a project, or of a
+// single bucket of it, may stay inactive before they are deleted.
+type GracePeriod struct {
+	ProjectID uuid.UUID
+	// BucketName is empty when the override applies to the whole project.
+	BucketName string
+	Period     time.Duration
+	UpdatedAt  time.Time
+}
+
+// GracePeriods stores the pending object grace period overrides.
+//
+// architecture: Database
+type GracePeriods interface {
+	// Set inserts or replaces the override of a project or bucket.
+	Set(ctx context.Context, gracePeriod GracePeriod) error
+	// Get returns the override of a project or bucket.
+	Get(ctx context.Context, projectID uuid.UUID, bucketName string) (GracePeriod, error)
+	// Delete removes the override of a project or bucket.
+	Delete(ctx context.Context, projectID uuid.UUID, bucketName string) error
+	// List returns all overrides.
+	List(ctx context.Context) ([]GracePeriod, error)
+}
+
+// deleteOptions returns the options for deleting the pending objects which have
+// been inactive for longer than period.
+//
+// Zombie deadlines are set DefaultZombieDeletionPeriod after the upload began, so
+// the deadline is shifted by the difference to the requested period.
+func deleteOptions(period time.Duration, now time.Time, config Config) metabase.DeleteZombieObjects {
+	return metabase.DeleteZombieObjects{
+		DeadlineBefore:     now.Add(metabase.DefaultZombieDeletionPeriod - period),
+		InactiveDeadline:   now.Add(-period),
+		BatchSize:          config.ListLimit,
+		AsOfSystemInterval: config.AsOfSystemInterval,
+	}
+}
+
+// resolve returns the override which applies to the bucket, preferring a bucket
+// override over the override of its project.
+func resolve(overrides []GracePeriod, bucket metabase.BucketLocation) (GracePeriod, bool) {
+	var project *GracePeriod
+	for i := range overrides {
+		override := &overrides[i]
+		if override.ProjectID != bucket.ProjectID {
+			continue
+		}
+		if override.BucketName == bucket.BucketName {
+			return *override, true
+		}
+		if override.BucketName == "" {
+			project = override
+		}
+	}
+	if project != nil {
+		return *project, true
+	}
+	return GracePeriod{}, false
+}
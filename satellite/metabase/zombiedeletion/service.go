@@ -0,0 +1,204 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package zombiedeletion
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/metabase"
+)
+
+// ErrObjectNotPending is used when listing the parts of an object, which isn't pending.
+var ErrObjectNotPending = errs.Class("object is not pending")
+
+// Service manages pending object grace period overrides and runs targeted
+// cleanups of single buckets.
+//
+// architecture: Service
+type Service struct {
+	log          *zap.Logger
+	config       Config
+	metabase     *metabase.DB
+	gracePeriods GracePeriods
+
+	nowFn func() time.Time
+}
+
+// NewService creates a new zombie deletion service.
+func NewService(log *zap.Logger, config Config, metabase *metabase.DB, gracePeriods GracePeriods) *Service {
+	return &Service{
+		log:          log,
+		config:       config,
+		metabase:     metabase,
+		gracePeriods: gracePeriods,
+
+		nowFn: time.Now,
+	}
+}
+
+// TestingSetNow allows tests to have the service act as if the current time is whatever they want.
+func (service *Service) TestingSetNow(nowFn func() time.Time) {
+	service.nowFn = nowFn
+}
+
+// SetGracePeriod validates and stores the override of a project or bucket.
+func (service *Service) SetGracePeriod(ctx context.Context, gracePeriod GracePeriod) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if gracePeriod.ProjectID.IsZero() {
+		return ErrInvalidGracePeriod.New("project ID missing")
+	}
+	if gracePeriod.Period < service.config.MinGracePeriod || gracePeriod.Period > service.config.MaxGracePeriod {
+		return ErrInvalidGracePeriod.New("grace period must be between %s and %s", service.config.MinGracePeriod, service.config.MaxGracePeriod)
+	}
+
+	if err := service.gracePeriods.Set(ctx, gracePeriod); err != nil {
+		return Error.Wrap(err)
+	}
+
+	service.log.Info("pending object grace period set",
+		zap.Stringer("Project ID", gracePeriod.ProjectID),
+		zap.String("Bucket", gracePeriod.BucketName),
+		zap.Duration("Grace Period", gracePeriod.Period))
+	return nil
+}
+
+// GetGracePeriod returns the override of a project or bucket.
+func (service *Service) GetGracePeriod(ctx context.Context, projectID uuid.UUID, bucketName string) (_ GracePeriod, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	gracePeriod, err := service.gracePeriods.Get(ctx, projectID, bucketName)
+	if err != nil {
+		if ErrGracePeriodNotFound.Has(err) {
+			return GracePeriod{}, err
+		}
+		return GracePeriod{}, Error.Wrap(err)
+	}
+	return gracePeriod, nil
+}
+
+// DeleteGracePeriod removes the override of a project or bucket.
+func (service *Service) DeleteGracePeriod(ctx context.Context, projectID uuid.UUID, bucketName string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = service.gracePeriods.Delete(ctx, projectID, bucketName)
+	if err != nil {
+		if ErrGracePeriodNotFound.Has(err) {
+			return err
+		}
+		return Error.Wrap(err)
+	}
+	return nil
+}
+
+// CleanupBucket deletes the zombie objects of a bucket right away, using the
+// grace period which applies to the bucket.
+func (service *Service) CleanupBucket(ctx context.Context, bucket metabase.BucketLocation) (_ metabase.DeleteZombieObjectsResult, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := bucket.Verify(); err != nil {
+		return metabase.DeleteZombieObjectsResult{}, err
+	}
+
+	overrides, err := service.gracePeriods.List(ctx)
+	if err != nil {
+		return metabase.DeleteZombieObjectsResult{}, Error.Wrap(err)
+	}
+
+	now := service.nowFn()
+	opts := metabase.DeleteZombieObjects{
+		DeadlineBefore:     now,
+		InactiveDeadline:   now.Add(-service.config.InactiveFor),
+		BatchSize:          service.config.ListLimit,
+		AsOfSystemInterval: service.config.AsOfSystemInterval,
+	}
+	if override, ok := resolve(overrides, bucket); ok {
+		opts = deleteOptions(override.Period, now, service.config)
+	}
+	opts.ProjectID = bucket.ProjectID
+	opts.BucketName = bucket.BucketName
+
+	result, err := service.metabase.DeleteBucketZombieObjects(ctx, opts)
+	if err != nil {
+		return result, Error.Wrap(err)
+	}
+
+	service.log.Info("bucket zombie objects deleted",
+		zap.Stringer("Project ID", bucket.ProjectID),
+		zap.String("Bucket", bucket.BucketName),
+		zap.Int64("Objects", result.ObjectsDeleted),
+		zap.Int64("Segments", result.SegmentsDeleted),
+		zap.Int64("Bytes", result.BytesDeleted))
+	return result, nil
+}
+
+// errListLimitReached stops listing pending objects once enough were collected.
+var errListLimitReached = errs.New("list limit reached")
+
+// ListPendingObjects returns up to limit pending objects of the project, which
+// were started more than olderThan ago. more reports whether there are more
+// such objects.
+func (service *Service) ListPendingObjects(ctx context.Context, projectID uuid.UUID, olderThan time.Duration, limit int) (objects []metabase.PendingObject, more bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if projectID.IsZero() {
+		return nil, false, Error.New("project ID missing")
+	}
+	metabase.ListLimit.Ensure(&limit)
+
+	err = service.metabase.ListPendingObjects(ctx, metabase.ListPendingObjects{
+		ProjectID:          projectID,
+		CreatedBefore:      service.nowFn().Add(-olderThan),
+		BatchSize:          limit + 1,
+		AsOfSystemInterval: service.config.AsOfSystemInterval,
+	}, func(ctx context.Context, batch []metabase.PendingObject) error {
+		objects = append(objects, batch...)
+		if len(objects) > limit {
+			return errListLimitReached
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, errListLimitReached) {
+		return nil, false, Error.Wrap(err)
+	}
+
+	if len(objects) > limit {
+		return objects[:limit], true, nil
+	}
+	return objects, false, nil
+}
+
+// ListParts returns up to limit parts of a pending object, which were uploaded
+// so far, with a part number greater than the cursor.
+func (service *Service) ListParts(ctx context.Context, location metabase.ObjectLocation, version metabase.Version, cursor uint32, limit int) (_ metabase.ListPartsResult, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	object, err := service.metabase.GetObjectExactVersion(ctx, metabase.GetObjectExactVersion{
+		ObjectLocation: location,
+		Version:        version,
+	})
+	if err != nil {
+		return metabase.ListPartsResult{}, Error.Wrap(err)
+	}
+	if object.Status != metabase.Pending {
+		return metabase.ListPartsResult{}, ErrObjectNotPending.New("%s", object.Status)
+	}
+
+	result, err := service.metabase.ListParts(ctx, metabase.ListParts{
+		ProjectID: location.ProjectID,
+		StreamID:  object.StreamID,
+		Cursor:    cursor,
+		Limit:     limit,
+	})
+	if err != nil {
+		return metabase.ListPartsResult{}, Error.Wrap(err)
+	}
+	return result, nil
+}
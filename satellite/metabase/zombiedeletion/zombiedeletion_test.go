@@ -19,6 +19,7 @@ import (
 	"storj.io/storj/satellite"
 	"storj.io/storj/satellite/metabase"
 	"storj.io/storj/satellite/metabase/metabasetest"
+	"storj.io/storj/satellite/metabase/zombiedeletion"
 	"storj.io/uplink/private/testuplink"
 )
 
@@ -170,3 +171,99 @@ func TestZombieDeletion_LastSegmentActive(t *testing.T) {
 		require.Zero(t, cmp.Diff(segments, afterSegments, cmpopts.EquateApproxTime(1*time.Second)))
 	})
 }
+
+func TestZombieDeletion_GracePeriodOverrides(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.ZombieDeletion.AsOfSystemInterval = -1 * time.Microsecond
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		upl := planet.Uplinks[0]
+		zombieChore := sat.Core.ZombieDeletion.Chore
+		service := sat.Admin.ZombieDeletion.Service
+
+		zombieChore.Loop.Pause()
+
+		projectID := upl.Projects[0].ID
+		for _, bucket := range []string{"short", "long", "default"} {
+			require.NoError(t, upl.CreateBucket(ctx, sat, bucket))
+		}
+
+		project, err := upl.OpenProject(ctx, sat)
+		require.NoError(t, err)
+		defer ctx.Check(project.Close)
+
+		for _, bucket := range []string{"short", "long", "default"} {
+			_, err = project.BeginUpload(ctx, bucket, "pending", nil)
+			require.NoError(t, err)
+		}
+
+		require.True(t, zombiedeletion.ErrInvalidGracePeriod.Has(service.SetGracePeriod(ctx, zombiedeletion.GracePeriod{
+			ProjectID:  projectID,
+			BucketName: "short",
+			Period:     time.Minute,
+		})))
+
+		require.NoError(t, service.SetGracePeriod(ctx, zombiedeletion.GracePeriod{
+			ProjectID:  projectID,
+			BucketName: "short",
+			Period:     2 * time.Hour,
+		}))
+		require.NoError(t, service.SetGracePeriod(ctx, zombiedeletion.GracePeriod{
+			ProjectID:  projectID,
+			BucketName: "long",
+			Period:     72 * time.Hour,
+		}))
+
+		gracePeriod, err := service.GetGracePeriod(ctx, projectID, "long")
+		require.NoError(t, err)
+		require.Equal(t, 72*time.Hour, gracePeriod.Period)
+
+		_, err = service.GetGracePeriod(ctx, projectID, "default")
+		require.True(t, zombiedeletion.ErrGracePeriodNotFound.Has(err))
+
+		remainingBuckets := func() []string {
+			objects, err := sat.Metabase.DB.TestingAllObjects(ctx)
+			require.NoError(t, err)
+
+			var names []string
+			for _, object := range objects {
+				names = append(names, object.BucketName)
+			}
+			return names
+		}
+
+		runAt := func(offset time.Duration) {
+			zombieChore.TestingSetNow(func() time.Time {
+				return time.Now().Add(offset)
+			})
+			zombieChore.Loop.TriggerWait()
+		}
+
+		runAt(3 * time.Hour)
+		require.ElementsMatch(t, []string{"long", "default"}, remainingBuckets())
+
+		runAt(25 * time.Hour)
+		require.ElementsMatch(t, []string{"long"}, remainingBuckets())
+
+		// a targeted cleanup still respects the override of the bucket.
+		result, err := service.CleanupBucket(ctx, metabase.BucketLocation{ProjectID: projectID, BucketName: "long"})
+		require.NoError(t, err)
+		require.Zero(t, result.ObjectsDeleted)
+
+		require.NoError(t, service.DeleteGracePeriod(ctx, projectID, "long"))
+		require.True(t, zombiedeletion.ErrGracePeriodNotFound.Has(service.DeleteGracePeriod(ctx, projectID, "long")))
+
+		service.TestingSetNow(func() time.Time {
+			return time.Now().Add(25 * time.Hour)
+		})
+		result, err = service.CleanupBucket(ctx, metabase.BucketLocation{ProjectID: projectID, BucketName: "long"})
+		require.NoError(t, err)
+		require.EqualValues(t, 1, result.ObjectsDeleted)
+		require.Empty(t, remainingBuckets())
+	})
+}
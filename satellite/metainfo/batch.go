@@ -335,6 +335,30 @@ func (endpoint *Endpoint) Batch(ctx context.Context, req *pb.BatchRequest) (resp
 					ObjectFinishDelete: response,
 				},
 			})
+		case *pb.BatchRequestItem_ObjectFinishCopy:
+			// consecutive copies are made together.
+			var copies []*pb.ObjectFinishCopyRequest
+			for ; i < len(req.Requests); i++ {
+				finishCopy := req.Requests[i].GetObjectFinishCopy()
+				if finishCopy == nil {
+					break
+				}
+				finishCopy.Header = req.Header
+				copies = append(copies, finishCopy)
+			}
+			i--
+
+			responses, err := endpoint.finishCopyObjects(ctx, copies)
+			for _, response := range responses {
+				resp.Responses = append(resp.Responses, &pb.BatchResponseItem{
+					Response: &pb.BatchResponseItem_ObjectFinishCopy{
+						ObjectFinishCopy: response,
+					},
+				})
+			}
+			if err != nil {
+				return resp, err
+			}
 		// SEGMENT
 		case *pb.BatchRequestItem_SegmentBegin:
 			singleRequest.SegmentBegin.Header = req.Header
@@ -9,13 +9,16 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"storj.io/common/errs2"
 	"storj.io/common/macaroon"
 	"storj.io/common/memory"
 	"storj.io/common/pb"
+	"storj.io/common/rpc/rpcstatus"
 	"storj.io/common/storj"
 	"storj.io/common/testcontext"
 	"storj.io/common/testrand"
 	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite/metabase"
 	"storj.io/uplink/private/metaclient"
 )
 
@@ -186,6 +189,73 @@ func TestBatch(t *testing.T) {
 	})
 }
 
+func TestBatch_FinishCopyObjects(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		apiKey := planet.Uplinks[0].APIKey[sat.ID()]
+
+		metainfoClient, err := planet.Uplinks[0].DialMetainfo(ctx, sat, apiKey)
+		require.NoError(t, err)
+		defer ctx.Check(metainfoClient.Close)
+
+		for i := 0; i < 3; i++ {
+			err := planet.Uplinks[0].Upload(ctx, sat, "bucket", "object-"+strconv.Itoa(i), testrand.Bytes(memory.KiB))
+			require.NoError(t, err)
+		}
+
+		objects, err := sat.Metabase.DB.TestingAllObjects(ctx)
+		require.NoError(t, err)
+		require.Len(t, objects, 3)
+
+		finishCopy := func(object metabase.Object, suffix string) *metaclient.FinishCopyObjectParams {
+			newKey := []byte(string(object.ObjectKey) + suffix)
+			begin, err := metainfoClient.BeginCopyObject(ctx, metaclient.BeginCopyObjectParams{
+				Bucket:                []byte(object.BucketName),
+				EncryptedObjectKey:    []byte(object.ObjectKey),
+				NewBucket:             []byte(object.BucketName),
+				NewEncryptedObjectKey: newKey,
+			})
+			require.NoError(t, err)
+			return &metaclient.FinishCopyObjectParams{
+				StreamID:                     begin.StreamID,
+				NewBucket:                    []byte(object.BucketName),
+				NewEncryptedObjectKey:        newKey,
+				NewEncryptedMetadataKeyNonce: begin.EncryptedMetadataKeyNonce,
+				NewEncryptedMetadataKey:      begin.EncryptedMetadataKey,
+				NewSegmentKeys:               begin.SegmentKeys,
+			}
+		}
+
+		responses, err := metainfoClient.Batch(ctx,
+			finishCopy(objects[0], "-copy"),
+			finishCopy(objects[1], "-copy"),
+			finishCopy(objects[2], "-copy"),
+		)
+		require.NoError(t, err)
+		require.Len(t, responses, 3)
+
+		copies, err := sat.Metabase.DB.TestingAllObjects(ctx)
+		require.NoError(t, err)
+		require.Len(t, copies, 6)
+
+		// the copies stop at the first failed one.
+		missingBucket := finishCopy(objects[1], "-second")
+		missingBucket.NewBucket = []byte("missing")
+		_, err = metainfoClient.Batch(ctx,
+			finishCopy(objects[0], "-second"),
+			missingBucket,
+			finishCopy(objects[2], "-second"),
+		)
+		require.True(t, errs2.IsRPC(err, rpcstatus.NotFound), err)
+
+		copies, err = sat.Metabase.DB.TestingAllObjects(ctx)
+		require.NoError(t, err)
+		require.Len(t, copies, 7)
+	})
+}
+
 func TestDeleteBatchWithoutPermission(t *testing.T) {
 	testplanet.Run(t, testplanet.Config{
 		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
@@ -11,6 +11,7 @@ import (
 
 	"storj.io/common/memory"
 	"storj.io/common/uuid"
+	"storj.io/storj/satellite/buckets"
 	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/metabase"
 	"storj.io/uplink/private/eestream"
@@ -121,6 +122,9 @@ type UploadLimiterConfig struct {
 // ProjectLimitConfig is a configuration struct for default project limits.
 type ProjectLimitConfig struct {
 	MaxBuckets int `help:"max bucket count for a project." default:"100" testDefault:"10"`
+	MaxObjects int `help:"max committed object count for a project, checked in the commit transaction. 0 means no limit." default:"0"`
+
+	CommitSegmentsQuota bool `help:"check the project segment limit in the commit transaction, in addition to the live accounting check." default:"false"`
 }
 
 // Config is a configuration struct that is everything you need to start a metainfo.
@@ -145,11 +149,22 @@ type Config struct {
 	SuccessTrackerTickDuration   time.Duration       `default:"10m" help:"how often to bump the generation in the node success tracker"`
 	SuccessTrackerTrustedUplinks []string            `help:"list of trusted uplinks for success tracker"`
 
+	StorageClasses buckets.StorageClasses `help:"semicolon-separated storage classes in the format name:placement:product. When set, new buckets must map to one of them"`
+
 	// TODO remove this flag when server-side copy implementation will be finished
 	ServerSideCopy         bool `help:"enable code for server-side copy, deprecated. please leave this to true." default:"true"`
 	ServerSideCopyDisabled bool `help:"disable already enabled server-side copy. this is because once server side copy is enabled, delete code should stay changed, even if you want to disable server side copy" default:"false"`
 	UseListObjectsIterator bool `help:"switch to iterator based implementation." default:"false"`
 
+	ReadReplicaURL          string        `help:"the connection string of a read-only metabase replica (postgres hot standby or spanner). empty disables it" default:""`
+	ReadReplicaOperations   []string      `help:"read operations served by the read replica: list-objects, list-segments, ranged-loop" default:""`
+	ReadReplicaMaxStaleness time.Duration `help:"how stale spanner reads served by the read replica may be" default:"10s"`
+
+	SlowPrecommitThreshold time.Duration `help:"commit transactions, which delete the previous object, taking longer are logged. 0 disables it" default:"0s"`
+	SlowDeleteThreshold    time.Duration `help:"object delete transactions taking longer are logged. 0 disables it" default:"0s"`
+
+	DeferSegmentDeletion bool `help:"enqueue the segments of deleted objects for the segment deletion chore, instead of deleting them in the object delete transaction" default:"false"`
+
 	UseBucketLevelObjectVersioning bool `help:"enable the use of bucket level object versioning" default:"false"`
 	// flag to simplify testing by enabling bucket level versioning feature only for specific projects
 	UseBucketLevelObjectVersioningProjects []string `help:"list of projects which will have UseBucketLevelObjectVersioning feature flag enabled" default:"" hidden:"true"`
@@ -164,15 +179,34 @@ type Config struct {
 // Metabase constructs Metabase configuration based on Metainfo configuration with specific application name.
 func (c Config) Metabase(applicationName string) metabase.Config {
 	return metabase.Config{
-		ApplicationName:            applicationName,
-		MinPartSize:                c.MinPartSize,
-		MaxNumberOfParts:           c.MaxNumberOfParts,
-		ServerSideCopy:             c.ServerSideCopy,
+		ApplicationName:  applicationName,
+		MinPartSize:      c.MinPartSize,
+		MaxNumberOfParts: c.MaxNumberOfParts,
+		ServerSideCopy:   c.ServerSideCopy,
+		ReadReplica:      c.ReadReplica(),
+		SlowTransactions: metabase.SlowTransactionConfig{
+			PrecommitThreshold: c.SlowPrecommitThreshold,
+			DeleteThreshold:    c.SlowDeleteThreshold,
+		},
+		DeferSegmentDeletion:       c.DeferSegmentDeletion,
 		TestingCommitSegmentMode:   c.TestCommitSegmentMode,
 		TestingPrecommitDeleteMode: c.TestingPrecommitDeleteMode,
 	}
 }
 
+// ReadReplica constructs the metabase read replica configuration.
+func (c Config) ReadReplica() metabase.ReadReplicaConfig {
+	operations := make([]metabase.ReadOperation, 0, len(c.ReadReplicaOperations))
+	for _, op := range c.ReadReplicaOperations {
+		operations = append(operations, metabase.ReadOperation(strings.TrimSpace(op)))
+	}
+	return metabase.ReadReplicaConfig{
+		DatabaseURL:  c.ReadReplicaURL,
+		Operations:   operations,
+		MaxStaleness: c.ReadReplicaMaxStaleness,
+	}
+}
+
 // ExtendedConfig extended config keeps additional helper fields and methods around Config.
 type ExtendedConfig struct {
 	Config
@@ -5,7 +5,6 @@ package metainfo
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"strings"
 	"time"
@@ -27,6 +26,7 @@ import (
 	"storj.io/storj/satellite/attribution"
 	"storj.io/storj/satellite/buckets"
 	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/errcatalog"
 	"storj.io/storj/satellite/internalpb"
 	"storj.io/storj/satellite/metabase"
 	"storj.io/storj/satellite/metainfo/pointerverification"
@@ -341,14 +341,23 @@ func (endpoint *Endpoint) unmarshalSatSegmentID(ctx context.Context, segmentID s
 }
 
 // ConvertMetabaseErr converts domain errors from metabase to appropriate rpc statuses errors.
+// The status is chosen by the error catalog, so it matches the other satellite APIs.
 func (endpoint *Endpoint) ConvertMetabaseErr(err error) error {
-	switch {
-	case err == nil:
+	if err == nil {
 		return nil
-	case errors.Is(err, context.Canceled):
-		return rpcstatus.Error(rpcstatus.Canceled, "context canceled")
-	case errors.Is(err, context.DeadlineExceeded):
-		return rpcstatus.Error(rpcstatus.DeadlineExceeded, "context deadline exceeded")
+	}
+
+	entry := errcatalog.Lookup(err)
+	errcatalog.Record("metainfo", entry.Code)
+
+	switch entry.Code {
+	case errcatalog.Canceled:
+		return entry.RPCError("context canceled")
+	case errcatalog.DeadlineExceeded:
+		return entry.RPCError("context deadline exceeded")
+	}
+
+	switch {
 	case rpcstatus.Code(err) != rpcstatus.Unknown:
 		// it's already RPC error
 		return err
@@ -356,25 +365,17 @@ func (endpoint *Endpoint) ConvertMetabaseErr(err error) error {
 		message := strings.TrimPrefix(err.Error(), string(metabase.ErrObjectNotFound))
 		message = strings.TrimPrefix(message, ": ")
 		// uplink expects a message that starts with the specified prefix
-		return rpcstatus.Error(rpcstatus.NotFound, "object not found: "+message)
+		return entry.RPCError("object not found: " + message)
 	case metabase.ErrSegmentNotFound.Has(err):
 		message := strings.TrimPrefix(err.Error(), string(metabase.ErrSegmentNotFound))
 		message = strings.TrimPrefix(message, ": ")
 		// uplink expects a message that starts with the specified prefix
-		return rpcstatus.Error(rpcstatus.NotFound, "segment not found: "+message)
-	case metabase.ErrInvalidRequest.Has(err):
-		return rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
-	case metabase.ErrFailedPrecondition.Has(err):
-		return rpcstatus.Error(rpcstatus.FailedPrecondition, err.Error())
-	case metabase.ErrObjectAlreadyExists.Has(err):
-		return rpcstatus.Error(rpcstatus.AlreadyExists, err.Error())
-	case metabase.ErrPendingObjectMissing.Has(err):
-		return rpcstatus.Error(rpcstatus.NotFound, err.Error())
-	case metabase.ErrPermissionDenied.Has(err):
-		return rpcstatus.Error(rpcstatus.PermissionDenied, err.Error())
-	default:
+		return entry.RPCError("segment not found: " + message)
+	case entry.Code == errcatalog.Internal:
 		endpoint.log.Error("internal", zap.Error(err))
-		return rpcstatus.Error(rpcstatus.Internal, "internal error")
+		return entry.RPCError("internal error")
+	default:
+		return entry.RPCError(err.Error())
 	}
 }
 
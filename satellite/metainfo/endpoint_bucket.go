@@ -150,6 +150,9 @@ func (endpoint *Endpoint) SetBucketVersioning(ctx context.Context, req *pb.SetBu
 		if buckets.ErrBucketNotFound.Has(err) {
 			return nil, rpcstatus.Error(rpcstatus.NotFound, err.Error())
 		}
+		if buckets.ErrInvalidSettings.Has(err) {
+			return nil, rpcstatus.Error(rpcstatus.FailedPrecondition, err.Error())
+		}
 		endpoint.log.Error("internal", zap.Error(err))
 		return nil, rpcstatus.Error(rpcstatus.Internal, "unable to enable versioning for the bucket")
 	}
@@ -214,6 +217,16 @@ func (endpoint *Endpoint) CreateBucket(ctx context.Context, req *pb.BucketCreate
 	}
 	bucketReq.Placement = project.DefaultPlacement
 
+	if endpoint.config.StorageClasses.Enabled() {
+		// the protocol doesn't carry the requested storage class yet, hence
+		// the bucket gets the class of the project placement.
+		class, err := endpoint.config.StorageClasses.ForBucket("", project.DefaultPlacement)
+		if err != nil {
+			return nil, rpcstatus.Error(rpcstatus.FailedPrecondition, err.Error())
+		}
+		bucketReq.Placement = class.Placement
+	}
+
 	if endpoint.config.UseBucketLevelObjectVersioningByProject(project) {
 		defaultVersioning, err := endpoint.projects.GetDefaultVersioning(ctx, keyInfo.ProjectID)
 		if err != nil {
@@ -288,6 +288,8 @@ func (endpoint *Endpoint) CommitObject(ctx context.Context, req *pb.ObjectCommit
 		DisallowDelete: !allowDelete,
 
 		Versioned: streamID.Versioned,
+
+		Quota: endpoint.commitQuota(keyInfo),
 	}
 	// uplink can send empty metadata with not empty key/nonce
 	// we need to fix it on uplink side but that part will be
@@ -501,6 +503,8 @@ func (endpoint *Endpoint) commitInlineObject(ctx context.Context, beginObjectReq
 		DisallowDelete: !allowDelete,
 
 		Versioned: bucket.Versioning == buckets.VersioningEnabled,
+
+		Quota: endpoint.commitQuota(keyInfo),
 	})
 	if err != nil {
 		return nil, nil, nil, endpoint.ConvertMetabaseErr(err)
@@ -795,7 +799,7 @@ func (endpoint *Endpoint) DownloadObject(ctx context.Context, req *pb.ObjectDown
 		}
 
 		if segment.Inline() {
-			err := endpoint.orders.UpdateGetInlineOrder(ctx, object.Location().Bucket(), downloadSizes.plainSize)
+			err := endpoint.orders.UpdateGetInlineOrder(ctx, object.Location().Bucket(), keyInfo.ID, downloadSizes.plainSize)
 			if err != nil {
 				endpoint.log.Error("internal", zap.Error(err))
 				return nil, rpcstatus.Error(rpcstatus.Internal, "unable to update GET inline order")
@@ -829,7 +833,7 @@ func (endpoint *Endpoint) DownloadObject(ctx context.Context, req *pb.ObjectDown
 			}}, nil
 		}
 
-		limits, privateKey, err := endpoint.orders.CreateGetOrderLimits(ctx, object.Location().Bucket(), segment, req.GetDesiredNodes(), downloadSizes.orderLimit)
+		limits, privateKey, err := endpoint.orders.CreateGetOrderLimits(ctx, object.Location().Bucket(), keyInfo.ID, segment, req.GetDesiredNodes(), downloadSizes.orderLimit)
 		if err != nil {
 			if orders.ErrDownloadFailedNotEnoughPieces.Has(err) {
 				endpoint.log.Error("Unable to create order limits.",
@@ -1234,7 +1238,8 @@ func (endpoint *Endpoint) ListObjects(ctx context.Context, req *pb.ObjectListReq
 				return nil, endpoint.ConvertMetabaseErr(err)
 			}
 		} else {
-			result, err := endpoint.metabase.ListObjects(ctx,
+			// handles listing the latest versions of versioned buckets
+			err = endpoint.metabase.IterateListObjects(ctx,
 				metabase.ListObjects{
 					ProjectID:  keyInfo.ProjectID,
 					BucketName: string(req.Bucket),
@@ -1250,19 +1255,23 @@ func (endpoint *Endpoint) ListObjects(ctx context.Context, req *pb.ObjectListReq
 
 					IncludeCustomMetadata: includeCustomMetadata,
 					IncludeSystemMetadata: includeSystemMetadata,
-				})
+				}, func(ctx context.Context, it metabase.ObjectsIterator) error {
+					entry := metabase.ObjectEntry{}
+					for len(resp.Items) < limit && it.Next(ctx, &entry) {
+						item, err := endpoint.objectEntryToProtoListItem(ctx, req.Bucket, entry, prefix, includeSystemMetadata, includeCustomMetadata, bucket.Placement, bucket.Versioning == buckets.VersioningEnabled)
+						if err != nil {
+							return err
+						}
+						resp.Items = append(resp.Items, item)
+					}
+
+					resp.More = it.Next(ctx, &entry)
+					return nil
+				},
+			)
 			if err != nil {
 				return nil, endpoint.ConvertMetabaseErr(err)
 			}
-
-			for _, entry := range result.Objects {
-				item, err := endpoint.objectEntryToProtoListItem(ctx, req.Bucket, entry, prefix, includeSystemMetadata, includeCustomMetadata, bucket.Placement, bucket.Versioning == buckets.VersioningEnabled)
-				if err != nil {
-					return nil, endpoint.ConvertMetabaseErr(err)
-				}
-				resp.Items = append(resp.Items, item)
-			}
-			resp.More = result.More
 		}
 	} else {
 		// handles listing all versions
@@ -2164,12 +2173,15 @@ func (endpoint *Endpoint) FinishMoveObject(ctx context.Context, req *pb.ObjectFi
 		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
 	}
 
-	exists, err := endpoint.buckets.HasBucket(ctx, req.NewBucket, keyInfo.ProjectID)
+	// the placement and object lock settings of the target bucket are verified
+	// again by metabase, as they may have changed since the move was started.
+	bucket, err := endpoint.buckets.GetBucket(ctx, req.NewBucket, keyInfo.ProjectID)
 	if err != nil {
+		if buckets.ErrBucketNotFound.Has(err) {
+			return nil, rpcstatus.Errorf(rpcstatus.NotFound, "target bucket not found: %s", req.NewBucket)
+		}
 		endpoint.log.Error("unable to check bucket", zap.Error(err))
 		return nil, rpcstatus.Error(rpcstatus.Internal, "unable to check bucket")
-	} else if !exists {
-		return nil, rpcstatus.Errorf(rpcstatus.NotFound, "target bucket not found: %s", req.NewBucket)
 	}
 
 	streamUUID, err := uuid.FromBytes(streamID.StreamId)
@@ -2193,6 +2205,10 @@ func (endpoint *Endpoint) FinishMoveObject(ctx context.Context, req *pb.ObjectFi
 
 		// TODO(ver): currently we disallow deletion, to not change behaviour.
 		NewDisallowDelete: true,
+
+		NewVersioned:         bucket.Versioning == buckets.VersioningEnabled,
+		NewPlacement:         bucket.Placement,
+		NewObjectLockEnabled: bucket.ObjectLockEnabled,
 	})
 	if err != nil {
 		return nil, endpoint.ConvertMetabaseErr(err)
@@ -2346,15 +2362,70 @@ func convertBeginCopyObjectResults(result metabase.BeginCopyObjectResult) (*pb.O
 func (endpoint *Endpoint) FinishCopyObject(ctx context.Context, req *pb.ObjectFinishCopyRequest) (resp *pb.ObjectFinishCopyResponse, err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	opts, err := endpoint.prepareFinishCopyObject(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	object, err := endpoint.metabase.FinishCopyObject(ctx, opts)
+	if err != nil {
+		return nil, endpoint.ConvertMetabaseErr(err)
+	}
+
+	return endpoint.finishCopyObjectResponse(ctx, object)
+}
+
+// finishCopyObjects finishes consecutive copies of a batch request with a single metabase
+// call. The copies are made in order and stop at the first failed one, whose error is
+// returned together with the responses of the copies before it.
+func (endpoint *Endpoint) finishCopyObjects(ctx context.Context, reqs []*pb.ObjectFinishCopyRequest) (responses []*pb.ObjectFinishCopyResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	copies := make([]metabase.FinishCopyObject, 0, len(reqs))
+	var prepareErr error
+	for _, req := range reqs {
+		opts, err := endpoint.prepareFinishCopyObject(ctx, req)
+		if err != nil {
+			prepareErr = err
+			break
+		}
+		copies = append(copies, opts)
+	}
+
+	if len(copies) > 0 {
+		results, err := endpoint.metabase.BatchCopyObjects(ctx, metabase.BatchCopyObjects{
+			Objects:       copies,
+			StopAtFailure: true,
+		})
+		if err != nil {
+			return nil, endpoint.ConvertMetabaseErr(err)
+		}
+		for _, result := range results {
+			if result.Err != nil {
+				return responses, endpoint.ConvertMetabaseErr(result.Err)
+			}
+			response, err := endpoint.finishCopyObjectResponse(ctx, result.Object)
+			if err != nil {
+				return responses, err
+			}
+			responses = append(responses, response)
+		}
+	}
+
+	return responses, prepareErr
+}
+
+// prepareFinishCopyObject authorizes and validates the request and converts it to a metabase copy.
+func (endpoint *Endpoint) prepareFinishCopyObject(ctx context.Context, req *pb.ObjectFinishCopyRequest) (_ metabase.FinishCopyObject, err error) {
 	if !endpoint.config.ServerSideCopy || endpoint.config.ServerSideCopyDisabled {
-		return nil, rpcstatus.Error(rpcstatus.Unimplemented, "Unimplemented")
+		return metabase.FinishCopyObject{}, rpcstatus.Error(rpcstatus.Unimplemented, "Unimplemented")
 	}
 
-	endpoint.versionCollector.collect(req.Header.UserAgent, mon.Func().ShortName())
+	endpoint.versionCollector.collect(req.Header.UserAgent, "FinishCopyObject")
 
 	streamID, err := endpoint.unmarshalSatStreamID(ctx, req.StreamId)
 	if err != nil {
-		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+		return metabase.FinishCopyObject{}, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
 	}
 
 	keyInfo, err := endpoint.validateAuth(ctx, req.Header, macaroon.Action{
@@ -2364,39 +2435,39 @@ func (endpoint *Endpoint) FinishCopyObject(ctx context.Context, req *pb.ObjectFi
 		EncryptedPath: req.NewEncryptedMetadataKey,
 	})
 	if err != nil {
-		return nil, err
+		return metabase.FinishCopyObject{}, err
 	}
 	endpoint.usageTracking(keyInfo, req.Header, fmt.Sprintf("%T", req))
 
 	err = endpoint.validateBucketNameLength(req.NewBucket)
 	if err != nil {
-		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+		return metabase.FinishCopyObject{}, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
 	}
 
 	if err := endpoint.checkEncryptedMetadataSize(req.NewEncryptedMetadata, req.NewEncryptedMetadataKey); err != nil {
-		return nil, err
+		return metabase.FinishCopyObject{}, err
 	}
 
 	bucketVersioning, err := endpoint.buckets.GetBucketVersioningState(ctx, req.NewBucket, keyInfo.ProjectID)
 	if err != nil {
 		if buckets.ErrBucketNotFound.Has(err) {
-			return nil, rpcstatus.Errorf(rpcstatus.NotFound, "bucket not found: %s", req.NewBucket)
+			return metabase.FinishCopyObject{}, rpcstatus.Errorf(rpcstatus.NotFound, "bucket not found: %s", req.NewBucket)
 		}
 		endpoint.log.Error("unable to check bucket versioning state", zap.Error(err))
-		return nil, rpcstatus.Error(rpcstatus.Internal, "unable to copy object")
+		return metabase.FinishCopyObject{}, rpcstatus.Error(rpcstatus.Internal, "unable to copy object")
 	}
 
 	streamUUID, err := uuid.FromBytes(streamID.StreamId)
 	if err != nil {
-		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+		return metabase.FinishCopyObject{}, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
 	}
 
 	newStreamID, err := uuid.New()
 	if err != nil {
-		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+		return metabase.FinishCopyObject{}, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
 	}
 
-	object, err := endpoint.metabase.FinishCopyObject(ctx, metabase.FinishCopyObject{
+	return metabase.FinishCopyObject{
 		ObjectStream: metabase.ObjectStream{
 			ProjectID:  keyInfo.ProjectID,
 			BucketName: string(streamID.Bucket),
@@ -2421,11 +2492,11 @@ func (endpoint *Endpoint) FinishCopyObject(ctx context.Context, req *pb.ObjectFi
 		VerifyLimits: func(encryptedObjectSize int64, nSegments int64) error {
 			return endpoint.addStorageUsageUpToLimit(ctx, keyInfo, encryptedObjectSize, nSegments)
 		},
-	})
-	if err != nil {
-		return nil, endpoint.ConvertMetabaseErr(err)
-	}
+	}, nil
+}
 
+// finishCopyObjectResponse converts the copied object into the response.
+func (endpoint *Endpoint) finishCopyObjectResponse(ctx context.Context, object metabase.Object) (*pb.ObjectFinishCopyResponse, error) {
 	// we can return nil redundancy because this request won't be used for downloading
 	protoObject, err := endpoint.objectToProto(ctx, object, nil)
 	if err != nil {
@@ -2433,7 +2504,7 @@ func (endpoint *Endpoint) FinishCopyObject(ctx context.Context, req *pb.ObjectFi
 		return nil, rpcstatus.Error(rpcstatus.Internal, "internal error")
 	}
 
-	endpoint.log.Debug("Object Copy Finished", zap.Stringer("Project ID", keyInfo.ProjectID), zap.String("operation", "copy"), zap.String("type", "object"))
+	endpoint.log.Debug("Object Copy Finished", zap.Stringer("Project ID", object.ProjectID), zap.String("operation", "copy"), zap.String("type", "object"))
 	mon.Meter("req_copy_object_finished").Mark(1)
 
 	return &pb.ObjectFinishCopyResponse{
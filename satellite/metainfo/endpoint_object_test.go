@@ -57,6 +57,38 @@ func assertRPCStatusCode(t *testing.T, actualError error, expectedStatusCode rpc
 	require.Equal(t, expectedStatusCode, statusCode, "wrong %T, got %v", statusCode, actualError)
 }
 
+func TestEndpoint_Object_CommitQuota(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Metainfo.ProjectLimits.MaxObjects = 2
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		upl := planet.Uplinks[0]
+		satellite := planet.Satellites[0]
+
+		// inline and remote uploads are committed by different endpoints.
+		require.NoError(t, upl.Upload(ctx, satellite, "testbucket", "inline", testrand.Bytes(memory.KiB)))
+		require.NoError(t, upl.Upload(ctx, satellite, "testbucket", "remote", testrand.Bytes(10*memory.KiB)))
+
+		// overwriting an object doesn't increase the object count.
+		require.NoError(t, upl.Upload(ctx, satellite, "testbucket", "inline", testrand.Bytes(memory.KiB)))
+
+		err := upl.Upload(ctx, satellite, "testbucket", "inline-exceeded", testrand.Bytes(memory.KiB))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "exceeded object limit")
+
+		err = upl.Upload(ctx, satellite, "testbucket", "remote-exceeded", testrand.Bytes(10*memory.KiB))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "exceeded object limit")
+
+		require.NoError(t, upl.DeleteObject(ctx, satellite, "testbucket", "remote"))
+		require.NoError(t, upl.Upload(ctx, satellite, "testbucket", "remote", testrand.Bytes(10*memory.KiB)))
+	})
+}
+
 func TestEndpoint_Object_No_StorageNodes(t *testing.T) {
 	testplanet.Run(t, testplanet.Config{
 		SatelliteCount: 1, UplinkCount: 1,
@@ -722,7 +722,7 @@ func (endpoint *Endpoint) DownloadSegment(ctx context.Context, req *pb.SegmentDo
 	}
 
 	if segment.Inline() {
-		err := endpoint.orders.UpdateGetInlineOrder(ctx, bucket, int64(len(segment.InlineData)))
+		err := endpoint.orders.UpdateGetInlineOrder(ctx, bucket, keyInfo.ID, int64(len(segment.InlineData)))
 		if err != nil {
 			endpoint.log.Error("internal", zap.Error(err))
 			return nil, rpcstatus.Error(rpcstatus.Internal, "unable to update GET inline order")
@@ -749,7 +749,7 @@ func (endpoint *Endpoint) DownloadSegment(ctx context.Context, req *pb.SegmentDo
 	}
 
 	// Remote segment
-	limits, privateKey, err := endpoint.orders.CreateGetOrderLimits(ctx, bucket, segment, req.GetDesiredNodes(), 0)
+	limits, privateKey, err := endpoint.orders.CreateGetOrderLimits(ctx, bucket, keyInfo.ID, segment, req.GetDesiredNodes(), 0)
 	if err != nil {
 		if orders.ErrDownloadFailedNotEnoughPieces.Has(err) {
 			endpoint.log.Error("Unable to create order limits.",
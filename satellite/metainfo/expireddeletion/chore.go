@@ -27,6 +27,8 @@ type Config struct {
 	Enabled            bool          `help:"set if expired segment cleanup is enabled or not" releaseDefault:"true" devDefault:"true"`
 	ListLimit          int           `help:"how many expired objects to query in a batch" default:"100"`
 	AsOfSystemInterval time.Duration `help:"as of system interval" releaseDefault:"-5m" devDefault:"-1us" testDefault:"-1us" hidden:"true"`
+
+	SpannerPartitionedDML bool `help:"delete the segments of expired objects with partitioned DML on Spanner" default:"false"`
 }
 
 // Chore implements the expired segment cleanup chore.
@@ -81,14 +83,34 @@ func (chore *Chore) deleteExpiredObjects(ctx context.Context) (err error) {
 
 	// TODO log error instead of crashing core until we will be sure
 	// that queries for deleting expired objects are stable
-	err = chore.metabase.DeleteExpiredObjects(ctx, metabase.DeleteExpiredObjects{
-		ExpiredBefore:      chore.nowFn(),
-		BatchSize:          chore.config.ListLimit,
-		AsOfSystemInterval: chore.config.AsOfSystemInterval,
+	result, err := chore.metabase.DeleteExpiredObjects(ctx, metabase.DeleteExpiredObjects{
+		ExpiredBefore:         chore.nowFn(),
+		BatchSize:             chore.config.ListLimit,
+		AsOfSystemInterval:    chore.config.AsOfSystemInterval,
+		SpannerPartitionedDML: chore.config.SpannerPartitionedDML,
 	})
 	if err != nil {
 		chore.log.Error("deleting expired objects failed", zap.Error(err))
+		return nil
+	}
+
+	for projectID, bytes := range result.DeletedBytes {
+		chore.log.Info("deleted expired objects of project",
+			zap.Stringer("Project ID", projectID),
+			zap.Int64("Bytes", bytes))
+	}
+	chore.log.Debug("deleted expired objects",
+		zap.Int64("Objects", result.DeletedObjects),
+		zap.Int64("Segments", result.DeletedSegments))
+
+	tags, err := chore.metabase.DeleteOrphanedObjectTags(ctx, metabase.DeleteOrphanedObjectTags{
+		BatchSize: chore.config.ListLimit,
+	})
+	if err != nil {
+		chore.log.Error("deleting orphaned object tags failed", zap.Error(err))
+		return nil
 	}
+	chore.log.Debug("deleted orphaned object tags", zap.Int64("Tags", tags))
 
 	return nil
 }
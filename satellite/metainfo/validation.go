@@ -522,6 +522,22 @@ func (endpoint *Endpoint) checkObjectUploadRate(ctx context.Context, projectID u
 	return nil
 }
 
+// commitQuota returns the project quota, which is checked by metabase in the commit
+// transaction. Unlike the live accounting check before the upload, it can't be exceeded
+// by concurrent uploads.
+func (endpoint *Endpoint) commitQuota(keyInfo *console.APIKeyInfo) *metabase.PrecommitQuota {
+	quota := metabase.PrecommitQuota{
+		MaxObjects: int64(endpoint.config.ProjectLimits.MaxObjects),
+	}
+	if endpoint.config.ProjectLimits.CommitSegmentsQuota {
+		quota.MaxSegments = endpoint.projectUsage.SegmentsLimit(keyInfoToLimits(keyInfo))
+	}
+	if quota == (metabase.PrecommitQuota{}) {
+		return nil
+	}
+	return &quota
+}
+
 func keyInfoToLimits(keyInfo *console.APIKeyInfo) accounting.ProjectLimits {
 	if keyInfo == nil {
 		return accounting.ProjectLimits{}
@@ -39,6 +39,11 @@ type DB interface {
 	// UpdateBandwidthBatch updates bucket and project bandwidth rollups in the database
 	UpdateBandwidthBatch(ctx context.Context, rollups []BucketBandwidthRollup) error
 
+	// UpdateAPIKeyBandwidthSettle updates 'settled' egress for given API key
+	UpdateAPIKeyBandwidthSettle(ctx context.Context, projectID, apiKeyID uuid.UUID, amount int64, intervalStart time.Time) error
+	// UpdateAPIKeyBandwidthInline updates 'inline' egress for given API key
+	UpdateAPIKeyBandwidthInline(ctx context.Context, projectID, apiKeyID uuid.UUID, amount int64, intervalStart time.Time) error
+
 	// UpdateStoragenodeBandwidthSettle updates 'settled' bandwidth for given storage node
 	UpdateStoragenodeBandwidthSettle(ctx context.Context, storageNode storj.NodeID, action pb.PieceAction, amount int64, intervalStart time.Time) error
 	// UpdateStoragenodeBandwidthSettleWithWindow updates 'settled' bandwidth for given storage node
@@ -69,6 +74,14 @@ func (noopDB) UpdateBandwidthBatch(ctx context.Context, rollups []BucketBandwidt
 	return nil
 }
 
+func (noopDB) UpdateAPIKeyBandwidthSettle(ctx context.Context, projectID, apiKeyID uuid.UUID, amount int64, intervalStart time.Time) error {
+	return nil
+}
+
+func (noopDB) UpdateAPIKeyBandwidthInline(ctx context.Context, projectID, apiKeyID uuid.UUID, amount int64, intervalStart time.Time) error {
+	return nil
+}
+
 func (noopDB) UpdateStoragenodeBandwidthSettle(ctx context.Context, storageNode storj.NodeID, action pb.PieceAction, amount int64, intervalStart time.Time) error {
 	return nil
 }
@@ -201,6 +214,11 @@ type bucketIDAction struct {
 	action     pb.PieceAction
 }
 
+type projectAPIKey struct {
+	projectID uuid.UUID
+	apiKeyID  uuid.UUID
+}
+
 // SettlementWithWindow processes all orders that were created in a 1 hour window.
 // Only one window is processed at a time.
 // Batches are atomic, all orders are settled successfully or they all fail.
@@ -258,6 +276,7 @@ func (endpoint *Endpoint) SettlementWithWindowFinal(stream pb.DRPCOrders_Settlem
 
 	storagenodeSettled := map[int32]int64{}
 	bucketSettled := map[bucketIDAction]bandwidthAmount{}
+	apiKeySettled := map[projectAPIKey]int64{}
 	seenSerials := map[storj.SerialNumber]struct{}{}
 
 	var window int64
@@ -362,6 +381,19 @@ func (endpoint *Endpoint) SettlementWithWindowFinal(stream pb.DRPCOrders_Settlem
 			Dead:    bucketSettled[currentBucketIDAction].Dead + orderLimit.Limit - order.Amount,
 			// we are not collecting Allocated bandwidth as it won't be stored with UpdateBucketBandwidthSettle
 		}
+
+		// egress is attributed to an API key only when the order limit was created
+		// with one. Order limits without it, e.g. created before the API key ID was
+		// added to the metadata, are settled for the bucket only.
+		if orderLimit.Action == pb.PieceAction_GET && len(metadata.ApiKeyId) > 0 {
+			apiKeyID, err := uuid.FromBytes(metadata.GetApiKeyId())
+			if err != nil {
+				log.Debug("decrypt order: invalid API key ID", zap.Error(err))
+				mon.Event("apikey_from_orders_metadata_error")
+			} else {
+				apiKeySettled[projectAPIKey{projectID: bucketInfo.ProjectID, apiKeyID: apiKeyID}] += order.Amount
+			}
+		}
 	}
 
 	if len(storagenodeSettled) == 0 {
@@ -394,6 +426,12 @@ func (endpoint *Endpoint) SettlementWithWindowFinal(stream pb.DRPCOrders_Settlem
 				log.Info("err updating bucket bandwidth settle", zap.Error(err))
 			}
 		}
+		for key, amount := range apiKeySettled {
+			err = endpoint.DB.UpdateAPIKeyBandwidthSettle(ctx, key.projectID, key.apiKeyID, amount, time.Unix(0, window))
+			if err != nil {
+				log.Info("err updating API key bandwidth settle", zap.Error(err))
+			}
+		}
 	} else {
 		mon.Event("orders_already_processed")
 	}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
 
 	"storj.io/common/memory"
 	"storj.io/common/pb"
@@ -401,3 +402,126 @@ func TestProjectBandwidthDailyRollups(t *testing.T) {
 		assert.Zero(t, dead)
 	})
 }
+
+func TestAPIKeyEgressAttribution(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 6, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: testplanet.Combine(
+				testplanet.ReconfigureRS(2, 3, 4, 4),
+				func(log *zap.Logger, index int, config *satellite.Config) {
+					config.Orders.APIKeyEgressAttribution = true
+				},
+			),
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		upl := planet.Uplinks[0]
+		project := upl.Projects[0]
+
+		now := time.Now()
+		tomorrow := now.Add(24 * time.Hour)
+
+		sat.Audit.Worker.Loop.Pause()
+		for _, storageNode := range planet.StorageNodes {
+			storageNode.Storage2.Orders.Sender.Pause()
+		}
+
+		apiKey, err := sat.DB.Console().APIKeys().GetByHead(ctx, project.RawAPIKey.Head())
+		require.NoError(t, err)
+
+		inlineData := testrand.Bytes(1 * memory.KiB)
+		require.NoError(t, upl.Upload(ctx, sat, "testbucket", "inline", inlineData))
+		require.NoError(t, upl.Upload(ctx, sat, "testbucket", "remote", testrand.Bytes(50*memory.KiB)))
+
+		_, err = upl.Download(ctx, sat, "testbucket", "inline")
+		require.NoError(t, err)
+		_, err = upl.Download(ctx, sat, "testbucket", "remote")
+		require.NoError(t, err)
+
+		require.NoError(t, planet.WaitForStorageNodeEndpoints(ctx))
+
+		var expectedSettled int64
+		for _, storageNode := range planet.StorageNodes {
+			infos, err := storageNode.OrdersStore.ListUnsentBySatellite(ctx, tomorrow)
+			require.NoError(t, err)
+			for _, unsentInfo := range infos {
+				for _, orderInfo := range unsentInfo.InfoList {
+					if orderInfo.Limit.Action == pb.PieceAction_GET {
+						expectedSettled += orderInfo.Order.Amount
+					}
+				}
+			}
+		}
+		require.NotZero(t, expectedSettled)
+
+		for _, storageNode := range planet.StorageNodes {
+			storageNode.Storage2.Orders.SendOrders(ctx, tomorrow)
+		}
+
+		egress, err := sat.DB.ProjectAccounting().GetAPIKeyEgress(ctx, project.ID, now.Add(-time.Hour), now.Add(time.Hour))
+		require.NoError(t, err)
+		require.Len(t, egress, 1)
+		require.Equal(t, apiKey.ID, egress[0].APIKeyID)
+		require.Equal(t, expectedSettled, egress[0].Settled)
+		require.NotZero(t, egress[0].Inline)
+	})
+}
+
+func TestAPIKeyEgressAttribution_OrdersWithoutAPIKey(t *testing.T) {
+	// with attribution disabled, the metadata is encrypted without the API key
+	// ID, the same way as by satellites which didn't support it yet.
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: testplanet.ReconfigureRS(2, 3, 4, 4),
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		upl := planet.Uplinks[0]
+		project := upl.Projects[0]
+
+		now := time.Now()
+		tomorrow := now.Add(24 * time.Hour)
+
+		sat.Audit.Worker.Loop.Pause()
+		for _, storageNode := range planet.StorageNodes {
+			storageNode.Storage2.Orders.Sender.Pause()
+		}
+
+		require.NoError(t, upl.Upload(ctx, sat, "testbucket", "remote", testrand.Bytes(50*memory.KiB)))
+		_, err := upl.Download(ctx, sat, "testbucket", "remote")
+		require.NoError(t, err)
+
+		require.NoError(t, planet.WaitForStorageNodeEndpoints(ctx))
+
+		var expectedSettled int64
+		for _, storageNode := range planet.StorageNodes {
+			infos, err := storageNode.OrdersStore.ListUnsentBySatellite(ctx, tomorrow)
+			require.NoError(t, err)
+			for _, unsentInfo := range infos {
+				for _, orderInfo := range unsentInfo.InfoList {
+					expectedSettled += orderInfo.Order.Amount
+
+					metadata, err := sat.Orders.Service.DecryptOrderMetadata(ctx, orderInfo.Limit)
+					require.NoError(t, err)
+					require.Empty(t, metadata.ApiKeyId)
+				}
+			}
+		}
+		require.NotZero(t, expectedSettled)
+
+		for _, storageNode := range planet.StorageNodes {
+			storageNode.Storage2.Orders.SendOrders(ctx, tomorrow)
+		}
+		sat.Orders.Chore.Loop.TriggerWait()
+
+		settled, err := sat.DB.Orders().GetBucketBandwidth(ctx, project.ID, []byte("testbucket"), now.Add(-time.Hour), now.Add(time.Hour))
+		require.NoError(t, err)
+		require.Equal(t, expectedSettled, settled)
+
+		egress, err := sat.DB.ProjectAccounting().GetAPIKeyEgress(ctx, project.ID, now.Add(-time.Hour), now.Add(time.Hour))
+		require.NoError(t, err)
+		require.Empty(t, egress)
+	})
+}
@@ -18,6 +18,7 @@ import (
 	"storj.io/common/pb"
 	"storj.io/common/signing"
 	"storj.io/common/storj"
+	"storj.io/common/uuid"
 	"storj.io/storj/satellite/internalpb"
 	"storj.io/storj/satellite/metabase"
 	"storj.io/storj/satellite/nodeselection"
@@ -40,6 +41,8 @@ type Config struct {
 	NodeStatusLogging   bool           `hidden:"true" help:"deprecated, log the offline/disqualification status of nodes" default:"false" testDefault:"true"`
 	OrdersSemaphoreSize int            `help:"how many concurrent orders to process at once. zero is unlimited" default:"2"`
 
+	APIKeyEgressAttribution bool `help:"attribute settled egress to the API key which requested the download" default:"false"`
+
 	DownloadTailToleranceOverrides string `help:"how many nodes should be used for downloads for certain k. must be >= k. if not specified, this is calculated from long tail tolerance. format is comma separated like k-d,k-d,k-d e.g. 29-35,3-5." default:""`
 }
 
@@ -70,6 +73,8 @@ type Service struct {
 
 	downloadOverrides map[int16]int32
 
+	apiKeyEgressAttribution bool
+
 	rngMu sync.Mutex
 	rng   *mathrand.Rand
 }
@@ -101,6 +106,8 @@ func NewService(
 
 		downloadOverrides: downloadOverrides,
 
+		apiKeyEgressAttribution: config.APIKeyEgressAttribution,
+
 		rng: mathrand.New(mathrand.NewSource(time.Now().UnixNano())),
 	}, nil
 }
@@ -167,7 +174,10 @@ func (service *Service) DownloadNodes(scheme storj.RedundancyScheme) int32 {
 }
 
 // CreateGetOrderLimits creates the order limits for downloading the pieces of a segment.
-func (service *Service) CreateGetOrderLimits(ctx context.Context, bucket metabase.BucketLocation, segment metabase.Segment, desiredNodes int32, overrideLimit int64) (_ []*pb.AddressedOrderLimit, privateKey storj.PiecePrivateKey, err error) {
+//
+// When API key egress attribution is enabled and apiKeyID is not zero, the
+// settled egress of the order limits is also attributed to the API key.
+func (service *Service) CreateGetOrderLimits(ctx context.Context, bucket metabase.BucketLocation, apiKeyID uuid.UUID, segment metabase.Segment, desiredNodes int32, overrideLimit int64) (_ []*pb.AddressedOrderLimit, privateKey storj.PiecePrivateKey, err error) {
 	defer mon.Task()(&ctx)(&err)
 
 	orderLimit := segment.PieceSize()
@@ -197,6 +207,9 @@ func (service *Service) CreateGetOrderLimits(ctx context.Context, bucket metabas
 	if err != nil {
 		return nil, storj.PiecePrivateKey{}, Error.Wrap(err)
 	}
+	if service.apiKeyEgressAttribution {
+		signer.APIKeyID = apiKeyID
+	}
 
 	neededLimits := service.DownloadNodes(segment.Redundancy)
 	if desiredNodes > neededLimits {
@@ -578,13 +591,22 @@ func (service *Service) CreateGracefulExitPutOrderLimit(ctx context.Context, buc
 	return limit, signer.PrivateKey, nil
 }
 
-// UpdateGetInlineOrder updates amount of inline GET bandwidth for given bucket.
-func (service *Service) UpdateGetInlineOrder(ctx context.Context, bucket metabase.BucketLocation, amount int64) (err error) {
+// UpdateGetInlineOrder updates amount of inline GET bandwidth for given bucket
+// and, when API key egress attribution is enabled, for given API key.
+func (service *Service) UpdateGetInlineOrder(ctx context.Context, bucket metabase.BucketLocation, apiKeyID uuid.UUID, amount int64) (err error) {
 	defer mon.Task()(&ctx)(&err)
 	now := time.Now().UTC()
 	intervalStart := time.Date(now.Year(), now.Month(), now.Day(), now.Hour(), 0, 0, 0, now.Location())
 
-	return service.orders.UpdateBucketBandwidthInline(ctx, bucket.ProjectID, []byte(bucket.BucketName), pb.PieceAction_GET, amount, intervalStart)
+	err = service.orders.UpdateBucketBandwidthInline(ctx, bucket.ProjectID, []byte(bucket.BucketName), pb.PieceAction_GET, amount, intervalStart)
+	if err != nil {
+		return err
+	}
+
+	if service.apiKeyEgressAttribution && !apiKeyID.IsZero() {
+		return service.orders.UpdateAPIKeyBandwidthInline(ctx, bucket.ProjectID, apiKeyID, amount, intervalStart)
+	}
+	return nil
 }
 
 // UpdatePutInlineOrder updates amount of inline PUT bandwidth for given bucket.
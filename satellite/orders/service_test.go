@@ -19,6 +19,7 @@ import (
 	"storj.io/common/storj"
 	"storj.io/common/testcontext"
 	"storj.io/common/testrand"
+	"storj.io/common/uuid"
 	"storj.io/storj/satellite/metabase"
 	"storj.io/storj/satellite/nodeselection"
 )
@@ -86,7 +87,7 @@ func TestGetOrderLimits(t *testing.T) {
 	}
 
 	checkExpectedLimits := func(requested int32, received int) {
-		limits, _, err := service.CreateGetOrderLimits(ctx, bucket, segment, requested, 0)
+		limits, _, err := service.CreateGetOrderLimits(ctx, bucket, uuid.UUID{}, segment, requested, 0)
 		require.NoError(t, err)
 		realLimits := 0
 		for _, limit := range limits {
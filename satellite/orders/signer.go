@@ -14,6 +14,7 @@ import (
 	"storj.io/common/pb"
 	"storj.io/common/signing"
 	"storj.io/common/storj"
+	"storj.io/common/uuid"
 	"storj.io/storj/satellite/internalpb"
 	"storj.io/storj/satellite/metabase"
 )
@@ -27,6 +28,9 @@ type Signer struct {
 	Service *Service
 
 	Bucket metabase.BucketLocation
+	// APIKeyID is included in the encrypted metadata, when set, so that
+	// settled egress can be attributed to the API key.
+	APIKeyID uuid.UUID
 
 	// TODO: use a Template pb.OrderLimit here?
 	RootPieceID        storj.PieceID
@@ -149,12 +153,14 @@ func (signer *Signer) Sign(ctx context.Context, node *pb.Node, pieceNum int32) (
 			return nil, ErrSigner.New("default encryption key is missing")
 		}
 
-		encrypted, err := encryptionKey.EncryptMetadata(
-			signer.Serial,
-			&internalpb.OrderLimitMetadata{
-				CompactProjectBucketPrefix: signer.Bucket.CompactPrefix(),
-			},
-		)
+		metadata := &internalpb.OrderLimitMetadata{
+			CompactProjectBucketPrefix: signer.Bucket.CompactPrefix(),
+		}
+		if !signer.APIKeyID.IsZero() {
+			metadata.ApiKeyId = signer.APIKeyID.Bytes()
+		}
+
+		encrypted, err := encryptionKey.EncryptMetadata(signer.Serial, metadata)
 		if err != nil {
 			return nil, ErrSigner.Wrap(err)
 		}
@@ -47,6 +47,7 @@ type Chore struct {
 	freezeService *console.AccountFreezeService
 	analytics     *analytics.Service
 	usersDB       console.Users
+	contactsDB    console.BillingContacts
 	walletsDB     storjscan.WalletsDB
 	paymentsDB    storjscan.PaymentsDB
 	payments      payments.Accounts
@@ -64,12 +65,13 @@ type Chore struct {
 }
 
 // NewChore is a constructor for Chore.
-func NewChore(log *zap.Logger, accounts stripe.DB, payments payments.Accounts, usersDB console.Users, walletsDB storjscan.WalletsDB, paymentsDB storjscan.PaymentsDB, freezeService *console.AccountFreezeService, analytics *analytics.Service, mailService *mailservice.Service, freezeConfig console.AccountFreezeConfig, config Config, flagBots bool, externalAddress, generalRequestURL string) *Chore {
+func NewChore(log *zap.Logger, accounts stripe.DB, payments payments.Accounts, usersDB console.Users, contactsDB console.BillingContacts, walletsDB storjscan.WalletsDB, paymentsDB storjscan.PaymentsDB, freezeService *console.AccountFreezeService, analytics *analytics.Service, mailService *mailservice.Service, freezeConfig console.AccountFreezeConfig, config Config, flagBots bool, externalAddress, generalRequestURL string) *Chore {
 	return &Chore{
 		log:               log,
 		freezeService:     freezeService,
 		analytics:         analytics,
 		usersDB:           usersDB,
+		contactsDB:        contactsDB,
 		walletsDB:         walletsDB,
 		paymentsDB:        paymentsDB,
 		accounts:          accounts,
@@ -636,9 +638,23 @@ func (chore *Chore) sendEmail(ctx context.Context, user *console.User, event *co
 		return Error.New("unknown event type")
 	}
 
-	chore.mailService.SendRenderedAsync(ctx, []post.Address{{Address: user.Email}}, message)
+	recipients := []post.Address{{Address: user.Email}}
 
-	err := chore.freezeService.IncrementNotificationsCount(ctx, user.ID, event.Type)
+	// billing contacts receive the same notices as the account owner.
+	contacts, err := chore.contactsDB.ListByUserID(ctx, user.ID)
+	if err != nil {
+		chore.log.Error("Could not list billing contacts",
+			zap.String("userID", user.ID.String()),
+			zap.Error(Error.Wrap(err)),
+		)
+	}
+	for _, contact := range contacts {
+		recipients = append(recipients, post.Address{Address: contact.Email})
+	}
+
+	chore.mailService.SendRenderedAsync(ctx, recipients, message)
+
+	err = chore.freezeService.IncrementNotificationsCount(ctx, user.ID, event.Type)
 	if err != nil {
 		return err
 	}
@@ -97,6 +97,7 @@ func TestSignupCouponCodes(t *testing.T) {
 			nil,
 			nil,
 			nil,
+			nil,
 			"",
 			"",
 			sat.Config.Metainfo.ProjectLimits.MaxBuckets,
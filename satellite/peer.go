@@ -28,6 +28,8 @@ import (
 	"storj.io/storj/satellite/accounting/rolluparchive"
 	"storj.io/storj/satellite/accounting/tally"
 	"storj.io/storj/satellite/admin"
+	"storj.io/storj/satellite/adminaudit"
+	"storj.io/storj/satellite/adminwebhook"
 	"storj.io/storj/satellite/analytics"
 	"storj.io/storj/satellite/attribution"
 	"storj.io/storj/satellite/audit"
@@ -43,14 +45,25 @@ import (
 	"storj.io/storj/satellite/contact"
 	"storj.io/storj/satellite/durability"
 	"storj.io/storj/satellite/emission"
+	"storj.io/storj/satellite/escrow"
 	"storj.io/storj/satellite/gc/bloomfilter"
 	"storj.io/storj/satellite/gc/piecetracker"
 	"storj.io/storj/satellite/gc/sender"
 	"storj.io/storj/satellite/gracefulexit"
+	"storj.io/storj/satellite/integrity"
 	"storj.io/storj/satellite/kms"
+	"storj.io/storj/satellite/limitschedule"
 	"storj.io/storj/satellite/mailservice"
 	"storj.io/storj/satellite/mailservice/simulate"
+	"storj.io/storj/satellite/maintenance"
+	"storj.io/storj/satellite/metabase/abortmultipart"
+	"storj.io/storj/satellite/metabase/aliasgc"
+	"storj.io/storj/satellite/metabase/avroexport"
+	"storj.io/storj/satellite/metabase/bucketinventory"
+	"storj.io/storj/satellite/metabase/lifecycledeletion"
+	"storj.io/storj/satellite/metabase/objectevents"
 	"storj.io/storj/satellite/metabase/rangedloop"
+	"storj.io/storj/satellite/metabase/segmentdeletion"
 	"storj.io/storj/satellite/metabase/zombiedeletion"
 	"storj.io/storj/satellite/metainfo"
 	"storj.io/storj/satellite/metainfo/expireddeletion"
@@ -67,6 +80,7 @@ import (
 	"storj.io/storj/satellite/payments/paymentsconfig"
 	"storj.io/storj/satellite/payments/storjscan"
 	"storj.io/storj/satellite/payments/stripe"
+	"storj.io/storj/satellite/projectdeletion"
 	"storj.io/storj/satellite/repair/checker"
 	"storj.io/storj/satellite/repair/queue"
 	"storj.io/storj/satellite/repair/repairer"
@@ -99,6 +113,18 @@ type DB interface {
 	OverlayCache() overlay.DB
 	// NodeEvents returns a database for node event information
 	NodeEvents() nodeevents.DB
+	// MaintenanceWindows returns a database for scheduled maintenance windows
+	MaintenanceWindows() maintenance.DB
+	// ProjectLimitChanges returns a database for scheduled project limit changes
+	ProjectLimitChanges() limitschedule.DB
+	// ProjectDataPurges returns a database for the progress of project data purges
+	ProjectDataPurges() projectdeletion.DB
+	// AdminAuditEvents returns a database for the changes made through the admin API
+	AdminAuditEvents() adminaudit.DB
+	// AdminWebhookDeliveries returns a database for the webhook deliveries of admin actions
+	AdminWebhookDeliveries() adminwebhook.DB
+	// PassphraseEscrows returns a database for recovery envelopes of project passphrases
+	PassphraseEscrows() escrow.DB
 	// Reputation returns database for audit reputation information
 	Reputation() reputation.DB
 	// Attribution returns database for partner keys information
@@ -113,6 +139,10 @@ type DB interface {
 	VerifyQueue() audit.VerifyQueue
 	// ReverifyQueue returns queue for pieces that need audit reverification
 	ReverifyQueue() audit.ReverifyQueue
+	// SegmentIntegrityQueue returns queue for discrepancies between segments and objects
+	SegmentIntegrityQueue() integrity.Queue
+	// PendingObjectGracePeriods returns database for pending object grace period overrides
+	PendingObjectGracePeriods() zombiedeletion.GracePeriods
 	// Console returns database for satellite console
 	Console() console.DB
 	// OIDC returns the database for OIDC resources.
@@ -168,6 +198,10 @@ type Config struct {
 
 	Admin admin.Config
 
+	Maintenance maintenance.Config
+
+	LimitSchedule limitschedule.Config
+
 	Contact      contact.Config
 	Overlay      overlay.Config
 	OfflineNodes offlinenodes.Config
@@ -194,6 +228,15 @@ type Config struct {
 
 	ExpiredDeletion expireddeletion.Config
 	ZombieDeletion  zombiedeletion.Config
+	AbortMultipart  abortmultipart.Config
+	SegmentDeletion segmentdeletion.Config
+
+	LifecycleDeletion lifecycledeletion.Config
+	BucketInventory   bucketinventory.Config
+
+	ObjectEvents objectevents.Config
+
+	AvroExport avroexport.Config
 
 	Tally            tally.Config
 	Rollup           rollup.Config
@@ -227,8 +270,14 @@ type Config struct {
 
 	DurabilityReport durability.ReportConfig
 
+	SegmentIntegrity integrity.Config
+
+	NodeAliasGC aliasgc.Config
+
 	KeyManagement kms.Config
 
+	PassphraseEscrow escrow.Config
+
 	TagAuthorities string `help:"comma-separated paths of additional cert files, used to validate signed node tags"`
 }
 
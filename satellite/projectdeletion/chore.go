@@ -0,0 +1,53 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package projectdeletion
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"storj.io/common/sync2"
+)
+
+// Chore continues the running project data purges.
+//
+// architecture: Chore
+type Chore struct {
+	log     *zap.Logger
+	service *Service
+	config  Config
+
+	Loop *sync2.Cycle
+}
+
+// NewChore creates a new instance of the project data purge chore.
+func NewChore(log *zap.Logger, service *Service, config Config) *Chore {
+	return &Chore{
+		log:     log,
+		service: service,
+		config:  config,
+
+		Loop: sync2.NewCycle(config.Interval),
+	}
+}
+
+// Run starts continuing the running purges.
+func (chore *Chore) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return chore.Loop.Run(ctx, func(ctx context.Context) error {
+		processed, err := chore.service.ProcessRunning(ctx)
+		if err != nil {
+			chore.log.Error("processing project data purges failed", zap.Int("Processed", processed), zap.Error(err))
+		}
+		return nil
+	})
+}
+
+// Close stops the project data purge chore.
+func (chore *Chore) Close() error {
+	chore.Loop.Close()
+	return nil
+}
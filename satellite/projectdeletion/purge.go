@@ -0,0 +1,67 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package projectdeletion
+
+import (
+	"context"
+	"time"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/metabase"
+)
+
+// PurgeStatus is the state of a project data purge.
+type PurgeStatus string
+
+const (
+	// PurgeRunning means the purge is waiting for or being processed by the chore.
+	PurgeRunning PurgeStatus = "running"
+	// PurgeFinished means all buckets of the project have been deleted.
+	PurgeFinished PurgeStatus = "finished"
+	// PurgeFailed means purging a bucket failed after all attempts, or the
+	// bucket contains protected data.
+	PurgeFailed PurgeStatus = "failed"
+)
+
+// Purge is the stored progress of purging all data of a project.
+type Purge struct {
+	ProjectID uuid.UUID   `json:"projectID"`
+	Status    PurgeStatus `json:"status"`
+
+	// BucketName is the bucket currently being purged, empty between buckets.
+	BucketName string `json:"bucketName,omitempty"`
+	// ContinuationToken is the position of the purge within BucketName.
+	ContinuationToken metabase.ContinuationToken `json:"-"`
+	// Attempts is the number of failed attempts at purging BucketName.
+	Attempts int `json:"attempts"`
+
+	DeletedObjects int64  `json:"deletedObjects"`
+	DeletedBuckets int    `json:"deletedBuckets"`
+	Error          string `json:"error,omitempty"`
+
+	CreatedBy  string     `json:"createdBy"`
+	CreatedAt  time.Time  `json:"createdAt"`
+	UpdatedAt  time.Time  `json:"updatedAt"`
+	FinishedAt *time.Time `json:"finishedAt,omitempty"`
+}
+
+// Running returns whether the purge hasn't finished or failed yet.
+func (purge Purge) Running() bool {
+	return purge.FinishedAt == nil
+}
+
+// DB stores the progress of project data purges.
+//
+// architecture: Database
+type DB interface {
+	// Start starts purging the data of a project. A finished or failed purge of
+	// the project is restarted; ErrAlreadyRunning is returned when a purge is running.
+	Start(ctx context.Context, projectID uuid.UUID, createdBy string) (Purge, error)
+	// Get returns the latest purge of a project.
+	Get(ctx context.Context, projectID uuid.UUID) (Purge, error)
+	// ListRunning returns at most limit running purges, least recently updated first.
+	ListRunning(ctx context.Context, limit int) ([]Purge, error)
+	// Update stores the progress of a running purge.
+	Update(ctx context.Context, purge Purge) error
+}
@@ -0,0 +1,293 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package projectdeletion
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/macaroon"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/buckets"
+	"storj.io/storj/satellite/metabase"
+)
+
+var (
+	mon = monkit.Package()
+
+	// Error is the default error class for project deletion.
+	Error = errs.Class("project deletion")
+	// ErrProtectedData is returned when the project contains data which must not be purged.
+	ErrProtectedData = errs.Class("project contains protected data")
+	// ErrNotFound is returned when the project has no purge.
+	ErrNotFound = errs.Class("project data purge not found")
+	// ErrAlreadyRunning is returned when starting a purge while one is running for the project.
+	ErrAlreadyRunning = errs.Class("project data purge already running")
+)
+
+// Config contains configurable values for the project deletion pipeline.
+type Config struct {
+	Interval    time.Duration `help:"how often to continue the running project data purges" releaseDefault:"1m" devDefault:"10s"`
+	MaxAttempts int           `help:"how many times purging the objects of a bucket is attempted before the purge fails" default:"3"`
+	BatchSize   int           `help:"number of objects deleted in a single database query" default:"100"`
+	ListLimit   int           `help:"how many running purges are continued in a single iteration" default:"10"`
+}
+
+// Service purges all bucket data of a project, so the project itself can be deleted.
+//
+// Purges are started by an admin and processed by the Chore. The progress is
+// stored after every deleted batch, so a purge which is interrupted, e.g. by a
+// restart, continues after the last deleted object.
+//
+// architecture: Service
+type Service struct {
+	log      *zap.Logger
+	db       DB
+	buckets  buckets.DB
+	metabase *metabase.DB
+	config   Config
+
+	nowFn func() time.Time
+}
+
+// NewService creates a new project deletion service.
+func NewService(log *zap.Logger, db DB, buckets buckets.DB, metabase *metabase.DB, config Config) *Service {
+	if config.MaxAttempts <= 0 {
+		config.MaxAttempts = 1
+	}
+	return &Service{
+		log:      log,
+		db:       db,
+		buckets:  buckets,
+		metabase: metabase,
+		config:   config,
+		nowFn:    time.Now,
+	}
+}
+
+// Validate checks whether all data of the project may be purged.
+func (service *Service) Validate(ctx context.Context, projectID uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return service.validate(ctx, projectID, "")
+}
+
+// Start validates the project data and starts purging it in the background.
+func (service *Service) Start(ctx context.Context, createdBy string, projectID uuid.UUID) (_ Purge, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := service.validate(ctx, projectID, ""); err != nil {
+		return Purge{}, err
+	}
+
+	purge, err := service.db.Start(ctx, projectID, createdBy)
+	if err != nil {
+		if ErrAlreadyRunning.Has(err) {
+			return Purge{}, err
+		}
+		return Purge{}, Error.Wrap(err)
+	}
+
+	service.log.Info("project data purge started",
+		zap.Stringer("Project ID", projectID),
+		zap.String("Created By", createdBy),
+	)
+	return purge, nil
+}
+
+// Status returns the latest purge of the project.
+func (service *Service) Status(ctx context.Context, projectID uuid.UUID) (_ Purge, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	purge, err := service.db.Get(ctx, projectID)
+	if err != nil {
+		if ErrNotFound.Has(err) {
+			return Purge{}, err
+		}
+		return Purge{}, Error.Wrap(err)
+	}
+	return purge, nil
+}
+
+// ProcessRunning continues at most ListLimit running purges. It returns how
+// many purges were processed.
+func (service *Service) ProcessRunning(ctx context.Context) (processed int, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	purges, err := service.db.ListRunning(ctx, service.config.ListLimit)
+	if err != nil {
+		return 0, Error.Wrap(err)
+	}
+
+	for _, purge := range purges {
+		if err := service.process(ctx, purge); err != nil {
+			return processed, Error.Wrap(err)
+		}
+		processed++
+	}
+	return processed, nil
+}
+
+// process purges the remaining buckets of the project, one at a time and in
+// name order. A failed bucket is retried on the next call, until MaxAttempts is
+// reached. The returned error is only about storing the progress.
+func (service *Service) process(ctx context.Context, purge Purge) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	for {
+		if purge.BucketName == "" {
+			next, ok, err := service.nextBucket(ctx, purge.ProjectID)
+			if err != nil {
+				return err
+			}
+			if !ok {
+				return service.finish(ctx, &purge, PurgeFinished)
+			}
+			purge.BucketName = next
+			purge.ContinuationToken = nil
+			purge.Attempts = 0
+			if err := service.db.Update(ctx, purge); err != nil {
+				return err
+			}
+		}
+
+		err := service.purgeBucket(ctx, &purge)
+		if err != nil {
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				// the purge is continued after the restart.
+				return ctxErr
+			}
+			return service.fail(ctx, &purge, err)
+		}
+
+		purge.DeletedBuckets++
+		purge.BucketName = ""
+		purge.ContinuationToken = nil
+		purge.Attempts = 0
+		purge.Error = ""
+		if err := service.db.Update(ctx, purge); err != nil {
+			return err
+		}
+	}
+}
+
+// purgeBucket deletes the objects and the metadata of the current bucket,
+// starting from the stored continuation token.
+func (service *Service) purgeBucket(ctx context.Context, purge *Purge) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	// retention can be set on objects while the purge is running.
+	if err := service.validate(ctx, purge.ProjectID, purge.BucketName); err != nil {
+		return err
+	}
+
+	_, err = service.metabase.DeleteBucketObjects(ctx, metabase.DeleteBucketObjects{
+		Bucket: metabase.BucketLocation{
+			ProjectID:  purge.ProjectID,
+			BucketName: purge.BucketName,
+		},
+		BatchSize:         service.config.BatchSize,
+		ContinuationToken: purge.ContinuationToken,
+		Progress: func(ctx context.Context, progress metabase.DeleteBucketObjectsProgress) error {
+			purge.DeletedObjects += progress.DeletedObjects
+			purge.ContinuationToken = progress.ContinuationToken
+			return service.db.Update(ctx, *purge)
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	err = service.buckets.DeleteBucket(ctx, []byte(purge.BucketName), purge.ProjectID)
+	if buckets.ErrBucketNotFound.Has(err) {
+		err = nil
+	}
+	return err
+}
+
+// fail records a failed attempt at purging the current bucket. The purge fails
+// once the bucket contains protected data or all attempts are used up.
+func (service *Service) fail(ctx context.Context, purge *Purge, cause error) error {
+	purge.Attempts++
+	purge.Error = cause.Error()
+
+	service.log.Warn("unable to purge bucket",
+		zap.Stringer("Project ID", purge.ProjectID),
+		zap.String("Bucket", purge.BucketName),
+		zap.Int("Attempt", purge.Attempts),
+		zap.Error(cause),
+	)
+
+	if ErrProtectedData.Has(cause) || purge.Attempts >= service.config.MaxAttempts {
+		return service.finish(ctx, purge, PurgeFailed)
+	}
+	return service.db.Update(ctx, *purge)
+}
+
+func (service *Service) finish(ctx context.Context, purge *Purge, status PurgeStatus) error {
+	finishedAt := service.nowFn()
+	purge.Status = status
+	purge.FinishedAt = &finishedAt
+	if err := service.db.Update(ctx, *purge); err != nil {
+		return err
+	}
+
+	service.log.Info("project data purge finished",
+		zap.Stringer("Project ID", purge.ProjectID),
+		zap.String("Status", string(purge.Status)),
+		zap.Int("Deleted Buckets", purge.DeletedBuckets),
+		zap.Int64("Deleted Objects", purge.DeletedObjects),
+		zap.Duration("Duration", finishedAt.Sub(purge.CreatedAt)),
+	)
+	return nil
+}
+
+// nextBucket returns the first remaining bucket of the project. Purged buckets
+// are deleted, so the first bucket is always the next one to purge.
+func (service *Service) nextBucket(ctx context.Context, projectID uuid.UUID) (name string, ok bool, err error) {
+	list, err := service.buckets.ListBuckets(ctx, projectID, buckets.ListOptions{
+		Direction: buckets.DirectionForward,
+		Limit:     1,
+	}, macaroon.AllowedBuckets{All: true})
+	if err != nil {
+		return "", false, err
+	}
+	if len(list.Items) == 0 {
+		return "", false, nil
+	}
+	return list.Items[0].Name, true, nil
+}
+
+// validate refuses to purge objects which are under an active compliance
+// retention or held by a pin. Buckets with object lock enabled may be purged
+// as long as none of their objects is protected.
+func (service *Service) validate(ctx context.Context, projectID uuid.UUID, bucketName string) error {
+	report, err := service.metabase.GetObjectLockReport(ctx, metabase.GetObjectLockReport{
+		ProjectID:  projectID,
+		BucketName: bucketName,
+		Now:        service.nowFn(),
+	})
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	var protected []string
+	for _, bucket := range report {
+		if bucket.RetainedObjects == 0 && bucket.LegalHoldObjects == 0 {
+			continue
+		}
+		protected = append(protected, fmt.Sprintf("%s (%d retained, %d under legal hold)",
+			bucket.BucketName, bucket.RetainedObjects, bucket.LegalHoldObjects))
+	}
+	if len(protected) > 0 {
+		return ErrProtectedData.New("%s", strings.Join(protected, ", "))
+	}
+	return nil
+}
@@ -0,0 +1,208 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package projectdeletion_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/memory"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite/buckets"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/projectdeletion"
+)
+
+func TestPurge(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		sat.Admin.ProjectDeletion.Chore.Loop.Pause()
+
+		upl := planet.Uplinks[0]
+		projectID := upl.Projects[0].ID
+
+		_, err := sat.DB.Buckets().CreateBucket(ctx, buckets.Bucket{
+			ID:                testrand.UUID(),
+			Name:              "locked",
+			ProjectID:         projectID,
+			Versioning:        buckets.VersioningEnabled,
+			ObjectLockEnabled: true,
+		})
+		require.NoError(t, err)
+
+		for _, bucket := range []string{"first", "locked", "second"} {
+			for i := 0; i < 3; i++ {
+				err := upl.Upload(ctx, sat, bucket, testrand.Path(), testrand.Bytes(memory.KiB))
+				require.NoError(t, err)
+			}
+		}
+
+		service := projectdeletion.NewService(zaptest.NewLogger(t), sat.DB.ProjectDataPurges(), sat.DB.Buckets(), sat.Metabase.DB, projectdeletion.Config{
+			MaxAttempts: 2,
+			BatchSize:   2,
+			ListLimit:   10,
+		})
+
+		_, err = service.Status(ctx, projectID)
+		require.True(t, projectdeletion.ErrNotFound.Has(err), err)
+
+		// buckets with object lock enabled are purged when no object is retained.
+		purge, err := service.Start(ctx, "admin@storj.test", projectID)
+		require.NoError(t, err)
+		require.Equal(t, projectdeletion.PurgeRunning, purge.Status)
+		require.Equal(t, "admin@storj.test", purge.CreatedBy)
+
+		_, err = service.Start(ctx, "admin@storj.test", projectID)
+		require.True(t, projectdeletion.ErrAlreadyRunning.Has(err), err)
+
+		processed, err := service.ProcessRunning(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 1, processed)
+
+		purge, err = service.Status(ctx, projectID)
+		require.NoError(t, err)
+		require.Equal(t, projectdeletion.PurgeFinished, purge.Status)
+		require.NotNil(t, purge.FinishedAt)
+		require.Equal(t, 3, purge.DeletedBuckets)
+		require.EqualValues(t, 9, purge.DeletedObjects)
+		require.Empty(t, purge.BucketName)
+		require.Empty(t, purge.Error)
+
+		count, err := sat.DB.Buckets().CountBuckets(ctx, projectID)
+		require.NoError(t, err)
+		require.Zero(t, count)
+
+		objects, err := sat.Metabase.DB.TestingAllObjects(ctx)
+		require.NoError(t, err)
+		require.Empty(t, objects)
+
+		processed, err = service.ProcessRunning(ctx)
+		require.NoError(t, err)
+		require.Zero(t, processed)
+
+		t.Run("legal hold", func(t *testing.T) {
+			err := upl.Upload(ctx, sat, "held", "object", testrand.Bytes(memory.KiB))
+			require.NoError(t, err)
+
+			objects, err := sat.Metabase.DB.TestingAllObjects(ctx)
+			require.NoError(t, err)
+			require.Len(t, objects, 1)
+
+			pin := metabase.ObjectPinLocation{ProjectID: projectID, BucketName: "held", Name: "hold"}
+			_, err = sat.Metabase.DB.CreateObjectPin(ctx, metabase.CreateObjectPin{
+				ObjectPinLocation: pin,
+				Versions:          []metabase.ObjectPinVersion{{ObjectKey: objects[0].ObjectKey, Version: objects[0].Version}},
+			})
+			require.NoError(t, err)
+
+			err = service.Validate(ctx, projectID)
+			require.True(t, projectdeletion.ErrProtectedData.Has(err), err)
+
+			_, err = service.Start(ctx, "admin@storj.test", projectID)
+			require.True(t, projectdeletion.ErrProtectedData.Has(err), err)
+
+			// the previous purge is kept when a new one can't be started.
+			purge, err := service.Status(ctx, projectID)
+			require.NoError(t, err)
+			require.Equal(t, projectdeletion.PurgeFinished, purge.Status)
+
+			require.NoError(t, sat.Metabase.DB.DeleteObjectPin(ctx, metabase.DeleteObjectPin{ObjectPinLocation: pin}))
+		})
+	})
+}
+
+func TestPurge_Resume(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		sat.Admin.ProjectDeletion.Chore.Loop.Pause()
+
+		upl := planet.Uplinks[0]
+		projectID := upl.Projects[0].ID
+
+		for _, bucket := range []string{"first", "second"} {
+			for i := 0; i < 3; i++ {
+				err := upl.Upload(ctx, sat, bucket, testrand.Path(), testrand.Bytes(memory.KiB))
+				require.NoError(t, err)
+			}
+		}
+
+		// storing the progress after the second deleted batch fails.
+		db := &failingDB{DB: sat.DB.ProjectDataPurges(), failAt: 3}
+		service := projectdeletion.NewService(zaptest.NewLogger(t), db, sat.DB.Buckets(), sat.Metabase.DB, projectdeletion.Config{
+			MaxAttempts: 2,
+			BatchSize:   1,
+			ListLimit:   10,
+		})
+
+		_, err := service.Start(ctx, "admin@storj.test", projectID)
+		require.NoError(t, err)
+
+		processed, err := service.ProcessRunning(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 1, processed)
+
+		purge, err := service.Status(ctx, projectID)
+		require.NoError(t, err)
+		require.Equal(t, projectdeletion.PurgeRunning, purge.Status)
+		require.Equal(t, "first", purge.BucketName)
+		require.Equal(t, 1, purge.Attempts)
+		require.NotEmpty(t, purge.ContinuationToken)
+		require.NotEmpty(t, purge.Error)
+		require.EqualValues(t, 2, purge.DeletedObjects)
+
+		objects, err := sat.Metabase.DB.TestingAllObjects(ctx)
+		require.NoError(t, err)
+		require.Len(t, objects, 4)
+
+		// the next run continues after the last deleted object.
+		processed, err = service.ProcessRunning(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 1, processed)
+
+		purge, err = service.Status(ctx, projectID)
+		require.NoError(t, err)
+		require.Equal(t, projectdeletion.PurgeFinished, purge.Status)
+		require.Equal(t, 2, purge.DeletedBuckets)
+		require.EqualValues(t, 6, purge.DeletedObjects)
+		require.Empty(t, purge.Error)
+
+		count, err := sat.DB.Buckets().CountBuckets(ctx, projectID)
+		require.NoError(t, err)
+		require.Zero(t, count)
+
+		// a finished purge can be started again.
+		purge, err = service.Start(ctx, "other@storj.test", projectID)
+		require.NoError(t, err)
+		require.Equal(t, projectdeletion.PurgeRunning, purge.Status)
+		require.Equal(t, "other@storj.test", purge.CreatedBy)
+		require.Zero(t, purge.DeletedObjects)
+		require.Nil(t, purge.FinishedAt)
+	})
+}
+
+// failingDB fails the failAt-th call to Update.
+type failingDB struct {
+	projectdeletion.DB
+
+	calls  int
+	failAt int
+}
+
+func (db *failingDB) Update(ctx context.Context, purge projectdeletion.Purge) error {
+	db.calls++
+	if db.calls == db.failAt {
+		return errors.New("update failed")
+	}
+	return db.DB.Update(ctx, purge)
+}
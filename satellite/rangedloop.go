@@ -22,7 +22,9 @@ import (
 	"storj.io/storj/satellite/audit"
 	"storj.io/storj/satellite/durability"
 	"storj.io/storj/satellite/gc/piecetracker"
+	"storj.io/storj/satellite/integrity"
 	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/aliasgc"
 	"storj.io/storj/satellite/metabase/rangedloop"
 	"storj.io/storj/satellite/metrics"
 	"storj.io/storj/satellite/nodeselection"
@@ -73,6 +75,14 @@ type RangedLoop struct {
 		Observer []*durability.Report
 	}
 
+	SegmentIntegrity struct {
+		Observer *integrity.Observer
+	}
+
+	NodeAliasGC struct {
+		Observer *aliasgc.Observer
+	}
+
 	RangedLoop struct {
 		Service *rangedloop.Service
 	}
@@ -148,6 +158,24 @@ func NewRangedLoop(log *zap.Logger, db DB, metabaseDB *metabase.DB, config *Conf
 		}
 	}
 
+	{ // setup segment integrity observer
+		peer.SegmentIntegrity.Observer = integrity.NewObserver(
+			log.Named("segment-integrity"),
+			metabaseDB,
+			db.SegmentIntegrityQueue(),
+			config.SegmentIntegrity,
+		)
+	}
+
+	{ // setup node alias gc observer
+		peer.NodeAliasGC.Observer = aliasgc.NewObserver(
+			log.Named("node-alias-gc"),
+			metabaseDB,
+			db.OverlayCache(),
+			config.NodeAliasGC,
+		)
+	}
+
 	{ // setup overlay
 		placement, err := config.Placement.Parse(config.Overlay.Node.CreateDefaultPlacement, nil)
 		if err != nil {
@@ -208,6 +236,14 @@ func NewRangedLoop(log *zap.Logger, db DB, metabaseDB *metabase.DB, config *Conf
 			observers = append(observers, peer.PieceTracker.Observer)
 		}
 
+		if config.SegmentIntegrity.Enabled {
+			observers = append(observers, peer.SegmentIntegrity.Observer)
+		}
+
+		if config.NodeAliasGC.Enabled {
+			observers = append(observers, peer.NodeAliasGC.Observer)
+		}
+
 		if config.DurabilityReport.Enabled {
 			sequenceObservers := []rangedloop.Observer{}
 			for _, observer := range peer.DurabilityReport.Observer {
@@ -0,0 +1,253 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package nodemigration plans moving the data away from nodes which are
+// going to be removed from the network without a graceful exit.
+package nodemigration
+
+import (
+	"context"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	"golang.org/x/time/rate"
+
+	"storj.io/common/storj"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/repair"
+	"storj.io/storj/satellite/repair/queue"
+)
+
+var (
+	mon = monkit.Package()
+
+	// Error is the default error class for the node migration planner.
+	Error = errs.Class("node migration")
+)
+
+// Config contains configurable values for the node migration planner.
+type Config struct {
+	BatchSize         int     `help:"number of segments inserted into the repair queue at once" default:"100"`
+	SegmentsPerSecond float64 `help:"maximum number of segments scheduled for repair per second" default:"100"`
+	NodeFailureRate   float64 `help:"the probability of a single node going down within the next checker iteration, used for prioritizing segments" default:"0.00005435"`
+}
+
+// Segment is a segment which has pieces on the nodes being migrated.
+type Segment struct {
+	queue.InjuredSegment
+
+	// AffectedPieces is the number of pieces stored on the migrated nodes.
+	AffectedPieces int
+	// EncryptedSize is the size of the whole segment.
+	EncryptedSize int32
+}
+
+// Plan describes the repair load needed to move data off a set of nodes.
+type Plan struct {
+	Nodes     []storj.NodeID
+	CreatedAt time.Time
+
+	Segments []Segment
+	// AffectedPieces is the total number of pieces on the migrated nodes.
+	AffectedPieces int64
+	// EstimatedRepairBytes is the number of bytes the repairers need to download
+	// to rebuild the affected segments.
+	EstimatedRepairBytes int64
+
+	// Scheduled is the number of segments already inserted into the repair queue.
+	Scheduled int
+}
+
+// Progress describes how far the migration of a plan is.
+type Progress struct {
+	Total     int
+	Scheduled int
+	Migrated  int
+}
+
+// Percent returns the percentage of the segments which no longer have pieces on the migrated nodes.
+func (progress Progress) Percent() float64 {
+	if progress.Total == 0 {
+		return 100
+	}
+	return 100 * float64(progress.Migrated) / float64(progress.Total)
+}
+
+// Planner enumerates the segments stored on nodes which are going to be retired
+// and schedules them for repair at a controlled rate.
+//
+// architecture: Service
+type Planner struct {
+	log      *zap.Logger
+	metabase *metabase.DB
+	queue    queue.RepairQueue
+	config   Config
+
+	nowFn func() time.Time
+}
+
+// NewPlanner creates a new node migration planner.
+func NewPlanner(log *zap.Logger, metabase *metabase.DB, repairQueue queue.RepairQueue, config Config) *Planner {
+	if config.BatchSize <= 0 {
+		config.BatchSize = 100
+	}
+	return &Planner{
+		log:      log,
+		metabase: metabase,
+		queue:    repairQueue,
+		config:   config,
+		nowFn:    time.Now,
+	}
+}
+
+// Plan enumerates all segments which have pieces on the specified nodes.
+func (planner *Planner) Plan(ctx context.Context, nodes []storj.NodeID) (_ *Plan, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(nodes) == 0 {
+		return nil, Error.New("no nodes specified")
+	}
+
+	aliasMap, err := planner.metabase.LatestNodesAliasMap(ctx)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	plan := &Plan{
+		Nodes:     nodes,
+		CreatedAt: planner.nowFn(),
+	}
+
+	aliases, _ := aliasMap.Aliases(nodes)
+	if len(aliases) == 0 {
+		// none of the nodes has ever stored a piece.
+		return plan, nil
+	}
+	migrated := make(map[metabase.NodeAlias]struct{}, len(aliases))
+	for _, alias := range aliases {
+		migrated[alias] = struct{}{}
+	}
+	totalNodes := aliasMap.Size()
+
+	err = planner.metabase.IterateLoopSegments(ctx, metabase.IterateLoopSegments{}, func(ctx context.Context, it metabase.LoopSegmentsIterator) error {
+		var entry metabase.LoopSegmentEntry
+		for it.Next(ctx, &entry) {
+			affected := 0
+			for _, piece := range entry.AliasPieces {
+				if _, ok := migrated[piece.Alias]; ok {
+					affected++
+				}
+			}
+			if affected == 0 {
+				continue
+			}
+
+			healthy := len(entry.AliasPieces) - affected
+			plan.Segments = append(plan.Segments, Segment{
+				InjuredSegment: queue.InjuredSegment{
+					StreamID:      entry.StreamID,
+					Position:      entry.Position,
+					SegmentHealth: repair.SegmentHealth(healthy, int(entry.Redundancy.RequiredShares), totalNodes, planner.config.NodeFailureRate, 0),
+					Placement:     entry.Placement,
+				},
+				AffectedPieces: affected,
+				EncryptedSize:  entry.EncryptedSize,
+			})
+			plan.AffectedPieces += int64(affected)
+			plan.EstimatedRepairBytes += int64(entry.EncryptedSize)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	planner.log.Info("node migration planned",
+		zap.Int("Nodes", len(nodes)),
+		zap.Int("Segments", len(plan.Segments)),
+		zap.Int64("Pieces", plan.AffectedPieces),
+		zap.Int64("Estimated Repair Bytes", plan.EstimatedRepairBytes),
+	)
+
+	return plan, nil
+}
+
+// Schedule inserts the remaining segments of the plan into the repair queue, limited to
+// the configured rate. It can be called again after an interruption to continue.
+func (planner *Planner) Schedule(ctx context.Context, plan *Plan) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	limit := rate.Inf
+	if planner.config.SegmentsPerSecond > 0 {
+		limit = rate.Limit(planner.config.SegmentsPerSecond)
+	}
+	limiter := rate.NewLimiter(limit, planner.config.BatchSize)
+
+	for plan.Scheduled < len(plan.Segments) {
+		end := plan.Scheduled + planner.config.BatchSize
+		if end > len(plan.Segments) {
+			end = len(plan.Segments)
+		}
+
+		if err := limiter.WaitN(ctx, end-plan.Scheduled); err != nil {
+			return Error.Wrap(err)
+		}
+
+		batch := make([]*queue.InjuredSegment, 0, end-plan.Scheduled)
+		for i := plan.Scheduled; i < end; i++ {
+			batch = append(batch, &plan.Segments[i].InjuredSegment)
+		}
+
+		if _, err := planner.queue.InsertBatch(ctx, batch); err != nil {
+			return Error.Wrap(err)
+		}
+		plan.Scheduled = end
+
+		mon.Counter("node_migration_segments_scheduled").Inc(int64(len(batch)))
+	}
+
+	return nil
+}
+
+// Progress checks how many segments of the plan no longer have pieces on the migrated nodes.
+// Segments which have been deleted in the meantime count as migrated.
+func (planner *Planner) Progress(ctx context.Context, plan *Plan) (progress Progress, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	progress.Total = len(plan.Segments)
+	progress.Scheduled = plan.Scheduled
+
+	migrated := make(map[storj.NodeID]struct{}, len(plan.Nodes))
+	for _, node := range plan.Nodes {
+		migrated[node] = struct{}{}
+	}
+
+	for _, segment := range plan.Segments {
+		current, err := planner.metabase.GetSegmentByPosition(ctx, metabase.GetSegmentByPosition{
+			StreamID: segment.StreamID,
+			Position: segment.Position,
+		})
+		if err != nil {
+			if metabase.ErrSegmentNotFound.Has(err) {
+				progress.Migrated++
+				continue
+			}
+			return Progress{}, Error.Wrap(err)
+		}
+
+		done := true
+		for _, piece := range current.Pieces {
+			if _, ok := migrated[piece.StorageNode]; ok {
+				done = false
+				break
+			}
+		}
+		if done {
+			progress.Migrated++
+		}
+	}
+
+	return progress, nil
+}
@@ -0,0 +1,82 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package nodemigration_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/memory"
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/repair/nodemigration"
+)
+
+func TestPlanner(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+
+		for i := 0; i < 3; i++ {
+			err := planet.Uplinks[0].Upload(ctx, sat, "testbucket", testrand.Path(), testrand.Bytes(10*memory.KiB))
+			require.NoError(t, err)
+		}
+
+		segments, err := sat.Metabase.DB.TestingAllSegments(ctx)
+		require.NoError(t, err)
+		require.Len(t, segments, 3)
+
+		node := planet.StorageNodes[0].ID()
+		expected := 0
+		for _, segment := range segments {
+			for _, piece := range segment.Pieces {
+				if piece.StorageNode == node {
+					expected++
+				}
+			}
+		}
+
+		planner := nodemigration.NewPlanner(zaptest.NewLogger(t), sat.Metabase.DB, sat.DB.RepairQueue(), nodemigration.Config{
+			BatchSize: 2,
+		})
+
+		plan, err := planner.Plan(ctx, []storj.NodeID{node})
+		require.NoError(t, err)
+		require.Len(t, plan.Segments, expected)
+		require.EqualValues(t, expected, plan.AffectedPieces)
+
+		require.NoError(t, planner.Schedule(ctx, plan))
+		require.Equal(t, expected, plan.Scheduled)
+
+		count, err := sat.DB.RepairQueue().Count(ctx)
+		require.NoError(t, err)
+		require.Equal(t, expected, count)
+
+		progress, err := planner.Progress(ctx, plan)
+		require.NoError(t, err)
+		require.Equal(t, expected, progress.Total)
+		require.Equal(t, expected, progress.Scheduled)
+		require.Zero(t, progress.Migrated)
+
+		// deleted segments are considered migrated.
+		_, err = sat.Metabase.DB.DeleteBucketObjects(ctx, metabase.DeleteBucketObjects{
+			Bucket: metabase.BucketLocation{
+				ProjectID:  planet.Uplinks[0].Projects[0].ID,
+				BucketName: "testbucket",
+			},
+		})
+		require.NoError(t, err)
+
+		progress, err = planner.Progress(ctx, plan)
+		require.NoError(t, err)
+		require.Equal(t, expected, progress.Migrated)
+		require.EqualValues(t, 100, progress.Percent())
+	})
+}
@@ -0,0 +1,51 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package prioritize
+
+import (
+	"time"
+
+	"storj.io/common/uuid"
+)
+
+// JobStatus is the state of a repair prioritization job.
+type JobStatus string
+
+const (
+	// JobRunning means the segments are being enumerated.
+	JobRunning JobStatus = "running"
+	// JobFinished means all segments have been enumerated.
+	JobFinished JobStatus = "finished"
+	// JobAborted means the job was aborted by an admin or by a shutdown.
+	JobAborted JobStatus = "aborted"
+	// JobFailed means enumerating or queuing the segments failed.
+	JobFailed JobStatus = "failed"
+)
+
+// Job is the progress of queuing the affected segments of a project.
+type Job struct {
+	ID        uuid.UUID `json:"id"`
+	ProjectID uuid.UUID `json:"projectID"`
+	// BucketName restricts the job to a single bucket, when not empty.
+	BucketName string    `json:"bucketName,omitempty"`
+	Status     JobStatus `json:"status"`
+
+	// CurrentBucket is the bucket currently being enumerated.
+	CurrentBucket string `json:"currentBucket,omitempty"`
+
+	Buckets        int    `json:"buckets"`
+	Objects        int64  `json:"objects"`
+	Segments       int64  `json:"segments"`
+	QueuedSegments int64  `json:"queuedSegments"`
+	Error          string `json:"error,omitempty"`
+
+	CreatedBy  string     `json:"createdBy"`
+	CreatedAt  time.Time  `json:"createdAt"`
+	FinishedAt *time.Time `json:"finishedAt,omitempty"`
+}
+
+// Running returns whether the job hasn't stopped yet.
+func (job Job) Running() bool {
+	return job.FinishedAt == nil
+}
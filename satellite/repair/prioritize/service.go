@@ -0,0 +1,447 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package prioritize queues the segments of a project, which lost pieces in an
+// incident, for repair ahead of the segments found by the checker.
+package prioritize
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/macaroon"
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/buckets"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/overlay"
+	"storj.io/storj/satellite/repair/queue"
+)
+
+var (
+	mon = monkit.Package()
+
+	// Error is the default error class for repair prioritization.
+	Error = errs.Class("repair prioritization")
+	// ErrNotFound is returned when the project has no prioritization job.
+	ErrNotFound = errs.Class("repair prioritization not found")
+	// ErrAlreadyRunning is returned when starting a job while one is running for the project.
+	ErrAlreadyRunning = errs.Class("repair prioritization already running")
+	// ErrNotRunning is returned when aborting a job which isn't running anymore.
+	ErrNotRunning = errs.Class("repair prioritization not running")
+)
+
+// Config contains configurable values for repair prioritization.
+type Config struct {
+	BatchSize int `help:"number of objects listed and segments queued for repair at once" default:"100"`
+}
+
+// Service enumerates the segments of a project, or of a single bucket, and
+// pushes every segment with pieces on unavailable nodes to the repair queue.
+//
+// The segments are queued with a negative health, so the repairer selects them
+// before any segment queued by the checker. Segments with fewer healthy pieces
+// above the repair threshold are selected first. A later checker run may
+// overwrite the health with its own value.
+//
+// Jobs only live in memory, a job interrupted by a restart has to be started
+// again. Queuing the same segment twice is harmless.
+//
+// architecture: Service
+type Service struct {
+	log      *zap.Logger
+	metabase *metabase.DB
+	buckets  buckets.DB
+	overlay  overlay.DB
+	queue    queue.RepairQueue
+	config   Config
+
+	onlineWindow       time.Duration
+	asOfSystemInterval time.Duration
+
+	nowFn func() time.Time
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	mu   sync.Mutex
+	jobs map[uuid.UUID]*runningJob
+}
+
+// runningJob is a job together with the control to abort it.
+type runningJob struct {
+	job   Job
+	abort context.CancelFunc
+}
+
+// NewService creates a new repair prioritization service.
+func NewService(log *zap.Logger, metabase *metabase.DB, buckets buckets.DB, overlay overlay.DB, queue queue.RepairQueue, onlineWindow, asOfSystemInterval time.Duration, config Config) *Service {
+	if config.BatchSize <= 0 {
+		config.BatchSize = 100
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Service{
+		log:      log,
+		metabase: metabase,
+		buckets:  buckets,
+		overlay:  overlay,
+		queue:    queue,
+		config:   config,
+
+		onlineWindow:       onlineWindow,
+		asOfSystemInterval: asOfSystemInterval,
+
+		nowFn: time.Now,
+
+		ctx:    ctx,
+		cancel: cancel,
+		jobs:   map[uuid.UUID]*runningJob{},
+	}
+}
+
+// Start starts queuing the affected segments of the project in the background.
+// When bucketName is empty, all buckets of the project are enumerated.
+func (service *Service) Start(ctx context.Context, createdBy string, projectID uuid.UUID, bucketName string) (_ Job, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	service.mu.Lock()
+	defer service.mu.Unlock()
+
+	if err := service.ctx.Err(); err != nil {
+		return Job{}, Error.Wrap(err)
+	}
+	if previous, ok := service.jobs[projectID]; ok && previous.job.Running() {
+		return Job{}, ErrAlreadyRunning.New("%s", projectID)
+	}
+
+	id, err := uuid.New()
+	if err != nil {
+		return Job{}, Error.Wrap(err)
+	}
+
+	jobCtx, abort := context.WithCancel(service.ctx)
+	running := &runningJob{
+		job: Job{
+			ID:         id,
+			ProjectID:  projectID,
+			BucketName: bucketName,
+			Status:     JobRunning,
+			CreatedBy:  createdBy,
+			CreatedAt:  service.nowFn(),
+		},
+		abort: abort,
+	}
+	service.jobs[projectID] = running
+
+	service.log.Info("repair prioritization started",
+		zap.Stringer("Project ID", projectID),
+		zap.String("Bucket", bucketName),
+		zap.String("Created By", createdBy),
+	)
+
+	service.wg.Add(1)
+	go func() {
+		defer service.wg.Done()
+		defer abort()
+		service.run(jobCtx, running)
+	}()
+
+	return running.job, nil
+}
+
+// Status returns the latest job of the project.
+func (service *Service) Status(ctx context.Context, projectID uuid.UUID) (_ Job, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	service.mu.Lock()
+	defer service.mu.Unlock()
+
+	running, ok := service.jobs[projectID]
+	if !ok {
+		return Job{}, ErrNotFound.New("%s", projectID)
+	}
+	return running.job, nil
+}
+
+// Abort stops the running job of the project. Segments which were already
+// queued stay in the repair queue.
+func (service *Service) Abort(ctx context.Context, abortedBy string, projectID uuid.UUID) (_ Job, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	service.mu.Lock()
+	defer service.mu.Unlock()
+
+	running, ok := service.jobs[projectID]
+	if !ok {
+		return Job{}, ErrNotFound.New("%s", projectID)
+	}
+	if !running.job.Running() {
+		return Job{}, ErrNotRunning.New("%s", projectID)
+	}
+
+	running.abort()
+	service.finish(running, JobAborted, nil)
+
+	service.log.Info("repair prioritization aborted",
+		zap.Stringer("Project ID", projectID),
+		zap.String("Aborted By", abortedBy),
+	)
+	return running.job, nil
+}
+
+// Close aborts all running jobs and waits for them to stop.
+func (service *Service) Close() error {
+	service.cancel()
+	service.wg.Wait()
+	return nil
+}
+
+// run enumerates the segments of the job and queues the affected ones.
+func (service *Service) run(ctx context.Context, running *runningJob) {
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	job := service.snapshot(running)
+
+	err = service.queueProject(ctx, running, job.ProjectID, job.BucketName)
+
+	service.mu.Lock()
+	defer service.mu.Unlock()
+
+	if !running.job.Running() {
+		// the job was aborted.
+		return
+	}
+	switch {
+	case err == nil:
+		service.finish(running, JobFinished, nil)
+	case ctx.Err() != nil:
+		// the service is shutting down.
+		service.finish(running, JobAborted, ctx.Err())
+	default:
+		service.finish(running, JobFailed, err)
+	}
+}
+
+// queueProject queues the affected segments of the given bucket or, when it's
+// empty, of all buckets of the project in name order.
+func (service *Service) queueProject(ctx context.Context, running *runningJob, projectID uuid.UUID, bucketName string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	online, err := service.onlineNodes(ctx)
+	if err != nil {
+		return err
+	}
+
+	if bucketName != "" {
+		return service.queueBucket(ctx, running, online, metabase.BucketLocation{
+			ProjectID:  projectID,
+			BucketName: bucketName,
+		})
+	}
+
+	opts := buckets.ListOptions{
+		Direction: buckets.DirectionForward,
+		Limit:     service.config.BatchSize,
+	}
+	for {
+		list, err := service.buckets.ListBuckets(ctx, projectID, opts, macaroon.AllowedBuckets{All: true})
+		if err != nil {
+			return err
+		}
+
+		for _, bucket := range list.Items {
+			err := service.queueBucket(ctx, running, online, metabase.BucketLocation{
+				ProjectID:  projectID,
+				BucketName: bucket.Name,
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		if !list.More || len(list.Items) == 0 {
+			return nil
+		}
+		opts = opts.NextPage(list)
+	}
+}
+
+// queueBucket queues the affected segments of all committed and pending objects
+// in the bucket.
+func (service *Service) queueBucket(ctx context.Context, running *runningJob, online map[storj.NodeID]struct{}, bucket metabase.BucketLocation) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	service.update(running, func(job *Job) {
+		job.CurrentBucket = bucket.BucketName
+	})
+
+	for _, pending := range []bool{false, true} {
+		err := service.metabase.IterateObjectsAllVersionsWithStatus(ctx, metabase.IterateObjectsWithStatus{
+			ProjectID:  bucket.ProjectID,
+			BucketName: bucket.BucketName,
+			Recursive:  true,
+			BatchSize:  service.config.BatchSize,
+			Pending:    pending,
+		}, func(ctx context.Context, it metabase.ObjectsIterator) error {
+			var entry metabase.ObjectEntry
+			for it.Next(ctx, &entry) {
+				if err := ctx.Err(); err != nil {
+					return err
+				}
+				if err := service.queueObject(ctx, running, online, bucket.ProjectID, entry.StreamID); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	service.update(running, func(job *Job) {
+		job.CurrentBucket = ""
+		job.Buckets++
+	})
+	return nil
+}
+
+// queueObject queues the affected segments of a single object.
+func (service *Service) queueObject(ctx context.Context, running *runningJob, online map[storj.NodeID]struct{}, projectID, streamID uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	cursor := metabase.SegmentPosition{}
+	for {
+		result, err := service.metabase.ListSegments(ctx, metabase.ListSegments{
+			ProjectID: projectID,
+			StreamID:  streamID,
+			Cursor:    cursor,
+			Limit:     service.config.BatchSize,
+		})
+		if err != nil {
+			return err
+		}
+
+		var injured []*queue.InjuredSegment
+		for _, segment := range result.Segments {
+			health, affected := segmentPriority(segment, online)
+			if !affected {
+				continue
+			}
+			injured = append(injured, &queue.InjuredSegment{
+				StreamID:      segment.StreamID,
+				Position:      segment.Position,
+				SegmentHealth: health,
+				Placement:     segment.Placement,
+			})
+		}
+
+		if len(injured) > 0 {
+			if _, err := service.queue.InsertBatch(ctx, injured); err != nil {
+				return err
+			}
+		}
+
+		service.update(running, func(job *Job) {
+			job.Segments += int64(len(result.Segments))
+			job.QueuedSegments += int64(len(injured))
+		})
+		mon.IntVal("repair_prioritized_segments").Observe(int64(len(injured)))
+
+		if !result.More || len(result.Segments) == 0 {
+			break
+		}
+		cursor = result.Segments[len(result.Segments)-1].Position
+	}
+
+	service.update(running, func(job *Job) {
+		job.Objects++
+	})
+	return nil
+}
+
+// onlineNodes returns the participating nodes, which were seen within the
+// online window. Disqualified and exited nodes aren't participating, so their
+// pieces are considered lost as well.
+func (service *Service) onlineNodes(ctx context.Context) (_ map[storj.NodeID]struct{}, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	nodes, err := service.overlay.GetParticipatingNodes(ctx, service.onlineWindow, service.asOfSystemInterval)
+	if err != nil {
+		return nil, err
+	}
+
+	online := make(map[storj.NodeID]struct{}, len(nodes))
+	for _, node := range nodes {
+		if node.Online {
+			online[node.ID] = struct{}{}
+		}
+	}
+	return online, nil
+}
+
+// segmentPriority returns the repair queue health of the segment and whether
+// any of its pieces are on nodes, which aren't online. The health is negative and lower
+// for segments with fewer healthy pieces above the repair threshold.
+func segmentPriority(segment metabase.Segment, online map[storj.NodeID]struct{}) (health float64, affected bool) {
+	if segment.Inline() {
+		return 0, false
+	}
+
+	healthy := 0
+	for _, piece := range segment.Pieces {
+		if _, ok := online[piece.StorageNode]; ok {
+			healthy++
+		}
+	}
+	if healthy == len(segment.Pieces) {
+		return 0, false
+	}
+
+	spare := healthy - int(segment.Redundancy.RequiredShares)
+	if spare < 0 {
+		spare = 0
+	}
+	return -1 / float64(1+spare), true
+}
+
+func (service *Service) snapshot(running *runningJob) Job {
+	service.mu.Lock()
+	defer service.mu.Unlock()
+	return running.job
+}
+
+func (service *Service) update(running *runningJob, fn func(job *Job)) {
+	service.mu.Lock()
+	defer service.mu.Unlock()
+	fn(&running.job)
+}
+
+// finish marks the job as stopped. It must be called with service.mu held.
+func (service *Service) finish(running *runningJob, status JobStatus, cause error) {
+	finishedAt := service.nowFn()
+	running.job.Status = status
+	running.job.CurrentBucket = ""
+	running.job.FinishedAt = &finishedAt
+	if cause != nil {
+		running.job.Error = cause.Error()
+	}
+
+	service.log.Info("repair prioritization finished",
+		zap.Stringer("Project ID", running.job.ProjectID),
+		zap.String("Status", string(status)),
+		zap.Int("Buckets", running.job.Buckets),
+		zap.Int64("Objects", running.job.Objects),
+		zap.Int64("Segments", running.job.Segments),
+		zap.Int64("Queued Segments", running.job.QueuedSegments),
+		zap.Duration("Duration", finishedAt.Sub(running.job.CreatedAt)),
+		zap.Error(cause),
+	)
+}
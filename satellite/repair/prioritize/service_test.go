@@ -0,0 +1,121 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package prioritize_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/memory"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/common/uuid"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite/repair/prioritize"
+)
+
+func TestPrioritize(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		sat.RangedLoop.RangedLoop.Service.Loop.Pause()
+		sat.Repair.Repairer.Loop.Pause()
+
+		upl := planet.Uplinks[0]
+		projectID := upl.Projects[0].ID
+
+		for bucket, count := range map[string]int{"first": 2, "second": 1} {
+			for i := 0; i < count; i++ {
+				err := upl.Upload(ctx, sat, bucket, testrand.Path(), testrand.Bytes(10*memory.KiB))
+				require.NoError(t, err)
+			}
+		}
+
+		segments, err := sat.Metabase.DB.TestingAllSegments(ctx)
+		require.NoError(t, err)
+		require.Len(t, segments, 3)
+		require.NotEmpty(t, segments[0].Pieces)
+
+		lost := segments[0].Pieces[0].StorageNode
+		require.NoError(t, planet.StopNodeAndUpdate(ctx, planet.FindNode(lost)))
+
+		objects, err := sat.Metabase.DB.TestingAllObjects(ctx)
+		require.NoError(t, err)
+		bucketOf := map[uuid.UUID]string{}
+		for _, object := range objects {
+			bucketOf[object.StreamID] = object.BucketName
+		}
+
+		affected := map[string]int64{}
+		for _, segment := range segments {
+			for _, piece := range segment.Pieces {
+				if piece.StorageNode == lost {
+					affected[bucketOf[segment.StreamID]]++
+					break
+				}
+			}
+		}
+
+		service := prioritize.NewService(zaptest.NewLogger(t), sat.Metabase.DB, sat.DB.Buckets(), sat.DB.OverlayCache(), sat.DB.RepairQueue(),
+			time.Minute, 0, prioritize.Config{BatchSize: 1})
+		defer ctx.Check(service.Close)
+
+		wait := func() prioritize.Job {
+			var job prioritize.Job
+			require.Eventually(t, func() bool {
+				job, err = service.Status(ctx, projectID)
+				require.NoError(t, err)
+				return !job.Running()
+			}, 10*time.Second, 10*time.Millisecond)
+			return job
+		}
+
+		_, err = service.Status(ctx, projectID)
+		require.True(t, prioritize.ErrNotFound.Has(err), err)
+
+		_, err = service.Abort(ctx, "admin@storj.test", projectID)
+		require.True(t, prioritize.ErrNotFound.Has(err), err)
+
+		// a single bucket.
+		job, err := service.Start(ctx, "admin@storj.test", projectID, "first")
+		require.NoError(t, err)
+		require.Equal(t, prioritize.JobRunning, job.Status)
+		require.Equal(t, "first", job.BucketName)
+		require.Equal(t, "admin@storj.test", job.CreatedBy)
+
+		job = wait()
+		require.Equal(t, prioritize.JobFinished, job.Status)
+		require.Empty(t, job.Error)
+		require.Equal(t, 1, job.Buckets)
+		require.EqualValues(t, 2, job.Objects)
+		require.EqualValues(t, 2, job.Segments)
+		require.Equal(t, affected["first"], job.QueuedSegments)
+
+		_, err = service.Abort(ctx, "admin@storj.test", projectID)
+		require.True(t, prioritize.ErrNotRunning.Has(err), err)
+
+		// the whole project.
+		_, err = service.Start(ctx, "admin@storj.test", projectID, "")
+		require.NoError(t, err)
+
+		job = wait()
+		require.Equal(t, prioritize.JobFinished, job.Status)
+		require.Equal(t, 2, job.Buckets)
+		require.EqualValues(t, 3, job.Objects)
+		require.EqualValues(t, 3, job.Segments)
+		require.Equal(t, affected["first"]+affected["second"], job.QueuedSegments)
+
+		// the segments are selected before the ones queued by the checker.
+		injured, err := sat.DB.RepairQueue().SelectN(ctx, 10)
+		require.NoError(t, err)
+		require.Len(t, injured, int(job.QueuedSegments))
+		for _, segment := range injured {
+			require.Less(t, segment.SegmentHealth, float64(0))
+		}
+	})
+}
@@ -0,0 +1,194 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package repairer
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"golang.org/x/sync/semaphore"
+
+	"storj.io/common/sync2"
+	"storj.io/storj/satellite/repair/queue"
+)
+
+// AdaptiveConcurrencyConfig configures how the number of concurrent repairs follows the load.
+type AdaptiveConcurrencyConfig struct {
+	Enabled            bool          `help:"whether to adjust the number of concurrent repairs between min-repair and max-repair based on the queue backlog, repair failures and database latency" default:"false"`
+	MinRepair          int           `help:"minimum segments that are repaired concurrently when adaptive concurrency is enabled" default:"1"`
+	Interval           time.Duration `help:"how frequently the number of concurrent repairs is adjusted" default:"1m0s"`
+	TargetBacklogAge   time.Duration `help:"concurrency is increased while the oldest segment in the repair queue is older than this, and decreased when it's younger than half of it" default:"1h0m0s"`
+	MaxFailureRate     float64       `help:"concurrency is halved when the ratio of failed repairs since the last adjustment exceeds this" default:"0.2"`
+	MaxDatabaseLatency time.Duration `help:"concurrency is halved when selecting from the repair queue takes longer than this on average" default:"1s"`
+}
+
+// ConcurrencyController adjusts the number of concurrent repairs of the Service within
+// the configured bounds. The setpoint is enforced by holding the unused capacity of the
+// job limiter.
+type ConcurrencyController struct {
+	log        *zap.Logger
+	config     AdaptiveConcurrencyConfig
+	maxRepair  int
+	queue      queue.RepairQueue
+	jobLimiter *semaphore.Weighted
+	Loop       *sync2.Cycle
+
+	nowFn func() time.Time
+
+	mu       sync.Mutex
+	setpoint int
+	reserved int
+
+	succeeded     int64
+	failed        int64
+	selectLatency time.Duration
+	selects       int64
+}
+
+// NewConcurrencyController creates a controller, which starts at the maximum concurrency.
+func NewConcurrencyController(log *zap.Logger, config AdaptiveConcurrencyConfig, maxRepair int, queue queue.RepairQueue, jobLimiter *semaphore.Weighted) *ConcurrencyController {
+	if config.MinRepair < 1 {
+		config.MinRepair = 1
+	}
+	if config.MinRepair > maxRepair {
+		config.MinRepair = maxRepair
+	}
+	return &ConcurrencyController{
+		log:        log,
+		config:     config,
+		maxRepair:  maxRepair,
+		queue:      queue,
+		jobLimiter: jobLimiter,
+		Loop:       sync2.NewCycle(config.Interval),
+
+		nowFn:    time.Now,
+		setpoint: maxRepair,
+	}
+}
+
+// Setpoint returns the current number of allowed concurrent repairs.
+func (controller *ConcurrencyController) Setpoint() int {
+	controller.mu.Lock()
+	defer controller.mu.Unlock()
+	return controller.setpoint
+}
+
+// observeRepair records the outcome of a repair.
+func (controller *ConcurrencyController) observeRepair(err error) {
+	controller.mu.Lock()
+	defer controller.mu.Unlock()
+	if err != nil {
+		controller.failed++
+	} else {
+		controller.succeeded++
+	}
+}
+
+// observeSelect records how long it took to select a segment from the repair queue.
+func (controller *ConcurrencyController) observeSelect(duration time.Duration) {
+	controller.mu.Lock()
+	defer controller.mu.Unlock()
+	controller.selectLatency += duration
+	controller.selects++
+}
+
+// Adjust updates the setpoint based on the signals collected since the previous adjustment.
+func (controller *ConcurrencyController) Adjust(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var backlogAge time.Duration
+	stats, err := controller.queue.Stat(ctx)
+	if err != nil {
+		// keep adjusting based on the other signals.
+		controller.log.Warn("unable to get repair queue statistics", zap.Error(Error.Wrap(err)))
+	}
+	now := controller.nowFn()
+	for _, stat := range stats {
+		if age := now.Sub(stat.MinInsertedAt); stat.Count > 0 && age > backlogAge {
+			backlogAge = age
+		}
+	}
+
+	controller.mu.Lock()
+	defer controller.mu.Unlock()
+
+	var failureRate float64
+	if total := controller.succeeded + controller.failed; total > 0 {
+		failureRate = float64(controller.failed) / float64(total)
+	}
+	var latency time.Duration
+	if controller.selects > 0 {
+		latency = controller.selectLatency / time.Duration(controller.selects)
+	}
+	controller.succeeded, controller.failed = 0, 0
+	controller.selectLatency, controller.selects = 0, 0
+
+	previous := controller.setpoint
+	controller.setpoint = controller.next(previous, backlogAge, failureRate, latency)
+	controller.apply()
+
+	if controller.setpoint != previous {
+		controller.log.Debug("repair concurrency adjusted",
+			zap.Int("From", previous),
+			zap.Int("To", controller.setpoint),
+			zap.Duration("Backlog Age", backlogAge),
+			zap.Float64("Failure Rate", failureRate),
+			zap.Duration("Database Latency", latency))
+	}
+
+	mon.IntVal("repair_concurrency_setpoint").Observe(int64(controller.setpoint))
+	mon.FloatVal("repair_concurrency_failure_rate").Observe(failureRate)
+	mon.DurationVal("repair_concurrency_database_latency").Observe(latency)
+	mon.DurationVal("repair_concurrency_backlog_age").Observe(backlogAge)
+
+	return nil
+}
+
+// next calculates the new setpoint: it backs off quickly when repairs fail or the
+// database is slow, and otherwise follows the age of the backlog one worker at a time.
+func (controller *ConcurrencyController) next(current int, backlogAge time.Duration, failureRate float64, latency time.Duration) int {
+	target := controller.config.TargetBacklogAge
+	switch {
+	case failureRate > controller.config.MaxFailureRate,
+		controller.config.MaxDatabaseLatency > 0 && latency > controller.config.MaxDatabaseLatency:
+		current /= 2
+	case backlogAge > target:
+		current++
+	case backlogAge < target/2:
+		current--
+	}
+
+	if current < controller.config.MinRepair {
+		current = controller.config.MinRepair
+	}
+	if current > controller.maxRepair {
+		current = controller.maxRepair
+	}
+	return current
+}
+
+// apply reserves or releases the job limiter capacity above the setpoint. Capacity used
+// by running repairs is reserved by a later adjustment, after the repairs finish.
+//
+// controller.mu must be held.
+func (controller *ConcurrencyController) apply() {
+	want := controller.maxRepair - controller.setpoint
+	if controller.reserved > want {
+		controller.jobLimiter.Release(int64(controller.reserved - want))
+		controller.reserved = want
+	}
+	for controller.reserved < want && controller.jobLimiter.TryAcquire(1) {
+		controller.reserved++
+	}
+}
+
+// releaseReserved gives back all the reserved capacity to the job limiter.
+func (controller *ConcurrencyController) releaseReserved() {
+	controller.mu.Lock()
+	defer controller.mu.Unlock()
+	controller.jobLimiter.Release(int64(controller.reserved))
+	controller.reserved = 0
+}
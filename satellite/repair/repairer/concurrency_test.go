@@ -0,0 +1,77 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package repairer
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+	"golang.org/x/sync/semaphore"
+
+	"storj.io/common/testcontext"
+	"storj.io/storj/satellite/repair/queue"
+)
+
+type statQueue struct {
+	queue.RepairQueue
+	stats []queue.Stat
+}
+
+func (q *statQueue) Stat(ctx context.Context) ([]queue.Stat, error) {
+	return q.stats, nil
+}
+
+func TestConcurrencyController(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	now := time.Now()
+	repairQueue := &statQueue{}
+	limiter := semaphore.NewWeighted(8)
+
+	controller := NewConcurrencyController(zaptest.NewLogger(t), AdaptiveConcurrencyConfig{
+		MinRepair:          2,
+		TargetBacklogAge:   time.Hour,
+		MaxFailureRate:     0.5,
+		MaxDatabaseLatency: time.Second,
+	}, 8, repairQueue, limiter)
+	controller.nowFn = func() time.Time { return now }
+	require.Equal(t, 8, controller.Setpoint())
+
+	// a small backlog decreases the concurrency down to the minimum.
+	for i := 0; i < 10; i++ {
+		require.NoError(t, controller.Adjust(ctx))
+	}
+	require.Equal(t, 2, controller.Setpoint())
+	require.False(t, limiter.TryAcquire(3), "only the setpoint capacity should be available")
+	require.True(t, limiter.TryAcquire(2))
+	limiter.Release(2)
+
+	// an old backlog increases the concurrency.
+	repairQueue.stats = []queue.Stat{{Count: 100, MinInsertedAt: now.Add(-2 * time.Hour)}}
+	require.NoError(t, controller.Adjust(ctx))
+	require.NoError(t, controller.Adjust(ctx))
+	require.Equal(t, 4, controller.Setpoint())
+
+	// failing repairs halve it.
+	controller.observeRepair(nil)
+	controller.observeRepair(errors.New("failure"))
+	controller.observeRepair(errors.New("failure"))
+	require.NoError(t, controller.Adjust(ctx))
+	require.Equal(t, 2, controller.Setpoint())
+
+	// as does a slow database.
+	require.NoError(t, controller.Adjust(ctx))
+	require.NoError(t, controller.Adjust(ctx))
+	require.Equal(t, 4, controller.Setpoint())
+	controller.observeSelect(3 * time.Second)
+	require.NoError(t, controller.Adjust(ctx))
+	require.Equal(t, 2, controller.Setpoint())
+
+	controller.releaseReserved()
+	require.True(t, limiter.TryAcquire(8))
+}
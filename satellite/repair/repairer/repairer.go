@@ -14,6 +14,7 @@ import (
 	"github.com/spf13/pflag"
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
 	"golang.org/x/sync/semaphore"
 
 	"storj.io/common/memory"
@@ -48,6 +49,8 @@ type Config struct {
 
 	IncludedPlacements PlacementList `help:"comma separated placement IDs (numbers), which should checked by the repairer (other placements are ignored)" default:""`
 	ExcludedPlacements PlacementList `help:"comma separated placement IDs (numbers), placements which should be ignored by the repairer" default:""`
+
+	AdaptiveConcurrency AdaptiveConcurrencyConfig
 }
 
 // PlacementList is a configurable, comma separated list of PlacementConstraint IDs.
@@ -99,12 +102,15 @@ type Service struct {
 	Loop       *sync2.Cycle
 	repairer   *SegmentRepairer
 
+	// Concurrency is nil unless adaptive concurrency is enabled.
+	Concurrency *ConcurrencyController
+
 	nowFn func() time.Time
 }
 
 // NewService creates repairing service.
 func NewService(log *zap.Logger, queue queue.RepairQueue, config *Config, repairer *SegmentRepairer) *Service {
-	return &Service{
+	service := &Service{
 		log:        log,
 		queue:      queue,
 		config:     config,
@@ -114,6 +120,10 @@ func NewService(log *zap.Logger, queue queue.RepairQueue, config *Config, repair
 
 		nowFn: time.Now,
 	}
+	if config.AdaptiveConcurrency.Enabled {
+		service.Concurrency = NewConcurrencyController(log.Named("concurrency"), config.AdaptiveConcurrency, config.MaxRepair, queue, service.JobLimiter)
+	}
+	return service
 }
 
 // Close closes resources.
@@ -146,6 +156,22 @@ func (service *Service) Run(ctx context.Context) (err error) {
 	// Wait for all repairs to complete
 	defer service.WaitForPendingRepairs()
 
+	if service.Concurrency != nil {
+		var group errgroup.Group
+		service.Concurrency.Loop.Start(ctx, &group, func(ctx context.Context) error {
+			if err := service.Concurrency.Adjust(ctx); err != nil {
+				service.log.Error("adjusting repair concurrency failed", zap.Error(err))
+			}
+			return nil
+		})
+		defer func() {
+			service.Concurrency.Loop.Close()
+			_ = group.Wait()
+			// the reserved capacity must be returned before waiting for the pending repairs.
+			service.Concurrency.releaseReserved()
+		}()
+	}
+
 	return service.Loop.Run(ctx, service.processWhileQueueHasItems)
 }
 
@@ -185,7 +211,11 @@ func (service *Service) process(ctx context.Context) (err error) {
 	// return from service.Run when queue fetch fails.
 	ctx, cancel := context.WithTimeout(ctx, service.config.TotalTimeout)
 
+	selectStart := time.Now()
 	seg, err := service.queue.Select(ctx, service.config.IncludedPlacements.Placements, service.config.ExcludedPlacements.Placements)
+	if service.Concurrency != nil && (err == nil || queue.ErrEmpty.Has(err)) {
+		service.Concurrency.observeSelect(time.Since(selectStart))
+	}
 	if err != nil {
 		service.JobLimiter.Release(1)
 		cancel()
@@ -198,7 +228,11 @@ func (service *Service) process(ctx context.Context) (err error) {
 	go func() {
 		defer service.JobLimiter.Release(1)
 		defer cancel()
-		if err := service.worker(ctx, seg); err != nil {
+		err := service.worker(ctx, seg)
+		if service.Concurrency != nil {
+			service.Concurrency.observeRepair(err)
+		}
+		if err != nil {
 			service.log.Error("repair worker failed:", zap.Error(err))
 		}
 	}()
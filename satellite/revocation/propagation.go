@@ -0,0 +1,178 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package revocation
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/uuid"
+	sharedsync "storj.io/storj/shared/sync"
+)
+
+var mon = monkit.Package()
+
+// ErrPropagation is the error class for failures to propagate revocations to edges.
+var ErrPropagation = errs.Class("revocation propagation")
+
+// PropagationConfig configures the propagation of API key revocations to the
+// edge auth services.
+type PropagationConfig struct {
+	Endpoints []string      `help:"URLs of the edge auth service webhooks notified when an API key is revoked" default:""`
+	Secret    string        `help:"secret sent as a bearer token to the edge auth service webhooks" default:""`
+	Timeout   time.Duration `help:"how long to wait for a single edge auth service to confirm a revocation" default:"10s"`
+	BatchSize int           `help:"maximum number of revocations of deleted API keys sent in a single request" default:"100"`
+	MaxDelay  time.Duration `help:"how long the revocations of deleted API keys are collected before they are sent" default:"1s"`
+}
+
+// Revocation describes a revoked API key. Edges identify the credentials
+// derived from the key by its head.
+type Revocation struct {
+	ProjectID  uuid.UUID `json:"projectID"`
+	APIKeyHead []byte    `json:"apiKeyHead"`
+	RevokedAt  time.Time `json:"revokedAt"`
+}
+
+// EdgeStatus is the result of notifying a single edge about revocations.
+type EdgeStatus struct {
+	Endpoint string `json:"endpoint"`
+	// Confirmed is true when the edge has invalidated the derived credentials.
+	Confirmed bool   `json:"confirmed"`
+	Error     string `json:"error,omitempty"`
+}
+
+// Propagator notifies the edge auth services about revoked API keys, so the
+// credentials derived from them stop working before the edge caches expire.
+//
+// architecture: Service
+type Propagator struct {
+	log    *zap.Logger
+	config PropagationConfig
+	client *http.Client
+
+	queue *sharedsync.Batcher[Revocation]
+}
+
+// NewPropagator creates a new revocation propagator.
+func NewPropagator(log *zap.Logger, config PropagationConfig) *Propagator {
+	propagator := &Propagator{
+		log:    log,
+		config: config,
+		client: &http.Client{Timeout: config.Timeout},
+	}
+	propagator.queue = sharedsync.NewBatcher("revocation_propagation", sharedsync.BatcherConfig{
+		MaxBatchSize: config.BatchSize,
+		MaxDelay:     config.MaxDelay,
+	}, func(ctx context.Context, revocations []Revocation) {
+		_ = propagator.Propagate(ctx, revocations)
+	})
+	return propagator
+}
+
+// Run sends the queued revocations in batches until ctx is canceled. The
+// revocations, which are still queued, are sent before it returns.
+func (propagator *Propagator) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return propagator.queue.Run(ctx)
+}
+
+// Queue queues the revocations to be sent with the next batch, without
+// waiting for the edges to confirm them.
+func (propagator *Propagator) Queue(revocations ...Revocation) {
+	if len(propagator.config.Endpoints) == 0 {
+		return
+	}
+	for _, revocation := range revocations {
+		propagator.queue.Add(revocation)
+	}
+}
+
+// Propagate sends the revocations to all the configured edges concurrently
+// and returns the status of each of them. An edge confirms the revocations
+// by responding with a successful status code.
+func (propagator *Propagator) Propagate(ctx context.Context, revocations []Revocation) (statuses []EdgeStatus) {
+	defer mon.Task()(&ctx)(nil)
+
+	if len(propagator.config.Endpoints) == 0 || len(revocations) == 0 {
+		return nil
+	}
+
+	body, err := json.Marshal(struct {
+		Revocations []Revocation `json:"revocations"`
+	}{revocations})
+	if err != nil {
+		// marshaling these types can't fail.
+		panic(err)
+	}
+
+	statuses = make([]EdgeStatus, len(propagator.config.Endpoints))
+
+	var wg sync.WaitGroup
+	for i, endpoint := range propagator.config.Endpoints {
+		i, endpoint := i, endpoint
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			statuses[i].Endpoint = endpoint
+			if err := propagator.notify(ctx, endpoint, body); err != nil {
+				propagator.log.Warn("edge did not confirm api key revocation",
+					zap.String("endpoint", endpoint), zap.Error(err))
+				mon.Counter("revocation_propagation_failures").Inc(1)
+				statuses[i].Error = err.Error()
+				return
+			}
+			statuses[i].Confirmed = true
+		}()
+	}
+	wg.Wait()
+
+	return statuses
+}
+
+func (propagator *Propagator) notify(ctx context.Context, endpoint string, body []byte) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return ErrPropagation.Wrap(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if propagator.config.Secret != "" {
+		req.Header.Set("Authorization", "Bearer "+propagator.config.Secret)
+	}
+
+	resp, err := propagator.client.Do(req)
+	if err != nil {
+		return ErrPropagation.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, resp.Body.Close()) }()
+
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 1<<10))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return ErrPropagation.New("unexpected status code %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// AllConfirmed returns whether every edge confirmed the revocations.
+func AllConfirmed(statuses []EdgeStatus) bool {
+	for _, status := range statuses {
+		if !status.Confirmed {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,68 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package revocation_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite/revocation"
+)
+
+func TestPropagator(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	var received []revocation.Revocation
+	confirming := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+
+		var body struct {
+			Revocations []revocation.Revocation `json:"revocations"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		received = body.Revocations
+	}))
+	defer confirming.Close()
+
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer failing.Close()
+
+	revocations := []revocation.Revocation{{
+		ProjectID:  testrand.UUID(),
+		APIKeyHead: testrand.BytesInt(32),
+		RevokedAt:  time.Now().UTC().Truncate(time.Second),
+	}}
+
+	propagator := revocation.NewPropagator(zaptest.NewLogger(t), revocation.PropagationConfig{
+		Endpoints: []string{confirming.URL, failing.URL},
+		Secret:    "secret",
+		Timeout:   time.Second,
+	})
+
+	statuses := propagator.Propagate(ctx, revocations)
+	require.Len(t, statuses, 2)
+	require.Equal(t, revocation.EdgeStatus{Endpoint: confirming.URL, Confirmed: true}, statuses[0])
+	require.Equal(t, failing.URL, statuses[1].Endpoint)
+	require.False(t, statuses[1].Confirmed)
+	require.NotEmpty(t, statuses[1].Error)
+	require.False(t, revocation.AllConfirmed(statuses))
+
+	require.Equal(t, revocations, received)
+
+	// without any edges there's nothing to confirm.
+	propagator = revocation.NewPropagator(zaptest.NewLogger(t), revocation.PropagationConfig{})
+	statuses = propagator.Propagate(ctx, revocations)
+	require.Empty(t, statuses)
+	require.True(t, revocation.AllConfirmed(statuses))
+}
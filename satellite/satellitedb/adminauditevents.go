@@ -0,0 +1,111 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/satellite/adminaudit"
+)
+
+var _ adminaudit.DB = (*adminAuditEvents)(nil)
+
+// adminAuditEvents implements adminaudit.DB.
+type adminAuditEvents struct {
+	db *satelliteDB
+}
+
+const adminAuditEventColumns = `id, admin_email, user_id, action, path, reason, created_at`
+
+// Insert inserts a new event.
+func (a *adminAuditEvents) Insert(ctx context.Context, event adminaudit.Event) (_ adminaudit.Event, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	row := a.db.QueryRowContext(ctx, `
+		INSERT INTO admin_audit_events (
+			id, admin_email, user_id, action, path, reason, created_at
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7
+		) RETURNING `+adminAuditEventColumns,
+		event.ID, event.AdminEmail, event.UserID, event.Action, event.Path, nullString(event.Reason), event.CreatedAt,
+	)
+
+	return scanAdminAuditEvent(row)
+}
+
+// Search returns at most opts.Limit events matching opts, ordered from the newest.
+func (a *adminAuditEvents) Search(ctx context.Context, opts adminaudit.SearchOptions) (events []adminaudit.Event, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	// only the used filters are added to the query, so it can use the index
+	// of the filtered column.
+	var conditions []string
+	var args []interface{}
+	addCondition := func(condition string, values ...interface{}) {
+		for _, value := range values {
+			args = append(args, value)
+			condition = strings.Replace(condition, "?", "$"+strconv.Itoa(len(args)), 1)
+		}
+		conditions = append(conditions, condition)
+	}
+
+	if opts.AdminEmail != "" {
+		addCondition("admin_email = ?", opts.AdminEmail)
+	}
+	if opts.UserID != nil {
+		addCondition("user_id = ?", *opts.UserID)
+	}
+	if opts.Action != "" {
+		addCondition("action = ?", opts.Action)
+	}
+	if !opts.Since.IsZero() {
+		addCondition("created_at >= ?", opts.Since)
+	}
+	if !opts.Before.IsZero() {
+		addCondition("created_at < ?", opts.Before)
+	}
+	if opts.Cursor != nil {
+		addCondition("(created_at, id) < (?, ?)", opts.Cursor.CreatedAt, opts.Cursor.ID)
+	}
+
+	query := `SELECT ` + adminAuditEventColumns + ` FROM admin_audit_events`
+	if len(conditions) > 0 {
+		query += ` WHERE ` + strings.Join(conditions, " AND ")
+	}
+	args = append(args, opts.Limit)
+	query += ` ORDER BY created_at DESC, id DESC LIMIT $` + strconv.Itoa(len(args))
+
+	rows, err := a.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	for rows.Next() {
+		event, err := scanAdminAuditEvent(rows)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+	return events, rows.Err()
+}
+
+// scanAdminAuditEvent scans an event selected with adminAuditEventColumns.
+func scanAdminAuditEvent(row interface{ Scan(dest ...any) error }) (adminaudit.Event, error) {
+	var event adminaudit.Event
+	var reason *string
+	err := row.Scan(&event.ID, &event.AdminEmail, &event.UserID, &event.Action, &event.Path, &reason, &event.CreatedAt)
+	if err != nil {
+		return adminaudit.Event{}, err
+	}
+	if reason != nil {
+		event.Reason = *reason
+	}
+	return event, nil
+}
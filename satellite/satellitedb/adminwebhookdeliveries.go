@@ -0,0 +1,111 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/satellite/adminwebhook"
+	"storj.io/storj/shared/tagsql"
+)
+
+var _ adminwebhook.DB = (*adminWebhookDeliveries)(nil)
+
+// adminWebhookDeliveries implements adminwebhook.DB.
+type adminWebhookDeliveries struct {
+	db *satelliteDB
+}
+
+const adminWebhookDeliveryColumns = `id, event_id, url, kind, payload, status, attempts,
+	last_error, next_attempt_at, created_at, finished_at`
+
+// Insert inserts a new delivery.
+func (a *adminWebhookDeliveries) Insert(ctx context.Context, delivery adminwebhook.Delivery) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = a.db.ExecContext(ctx, `
+		INSERT INTO admin_webhook_deliveries (
+			id, event_id, url, kind, payload, status, attempts,
+			last_error, next_attempt_at, created_at, finished_at
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, now(), $10
+		)`,
+		delivery.ID, delivery.EventID, delivery.URL, delivery.Kind, delivery.Payload,
+		delivery.Status, delivery.Attempts, nullString(delivery.LastError),
+		delivery.NextAttemptAt, delivery.FinishedAt,
+	)
+	return err
+}
+
+// Update updates the status, the attempts and the times of a delivery.
+func (a *adminWebhookDeliveries) Update(ctx context.Context, delivery adminwebhook.Delivery) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = a.db.ExecContext(ctx, `
+		UPDATE admin_webhook_deliveries
+		SET status = $2, attempts = $3, last_error = $4, next_attempt_at = $5, finished_at = $6
+		WHERE id = $1
+	`, delivery.ID, delivery.Status, delivery.Attempts, nullString(delivery.LastError),
+		delivery.NextAttemptAt, delivery.FinishedAt)
+	return err
+}
+
+// ListDue returns at most limit pending deliveries which are due at the specified time, ordered by their next attempt.
+func (a *adminWebhookDeliveries) ListDue(ctx context.Context, now time.Time, limit int) (_ []adminwebhook.Delivery, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := a.db.QueryContext(ctx, `
+		SELECT `+adminWebhookDeliveryColumns+`
+		FROM admin_webhook_deliveries
+		WHERE finished_at IS NULL AND next_attempt_at <= $1
+		ORDER BY next_attempt_at, id
+		LIMIT $2
+	`, now, limit)
+	if err != nil {
+		return nil, err
+	}
+	return scanAdminWebhookDeliveries(rows)
+}
+
+// ListByStatus returns at most limit deliveries with the specified status, ordered from the newest.
+func (a *adminWebhookDeliveries) ListByStatus(ctx context.Context, status adminwebhook.Status, limit int) (_ []adminwebhook.Delivery, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := a.db.QueryContext(ctx, `
+		SELECT `+adminWebhookDeliveryColumns+`
+		FROM admin_webhook_deliveries
+		WHERE status = $1
+		ORDER BY created_at DESC, id DESC
+		LIMIT $2
+	`, status, limit)
+	if err != nil {
+		return nil, err
+	}
+	return scanAdminWebhookDeliveries(rows)
+}
+
+func scanAdminWebhookDeliveries(rows tagsql.Rows) (deliveries []adminwebhook.Delivery, err error) {
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	for rows.Next() {
+		var delivery adminwebhook.Delivery
+		var lastError *string
+		err := rows.Scan(
+			&delivery.ID, &delivery.EventID, &delivery.URL, &delivery.Kind, &delivery.Payload,
+			&delivery.Status, &delivery.Attempts, &lastError,
+			&delivery.NextAttemptAt, &delivery.CreatedAt, &delivery.FinishedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		if lastError != nil {
+			delivery.LastError = *lastError
+		}
+		deliveries = append(deliveries, delivery)
+	}
+	return deliveries, rows.Err()
+}
@@ -0,0 +1,127 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/shared/tagsql"
+)
+
+// Ensure that billingContacts implements console.BillingContacts.
+var _ console.BillingContacts = (*billingContacts)(nil)
+
+// billingContacts is an implementation of console.BillingContacts.
+type billingContacts struct {
+	db *satelliteDB
+}
+
+// Insert adds a billing contact to a user.
+func (contacts *billingContacts) Insert(ctx context.Context, contact console.BillingContact) (_ *console.BillingContact, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = contacts.db.QueryRowContext(ctx, `
+		INSERT INTO billing_contacts (id, user_id, email, created_at)
+		VALUES ($1, $2, $3, now())
+		RETURNING created_at
+	`, contact.ID, contact.UserID, contact.Email).Scan(&contact.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &contact, nil
+}
+
+// Get returns the billing contact with the given ID.
+func (contacts *billingContacts) Get(ctx context.Context, id uuid.UUID) (_ *console.BillingContact, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	contact := console.BillingContact{ID: id}
+	err = contacts.db.QueryRowContext(ctx, `
+		SELECT user_id, email, created_at FROM billing_contacts WHERE id = $1
+	`, id).Scan(&contact.UserID, &contact.Email, &contact.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &contact, nil
+}
+
+// ListByUserID returns the billing contacts of a user ordered by email.
+func (contacts *billingContacts) ListByUserID(ctx context.Context, userID uuid.UUID) (_ []console.BillingContact, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := contacts.db.QueryContext(ctx, `
+		SELECT id, user_id, email, created_at
+		FROM billing_contacts
+		WHERE user_id = $1
+		ORDER BY email
+	`, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return scanBillingContacts(rows)
+}
+
+// ListByEmail returns the billing contacts of all users with the given email.
+func (contacts *billingContacts) ListByEmail(ctx context.Context, email string) (_ []console.BillingContact, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := contacts.db.QueryContext(ctx, `
+		SELECT id, user_id, email, created_at
+		FROM billing_contacts
+		WHERE email = $1
+		ORDER BY created_at
+	`, email)
+	if err != nil {
+		return nil, err
+	}
+
+	return scanBillingContacts(rows)
+}
+
+// UpdateEmail changes the email of a billing contact of a user.
+func (contacts *billingContacts) UpdateEmail(ctx context.Context, userID, id uuid.UUID, email string) (_ *console.BillingContact, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	contact := console.BillingContact{ID: id, UserID: userID, Email: email}
+	err = contacts.db.QueryRowContext(ctx, `
+		UPDATE billing_contacts SET email = $3
+		WHERE user_id = $1 AND id = $2
+		RETURNING created_at
+	`, userID, id, email).Scan(&contact.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &contact, nil
+}
+
+// Delete removes a billing contact of a user.
+func (contacts *billingContacts) Delete(ctx context.Context, userID, id uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = contacts.db.ExecContext(ctx, `DELETE FROM billing_contacts WHERE user_id = $1 AND id = $2`, userID, id)
+	return err
+}
+
+func scanBillingContacts(rows tagsql.Rows) (list []console.BillingContact, err error) {
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	for rows.Next() {
+		var contact console.BillingContact
+		err = rows.Scan(&contact.ID, &contact.UserID, &contact.Email, &contact.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, contact)
+	}
+
+	return list, rows.Err()
+}
@@ -6,7 +6,11 @@ package satellitedb
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
+	"time"
+
+	"github.com/zeebo/errs"
 
 	"storj.io/common/macaroon"
 	"storj.io/common/storj"
@@ -31,6 +35,9 @@ func (db *bucketsDB) CreateBucket(ctx context.Context, bucket buckets.Bucket) (_
 	if bucket.Versioning != buckets.VersioningUnsupported {
 		optionalFields.Versioning = dbx.BucketMetainfo_Versioning(int(bucket.Versioning))
 	}
+	if bucket.ObjectLockEnabled {
+		optionalFields.ObjectLockEnabled = dbx.BucketMetainfo_ObjectLockEnabled(true)
+	}
 	if !bucket.CreatedBy.IsZero() {
 		optionalFields.CreatedBy = dbx.BucketMetainfo_CreatedBy(bucket.CreatedBy[:])
 	}
@@ -252,7 +259,12 @@ func (db *bucketsDB) DeleteBucket(ctx context.Context, bucketName []byte, projec
 	if !deleted {
 		return buckets.ErrBucketNotFound.New("%s", bucketName)
 	}
-	return nil
+
+	// the configurations must not apply to a new bucket with the same name.
+	return errs.Combine(
+		db.DeleteBucketLifecycle(ctx, bucketName, projectID),
+		db.DeleteBucketInventory(ctx, bucketName, projectID),
+	)
 }
 
 // ListBuckets returns a list of buckets for a project.
@@ -379,7 +391,8 @@ func convertDBXtoBucket(dbxBucket *dbx.BucketMetainfo) (bucket buckets.Bucket, e
 			CipherSuite: storj.CipherSuite(dbxBucket.DefaultEncryptionCipherSuite),
 			BlockSize:   int32(dbxBucket.DefaultEncryptionBlockSize),
 		},
-		Versioning: buckets.Versioning(dbxBucket.Versioning),
+		Versioning:        buckets.Versioning(dbxBucket.Versioning),
+		ObjectLockEnabled: dbxBucket.ObjectLockEnabled,
 	}
 
 	if dbxBucket.Placement != nil {
@@ -431,3 +444,286 @@ func (db *bucketsDB) IterateBucketLocations(ctx context.Context, pageSize int, f
 
 	}
 }
+
+// GetBucketLifecycle returns the lifecycle configuration of a bucket, or nil when it has none.
+func (db *bucketsDB) GetBucketLifecycle(ctx context.Context, bucketName []byte, projectID uuid.UUID) (_ *buckets.LifecycleConfiguration, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var rules []byte
+	err = db.db.QueryRowContext(ctx, `
+		SELECT rules FROM bucket_lifecycle_configurations
+		WHERE project_id = $1 AND bucket_name = $2
+	`, projectID, bucketName).Scan(&rules)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, buckets.ErrBucket.Wrap(err)
+	}
+
+	var config buckets.LifecycleConfiguration
+	if err := json.Unmarshal(rules, &config); err != nil {
+		return nil, buckets.ErrBucket.Wrap(err)
+	}
+	return &config, nil
+}
+
+// SetBucketLifecycle replaces the lifecycle configuration of an existing bucket.
+func (db *bucketsDB) SetBucketLifecycle(ctx context.Context, bucketName []byte, projectID uuid.UUID, config buckets.LifecycleConfiguration) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := config.Validate(); err != nil {
+		return err
+	}
+
+	rules, err := json.Marshal(config)
+	if err != nil {
+		return buckets.ErrBucket.Wrap(err)
+	}
+
+	result, err := db.db.ExecContext(ctx, `
+		INSERT INTO bucket_lifecycle_configurations (
+			project_id, bucket_name, rules, created_at, updated_at
+		)
+		SELECT project_id, name, $3, now(), now()
+		FROM bucket_metainfos
+		WHERE project_id = $1 AND name = $2
+		ON CONFLICT (project_id, bucket_name) DO UPDATE SET
+			rules      = EXCLUDED.rules,
+			updated_at = EXCLUDED.updated_at
+	`, projectID, bucketName, rules)
+	if err != nil {
+		return buckets.ErrBucket.Wrap(err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return buckets.ErrBucket.Wrap(err)
+	}
+	if affected == 0 {
+		return buckets.ErrBucketNotFound.New("%s", bucketName)
+	}
+	return nil
+}
+
+// DeleteBucketLifecycle removes the lifecycle configuration of a bucket.
+func (db *bucketsDB) DeleteBucketLifecycle(ctx context.Context, bucketName []byte, projectID uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = db.db.ExecContext(ctx, `
+		DELETE FROM bucket_lifecycle_configurations
+		WHERE project_id = $1 AND bucket_name = $2
+	`, projectID, bucketName)
+	return buckets.ErrBucket.Wrap(err)
+}
+
+// IterateBucketLifecycles iterates through all buckets which have a lifecycle configuration.
+func (db *bucketsDB) IterateBucketLifecycles(ctx context.Context, pageSize int, fn func([]buckets.BucketLifecycle) error) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var lastProjectID, lastBucketName []byte = []byte{}, []byte{}
+	for {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		page, err := db.bucketLifecyclesPage(ctx, lastProjectID, lastBucketName, pageSize)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		if len(page) == 0 {
+			return nil
+		}
+
+		if err := fn(page); err != nil {
+			return Error.Wrap(err)
+		}
+
+		last := page[len(page)-1].Bucket
+		lastProjectID, lastBucketName = last.ProjectID.Bytes(), []byte(last.BucketName)
+	}
+}
+
+func (db *bucketsDB) bucketLifecyclesPage(ctx context.Context, afterProjectID, afterBucketName []byte, limit int) (page []buckets.BucketLifecycle, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := db.db.QueryContext(ctx, `
+		SELECT project_id, bucket_name, rules
+		FROM bucket_lifecycle_configurations
+		WHERE (project_id, bucket_name) > ($1, $2)
+		ORDER BY project_id, bucket_name
+		LIMIT $3
+	`, afterProjectID, afterBucketName, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	for rows.Next() {
+		var projectID uuid.UUID
+		var bucketName, rules []byte
+		if err := rows.Scan(&projectID, &bucketName, &rules); err != nil {
+			return nil, err
+		}
+
+		lifecycle := buckets.BucketLifecycle{
+			Bucket: metabase.BucketLocation{ProjectID: projectID, BucketName: string(bucketName)},
+		}
+		if err := json.Unmarshal(rules, &lifecycle.Configuration); err != nil {
+			return nil, err
+		}
+		page = append(page, lifecycle)
+	}
+	return page, rows.Err()
+}
+
+// GetBucketInventory returns the inventory configuration of a bucket, or nil when it has none.
+func (db *bucketsDB) GetBucketInventory(ctx context.Context, bucketName []byte, projectID uuid.UUID) (_ *buckets.InventoryConfiguration, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var data []byte
+	err = db.db.QueryRowContext(ctx, `
+		SELECT configuration FROM bucket_inventory_configurations
+		WHERE project_id = $1 AND bucket_name = $2
+	`, projectID, bucketName).Scan(&data)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, buckets.ErrBucket.Wrap(err)
+	}
+
+	var config buckets.InventoryConfiguration
+	if err := json.Unmarshal(data, &config); err != nil {
+		return nil, buckets.ErrBucket.Wrap(err)
+	}
+	return &config, nil
+}
+
+// SetBucketInventory replaces the inventory configuration of an existing bucket.
+func (db *bucketsDB) SetBucketInventory(ctx context.Context, bucketName []byte, projectID uuid.UUID, config buckets.InventoryConfiguration) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := config.Validate(); err != nil {
+		return err
+	}
+	if err := config.ValidateCredentials(); err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(config)
+	if err != nil {
+		return buckets.ErrBucket.Wrap(err)
+	}
+
+	// the time of the last report is kept, so changing the destination
+	// doesn't immediately trigger another report.
+	result, err := db.db.ExecContext(ctx, `
+		INSERT INTO bucket_inventory_configurations (
+			project_id, bucket_name, configuration, created_at, updated_at
+		)
+		SELECT project_id, name, $3, now(), now()
+		FROM bucket_metainfos
+		WHERE project_id = $1 AND name = $2
+		ON CONFLICT (project_id, bucket_name) DO UPDATE SET
+			configuration = EXCLUDED.configuration,
+			updated_at    = EXCLUDED.updated_at
+	`, projectID, bucketName, data)
+	if err != nil {
+		return buckets.ErrBucket.Wrap(err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return buckets.ErrBucket.Wrap(err)
+	}
+	if affected == 0 {
+		return buckets.ErrBucketNotFound.New("%s", bucketName)
+	}
+	return nil
+}
+
+// DeleteBucketInventory removes the inventory configuration of a bucket.
+func (db *bucketsDB) DeleteBucketInventory(ctx context.Context, bucketName []byte, projectID uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = db.db.ExecContext(ctx, `
+		DELETE FROM bucket_inventory_configurations
+		WHERE project_id = $1 AND bucket_name = $2
+	`, projectID, bucketName)
+	return buckets.ErrBucket.Wrap(err)
+}
+
+// SetBucketInventoryLastRun records when the last inventory report of a bucket was written.
+func (db *bucketsDB) SetBucketInventoryLastRun(ctx context.Context, bucketName []byte, projectID uuid.UUID, lastRunAt time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = db.db.ExecContext(ctx, `
+		UPDATE bucket_inventory_configurations
+		SET last_run_at = $3
+		WHERE project_id = $1 AND bucket_name = $2
+	`, projectID, bucketName, lastRunAt)
+	return buckets.ErrBucket.Wrap(err)
+}
+
+// IterateBucketInventories iterates through all buckets which have an inventory configuration.
+func (db *bucketsDB) IterateBucketInventories(ctx context.Context, pageSize int, fn func([]buckets.BucketInventory) error) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var lastProjectID, lastBucketName []byte = []byte{}, []byte{}
+	for {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		page, err := db.bucketInventoriesPage(ctx, lastProjectID, lastBucketName, pageSize)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		if len(page) == 0 {
+			return nil
+		}
+
+		if err := fn(page); err != nil {
+			return Error.Wrap(err)
+		}
+
+		last := page[len(page)-1].Bucket
+		lastProjectID, lastBucketName = last.ProjectID.Bytes(), []byte(last.BucketName)
+	}
+}
+
+func (db *bucketsDB) bucketInventoriesPage(ctx context.Context, afterProjectID, afterBucketName []byte, limit int) (page []buckets.BucketInventory, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := db.db.QueryContext(ctx, `
+		SELECT project_id, bucket_name, configuration, last_run_at
+		FROM bucket_inventory_configurations
+		WHERE (project_id, bucket_name) > ($1, $2)
+		ORDER BY project_id, bucket_name
+		LIMIT $3
+	`, afterProjectID, afterBucketName, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	for rows.Next() {
+		var projectID uuid.UUID
+		var bucketName, data []byte
+		var lastRunAt *time.Time
+		if err := rows.Scan(&projectID, &bucketName, &data, &lastRunAt); err != nil {
+			return nil, err
+		}
+
+		inventory := buckets.BucketInventory{
+			Bucket:    metabase.BucketLocation{ProjectID: projectID, BucketName: string(bucketName)},
+			LastRunAt: lastRunAt,
+		}
+		if err := json.Unmarshal(data, &inventory.Configuration); err != nil {
+			return nil, err
+		}
+		page = append(page, inventory)
+	}
+	return page, rows.Err()
+}
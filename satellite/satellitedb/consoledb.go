@@ -86,6 +86,31 @@ func (db *ConsoleDB) AccountFreezeEvents() console.AccountFreezeEvents {
 	return &accountFreezeEvents{db.db}
 }
 
+// LinksharingBrandings is a getter for LinksharingBrandings repository.
+func (db *ConsoleDB) LinksharingBrandings() console.LinksharingBrandings {
+	return &linksharingBrandings{db.db}
+}
+
+// ProjectInvitationPolicies is a getter for ProjectInvitationPolicies repository.
+func (db *ConsoleDB) ProjectInvitationPolicies() console.ProjectInvitationPolicies {
+	return &projectInvitationPolicies{db.db}
+}
+
+// TrustedDevices is a getter for TrustedDevices repository.
+func (db *ConsoleDB) TrustedDevices() console.TrustedDevices {
+	return &trustedDevices{db.db}
+}
+
+// BillingContacts is a getter for BillingContacts repository.
+func (db *ConsoleDB) BillingContacts() console.BillingContacts {
+	return &billingContacts{db.db}
+}
+
+// SCIMUsers is a getter for SCIMUsers repository.
+func (db *ConsoleDB) SCIMUsers() console.SCIMUsers {
+	return &scimUsers{db.db}
+}
+
 // WithTx is a method for executing and retrying transaction.
 func (db *ConsoleDB) WithTx(ctx context.Context, fn func(context.Context, console.DBTx) error) error {
 	if db.db == nil {
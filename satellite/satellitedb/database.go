@@ -14,11 +14,18 @@ import (
 	"storj.io/storj/private/migrate"
 	"storj.io/storj/satellite"
 	"storj.io/storj/satellite/accounting"
+	"storj.io/storj/satellite/adminaudit"
+	"storj.io/storj/satellite/adminwebhook"
 	"storj.io/storj/satellite/attribution"
 	"storj.io/storj/satellite/audit"
 	"storj.io/storj/satellite/buckets"
 	"storj.io/storj/satellite/compensation"
 	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/escrow"
+	"storj.io/storj/satellite/integrity"
+	"storj.io/storj/satellite/limitschedule"
+	"storj.io/storj/satellite/maintenance"
+	"storj.io/storj/satellite/metabase/zombiedeletion"
 	"storj.io/storj/satellite/nodeapiversion"
 	"storj.io/storj/satellite/nodeevents"
 	"storj.io/storj/satellite/oidc"
@@ -27,6 +34,7 @@ import (
 	"storj.io/storj/satellite/payments/billing"
 	"storj.io/storj/satellite/payments/storjscan"
 	"storj.io/storj/satellite/payments/stripe"
+	"storj.io/storj/satellite/projectdeletion"
 	"storj.io/storj/satellite/repair/queue"
 	"storj.io/storj/satellite/reputation"
 	"storj.io/storj/satellite/revocation"
@@ -187,6 +195,36 @@ func (dbc *satelliteDBCollection) NodeEvents() nodeevents.DB {
 	return &nodeEvents{db: dbc.getByName("nodeevents")}
 }
 
+// MaintenanceWindows is a getter for maintenance windows repository.
+func (dbc *satelliteDBCollection) MaintenanceWindows() maintenance.DB {
+	return &maintenanceWindows{db: dbc.getByName("maintenancewindows")}
+}
+
+// ProjectLimitChanges is a getter for scheduled project limit changes repository.
+func (dbc *satelliteDBCollection) ProjectLimitChanges() limitschedule.DB {
+	return &projectLimitChanges{db: dbc.getByName("projectlimitchanges")}
+}
+
+// ProjectDataPurges is a getter for project data purges repository.
+func (dbc *satelliteDBCollection) ProjectDataPurges() projectdeletion.DB {
+	return &projectDataPurges{db: dbc.getByName("projectdatapurges")}
+}
+
+// AdminAuditEvents is a getter for admin audit events repository.
+func (dbc *satelliteDBCollection) AdminAuditEvents() adminaudit.DB {
+	return &adminAuditEvents{db: dbc.getByName("adminauditevents")}
+}
+
+// AdminWebhookDeliveries is a getter for admin webhook deliveries repository.
+func (dbc *satelliteDBCollection) AdminWebhookDeliveries() adminwebhook.DB {
+	return &adminWebhookDeliveries{db: dbc.getByName("adminwebhookdeliveries")}
+}
+
+// PassphraseEscrows is a getter for project passphrase escrow repository.
+func (dbc *satelliteDBCollection) PassphraseEscrows() escrow.DB {
+	return &passphraseEscrows{db: dbc.getByName("passphraseescrows")}
+}
+
 // Reputation is a getter for overlay cache repository.
 func (dbc *satelliteDBCollection) Reputation() reputation.DB {
 	return &reputations{db: dbc.getByName("reputations")}
@@ -202,6 +240,16 @@ func (dbc *satelliteDBCollection) VerifyQueue() audit.VerifyQueue {
 	return &verifyQueue{db: dbc.getByName("verifyqueue")}
 }
 
+// SegmentIntegrityQueue is a getter for SegmentIntegrityQueue database.
+func (dbc *satelliteDBCollection) SegmentIntegrityQueue() integrity.Queue {
+	return &integrityQueue{db: dbc.getByName("integrityqueue")}
+}
+
+// PendingObjectGracePeriods is a getter for PendingObjectGracePeriods database.
+func (dbc *satelliteDBCollection) PendingObjectGracePeriods() zombiedeletion.GracePeriods {
+	return &pendingObjectGracePeriods{db: dbc.getByName("pendingobjectgraceperiods")}
+}
+
 // ReverifyQueue is a getter for ReverifyQueue database.
 func (dbc *satelliteDBCollection) ReverifyQueue() audit.ReverifyQueue {
 	return &reverifyQueue{db: dbc.getByName("reverifyqueue")}
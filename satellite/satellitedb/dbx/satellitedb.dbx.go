@@ -344,6 +344,41 @@ func (obj *pgxDB) Schema() []string {
 	PRIMARY KEY ( name )
 )`,
 
+		`CREATE TABLE admin_audit_events (
+	id bytea NOT NULL,
+	admin_email text NOT NULL,
+	user_id bytea,
+	action text NOT NULL,
+	path text NOT NULL,
+	reason text,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id )
+)`,
+
+		`CREATE TABLE admin_webhook_deliveries (
+	id bytea NOT NULL,
+	event_id bytea NOT NULL,
+	url text NOT NULL,
+	kind text NOT NULL,
+	payload bytea NOT NULL,
+	status text NOT NULL,
+	attempts integer NOT NULL,
+	last_error text,
+	next_attempt_at timestamp with time zone NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	finished_at timestamp with time zone,
+	PRIMARY KEY ( id )
+)`,
+
+		`CREATE TABLE api_key_bandwidth_rollups (
+	project_id bytea NOT NULL,
+	api_key_id bytea NOT NULL,
+	interval_start timestamp with time zone NOT NULL,
+	inline bigint NOT NULL,
+	settled bigint NOT NULL,
+	PRIMARY KEY ( project_id, api_key_id, interval_start )
+)`,
+
 		`CREATE TABLE billing_balances (
 	user_id bytea NOT NULL,
 	balance bigint NOT NULL,
@@ -351,6 +386,15 @@ func (obj *pgxDB) Schema() []string {
 	PRIMARY KEY ( user_id )
 )`,
 
+		`CREATE TABLE billing_contacts (
+	id bytea NOT NULL,
+	user_id bytea NOT NULL,
+	email text NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id ),
+	UNIQUE ( user_id, email )
+)`,
+
 		`CREATE TABLE billing_transactions (
 	id bigserial NOT NULL,
 	user_id bytea NOT NULL,
@@ -390,6 +434,25 @@ func (obj *pgxDB) Schema() []string {
 	PRIMARY KEY ( bucket_name, project_id, interval_start, action )
 )`,
 
+		`CREATE TABLE bucket_inventory_configurations (
+	project_id bytea NOT NULL,
+	bucket_name bytea NOT NULL,
+	configuration bytea NOT NULL,
+	last_run_at timestamp with time zone,
+	created_at timestamp with time zone NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id, bucket_name )
+)`,
+
+		`CREATE TABLE bucket_lifecycle_configurations (
+	project_id bytea NOT NULL,
+	bucket_name bytea NOT NULL,
+	rules bytea NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id, bucket_name )
+)`,
+
 		`CREATE TABLE bucket_storage_tallies (
 	bucket_name bytea NOT NULL,
 	project_id bytea NOT NULL,
@@ -444,6 +507,29 @@ func (obj *pgxDB) Schema() []string {
 	PRIMARY KEY ( node_id, stream_id, position, piece_num )
 )`,
 
+		`CREATE TABLE linksharing_brandings (
+	project_id bytea NOT NULL,
+	logo_url text NOT NULL,
+	primary_color text NOT NULL,
+	footer text NOT NULL,
+	download_disclaimer text NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id )
+)`,
+
+		`CREATE TABLE maintenance_windows (
+	id bytea NOT NULL,
+	starts_at timestamp with time zone NOT NULL,
+	ends_at timestamp with time zone NOT NULL,
+	components integer NOT NULL,
+	message text NOT NULL,
+	created_by text NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id )
+)`,
+
 		`CREATE TABLE nodes (
 	id bytea NOT NULL,
 	address text NOT NULL DEFAULT '',
@@ -551,6 +637,23 @@ func (obj *pgxDB) Schema() []string {
 	PRIMARY KEY ( token )
 )`,
 
+		`CREATE TABLE passphrase_recoveries (
+	id bytea NOT NULL,
+	project_id bytea NOT NULL,
+	requested_by text NOT NULL,
+	reason text NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	recovered_at timestamp with time zone,
+	PRIMARY KEY ( id )
+)`,
+
+		`CREATE TABLE passphrase_recovery_approvals (
+	recovery_id bytea NOT NULL,
+	approved_by text NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( recovery_id, approved_by )
+)`,
+
 		`CREATE TABLE peer_identities (
 	node_id bytea NOT NULL,
 	leaf_serial_number bytea NOT NULL,
@@ -559,6 +662,14 @@ func (obj *pgxDB) Schema() []string {
 	PRIMARY KEY ( node_id )
 )`,
 
+		`CREATE TABLE pending_object_grace_periods (
+	project_id bytea NOT NULL,
+	bucket_name bytea NOT NULL,
+	grace_period bigint NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id, bucket_name )
+)`,
+
 		`CREATE TABLE projects (
 	id bytea NOT NULL,
 	public_id bytea,
@@ -604,6 +715,56 @@ func (obj *pgxDB) Schema() []string {
 	PRIMARY KEY ( project_id, interval_day )
 )`,
 
+		`CREATE TABLE project_data_purges (
+	project_id bytea NOT NULL,
+	status text NOT NULL,
+	bucket_name bytea,
+	continuation_token bytea,
+	attempts integer NOT NULL,
+	deleted_objects bigint NOT NULL,
+	deleted_buckets integer NOT NULL,
+	error text,
+	created_by text NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	finished_at timestamp with time zone,
+	PRIMARY KEY ( project_id )
+)`,
+
+		`CREATE TABLE project_invitation_policies (
+	project_id bytea NOT NULL,
+	allowed_email_domains text NOT NULL,
+	max_pending_invitations integer NOT NULL,
+	default_role integer NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id )
+)`,
+
+		`CREATE TABLE project_limit_changes (
+	id bytea NOT NULL,
+	project_id bytea NOT NULL,
+	effective_at timestamp with time zone NOT NULL,
+	usage_limit bigint,
+	bandwidth_limit bigint,
+	segment_limit bigint,
+	rate_limit integer,
+	burst_limit integer,
+	max_buckets integer,
+	created_by text NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	applied_at timestamp with time zone,
+	PRIMARY KEY ( id )
+)`,
+
+		`CREATE TABLE project_passphrase_escrows (
+	project_id bytea NOT NULL,
+	envelope bytea NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id )
+)`,
+
 		`CREATE TABLE registration_tokens (
 	secret bytea NOT NULL,
 	owner_id bytea,
@@ -670,6 +831,30 @@ func (obj *pgxDB) Schema() []string {
 	PRIMARY KEY ( revoked )
 )`,
 
+		`CREATE TABLE scim_users (
+	user_id bytea NOT NULL,
+	provider text NOT NULL,
+	external_id text,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( user_id ),
+	UNIQUE ( provider, external_id )
+)`,
+
+		`CREATE TABLE segment_integrity_queue (
+	stream_id bytea NOT NULL,
+	kind text NOT NULL,
+	project_id bytea,
+	bucket_name bytea,
+	object_key bytea,
+	version bigint,
+	expected_segments integer NOT NULL,
+	actual_segments integer NOT NULL,
+	expected_size bigint NOT NULL,
+	actual_size bigint NOT NULL,
+	detected_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( stream_id, kind )
+)`,
+
 		`CREATE TABLE segment_pending_audits (
 	node_id bytea NOT NULL,
 	stream_id bytea NOT NULL,
@@ -812,6 +997,18 @@ func (obj *pgxDB) Schema() []string {
 	PRIMARY KEY ( tx_id )
 )`,
 
+		`CREATE TABLE trusted_devices (
+	id bytea NOT NULL,
+	user_id bytea NOT NULL,
+	fingerprint bytea NOT NULL,
+	user_agent text NOT NULL,
+	ip_address text NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	last_used_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id ),
+	UNIQUE ( user_id, fingerprint )
+)`,
+
 		`CREATE TABLE users (
 	id bytea NOT NULL,
 	email text NOT NULL,
@@ -863,6 +1060,7 @@ func (obj *pgxDB) Schema() []string {
 	onboarding_end boolean NOT NULL DEFAULT true,
 	onboarding_step text,
 	notice_dismissal jsonb NOT NULL DEFAULT '{}',
+	login_alerts boolean NOT NULL DEFAULT false,
 	PRIMARY KEY ( user_id )
 )`,
 
@@ -956,6 +1154,18 @@ func (obj *pgxDB) Schema() []string {
 
 		`CREATE INDEX accounting_rollups_start_time_index ON accounting_rollups ( start_time )`,
 
+		`CREATE INDEX admin_audit_events_admin_email_created_at_index ON admin_audit_events ( admin_email, created_at )`,
+
+		`CREATE INDEX admin_audit_events_user_id_created_at_index ON admin_audit_events ( user_id, created_at )`,
+
+		`CREATE INDEX admin_audit_events_action_created_at_index ON admin_audit_events ( action, created_at )`,
+
+		`CREATE INDEX admin_audit_events_created_at_index ON admin_audit_events ( created_at )`,
+
+		`CREATE INDEX admin_webhook_deliveries_pending_index ON admin_webhook_deliveries ( next_attempt_at ) WHERE admin_webhook_deliveries.finished_at is NULL`,
+
+		`CREATE INDEX admin_webhook_deliveries_status_created_at_index ON admin_webhook_deliveries ( status, created_at )`,
+
 		`CREATE INDEX billing_transactions_timestamp_index ON billing_transactions ( timestamp )`,
 
 		`CREATE INDEX bucket_bandwidth_rollups_project_id_action_interval_index ON bucket_bandwidth_rollups ( project_id, action, interval_start )`,
@@ -972,6 +1182,8 @@ func (obj *pgxDB) Schema() []string {
 
 		`CREATE INDEX graceful_exit_segment_transfer_nid_dr_qa_fa_lfa_index ON graceful_exit_segment_transfer_queue ( node_id, durability_ratio, queued_at, finished_at, last_failed_at )`,
 
+		`CREATE INDEX maintenance_windows_ends_at_index ON maintenance_windows ( ends_at )`,
+
 		`CREATE INDEX node_last_ip ON nodes ( last_net )`,
 
 		`CREATE INDEX nodes_dis_unk_off_exit_fin_last_success_index ON nodes ( disqualified, unknown_audit_suspended, offline_suspended, exit_finished_at, last_contact_success )`,
@@ -992,12 +1204,20 @@ func (obj *pgxDB) Schema() []string {
 
 		`CREATE INDEX oauth_tokens_client_id_index ON oauth_tokens ( client_id )`,
 
+		`CREATE INDEX passphrase_recoveries_project_id_index ON passphrase_recoveries ( project_id )`,
+
 		`CREATE INDEX projects_public_id_index ON projects ( public_id )`,
 
 		`CREATE INDEX projects_owner_id_index ON projects ( owner_id )`,
 
 		`CREATE INDEX project_bandwidth_daily_rollup_interval_day_index ON project_bandwidth_daily_rollups ( interval_day )`,
 
+		`CREATE INDEX project_data_purges_running_index ON project_data_purges ( updated_at ) WHERE project_data_purges.finished_at is NULL`,
+
+		`CREATE INDEX project_limit_changes_project_id_index ON project_limit_changes ( project_id )`,
+
+		`CREATE INDEX project_limit_changes_pending_index ON project_limit_changes ( effective_at ) WHERE project_limit_changes.applied_at is NULL`,
+
 		`CREATE INDEX repair_queue_updated_at_index ON repair_queue ( updated_at )`,
 
 		`CREATE INDEX repair_queue_num_healthy_pieces_attempted_at_index ON repair_queue ( segment_health, attempted_at )`,
@@ -1006,6 +1226,8 @@ func (obj *pgxDB) Schema() []string {
 
 		`CREATE INDEX reverification_audits_inserted_at_index ON reverification_audits ( inserted_at )`,
 
+		`CREATE INDEX segment_integrity_queue_kind_detected_at_index ON segment_integrity_queue ( kind, detected_at )`,
+
 		`CREATE INDEX storagenode_bandwidth_rollups_interval_start_index ON storagenode_bandwidth_rollups ( interval_start )`,
 
 		`CREATE INDEX storagenode_bandwidth_rollup_archives_interval_start_index ON storagenode_bandwidth_rollup_archives ( interval_start )`,
@@ -1059,6 +1281,8 @@ func (obj *pgxDB) DropSchema() []string {
 
 		`DROP TABLE IF EXISTS users`,
 
+		`DROP TABLE IF EXISTS trusted_devices`,
+
 		`DROP TABLE IF EXISTS stripecoinpayments_tx_conversion_rates`,
 
 		`DROP TABLE IF EXISTS stripecoinpayments_invoice_project_records`,
@@ -1083,6 +1307,10 @@ func (obj *pgxDB) DropSchema() []string {
 
 		`DROP TABLE IF EXISTS segment_pending_audits`,
 
+		`DROP TABLE IF EXISTS segment_integrity_queue`,
+
+		`DROP TABLE IF EXISTS scim_users`,
+
 		`DROP TABLE IF EXISTS revocations`,
 
 		`DROP TABLE IF EXISTS reverification_audits`,
@@ -1095,12 +1323,26 @@ func (obj *pgxDB) DropSchema() []string {
 
 		`DROP TABLE IF EXISTS registration_tokens`,
 
+		`DROP TABLE IF EXISTS project_passphrase_escrows`,
+
+		`DROP TABLE IF EXISTS project_limit_changes`,
+
+		`DROP TABLE IF EXISTS project_invitation_policies`,
+
+		`DROP TABLE IF EXISTS project_data_purges`,
+
 		`DROP TABLE IF EXISTS project_bandwidth_daily_rollups`,
 
 		`DROP TABLE IF EXISTS projects`,
 
+		`DROP TABLE IF EXISTS pending_object_grace_periods`,
+
 		`DROP TABLE IF EXISTS peer_identities`,
 
+		`DROP TABLE IF EXISTS passphrase_recovery_approvals`,
+
+		`DROP TABLE IF EXISTS passphrase_recoveries`,
+
 		`DROP TABLE IF EXISTS oauth_tokens`,
 
 		`DROP TABLE IF EXISTS oauth_codes`,
@@ -1115,6 +1357,10 @@ func (obj *pgxDB) DropSchema() []string {
 
 		`DROP TABLE IF EXISTS nodes`,
 
+		`DROP TABLE IF EXISTS maintenance_windows`,
+
+		`DROP TABLE IF EXISTS linksharing_brandings`,
+
 		`DROP TABLE IF EXISTS graceful_exit_segment_transfer_queue`,
 
 		`DROP TABLE IF EXISTS graceful_exit_progress`,
@@ -1123,14 +1369,26 @@ func (obj *pgxDB) DropSchema() []string {
 
 		`DROP TABLE IF EXISTS bucket_storage_tallies`,
 
+		`DROP TABLE IF EXISTS bucket_lifecycle_configurations`,
+
+		`DROP TABLE IF EXISTS bucket_inventory_configurations`,
+
 		`DROP TABLE IF EXISTS bucket_bandwidth_rollup_archives`,
 
 		`DROP TABLE IF EXISTS bucket_bandwidth_rollups`,
 
 		`DROP TABLE IF EXISTS billing_transactions`,
 
+		`DROP TABLE IF EXISTS billing_contacts`,
+
 		`DROP TABLE IF EXISTS billing_balances`,
 
+		`DROP TABLE IF EXISTS api_key_bandwidth_rollups`,
+
+		`DROP TABLE IF EXISTS admin_webhook_deliveries`,
+
+		`DROP TABLE IF EXISTS admin_audit_events`,
+
 		`DROP TABLE IF EXISTS accounting_timestamps`,
 
 		`DROP TABLE IF EXISTS accounting_rollups`,
@@ -1267,6 +1525,41 @@ func (obj *pgxcockroachDB) Schema() []string {
 	PRIMARY KEY ( name )
 )`,
 
+		`CREATE TABLE admin_audit_events (
+	id bytea NOT NULL,
+	admin_email text NOT NULL,
+	user_id bytea,
+	action text NOT NULL,
+	path text NOT NULL,
+	reason text,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id )
+)`,
+
+		`CREATE TABLE admin_webhook_deliveries (
+	id bytea NOT NULL,
+	event_id bytea NOT NULL,
+	url text NOT NULL,
+	kind text NOT NULL,
+	payload bytea NOT NULL,
+	status text NOT NULL,
+	attempts integer NOT NULL,
+	last_error text,
+	next_attempt_at timestamp with time zone NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	finished_at timestamp with time zone,
+	PRIMARY KEY ( id )
+)`,
+
+		`CREATE TABLE api_key_bandwidth_rollups (
+	project_id bytea NOT NULL,
+	api_key_id bytea NOT NULL,
+	interval_start timestamp with time zone NOT NULL,
+	inline bigint NOT NULL,
+	settled bigint NOT NULL,
+	PRIMARY KEY ( project_id, api_key_id, interval_start )
+)`,
+
 		`CREATE TABLE billing_balances (
 	user_id bytea NOT NULL,
 	balance bigint NOT NULL,
@@ -1274,6 +1567,15 @@ func (obj *pgxcockroachDB) Schema() []string {
 	PRIMARY KEY ( user_id )
 )`,
 
+		`CREATE TABLE billing_contacts (
+	id bytea NOT NULL,
+	user_id bytea NOT NULL,
+	email text NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id ),
+	UNIQUE ( user_id, email )
+)`,
+
 		`CREATE TABLE billing_transactions (
 	id bigserial NOT NULL,
 	user_id bytea NOT NULL,
@@ -1313,6 +1615,25 @@ func (obj *pgxcockroachDB) Schema() []string {
 	PRIMARY KEY ( bucket_name, project_id, interval_start, action )
 )`,
 
+		`CREATE TABLE bucket_inventory_configurations (
+	project_id bytea NOT NULL,
+	bucket_name bytea NOT NULL,
+	configuration bytea NOT NULL,
+	last_run_at timestamp with time zone,
+	created_at timestamp with time zone NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id, bucket_name )
+)`,
+
+		`CREATE TABLE bucket_lifecycle_configurations (
+	project_id bytea NOT NULL,
+	bucket_name bytea NOT NULL,
+	rules bytea NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id, bucket_name )
+)`,
+
 		`CREATE TABLE bucket_storage_tallies (
 	bucket_name bytea NOT NULL,
 	project_id bytea NOT NULL,
@@ -1367,6 +1688,29 @@ func (obj *pgxcockroachDB) Schema() []string {
 	PRIMARY KEY ( node_id, stream_id, position, piece_num )
 )`,
 
+		`CREATE TABLE linksharing_brandings (
+	project_id bytea NOT NULL,
+	logo_url text NOT NULL,
+	primary_color text NOT NULL,
+	footer text NOT NULL,
+	download_disclaimer text NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id )
+)`,
+
+		`CREATE TABLE maintenance_windows (
+	id bytea NOT NULL,
+	starts_at timestamp with time zone NOT NULL,
+	ends_at timestamp with time zone NOT NULL,
+	components integer NOT NULL,
+	message text NOT NULL,
+	created_by text NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id )
+)`,
+
 		`CREATE TABLE nodes (
 	id bytea NOT NULL,
 	address text NOT NULL DEFAULT '',
@@ -1474,6 +1818,23 @@ func (obj *pgxcockroachDB) Schema() []string {
 	PRIMARY KEY ( token )
 )`,
 
+		`CREATE TABLE passphrase_recoveries (
+	id bytea NOT NULL,
+	project_id bytea NOT NULL,
+	requested_by text NOT NULL,
+	reason text NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	recovered_at timestamp with time zone,
+	PRIMARY KEY ( id )
+)`,
+
+		`CREATE TABLE passphrase_recovery_approvals (
+	recovery_id bytea NOT NULL,
+	approved_by text NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( recovery_id, approved_by )
+)`,
+
 		`CREATE TABLE peer_identities (
 	node_id bytea NOT NULL,
 	leaf_serial_number bytea NOT NULL,
@@ -1482,6 +1843,14 @@ func (obj *pgxcockroachDB) Schema() []string {
 	PRIMARY KEY ( node_id )
 )`,
 
+		`CREATE TABLE pending_object_grace_periods (
+	project_id bytea NOT NULL,
+	bucket_name bytea NOT NULL,
+	grace_period bigint NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id, bucket_name )
+)`,
+
 		`CREATE TABLE projects (
 	id bytea NOT NULL,
 	public_id bytea,
@@ -1527,6 +1896,56 @@ func (obj *pgxcockroachDB) Schema() []string {
 	PRIMARY KEY ( project_id, interval_day )
 )`,
 
+		`CREATE TABLE project_data_purges (
+	project_id bytea NOT NULL,
+	status text NOT NULL,
+	bucket_name bytea,
+	continuation_token bytea,
+	attempts integer NOT NULL,
+	deleted_objects bigint NOT NULL,
+	deleted_buckets integer NOT NULL,
+	error text,
+	created_by text NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	finished_at timestamp with time zone,
+	PRIMARY KEY ( project_id )
+)`,
+
+		`CREATE TABLE project_invitation_policies (
+	project_id bytea NOT NULL,
+	allowed_email_domains text NOT NULL,
+	max_pending_invitations integer NOT NULL,
+	default_role integer NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id )
+)`,
+
+		`CREATE TABLE project_limit_changes (
+	id bytea NOT NULL,
+	project_id bytea NOT NULL,
+	effective_at timestamp with time zone NOT NULL,
+	usage_limit bigint,
+	bandwidth_limit bigint,
+	segment_limit bigint,
+	rate_limit integer,
+	burst_limit integer,
+	max_buckets integer,
+	created_by text NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	applied_at timestamp with time zone,
+	PRIMARY KEY ( id )
+)`,
+
+		`CREATE TABLE project_passphrase_escrows (
+	project_id bytea NOT NULL,
+	envelope bytea NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id )
+)`,
+
 		`CREATE TABLE registration_tokens (
 	secret bytea NOT NULL,
 	owner_id bytea,
@@ -1593,6 +2012,30 @@ func (obj *pgxcockroachDB) Schema() []string {
 	PRIMARY KEY ( revoked )
 )`,
 
+		`CREATE TABLE scim_users (
+	user_id bytea NOT NULL,
+	provider text NOT NULL,
+	external_id text,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( user_id ),
+	UNIQUE ( provider, external_id )
+)`,
+
+		`CREATE TABLE segment_integrity_queue (
+	stream_id bytea NOT NULL,
+	kind text NOT NULL,
+	project_id bytea,
+	bucket_name bytea,
+	object_key bytea,
+	version bigint,
+	expected_segments integer NOT NULL,
+	actual_segments integer NOT NULL,
+	expected_size bigint NOT NULL,
+	actual_size bigint NOT NULL,
+	detected_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( stream_id, kind )
+)`,
+
 		`CREATE TABLE segment_pending_audits (
 	node_id bytea NOT NULL,
 	stream_id bytea NOT NULL,
@@ -1735,6 +2178,18 @@ func (obj *pgxcockroachDB) Schema() []string {
 	PRIMARY KEY ( tx_id )
 )`,
 
+		`CREATE TABLE trusted_devices (
+	id bytea NOT NULL,
+	user_id bytea NOT NULL,
+	fingerprint bytea NOT NULL,
+	user_agent text NOT NULL,
+	ip_address text NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	last_used_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id ),
+	UNIQUE ( user_id, fingerprint )
+)`,
+
 		`CREATE TABLE users (
 	id bytea NOT NULL,
 	email text NOT NULL,
@@ -1786,6 +2241,7 @@ func (obj *pgxcockroachDB) Schema() []string {
 	onboarding_end boolean NOT NULL DEFAULT true,
 	onboarding_step text,
 	notice_dismissal jsonb NOT NULL DEFAULT '{}',
+	login_alerts boolean NOT NULL DEFAULT false,
 	PRIMARY KEY ( user_id )
 )`,
 
@@ -1879,6 +2335,18 @@ func (obj *pgxcockroachDB) Schema() []string {
 
 		`CREATE INDEX accounting_rollups_start_time_index ON accounting_rollups ( start_time )`,
 
+		`CREATE INDEX admin_audit_events_admin_email_created_at_index ON admin_audit_events ( admin_email, created_at )`,
+
+		`CREATE INDEX admin_audit_events_user_id_created_at_index ON admin_audit_events ( user_id, created_at )`,
+
+		`CREATE INDEX admin_audit_events_action_created_at_index ON admin_audit_events ( action, created_at )`,
+
+		`CREATE INDEX admin_audit_events_created_at_index ON admin_audit_events ( created_at )`,
+
+		`CREATE INDEX admin_webhook_deliveries_pending_index ON admin_webhook_deliveries ( next_attempt_at ) WHERE admin_webhook_deliveries.finished_at is NULL`,
+
+		`CREATE INDEX admin_webhook_deliveries_status_created_at_index ON admin_webhook_deliveries ( status, created_at )`,
+
 		`CREATE INDEX billing_transactions_timestamp_index ON billing_transactions ( timestamp )`,
 
 		`CREATE INDEX bucket_bandwidth_rollups_project_id_action_interval_index ON bucket_bandwidth_rollups ( project_id, action, interval_start )`,
@@ -1895,6 +2363,8 @@ func (obj *pgxcockroachDB) Schema() []string {
 
 		`CREATE INDEX graceful_exit_segment_transfer_nid_dr_qa_fa_lfa_index ON graceful_exit_segment_transfer_queue ( node_id, durability_ratio, queued_at, finished_at, last_failed_at )`,
 
+		`CREATE INDEX maintenance_windows_ends_at_index ON maintenance_windows ( ends_at )`,
+
 		`CREATE INDEX node_last_ip ON nodes ( last_net )`,
 
 		`CREATE INDEX nodes_dis_unk_off_exit_fin_last_success_index ON nodes ( disqualified, unknown_audit_suspended, offline_suspended, exit_finished_at, last_contact_success )`,
@@ -1915,12 +2385,20 @@ func (obj *pgxcockroachDB) Schema() []string {
 
 		`CREATE INDEX oauth_tokens_client_id_index ON oauth_tokens ( client_id )`,
 
+		`CREATE INDEX passphrase_recoveries_project_id_index ON passphrase_recoveries ( project_id )`,
+
 		`CREATE INDEX projects_public_id_index ON projects ( public_id )`,
 
 		`CREATE INDEX projects_owner_id_index ON projects ( owner_id )`,
 
 		`CREATE INDEX project_bandwidth_daily_rollup_interval_day_index ON project_bandwidth_daily_rollups ( interval_day )`,
 
+		`CREATE INDEX project_data_purges_running_index ON project_data_purges ( updated_at ) WHERE project_data_purges.finished_at is NULL`,
+
+		`CREATE INDEX project_limit_changes_project_id_index ON project_limit_changes ( project_id )`,
+
+		`CREATE INDEX project_limit_changes_pending_index ON project_limit_changes ( effective_at ) WHERE project_limit_changes.applied_at is NULL`,
+
 		`CREATE INDEX repair_queue_updated_at_index ON repair_queue ( updated_at )`,
 
 		`CREATE INDEX repair_queue_num_healthy_pieces_attempted_at_index ON repair_queue ( segment_health, attempted_at )`,
@@ -1929,6 +2407,8 @@ func (obj *pgxcockroachDB) Schema() []string {
 
 		`CREATE INDEX reverification_audits_inserted_at_index ON reverification_audits ( inserted_at )`,
 
+		`CREATE INDEX segment_integrity_queue_kind_detected_at_index ON segment_integrity_queue ( kind, detected_at )`,
+
 		`CREATE INDEX storagenode_bandwidth_rollups_interval_start_index ON storagenode_bandwidth_rollups ( interval_start )`,
 
 		`CREATE INDEX storagenode_bandwidth_rollup_archives_interval_start_index ON storagenode_bandwidth_rollup_archives ( interval_start )`,
@@ -1982,6 +2462,8 @@ func (obj *pgxcockroachDB) DropSchema() []string {
 
 		`DROP TABLE IF EXISTS users`,
 
+		`DROP TABLE IF EXISTS trusted_devices`,
+
 		`DROP TABLE IF EXISTS stripecoinpayments_tx_conversion_rates`,
 
 		`DROP TABLE IF EXISTS stripecoinpayments_invoice_project_records`,
@@ -2006,6 +2488,10 @@ func (obj *pgxcockroachDB) DropSchema() []string {
 
 		`DROP TABLE IF EXISTS segment_pending_audits`,
 
+		`DROP TABLE IF EXISTS segment_integrity_queue`,
+
+		`DROP TABLE IF EXISTS scim_users`,
+
 		`DROP TABLE IF EXISTS revocations`,
 
 		`DROP TABLE IF EXISTS reverification_audits`,
@@ -2018,12 +2504,26 @@ func (obj *pgxcockroachDB) DropSchema() []string {
 
 		`DROP TABLE IF EXISTS registration_tokens`,
 
+		`DROP TABLE IF EXISTS project_passphrase_escrows`,
+
+		`DROP TABLE IF EXISTS project_limit_changes`,
+
+		`DROP TABLE IF EXISTS project_invitation_policies`,
+
+		`DROP TABLE IF EXISTS project_data_purges`,
+
 		`DROP TABLE IF EXISTS project_bandwidth_daily_rollups`,
 
 		`DROP TABLE IF EXISTS projects`,
 
+		`DROP TABLE IF EXISTS pending_object_grace_periods`,
+
 		`DROP TABLE IF EXISTS peer_identities`,
 
+		`DROP TABLE IF EXISTS passphrase_recovery_approvals`,
+
+		`DROP TABLE IF EXISTS passphrase_recoveries`,
+
 		`DROP TABLE IF EXISTS oauth_tokens`,
 
 		`DROP TABLE IF EXISTS oauth_codes`,
@@ -2038,6 +2538,10 @@ func (obj *pgxcockroachDB) DropSchema() []string {
 
 		`DROP TABLE IF EXISTS nodes`,
 
+		`DROP TABLE IF EXISTS maintenance_windows`,
+
+		`DROP TABLE IF EXISTS linksharing_brandings`,
+
 		`DROP TABLE IF EXISTS graceful_exit_segment_transfer_queue`,
 
 		`DROP TABLE IF EXISTS graceful_exit_progress`,
@@ -2046,14 +2550,26 @@ func (obj *pgxcockroachDB) DropSchema() []string {
 
 		`DROP TABLE IF EXISTS bucket_storage_tallies`,
 
+		`DROP TABLE IF EXISTS bucket_lifecycle_configurations`,
+
+		`DROP TABLE IF EXISTS bucket_inventory_configurations`,
+
 		`DROP TABLE IF EXISTS bucket_bandwidth_rollup_archives`,
 
 		`DROP TABLE IF EXISTS bucket_bandwidth_rollups`,
 
 		`DROP TABLE IF EXISTS billing_transactions`,
 
+		`DROP TABLE IF EXISTS billing_contacts`,
+
 		`DROP TABLE IF EXISTS billing_balances`,
 
+		`DROP TABLE IF EXISTS api_key_bandwidth_rollups`,
+
+		`DROP TABLE IF EXISTS admin_webhook_deliveries`,
+
+		`DROP TABLE IF EXISTS admin_audit_events`,
+
 		`DROP TABLE IF EXISTS accounting_timestamps`,
 
 		`DROP TABLE IF EXISTS accounting_rollups`,
@@ -2546,5824 +3062,8692 @@ func (f AccountingTimestamps_Value_Field) value() interface{} {
 
 func (AccountingTimestamps_Value_Field) _Column() string { return "value" }
 
-type BillingBalance struct {
-	UserId      []byte
-	Balance     int64
-	LastUpdated time.Time
+type AdminAuditEvent struct {
+	Id         []byte
+	AdminEmail string
+	UserId     []byte
+	Action     string
+	Path       string
+	Reason     *string
+	CreatedAt  time.Time
 }
 
-func (BillingBalance) _Table() string { return "billing_balances" }
+func (AdminAuditEvent) _Table() string { return "admin_audit_events" }
 
-type BillingBalance_Update_Fields struct {
-	Balance BillingBalance_Balance_Field
+type AdminAuditEvent_Create_Fields struct {
+	UserId AdminAuditEvent_UserId_Field
+	Reason AdminAuditEvent_Reason_Field
 }
 
-type BillingBalance_UserId_Field struct {
+type AdminAuditEvent_Update_Fields struct {
+}
+
+type AdminAuditEvent_Id_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func BillingBalance_UserId(v []byte) BillingBalance_UserId_Field {
-	return BillingBalance_UserId_Field{_set: true, _value: v}
+func AdminAuditEvent_Id(v []byte) AdminAuditEvent_Id_Field {
+	return AdminAuditEvent_Id_Field{_set: true, _value: v}
 }
 
-func (f BillingBalance_UserId_Field) value() interface{} {
+func (f AdminAuditEvent_Id_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BillingBalance_UserId_Field) _Column() string { return "user_id" }
+func (AdminAuditEvent_Id_Field) _Column() string { return "id" }
 
-type BillingBalance_Balance_Field struct {
+type AdminAuditEvent_AdminEmail_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value string
 }
 
-func BillingBalance_Balance(v int64) BillingBalance_Balance_Field {
-	return BillingBalance_Balance_Field{_set: true, _value: v}
+func AdminAuditEvent_AdminEmail(v string) AdminAuditEvent_AdminEmail_Field {
+	return AdminAuditEvent_AdminEmail_Field{_set: true, _value: v}
 }
 
-func (f BillingBalance_Balance_Field) value() interface{} {
+func (f AdminAuditEvent_AdminEmail_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BillingBalance_Balance_Field) _Column() string { return "balance" }
+func (AdminAuditEvent_AdminEmail_Field) _Column() string { return "admin_email" }
 
-type BillingBalance_LastUpdated_Field struct {
+type AdminAuditEvent_UserId_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value []byte
 }
 
-func BillingBalance_LastUpdated(v time.Time) BillingBalance_LastUpdated_Field {
-	return BillingBalance_LastUpdated_Field{_set: true, _value: v}
+func AdminAuditEvent_UserId(v []byte) AdminAuditEvent_UserId_Field {
+	return AdminAuditEvent_UserId_Field{_set: true, _value: v}
 }
 
-func (f BillingBalance_LastUpdated_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
+func AdminAuditEvent_UserId_Raw(v []byte) AdminAuditEvent_UserId_Field {
+	if v == nil {
+		return AdminAuditEvent_UserId_Null()
 	}
-	return f._value
+	return AdminAuditEvent_UserId(v)
 }
 
-func (BillingBalance_LastUpdated_Field) _Column() string { return "last_updated" }
-
-type BillingTransaction struct {
-	Id          int64
-	UserId      []byte
-	Amount      int64
-	Currency    string
-	Description string
-	Source      string
-	Status      string
-	Type        string
-	Metadata    []byte
-	Timestamp   time.Time
-	CreatedAt   time.Time
+func AdminAuditEvent_UserId_Null() AdminAuditEvent_UserId_Field {
+	return AdminAuditEvent_UserId_Field{_set: true, _null: true}
 }
 
-func (BillingTransaction) _Table() string { return "billing_transactions" }
+func (f AdminAuditEvent_UserId_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
 
-type BillingTransaction_Update_Fields struct {
-	Status   BillingTransaction_Status_Field
-	Metadata BillingTransaction_Metadata_Field
+func (f AdminAuditEvent_UserId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
 }
 
-type BillingTransaction_Id_Field struct {
+func (AdminAuditEvent_UserId_Field) _Column() string { return "user_id" }
+
+type AdminAuditEvent_Action_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value string
 }
 
-func BillingTransaction_Id(v int64) BillingTransaction_Id_Field {
-	return BillingTransaction_Id_Field{_set: true, _value: v}
+func AdminAuditEvent_Action(v string) AdminAuditEvent_Action_Field {
+	return AdminAuditEvent_Action_Field{_set: true, _value: v}
 }
 
-func (f BillingTransaction_Id_Field) value() interface{} {
+func (f AdminAuditEvent_Action_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BillingTransaction_Id_Field) _Column() string { return "id" }
+func (AdminAuditEvent_Action_Field) _Column() string { return "action" }
 
-type BillingTransaction_UserId_Field struct {
+type AdminAuditEvent_Path_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value string
 }
 
-func BillingTransaction_UserId(v []byte) BillingTransaction_UserId_Field {
-	return BillingTransaction_UserId_Field{_set: true, _value: v}
+func AdminAuditEvent_Path(v string) AdminAuditEvent_Path_Field {
+	return AdminAuditEvent_Path_Field{_set: true, _value: v}
 }
 
-func (f BillingTransaction_UserId_Field) value() interface{} {
+func (f AdminAuditEvent_Path_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BillingTransaction_UserId_Field) _Column() string { return "user_id" }
+func (AdminAuditEvent_Path_Field) _Column() string { return "path" }
 
-type BillingTransaction_Amount_Field struct {
+type AdminAuditEvent_Reason_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value *string
 }
 
-func BillingTransaction_Amount(v int64) BillingTransaction_Amount_Field {
-	return BillingTransaction_Amount_Field{_set: true, _value: v}
+func AdminAuditEvent_Reason(v string) AdminAuditEvent_Reason_Field {
+	return AdminAuditEvent_Reason_Field{_set: true, _value: &v}
 }
 
-func (f BillingTransaction_Amount_Field) value() interface{} {
+func AdminAuditEvent_Reason_Raw(v *string) AdminAuditEvent_Reason_Field {
+	if v == nil {
+		return AdminAuditEvent_Reason_Null()
+	}
+	return AdminAuditEvent_Reason(*v)
+}
+
+func AdminAuditEvent_Reason_Null() AdminAuditEvent_Reason_Field {
+	return AdminAuditEvent_Reason_Field{_set: true, _null: true}
+}
+
+func (f AdminAuditEvent_Reason_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f AdminAuditEvent_Reason_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BillingTransaction_Amount_Field) _Column() string { return "amount" }
+func (AdminAuditEvent_Reason_Field) _Column() string { return "reason" }
 
-type BillingTransaction_Currency_Field struct {
+type AdminAuditEvent_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value time.Time
 }
 
-func BillingTransaction_Currency(v string) BillingTransaction_Currency_Field {
-	return BillingTransaction_Currency_Field{_set: true, _value: v}
+func AdminAuditEvent_CreatedAt(v time.Time) AdminAuditEvent_CreatedAt_Field {
+	return AdminAuditEvent_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f BillingTransaction_Currency_Field) value() interface{} {
+func (f AdminAuditEvent_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BillingTransaction_Currency_Field) _Column() string { return "currency" }
+func (AdminAuditEvent_CreatedAt_Field) _Column() string { return "created_at" }
 
-type BillingTransaction_Description_Field struct {
+type AdminWebhookDelivery struct {
+	Id            []byte
+	EventId       []byte
+	Url           string
+	Kind          string
+	Payload       []byte
+	Status        string
+	Attempts      int
+	LastError     *string
+	NextAttemptAt time.Time
+	CreatedAt     time.Time
+	FinishedAt    *time.Time
+}
+
+func (AdminWebhookDelivery) _Table() string { return "admin_webhook_deliveries" }
+
+type AdminWebhookDelivery_Create_Fields struct {
+	LastError  AdminWebhookDelivery_LastError_Field
+	FinishedAt AdminWebhookDelivery_FinishedAt_Field
+}
+
+type AdminWebhookDelivery_Update_Fields struct {
+	Status        AdminWebhookDelivery_Status_Field
+	Attempts      AdminWebhookDelivery_Attempts_Field
+	LastError     AdminWebhookDelivery_LastError_Field
+	NextAttemptAt AdminWebhookDelivery_NextAttemptAt_Field
+	FinishedAt    AdminWebhookDelivery_FinishedAt_Field
+}
+
+type AdminWebhookDelivery_Id_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value []byte
 }
 
-func BillingTransaction_Description(v string) BillingTransaction_Description_Field {
-	return BillingTransaction_Description_Field{_set: true, _value: v}
+func AdminWebhookDelivery_Id(v []byte) AdminWebhookDelivery_Id_Field {
+	return AdminWebhookDelivery_Id_Field{_set: true, _value: v}
 }
 
-func (f BillingTransaction_Description_Field) value() interface{} {
+func (f AdminWebhookDelivery_Id_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BillingTransaction_Description_Field) _Column() string { return "description" }
+func (AdminWebhookDelivery_Id_Field) _Column() string { return "id" }
 
-type BillingTransaction_Source_Field struct {
+type AdminWebhookDelivery_EventId_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value []byte
 }
 
-func BillingTransaction_Source(v string) BillingTransaction_Source_Field {
-	return BillingTransaction_Source_Field{_set: true, _value: v}
+func AdminWebhookDelivery_EventId(v []byte) AdminWebhookDelivery_EventId_Field {
+	return AdminWebhookDelivery_EventId_Field{_set: true, _value: v}
 }
 
-func (f BillingTransaction_Source_Field) value() interface{} {
+func (f AdminWebhookDelivery_EventId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BillingTransaction_Source_Field) _Column() string { return "source" }
+func (AdminWebhookDelivery_EventId_Field) _Column() string { return "event_id" }
 
-type BillingTransaction_Status_Field struct {
+type AdminWebhookDelivery_Url_Field struct {
 	_set   bool
 	_null  bool
 	_value string
 }
 
-func BillingTransaction_Status(v string) BillingTransaction_Status_Field {
-	return BillingTransaction_Status_Field{_set: true, _value: v}
+func AdminWebhookDelivery_Url(v string) AdminWebhookDelivery_Url_Field {
+	return AdminWebhookDelivery_Url_Field{_set: true, _value: v}
 }
 
-func (f BillingTransaction_Status_Field) value() interface{} {
+func (f AdminWebhookDelivery_Url_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BillingTransaction_Status_Field) _Column() string { return "status" }
+func (AdminWebhookDelivery_Url_Field) _Column() string { return "url" }
 
-type BillingTransaction_Type_Field struct {
+type AdminWebhookDelivery_Kind_Field struct {
 	_set   bool
 	_null  bool
 	_value string
 }
 
-func BillingTransaction_Type(v string) BillingTransaction_Type_Field {
-	return BillingTransaction_Type_Field{_set: true, _value: v}
+func AdminWebhookDelivery_Kind(v string) AdminWebhookDelivery_Kind_Field {
+	return AdminWebhookDelivery_Kind_Field{_set: true, _value: v}
 }
 
-func (f BillingTransaction_Type_Field) value() interface{} {
+func (f AdminWebhookDelivery_Kind_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BillingTransaction_Type_Field) _Column() string { return "type" }
+func (AdminWebhookDelivery_Kind_Field) _Column() string { return "kind" }
 
-type BillingTransaction_Metadata_Field struct {
+type AdminWebhookDelivery_Payload_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func BillingTransaction_Metadata(v []byte) BillingTransaction_Metadata_Field {
-	return BillingTransaction_Metadata_Field{_set: true, _value: v}
+func AdminWebhookDelivery_Payload(v []byte) AdminWebhookDelivery_Payload_Field {
+	return AdminWebhookDelivery_Payload_Field{_set: true, _value: v}
 }
 
-func (f BillingTransaction_Metadata_Field) value() interface{} {
+func (f AdminWebhookDelivery_Payload_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BillingTransaction_Metadata_Field) _Column() string { return "metadata" }
+func (AdminWebhookDelivery_Payload_Field) _Column() string { return "payload" }
 
-type BillingTransaction_Timestamp_Field struct {
+type AdminWebhookDelivery_Status_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value string
 }
 
-func BillingTransaction_Timestamp(v time.Time) BillingTransaction_Timestamp_Field {
-	return BillingTransaction_Timestamp_Field{_set: true, _value: v}
+func AdminWebhookDelivery_Status(v string) AdminWebhookDelivery_Status_Field {
+	return AdminWebhookDelivery_Status_Field{_set: true, _value: v}
 }
 
-func (f BillingTransaction_Timestamp_Field) value() interface{} {
+func (f AdminWebhookDelivery_Status_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BillingTransaction_Timestamp_Field) _Column() string { return "timestamp" }
+func (AdminWebhookDelivery_Status_Field) _Column() string { return "status" }
 
-type BillingTransaction_CreatedAt_Field struct {
+type AdminWebhookDelivery_Attempts_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value int
 }
 
-func BillingTransaction_CreatedAt(v time.Time) BillingTransaction_CreatedAt_Field {
-	return BillingTransaction_CreatedAt_Field{_set: true, _value: v}
+func AdminWebhookDelivery_Attempts(v int) AdminWebhookDelivery_Attempts_Field {
+	return AdminWebhookDelivery_Attempts_Field{_set: true, _value: v}
 }
 
-func (f BillingTransaction_CreatedAt_Field) value() interface{} {
+func (f AdminWebhookDelivery_Attempts_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BillingTransaction_CreatedAt_Field) _Column() string { return "created_at" }
+func (AdminWebhookDelivery_Attempts_Field) _Column() string { return "attempts" }
 
-type BucketBandwidthRollup struct {
-	BucketName      []byte
-	ProjectId       []byte
-	IntervalStart   time.Time
-	IntervalSeconds uint
-	Action          uint
-	Inline          uint64
-	Allocated       uint64
-	Settled         uint64
+type AdminWebhookDelivery_LastError_Field struct {
+	_set   bool
+	_null  bool
+	_value *string
 }
 
-func (BucketBandwidthRollup) _Table() string { return "bucket_bandwidth_rollups" }
+func AdminWebhookDelivery_LastError(v string) AdminWebhookDelivery_LastError_Field {
+	return AdminWebhookDelivery_LastError_Field{_set: true, _value: &v}
+}
 
-type BucketBandwidthRollup_Update_Fields struct {
-	Inline    BucketBandwidthRollup_Inline_Field
-	Allocated BucketBandwidthRollup_Allocated_Field
-	Settled   BucketBandwidthRollup_Settled_Field
+func AdminWebhookDelivery_LastError_Raw(v *string) AdminWebhookDelivery_LastError_Field {
+	if v == nil {
+		return AdminWebhookDelivery_LastError_Null()
+	}
+	return AdminWebhookDelivery_LastError(*v)
 }
 
-type BucketBandwidthRollup_BucketName_Field struct {
-	_set   bool
-	_null  bool
-	_value []byte
+func AdminWebhookDelivery_LastError_Null() AdminWebhookDelivery_LastError_Field {
+	return AdminWebhookDelivery_LastError_Field{_set: true, _null: true}
 }
 
-func BucketBandwidthRollup_BucketName(v []byte) BucketBandwidthRollup_BucketName_Field {
-	return BucketBandwidthRollup_BucketName_Field{_set: true, _value: v}
+func (f AdminWebhookDelivery_LastError_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
 }
 
-func (f BucketBandwidthRollup_BucketName_Field) value() interface{} {
+func (f AdminWebhookDelivery_LastError_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketBandwidthRollup_BucketName_Field) _Column() string { return "bucket_name" }
+func (AdminWebhookDelivery_LastError_Field) _Column() string { return "last_error" }
 
-type BucketBandwidthRollup_ProjectId_Field struct {
+type AdminWebhookDelivery_NextAttemptAt_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value time.Time
 }
 
-func BucketBandwidthRollup_ProjectId(v []byte) BucketBandwidthRollup_ProjectId_Field {
-	return BucketBandwidthRollup_ProjectId_Field{_set: true, _value: v}
+func AdminWebhookDelivery_NextAttemptAt(v time.Time) AdminWebhookDelivery_NextAttemptAt_Field {
+	return AdminWebhookDelivery_NextAttemptAt_Field{_set: true, _value: v}
 }
 
-func (f BucketBandwidthRollup_ProjectId_Field) value() interface{} {
+func (f AdminWebhookDelivery_NextAttemptAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketBandwidthRollup_ProjectId_Field) _Column() string { return "project_id" }
+func (AdminWebhookDelivery_NextAttemptAt_Field) _Column() string { return "next_attempt_at" }
 
-type BucketBandwidthRollup_IntervalStart_Field struct {
+type AdminWebhookDelivery_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
 	_value time.Time
 }
 
-func BucketBandwidthRollup_IntervalStart(v time.Time) BucketBandwidthRollup_IntervalStart_Field {
-	return BucketBandwidthRollup_IntervalStart_Field{_set: true, _value: v}
+func AdminWebhookDelivery_CreatedAt(v time.Time) AdminWebhookDelivery_CreatedAt_Field {
+	return AdminWebhookDelivery_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f BucketBandwidthRollup_IntervalStart_Field) value() interface{} {
+func (f AdminWebhookDelivery_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketBandwidthRollup_IntervalStart_Field) _Column() string { return "interval_start" }
+func (AdminWebhookDelivery_CreatedAt_Field) _Column() string { return "created_at" }
 
-type BucketBandwidthRollup_IntervalSeconds_Field struct {
+type AdminWebhookDelivery_FinishedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value uint
+	_value *time.Time
 }
 
-func BucketBandwidthRollup_IntervalSeconds(v uint) BucketBandwidthRollup_IntervalSeconds_Field {
-	return BucketBandwidthRollup_IntervalSeconds_Field{_set: true, _value: v}
+func AdminWebhookDelivery_FinishedAt(v time.Time) AdminWebhookDelivery_FinishedAt_Field {
+	return AdminWebhookDelivery_FinishedAt_Field{_set: true, _value: &v}
 }
 
-func (f BucketBandwidthRollup_IntervalSeconds_Field) value() interface{} {
+func AdminWebhookDelivery_FinishedAt_Raw(v *time.Time) AdminWebhookDelivery_FinishedAt_Field {
+	if v == nil {
+		return AdminWebhookDelivery_FinishedAt_Null()
+	}
+	return AdminWebhookDelivery_FinishedAt(*v)
+}
+
+func AdminWebhookDelivery_FinishedAt_Null() AdminWebhookDelivery_FinishedAt_Field {
+	return AdminWebhookDelivery_FinishedAt_Field{_set: true, _null: true}
+}
+
+func (f AdminWebhookDelivery_FinishedAt_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f AdminWebhookDelivery_FinishedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketBandwidthRollup_IntervalSeconds_Field) _Column() string { return "interval_seconds" }
+func (AdminWebhookDelivery_FinishedAt_Field) _Column() string { return "finished_at" }
 
-type BucketBandwidthRollup_Action_Field struct {
+type ApiKeyBandwidthRollup struct {
+	ProjectId     []byte
+	ApiKeyId      []byte
+	IntervalStart time.Time
+	Inline        uint64
+	Settled       uint64
+}
+
+func (ApiKeyBandwidthRollup) _Table() string { return "api_key_bandwidth_rollups" }
+
+type ApiKeyBandwidthRollup_Update_Fields struct {
+	Inline  ApiKeyBandwidthRollup_Inline_Field
+	Settled ApiKeyBandwidthRollup_Settled_Field
+}
+
+type ApiKeyBandwidthRollup_ProjectId_Field struct {
 	_set   bool
 	_null  bool
-	_value uint
+	_value []byte
 }
 
-func BucketBandwidthRollup_Action(v uint) BucketBandwidthRollup_Action_Field {
-	return BucketBandwidthRollup_Action_Field{_set: true, _value: v}
+func ApiKeyBandwidthRollup_ProjectId(v []byte) ApiKeyBandwidthRollup_ProjectId_Field {
+	return ApiKeyBandwidthRollup_ProjectId_Field{_set: true, _value: v}
 }
 
-func (f BucketBandwidthRollup_Action_Field) value() interface{} {
+func (f ApiKeyBandwidthRollup_ProjectId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketBandwidthRollup_Action_Field) _Column() string { return "action" }
+func (ApiKeyBandwidthRollup_ProjectId_Field) _Column() string { return "project_id" }
 
-type BucketBandwidthRollup_Inline_Field struct {
+type ApiKeyBandwidthRollup_ApiKeyId_Field struct {
 	_set   bool
 	_null  bool
-	_value uint64
+	_value []byte
 }
 
-func BucketBandwidthRollup_Inline(v uint64) BucketBandwidthRollup_Inline_Field {
-	return BucketBandwidthRollup_Inline_Field{_set: true, _value: v}
+func ApiKeyBandwidthRollup_ApiKeyId(v []byte) ApiKeyBandwidthRollup_ApiKeyId_Field {
+	return ApiKeyBandwidthRollup_ApiKeyId_Field{_set: true, _value: v}
 }
 
-func (f BucketBandwidthRollup_Inline_Field) value() interface{} {
+func (f ApiKeyBandwidthRollup_ApiKeyId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketBandwidthRollup_Inline_Field) _Column() string { return "inline" }
+func (ApiKeyBandwidthRollup_ApiKeyId_Field) _Column() string { return "api_key_id" }
 
-type BucketBandwidthRollup_Allocated_Field struct {
+type ApiKeyBandwidthRollup_IntervalStart_Field struct {
 	_set   bool
 	_null  bool
-	_value uint64
+	_value time.Time
 }
 
-func BucketBandwidthRollup_Allocated(v uint64) BucketBandwidthRollup_Allocated_Field {
-	return BucketBandwidthRollup_Allocated_Field{_set: true, _value: v}
+func ApiKeyBandwidthRollup_IntervalStart(v time.Time) ApiKeyBandwidthRollup_IntervalStart_Field {
+	return ApiKeyBandwidthRollup_IntervalStart_Field{_set: true, _value: v}
 }
 
-func (f BucketBandwidthRollup_Allocated_Field) value() interface{} {
+func (f ApiKeyBandwidthRollup_IntervalStart_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketBandwidthRollup_Allocated_Field) _Column() string { return "allocated" }
+func (ApiKeyBandwidthRollup_IntervalStart_Field) _Column() string { return "interval_start" }
 
-type BucketBandwidthRollup_Settled_Field struct {
+type ApiKeyBandwidthRollup_Inline_Field struct {
 	_set   bool
 	_null  bool
 	_value uint64
 }
 
-func BucketBandwidthRollup_Settled(v uint64) BucketBandwidthRollup_Settled_Field {
-	return BucketBandwidthRollup_Settled_Field{_set: true, _value: v}
+func ApiKeyBandwidthRollup_Inline(v uint64) ApiKeyBandwidthRollup_Inline_Field {
+	return ApiKeyBandwidthRollup_Inline_Field{_set: true, _value: v}
 }
 
-func (f BucketBandwidthRollup_Settled_Field) value() interface{} {
+func (f ApiKeyBandwidthRollup_Inline_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketBandwidthRollup_Settled_Field) _Column() string { return "settled" }
-
-type BucketBandwidthRollupArchive struct {
-	BucketName      []byte
-	ProjectId       []byte
-	IntervalStart   time.Time
-	IntervalSeconds uint
-	Action          uint
-	Inline          uint64
-	Allocated       uint64
-	Settled         uint64
-}
-
-func (BucketBandwidthRollupArchive) _Table() string { return "bucket_bandwidth_rollup_archives" }
-
-type BucketBandwidthRollupArchive_Update_Fields struct {
-	Inline    BucketBandwidthRollupArchive_Inline_Field
-	Allocated BucketBandwidthRollupArchive_Allocated_Field
-	Settled   BucketBandwidthRollupArchive_Settled_Field
-}
+func (ApiKeyBandwidthRollup_Inline_Field) _Column() string { return "inline" }
 
-type BucketBandwidthRollupArchive_BucketName_Field struct {
+type ApiKeyBandwidthRollup_Settled_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value uint64
 }
 
-func BucketBandwidthRollupArchive_BucketName(v []byte) BucketBandwidthRollupArchive_BucketName_Field {
-	return BucketBandwidthRollupArchive_BucketName_Field{_set: true, _value: v}
+func ApiKeyBandwidthRollup_Settled(v uint64) ApiKeyBandwidthRollup_Settled_Field {
+	return ApiKeyBandwidthRollup_Settled_Field{_set: true, _value: v}
 }
 
-func (f BucketBandwidthRollupArchive_BucketName_Field) value() interface{} {
+func (f ApiKeyBandwidthRollup_Settled_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketBandwidthRollupArchive_BucketName_Field) _Column() string { return "bucket_name" }
+func (ApiKeyBandwidthRollup_Settled_Field) _Column() string { return "settled" }
 
-type BucketBandwidthRollupArchive_ProjectId_Field struct {
+type BillingBalance struct {
+	UserId      []byte
+	Balance     int64
+	LastUpdated time.Time
+}
+
+func (BillingBalance) _Table() string { return "billing_balances" }
+
+type BillingBalance_Update_Fields struct {
+	Balance BillingBalance_Balance_Field
+}
+
+type BillingBalance_UserId_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func BucketBandwidthRollupArchive_ProjectId(v []byte) BucketBandwidthRollupArchive_ProjectId_Field {
-	return BucketBandwidthRollupArchive_ProjectId_Field{_set: true, _value: v}
+func BillingBalance_UserId(v []byte) BillingBalance_UserId_Field {
+	return BillingBalance_UserId_Field{_set: true, _value: v}
 }
 
-func (f BucketBandwidthRollupArchive_ProjectId_Field) value() interface{} {
+func (f BillingBalance_UserId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketBandwidthRollupArchive_ProjectId_Field) _Column() string { return "project_id" }
+func (BillingBalance_UserId_Field) _Column() string { return "user_id" }
 
-type BucketBandwidthRollupArchive_IntervalStart_Field struct {
+type BillingBalance_Balance_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value int64
 }
 
-func BucketBandwidthRollupArchive_IntervalStart(v time.Time) BucketBandwidthRollupArchive_IntervalStart_Field {
-	return BucketBandwidthRollupArchive_IntervalStart_Field{_set: true, _value: v}
+func BillingBalance_Balance(v int64) BillingBalance_Balance_Field {
+	return BillingBalance_Balance_Field{_set: true, _value: v}
 }
 
-func (f BucketBandwidthRollupArchive_IntervalStart_Field) value() interface{} {
+func (f BillingBalance_Balance_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketBandwidthRollupArchive_IntervalStart_Field) _Column() string { return "interval_start" }
+func (BillingBalance_Balance_Field) _Column() string { return "balance" }
 
-type BucketBandwidthRollupArchive_IntervalSeconds_Field struct {
+type BillingBalance_LastUpdated_Field struct {
 	_set   bool
 	_null  bool
-	_value uint
+	_value time.Time
 }
 
-func BucketBandwidthRollupArchive_IntervalSeconds(v uint) BucketBandwidthRollupArchive_IntervalSeconds_Field {
-	return BucketBandwidthRollupArchive_IntervalSeconds_Field{_set: true, _value: v}
+func BillingBalance_LastUpdated(v time.Time) BillingBalance_LastUpdated_Field {
+	return BillingBalance_LastUpdated_Field{_set: true, _value: v}
 }
 
-func (f BucketBandwidthRollupArchive_IntervalSeconds_Field) value() interface{} {
+func (f BillingBalance_LastUpdated_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketBandwidthRollupArchive_IntervalSeconds_Field) _Column() string { return "interval_seconds" }
+func (BillingBalance_LastUpdated_Field) _Column() string { return "last_updated" }
 
-type BucketBandwidthRollupArchive_Action_Field struct {
+type BillingContact struct {
+	Id        []byte
+	UserId    []byte
+	Email     string
+	CreatedAt time.Time
+}
+
+func (BillingContact) _Table() string { return "billing_contacts" }
+
+type BillingContact_Update_Fields struct {
+	Email BillingContact_Email_Field
+}
+
+type BillingContact_Id_Field struct {
 	_set   bool
 	_null  bool
-	_value uint
+	_value []byte
 }
 
-func BucketBandwidthRollupArchive_Action(v uint) BucketBandwidthRollupArchive_Action_Field {
-	return BucketBandwidthRollupArchive_Action_Field{_set: true, _value: v}
+func BillingContact_Id(v []byte) BillingContact_Id_Field {
+	return BillingContact_Id_Field{_set: true, _value: v}
 }
 
-func (f BucketBandwidthRollupArchive_Action_Field) value() interface{} {
+func (f BillingContact_Id_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketBandwidthRollupArchive_Action_Field) _Column() string { return "action" }
+func (BillingContact_Id_Field) _Column() string { return "id" }
 
-type BucketBandwidthRollupArchive_Inline_Field struct {
+type BillingContact_UserId_Field struct {
 	_set   bool
 	_null  bool
-	_value uint64
+	_value []byte
 }
 
-func BucketBandwidthRollupArchive_Inline(v uint64) BucketBandwidthRollupArchive_Inline_Field {
-	return BucketBandwidthRollupArchive_Inline_Field{_set: true, _value: v}
+func BillingContact_UserId(v []byte) BillingContact_UserId_Field {
+	return BillingContact_UserId_Field{_set: true, _value: v}
 }
 
-func (f BucketBandwidthRollupArchive_Inline_Field) value() interface{} {
+func (f BillingContact_UserId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketBandwidthRollupArchive_Inline_Field) _Column() string { return "inline" }
+func (BillingContact_UserId_Field) _Column() string { return "user_id" }
 
-type BucketBandwidthRollupArchive_Allocated_Field struct {
+type BillingContact_Email_Field struct {
 	_set   bool
 	_null  bool
-	_value uint64
+	_value string
 }
 
-func BucketBandwidthRollupArchive_Allocated(v uint64) BucketBandwidthRollupArchive_Allocated_Field {
-	return BucketBandwidthRollupArchive_Allocated_Field{_set: true, _value: v}
+func BillingContact_Email(v string) BillingContact_Email_Field {
+	return BillingContact_Email_Field{_set: true, _value: v}
 }
 
-func (f BucketBandwidthRollupArchive_Allocated_Field) value() interface{} {
+func (f BillingContact_Email_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketBandwidthRollupArchive_Allocated_Field) _Column() string { return "allocated" }
+func (BillingContact_Email_Field) _Column() string { return "email" }
 
-type BucketBandwidthRollupArchive_Settled_Field struct {
+type BillingContact_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value uint64
+	_value time.Time
 }
 
-func BucketBandwidthRollupArchive_Settled(v uint64) BucketBandwidthRollupArchive_Settled_Field {
-	return BucketBandwidthRollupArchive_Settled_Field{_set: true, _value: v}
+func BillingContact_CreatedAt(v time.Time) BillingContact_CreatedAt_Field {
+	return BillingContact_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f BucketBandwidthRollupArchive_Settled_Field) value() interface{} {
+func (f BillingContact_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketBandwidthRollupArchive_Settled_Field) _Column() string { return "settled" }
+func (BillingContact_CreatedAt_Field) _Column() string { return "created_at" }
 
-type BucketStorageTally struct {
-	BucketName          []byte
-	ProjectId           []byte
-	IntervalStart       time.Time
-	TotalBytes          uint64
-	Inline              uint64
-	Remote              uint64
-	TotalSegmentsCount  uint
-	RemoteSegmentsCount uint
-	InlineSegmentsCount uint
-	ObjectCount         uint
-	MetadataSize        uint64
+type BillingTransaction struct {
+	Id          int64
+	UserId      []byte
+	Amount      int64
+	Currency    string
+	Description string
+	Source      string
+	Status      string
+	Type        string
+	Metadata    []byte
+	Timestamp   time.Time
+	CreatedAt   time.Time
 }
 
-func (BucketStorageTally) _Table() string { return "bucket_storage_tallies" }
-
-type BucketStorageTally_Create_Fields struct {
-	TotalBytes         BucketStorageTally_TotalBytes_Field
-	TotalSegmentsCount BucketStorageTally_TotalSegmentsCount_Field
-}
+func (BillingTransaction) _Table() string { return "billing_transactions" }
 
-type BucketStorageTally_Update_Fields struct {
+type BillingTransaction_Update_Fields struct {
+	Status   BillingTransaction_Status_Field
+	Metadata BillingTransaction_Metadata_Field
 }
 
-type BucketStorageTally_BucketName_Field struct {
+type BillingTransaction_Id_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value int64
 }
 
-func BucketStorageTally_BucketName(v []byte) BucketStorageTally_BucketName_Field {
-	return BucketStorageTally_BucketName_Field{_set: true, _value: v}
+func BillingTransaction_Id(v int64) BillingTransaction_Id_Field {
+	return BillingTransaction_Id_Field{_set: true, _value: v}
 }
 
-func (f BucketStorageTally_BucketName_Field) value() interface{} {
+func (f BillingTransaction_Id_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketStorageTally_BucketName_Field) _Column() string { return "bucket_name" }
+func (BillingTransaction_Id_Field) _Column() string { return "id" }
 
-type BucketStorageTally_ProjectId_Field struct {
+type BillingTransaction_UserId_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func BucketStorageTally_ProjectId(v []byte) BucketStorageTally_ProjectId_Field {
-	return BucketStorageTally_ProjectId_Field{_set: true, _value: v}
+func BillingTransaction_UserId(v []byte) BillingTransaction_UserId_Field {
+	return BillingTransaction_UserId_Field{_set: true, _value: v}
 }
 
-func (f BucketStorageTally_ProjectId_Field) value() interface{} {
+func (f BillingTransaction_UserId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketStorageTally_ProjectId_Field) _Column() string { return "project_id" }
+func (BillingTransaction_UserId_Field) _Column() string { return "user_id" }
 
-type BucketStorageTally_IntervalStart_Field struct {
+type BillingTransaction_Amount_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value int64
 }
 
-func BucketStorageTally_IntervalStart(v time.Time) BucketStorageTally_IntervalStart_Field {
-	return BucketStorageTally_IntervalStart_Field{_set: true, _value: v}
+func BillingTransaction_Amount(v int64) BillingTransaction_Amount_Field {
+	return BillingTransaction_Amount_Field{_set: true, _value: v}
 }
 
-func (f BucketStorageTally_IntervalStart_Field) value() interface{} {
+func (f BillingTransaction_Amount_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketStorageTally_IntervalStart_Field) _Column() string { return "interval_start" }
+func (BillingTransaction_Amount_Field) _Column() string { return "amount" }
 
-type BucketStorageTally_TotalBytes_Field struct {
+type BillingTransaction_Currency_Field struct {
 	_set   bool
 	_null  bool
-	_value uint64
+	_value string
 }
 
-func BucketStorageTally_TotalBytes(v uint64) BucketStorageTally_TotalBytes_Field {
-	return BucketStorageTally_TotalBytes_Field{_set: true, _value: v}
+func BillingTransaction_Currency(v string) BillingTransaction_Currency_Field {
+	return BillingTransaction_Currency_Field{_set: true, _value: v}
 }
 
-func (f BucketStorageTally_TotalBytes_Field) value() interface{} {
+func (f BillingTransaction_Currency_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketStorageTally_TotalBytes_Field) _Column() string { return "total_bytes" }
+func (BillingTransaction_Currency_Field) _Column() string { return "currency" }
 
-type BucketStorageTally_Inline_Field struct {
+type BillingTransaction_Description_Field struct {
 	_set   bool
 	_null  bool
-	_value uint64
+	_value string
 }
 
-func BucketStorageTally_Inline(v uint64) BucketStorageTally_Inline_Field {
-	return BucketStorageTally_Inline_Field{_set: true, _value: v}
+func BillingTransaction_Description(v string) BillingTransaction_Description_Field {
+	return BillingTransaction_Description_Field{_set: true, _value: v}
 }
 
-func (f BucketStorageTally_Inline_Field) value() interface{} {
+func (f BillingTransaction_Description_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketStorageTally_Inline_Field) _Column() string { return "inline" }
+func (BillingTransaction_Description_Field) _Column() string { return "description" }
 
-type BucketStorageTally_Remote_Field struct {
+type BillingTransaction_Source_Field struct {
 	_set   bool
 	_null  bool
-	_value uint64
+	_value string
 }
 
-func BucketStorageTally_Remote(v uint64) BucketStorageTally_Remote_Field {
-	return BucketStorageTally_Remote_Field{_set: true, _value: v}
+func BillingTransaction_Source(v string) BillingTransaction_Source_Field {
+	return BillingTransaction_Source_Field{_set: true, _value: v}
 }
 
-func (f BucketStorageTally_Remote_Field) value() interface{} {
+func (f BillingTransaction_Source_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketStorageTally_Remote_Field) _Column() string { return "remote" }
+func (BillingTransaction_Source_Field) _Column() string { return "source" }
 
-type BucketStorageTally_TotalSegmentsCount_Field struct {
+type BillingTransaction_Status_Field struct {
 	_set   bool
 	_null  bool
-	_value uint
+	_value string
 }
 
-func BucketStorageTally_TotalSegmentsCount(v uint) BucketStorageTally_TotalSegmentsCount_Field {
-	return BucketStorageTally_TotalSegmentsCount_Field{_set: true, _value: v}
+func BillingTransaction_Status(v string) BillingTransaction_Status_Field {
+	return BillingTransaction_Status_Field{_set: true, _value: v}
 }
 
-func (f BucketStorageTally_TotalSegmentsCount_Field) value() interface{} {
+func (f BillingTransaction_Status_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketStorageTally_TotalSegmentsCount_Field) _Column() string { return "total_segments_count" }
+func (BillingTransaction_Status_Field) _Column() string { return "status" }
 
-type BucketStorageTally_RemoteSegmentsCount_Field struct {
+type BillingTransaction_Type_Field struct {
 	_set   bool
 	_null  bool
-	_value uint
+	_value string
 }
 
-func BucketStorageTally_RemoteSegmentsCount(v uint) BucketStorageTally_RemoteSegmentsCount_Field {
-	return BucketStorageTally_RemoteSegmentsCount_Field{_set: true, _value: v}
+func BillingTransaction_Type(v string) BillingTransaction_Type_Field {
+	return BillingTransaction_Type_Field{_set: true, _value: v}
 }
 
-func (f BucketStorageTally_RemoteSegmentsCount_Field) value() interface{} {
+func (f BillingTransaction_Type_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketStorageTally_RemoteSegmentsCount_Field) _Column() string { return "remote_segments_count" }
+func (BillingTransaction_Type_Field) _Column() string { return "type" }
 
-type BucketStorageTally_InlineSegmentsCount_Field struct {
+type BillingTransaction_Metadata_Field struct {
 	_set   bool
 	_null  bool
-	_value uint
+	_value []byte
 }
 
-func BucketStorageTally_InlineSegmentsCount(v uint) BucketStorageTally_InlineSegmentsCount_Field {
-	return BucketStorageTally_InlineSegmentsCount_Field{_set: true, _value: v}
+func BillingTransaction_Metadata(v []byte) BillingTransaction_Metadata_Field {
+	return BillingTransaction_Metadata_Field{_set: true, _value: v}
 }
 
-func (f BucketStorageTally_InlineSegmentsCount_Field) value() interface{} {
+func (f BillingTransaction_Metadata_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketStorageTally_InlineSegmentsCount_Field) _Column() string { return "inline_segments_count" }
+func (BillingTransaction_Metadata_Field) _Column() string { return "metadata" }
 
-type BucketStorageTally_ObjectCount_Field struct {
+type BillingTransaction_Timestamp_Field struct {
 	_set   bool
 	_null  bool
-	_value uint
+	_value time.Time
 }
 
-func BucketStorageTally_ObjectCount(v uint) BucketStorageTally_ObjectCount_Field {
-	return BucketStorageTally_ObjectCount_Field{_set: true, _value: v}
+func BillingTransaction_Timestamp(v time.Time) BillingTransaction_Timestamp_Field {
+	return BillingTransaction_Timestamp_Field{_set: true, _value: v}
 }
 
-func (f BucketStorageTally_ObjectCount_Field) value() interface{} {
+func (f BillingTransaction_Timestamp_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketStorageTally_ObjectCount_Field) _Column() string { return "object_count" }
+func (BillingTransaction_Timestamp_Field) _Column() string { return "timestamp" }
 
-type BucketStorageTally_MetadataSize_Field struct {
+type BillingTransaction_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value uint64
+	_value time.Time
 }
 
-func BucketStorageTally_MetadataSize(v uint64) BucketStorageTally_MetadataSize_Field {
-	return BucketStorageTally_MetadataSize_Field{_set: true, _value: v}
+func BillingTransaction_CreatedAt(v time.Time) BillingTransaction_CreatedAt_Field {
+	return BillingTransaction_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f BucketStorageTally_MetadataSize_Field) value() interface{} {
+func (f BillingTransaction_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketStorageTally_MetadataSize_Field) _Column() string { return "metadata_size" }
+func (BillingTransaction_CreatedAt_Field) _Column() string { return "created_at" }
 
-type CoinpaymentsTransaction struct {
-	Id              string
-	UserId          []byte
-	Address         string
-	AmountNumeric   int64
-	ReceivedNumeric int64
-	Status          int
-	Key             string
-	Timeout         int
-	CreatedAt       time.Time
+type BucketBandwidthRollup struct {
+	BucketName      []byte
+	ProjectId       []byte
+	IntervalStart   time.Time
+	IntervalSeconds uint
+	Action          uint
+	Inline          uint64
+	Allocated       uint64
+	Settled         uint64
 }
 
-func (CoinpaymentsTransaction) _Table() string { return "coinpayments_transactions" }
+func (BucketBandwidthRollup) _Table() string { return "bucket_bandwidth_rollups" }
 
-type CoinpaymentsTransaction_Update_Fields struct {
-	ReceivedNumeric CoinpaymentsTransaction_ReceivedNumeric_Field
-	Status          CoinpaymentsTransaction_Status_Field
+type BucketBandwidthRollup_Update_Fields struct {
+	Inline    BucketBandwidthRollup_Inline_Field
+	Allocated BucketBandwidthRollup_Allocated_Field
+	Settled   BucketBandwidthRollup_Settled_Field
 }
 
-type CoinpaymentsTransaction_Id_Field struct {
+type BucketBandwidthRollup_BucketName_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value []byte
 }
 
-func CoinpaymentsTransaction_Id(v string) CoinpaymentsTransaction_Id_Field {
-	return CoinpaymentsTransaction_Id_Field{_set: true, _value: v}
+func BucketBandwidthRollup_BucketName(v []byte) BucketBandwidthRollup_BucketName_Field {
+	return BucketBandwidthRollup_BucketName_Field{_set: true, _value: v}
 }
 
-func (f CoinpaymentsTransaction_Id_Field) value() interface{} {
+func (f BucketBandwidthRollup_BucketName_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (CoinpaymentsTransaction_Id_Field) _Column() string { return "id" }
+func (BucketBandwidthRollup_BucketName_Field) _Column() string { return "bucket_name" }
 
-type CoinpaymentsTransaction_UserId_Field struct {
+type BucketBandwidthRollup_ProjectId_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func CoinpaymentsTransaction_UserId(v []byte) CoinpaymentsTransaction_UserId_Field {
-	return CoinpaymentsTransaction_UserId_Field{_set: true, _value: v}
+func BucketBandwidthRollup_ProjectId(v []byte) BucketBandwidthRollup_ProjectId_Field {
+	return BucketBandwidthRollup_ProjectId_Field{_set: true, _value: v}
 }
 
-func (f CoinpaymentsTransaction_UserId_Field) value() interface{} {
+func (f BucketBandwidthRollup_ProjectId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (CoinpaymentsTransaction_UserId_Field) _Column() string { return "user_id" }
+func (BucketBandwidthRollup_ProjectId_Field) _Column() string { return "project_id" }
 
-type CoinpaymentsTransaction_Address_Field struct {
+type BucketBandwidthRollup_IntervalStart_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value time.Time
 }
 
-func CoinpaymentsTransaction_Address(v string) CoinpaymentsTransaction_Address_Field {
-	return CoinpaymentsTransaction_Address_Field{_set: true, _value: v}
+func BucketBandwidthRollup_IntervalStart(v time.Time) BucketBandwidthRollup_IntervalStart_Field {
+	return BucketBandwidthRollup_IntervalStart_Field{_set: true, _value: v}
 }
 
-func (f CoinpaymentsTransaction_Address_Field) value() interface{} {
+func (f BucketBandwidthRollup_IntervalStart_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (CoinpaymentsTransaction_Address_Field) _Column() string { return "address" }
+func (BucketBandwidthRollup_IntervalStart_Field) _Column() string { return "interval_start" }
 
-type CoinpaymentsTransaction_AmountNumeric_Field struct {
+type BucketBandwidthRollup_IntervalSeconds_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value uint
 }
 
-func CoinpaymentsTransaction_AmountNumeric(v int64) CoinpaymentsTransaction_AmountNumeric_Field {
-	return CoinpaymentsTransaction_AmountNumeric_Field{_set: true, _value: v}
+func BucketBandwidthRollup_IntervalSeconds(v uint) BucketBandwidthRollup_IntervalSeconds_Field {
+	return BucketBandwidthRollup_IntervalSeconds_Field{_set: true, _value: v}
 }
 
-func (f CoinpaymentsTransaction_AmountNumeric_Field) value() interface{} {
+func (f BucketBandwidthRollup_IntervalSeconds_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (CoinpaymentsTransaction_AmountNumeric_Field) _Column() string { return "amount_numeric" }
+func (BucketBandwidthRollup_IntervalSeconds_Field) _Column() string { return "interval_seconds" }
 
-type CoinpaymentsTransaction_ReceivedNumeric_Field struct {
+type BucketBandwidthRollup_Action_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value uint
 }
 
-func CoinpaymentsTransaction_ReceivedNumeric(v int64) CoinpaymentsTransaction_ReceivedNumeric_Field {
-	return CoinpaymentsTransaction_ReceivedNumeric_Field{_set: true, _value: v}
+func BucketBandwidthRollup_Action(v uint) BucketBandwidthRollup_Action_Field {
+	return BucketBandwidthRollup_Action_Field{_set: true, _value: v}
 }
 
-func (f CoinpaymentsTransaction_ReceivedNumeric_Field) value() interface{} {
+func (f BucketBandwidthRollup_Action_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (CoinpaymentsTransaction_ReceivedNumeric_Field) _Column() string { return "received_numeric" }
+func (BucketBandwidthRollup_Action_Field) _Column() string { return "action" }
 
-type CoinpaymentsTransaction_Status_Field struct {
+type BucketBandwidthRollup_Inline_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value uint64
 }
 
-func CoinpaymentsTransaction_Status(v int) CoinpaymentsTransaction_Status_Field {
-	return CoinpaymentsTransaction_Status_Field{_set: true, _value: v}
+func BucketBandwidthRollup_Inline(v uint64) BucketBandwidthRollup_Inline_Field {
+	return BucketBandwidthRollup_Inline_Field{_set: true, _value: v}
 }
 
-func (f CoinpaymentsTransaction_Status_Field) value() interface{} {
+func (f BucketBandwidthRollup_Inline_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (CoinpaymentsTransaction_Status_Field) _Column() string { return "status" }
+func (BucketBandwidthRollup_Inline_Field) _Column() string { return "inline" }
 
-type CoinpaymentsTransaction_Key_Field struct {
+type BucketBandwidthRollup_Allocated_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value uint64
 }
 
-func CoinpaymentsTransaction_Key(v string) CoinpaymentsTransaction_Key_Field {
-	return CoinpaymentsTransaction_Key_Field{_set: true, _value: v}
+func BucketBandwidthRollup_Allocated(v uint64) BucketBandwidthRollup_Allocated_Field {
+	return BucketBandwidthRollup_Allocated_Field{_set: true, _value: v}
 }
 
-func (f CoinpaymentsTransaction_Key_Field) value() interface{} {
+func (f BucketBandwidthRollup_Allocated_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (CoinpaymentsTransaction_Key_Field) _Column() string { return "key" }
+func (BucketBandwidthRollup_Allocated_Field) _Column() string { return "allocated" }
 
-type CoinpaymentsTransaction_Timeout_Field struct {
+type BucketBandwidthRollup_Settled_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value uint64
 }
 
-func CoinpaymentsTransaction_Timeout(v int) CoinpaymentsTransaction_Timeout_Field {
-	return CoinpaymentsTransaction_Timeout_Field{_set: true, _value: v}
+func BucketBandwidthRollup_Settled(v uint64) BucketBandwidthRollup_Settled_Field {
+	return BucketBandwidthRollup_Settled_Field{_set: true, _value: v}
 }
 
-func (f CoinpaymentsTransaction_Timeout_Field) value() interface{} {
+func (f BucketBandwidthRollup_Settled_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (CoinpaymentsTransaction_Timeout_Field) _Column() string { return "timeout" }
+func (BucketBandwidthRollup_Settled_Field) _Column() string { return "settled" }
 
-type CoinpaymentsTransaction_CreatedAt_Field struct {
+type BucketBandwidthRollupArchive struct {
+	BucketName      []byte
+	ProjectId       []byte
+	IntervalStart   time.Time
+	IntervalSeconds uint
+	Action          uint
+	Inline          uint64
+	Allocated       uint64
+	Settled         uint64
+}
+
+func (BucketBandwidthRollupArchive) _Table() string { return "bucket_bandwidth_rollup_archives" }
+
+type BucketBandwidthRollupArchive_Update_Fields struct {
+	Inline    BucketBandwidthRollupArchive_Inline_Field
+	Allocated BucketBandwidthRollupArchive_Allocated_Field
+	Settled   BucketBandwidthRollupArchive_Settled_Field
+}
+
+type BucketBandwidthRollupArchive_BucketName_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value []byte
 }
 
-func CoinpaymentsTransaction_CreatedAt(v time.Time) CoinpaymentsTransaction_CreatedAt_Field {
-	return CoinpaymentsTransaction_CreatedAt_Field{_set: true, _value: v}
+func BucketBandwidthRollupArchive_BucketName(v []byte) BucketBandwidthRollupArchive_BucketName_Field {
+	return BucketBandwidthRollupArchive_BucketName_Field{_set: true, _value: v}
 }
 
-func (f CoinpaymentsTransaction_CreatedAt_Field) value() interface{} {
+func (f BucketBandwidthRollupArchive_BucketName_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (CoinpaymentsTransaction_CreatedAt_Field) _Column() string { return "created_at" }
-
-type GracefulExitProgress struct {
-	NodeId            []byte
-	BytesTransferred  int64
-	PiecesTransferred int64
-	PiecesFailed      int64
-	UpdatedAt         time.Time
-}
-
-func (GracefulExitProgress) _Table() string { return "graceful_exit_progress" }
-
-type GracefulExitProgress_Update_Fields struct {
-	BytesTransferred  GracefulExitProgress_BytesTransferred_Field
-	PiecesTransferred GracefulExitProgress_PiecesTransferred_Field
-	PiecesFailed      GracefulExitProgress_PiecesFailed_Field
-}
+func (BucketBandwidthRollupArchive_BucketName_Field) _Column() string { return "bucket_name" }
 
-type GracefulExitProgress_NodeId_Field struct {
+type BucketBandwidthRollupArchive_ProjectId_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func GracefulExitProgress_NodeId(v []byte) GracefulExitProgress_NodeId_Field {
-	return GracefulExitProgress_NodeId_Field{_set: true, _value: v}
+func BucketBandwidthRollupArchive_ProjectId(v []byte) BucketBandwidthRollupArchive_ProjectId_Field {
+	return BucketBandwidthRollupArchive_ProjectId_Field{_set: true, _value: v}
 }
 
-func (f GracefulExitProgress_NodeId_Field) value() interface{} {
+func (f BucketBandwidthRollupArchive_ProjectId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (GracefulExitProgress_NodeId_Field) _Column() string { return "node_id" }
+func (BucketBandwidthRollupArchive_ProjectId_Field) _Column() string { return "project_id" }
 
-type GracefulExitProgress_BytesTransferred_Field struct {
+type BucketBandwidthRollupArchive_IntervalStart_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value time.Time
 }
 
-func GracefulExitProgress_BytesTransferred(v int64) GracefulExitProgress_BytesTransferred_Field {
-	return GracefulExitProgress_BytesTransferred_Field{_set: true, _value: v}
+func BucketBandwidthRollupArchive_IntervalStart(v time.Time) BucketBandwidthRollupArchive_IntervalStart_Field {
+	return BucketBandwidthRollupArchive_IntervalStart_Field{_set: true, _value: v}
 }
 
-func (f GracefulExitProgress_BytesTransferred_Field) value() interface{} {
+func (f BucketBandwidthRollupArchive_IntervalStart_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (GracefulExitProgress_BytesTransferred_Field) _Column() string { return "bytes_transferred" }
+func (BucketBandwidthRollupArchive_IntervalStart_Field) _Column() string { return "interval_start" }
 
-type GracefulExitProgress_PiecesTransferred_Field struct {
+type BucketBandwidthRollupArchive_IntervalSeconds_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value uint
 }
 
-func GracefulExitProgress_PiecesTransferred(v int64) GracefulExitProgress_PiecesTransferred_Field {
-	return GracefulExitProgress_PiecesTransferred_Field{_set: true, _value: v}
+func BucketBandwidthRollupArchive_IntervalSeconds(v uint) BucketBandwidthRollupArchive_IntervalSeconds_Field {
+	return BucketBandwidthRollupArchive_IntervalSeconds_Field{_set: true, _value: v}
 }
 
-func (f GracefulExitProgress_PiecesTransferred_Field) value() interface{} {
+func (f BucketBandwidthRollupArchive_IntervalSeconds_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (GracefulExitProgress_PiecesTransferred_Field) _Column() string { return "pieces_transferred" }
+func (BucketBandwidthRollupArchive_IntervalSeconds_Field) _Column() string { return "interval_seconds" }
 
-type GracefulExitProgress_PiecesFailed_Field struct {
+type BucketBandwidthRollupArchive_Action_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value uint
 }
 
-func GracefulExitProgress_PiecesFailed(v int64) GracefulExitProgress_PiecesFailed_Field {
-	return GracefulExitProgress_PiecesFailed_Field{_set: true, _value: v}
+func BucketBandwidthRollupArchive_Action(v uint) BucketBandwidthRollupArchive_Action_Field {
+	return BucketBandwidthRollupArchive_Action_Field{_set: true, _value: v}
 }
 
-func (f GracefulExitProgress_PiecesFailed_Field) value() interface{} {
+func (f BucketBandwidthRollupArchive_Action_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (GracefulExitProgress_PiecesFailed_Field) _Column() string { return "pieces_failed" }
+func (BucketBandwidthRollupArchive_Action_Field) _Column() string { return "action" }
 
-type GracefulExitProgress_UpdatedAt_Field struct {
+type BucketBandwidthRollupArchive_Inline_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value uint64
 }
 
-func GracefulExitProgress_UpdatedAt(v time.Time) GracefulExitProgress_UpdatedAt_Field {
-	return GracefulExitProgress_UpdatedAt_Field{_set: true, _value: v}
+func BucketBandwidthRollupArchive_Inline(v uint64) BucketBandwidthRollupArchive_Inline_Field {
+	return BucketBandwidthRollupArchive_Inline_Field{_set: true, _value: v}
 }
 
-func (f GracefulExitProgress_UpdatedAt_Field) value() interface{} {
+func (f BucketBandwidthRollupArchive_Inline_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (GracefulExitProgress_UpdatedAt_Field) _Column() string { return "updated_at" }
+func (BucketBandwidthRollupArchive_Inline_Field) _Column() string { return "inline" }
 
-type GracefulExitSegmentTransfer struct {
-	NodeId              []byte
-	StreamId            []byte
-	Position            uint64
-	PieceNum            int
-	RootPieceId         []byte
-	DurabilityRatio     float64
-	QueuedAt            time.Time
-	RequestedAt         *time.Time
-	LastFailedAt        *time.Time
-	LastFailedCode      *int
-	FailedCount         *int
-	FinishedAt          *time.Time
-	OrderLimitSendCount int
+type BucketBandwidthRollupArchive_Allocated_Field struct {
+	_set   bool
+	_null  bool
+	_value uint64
 }
 
-func (GracefulExitSegmentTransfer) _Table() string { return "graceful_exit_segment_transfer_queue" }
-
-type GracefulExitSegmentTransfer_Create_Fields struct {
-	RootPieceId         GracefulExitSegmentTransfer_RootPieceId_Field
-	RequestedAt         GracefulExitSegmentTransfer_RequestedAt_Field
-	LastFailedAt        GracefulExitSegmentTransfer_LastFailedAt_Field
-	LastFailedCode      GracefulExitSegmentTransfer_LastFailedCode_Field
-	FailedCount         GracefulExitSegmentTransfer_FailedCount_Field
-	FinishedAt          GracefulExitSegmentTransfer_FinishedAt_Field
-	OrderLimitSendCount GracefulExitSegmentTransfer_OrderLimitSendCount_Field
+func BucketBandwidthRollupArchive_Allocated(v uint64) BucketBandwidthRollupArchive_Allocated_Field {
+	return BucketBandwidthRollupArchive_Allocated_Field{_set: true, _value: v}
 }
 
-type GracefulExitSegmentTransfer_Update_Fields struct {
-	DurabilityRatio     GracefulExitSegmentTransfer_DurabilityRatio_Field
-	RequestedAt         GracefulExitSegmentTransfer_RequestedAt_Field
-	LastFailedAt        GracefulExitSegmentTransfer_LastFailedAt_Field
-	LastFailedCode      GracefulExitSegmentTransfer_LastFailedCode_Field
-	FailedCount         GracefulExitSegmentTransfer_FailedCount_Field
-	FinishedAt          GracefulExitSegmentTransfer_FinishedAt_Field
-	OrderLimitSendCount GracefulExitSegmentTransfer_OrderLimitSendCount_Field
+func (f BucketBandwidthRollupArchive_Allocated_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
 }
 
-type GracefulExitSegmentTransfer_NodeId_Field struct {
+func (BucketBandwidthRollupArchive_Allocated_Field) _Column() string { return "allocated" }
+
+type BucketBandwidthRollupArchive_Settled_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value uint64
 }
 
-func GracefulExitSegmentTransfer_NodeId(v []byte) GracefulExitSegmentTransfer_NodeId_Field {
-	return GracefulExitSegmentTransfer_NodeId_Field{_set: true, _value: v}
+func BucketBandwidthRollupArchive_Settled(v uint64) BucketBandwidthRollupArchive_Settled_Field {
+	return BucketBandwidthRollupArchive_Settled_Field{_set: true, _value: v}
 }
 
-func (f GracefulExitSegmentTransfer_NodeId_Field) value() interface{} {
+func (f BucketBandwidthRollupArchive_Settled_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (GracefulExitSegmentTransfer_NodeId_Field) _Column() string { return "node_id" }
+func (BucketBandwidthRollupArchive_Settled_Field) _Column() string { return "settled" }
 
-type GracefulExitSegmentTransfer_StreamId_Field struct {
+type BucketInventoryConfiguration struct {
+	ProjectId     []byte
+	BucketName    []byte
+	Configuration []byte
+	LastRunAt     *time.Time
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+func (BucketInventoryConfiguration) _Table() string { return "bucket_inventory_configurations" }
+
+type BucketInventoryConfiguration_Create_Fields struct {
+	LastRunAt BucketInventoryConfiguration_LastRunAt_Field
+}
+
+type BucketInventoryConfiguration_Update_Fields struct {
+	Configuration BucketInventoryConfiguration_Configuration_Field
+	LastRunAt     BucketInventoryConfiguration_LastRunAt_Field
+}
+
+type BucketInventoryConfiguration_ProjectId_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func GracefulExitSegmentTransfer_StreamId(v []byte) GracefulExitSegmentTransfer_StreamId_Field {
-	return GracefulExitSegmentTransfer_StreamId_Field{_set: true, _value: v}
+func BucketInventoryConfiguration_ProjectId(v []byte) BucketInventoryConfiguration_ProjectId_Field {
+	return BucketInventoryConfiguration_ProjectId_Field{_set: true, _value: v}
 }
 
-func (f GracefulExitSegmentTransfer_StreamId_Field) value() interface{} {
+func (f BucketInventoryConfiguration_ProjectId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (GracefulExitSegmentTransfer_StreamId_Field) _Column() string { return "stream_id" }
+func (BucketInventoryConfiguration_ProjectId_Field) _Column() string { return "project_id" }
 
-type GracefulExitSegmentTransfer_Position_Field struct {
+type BucketInventoryConfiguration_BucketName_Field struct {
 	_set   bool
 	_null  bool
-	_value uint64
+	_value []byte
 }
 
-func GracefulExitSegmentTransfer_Position(v uint64) GracefulExitSegmentTransfer_Position_Field {
-	return GracefulExitSegmentTransfer_Position_Field{_set: true, _value: v}
+func BucketInventoryConfiguration_BucketName(v []byte) BucketInventoryConfiguration_BucketName_Field {
+	return BucketInventoryConfiguration_BucketName_Field{_set: true, _value: v}
 }
 
-func (f GracefulExitSegmentTransfer_Position_Field) value() interface{} {
+func (f BucketInventoryConfiguration_BucketName_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (GracefulExitSegmentTransfer_Position_Field) _Column() string { return "position" }
+func (BucketInventoryConfiguration_BucketName_Field) _Column() string { return "bucket_name" }
 
-type GracefulExitSegmentTransfer_PieceNum_Field struct {
+type BucketInventoryConfiguration_Configuration_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value []byte
 }
 
-func GracefulExitSegmentTransfer_PieceNum(v int) GracefulExitSegmentTransfer_PieceNum_Field {
-	return GracefulExitSegmentTransfer_PieceNum_Field{_set: true, _value: v}
+func BucketInventoryConfiguration_Configuration(v []byte) BucketInventoryConfiguration_Configuration_Field {
+	return BucketInventoryConfiguration_Configuration_Field{_set: true, _value: v}
 }
 
-func (f GracefulExitSegmentTransfer_PieceNum_Field) value() interface{} {
+func (f BucketInventoryConfiguration_Configuration_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (GracefulExitSegmentTransfer_PieceNum_Field) _Column() string { return "piece_num" }
+func (BucketInventoryConfiguration_Configuration_Field) _Column() string { return "configuration" }
 
-type GracefulExitSegmentTransfer_RootPieceId_Field struct {
+type BucketInventoryConfiguration_LastRunAt_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value *time.Time
 }
 
-func GracefulExitSegmentTransfer_RootPieceId(v []byte) GracefulExitSegmentTransfer_RootPieceId_Field {
-	return GracefulExitSegmentTransfer_RootPieceId_Field{_set: true, _value: v}
+func BucketInventoryConfiguration_LastRunAt(v time.Time) BucketInventoryConfiguration_LastRunAt_Field {
+	return BucketInventoryConfiguration_LastRunAt_Field{_set: true, _value: &v}
 }
 
-func GracefulExitSegmentTransfer_RootPieceId_Raw(v []byte) GracefulExitSegmentTransfer_RootPieceId_Field {
+func BucketInventoryConfiguration_LastRunAt_Raw(v *time.Time) BucketInventoryConfiguration_LastRunAt_Field {
 	if v == nil {
-		return GracefulExitSegmentTransfer_RootPieceId_Null()
+		return BucketInventoryConfiguration_LastRunAt_Null()
 	}
-	return GracefulExitSegmentTransfer_RootPieceId(v)
+	return BucketInventoryConfiguration_LastRunAt(*v)
 }
 
-func GracefulExitSegmentTransfer_RootPieceId_Null() GracefulExitSegmentTransfer_RootPieceId_Field {
-	return GracefulExitSegmentTransfer_RootPieceId_Field{_set: true, _null: true}
+func BucketInventoryConfiguration_LastRunAt_Null() BucketInventoryConfiguration_LastRunAt_Field {
+	return BucketInventoryConfiguration_LastRunAt_Field{_set: true, _null: true}
 }
 
-func (f GracefulExitSegmentTransfer_RootPieceId_Field) isnull() bool {
+func (f BucketInventoryConfiguration_LastRunAt_Field) isnull() bool {
 	return !f._set || f._null || f._value == nil
 }
 
-func (f GracefulExitSegmentTransfer_RootPieceId_Field) value() interface{} {
+func (f BucketInventoryConfiguration_LastRunAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (GracefulExitSegmentTransfer_RootPieceId_Field) _Column() string { return "root_piece_id" }
+func (BucketInventoryConfiguration_LastRunAt_Field) _Column() string { return "last_run_at" }
 
-type GracefulExitSegmentTransfer_DurabilityRatio_Field struct {
+type BucketInventoryConfiguration_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value float64
+	_value time.Time
 }
 
-func GracefulExitSegmentTransfer_DurabilityRatio(v float64) GracefulExitSegmentTransfer_DurabilityRatio_Field {
-	return GracefulExitSegmentTransfer_DurabilityRatio_Field{_set: true, _value: v}
+func BucketInventoryConfiguration_CreatedAt(v time.Time) BucketInventoryConfiguration_CreatedAt_Field {
+	return BucketInventoryConfiguration_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f GracefulExitSegmentTransfer_DurabilityRatio_Field) value() interface{} {
+func (f BucketInventoryConfiguration_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (GracefulExitSegmentTransfer_DurabilityRatio_Field) _Column() string { return "durability_ratio" }
+func (BucketInventoryConfiguration_CreatedAt_Field) _Column() string { return "created_at" }
 
-type GracefulExitSegmentTransfer_QueuedAt_Field struct {
+type BucketInventoryConfiguration_UpdatedAt_Field struct {
 	_set   bool
 	_null  bool
 	_value time.Time
 }
 
-func GracefulExitSegmentTransfer_QueuedAt(v time.Time) GracefulExitSegmentTransfer_QueuedAt_Field {
-	return GracefulExitSegmentTransfer_QueuedAt_Field{_set: true, _value: v}
+func BucketInventoryConfiguration_UpdatedAt(v time.Time) BucketInventoryConfiguration_UpdatedAt_Field {
+	return BucketInventoryConfiguration_UpdatedAt_Field{_set: true, _value: v}
 }
 
-func (f GracefulExitSegmentTransfer_QueuedAt_Field) value() interface{} {
+func (f BucketInventoryConfiguration_UpdatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (GracefulExitSegmentTransfer_QueuedAt_Field) _Column() string { return "queued_at" }
+func (BucketInventoryConfiguration_UpdatedAt_Field) _Column() string { return "updated_at" }
 
-type GracefulExitSegmentTransfer_RequestedAt_Field struct {
-	_set   bool
-	_null  bool
-	_value *time.Time
+type BucketLifecycleConfiguration struct {
+	ProjectId  []byte
+	BucketName []byte
+	Rules      []byte
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
 }
 
-func GracefulExitSegmentTransfer_RequestedAt(v time.Time) GracefulExitSegmentTransfer_RequestedAt_Field {
-	return GracefulExitSegmentTransfer_RequestedAt_Field{_set: true, _value: &v}
-}
+func (BucketLifecycleConfiguration) _Table() string { return "bucket_lifecycle_configurations" }
 
-func GracefulExitSegmentTransfer_RequestedAt_Raw(v *time.Time) GracefulExitSegmentTransfer_RequestedAt_Field {
-	if v == nil {
-		return GracefulExitSegmentTransfer_RequestedAt_Null()
-	}
-	return GracefulExitSegmentTransfer_RequestedAt(*v)
+type BucketLifecycleConfiguration_Update_Fields struct {
+	Rules BucketLifecycleConfiguration_Rules_Field
 }
 
-func GracefulExitSegmentTransfer_RequestedAt_Null() GracefulExitSegmentTransfer_RequestedAt_Field {
-	return GracefulExitSegmentTransfer_RequestedAt_Field{_set: true, _null: true}
+type BucketLifecycleConfiguration_ProjectId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
 }
 
-func (f GracefulExitSegmentTransfer_RequestedAt_Field) isnull() bool {
-	return !f._set || f._null || f._value == nil
+func BucketLifecycleConfiguration_ProjectId(v []byte) BucketLifecycleConfiguration_ProjectId_Field {
+	return BucketLifecycleConfiguration_ProjectId_Field{_set: true, _value: v}
 }
 
-func (f GracefulExitSegmentTransfer_RequestedAt_Field) value() interface{} {
+func (f BucketLifecycleConfiguration_ProjectId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (GracefulExitSegmentTransfer_RequestedAt_Field) _Column() string { return "requested_at" }
+func (BucketLifecycleConfiguration_ProjectId_Field) _Column() string { return "project_id" }
 
-type GracefulExitSegmentTransfer_LastFailedAt_Field struct {
+type BucketLifecycleConfiguration_BucketName_Field struct {
 	_set   bool
 	_null  bool
-	_value *time.Time
+	_value []byte
 }
 
-func GracefulExitSegmentTransfer_LastFailedAt(v time.Time) GracefulExitSegmentTransfer_LastFailedAt_Field {
-	return GracefulExitSegmentTransfer_LastFailedAt_Field{_set: true, _value: &v}
+func BucketLifecycleConfiguration_BucketName(v []byte) BucketLifecycleConfiguration_BucketName_Field {
+	return BucketLifecycleConfiguration_BucketName_Field{_set: true, _value: v}
 }
 
-func GracefulExitSegmentTransfer_LastFailedAt_Raw(v *time.Time) GracefulExitSegmentTransfer_LastFailedAt_Field {
-	if v == nil {
-		return GracefulExitSegmentTransfer_LastFailedAt_Null()
+func (f BucketLifecycleConfiguration_BucketName_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return GracefulExitSegmentTransfer_LastFailedAt(*v)
+	return f._value
 }
 
-func GracefulExitSegmentTransfer_LastFailedAt_Null() GracefulExitSegmentTransfer_LastFailedAt_Field {
-	return GracefulExitSegmentTransfer_LastFailedAt_Field{_set: true, _null: true}
+func (BucketLifecycleConfiguration_BucketName_Field) _Column() string { return "bucket_name" }
+
+type BucketLifecycleConfiguration_Rules_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
 }
 
-func (f GracefulExitSegmentTransfer_LastFailedAt_Field) isnull() bool {
-	return !f._set || f._null || f._value == nil
+func BucketLifecycleConfiguration_Rules(v []byte) BucketLifecycleConfiguration_Rules_Field {
+	return BucketLifecycleConfiguration_Rules_Field{_set: true, _value: v}
 }
 
-func (f GracefulExitSegmentTransfer_LastFailedAt_Field) value() interface{} {
+func (f BucketLifecycleConfiguration_Rules_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (GracefulExitSegmentTransfer_LastFailedAt_Field) _Column() string { return "last_failed_at" }
+func (BucketLifecycleConfiguration_Rules_Field) _Column() string { return "rules" }
 
-type GracefulExitSegmentTransfer_LastFailedCode_Field struct {
+type BucketLifecycleConfiguration_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value *int
+	_value time.Time
 }
 
-func GracefulExitSegmentTransfer_LastFailedCode(v int) GracefulExitSegmentTransfer_LastFailedCode_Field {
-	return GracefulExitSegmentTransfer_LastFailedCode_Field{_set: true, _value: &v}
+func BucketLifecycleConfiguration_CreatedAt(v time.Time) BucketLifecycleConfiguration_CreatedAt_Field {
+	return BucketLifecycleConfiguration_CreatedAt_Field{_set: true, _value: v}
 }
 
-func GracefulExitSegmentTransfer_LastFailedCode_Raw(v *int) GracefulExitSegmentTransfer_LastFailedCode_Field {
-	if v == nil {
-		return GracefulExitSegmentTransfer_LastFailedCode_Null()
+func (f BucketLifecycleConfiguration_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return GracefulExitSegmentTransfer_LastFailedCode(*v)
+	return f._value
 }
 
-func GracefulExitSegmentTransfer_LastFailedCode_Null() GracefulExitSegmentTransfer_LastFailedCode_Field {
-	return GracefulExitSegmentTransfer_LastFailedCode_Field{_set: true, _null: true}
+func (BucketLifecycleConfiguration_CreatedAt_Field) _Column() string { return "created_at" }
+
+type BucketLifecycleConfiguration_UpdatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
 }
 
-func (f GracefulExitSegmentTransfer_LastFailedCode_Field) isnull() bool {
-	return !f._set || f._null || f._value == nil
+func BucketLifecycleConfiguration_UpdatedAt(v time.Time) BucketLifecycleConfiguration_UpdatedAt_Field {
+	return BucketLifecycleConfiguration_UpdatedAt_Field{_set: true, _value: v}
 }
 
-func (f GracefulExitSegmentTransfer_LastFailedCode_Field) value() interface{} {
+func (f BucketLifecycleConfiguration_UpdatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (GracefulExitSegmentTransfer_LastFailedCode_Field) _Column() string { return "last_failed_code" }
+func (BucketLifecycleConfiguration_UpdatedAt_Field) _Column() string { return "updated_at" }
 
-type GracefulExitSegmentTransfer_FailedCount_Field struct {
-	_set   bool
-	_null  bool
-	_value *int
+type BucketStorageTally struct {
+	BucketName          []byte
+	ProjectId           []byte
+	IntervalStart       time.Time
+	TotalBytes          uint64
+	Inline              uint64
+	Remote              uint64
+	TotalSegmentsCount  uint
+	RemoteSegmentsCount uint
+	InlineSegmentsCount uint
+	ObjectCount         uint
+	MetadataSize        uint64
 }
 
-func GracefulExitSegmentTransfer_FailedCount(v int) GracefulExitSegmentTransfer_FailedCount_Field {
-	return GracefulExitSegmentTransfer_FailedCount_Field{_set: true, _value: &v}
+func (BucketStorageTally) _Table() string { return "bucket_storage_tallies" }
+
+type BucketStorageTally_Create_Fields struct {
+	TotalBytes         BucketStorageTally_TotalBytes_Field
+	TotalSegmentsCount BucketStorageTally_TotalSegmentsCount_Field
 }
 
-func GracefulExitSegmentTransfer_FailedCount_Raw(v *int) GracefulExitSegmentTransfer_FailedCount_Field {
-	if v == nil {
-		return GracefulExitSegmentTransfer_FailedCount_Null()
-	}
-	return GracefulExitSegmentTransfer_FailedCount(*v)
+type BucketStorageTally_Update_Fields struct {
 }
 
-func GracefulExitSegmentTransfer_FailedCount_Null() GracefulExitSegmentTransfer_FailedCount_Field {
-	return GracefulExitSegmentTransfer_FailedCount_Field{_set: true, _null: true}
+type BucketStorageTally_BucketName_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
 }
 
-func (f GracefulExitSegmentTransfer_FailedCount_Field) isnull() bool {
-	return !f._set || f._null || f._value == nil
+func BucketStorageTally_BucketName(v []byte) BucketStorageTally_BucketName_Field {
+	return BucketStorageTally_BucketName_Field{_set: true, _value: v}
 }
 
-func (f GracefulExitSegmentTransfer_FailedCount_Field) value() interface{} {
+func (f BucketStorageTally_BucketName_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (GracefulExitSegmentTransfer_FailedCount_Field) _Column() string { return "failed_count" }
+func (BucketStorageTally_BucketName_Field) _Column() string { return "bucket_name" }
 
-type GracefulExitSegmentTransfer_FinishedAt_Field struct {
+type BucketStorageTally_ProjectId_Field struct {
 	_set   bool
 	_null  bool
-	_value *time.Time
+	_value []byte
 }
 
-func GracefulExitSegmentTransfer_FinishedAt(v time.Time) GracefulExitSegmentTransfer_FinishedAt_Field {
-	return GracefulExitSegmentTransfer_FinishedAt_Field{_set: true, _value: &v}
+func BucketStorageTally_ProjectId(v []byte) BucketStorageTally_ProjectId_Field {
+	return BucketStorageTally_ProjectId_Field{_set: true, _value: v}
 }
 
-func GracefulExitSegmentTransfer_FinishedAt_Raw(v *time.Time) GracefulExitSegmentTransfer_FinishedAt_Field {
-	if v == nil {
-		return GracefulExitSegmentTransfer_FinishedAt_Null()
+func (f BucketStorageTally_ProjectId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return GracefulExitSegmentTransfer_FinishedAt(*v)
+	return f._value
 }
 
-func GracefulExitSegmentTransfer_FinishedAt_Null() GracefulExitSegmentTransfer_FinishedAt_Field {
-	return GracefulExitSegmentTransfer_FinishedAt_Field{_set: true, _null: true}
+func (BucketStorageTally_ProjectId_Field) _Column() string { return "project_id" }
+
+type BucketStorageTally_IntervalStart_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
 }
 
-func (f GracefulExitSegmentTransfer_FinishedAt_Field) isnull() bool {
-	return !f._set || f._null || f._value == nil
+func BucketStorageTally_IntervalStart(v time.Time) BucketStorageTally_IntervalStart_Field {
+	return BucketStorageTally_IntervalStart_Field{_set: true, _value: v}
 }
 
-func (f GracefulExitSegmentTransfer_FinishedAt_Field) value() interface{} {
+func (f BucketStorageTally_IntervalStart_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (GracefulExitSegmentTransfer_FinishedAt_Field) _Column() string { return "finished_at" }
+func (BucketStorageTally_IntervalStart_Field) _Column() string { return "interval_start" }
 
-type GracefulExitSegmentTransfer_OrderLimitSendCount_Field struct {
+type BucketStorageTally_TotalBytes_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value uint64
 }
 
-func GracefulExitSegmentTransfer_OrderLimitSendCount(v int) GracefulExitSegmentTransfer_OrderLimitSendCount_Field {
-	return GracefulExitSegmentTransfer_OrderLimitSendCount_Field{_set: true, _value: v}
+func BucketStorageTally_TotalBytes(v uint64) BucketStorageTally_TotalBytes_Field {
+	return BucketStorageTally_TotalBytes_Field{_set: true, _value: v}
 }
 
-func (f GracefulExitSegmentTransfer_OrderLimitSendCount_Field) value() interface{} {
+func (f BucketStorageTally_TotalBytes_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (GracefulExitSegmentTransfer_OrderLimitSendCount_Field) _Column() string {
-	return "order_limit_send_count"
+func (BucketStorageTally_TotalBytes_Field) _Column() string { return "total_bytes" }
+
+type BucketStorageTally_Inline_Field struct {
+	_set   bool
+	_null  bool
+	_value uint64
+}
+
+func BucketStorageTally_Inline(v uint64) BucketStorageTally_Inline_Field {
+	return BucketStorageTally_Inline_Field{_set: true, _value: v}
+}
+
+func (f BucketStorageTally_Inline_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketStorageTally_Inline_Field) _Column() string { return "inline" }
+
+type BucketStorageTally_Remote_Field struct {
+	_set   bool
+	_null  bool
+	_value uint64
+}
+
+func BucketStorageTally_Remote(v uint64) BucketStorageTally_Remote_Field {
+	return BucketStorageTally_Remote_Field{_set: true, _value: v}
+}
+
+func (f BucketStorageTally_Remote_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketStorageTally_Remote_Field) _Column() string { return "remote" }
+
+type BucketStorageTally_TotalSegmentsCount_Field struct {
+	_set   bool
+	_null  bool
+	_value uint
+}
+
+func BucketStorageTally_TotalSegmentsCount(v uint) BucketStorageTally_TotalSegmentsCount_Field {
+	return BucketStorageTally_TotalSegmentsCount_Field{_set: true, _value: v}
+}
+
+func (f BucketStorageTally_TotalSegmentsCount_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketStorageTally_TotalSegmentsCount_Field) _Column() string { return "total_segments_count" }
+
+type BucketStorageTally_RemoteSegmentsCount_Field struct {
+	_set   bool
+	_null  bool
+	_value uint
+}
+
+func BucketStorageTally_RemoteSegmentsCount(v uint) BucketStorageTally_RemoteSegmentsCount_Field {
+	return BucketStorageTally_RemoteSegmentsCount_Field{_set: true, _value: v}
+}
+
+func (f BucketStorageTally_RemoteSegmentsCount_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketStorageTally_RemoteSegmentsCount_Field) _Column() string { return "remote_segments_count" }
+
+type BucketStorageTally_InlineSegmentsCount_Field struct {
+	_set   bool
+	_null  bool
+	_value uint
+}
+
+func BucketStorageTally_InlineSegmentsCount(v uint) BucketStorageTally_InlineSegmentsCount_Field {
+	return BucketStorageTally_InlineSegmentsCount_Field{_set: true, _value: v}
+}
+
+func (f BucketStorageTally_InlineSegmentsCount_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketStorageTally_InlineSegmentsCount_Field) _Column() string { return "inline_segments_count" }
+
+type BucketStorageTally_ObjectCount_Field struct {
+	_set   bool
+	_null  bool
+	_value uint
+}
+
+func BucketStorageTally_ObjectCount(v uint) BucketStorageTally_ObjectCount_Field {
+	return BucketStorageTally_ObjectCount_Field{_set: true, _value: v}
+}
+
+func (f BucketStorageTally_ObjectCount_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketStorageTally_ObjectCount_Field) _Column() string { return "object_count" }
+
+type BucketStorageTally_MetadataSize_Field struct {
+	_set   bool
+	_null  bool
+	_value uint64
+}
+
+func BucketStorageTally_MetadataSize(v uint64) BucketStorageTally_MetadataSize_Field {
+	return BucketStorageTally_MetadataSize_Field{_set: true, _value: v}
+}
+
+func (f BucketStorageTally_MetadataSize_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketStorageTally_MetadataSize_Field) _Column() string { return "metadata_size" }
+
+type CoinpaymentsTransaction struct {
+	Id              string
+	UserId          []byte
+	Address         string
+	AmountNumeric   int64
+	ReceivedNumeric int64
+	Status          int
+	Key             string
+	Timeout         int
+	CreatedAt       time.Time
+}
+
+func (CoinpaymentsTransaction) _Table() string { return "coinpayments_transactions" }
+
+type CoinpaymentsTransaction_Update_Fields struct {
+	ReceivedNumeric CoinpaymentsTransaction_ReceivedNumeric_Field
+	Status          CoinpaymentsTransaction_Status_Field
+}
+
+type CoinpaymentsTransaction_Id_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func CoinpaymentsTransaction_Id(v string) CoinpaymentsTransaction_Id_Field {
+	return CoinpaymentsTransaction_Id_Field{_set: true, _value: v}
+}
+
+func (f CoinpaymentsTransaction_Id_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (CoinpaymentsTransaction_Id_Field) _Column() string { return "id" }
+
+type CoinpaymentsTransaction_UserId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func CoinpaymentsTransaction_UserId(v []byte) CoinpaymentsTransaction_UserId_Field {
+	return CoinpaymentsTransaction_UserId_Field{_set: true, _value: v}
+}
+
+func (f CoinpaymentsTransaction_UserId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (CoinpaymentsTransaction_UserId_Field) _Column() string { return "user_id" }
+
+type CoinpaymentsTransaction_Address_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func CoinpaymentsTransaction_Address(v string) CoinpaymentsTransaction_Address_Field {
+	return CoinpaymentsTransaction_Address_Field{_set: true, _value: v}
+}
+
+func (f CoinpaymentsTransaction_Address_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (CoinpaymentsTransaction_Address_Field) _Column() string { return "address" }
+
+type CoinpaymentsTransaction_AmountNumeric_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func CoinpaymentsTransaction_AmountNumeric(v int64) CoinpaymentsTransaction_AmountNumeric_Field {
+	return CoinpaymentsTransaction_AmountNumeric_Field{_set: true, _value: v}
+}
+
+func (f CoinpaymentsTransaction_AmountNumeric_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (CoinpaymentsTransaction_AmountNumeric_Field) _Column() string { return "amount_numeric" }
+
+type CoinpaymentsTransaction_ReceivedNumeric_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func CoinpaymentsTransaction_ReceivedNumeric(v int64) CoinpaymentsTransaction_ReceivedNumeric_Field {
+	return CoinpaymentsTransaction_ReceivedNumeric_Field{_set: true, _value: v}
+}
+
+func (f CoinpaymentsTransaction_ReceivedNumeric_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (CoinpaymentsTransaction_ReceivedNumeric_Field) _Column() string { return "received_numeric" }
+
+type CoinpaymentsTransaction_Status_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func CoinpaymentsTransaction_Status(v int) CoinpaymentsTransaction_Status_Field {
+	return CoinpaymentsTransaction_Status_Field{_set: true, _value: v}
+}
+
+func (f CoinpaymentsTransaction_Status_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (CoinpaymentsTransaction_Status_Field) _Column() string { return "status" }
+
+type CoinpaymentsTransaction_Key_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func CoinpaymentsTransaction_Key(v string) CoinpaymentsTransaction_Key_Field {
+	return CoinpaymentsTransaction_Key_Field{_set: true, _value: v}
+}
+
+func (f CoinpaymentsTransaction_Key_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (CoinpaymentsTransaction_Key_Field) _Column() string { return "key" }
+
+type CoinpaymentsTransaction_Timeout_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func CoinpaymentsTransaction_Timeout(v int) CoinpaymentsTransaction_Timeout_Field {
+	return CoinpaymentsTransaction_Timeout_Field{_set: true, _value: v}
+}
+
+func (f CoinpaymentsTransaction_Timeout_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (CoinpaymentsTransaction_Timeout_Field) _Column() string { return "timeout" }
+
+type CoinpaymentsTransaction_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func CoinpaymentsTransaction_CreatedAt(v time.Time) CoinpaymentsTransaction_CreatedAt_Field {
+	return CoinpaymentsTransaction_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f CoinpaymentsTransaction_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (CoinpaymentsTransaction_CreatedAt_Field) _Column() string { return "created_at" }
+
+type GracefulExitProgress struct {
+	NodeId            []byte
+	BytesTransferred  int64
+	PiecesTransferred int64
+	PiecesFailed      int64
+	UpdatedAt         time.Time
+}
+
+func (GracefulExitProgress) _Table() string { return "graceful_exit_progress" }
+
+type GracefulExitProgress_Update_Fields struct {
+	BytesTransferred  GracefulExitProgress_BytesTransferred_Field
+	PiecesTransferred GracefulExitProgress_PiecesTransferred_Field
+	PiecesFailed      GracefulExitProgress_PiecesFailed_Field
+}
+
+type GracefulExitProgress_NodeId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func GracefulExitProgress_NodeId(v []byte) GracefulExitProgress_NodeId_Field {
+	return GracefulExitProgress_NodeId_Field{_set: true, _value: v}
+}
+
+func (f GracefulExitProgress_NodeId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitProgress_NodeId_Field) _Column() string { return "node_id" }
+
+type GracefulExitProgress_BytesTransferred_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func GracefulExitProgress_BytesTransferred(v int64) GracefulExitProgress_BytesTransferred_Field {
+	return GracefulExitProgress_BytesTransferred_Field{_set: true, _value: v}
+}
+
+func (f GracefulExitProgress_BytesTransferred_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitProgress_BytesTransferred_Field) _Column() string { return "bytes_transferred" }
+
+type GracefulExitProgress_PiecesTransferred_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func GracefulExitProgress_PiecesTransferred(v int64) GracefulExitProgress_PiecesTransferred_Field {
+	return GracefulExitProgress_PiecesTransferred_Field{_set: true, _value: v}
+}
+
+func (f GracefulExitProgress_PiecesTransferred_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitProgress_PiecesTransferred_Field) _Column() string { return "pieces_transferred" }
+
+type GracefulExitProgress_PiecesFailed_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func GracefulExitProgress_PiecesFailed(v int64) GracefulExitProgress_PiecesFailed_Field {
+	return GracefulExitProgress_PiecesFailed_Field{_set: true, _value: v}
+}
+
+func (f GracefulExitProgress_PiecesFailed_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitProgress_PiecesFailed_Field) _Column() string { return "pieces_failed" }
+
+type GracefulExitProgress_UpdatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func GracefulExitProgress_UpdatedAt(v time.Time) GracefulExitProgress_UpdatedAt_Field {
+	return GracefulExitProgress_UpdatedAt_Field{_set: true, _value: v}
+}
+
+func (f GracefulExitProgress_UpdatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitProgress_UpdatedAt_Field) _Column() string { return "updated_at" }
+
+type GracefulExitSegmentTransfer struct {
+	NodeId              []byte
+	StreamId            []byte
+	Position            uint64
+	PieceNum            int
+	RootPieceId         []byte
+	DurabilityRatio     float64
+	QueuedAt            time.Time
+	RequestedAt         *time.Time
+	LastFailedAt        *time.Time
+	LastFailedCode      *int
+	FailedCount         *int
+	FinishedAt          *time.Time
+	OrderLimitSendCount int
+}
+
+func (GracefulExitSegmentTransfer) _Table() string { return "graceful_exit_segment_transfer_queue" }
+
+type GracefulExitSegmentTransfer_Create_Fields struct {
+	RootPieceId         GracefulExitSegmentTransfer_RootPieceId_Field
+	RequestedAt         GracefulExitSegmentTransfer_RequestedAt_Field
+	LastFailedAt        GracefulExitSegmentTransfer_LastFailedAt_Field
+	LastFailedCode      GracefulExitSegmentTransfer_LastFailedCode_Field
+	FailedCount         GracefulExitSegmentTransfer_FailedCount_Field
+	FinishedAt          GracefulExitSegmentTransfer_FinishedAt_Field
+	OrderLimitSendCount GracefulExitSegmentTransfer_OrderLimitSendCount_Field
+}
+
+type GracefulExitSegmentTransfer_Update_Fields struct {
+	DurabilityRatio     GracefulExitSegmentTransfer_DurabilityRatio_Field
+	RequestedAt         GracefulExitSegmentTransfer_RequestedAt_Field
+	LastFailedAt        GracefulExitSegmentTransfer_LastFailedAt_Field
+	LastFailedCode      GracefulExitSegmentTransfer_LastFailedCode_Field
+	FailedCount         GracefulExitSegmentTransfer_FailedCount_Field
+	FinishedAt          GracefulExitSegmentTransfer_FinishedAt_Field
+	OrderLimitSendCount GracefulExitSegmentTransfer_OrderLimitSendCount_Field
+}
+
+type GracefulExitSegmentTransfer_NodeId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func GracefulExitSegmentTransfer_NodeId(v []byte) GracefulExitSegmentTransfer_NodeId_Field {
+	return GracefulExitSegmentTransfer_NodeId_Field{_set: true, _value: v}
+}
+
+func (f GracefulExitSegmentTransfer_NodeId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitSegmentTransfer_NodeId_Field) _Column() string { return "node_id" }
+
+type GracefulExitSegmentTransfer_StreamId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func GracefulExitSegmentTransfer_StreamId(v []byte) GracefulExitSegmentTransfer_StreamId_Field {
+	return GracefulExitSegmentTransfer_StreamId_Field{_set: true, _value: v}
+}
+
+func (f GracefulExitSegmentTransfer_StreamId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitSegmentTransfer_StreamId_Field) _Column() string { return "stream_id" }
+
+type GracefulExitSegmentTransfer_Position_Field struct {
+	_set   bool
+	_null  bool
+	_value uint64
+}
+
+func GracefulExitSegmentTransfer_Position(v uint64) GracefulExitSegmentTransfer_Position_Field {
+	return GracefulExitSegmentTransfer_Position_Field{_set: true, _value: v}
+}
+
+func (f GracefulExitSegmentTransfer_Position_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitSegmentTransfer_Position_Field) _Column() string { return "position" }
+
+type GracefulExitSegmentTransfer_PieceNum_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func GracefulExitSegmentTransfer_PieceNum(v int) GracefulExitSegmentTransfer_PieceNum_Field {
+	return GracefulExitSegmentTransfer_PieceNum_Field{_set: true, _value: v}
+}
+
+func (f GracefulExitSegmentTransfer_PieceNum_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitSegmentTransfer_PieceNum_Field) _Column() string { return "piece_num" }
+
+type GracefulExitSegmentTransfer_RootPieceId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func GracefulExitSegmentTransfer_RootPieceId(v []byte) GracefulExitSegmentTransfer_RootPieceId_Field {
+	return GracefulExitSegmentTransfer_RootPieceId_Field{_set: true, _value: v}
+}
+
+func GracefulExitSegmentTransfer_RootPieceId_Raw(v []byte) GracefulExitSegmentTransfer_RootPieceId_Field {
+	if v == nil {
+		return GracefulExitSegmentTransfer_RootPieceId_Null()
+	}
+	return GracefulExitSegmentTransfer_RootPieceId(v)
+}
+
+func GracefulExitSegmentTransfer_RootPieceId_Null() GracefulExitSegmentTransfer_RootPieceId_Field {
+	return GracefulExitSegmentTransfer_RootPieceId_Field{_set: true, _null: true}
+}
+
+func (f GracefulExitSegmentTransfer_RootPieceId_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f GracefulExitSegmentTransfer_RootPieceId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitSegmentTransfer_RootPieceId_Field) _Column() string { return "root_piece_id" }
+
+type GracefulExitSegmentTransfer_DurabilityRatio_Field struct {
+	_set   bool
+	_null  bool
+	_value float64
+}
+
+func GracefulExitSegmentTransfer_DurabilityRatio(v float64) GracefulExitSegmentTransfer_DurabilityRatio_Field {
+	return GracefulExitSegmentTransfer_DurabilityRatio_Field{_set: true, _value: v}
+}
+
+func (f GracefulExitSegmentTransfer_DurabilityRatio_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitSegmentTransfer_DurabilityRatio_Field) _Column() string { return "durability_ratio" }
+
+type GracefulExitSegmentTransfer_QueuedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func GracefulExitSegmentTransfer_QueuedAt(v time.Time) GracefulExitSegmentTransfer_QueuedAt_Field {
+	return GracefulExitSegmentTransfer_QueuedAt_Field{_set: true, _value: v}
+}
+
+func (f GracefulExitSegmentTransfer_QueuedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitSegmentTransfer_QueuedAt_Field) _Column() string { return "queued_at" }
+
+type GracefulExitSegmentTransfer_RequestedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func GracefulExitSegmentTransfer_RequestedAt(v time.Time) GracefulExitSegmentTransfer_RequestedAt_Field {
+	return GracefulExitSegmentTransfer_RequestedAt_Field{_set: true, _value: &v}
+}
+
+func GracefulExitSegmentTransfer_RequestedAt_Raw(v *time.Time) GracefulExitSegmentTransfer_RequestedAt_Field {
+	if v == nil {
+		return GracefulExitSegmentTransfer_RequestedAt_Null()
+	}
+	return GracefulExitSegmentTransfer_RequestedAt(*v)
+}
+
+func GracefulExitSegmentTransfer_RequestedAt_Null() GracefulExitSegmentTransfer_RequestedAt_Field {
+	return GracefulExitSegmentTransfer_RequestedAt_Field{_set: true, _null: true}
+}
+
+func (f GracefulExitSegmentTransfer_RequestedAt_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f GracefulExitSegmentTransfer_RequestedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitSegmentTransfer_RequestedAt_Field) _Column() string { return "requested_at" }
+
+type GracefulExitSegmentTransfer_LastFailedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func GracefulExitSegmentTransfer_LastFailedAt(v time.Time) GracefulExitSegmentTransfer_LastFailedAt_Field {
+	return GracefulExitSegmentTransfer_LastFailedAt_Field{_set: true, _value: &v}
+}
+
+func GracefulExitSegmentTransfer_LastFailedAt_Raw(v *time.Time) GracefulExitSegmentTransfer_LastFailedAt_Field {
+	if v == nil {
+		return GracefulExitSegmentTransfer_LastFailedAt_Null()
+	}
+	return GracefulExitSegmentTransfer_LastFailedAt(*v)
+}
+
+func GracefulExitSegmentTransfer_LastFailedAt_Null() GracefulExitSegmentTransfer_LastFailedAt_Field {
+	return GracefulExitSegmentTransfer_LastFailedAt_Field{_set: true, _null: true}
+}
+
+func (f GracefulExitSegmentTransfer_LastFailedAt_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f GracefulExitSegmentTransfer_LastFailedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitSegmentTransfer_LastFailedAt_Field) _Column() string { return "last_failed_at" }
+
+type GracefulExitSegmentTransfer_LastFailedCode_Field struct {
+	_set   bool
+	_null  bool
+	_value *int
+}
+
+func GracefulExitSegmentTransfer_LastFailedCode(v int) GracefulExitSegmentTransfer_LastFailedCode_Field {
+	return GracefulExitSegmentTransfer_LastFailedCode_Field{_set: true, _value: &v}
+}
+
+func GracefulExitSegmentTransfer_LastFailedCode_Raw(v *int) GracefulExitSegmentTransfer_LastFailedCode_Field {
+	if v == nil {
+		return GracefulExitSegmentTransfer_LastFailedCode_Null()
+	}
+	return GracefulExitSegmentTransfer_LastFailedCode(*v)
+}
+
+func GracefulExitSegmentTransfer_LastFailedCode_Null() GracefulExitSegmentTransfer_LastFailedCode_Field {
+	return GracefulExitSegmentTransfer_LastFailedCode_Field{_set: true, _null: true}
+}
+
+func (f GracefulExitSegmentTransfer_LastFailedCode_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f GracefulExitSegmentTransfer_LastFailedCode_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitSegmentTransfer_LastFailedCode_Field) _Column() string { return "last_failed_code" }
+
+type GracefulExitSegmentTransfer_FailedCount_Field struct {
+	_set   bool
+	_null  bool
+	_value *int
+}
+
+func GracefulExitSegmentTransfer_FailedCount(v int) GracefulExitSegmentTransfer_FailedCount_Field {
+	return GracefulExitSegmentTransfer_FailedCount_Field{_set: true, _value: &v}
+}
+
+func GracefulExitSegmentTransfer_FailedCount_Raw(v *int) GracefulExitSegmentTransfer_FailedCount_Field {
+	if v == nil {
+		return GracefulExitSegmentTransfer_FailedCount_Null()
+	}
+	return GracefulExitSegmentTransfer_FailedCount(*v)
+}
+
+func GracefulExitSegmentTransfer_FailedCount_Null() GracefulExitSegmentTransfer_FailedCount_Field {
+	return GracefulExitSegmentTransfer_FailedCount_Field{_set: true, _null: true}
+}
+
+func (f GracefulExitSegmentTransfer_FailedCount_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f GracefulExitSegmentTransfer_FailedCount_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitSegmentTransfer_FailedCount_Field) _Column() string { return "failed_count" }
+
+type GracefulExitSegmentTransfer_FinishedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func GracefulExitSegmentTransfer_FinishedAt(v time.Time) GracefulExitSegmentTransfer_FinishedAt_Field {
+	return GracefulExitSegmentTransfer_FinishedAt_Field{_set: true, _value: &v}
+}
+
+func GracefulExitSegmentTransfer_FinishedAt_Raw(v *time.Time) GracefulExitSegmentTransfer_FinishedAt_Field {
+	if v == nil {
+		return GracefulExitSegmentTransfer_FinishedAt_Null()
+	}
+	return GracefulExitSegmentTransfer_FinishedAt(*v)
+}
+
+func GracefulExitSegmentTransfer_FinishedAt_Null() GracefulExitSegmentTransfer_FinishedAt_Field {
+	return GracefulExitSegmentTransfer_FinishedAt_Field{_set: true, _null: true}
+}
+
+func (f GracefulExitSegmentTransfer_FinishedAt_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f GracefulExitSegmentTransfer_FinishedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitSegmentTransfer_FinishedAt_Field) _Column() string { return "finished_at" }
+
+type GracefulExitSegmentTransfer_OrderLimitSendCount_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func GracefulExitSegmentTransfer_OrderLimitSendCount(v int) GracefulExitSegmentTransfer_OrderLimitSendCount_Field {
+	return GracefulExitSegmentTransfer_OrderLimitSendCount_Field{_set: true, _value: v}
+}
+
+func (f GracefulExitSegmentTransfer_OrderLimitSendCount_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitSegmentTransfer_OrderLimitSendCount_Field) _Column() string {
+	return "order_limit_send_count"
+}
+
+type LinksharingBranding struct {
+	ProjectId          []byte
+	LogoUrl            string
+	PrimaryColor       string
+	Footer             string
+	DownloadDisclaimer string
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
+}
+
+func (LinksharingBranding) _Table() string { return "linksharing_brandings" }
+
+type LinksharingBranding_Update_Fields struct {
+	LogoUrl            LinksharingBranding_LogoUrl_Field
+	PrimaryColor       LinksharingBranding_PrimaryColor_Field
+	Footer             LinksharingBranding_Footer_Field
+	DownloadDisclaimer LinksharingBranding_DownloadDisclaimer_Field
+}
+
+type LinksharingBranding_ProjectId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func LinksharingBranding_ProjectId(v []byte) LinksharingBranding_ProjectId_Field {
+	return LinksharingBranding_ProjectId_Field{_set: true, _value: v}
+}
+
+func (f LinksharingBranding_ProjectId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (LinksharingBranding_ProjectId_Field) _Column() string { return "project_id" }
+
+type LinksharingBranding_LogoUrl_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func LinksharingBranding_LogoUrl(v string) LinksharingBranding_LogoUrl_Field {
+	return LinksharingBranding_LogoUrl_Field{_set: true, _value: v}
+}
+
+func (f LinksharingBranding_LogoUrl_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (LinksharingBranding_LogoUrl_Field) _Column() string { return "logo_url" }
+
+type LinksharingBranding_PrimaryColor_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func LinksharingBranding_PrimaryColor(v string) LinksharingBranding_PrimaryColor_Field {
+	return LinksharingBranding_PrimaryColor_Field{_set: true, _value: v}
+}
+
+func (f LinksharingBranding_PrimaryColor_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (LinksharingBranding_PrimaryColor_Field) _Column() string { return "primary_color" }
+
+type LinksharingBranding_Footer_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func LinksharingBranding_Footer(v string) LinksharingBranding_Footer_Field {
+	return LinksharingBranding_Footer_Field{_set: true, _value: v}
+}
+
+func (f LinksharingBranding_Footer_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (LinksharingBranding_Footer_Field) _Column() string { return "footer" }
+
+type LinksharingBranding_DownloadDisclaimer_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func LinksharingBranding_DownloadDisclaimer(v string) LinksharingBranding_DownloadDisclaimer_Field {
+	return LinksharingBranding_DownloadDisclaimer_Field{_set: true, _value: v}
+}
+
+func (f LinksharingBranding_DownloadDisclaimer_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (LinksharingBranding_DownloadDisclaimer_Field) _Column() string { return "download_disclaimer" }
+
+type LinksharingBranding_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func LinksharingBranding_CreatedAt(v time.Time) LinksharingBranding_CreatedAt_Field {
+	return LinksharingBranding_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f LinksharingBranding_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (LinksharingBranding_CreatedAt_Field) _Column() string { return "created_at" }
+
+type LinksharingBranding_UpdatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func LinksharingBranding_UpdatedAt(v time.Time) LinksharingBranding_UpdatedAt_Field {
+	return LinksharingBranding_UpdatedAt_Field{_set: true, _value: v}
+}
+
+func (f LinksharingBranding_UpdatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (LinksharingBranding_UpdatedAt_Field) _Column() string { return "updated_at" }
+
+type MaintenanceWindow struct {
+	Id         []byte
+	StartsAt   time.Time
+	EndsAt     time.Time
+	Components int
+	Message    string
+	CreatedBy  string
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+func (MaintenanceWindow) _Table() string { return "maintenance_windows" }
+
+type MaintenanceWindow_Update_Fields struct {
+	StartsAt   MaintenanceWindow_StartsAt_Field
+	EndsAt     MaintenanceWindow_EndsAt_Field
+	Components MaintenanceWindow_Components_Field
+	Message    MaintenanceWindow_Message_Field
+}
+
+type MaintenanceWindow_Id_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func MaintenanceWindow_Id(v []byte) MaintenanceWindow_Id_Field {
+	return MaintenanceWindow_Id_Field{_set: true, _value: v}
+}
+
+func (f MaintenanceWindow_Id_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (MaintenanceWindow_Id_Field) _Column() string { return "id" }
+
+type MaintenanceWindow_StartsAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func MaintenanceWindow_StartsAt(v time.Time) MaintenanceWindow_StartsAt_Field {
+	return MaintenanceWindow_StartsAt_Field{_set: true, _value: v}
+}
+
+func (f MaintenanceWindow_StartsAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (MaintenanceWindow_StartsAt_Field) _Column() string { return "starts_at" }
+
+type MaintenanceWindow_EndsAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func MaintenanceWindow_EndsAt(v time.Time) MaintenanceWindow_EndsAt_Field {
+	return MaintenanceWindow_EndsAt_Field{_set: true, _value: v}
+}
+
+func (f MaintenanceWindow_EndsAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (MaintenanceWindow_EndsAt_Field) _Column() string { return "ends_at" }
+
+type MaintenanceWindow_Components_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func MaintenanceWindow_Components(v int) MaintenanceWindow_Components_Field {
+	return MaintenanceWindow_Components_Field{_set: true, _value: v}
+}
+
+func (f MaintenanceWindow_Components_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (MaintenanceWindow_Components_Field) _Column() string { return "components" }
+
+type MaintenanceWindow_Message_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func MaintenanceWindow_Message(v string) MaintenanceWindow_Message_Field {
+	return MaintenanceWindow_Message_Field{_set: true, _value: v}
+}
+
+func (f MaintenanceWindow_Message_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (MaintenanceWindow_Message_Field) _Column() string { return "message" }
+
+type MaintenanceWindow_CreatedBy_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func MaintenanceWindow_CreatedBy(v string) MaintenanceWindow_CreatedBy_Field {
+	return MaintenanceWindow_CreatedBy_Field{_set: true, _value: v}
+}
+
+func (f MaintenanceWindow_CreatedBy_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (MaintenanceWindow_CreatedBy_Field) _Column() string { return "created_by" }
+
+type MaintenanceWindow_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func MaintenanceWindow_CreatedAt(v time.Time) MaintenanceWindow_CreatedAt_Field {
+	return MaintenanceWindow_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f MaintenanceWindow_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (MaintenanceWindow_CreatedAt_Field) _Column() string { return "created_at" }
+
+type MaintenanceWindow_UpdatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func MaintenanceWindow_UpdatedAt(v time.Time) MaintenanceWindow_UpdatedAt_Field {
+	return MaintenanceWindow_UpdatedAt_Field{_set: true, _value: v}
+}
+
+func (f MaintenanceWindow_UpdatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (MaintenanceWindow_UpdatedAt_Field) _Column() string { return "updated_at" }
+
+type Node struct {
+	Id                      []byte
+	Address                 string
+	LastNet                 string
+	LastIpPort              *string
+	CountryCode             *string
+	Protocol                int
+	Email                   string
+	Wallet                  string
+	WalletFeatures          string
+	FreeDisk                int64
+	PieceCount              int64
+	Major                   int64
+	Minor                   int64
+	Patch                   int64
+	Hash                    string
+	Timestamp               time.Time
+	Release                 bool
+	Latency90               int64
+	VettedAt                *time.Time
+	CreatedAt               time.Time
+	UpdatedAt               time.Time
+	LastContactSuccess      time.Time
+	LastContactFailure      time.Time
+	Disqualified            *time.Time
+	DisqualificationReason  *int
+	UnknownAuditSuspended   *time.Time
+	OfflineSuspended        *time.Time
+	UnderReview             *time.Time
+	ExitInitiatedAt         *time.Time
+	ExitLoopCompletedAt     *time.Time
+	ExitFinishedAt          *time.Time
+	ExitSuccess             bool
+	Contained               *time.Time
+	LastOfflineEmail        *time.Time
+	LastSoftwareUpdateEmail *time.Time
+	NoiseProto              *int
+	NoisePublicKey          []byte
+	DebounceLimit           int
+	Features                int
+}
+
+func (Node) _Table() string { return "nodes" }
+
+type Node_Create_Fields struct {
+	Address                 Node_Address_Field
+	LastIpPort              Node_LastIpPort_Field
+	CountryCode             Node_CountryCode_Field
+	Protocol                Node_Protocol_Field
+	WalletFeatures          Node_WalletFeatures_Field
+	FreeDisk                Node_FreeDisk_Field
+	Major                   Node_Major_Field
+	Minor                   Node_Minor_Field
+	Patch                   Node_Patch_Field
+	Hash                    Node_Hash_Field
+	Timestamp               Node_Timestamp_Field
+	Release                 Node_Release_Field
+	Latency90               Node_Latency90_Field
+	VettedAt                Node_VettedAt_Field
+	LastContactSuccess      Node_LastContactSuccess_Field
+	LastContactFailure      Node_LastContactFailure_Field
+	Disqualified            Node_Disqualified_Field
+	DisqualificationReason  Node_DisqualificationReason_Field
+	UnknownAuditSuspended   Node_UnknownAuditSuspended_Field
+	OfflineSuspended        Node_OfflineSuspended_Field
+	UnderReview             Node_UnderReview_Field
+	ExitInitiatedAt         Node_ExitInitiatedAt_Field
+	ExitLoopCompletedAt     Node_ExitLoopCompletedAt_Field
+	ExitFinishedAt          Node_ExitFinishedAt_Field
+	ExitSuccess             Node_ExitSuccess_Field
+	Contained               Node_Contained_Field
+	LastOfflineEmail        Node_LastOfflineEmail_Field
+	LastSoftwareUpdateEmail Node_LastSoftwareUpdateEmail_Field
+	NoiseProto              Node_NoiseProto_Field
+	NoisePublicKey          Node_NoisePublicKey_Field
+	DebounceLimit           Node_DebounceLimit_Field
+	Features                Node_Features_Field
+}
+
+type Node_Update_Fields struct {
+	Address                 Node_Address_Field
+	LastNet                 Node_LastNet_Field
+	LastIpPort              Node_LastIpPort_Field
+	CountryCode             Node_CountryCode_Field
+	Protocol                Node_Protocol_Field
+	Email                   Node_Email_Field
+	Wallet                  Node_Wallet_Field
+	WalletFeatures          Node_WalletFeatures_Field
+	FreeDisk                Node_FreeDisk_Field
+	PieceCount              Node_PieceCount_Field
+	Major                   Node_Major_Field
+	Minor                   Node_Minor_Field
+	Patch                   Node_Patch_Field
+	Hash                    Node_Hash_Field
+	Timestamp               Node_Timestamp_Field
+	Release                 Node_Release_Field
+	Latency90               Node_Latency90_Field
+	VettedAt                Node_VettedAt_Field
+	LastContactSuccess      Node_LastContactSuccess_Field
+	LastContactFailure      Node_LastContactFailure_Field
+	Disqualified            Node_Disqualified_Field
+	DisqualificationReason  Node_DisqualificationReason_Field
+	UnknownAuditSuspended   Node_UnknownAuditSuspended_Field
+	OfflineSuspended        Node_OfflineSuspended_Field
+	UnderReview             Node_UnderReview_Field
+	ExitInitiatedAt         Node_ExitInitiatedAt_Field
+	ExitLoopCompletedAt     Node_ExitLoopCompletedAt_Field
+	ExitFinishedAt          Node_ExitFinishedAt_Field
+	ExitSuccess             Node_ExitSuccess_Field
+	Contained               Node_Contained_Field
+	LastOfflineEmail        Node_LastOfflineEmail_Field
+	LastSoftwareUpdateEmail Node_LastSoftwareUpdateEmail_Field
+	NoiseProto              Node_NoiseProto_Field
+	NoisePublicKey          Node_NoisePublicKey_Field
+	DebounceLimit           Node_DebounceLimit_Field
+	Features                Node_Features_Field
+}
+
+type Node_Id_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func Node_Id(v []byte) Node_Id_Field {
+	return Node_Id_Field{_set: true, _value: v}
+}
+
+func (f Node_Id_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_Id_Field) _Column() string { return "id" }
+
+type Node_Address_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func Node_Address(v string) Node_Address_Field {
+	return Node_Address_Field{_set: true, _value: v}
+}
+
+func (f Node_Address_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_Address_Field) _Column() string { return "address" }
+
+type Node_LastNet_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func Node_LastNet(v string) Node_LastNet_Field {
+	return Node_LastNet_Field{_set: true, _value: v}
+}
+
+func (f Node_LastNet_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_LastNet_Field) _Column() string { return "last_net" }
+
+type Node_LastIpPort_Field struct {
+	_set   bool
+	_null  bool
+	_value *string
+}
+
+func Node_LastIpPort(v string) Node_LastIpPort_Field {
+	return Node_LastIpPort_Field{_set: true, _value: &v}
+}
+
+func Node_LastIpPort_Raw(v *string) Node_LastIpPort_Field {
+	if v == nil {
+		return Node_LastIpPort_Null()
+	}
+	return Node_LastIpPort(*v)
+}
+
+func Node_LastIpPort_Null() Node_LastIpPort_Field {
+	return Node_LastIpPort_Field{_set: true, _null: true}
+}
+
+func (f Node_LastIpPort_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Node_LastIpPort_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_LastIpPort_Field) _Column() string { return "last_ip_port" }
+
+type Node_CountryCode_Field struct {
+	_set   bool
+	_null  bool
+	_value *string
+}
+
+func Node_CountryCode(v string) Node_CountryCode_Field {
+	return Node_CountryCode_Field{_set: true, _value: &v}
+}
+
+func Node_CountryCode_Raw(v *string) Node_CountryCode_Field {
+	if v == nil {
+		return Node_CountryCode_Null()
+	}
+	return Node_CountryCode(*v)
+}
+
+func Node_CountryCode_Null() Node_CountryCode_Field {
+	return Node_CountryCode_Field{_set: true, _null: true}
+}
+
+func (f Node_CountryCode_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Node_CountryCode_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_CountryCode_Field) _Column() string { return "country_code" }
+
+type Node_Protocol_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func Node_Protocol(v int) Node_Protocol_Field {
+	return Node_Protocol_Field{_set: true, _value: v}
+}
+
+func (f Node_Protocol_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_Protocol_Field) _Column() string { return "protocol" }
+
+type Node_Email_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func Node_Email(v string) Node_Email_Field {
+	return Node_Email_Field{_set: true, _value: v}
+}
+
+func (f Node_Email_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_Email_Field) _Column() string { return "email" }
+
+type Node_Wallet_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func Node_Wallet(v string) Node_Wallet_Field {
+	return Node_Wallet_Field{_set: true, _value: v}
+}
+
+func (f Node_Wallet_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_Wallet_Field) _Column() string { return "wallet" }
+
+type Node_WalletFeatures_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func Node_WalletFeatures(v string) Node_WalletFeatures_Field {
+	return Node_WalletFeatures_Field{_set: true, _value: v}
+}
+
+func (f Node_WalletFeatures_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_WalletFeatures_Field) _Column() string { return "wallet_features" }
+
+type Node_FreeDisk_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func Node_FreeDisk(v int64) Node_FreeDisk_Field {
+	return Node_FreeDisk_Field{_set: true, _value: v}
+}
+
+func (f Node_FreeDisk_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_FreeDisk_Field) _Column() string { return "free_disk" }
+
+type Node_PieceCount_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func Node_PieceCount(v int64) Node_PieceCount_Field {
+	return Node_PieceCount_Field{_set: true, _value: v}
+}
+
+func (f Node_PieceCount_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_PieceCount_Field) _Column() string { return "piece_count" }
+
+type Node_Major_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func Node_Major(v int64) Node_Major_Field {
+	return Node_Major_Field{_set: true, _value: v}
+}
+
+func (f Node_Major_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_Major_Field) _Column() string { return "major" }
+
+type Node_Minor_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func Node_Minor(v int64) Node_Minor_Field {
+	return Node_Minor_Field{_set: true, _value: v}
+}
+
+func (f Node_Minor_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_Minor_Field) _Column() string { return "minor" }
+
+type Node_Patch_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func Node_Patch(v int64) Node_Patch_Field {
+	return Node_Patch_Field{_set: true, _value: v}
+}
+
+func (f Node_Patch_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_Patch_Field) _Column() string { return "patch" }
+
+type Node_Hash_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func Node_Hash(v string) Node_Hash_Field {
+	return Node_Hash_Field{_set: true, _value: v}
+}
+
+func (f Node_Hash_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_Hash_Field) _Column() string { return "hash" }
+
+type Node_Timestamp_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func Node_Timestamp(v time.Time) Node_Timestamp_Field {
+	return Node_Timestamp_Field{_set: true, _value: v}
+}
+
+func (f Node_Timestamp_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_Timestamp_Field) _Column() string { return "timestamp" }
+
+type Node_Release_Field struct {
+	_set   bool
+	_null  bool
+	_value bool
+}
+
+func Node_Release(v bool) Node_Release_Field {
+	return Node_Release_Field{_set: true, _value: v}
+}
+
+func (f Node_Release_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_Release_Field) _Column() string { return "release" }
+
+type Node_Latency90_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func Node_Latency90(v int64) Node_Latency90_Field {
+	return Node_Latency90_Field{_set: true, _value: v}
+}
+
+func (f Node_Latency90_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_Latency90_Field) _Column() string { return "latency_90" }
+
+type Node_VettedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func Node_VettedAt(v time.Time) Node_VettedAt_Field {
+	return Node_VettedAt_Field{_set: true, _value: &v}
+}
+
+func Node_VettedAt_Raw(v *time.Time) Node_VettedAt_Field {
+	if v == nil {
+		return Node_VettedAt_Null()
+	}
+	return Node_VettedAt(*v)
+}
+
+func Node_VettedAt_Null() Node_VettedAt_Field {
+	return Node_VettedAt_Field{_set: true, _null: true}
+}
+
+func (f Node_VettedAt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Node_VettedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_VettedAt_Field) _Column() string { return "vetted_at" }
+
+type Node_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func Node_CreatedAt(v time.Time) Node_CreatedAt_Field {
+	return Node_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f Node_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_CreatedAt_Field) _Column() string { return "created_at" }
+
+type Node_UpdatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func Node_UpdatedAt(v time.Time) Node_UpdatedAt_Field {
+	return Node_UpdatedAt_Field{_set: true, _value: v}
+}
+
+func (f Node_UpdatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_UpdatedAt_Field) _Column() string { return "updated_at" }
+
+type Node_LastContactSuccess_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func Node_LastContactSuccess(v time.Time) Node_LastContactSuccess_Field {
+	return Node_LastContactSuccess_Field{_set: true, _value: v}
+}
+
+func (f Node_LastContactSuccess_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_LastContactSuccess_Field) _Column() string { return "last_contact_success" }
+
+type Node_LastContactFailure_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func Node_LastContactFailure(v time.Time) Node_LastContactFailure_Field {
+	return Node_LastContactFailure_Field{_set: true, _value: v}
+}
+
+func (f Node_LastContactFailure_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_LastContactFailure_Field) _Column() string { return "last_contact_failure" }
+
+type Node_Disqualified_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func Node_Disqualified(v time.Time) Node_Disqualified_Field {
+	return Node_Disqualified_Field{_set: true, _value: &v}
+}
+
+func Node_Disqualified_Raw(v *time.Time) Node_Disqualified_Field {
+	if v == nil {
+		return Node_Disqualified_Null()
+	}
+	return Node_Disqualified(*v)
+}
+
+func Node_Disqualified_Null() Node_Disqualified_Field {
+	return Node_Disqualified_Field{_set: true, _null: true}
+}
+
+func (f Node_Disqualified_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Node_Disqualified_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_Disqualified_Field) _Column() string { return "disqualified" }
+
+type Node_DisqualificationReason_Field struct {
+	_set   bool
+	_null  bool
+	_value *int
+}
+
+func Node_DisqualificationReason(v int) Node_DisqualificationReason_Field {
+	return Node_DisqualificationReason_Field{_set: true, _value: &v}
+}
+
+func Node_DisqualificationReason_Raw(v *int) Node_DisqualificationReason_Field {
+	if v == nil {
+		return Node_DisqualificationReason_Null()
+	}
+	return Node_DisqualificationReason(*v)
+}
+
+func Node_DisqualificationReason_Null() Node_DisqualificationReason_Field {
+	return Node_DisqualificationReason_Field{_set: true, _null: true}
+}
+
+func (f Node_DisqualificationReason_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f Node_DisqualificationReason_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_DisqualificationReason_Field) _Column() string { return "disqualification_reason" }
+
+type Node_UnknownAuditSuspended_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func Node_UnknownAuditSuspended(v time.Time) Node_UnknownAuditSuspended_Field {
+	return Node_UnknownAuditSuspended_Field{_set: true, _value: &v}
+}
+
+func Node_UnknownAuditSuspended_Raw(v *time.Time) Node_UnknownAuditSuspended_Field {
+	if v == nil {
+		return Node_UnknownAuditSuspended_Null()
+	}
+	return Node_UnknownAuditSuspended(*v)
+}
+
+func Node_UnknownAuditSuspended_Null() Node_UnknownAuditSuspended_Field {
+	return Node_UnknownAuditSuspended_Field{_set: true, _null: true}
+}
+
+func (f Node_UnknownAuditSuspended_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Node_UnknownAuditSuspended_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_UnknownAuditSuspended_Field) _Column() string { return "unknown_audit_suspended" }
+
+type Node_OfflineSuspended_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func Node_OfflineSuspended(v time.Time) Node_OfflineSuspended_Field {
+	return Node_OfflineSuspended_Field{_set: true, _value: &v}
+}
+
+func Node_OfflineSuspended_Raw(v *time.Time) Node_OfflineSuspended_Field {
+	if v == nil {
+		return Node_OfflineSuspended_Null()
+	}
+	return Node_OfflineSuspended(*v)
+}
+
+func Node_OfflineSuspended_Null() Node_OfflineSuspended_Field {
+	return Node_OfflineSuspended_Field{_set: true, _null: true}
+}
+
+func (f Node_OfflineSuspended_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Node_OfflineSuspended_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_OfflineSuspended_Field) _Column() string { return "offline_suspended" }
+
+type Node_UnderReview_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func Node_UnderReview(v time.Time) Node_UnderReview_Field {
+	return Node_UnderReview_Field{_set: true, _value: &v}
+}
+
+func Node_UnderReview_Raw(v *time.Time) Node_UnderReview_Field {
+	if v == nil {
+		return Node_UnderReview_Null()
+	}
+	return Node_UnderReview(*v)
+}
+
+func Node_UnderReview_Null() Node_UnderReview_Field {
+	return Node_UnderReview_Field{_set: true, _null: true}
+}
+
+func (f Node_UnderReview_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Node_UnderReview_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_UnderReview_Field) _Column() string { return "under_review" }
+
+type Node_ExitInitiatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func Node_ExitInitiatedAt(v time.Time) Node_ExitInitiatedAt_Field {
+	return Node_ExitInitiatedAt_Field{_set: true, _value: &v}
+}
+
+func Node_ExitInitiatedAt_Raw(v *time.Time) Node_ExitInitiatedAt_Field {
+	if v == nil {
+		return Node_ExitInitiatedAt_Null()
+	}
+	return Node_ExitInitiatedAt(*v)
+}
+
+func Node_ExitInitiatedAt_Null() Node_ExitInitiatedAt_Field {
+	return Node_ExitInitiatedAt_Field{_set: true, _null: true}
+}
+
+func (f Node_ExitInitiatedAt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Node_ExitInitiatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_ExitInitiatedAt_Field) _Column() string { return "exit_initiated_at" }
+
+type Node_ExitLoopCompletedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func Node_ExitLoopCompletedAt(v time.Time) Node_ExitLoopCompletedAt_Field {
+	return Node_ExitLoopCompletedAt_Field{_set: true, _value: &v}
+}
+
+func Node_ExitLoopCompletedAt_Raw(v *time.Time) Node_ExitLoopCompletedAt_Field {
+	if v == nil {
+		return Node_ExitLoopCompletedAt_Null()
+	}
+	return Node_ExitLoopCompletedAt(*v)
+}
+
+func Node_ExitLoopCompletedAt_Null() Node_ExitLoopCompletedAt_Field {
+	return Node_ExitLoopCompletedAt_Field{_set: true, _null: true}
+}
+
+func (f Node_ExitLoopCompletedAt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Node_ExitLoopCompletedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_ExitLoopCompletedAt_Field) _Column() string { return "exit_loop_completed_at" }
+
+type Node_ExitFinishedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func Node_ExitFinishedAt(v time.Time) Node_ExitFinishedAt_Field {
+	return Node_ExitFinishedAt_Field{_set: true, _value: &v}
+}
+
+func Node_ExitFinishedAt_Raw(v *time.Time) Node_ExitFinishedAt_Field {
+	if v == nil {
+		return Node_ExitFinishedAt_Null()
+	}
+	return Node_ExitFinishedAt(*v)
+}
+
+func Node_ExitFinishedAt_Null() Node_ExitFinishedAt_Field {
+	return Node_ExitFinishedAt_Field{_set: true, _null: true}
+}
+
+func (f Node_ExitFinishedAt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Node_ExitFinishedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_ExitFinishedAt_Field) _Column() string { return "exit_finished_at" }
+
+type Node_ExitSuccess_Field struct {
+	_set   bool
+	_null  bool
+	_value bool
+}
+
+func Node_ExitSuccess(v bool) Node_ExitSuccess_Field {
+	return Node_ExitSuccess_Field{_set: true, _value: v}
+}
+
+func (f Node_ExitSuccess_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_ExitSuccess_Field) _Column() string { return "exit_success" }
+
+type Node_Contained_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func Node_Contained(v time.Time) Node_Contained_Field {
+	return Node_Contained_Field{_set: true, _value: &v}
+}
+
+func Node_Contained_Raw(v *time.Time) Node_Contained_Field {
+	if v == nil {
+		return Node_Contained_Null()
+	}
+	return Node_Contained(*v)
+}
+
+func Node_Contained_Null() Node_Contained_Field {
+	return Node_Contained_Field{_set: true, _null: true}
+}
+
+func (f Node_Contained_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Node_Contained_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_Contained_Field) _Column() string { return "contained" }
+
+type Node_LastOfflineEmail_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func Node_LastOfflineEmail(v time.Time) Node_LastOfflineEmail_Field {
+	return Node_LastOfflineEmail_Field{_set: true, _value: &v}
+}
+
+func Node_LastOfflineEmail_Raw(v *time.Time) Node_LastOfflineEmail_Field {
+	if v == nil {
+		return Node_LastOfflineEmail_Null()
+	}
+	return Node_LastOfflineEmail(*v)
+}
+
+func Node_LastOfflineEmail_Null() Node_LastOfflineEmail_Field {
+	return Node_LastOfflineEmail_Field{_set: true, _null: true}
+}
+
+func (f Node_LastOfflineEmail_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Node_LastOfflineEmail_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_LastOfflineEmail_Field) _Column() string { return "last_offline_email" }
+
+type Node_LastSoftwareUpdateEmail_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func Node_LastSoftwareUpdateEmail(v time.Time) Node_LastSoftwareUpdateEmail_Field {
+	return Node_LastSoftwareUpdateEmail_Field{_set: true, _value: &v}
+}
+
+func Node_LastSoftwareUpdateEmail_Raw(v *time.Time) Node_LastSoftwareUpdateEmail_Field {
+	if v == nil {
+		return Node_LastSoftwareUpdateEmail_Null()
+	}
+	return Node_LastSoftwareUpdateEmail(*v)
+}
+
+func Node_LastSoftwareUpdateEmail_Null() Node_LastSoftwareUpdateEmail_Field {
+	return Node_LastSoftwareUpdateEmail_Field{_set: true, _null: true}
+}
+
+func (f Node_LastSoftwareUpdateEmail_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f Node_LastSoftwareUpdateEmail_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_LastSoftwareUpdateEmail_Field) _Column() string { return "last_software_update_email" }
+
+type Node_NoiseProto_Field struct {
+	_set   bool
+	_null  bool
+	_value *int
+}
+
+func Node_NoiseProto(v int) Node_NoiseProto_Field {
+	return Node_NoiseProto_Field{_set: true, _value: &v}
+}
+
+func Node_NoiseProto_Raw(v *int) Node_NoiseProto_Field {
+	if v == nil {
+		return Node_NoiseProto_Null()
+	}
+	return Node_NoiseProto(*v)
+}
+
+func Node_NoiseProto_Null() Node_NoiseProto_Field {
+	return Node_NoiseProto_Field{_set: true, _null: true}
+}
+
+func (f Node_NoiseProto_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Node_NoiseProto_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_NoiseProto_Field) _Column() string { return "noise_proto" }
+
+type Node_NoisePublicKey_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func Node_NoisePublicKey(v []byte) Node_NoisePublicKey_Field {
+	return Node_NoisePublicKey_Field{_set: true, _value: v}
+}
+
+func Node_NoisePublicKey_Raw(v []byte) Node_NoisePublicKey_Field {
+	if v == nil {
+		return Node_NoisePublicKey_Null()
+	}
+	return Node_NoisePublicKey(v)
+}
+
+func Node_NoisePublicKey_Null() Node_NoisePublicKey_Field {
+	return Node_NoisePublicKey_Field{_set: true, _null: true}
+}
+
+func (f Node_NoisePublicKey_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Node_NoisePublicKey_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_NoisePublicKey_Field) _Column() string { return "noise_public_key" }
+
+type Node_DebounceLimit_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func Node_DebounceLimit(v int) Node_DebounceLimit_Field {
+	return Node_DebounceLimit_Field{_set: true, _value: v}
+}
+
+func (f Node_DebounceLimit_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_DebounceLimit_Field) _Column() string { return "debounce_limit" }
+
+type Node_Features_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func Node_Features(v int) Node_Features_Field {
+	return Node_Features_Field{_set: true, _value: v}
+}
+
+func (f Node_Features_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_Features_Field) _Column() string { return "features" }
+
+type NodeApiVersion struct {
+	Id         []byte
+	ApiVersion int
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+func (NodeApiVersion) _Table() string { return "node_api_versions" }
+
+type NodeApiVersion_Update_Fields struct {
+	ApiVersion NodeApiVersion_ApiVersion_Field
+}
+
+type NodeApiVersion_Id_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func NodeApiVersion_Id(v []byte) NodeApiVersion_Id_Field {
+	return NodeApiVersion_Id_Field{_set: true, _value: v}
+}
+
+func (f NodeApiVersion_Id_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (NodeApiVersion_Id_Field) _Column() string { return "id" }
+
+type NodeApiVersion_ApiVersion_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func NodeApiVersion_ApiVersion(v int) NodeApiVersion_ApiVersion_Field {
+	return NodeApiVersion_ApiVersion_Field{_set: true, _value: v}
+}
+
+func (f NodeApiVersion_ApiVersion_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (NodeApiVersion_ApiVersion_Field) _Column() string { return "api_version" }
+
+type NodeApiVersion_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func NodeApiVersion_CreatedAt(v time.Time) NodeApiVersion_CreatedAt_Field {
+	return NodeApiVersion_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f NodeApiVersion_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (NodeApiVersion_CreatedAt_Field) _Column() string { return "created_at" }
+
+type NodeApiVersion_UpdatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func NodeApiVersion_UpdatedAt(v time.Time) NodeApiVersion_UpdatedAt_Field {
+	return NodeApiVersion_UpdatedAt_Field{_set: true, _value: v}
+}
+
+func (f NodeApiVersion_UpdatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (NodeApiVersion_UpdatedAt_Field) _Column() string { return "updated_at" }
+
+type NodeEvent struct {
+	Id            []byte
+	Email         string
+	LastIpPort    *string
+	NodeId        []byte
+	Event         int
+	CreatedAt     time.Time
+	LastAttempted *time.Time
+	EmailSent     *time.Time
+}
+
+func (NodeEvent) _Table() string { return "node_events" }
+
+type NodeEvent_Create_Fields struct {
+	LastIpPort    NodeEvent_LastIpPort_Field
+	CreatedAt     NodeEvent_CreatedAt_Field
+	LastAttempted NodeEvent_LastAttempted_Field
+	EmailSent     NodeEvent_EmailSent_Field
+}
+
+type NodeEvent_Update_Fields struct {
+	LastAttempted NodeEvent_LastAttempted_Field
+	EmailSent     NodeEvent_EmailSent_Field
+}
+
+type NodeEvent_Id_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func NodeEvent_Id(v []byte) NodeEvent_Id_Field {
+	return NodeEvent_Id_Field{_set: true, _value: v}
+}
+
+func (f NodeEvent_Id_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (NodeEvent_Id_Field) _Column() string { return "id" }
+
+type NodeEvent_Email_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func NodeEvent_Email(v string) NodeEvent_Email_Field {
+	return NodeEvent_Email_Field{_set: true, _value: v}
+}
+
+func (f NodeEvent_Email_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (NodeEvent_Email_Field) _Column() string { return "email" }
+
+type NodeEvent_LastIpPort_Field struct {
+	_set   bool
+	_null  bool
+	_value *string
+}
+
+func NodeEvent_LastIpPort(v string) NodeEvent_LastIpPort_Field {
+	return NodeEvent_LastIpPort_Field{_set: true, _value: &v}
+}
+
+func NodeEvent_LastIpPort_Raw(v *string) NodeEvent_LastIpPort_Field {
+	if v == nil {
+		return NodeEvent_LastIpPort_Null()
+	}
+	return NodeEvent_LastIpPort(*v)
+}
+
+func NodeEvent_LastIpPort_Null() NodeEvent_LastIpPort_Field {
+	return NodeEvent_LastIpPort_Field{_set: true, _null: true}
+}
+
+func (f NodeEvent_LastIpPort_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f NodeEvent_LastIpPort_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (NodeEvent_LastIpPort_Field) _Column() string { return "last_ip_port" }
+
+type NodeEvent_NodeId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func NodeEvent_NodeId(v []byte) NodeEvent_NodeId_Field {
+	return NodeEvent_NodeId_Field{_set: true, _value: v}
+}
+
+func (f NodeEvent_NodeId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (NodeEvent_NodeId_Field) _Column() string { return "node_id" }
+
+type NodeEvent_Event_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func NodeEvent_Event(v int) NodeEvent_Event_Field {
+	return NodeEvent_Event_Field{_set: true, _value: v}
+}
+
+func (f NodeEvent_Event_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (NodeEvent_Event_Field) _Column() string { return "event" }
+
+type NodeEvent_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func NodeEvent_CreatedAt(v time.Time) NodeEvent_CreatedAt_Field {
+	return NodeEvent_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f NodeEvent_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (NodeEvent_CreatedAt_Field) _Column() string { return "created_at" }
+
+type NodeEvent_LastAttempted_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func NodeEvent_LastAttempted(v time.Time) NodeEvent_LastAttempted_Field {
+	return NodeEvent_LastAttempted_Field{_set: true, _value: &v}
+}
+
+func NodeEvent_LastAttempted_Raw(v *time.Time) NodeEvent_LastAttempted_Field {
+	if v == nil {
+		return NodeEvent_LastAttempted_Null()
+	}
+	return NodeEvent_LastAttempted(*v)
+}
+
+func NodeEvent_LastAttempted_Null() NodeEvent_LastAttempted_Field {
+	return NodeEvent_LastAttempted_Field{_set: true, _null: true}
+}
+
+func (f NodeEvent_LastAttempted_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f NodeEvent_LastAttempted_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (NodeEvent_LastAttempted_Field) _Column() string { return "last_attempted" }
+
+type NodeEvent_EmailSent_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
 }
 
-type Node struct {
-	Id                      []byte
-	Address                 string
-	LastNet                 string
-	LastIpPort              *string
-	CountryCode             *string
-	Protocol                int
-	Email                   string
-	Wallet                  string
-	WalletFeatures          string
-	FreeDisk                int64
-	PieceCount              int64
-	Major                   int64
-	Minor                   int64
-	Patch                   int64
-	Hash                    string
-	Timestamp               time.Time
-	Release                 bool
-	Latency90               int64
-	VettedAt                *time.Time
-	CreatedAt               time.Time
-	UpdatedAt               time.Time
-	LastContactSuccess      time.Time
-	LastContactFailure      time.Time
-	Disqualified            *time.Time
-	DisqualificationReason  *int
-	UnknownAuditSuspended   *time.Time
-	OfflineSuspended        *time.Time
-	UnderReview             *time.Time
-	ExitInitiatedAt         *time.Time
-	ExitLoopCompletedAt     *time.Time
-	ExitFinishedAt          *time.Time
-	ExitSuccess             bool
-	Contained               *time.Time
-	LastOfflineEmail        *time.Time
-	LastSoftwareUpdateEmail *time.Time
-	NoiseProto              *int
-	NoisePublicKey          []byte
-	DebounceLimit           int
-	Features                int
+func NodeEvent_EmailSent(v time.Time) NodeEvent_EmailSent_Field {
+	return NodeEvent_EmailSent_Field{_set: true, _value: &v}
 }
 
-func (Node) _Table() string { return "nodes" }
+func NodeEvent_EmailSent_Raw(v *time.Time) NodeEvent_EmailSent_Field {
+	if v == nil {
+		return NodeEvent_EmailSent_Null()
+	}
+	return NodeEvent_EmailSent(*v)
+}
 
-type Node_Create_Fields struct {
-	Address                 Node_Address_Field
-	LastIpPort              Node_LastIpPort_Field
-	CountryCode             Node_CountryCode_Field
-	Protocol                Node_Protocol_Field
-	WalletFeatures          Node_WalletFeatures_Field
-	FreeDisk                Node_FreeDisk_Field
-	Major                   Node_Major_Field
-	Minor                   Node_Minor_Field
-	Patch                   Node_Patch_Field
-	Hash                    Node_Hash_Field
-	Timestamp               Node_Timestamp_Field
-	Release                 Node_Release_Field
-	Latency90               Node_Latency90_Field
-	VettedAt                Node_VettedAt_Field
-	LastContactSuccess      Node_LastContactSuccess_Field
-	LastContactFailure      Node_LastContactFailure_Field
-	Disqualified            Node_Disqualified_Field
-	DisqualificationReason  Node_DisqualificationReason_Field
-	UnknownAuditSuspended   Node_UnknownAuditSuspended_Field
-	OfflineSuspended        Node_OfflineSuspended_Field
-	UnderReview             Node_UnderReview_Field
-	ExitInitiatedAt         Node_ExitInitiatedAt_Field
-	ExitLoopCompletedAt     Node_ExitLoopCompletedAt_Field
-	ExitFinishedAt          Node_ExitFinishedAt_Field
-	ExitSuccess             Node_ExitSuccess_Field
-	Contained               Node_Contained_Field
-	LastOfflineEmail        Node_LastOfflineEmail_Field
-	LastSoftwareUpdateEmail Node_LastSoftwareUpdateEmail_Field
-	NoiseProto              Node_NoiseProto_Field
-	NoisePublicKey          Node_NoisePublicKey_Field
-	DebounceLimit           Node_DebounceLimit_Field
-	Features                Node_Features_Field
+func NodeEvent_EmailSent_Null() NodeEvent_EmailSent_Field {
+	return NodeEvent_EmailSent_Field{_set: true, _null: true}
 }
 
-type Node_Update_Fields struct {
-	Address                 Node_Address_Field
-	LastNet                 Node_LastNet_Field
-	LastIpPort              Node_LastIpPort_Field
-	CountryCode             Node_CountryCode_Field
-	Protocol                Node_Protocol_Field
-	Email                   Node_Email_Field
-	Wallet                  Node_Wallet_Field
-	WalletFeatures          Node_WalletFeatures_Field
-	FreeDisk                Node_FreeDisk_Field
-	PieceCount              Node_PieceCount_Field
-	Major                   Node_Major_Field
-	Minor                   Node_Minor_Field
-	Patch                   Node_Patch_Field
-	Hash                    Node_Hash_Field
-	Timestamp               Node_Timestamp_Field
-	Release                 Node_Release_Field
-	Latency90               Node_Latency90_Field
-	VettedAt                Node_VettedAt_Field
-	LastContactSuccess      Node_LastContactSuccess_Field
-	LastContactFailure      Node_LastContactFailure_Field
-	Disqualified            Node_Disqualified_Field
-	DisqualificationReason  Node_DisqualificationReason_Field
-	UnknownAuditSuspended   Node_UnknownAuditSuspended_Field
-	OfflineSuspended        Node_OfflineSuspended_Field
-	UnderReview             Node_UnderReview_Field
-	ExitInitiatedAt         Node_ExitInitiatedAt_Field
-	ExitLoopCompletedAt     Node_ExitLoopCompletedAt_Field
-	ExitFinishedAt          Node_ExitFinishedAt_Field
-	ExitSuccess             Node_ExitSuccess_Field
-	Contained               Node_Contained_Field
-	LastOfflineEmail        Node_LastOfflineEmail_Field
-	LastSoftwareUpdateEmail Node_LastSoftwareUpdateEmail_Field
-	NoiseProto              Node_NoiseProto_Field
-	NoisePublicKey          Node_NoisePublicKey_Field
-	DebounceLimit           Node_DebounceLimit_Field
-	Features                Node_Features_Field
+func (f NodeEvent_EmailSent_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f NodeEvent_EmailSent_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (NodeEvent_EmailSent_Field) _Column() string { return "email_sent" }
+
+type NodeTags struct {
+	NodeId   []byte
+	Name     string
+	Value    []byte
+	SignedAt time.Time
+	Signer   []byte
+}
+
+func (NodeTags) _Table() string { return "node_tags" }
+
+type NodeTags_Update_Fields struct {
+}
+
+type NodeTags_NodeId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func NodeTags_NodeId(v []byte) NodeTags_NodeId_Field {
+	return NodeTags_NodeId_Field{_set: true, _value: v}
+}
+
+func (f NodeTags_NodeId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (NodeTags_NodeId_Field) _Column() string { return "node_id" }
+
+type NodeTags_Name_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func NodeTags_Name(v string) NodeTags_Name_Field {
+	return NodeTags_Name_Field{_set: true, _value: v}
+}
+
+func (f NodeTags_Name_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (NodeTags_Name_Field) _Column() string { return "name" }
+
+type NodeTags_Value_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func NodeTags_Value(v []byte) NodeTags_Value_Field {
+	return NodeTags_Value_Field{_set: true, _value: v}
+}
+
+func (f NodeTags_Value_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (NodeTags_Value_Field) _Column() string { return "value" }
+
+type NodeTags_SignedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func NodeTags_SignedAt(v time.Time) NodeTags_SignedAt_Field {
+	return NodeTags_SignedAt_Field{_set: true, _value: v}
+}
+
+func (f NodeTags_SignedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (NodeTags_SignedAt_Field) _Column() string { return "signed_at" }
+
+type NodeTags_Signer_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func NodeTags_Signer(v []byte) NodeTags_Signer_Field {
+	return NodeTags_Signer_Field{_set: true, _value: v}
+}
+
+func (f NodeTags_Signer_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (NodeTags_Signer_Field) _Column() string { return "signer" }
+
+type OauthClient struct {
+	Id              []byte
+	EncryptedSecret []byte
+	RedirectUrl     string
+	UserId          []byte
+	AppName         string
+	AppLogoUrl      string
+}
+
+func (OauthClient) _Table() string { return "oauth_clients" }
+
+type OauthClient_Update_Fields struct {
+	EncryptedSecret OauthClient_EncryptedSecret_Field
+	RedirectUrl     OauthClient_RedirectUrl_Field
+	AppName         OauthClient_AppName_Field
+	AppLogoUrl      OauthClient_AppLogoUrl_Field
 }
 
-type Node_Id_Field struct {
+type OauthClient_Id_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func Node_Id(v []byte) Node_Id_Field {
-	return Node_Id_Field{_set: true, _value: v}
+func OauthClient_Id(v []byte) OauthClient_Id_Field {
+	return OauthClient_Id_Field{_set: true, _value: v}
 }
 
-func (f Node_Id_Field) value() interface{} {
+func (f OauthClient_Id_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_Id_Field) _Column() string { return "id" }
+func (OauthClient_Id_Field) _Column() string { return "id" }
 
-type Node_Address_Field struct {
+type OauthClient_EncryptedSecret_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func OauthClient_EncryptedSecret(v []byte) OauthClient_EncryptedSecret_Field {
+	return OauthClient_EncryptedSecret_Field{_set: true, _value: v}
+}
+
+func (f OauthClient_EncryptedSecret_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (OauthClient_EncryptedSecret_Field) _Column() string { return "encrypted_secret" }
+
+type OauthClient_RedirectUrl_Field struct {
 	_set   bool
 	_null  bool
 	_value string
 }
 
-func Node_Address(v string) Node_Address_Field {
-	return Node_Address_Field{_set: true, _value: v}
+func OauthClient_RedirectUrl(v string) OauthClient_RedirectUrl_Field {
+	return OauthClient_RedirectUrl_Field{_set: true, _value: v}
 }
 
-func (f Node_Address_Field) value() interface{} {
+func (f OauthClient_RedirectUrl_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_Address_Field) _Column() string { return "address" }
+func (OauthClient_RedirectUrl_Field) _Column() string { return "redirect_url" }
 
-type Node_LastNet_Field struct {
+type OauthClient_UserId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func OauthClient_UserId(v []byte) OauthClient_UserId_Field {
+	return OauthClient_UserId_Field{_set: true, _value: v}
+}
+
+func (f OauthClient_UserId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (OauthClient_UserId_Field) _Column() string { return "user_id" }
+
+type OauthClient_AppName_Field struct {
 	_set   bool
 	_null  bool
 	_value string
 }
 
-func Node_LastNet(v string) Node_LastNet_Field {
-	return Node_LastNet_Field{_set: true, _value: v}
+func OauthClient_AppName(v string) OauthClient_AppName_Field {
+	return OauthClient_AppName_Field{_set: true, _value: v}
 }
 
-func (f Node_LastNet_Field) value() interface{} {
+func (f OauthClient_AppName_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_LastNet_Field) _Column() string { return "last_net" }
+func (OauthClient_AppName_Field) _Column() string { return "app_name" }
 
-type Node_LastIpPort_Field struct {
+type OauthClient_AppLogoUrl_Field struct {
 	_set   bool
 	_null  bool
-	_value *string
+	_value string
 }
 
-func Node_LastIpPort(v string) Node_LastIpPort_Field {
-	return Node_LastIpPort_Field{_set: true, _value: &v}
+func OauthClient_AppLogoUrl(v string) OauthClient_AppLogoUrl_Field {
+	return OauthClient_AppLogoUrl_Field{_set: true, _value: v}
 }
 
-func Node_LastIpPort_Raw(v *string) Node_LastIpPort_Field {
-	if v == nil {
-		return Node_LastIpPort_Null()
+func (f OauthClient_AppLogoUrl_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return Node_LastIpPort(*v)
+	return f._value
 }
 
-func Node_LastIpPort_Null() Node_LastIpPort_Field {
-	return Node_LastIpPort_Field{_set: true, _null: true}
+func (OauthClient_AppLogoUrl_Field) _Column() string { return "app_logo_url" }
+
+type OauthCode struct {
+	ClientId        []byte
+	UserId          []byte
+	Scope           string
+	RedirectUrl     string
+	Challenge       string
+	ChallengeMethod string
+	Code            string
+	CreatedAt       time.Time
+	ExpiresAt       time.Time
+	ClaimedAt       *time.Time
 }
 
-func (f Node_LastIpPort_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func (OauthCode) _Table() string { return "oauth_codes" }
 
-func (f Node_LastIpPort_Field) value() interface{} {
+type OauthCode_Create_Fields struct {
+	ClaimedAt OauthCode_ClaimedAt_Field
+}
+
+type OauthCode_Update_Fields struct {
+	ClaimedAt OauthCode_ClaimedAt_Field
+}
+
+type OauthCode_ClientId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func OauthCode_ClientId(v []byte) OauthCode_ClientId_Field {
+	return OauthCode_ClientId_Field{_set: true, _value: v}
+}
+
+func (f OauthCode_ClientId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_LastIpPort_Field) _Column() string { return "last_ip_port" }
+func (OauthCode_ClientId_Field) _Column() string { return "client_id" }
 
-type Node_CountryCode_Field struct {
+type OauthCode_UserId_Field struct {
 	_set   bool
 	_null  bool
-	_value *string
+	_value []byte
 }
 
-func Node_CountryCode(v string) Node_CountryCode_Field {
-	return Node_CountryCode_Field{_set: true, _value: &v}
+func OauthCode_UserId(v []byte) OauthCode_UserId_Field {
+	return OauthCode_UserId_Field{_set: true, _value: v}
 }
 
-func Node_CountryCode_Raw(v *string) Node_CountryCode_Field {
-	if v == nil {
-		return Node_CountryCode_Null()
+func (f OauthCode_UserId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return Node_CountryCode(*v)
+	return f._value
 }
 
-func Node_CountryCode_Null() Node_CountryCode_Field {
-	return Node_CountryCode_Field{_set: true, _null: true}
+func (OauthCode_UserId_Field) _Column() string { return "user_id" }
+
+type OauthCode_Scope_Field struct {
+	_set   bool
+	_null  bool
+	_value string
 }
 
-func (f Node_CountryCode_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func OauthCode_Scope(v string) OauthCode_Scope_Field {
+	return OauthCode_Scope_Field{_set: true, _value: v}
+}
 
-func (f Node_CountryCode_Field) value() interface{} {
+func (f OauthCode_Scope_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_CountryCode_Field) _Column() string { return "country_code" }
+func (OauthCode_Scope_Field) _Column() string { return "scope" }
 
-type Node_Protocol_Field struct {
+type OauthCode_RedirectUrl_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value string
 }
 
-func Node_Protocol(v int) Node_Protocol_Field {
-	return Node_Protocol_Field{_set: true, _value: v}
+func OauthCode_RedirectUrl(v string) OauthCode_RedirectUrl_Field {
+	return OauthCode_RedirectUrl_Field{_set: true, _value: v}
 }
 
-func (f Node_Protocol_Field) value() interface{} {
+func (f OauthCode_RedirectUrl_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_Protocol_Field) _Column() string { return "protocol" }
+func (OauthCode_RedirectUrl_Field) _Column() string { return "redirect_url" }
 
-type Node_Email_Field struct {
+type OauthCode_Challenge_Field struct {
 	_set   bool
 	_null  bool
 	_value string
 }
 
-func Node_Email(v string) Node_Email_Field {
-	return Node_Email_Field{_set: true, _value: v}
+func OauthCode_Challenge(v string) OauthCode_Challenge_Field {
+	return OauthCode_Challenge_Field{_set: true, _value: v}
 }
 
-func (f Node_Email_Field) value() interface{} {
+func (f OauthCode_Challenge_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_Email_Field) _Column() string { return "email" }
+func (OauthCode_Challenge_Field) _Column() string { return "challenge" }
 
-type Node_Wallet_Field struct {
+type OauthCode_ChallengeMethod_Field struct {
 	_set   bool
 	_null  bool
 	_value string
 }
 
-func Node_Wallet(v string) Node_Wallet_Field {
-	return Node_Wallet_Field{_set: true, _value: v}
+func OauthCode_ChallengeMethod(v string) OauthCode_ChallengeMethod_Field {
+	return OauthCode_ChallengeMethod_Field{_set: true, _value: v}
 }
 
-func (f Node_Wallet_Field) value() interface{} {
+func (f OauthCode_ChallengeMethod_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_Wallet_Field) _Column() string { return "wallet" }
+func (OauthCode_ChallengeMethod_Field) _Column() string { return "challenge_method" }
 
-type Node_WalletFeatures_Field struct {
+type OauthCode_Code_Field struct {
 	_set   bool
 	_null  bool
 	_value string
 }
 
-func Node_WalletFeatures(v string) Node_WalletFeatures_Field {
-	return Node_WalletFeatures_Field{_set: true, _value: v}
+func OauthCode_Code(v string) OauthCode_Code_Field {
+	return OauthCode_Code_Field{_set: true, _value: v}
 }
 
-func (f Node_WalletFeatures_Field) value() interface{} {
+func (f OauthCode_Code_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_WalletFeatures_Field) _Column() string { return "wallet_features" }
+func (OauthCode_Code_Field) _Column() string { return "code" }
 
-type Node_FreeDisk_Field struct {
+type OauthCode_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value time.Time
 }
 
-func Node_FreeDisk(v int64) Node_FreeDisk_Field {
-	return Node_FreeDisk_Field{_set: true, _value: v}
+func OauthCode_CreatedAt(v time.Time) OauthCode_CreatedAt_Field {
+	return OauthCode_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f Node_FreeDisk_Field) value() interface{} {
+func (f OauthCode_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_FreeDisk_Field) _Column() string { return "free_disk" }
+func (OauthCode_CreatedAt_Field) _Column() string { return "created_at" }
 
-type Node_PieceCount_Field struct {
+type OauthCode_ExpiresAt_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value time.Time
 }
 
-func Node_PieceCount(v int64) Node_PieceCount_Field {
-	return Node_PieceCount_Field{_set: true, _value: v}
+func OauthCode_ExpiresAt(v time.Time) OauthCode_ExpiresAt_Field {
+	return OauthCode_ExpiresAt_Field{_set: true, _value: v}
 }
 
-func (f Node_PieceCount_Field) value() interface{} {
+func (f OauthCode_ExpiresAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_PieceCount_Field) _Column() string { return "piece_count" }
+func (OauthCode_ExpiresAt_Field) _Column() string { return "expires_at" }
 
-type Node_Major_Field struct {
+type OauthCode_ClaimedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value *time.Time
 }
 
-func Node_Major(v int64) Node_Major_Field {
-	return Node_Major_Field{_set: true, _value: v}
+func OauthCode_ClaimedAt(v time.Time) OauthCode_ClaimedAt_Field {
+	return OauthCode_ClaimedAt_Field{_set: true, _value: &v}
 }
 
-func (f Node_Major_Field) value() interface{} {
+func OauthCode_ClaimedAt_Raw(v *time.Time) OauthCode_ClaimedAt_Field {
+	if v == nil {
+		return OauthCode_ClaimedAt_Null()
+	}
+	return OauthCode_ClaimedAt(*v)
+}
+
+func OauthCode_ClaimedAt_Null() OauthCode_ClaimedAt_Field {
+	return OauthCode_ClaimedAt_Field{_set: true, _null: true}
+}
+
+func (f OauthCode_ClaimedAt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f OauthCode_ClaimedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_Major_Field) _Column() string { return "major" }
+func (OauthCode_ClaimedAt_Field) _Column() string { return "claimed_at" }
+
+type OauthToken struct {
+	ClientId  []byte
+	UserId    []byte
+	Scope     string
+	Kind      int
+	Token     []byte
+	CreatedAt time.Time
+	ExpiresAt time.Time
+}
+
+func (OauthToken) _Table() string { return "oauth_tokens" }
 
-type Node_Minor_Field struct {
+type OauthToken_Update_Fields struct {
+	ExpiresAt OauthToken_ExpiresAt_Field
+}
+
+type OauthToken_ClientId_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value []byte
 }
 
-func Node_Minor(v int64) Node_Minor_Field {
-	return Node_Minor_Field{_set: true, _value: v}
+func OauthToken_ClientId(v []byte) OauthToken_ClientId_Field {
+	return OauthToken_ClientId_Field{_set: true, _value: v}
 }
 
-func (f Node_Minor_Field) value() interface{} {
+func (f OauthToken_ClientId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_Minor_Field) _Column() string { return "minor" }
+func (OauthToken_ClientId_Field) _Column() string { return "client_id" }
 
-type Node_Patch_Field struct {
+type OauthToken_UserId_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value []byte
 }
 
-func Node_Patch(v int64) Node_Patch_Field {
-	return Node_Patch_Field{_set: true, _value: v}
+func OauthToken_UserId(v []byte) OauthToken_UserId_Field {
+	return OauthToken_UserId_Field{_set: true, _value: v}
 }
 
-func (f Node_Patch_Field) value() interface{} {
+func (f OauthToken_UserId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_Patch_Field) _Column() string { return "patch" }
+func (OauthToken_UserId_Field) _Column() string { return "user_id" }
 
-type Node_Hash_Field struct {
+type OauthToken_Scope_Field struct {
 	_set   bool
 	_null  bool
 	_value string
 }
 
-func Node_Hash(v string) Node_Hash_Field {
-	return Node_Hash_Field{_set: true, _value: v}
+func OauthToken_Scope(v string) OauthToken_Scope_Field {
+	return OauthToken_Scope_Field{_set: true, _value: v}
 }
 
-func (f Node_Hash_Field) value() interface{} {
+func (f OauthToken_Scope_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_Hash_Field) _Column() string { return "hash" }
+func (OauthToken_Scope_Field) _Column() string { return "scope" }
 
-type Node_Timestamp_Field struct {
+type OauthToken_Kind_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value int
 }
 
-func Node_Timestamp(v time.Time) Node_Timestamp_Field {
-	return Node_Timestamp_Field{_set: true, _value: v}
+func OauthToken_Kind(v int) OauthToken_Kind_Field {
+	return OauthToken_Kind_Field{_set: true, _value: v}
 }
 
-func (f Node_Timestamp_Field) value() interface{} {
+func (f OauthToken_Kind_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_Timestamp_Field) _Column() string { return "timestamp" }
+func (OauthToken_Kind_Field) _Column() string { return "kind" }
 
-type Node_Release_Field struct {
+type OauthToken_Token_Field struct {
 	_set   bool
 	_null  bool
-	_value bool
+	_value []byte
 }
 
-func Node_Release(v bool) Node_Release_Field {
-	return Node_Release_Field{_set: true, _value: v}
+func OauthToken_Token(v []byte) OauthToken_Token_Field {
+	return OauthToken_Token_Field{_set: true, _value: v}
 }
 
-func (f Node_Release_Field) value() interface{} {
+func (f OauthToken_Token_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_Release_Field) _Column() string { return "release" }
+func (OauthToken_Token_Field) _Column() string { return "token" }
 
-type Node_Latency90_Field struct {
+type OauthToken_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value time.Time
 }
 
-func Node_Latency90(v int64) Node_Latency90_Field {
-	return Node_Latency90_Field{_set: true, _value: v}
+func OauthToken_CreatedAt(v time.Time) OauthToken_CreatedAt_Field {
+	return OauthToken_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f Node_Latency90_Field) value() interface{} {
+func (f OauthToken_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_Latency90_Field) _Column() string { return "latency_90" }
+func (OauthToken_CreatedAt_Field) _Column() string { return "created_at" }
 
-type Node_VettedAt_Field struct {
+type OauthToken_ExpiresAt_Field struct {
 	_set   bool
 	_null  bool
-	_value *time.Time
-}
-
-func Node_VettedAt(v time.Time) Node_VettedAt_Field {
-	return Node_VettedAt_Field{_set: true, _value: &v}
-}
-
-func Node_VettedAt_Raw(v *time.Time) Node_VettedAt_Field {
-	if v == nil {
-		return Node_VettedAt_Null()
-	}
-	return Node_VettedAt(*v)
+	_value time.Time
 }
 
-func Node_VettedAt_Null() Node_VettedAt_Field {
-	return Node_VettedAt_Field{_set: true, _null: true}
+func OauthToken_ExpiresAt(v time.Time) OauthToken_ExpiresAt_Field {
+	return OauthToken_ExpiresAt_Field{_set: true, _value: v}
 }
 
-func (f Node_VettedAt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
-
-func (f Node_VettedAt_Field) value() interface{} {
+func (f OauthToken_ExpiresAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_VettedAt_Field) _Column() string { return "vetted_at" }
+func (OauthToken_ExpiresAt_Field) _Column() string { return "expires_at" }
 
-type Node_CreatedAt_Field struct {
-	_set   bool
-	_null  bool
-	_value time.Time
+type PassphraseRecovery struct {
+	Id          []byte
+	ProjectId   []byte
+	RequestedBy string
+	Reason      string
+	CreatedAt   time.Time
+	RecoveredAt *time.Time
 }
 
-func Node_CreatedAt(v time.Time) Node_CreatedAt_Field {
-	return Node_CreatedAt_Field{_set: true, _value: v}
-}
+func (PassphraseRecovery) _Table() string { return "passphrase_recoveries" }
 
-func (f Node_CreatedAt_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
-	}
-	return f._value
+type PassphraseRecovery_Create_Fields struct {
+	RecoveredAt PassphraseRecovery_RecoveredAt_Field
 }
 
-func (Node_CreatedAt_Field) _Column() string { return "created_at" }
+type PassphraseRecovery_Update_Fields struct {
+	RecoveredAt PassphraseRecovery_RecoveredAt_Field
+}
 
-type Node_UpdatedAt_Field struct {
+type PassphraseRecovery_Id_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value []byte
 }
 
-func Node_UpdatedAt(v time.Time) Node_UpdatedAt_Field {
-	return Node_UpdatedAt_Field{_set: true, _value: v}
+func PassphraseRecovery_Id(v []byte) PassphraseRecovery_Id_Field {
+	return PassphraseRecovery_Id_Field{_set: true, _value: v}
 }
 
-func (f Node_UpdatedAt_Field) value() interface{} {
+func (f PassphraseRecovery_Id_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_UpdatedAt_Field) _Column() string { return "updated_at" }
+func (PassphraseRecovery_Id_Field) _Column() string { return "id" }
 
-type Node_LastContactSuccess_Field struct {
+type PassphraseRecovery_ProjectId_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value []byte
 }
 
-func Node_LastContactSuccess(v time.Time) Node_LastContactSuccess_Field {
-	return Node_LastContactSuccess_Field{_set: true, _value: v}
+func PassphraseRecovery_ProjectId(v []byte) PassphraseRecovery_ProjectId_Field {
+	return PassphraseRecovery_ProjectId_Field{_set: true, _value: v}
 }
 
-func (f Node_LastContactSuccess_Field) value() interface{} {
+func (f PassphraseRecovery_ProjectId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_LastContactSuccess_Field) _Column() string { return "last_contact_success" }
+func (PassphraseRecovery_ProjectId_Field) _Column() string { return "project_id" }
 
-type Node_LastContactFailure_Field struct {
+type PassphraseRecovery_RequestedBy_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value string
 }
 
-func Node_LastContactFailure(v time.Time) Node_LastContactFailure_Field {
-	return Node_LastContactFailure_Field{_set: true, _value: v}
+func PassphraseRecovery_RequestedBy(v string) PassphraseRecovery_RequestedBy_Field {
+	return PassphraseRecovery_RequestedBy_Field{_set: true, _value: v}
 }
 
-func (f Node_LastContactFailure_Field) value() interface{} {
+func (f PassphraseRecovery_RequestedBy_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_LastContactFailure_Field) _Column() string { return "last_contact_failure" }
+func (PassphraseRecovery_RequestedBy_Field) _Column() string { return "requested_by" }
 
-type Node_Disqualified_Field struct {
+type PassphraseRecovery_Reason_Field struct {
 	_set   bool
 	_null  bool
-	_value *time.Time
-}
-
-func Node_Disqualified(v time.Time) Node_Disqualified_Field {
-	return Node_Disqualified_Field{_set: true, _value: &v}
-}
-
-func Node_Disqualified_Raw(v *time.Time) Node_Disqualified_Field {
-	if v == nil {
-		return Node_Disqualified_Null()
-	}
-	return Node_Disqualified(*v)
+	_value string
 }
 
-func Node_Disqualified_Null() Node_Disqualified_Field {
-	return Node_Disqualified_Field{_set: true, _null: true}
+func PassphraseRecovery_Reason(v string) PassphraseRecovery_Reason_Field {
+	return PassphraseRecovery_Reason_Field{_set: true, _value: v}
 }
 
-func (f Node_Disqualified_Field) isnull() bool { return !f._set || f._null || f._value == nil }
-
-func (f Node_Disqualified_Field) value() interface{} {
+func (f PassphraseRecovery_Reason_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_Disqualified_Field) _Column() string { return "disqualified" }
+func (PassphraseRecovery_Reason_Field) _Column() string { return "reason" }
 
-type Node_DisqualificationReason_Field struct {
+type PassphraseRecovery_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value *int
-}
-
-func Node_DisqualificationReason(v int) Node_DisqualificationReason_Field {
-	return Node_DisqualificationReason_Field{_set: true, _value: &v}
-}
-
-func Node_DisqualificationReason_Raw(v *int) Node_DisqualificationReason_Field {
-	if v == nil {
-		return Node_DisqualificationReason_Null()
-	}
-	return Node_DisqualificationReason(*v)
-}
-
-func Node_DisqualificationReason_Null() Node_DisqualificationReason_Field {
-	return Node_DisqualificationReason_Field{_set: true, _null: true}
+	_value time.Time
 }
 
-func (f Node_DisqualificationReason_Field) isnull() bool {
-	return !f._set || f._null || f._value == nil
+func PassphraseRecovery_CreatedAt(v time.Time) PassphraseRecovery_CreatedAt_Field {
+	return PassphraseRecovery_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f Node_DisqualificationReason_Field) value() interface{} {
+func (f PassphraseRecovery_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_DisqualificationReason_Field) _Column() string { return "disqualification_reason" }
+func (PassphraseRecovery_CreatedAt_Field) _Column() string { return "created_at" }
 
-type Node_UnknownAuditSuspended_Field struct {
+type PassphraseRecovery_RecoveredAt_Field struct {
 	_set   bool
 	_null  bool
 	_value *time.Time
 }
 
-func Node_UnknownAuditSuspended(v time.Time) Node_UnknownAuditSuspended_Field {
-	return Node_UnknownAuditSuspended_Field{_set: true, _value: &v}
+func PassphraseRecovery_RecoveredAt(v time.Time) PassphraseRecovery_RecoveredAt_Field {
+	return PassphraseRecovery_RecoveredAt_Field{_set: true, _value: &v}
 }
 
-func Node_UnknownAuditSuspended_Raw(v *time.Time) Node_UnknownAuditSuspended_Field {
+func PassphraseRecovery_RecoveredAt_Raw(v *time.Time) PassphraseRecovery_RecoveredAt_Field {
 	if v == nil {
-		return Node_UnknownAuditSuspended_Null()
+		return PassphraseRecovery_RecoveredAt_Null()
 	}
-	return Node_UnknownAuditSuspended(*v)
+	return PassphraseRecovery_RecoveredAt(*v)
 }
 
-func Node_UnknownAuditSuspended_Null() Node_UnknownAuditSuspended_Field {
-	return Node_UnknownAuditSuspended_Field{_set: true, _null: true}
+func PassphraseRecovery_RecoveredAt_Null() PassphraseRecovery_RecoveredAt_Field {
+	return PassphraseRecovery_RecoveredAt_Field{_set: true, _null: true}
 }
 
-func (f Node_UnknownAuditSuspended_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func (f PassphraseRecovery_RecoveredAt_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
 
-func (f Node_UnknownAuditSuspended_Field) value() interface{} {
+func (f PassphraseRecovery_RecoveredAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_UnknownAuditSuspended_Field) _Column() string { return "unknown_audit_suspended" }
+func (PassphraseRecovery_RecoveredAt_Field) _Column() string { return "recovered_at" }
 
-type Node_OfflineSuspended_Field struct {
-	_set   bool
-	_null  bool
-	_value *time.Time
+type PassphraseRecoveryApproval struct {
+	RecoveryId []byte
+	ApprovedBy string
+	CreatedAt  time.Time
 }
 
-func Node_OfflineSuspended(v time.Time) Node_OfflineSuspended_Field {
-	return Node_OfflineSuspended_Field{_set: true, _value: &v}
-}
+func (PassphraseRecoveryApproval) _Table() string { return "passphrase_recovery_approvals" }
 
-func Node_OfflineSuspended_Raw(v *time.Time) Node_OfflineSuspended_Field {
-	if v == nil {
-		return Node_OfflineSuspended_Null()
-	}
-	return Node_OfflineSuspended(*v)
+type PassphraseRecoveryApproval_Update_Fields struct {
 }
 
-func Node_OfflineSuspended_Null() Node_OfflineSuspended_Field {
-	return Node_OfflineSuspended_Field{_set: true, _null: true}
+type PassphraseRecoveryApproval_RecoveryId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
 }
 
-func (f Node_OfflineSuspended_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func PassphraseRecoveryApproval_RecoveryId(v []byte) PassphraseRecoveryApproval_RecoveryId_Field {
+	return PassphraseRecoveryApproval_RecoveryId_Field{_set: true, _value: v}
+}
 
-func (f Node_OfflineSuspended_Field) value() interface{} {
+func (f PassphraseRecoveryApproval_RecoveryId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_OfflineSuspended_Field) _Column() string { return "offline_suspended" }
+func (PassphraseRecoveryApproval_RecoveryId_Field) _Column() string { return "recovery_id" }
 
-type Node_UnderReview_Field struct {
+type PassphraseRecoveryApproval_ApprovedBy_Field struct {
 	_set   bool
 	_null  bool
-	_value *time.Time
-}
-
-func Node_UnderReview(v time.Time) Node_UnderReview_Field {
-	return Node_UnderReview_Field{_set: true, _value: &v}
-}
-
-func Node_UnderReview_Raw(v *time.Time) Node_UnderReview_Field {
-	if v == nil {
-		return Node_UnderReview_Null()
-	}
-	return Node_UnderReview(*v)
+	_value string
 }
 
-func Node_UnderReview_Null() Node_UnderReview_Field {
-	return Node_UnderReview_Field{_set: true, _null: true}
+func PassphraseRecoveryApproval_ApprovedBy(v string) PassphraseRecoveryApproval_ApprovedBy_Field {
+	return PassphraseRecoveryApproval_ApprovedBy_Field{_set: true, _value: v}
 }
 
-func (f Node_UnderReview_Field) isnull() bool { return !f._set || f._null || f._value == nil }
-
-func (f Node_UnderReview_Field) value() interface{} {
+func (f PassphraseRecoveryApproval_ApprovedBy_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_UnderReview_Field) _Column() string { return "under_review" }
+func (PassphraseRecoveryApproval_ApprovedBy_Field) _Column() string { return "approved_by" }
 
-type Node_ExitInitiatedAt_Field struct {
+type PassphraseRecoveryApproval_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value *time.Time
-}
-
-func Node_ExitInitiatedAt(v time.Time) Node_ExitInitiatedAt_Field {
-	return Node_ExitInitiatedAt_Field{_set: true, _value: &v}
-}
-
-func Node_ExitInitiatedAt_Raw(v *time.Time) Node_ExitInitiatedAt_Field {
-	if v == nil {
-		return Node_ExitInitiatedAt_Null()
-	}
-	return Node_ExitInitiatedAt(*v)
-}
-
-func Node_ExitInitiatedAt_Null() Node_ExitInitiatedAt_Field {
-	return Node_ExitInitiatedAt_Field{_set: true, _null: true}
+	_value time.Time
 }
 
-func (f Node_ExitInitiatedAt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func PassphraseRecoveryApproval_CreatedAt(v time.Time) PassphraseRecoveryApproval_CreatedAt_Field {
+	return PassphraseRecoveryApproval_CreatedAt_Field{_set: true, _value: v}
+}
 
-func (f Node_ExitInitiatedAt_Field) value() interface{} {
+func (f PassphraseRecoveryApproval_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_ExitInitiatedAt_Field) _Column() string { return "exit_initiated_at" }
+func (PassphraseRecoveryApproval_CreatedAt_Field) _Column() string { return "created_at" }
 
-type Node_ExitLoopCompletedAt_Field struct {
-	_set   bool
-	_null  bool
-	_value *time.Time
+type PeerIdentity struct {
+	NodeId           []byte
+	LeafSerialNumber []byte
+	Chain            []byte
+	UpdatedAt        time.Time
 }
 
-func Node_ExitLoopCompletedAt(v time.Time) Node_ExitLoopCompletedAt_Field {
-	return Node_ExitLoopCompletedAt_Field{_set: true, _value: &v}
-}
+func (PeerIdentity) _Table() string { return "peer_identities" }
 
-func Node_ExitLoopCompletedAt_Raw(v *time.Time) Node_ExitLoopCompletedAt_Field {
-	if v == nil {
-		return Node_ExitLoopCompletedAt_Null()
-	}
-	return Node_ExitLoopCompletedAt(*v)
+type PeerIdentity_Update_Fields struct {
+	LeafSerialNumber PeerIdentity_LeafSerialNumber_Field
+	Chain            PeerIdentity_Chain_Field
 }
 
-func Node_ExitLoopCompletedAt_Null() Node_ExitLoopCompletedAt_Field {
-	return Node_ExitLoopCompletedAt_Field{_set: true, _null: true}
+type PeerIdentity_NodeId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
 }
 
-func (f Node_ExitLoopCompletedAt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func PeerIdentity_NodeId(v []byte) PeerIdentity_NodeId_Field {
+	return PeerIdentity_NodeId_Field{_set: true, _value: v}
+}
 
-func (f Node_ExitLoopCompletedAt_Field) value() interface{} {
+func (f PeerIdentity_NodeId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_ExitLoopCompletedAt_Field) _Column() string { return "exit_loop_completed_at" }
+func (PeerIdentity_NodeId_Field) _Column() string { return "node_id" }
 
-type Node_ExitFinishedAt_Field struct {
+type PeerIdentity_LeafSerialNumber_Field struct {
 	_set   bool
 	_null  bool
-	_value *time.Time
+	_value []byte
 }
 
-func Node_ExitFinishedAt(v time.Time) Node_ExitFinishedAt_Field {
-	return Node_ExitFinishedAt_Field{_set: true, _value: &v}
+func PeerIdentity_LeafSerialNumber(v []byte) PeerIdentity_LeafSerialNumber_Field {
+	return PeerIdentity_LeafSerialNumber_Field{_set: true, _value: v}
 }
 
-func Node_ExitFinishedAt_Raw(v *time.Time) Node_ExitFinishedAt_Field {
-	if v == nil {
-		return Node_ExitFinishedAt_Null()
+func (f PeerIdentity_LeafSerialNumber_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return Node_ExitFinishedAt(*v)
+	return f._value
 }
 
-func Node_ExitFinishedAt_Null() Node_ExitFinishedAt_Field {
-	return Node_ExitFinishedAt_Field{_set: true, _null: true}
+func (PeerIdentity_LeafSerialNumber_Field) _Column() string { return "leaf_serial_number" }
+
+type PeerIdentity_Chain_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
 }
 
-func (f Node_ExitFinishedAt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func PeerIdentity_Chain(v []byte) PeerIdentity_Chain_Field {
+	return PeerIdentity_Chain_Field{_set: true, _value: v}
+}
 
-func (f Node_ExitFinishedAt_Field) value() interface{} {
+func (f PeerIdentity_Chain_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_ExitFinishedAt_Field) _Column() string { return "exit_finished_at" }
+func (PeerIdentity_Chain_Field) _Column() string { return "chain" }
 
-type Node_ExitSuccess_Field struct {
+type PeerIdentity_UpdatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value bool
+	_value time.Time
 }
 
-func Node_ExitSuccess(v bool) Node_ExitSuccess_Field {
-	return Node_ExitSuccess_Field{_set: true, _value: v}
+func PeerIdentity_UpdatedAt(v time.Time) PeerIdentity_UpdatedAt_Field {
+	return PeerIdentity_UpdatedAt_Field{_set: true, _value: v}
 }
 
-func (f Node_ExitSuccess_Field) value() interface{} {
+func (f PeerIdentity_UpdatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_ExitSuccess_Field) _Column() string { return "exit_success" }
+func (PeerIdentity_UpdatedAt_Field) _Column() string { return "updated_at" }
 
-type Node_Contained_Field struct {
-	_set   bool
-	_null  bool
-	_value *time.Time
+type PendingObjectGracePeriod struct {
+	ProjectId   []byte
+	BucketName  []byte
+	GracePeriod int64
+	UpdatedAt   time.Time
 }
 
-func Node_Contained(v time.Time) Node_Contained_Field {
-	return Node_Contained_Field{_set: true, _value: &v}
-}
+func (PendingObjectGracePeriod) _Table() string { return "pending_object_grace_periods" }
 
-func Node_Contained_Raw(v *time.Time) Node_Contained_Field {
-	if v == nil {
-		return Node_Contained_Null()
-	}
-	return Node_Contained(*v)
+type PendingObjectGracePeriod_Update_Fields struct {
+	GracePeriod PendingObjectGracePeriod_GracePeriod_Field
 }
 
-func Node_Contained_Null() Node_Contained_Field {
-	return Node_Contained_Field{_set: true, _null: true}
+type PendingObjectGracePeriod_ProjectId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
 }
 
-func (f Node_Contained_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func PendingObjectGracePeriod_ProjectId(v []byte) PendingObjectGracePeriod_ProjectId_Field {
+	return PendingObjectGracePeriod_ProjectId_Field{_set: true, _value: v}
+}
 
-func (f Node_Contained_Field) value() interface{} {
+func (f PendingObjectGracePeriod_ProjectId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_Contained_Field) _Column() string { return "contained" }
+func (PendingObjectGracePeriod_ProjectId_Field) _Column() string { return "project_id" }
 
-type Node_LastOfflineEmail_Field struct {
+type PendingObjectGracePeriod_BucketName_Field struct {
 	_set   bool
 	_null  bool
-	_value *time.Time
-}
-
-func Node_LastOfflineEmail(v time.Time) Node_LastOfflineEmail_Field {
-	return Node_LastOfflineEmail_Field{_set: true, _value: &v}
-}
-
-func Node_LastOfflineEmail_Raw(v *time.Time) Node_LastOfflineEmail_Field {
-	if v == nil {
-		return Node_LastOfflineEmail_Null()
-	}
-	return Node_LastOfflineEmail(*v)
+	_value []byte
 }
 
-func Node_LastOfflineEmail_Null() Node_LastOfflineEmail_Field {
-	return Node_LastOfflineEmail_Field{_set: true, _null: true}
+func PendingObjectGracePeriod_BucketName(v []byte) PendingObjectGracePeriod_BucketName_Field {
+	return PendingObjectGracePeriod_BucketName_Field{_set: true, _value: v}
 }
 
-func (f Node_LastOfflineEmail_Field) isnull() bool { return !f._set || f._null || f._value == nil }
-
-func (f Node_LastOfflineEmail_Field) value() interface{} {
+func (f PendingObjectGracePeriod_BucketName_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_LastOfflineEmail_Field) _Column() string { return "last_offline_email" }
+func (PendingObjectGracePeriod_BucketName_Field) _Column() string { return "bucket_name" }
 
-type Node_LastSoftwareUpdateEmail_Field struct {
+type PendingObjectGracePeriod_GracePeriod_Field struct {
 	_set   bool
 	_null  bool
-	_value *time.Time
+	_value int64
 }
 
-func Node_LastSoftwareUpdateEmail(v time.Time) Node_LastSoftwareUpdateEmail_Field {
-	return Node_LastSoftwareUpdateEmail_Field{_set: true, _value: &v}
+func PendingObjectGracePeriod_GracePeriod(v int64) PendingObjectGracePeriod_GracePeriod_Field {
+	return PendingObjectGracePeriod_GracePeriod_Field{_set: true, _value: v}
 }
 
-func Node_LastSoftwareUpdateEmail_Raw(v *time.Time) Node_LastSoftwareUpdateEmail_Field {
-	if v == nil {
-		return Node_LastSoftwareUpdateEmail_Null()
+func (f PendingObjectGracePeriod_GracePeriod_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return Node_LastSoftwareUpdateEmail(*v)
+	return f._value
 }
 
-func Node_LastSoftwareUpdateEmail_Null() Node_LastSoftwareUpdateEmail_Field {
-	return Node_LastSoftwareUpdateEmail_Field{_set: true, _null: true}
+func (PendingObjectGracePeriod_GracePeriod_Field) _Column() string { return "grace_period" }
+
+type PendingObjectGracePeriod_UpdatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
 }
 
-func (f Node_LastSoftwareUpdateEmail_Field) isnull() bool {
-	return !f._set || f._null || f._value == nil
+func PendingObjectGracePeriod_UpdatedAt(v time.Time) PendingObjectGracePeriod_UpdatedAt_Field {
+	return PendingObjectGracePeriod_UpdatedAt_Field{_set: true, _value: v}
 }
 
-func (f Node_LastSoftwareUpdateEmail_Field) value() interface{} {
+func (f PendingObjectGracePeriod_UpdatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_LastSoftwareUpdateEmail_Field) _Column() string { return "last_software_update_email" }
+func (PendingObjectGracePeriod_UpdatedAt_Field) _Column() string { return "updated_at" }
 
-type Node_NoiseProto_Field struct {
-	_set   bool
-	_null  bool
-	_value *int
+type Project struct {
+	Id                          []byte
+	PublicId                    []byte
+	Name                        string
+	Description                 string
+	UsageLimit                  *int64
+	BandwidthLimit              *int64
+	UserSpecifiedUsageLimit     *int64
+	UserSpecifiedBandwidthLimit *int64
+	SegmentLimit                *int64
+	RateLimit                   *int
+	BurstLimit                  *int
+	RateLimitHead               *int
+	BurstLimitHead              *int
+	RateLimitGet                *int
+	BurstLimitGet               *int
+	RateLimitPut                *int
+	BurstLimitPut               *int
+	RateLimitList               *int
+	BurstLimitList              *int
+	RateLimitDel                *int
+	BurstLimitDel               *int
+	MaxBuckets                  *int
+	UserAgent                   []byte
+	OwnerId                     []byte
+	Salt                        []byte
+	CreatedAt                   time.Time
+	DefaultPlacement            *int
+	DefaultVersioning           int
+	PromptedForVersioningBeta   bool
+	PassphraseEnc               []byte
+	PassphraseEncKeyId          *int
+	PathEncryption              bool
 }
 
-func Node_NoiseProto(v int) Node_NoiseProto_Field {
-	return Node_NoiseProto_Field{_set: true, _value: &v}
+func (Project) _Table() string { return "projects" }
+
+type Project_Create_Fields struct {
+	PublicId                    Project_PublicId_Field
+	UsageLimit                  Project_UsageLimit_Field
+	BandwidthLimit              Project_BandwidthLimit_Field
+	UserSpecifiedUsageLimit     Project_UserSpecifiedUsageLimit_Field
+	UserSpecifiedBandwidthLimit Project_UserSpecifiedBandwidthLimit_Field
+	SegmentLimit                Project_SegmentLimit_Field
+	RateLimit                   Project_RateLimit_Field
+	BurstLimit                  Project_BurstLimit_Field
+	RateLimitHead               Project_RateLimitHead_Field
+	BurstLimitHead              Project_BurstLimitHead_Field
+	RateLimitGet                Project_RateLimitGet_Field
+	BurstLimitGet               Project_BurstLimitGet_Field
+	RateLimitPut                Project_RateLimitPut_Field
+	BurstLimitPut               Project_BurstLimitPut_Field
+	RateLimitList               Project_RateLimitList_Field
+	BurstLimitList              Project_BurstLimitList_Field
+	RateLimitDel                Project_RateLimitDel_Field
+	BurstLimitDel               Project_BurstLimitDel_Field
+	MaxBuckets                  Project_MaxBuckets_Field
+	UserAgent                   Project_UserAgent_Field
+	Salt                        Project_Salt_Field
+	DefaultPlacement            Project_DefaultPlacement_Field
+	DefaultVersioning           Project_DefaultVersioning_Field
+	PromptedForVersioningBeta   Project_PromptedForVersioningBeta_Field
+	PassphraseEnc               Project_PassphraseEnc_Field
+	PassphraseEncKeyId          Project_PassphraseEncKeyId_Field
+	PathEncryption              Project_PathEncryption_Field
 }
 
-func Node_NoiseProto_Raw(v *int) Node_NoiseProto_Field {
-	if v == nil {
-		return Node_NoiseProto_Null()
-	}
-	return Node_NoiseProto(*v)
+type Project_Update_Fields struct {
+	Name                        Project_Name_Field
+	Description                 Project_Description_Field
+	UsageLimit                  Project_UsageLimit_Field
+	BandwidthLimit              Project_BandwidthLimit_Field
+	UserSpecifiedUsageLimit     Project_UserSpecifiedUsageLimit_Field
+	UserSpecifiedBandwidthLimit Project_UserSpecifiedBandwidthLimit_Field
+	SegmentLimit                Project_SegmentLimit_Field
+	RateLimit                   Project_RateLimit_Field
+	BurstLimit                  Project_BurstLimit_Field
+	RateLimitHead               Project_RateLimitHead_Field
+	BurstLimitHead              Project_BurstLimitHead_Field
+	RateLimitGet                Project_RateLimitGet_Field
+	BurstLimitGet               Project_BurstLimitGet_Field
+	RateLimitPut                Project_RateLimitPut_Field
+	BurstLimitPut               Project_BurstLimitPut_Field
+	RateLimitList               Project_RateLimitList_Field
+	BurstLimitList              Project_BurstLimitList_Field
+	RateLimitDel                Project_RateLimitDel_Field
+	BurstLimitDel               Project_BurstLimitDel_Field
+	MaxBuckets                  Project_MaxBuckets_Field
+	UserAgent                   Project_UserAgent_Field
+	DefaultPlacement            Project_DefaultPlacement_Field
+	DefaultVersioning           Project_DefaultVersioning_Field
+	PromptedForVersioningBeta   Project_PromptedForVersioningBeta_Field
+	PassphraseEnc               Project_PassphraseEnc_Field
+	PassphraseEncKeyId          Project_PassphraseEncKeyId_Field
+	PathEncryption              Project_PathEncryption_Field
+}
+
+type Project_Id_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
 }
 
-func Node_NoiseProto_Null() Node_NoiseProto_Field {
-	return Node_NoiseProto_Field{_set: true, _null: true}
+func Project_Id(v []byte) Project_Id_Field {
+	return Project_Id_Field{_set: true, _value: v}
 }
 
-func (f Node_NoiseProto_Field) isnull() bool { return !f._set || f._null || f._value == nil }
-
-func (f Node_NoiseProto_Field) value() interface{} {
+func (f Project_Id_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_NoiseProto_Field) _Column() string { return "noise_proto" }
+func (Project_Id_Field) _Column() string { return "id" }
 
-type Node_NoisePublicKey_Field struct {
+type Project_PublicId_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func Node_NoisePublicKey(v []byte) Node_NoisePublicKey_Field {
-	return Node_NoisePublicKey_Field{_set: true, _value: v}
+func Project_PublicId(v []byte) Project_PublicId_Field {
+	return Project_PublicId_Field{_set: true, _value: v}
 }
 
-func Node_NoisePublicKey_Raw(v []byte) Node_NoisePublicKey_Field {
+func Project_PublicId_Raw(v []byte) Project_PublicId_Field {
 	if v == nil {
-		return Node_NoisePublicKey_Null()
+		return Project_PublicId_Null()
 	}
-	return Node_NoisePublicKey(v)
+	return Project_PublicId(v)
 }
 
-func Node_NoisePublicKey_Null() Node_NoisePublicKey_Field {
-	return Node_NoisePublicKey_Field{_set: true, _null: true}
+func Project_PublicId_Null() Project_PublicId_Field {
+	return Project_PublicId_Field{_set: true, _null: true}
 }
 
-func (f Node_NoisePublicKey_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func (f Project_PublicId_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f Node_NoisePublicKey_Field) value() interface{} {
+func (f Project_PublicId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_NoisePublicKey_Field) _Column() string { return "noise_public_key" }
+func (Project_PublicId_Field) _Column() string { return "public_id" }
 
-type Node_DebounceLimit_Field struct {
+type Project_Name_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value string
 }
 
-func Node_DebounceLimit(v int) Node_DebounceLimit_Field {
-	return Node_DebounceLimit_Field{_set: true, _value: v}
+func Project_Name(v string) Project_Name_Field {
+	return Project_Name_Field{_set: true, _value: v}
 }
 
-func (f Node_DebounceLimit_Field) value() interface{} {
+func (f Project_Name_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_DebounceLimit_Field) _Column() string { return "debounce_limit" }
+func (Project_Name_Field) _Column() string { return "name" }
 
-type Node_Features_Field struct {
+type Project_Description_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value string
 }
 
-func Node_Features(v int) Node_Features_Field {
-	return Node_Features_Field{_set: true, _value: v}
+func Project_Description(v string) Project_Description_Field {
+	return Project_Description_Field{_set: true, _value: v}
 }
 
-func (f Node_Features_Field) value() interface{} {
+func (f Project_Description_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_Features_Field) _Column() string { return "features" }
+func (Project_Description_Field) _Column() string { return "description" }
 
-type NodeApiVersion struct {
-	Id         []byte
-	ApiVersion int
-	CreatedAt  time.Time
-	UpdatedAt  time.Time
+type Project_UsageLimit_Field struct {
+	_set   bool
+	_null  bool
+	_value *int64
 }
 
-func (NodeApiVersion) _Table() string { return "node_api_versions" }
-
-type NodeApiVersion_Update_Fields struct {
-	ApiVersion NodeApiVersion_ApiVersion_Field
+func Project_UsageLimit(v int64) Project_UsageLimit_Field {
+	return Project_UsageLimit_Field{_set: true, _value: &v}
 }
 
-type NodeApiVersion_Id_Field struct {
-	_set   bool
-	_null  bool
-	_value []byte
+func Project_UsageLimit_Raw(v *int64) Project_UsageLimit_Field {
+	if v == nil {
+		return Project_UsageLimit_Null()
+	}
+	return Project_UsageLimit(*v)
 }
 
-func NodeApiVersion_Id(v []byte) NodeApiVersion_Id_Field {
-	return NodeApiVersion_Id_Field{_set: true, _value: v}
+func Project_UsageLimit_Null() Project_UsageLimit_Field {
+	return Project_UsageLimit_Field{_set: true, _null: true}
 }
 
-func (f NodeApiVersion_Id_Field) value() interface{} {
+func (f Project_UsageLimit_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Project_UsageLimit_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (NodeApiVersion_Id_Field) _Column() string { return "id" }
+func (Project_UsageLimit_Field) _Column() string { return "usage_limit" }
 
-type NodeApiVersion_ApiVersion_Field struct {
+type Project_BandwidthLimit_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value *int64
 }
 
-func NodeApiVersion_ApiVersion(v int) NodeApiVersion_ApiVersion_Field {
-	return NodeApiVersion_ApiVersion_Field{_set: true, _value: v}
+func Project_BandwidthLimit(v int64) Project_BandwidthLimit_Field {
+	return Project_BandwidthLimit_Field{_set: true, _value: &v}
 }
 
-func (f NodeApiVersion_ApiVersion_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
+func Project_BandwidthLimit_Raw(v *int64) Project_BandwidthLimit_Field {
+	if v == nil {
+		return Project_BandwidthLimit_Null()
 	}
-	return f._value
+	return Project_BandwidthLimit(*v)
 }
 
-func (NodeApiVersion_ApiVersion_Field) _Column() string { return "api_version" }
-
-type NodeApiVersion_CreatedAt_Field struct {
-	_set   bool
-	_null  bool
-	_value time.Time
+func Project_BandwidthLimit_Null() Project_BandwidthLimit_Field {
+	return Project_BandwidthLimit_Field{_set: true, _null: true}
 }
 
-func NodeApiVersion_CreatedAt(v time.Time) NodeApiVersion_CreatedAt_Field {
-	return NodeApiVersion_CreatedAt_Field{_set: true, _value: v}
-}
+func (f Project_BandwidthLimit_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f NodeApiVersion_CreatedAt_Field) value() interface{} {
+func (f Project_BandwidthLimit_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (NodeApiVersion_CreatedAt_Field) _Column() string { return "created_at" }
+func (Project_BandwidthLimit_Field) _Column() string { return "bandwidth_limit" }
 
-type NodeApiVersion_UpdatedAt_Field struct {
+type Project_UserSpecifiedUsageLimit_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value *int64
 }
 
-func NodeApiVersion_UpdatedAt(v time.Time) NodeApiVersion_UpdatedAt_Field {
-	return NodeApiVersion_UpdatedAt_Field{_set: true, _value: v}
+func Project_UserSpecifiedUsageLimit(v int64) Project_UserSpecifiedUsageLimit_Field {
+	return Project_UserSpecifiedUsageLimit_Field{_set: true, _value: &v}
 }
 
-func (f NodeApiVersion_UpdatedAt_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
+func Project_UserSpecifiedUsageLimit_Raw(v *int64) Project_UserSpecifiedUsageLimit_Field {
+	if v == nil {
+		return Project_UserSpecifiedUsageLimit_Null()
 	}
-	return f._value
+	return Project_UserSpecifiedUsageLimit(*v)
 }
 
-func (NodeApiVersion_UpdatedAt_Field) _Column() string { return "updated_at" }
-
-type NodeEvent struct {
-	Id            []byte
-	Email         string
-	LastIpPort    *string
-	NodeId        []byte
-	Event         int
-	CreatedAt     time.Time
-	LastAttempted *time.Time
-	EmailSent     *time.Time
+func Project_UserSpecifiedUsageLimit_Null() Project_UserSpecifiedUsageLimit_Field {
+	return Project_UserSpecifiedUsageLimit_Field{_set: true, _null: true}
 }
 
-func (NodeEvent) _Table() string { return "node_events" }
-
-type NodeEvent_Create_Fields struct {
-	LastIpPort    NodeEvent_LastIpPort_Field
-	CreatedAt     NodeEvent_CreatedAt_Field
-	LastAttempted NodeEvent_LastAttempted_Field
-	EmailSent     NodeEvent_EmailSent_Field
+func (f Project_UserSpecifiedUsageLimit_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
 }
 
-type NodeEvent_Update_Fields struct {
-	LastAttempted NodeEvent_LastAttempted_Field
-	EmailSent     NodeEvent_EmailSent_Field
+func (f Project_UserSpecifiedUsageLimit_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
 }
 
-type NodeEvent_Id_Field struct {
+func (Project_UserSpecifiedUsageLimit_Field) _Column() string { return "user_specified_usage_limit" }
+
+type Project_UserSpecifiedBandwidthLimit_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value *int64
 }
 
-func NodeEvent_Id(v []byte) NodeEvent_Id_Field {
-	return NodeEvent_Id_Field{_set: true, _value: v}
+func Project_UserSpecifiedBandwidthLimit(v int64) Project_UserSpecifiedBandwidthLimit_Field {
+	return Project_UserSpecifiedBandwidthLimit_Field{_set: true, _value: &v}
 }
 
-func (f NodeEvent_Id_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
+func Project_UserSpecifiedBandwidthLimit_Raw(v *int64) Project_UserSpecifiedBandwidthLimit_Field {
+	if v == nil {
+		return Project_UserSpecifiedBandwidthLimit_Null()
 	}
-	return f._value
+	return Project_UserSpecifiedBandwidthLimit(*v)
 }
 
-func (NodeEvent_Id_Field) _Column() string { return "id" }
-
-type NodeEvent_Email_Field struct {
-	_set   bool
-	_null  bool
-	_value string
+func Project_UserSpecifiedBandwidthLimit_Null() Project_UserSpecifiedBandwidthLimit_Field {
+	return Project_UserSpecifiedBandwidthLimit_Field{_set: true, _null: true}
 }
 
-func NodeEvent_Email(v string) NodeEvent_Email_Field {
-	return NodeEvent_Email_Field{_set: true, _value: v}
+func (f Project_UserSpecifiedBandwidthLimit_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
 }
 
-func (f NodeEvent_Email_Field) value() interface{} {
+func (f Project_UserSpecifiedBandwidthLimit_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (NodeEvent_Email_Field) _Column() string { return "email" }
+func (Project_UserSpecifiedBandwidthLimit_Field) _Column() string {
+	return "user_specified_bandwidth_limit"
+}
 
-type NodeEvent_LastIpPort_Field struct {
+type Project_SegmentLimit_Field struct {
 	_set   bool
 	_null  bool
-	_value *string
+	_value *int64
 }
 
-func NodeEvent_LastIpPort(v string) NodeEvent_LastIpPort_Field {
-	return NodeEvent_LastIpPort_Field{_set: true, _value: &v}
+func Project_SegmentLimit(v int64) Project_SegmentLimit_Field {
+	return Project_SegmentLimit_Field{_set: true, _value: &v}
 }
 
-func NodeEvent_LastIpPort_Raw(v *string) NodeEvent_LastIpPort_Field {
+func Project_SegmentLimit_Raw(v *int64) Project_SegmentLimit_Field {
 	if v == nil {
-		return NodeEvent_LastIpPort_Null()
+		return Project_SegmentLimit_Null()
 	}
-	return NodeEvent_LastIpPort(*v)
+	return Project_SegmentLimit(*v)
 }
 
-func NodeEvent_LastIpPort_Null() NodeEvent_LastIpPort_Field {
-	return NodeEvent_LastIpPort_Field{_set: true, _null: true}
+func Project_SegmentLimit_Null() Project_SegmentLimit_Field {
+	return Project_SegmentLimit_Field{_set: true, _null: true}
 }
 
-func (f NodeEvent_LastIpPort_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func (f Project_SegmentLimit_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f NodeEvent_LastIpPort_Field) value() interface{} {
+func (f Project_SegmentLimit_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (NodeEvent_LastIpPort_Field) _Column() string { return "last_ip_port" }
+func (Project_SegmentLimit_Field) _Column() string { return "segment_limit" }
 
-type NodeEvent_NodeId_Field struct {
+type Project_RateLimit_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value *int
 }
 
-func NodeEvent_NodeId(v []byte) NodeEvent_NodeId_Field {
-	return NodeEvent_NodeId_Field{_set: true, _value: v}
+func Project_RateLimit(v int) Project_RateLimit_Field {
+	return Project_RateLimit_Field{_set: true, _value: &v}
 }
 
-func (f NodeEvent_NodeId_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
+func Project_RateLimit_Raw(v *int) Project_RateLimit_Field {
+	if v == nil {
+		return Project_RateLimit_Null()
 	}
-	return f._value
-}
-
-func (NodeEvent_NodeId_Field) _Column() string { return "node_id" }
-
-type NodeEvent_Event_Field struct {
-	_set   bool
-	_null  bool
-	_value int
+	return Project_RateLimit(*v)
 }
 
-func NodeEvent_Event(v int) NodeEvent_Event_Field {
-	return NodeEvent_Event_Field{_set: true, _value: v}
+func Project_RateLimit_Null() Project_RateLimit_Field {
+	return Project_RateLimit_Field{_set: true, _null: true}
 }
 
-func (f NodeEvent_Event_Field) value() interface{} {
+func (f Project_RateLimit_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Project_RateLimit_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (NodeEvent_Event_Field) _Column() string { return "event" }
+func (Project_RateLimit_Field) _Column() string { return "rate_limit" }
 
-type NodeEvent_CreatedAt_Field struct {
+type Project_BurstLimit_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value *int
 }
 
-func NodeEvent_CreatedAt(v time.Time) NodeEvent_CreatedAt_Field {
-	return NodeEvent_CreatedAt_Field{_set: true, _value: v}
+func Project_BurstLimit(v int) Project_BurstLimit_Field {
+	return Project_BurstLimit_Field{_set: true, _value: &v}
 }
 
-func (f NodeEvent_CreatedAt_Field) value() interface{} {
+func Project_BurstLimit_Raw(v *int) Project_BurstLimit_Field {
+	if v == nil {
+		return Project_BurstLimit_Null()
+	}
+	return Project_BurstLimit(*v)
+}
+
+func Project_BurstLimit_Null() Project_BurstLimit_Field {
+	return Project_BurstLimit_Field{_set: true, _null: true}
+}
+
+func (f Project_BurstLimit_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Project_BurstLimit_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (NodeEvent_CreatedAt_Field) _Column() string { return "created_at" }
+func (Project_BurstLimit_Field) _Column() string { return "burst_limit" }
 
-type NodeEvent_LastAttempted_Field struct {
+type Project_RateLimitHead_Field struct {
 	_set   bool
 	_null  bool
-	_value *time.Time
+	_value *int
 }
 
-func NodeEvent_LastAttempted(v time.Time) NodeEvent_LastAttempted_Field {
-	return NodeEvent_LastAttempted_Field{_set: true, _value: &v}
+func Project_RateLimitHead(v int) Project_RateLimitHead_Field {
+	return Project_RateLimitHead_Field{_set: true, _value: &v}
 }
 
-func NodeEvent_LastAttempted_Raw(v *time.Time) NodeEvent_LastAttempted_Field {
+func Project_RateLimitHead_Raw(v *int) Project_RateLimitHead_Field {
 	if v == nil {
-		return NodeEvent_LastAttempted_Null()
+		return Project_RateLimitHead_Null()
 	}
-	return NodeEvent_LastAttempted(*v)
+	return Project_RateLimitHead(*v)
 }
 
-func NodeEvent_LastAttempted_Null() NodeEvent_LastAttempted_Field {
-	return NodeEvent_LastAttempted_Field{_set: true, _null: true}
+func Project_RateLimitHead_Null() Project_RateLimitHead_Field {
+	return Project_RateLimitHead_Field{_set: true, _null: true}
 }
 
-func (f NodeEvent_LastAttempted_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func (f Project_RateLimitHead_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f NodeEvent_LastAttempted_Field) value() interface{} {
+func (f Project_RateLimitHead_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (NodeEvent_LastAttempted_Field) _Column() string { return "last_attempted" }
+func (Project_RateLimitHead_Field) _Column() string { return "rate_limit_head" }
 
-type NodeEvent_EmailSent_Field struct {
+type Project_BurstLimitHead_Field struct {
 	_set   bool
 	_null  bool
-	_value *time.Time
+	_value *int
 }
 
-func NodeEvent_EmailSent(v time.Time) NodeEvent_EmailSent_Field {
-	return NodeEvent_EmailSent_Field{_set: true, _value: &v}
+func Project_BurstLimitHead(v int) Project_BurstLimitHead_Field {
+	return Project_BurstLimitHead_Field{_set: true, _value: &v}
 }
 
-func NodeEvent_EmailSent_Raw(v *time.Time) NodeEvent_EmailSent_Field {
+func Project_BurstLimitHead_Raw(v *int) Project_BurstLimitHead_Field {
 	if v == nil {
-		return NodeEvent_EmailSent_Null()
+		return Project_BurstLimitHead_Null()
 	}
-	return NodeEvent_EmailSent(*v)
+	return Project_BurstLimitHead(*v)
 }
 
-func NodeEvent_EmailSent_Null() NodeEvent_EmailSent_Field {
-	return NodeEvent_EmailSent_Field{_set: true, _null: true}
+func Project_BurstLimitHead_Null() Project_BurstLimitHead_Field {
+	return Project_BurstLimitHead_Field{_set: true, _null: true}
 }
 
-func (f NodeEvent_EmailSent_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func (f Project_BurstLimitHead_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f NodeEvent_EmailSent_Field) value() interface{} {
+func (f Project_BurstLimitHead_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (NodeEvent_EmailSent_Field) _Column() string { return "email_sent" }
+func (Project_BurstLimitHead_Field) _Column() string { return "burst_limit_head" }
 
-type NodeTags struct {
-	NodeId   []byte
-	Name     string
-	Value    []byte
-	SignedAt time.Time
-	Signer   []byte
+type Project_RateLimitGet_Field struct {
+	_set   bool
+	_null  bool
+	_value *int
 }
 
-func (NodeTags) _Table() string { return "node_tags" }
-
-type NodeTags_Update_Fields struct {
+func Project_RateLimitGet(v int) Project_RateLimitGet_Field {
+	return Project_RateLimitGet_Field{_set: true, _value: &v}
 }
 
-type NodeTags_NodeId_Field struct {
-	_set   bool
-	_null  bool
-	_value []byte
+func Project_RateLimitGet_Raw(v *int) Project_RateLimitGet_Field {
+	if v == nil {
+		return Project_RateLimitGet_Null()
+	}
+	return Project_RateLimitGet(*v)
 }
 
-func NodeTags_NodeId(v []byte) NodeTags_NodeId_Field {
-	return NodeTags_NodeId_Field{_set: true, _value: v}
+func Project_RateLimitGet_Null() Project_RateLimitGet_Field {
+	return Project_RateLimitGet_Field{_set: true, _null: true}
 }
 
-func (f NodeTags_NodeId_Field) value() interface{} {
+func (f Project_RateLimitGet_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Project_RateLimitGet_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (NodeTags_NodeId_Field) _Column() string { return "node_id" }
+func (Project_RateLimitGet_Field) _Column() string { return "rate_limit_get" }
 
-type NodeTags_Name_Field struct {
+type Project_BurstLimitGet_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value *int
 }
 
-func NodeTags_Name(v string) NodeTags_Name_Field {
-	return NodeTags_Name_Field{_set: true, _value: v}
+func Project_BurstLimitGet(v int) Project_BurstLimitGet_Field {
+	return Project_BurstLimitGet_Field{_set: true, _value: &v}
 }
 
-func (f NodeTags_Name_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
+func Project_BurstLimitGet_Raw(v *int) Project_BurstLimitGet_Field {
+	if v == nil {
+		return Project_BurstLimitGet_Null()
 	}
-	return f._value
+	return Project_BurstLimitGet(*v)
 }
 
-func (NodeTags_Name_Field) _Column() string { return "name" }
-
-type NodeTags_Value_Field struct {
-	_set   bool
-	_null  bool
-	_value []byte
+func Project_BurstLimitGet_Null() Project_BurstLimitGet_Field {
+	return Project_BurstLimitGet_Field{_set: true, _null: true}
 }
 
-func NodeTags_Value(v []byte) NodeTags_Value_Field {
-	return NodeTags_Value_Field{_set: true, _value: v}
-}
+func (f Project_BurstLimitGet_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f NodeTags_Value_Field) value() interface{} {
+func (f Project_BurstLimitGet_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (NodeTags_Value_Field) _Column() string { return "value" }
+func (Project_BurstLimitGet_Field) _Column() string { return "burst_limit_get" }
 
-type NodeTags_SignedAt_Field struct {
+type Project_RateLimitPut_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value *int
 }
 
-func NodeTags_SignedAt(v time.Time) NodeTags_SignedAt_Field {
-	return NodeTags_SignedAt_Field{_set: true, _value: v}
+func Project_RateLimitPut(v int) Project_RateLimitPut_Field {
+	return Project_RateLimitPut_Field{_set: true, _value: &v}
 }
 
-func (f NodeTags_SignedAt_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
+func Project_RateLimitPut_Raw(v *int) Project_RateLimitPut_Field {
+	if v == nil {
+		return Project_RateLimitPut_Null()
 	}
-	return f._value
+	return Project_RateLimitPut(*v)
 }
 
-func (NodeTags_SignedAt_Field) _Column() string { return "signed_at" }
-
-type NodeTags_Signer_Field struct {
-	_set   bool
-	_null  bool
-	_value []byte
+func Project_RateLimitPut_Null() Project_RateLimitPut_Field {
+	return Project_RateLimitPut_Field{_set: true, _null: true}
 }
 
-func NodeTags_Signer(v []byte) NodeTags_Signer_Field {
-	return NodeTags_Signer_Field{_set: true, _value: v}
-}
+func (f Project_RateLimitPut_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f NodeTags_Signer_Field) value() interface{} {
+func (f Project_RateLimitPut_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (NodeTags_Signer_Field) _Column() string { return "signer" }
+func (Project_RateLimitPut_Field) _Column() string { return "rate_limit_put" }
 
-type OauthClient struct {
-	Id              []byte
-	EncryptedSecret []byte
-	RedirectUrl     string
-	UserId          []byte
-	AppName         string
-	AppLogoUrl      string
+type Project_BurstLimitPut_Field struct {
+	_set   bool
+	_null  bool
+	_value *int
 }
 
-func (OauthClient) _Table() string { return "oauth_clients" }
-
-type OauthClient_Update_Fields struct {
-	EncryptedSecret OauthClient_EncryptedSecret_Field
-	RedirectUrl     OauthClient_RedirectUrl_Field
-	AppName         OauthClient_AppName_Field
-	AppLogoUrl      OauthClient_AppLogoUrl_Field
+func Project_BurstLimitPut(v int) Project_BurstLimitPut_Field {
+	return Project_BurstLimitPut_Field{_set: true, _value: &v}
 }
 
-type OauthClient_Id_Field struct {
-	_set   bool
-	_null  bool
-	_value []byte
+func Project_BurstLimitPut_Raw(v *int) Project_BurstLimitPut_Field {
+	if v == nil {
+		return Project_BurstLimitPut_Null()
+	}
+	return Project_BurstLimitPut(*v)
 }
 
-func OauthClient_Id(v []byte) OauthClient_Id_Field {
-	return OauthClient_Id_Field{_set: true, _value: v}
+func Project_BurstLimitPut_Null() Project_BurstLimitPut_Field {
+	return Project_BurstLimitPut_Field{_set: true, _null: true}
 }
 
-func (f OauthClient_Id_Field) value() interface{} {
+func (f Project_BurstLimitPut_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Project_BurstLimitPut_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OauthClient_Id_Field) _Column() string { return "id" }
+func (Project_BurstLimitPut_Field) _Column() string { return "burst_limit_put" }
 
-type OauthClient_EncryptedSecret_Field struct {
+type Project_RateLimitList_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value *int
 }
 
-func OauthClient_EncryptedSecret(v []byte) OauthClient_EncryptedSecret_Field {
-	return OauthClient_EncryptedSecret_Field{_set: true, _value: v}
+func Project_RateLimitList(v int) Project_RateLimitList_Field {
+	return Project_RateLimitList_Field{_set: true, _value: &v}
 }
 
-func (f OauthClient_EncryptedSecret_Field) value() interface{} {
+func Project_RateLimitList_Raw(v *int) Project_RateLimitList_Field {
+	if v == nil {
+		return Project_RateLimitList_Null()
+	}
+	return Project_RateLimitList(*v)
+}
+
+func Project_RateLimitList_Null() Project_RateLimitList_Field {
+	return Project_RateLimitList_Field{_set: true, _null: true}
+}
+
+func (f Project_RateLimitList_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Project_RateLimitList_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OauthClient_EncryptedSecret_Field) _Column() string { return "encrypted_secret" }
+func (Project_RateLimitList_Field) _Column() string { return "rate_limit_list" }
 
-type OauthClient_RedirectUrl_Field struct {
+type Project_BurstLimitList_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value *int
 }
 
-func OauthClient_RedirectUrl(v string) OauthClient_RedirectUrl_Field {
-	return OauthClient_RedirectUrl_Field{_set: true, _value: v}
+func Project_BurstLimitList(v int) Project_BurstLimitList_Field {
+	return Project_BurstLimitList_Field{_set: true, _value: &v}
 }
 
-func (f OauthClient_RedirectUrl_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
+func Project_BurstLimitList_Raw(v *int) Project_BurstLimitList_Field {
+	if v == nil {
+		return Project_BurstLimitList_Null()
 	}
-	return f._value
+	return Project_BurstLimitList(*v)
 }
 
-func (OauthClient_RedirectUrl_Field) _Column() string { return "redirect_url" }
-
-type OauthClient_UserId_Field struct {
-	_set   bool
-	_null  bool
-	_value []byte
+func Project_BurstLimitList_Null() Project_BurstLimitList_Field {
+	return Project_BurstLimitList_Field{_set: true, _null: true}
 }
 
-func OauthClient_UserId(v []byte) OauthClient_UserId_Field {
-	return OauthClient_UserId_Field{_set: true, _value: v}
-}
+func (f Project_BurstLimitList_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f OauthClient_UserId_Field) value() interface{} {
+func (f Project_BurstLimitList_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OauthClient_UserId_Field) _Column() string { return "user_id" }
+func (Project_BurstLimitList_Field) _Column() string { return "burst_limit_list" }
 
-type OauthClient_AppName_Field struct {
+type Project_RateLimitDel_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value *int
 }
 
-func OauthClient_AppName(v string) OauthClient_AppName_Field {
-	return OauthClient_AppName_Field{_set: true, _value: v}
+func Project_RateLimitDel(v int) Project_RateLimitDel_Field {
+	return Project_RateLimitDel_Field{_set: true, _value: &v}
 }
 
-func (f OauthClient_AppName_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
+func Project_RateLimitDel_Raw(v *int) Project_RateLimitDel_Field {
+	if v == nil {
+		return Project_RateLimitDel_Null()
 	}
-	return f._value
+	return Project_RateLimitDel(*v)
 }
 
-func (OauthClient_AppName_Field) _Column() string { return "app_name" }
-
-type OauthClient_AppLogoUrl_Field struct {
-	_set   bool
-	_null  bool
-	_value string
+func Project_RateLimitDel_Null() Project_RateLimitDel_Field {
+	return Project_RateLimitDel_Field{_set: true, _null: true}
 }
 
-func OauthClient_AppLogoUrl(v string) OauthClient_AppLogoUrl_Field {
-	return OauthClient_AppLogoUrl_Field{_set: true, _value: v}
-}
+func (f Project_RateLimitDel_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f OauthClient_AppLogoUrl_Field) value() interface{} {
+func (f Project_RateLimitDel_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OauthClient_AppLogoUrl_Field) _Column() string { return "app_logo_url" }
+func (Project_RateLimitDel_Field) _Column() string { return "rate_limit_del" }
 
-type OauthCode struct {
-	ClientId        []byte
-	UserId          []byte
-	Scope           string
-	RedirectUrl     string
-	Challenge       string
-	ChallengeMethod string
-	Code            string
-	CreatedAt       time.Time
-	ExpiresAt       time.Time
-	ClaimedAt       *time.Time
+type Project_BurstLimitDel_Field struct {
+	_set   bool
+	_null  bool
+	_value *int
 }
 
-func (OauthCode) _Table() string { return "oauth_codes" }
-
-type OauthCode_Create_Fields struct {
-	ClaimedAt OauthCode_ClaimedAt_Field
+func Project_BurstLimitDel(v int) Project_BurstLimitDel_Field {
+	return Project_BurstLimitDel_Field{_set: true, _value: &v}
 }
 
-type OauthCode_Update_Fields struct {
-	ClaimedAt OauthCode_ClaimedAt_Field
+func Project_BurstLimitDel_Raw(v *int) Project_BurstLimitDel_Field {
+	if v == nil {
+		return Project_BurstLimitDel_Null()
+	}
+	return Project_BurstLimitDel(*v)
 }
 
-type OauthCode_ClientId_Field struct {
-	_set   bool
-	_null  bool
-	_value []byte
+func Project_BurstLimitDel_Null() Project_BurstLimitDel_Field {
+	return Project_BurstLimitDel_Field{_set: true, _null: true}
 }
 
-func OauthCode_ClientId(v []byte) OauthCode_ClientId_Field {
-	return OauthCode_ClientId_Field{_set: true, _value: v}
-}
+func (f Project_BurstLimitDel_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f OauthCode_ClientId_Field) value() interface{} {
+func (f Project_BurstLimitDel_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OauthCode_ClientId_Field) _Column() string { return "client_id" }
+func (Project_BurstLimitDel_Field) _Column() string { return "burst_limit_del" }
 
-type OauthCode_UserId_Field struct {
+type Project_MaxBuckets_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value *int
 }
 
-func OauthCode_UserId(v []byte) OauthCode_UserId_Field {
-	return OauthCode_UserId_Field{_set: true, _value: v}
+func Project_MaxBuckets(v int) Project_MaxBuckets_Field {
+	return Project_MaxBuckets_Field{_set: true, _value: &v}
 }
 
-func (f OauthCode_UserId_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
+func Project_MaxBuckets_Raw(v *int) Project_MaxBuckets_Field {
+	if v == nil {
+		return Project_MaxBuckets_Null()
 	}
-	return f._value
+	return Project_MaxBuckets(*v)
 }
 
-func (OauthCode_UserId_Field) _Column() string { return "user_id" }
-
-type OauthCode_Scope_Field struct {
-	_set   bool
-	_null  bool
-	_value string
+func Project_MaxBuckets_Null() Project_MaxBuckets_Field {
+	return Project_MaxBuckets_Field{_set: true, _null: true}
 }
 
-func OauthCode_Scope(v string) OauthCode_Scope_Field {
-	return OauthCode_Scope_Field{_set: true, _value: v}
-}
+func (f Project_MaxBuckets_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f OauthCode_Scope_Field) value() interface{} {
+func (f Project_MaxBuckets_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OauthCode_Scope_Field) _Column() string { return "scope" }
+func (Project_MaxBuckets_Field) _Column() string { return "max_buckets" }
 
-type OauthCode_RedirectUrl_Field struct {
+type Project_UserAgent_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value []byte
 }
 
-func OauthCode_RedirectUrl(v string) OauthCode_RedirectUrl_Field {
-	return OauthCode_RedirectUrl_Field{_set: true, _value: v}
+func Project_UserAgent(v []byte) Project_UserAgent_Field {
+	return Project_UserAgent_Field{_set: true, _value: v}
 }
 
-func (f OauthCode_RedirectUrl_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
+func Project_UserAgent_Raw(v []byte) Project_UserAgent_Field {
+	if v == nil {
+		return Project_UserAgent_Null()
 	}
-	return f._value
+	return Project_UserAgent(v)
 }
 
-func (OauthCode_RedirectUrl_Field) _Column() string { return "redirect_url" }
-
-type OauthCode_Challenge_Field struct {
-	_set   bool
-	_null  bool
-	_value string
+func Project_UserAgent_Null() Project_UserAgent_Field {
+	return Project_UserAgent_Field{_set: true, _null: true}
 }
 
-func OauthCode_Challenge(v string) OauthCode_Challenge_Field {
-	return OauthCode_Challenge_Field{_set: true, _value: v}
-}
+func (f Project_UserAgent_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f OauthCode_Challenge_Field) value() interface{} {
+func (f Project_UserAgent_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OauthCode_Challenge_Field) _Column() string { return "challenge" }
+func (Project_UserAgent_Field) _Column() string { return "user_agent" }
 
-type OauthCode_ChallengeMethod_Field struct {
+type Project_OwnerId_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value []byte
 }
 
-func OauthCode_ChallengeMethod(v string) OauthCode_ChallengeMethod_Field {
-	return OauthCode_ChallengeMethod_Field{_set: true, _value: v}
+func Project_OwnerId(v []byte) Project_OwnerId_Field {
+	return Project_OwnerId_Field{_set: true, _value: v}
 }
 
-func (f OauthCode_ChallengeMethod_Field) value() interface{} {
+func (f Project_OwnerId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OauthCode_ChallengeMethod_Field) _Column() string { return "challenge_method" }
+func (Project_OwnerId_Field) _Column() string { return "owner_id" }
 
-type OauthCode_Code_Field struct {
+type Project_Salt_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value []byte
 }
 
-func OauthCode_Code(v string) OauthCode_Code_Field {
-	return OauthCode_Code_Field{_set: true, _value: v}
+func Project_Salt(v []byte) Project_Salt_Field {
+	return Project_Salt_Field{_set: true, _value: v}
 }
 
-func (f OauthCode_Code_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
+func Project_Salt_Raw(v []byte) Project_Salt_Field {
+	if v == nil {
+		return Project_Salt_Null()
 	}
-	return f._value
+	return Project_Salt(v)
 }
 
-func (OauthCode_Code_Field) _Column() string { return "code" }
-
-type OauthCode_CreatedAt_Field struct {
-	_set   bool
-	_null  bool
-	_value time.Time
+func Project_Salt_Null() Project_Salt_Field {
+	return Project_Salt_Field{_set: true, _null: true}
 }
 
-func OauthCode_CreatedAt(v time.Time) OauthCode_CreatedAt_Field {
-	return OauthCode_CreatedAt_Field{_set: true, _value: v}
-}
+func (f Project_Salt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f OauthCode_CreatedAt_Field) value() interface{} {
+func (f Project_Salt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OauthCode_CreatedAt_Field) _Column() string { return "created_at" }
+func (Project_Salt_Field) _Column() string { return "salt" }
 
-type OauthCode_ExpiresAt_Field struct {
+type Project_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
 	_value time.Time
 }
 
-func OauthCode_ExpiresAt(v time.Time) OauthCode_ExpiresAt_Field {
-	return OauthCode_ExpiresAt_Field{_set: true, _value: v}
+func Project_CreatedAt(v time.Time) Project_CreatedAt_Field {
+	return Project_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f OauthCode_ExpiresAt_Field) value() interface{} {
+func (f Project_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OauthCode_ExpiresAt_Field) _Column() string { return "expires_at" }
+func (Project_CreatedAt_Field) _Column() string { return "created_at" }
 
-type OauthCode_ClaimedAt_Field struct {
+type Project_DefaultPlacement_Field struct {
 	_set   bool
 	_null  bool
-	_value *time.Time
+	_value *int
 }
 
-func OauthCode_ClaimedAt(v time.Time) OauthCode_ClaimedAt_Field {
-	return OauthCode_ClaimedAt_Field{_set: true, _value: &v}
+func Project_DefaultPlacement(v int) Project_DefaultPlacement_Field {
+	return Project_DefaultPlacement_Field{_set: true, _value: &v}
 }
 
-func OauthCode_ClaimedAt_Raw(v *time.Time) OauthCode_ClaimedAt_Field {
+func Project_DefaultPlacement_Raw(v *int) Project_DefaultPlacement_Field {
 	if v == nil {
-		return OauthCode_ClaimedAt_Null()
+		return Project_DefaultPlacement_Null()
 	}
-	return OauthCode_ClaimedAt(*v)
+	return Project_DefaultPlacement(*v)
 }
 
-func OauthCode_ClaimedAt_Null() OauthCode_ClaimedAt_Field {
-	return OauthCode_ClaimedAt_Field{_set: true, _null: true}
+func Project_DefaultPlacement_Null() Project_DefaultPlacement_Field {
+	return Project_DefaultPlacement_Field{_set: true, _null: true}
 }
 
-func (f OauthCode_ClaimedAt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func (f Project_DefaultPlacement_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f OauthCode_ClaimedAt_Field) value() interface{} {
+func (f Project_DefaultPlacement_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OauthCode_ClaimedAt_Field) _Column() string { return "claimed_at" }
+func (Project_DefaultPlacement_Field) _Column() string { return "default_placement" }
 
-type OauthToken struct {
-	ClientId  []byte
-	UserId    []byte
-	Scope     string
-	Kind      int
-	Token     []byte
-	CreatedAt time.Time
-	ExpiresAt time.Time
+type Project_DefaultVersioning_Field struct {
+	_set   bool
+	_null  bool
+	_value int
 }
 
-func (OauthToken) _Table() string { return "oauth_tokens" }
+func Project_DefaultVersioning(v int) Project_DefaultVersioning_Field {
+	return Project_DefaultVersioning_Field{_set: true, _value: v}
+}
 
-type OauthToken_Update_Fields struct {
-	ExpiresAt OauthToken_ExpiresAt_Field
+func (f Project_DefaultVersioning_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
 }
 
-type OauthToken_ClientId_Field struct {
+func (Project_DefaultVersioning_Field) _Column() string { return "default_versioning" }
+
+type Project_PromptedForVersioningBeta_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value bool
 }
 
-func OauthToken_ClientId(v []byte) OauthToken_ClientId_Field {
-	return OauthToken_ClientId_Field{_set: true, _value: v}
+func Project_PromptedForVersioningBeta(v bool) Project_PromptedForVersioningBeta_Field {
+	return Project_PromptedForVersioningBeta_Field{_set: true, _value: v}
 }
 
-func (f OauthToken_ClientId_Field) value() interface{} {
+func (f Project_PromptedForVersioningBeta_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OauthToken_ClientId_Field) _Column() string { return "client_id" }
+func (Project_PromptedForVersioningBeta_Field) _Column() string {
+	return "prompted_for_versioning_beta"
+}
 
-type OauthToken_UserId_Field struct {
+type Project_PassphraseEnc_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func OauthToken_UserId(v []byte) OauthToken_UserId_Field {
-	return OauthToken_UserId_Field{_set: true, _value: v}
+func Project_PassphraseEnc(v []byte) Project_PassphraseEnc_Field {
+	return Project_PassphraseEnc_Field{_set: true, _value: v}
 }
 
-func (f OauthToken_UserId_Field) value() interface{} {
+func Project_PassphraseEnc_Raw(v []byte) Project_PassphraseEnc_Field {
+	if v == nil {
+		return Project_PassphraseEnc_Null()
+	}
+	return Project_PassphraseEnc(v)
+}
+
+func Project_PassphraseEnc_Null() Project_PassphraseEnc_Field {
+	return Project_PassphraseEnc_Field{_set: true, _null: true}
+}
+
+func (f Project_PassphraseEnc_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Project_PassphraseEnc_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OauthToken_UserId_Field) _Column() string { return "user_id" }
+func (Project_PassphraseEnc_Field) _Column() string { return "passphrase_enc" }
 
-type OauthToken_Scope_Field struct {
+type Project_PassphraseEncKeyId_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value *int
 }
 
-func OauthToken_Scope(v string) OauthToken_Scope_Field {
-	return OauthToken_Scope_Field{_set: true, _value: v}
+func Project_PassphraseEncKeyId(v int) Project_PassphraseEncKeyId_Field {
+	return Project_PassphraseEncKeyId_Field{_set: true, _value: &v}
 }
 
-func (f OauthToken_Scope_Field) value() interface{} {
+func Project_PassphraseEncKeyId_Raw(v *int) Project_PassphraseEncKeyId_Field {
+	if v == nil {
+		return Project_PassphraseEncKeyId_Null()
+	}
+	return Project_PassphraseEncKeyId(*v)
+}
+
+func Project_PassphraseEncKeyId_Null() Project_PassphraseEncKeyId_Field {
+	return Project_PassphraseEncKeyId_Field{_set: true, _null: true}
+}
+
+func (f Project_PassphraseEncKeyId_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Project_PassphraseEncKeyId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OauthToken_Scope_Field) _Column() string { return "scope" }
+func (Project_PassphraseEncKeyId_Field) _Column() string { return "passphrase_enc_key_id" }
 
-type OauthToken_Kind_Field struct {
+type Project_PathEncryption_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value bool
 }
 
-func OauthToken_Kind(v int) OauthToken_Kind_Field {
-	return OauthToken_Kind_Field{_set: true, _value: v}
+func Project_PathEncryption(v bool) Project_PathEncryption_Field {
+	return Project_PathEncryption_Field{_set: true, _value: v}
 }
 
-func (f OauthToken_Kind_Field) value() interface{} {
+func (f Project_PathEncryption_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OauthToken_Kind_Field) _Column() string { return "kind" }
+func (Project_PathEncryption_Field) _Column() string { return "path_encryption" }
 
-type OauthToken_Token_Field struct {
+type ProjectBandwidthDailyRollup struct {
+	ProjectId       []byte
+	IntervalDay     time.Time
+	EgressAllocated uint64
+	EgressSettled   uint64
+	EgressDead      uint64
+}
+
+func (ProjectBandwidthDailyRollup) _Table() string { return "project_bandwidth_daily_rollups" }
+
+type ProjectBandwidthDailyRollup_Create_Fields struct {
+	EgressDead ProjectBandwidthDailyRollup_EgressDead_Field
+}
+
+type ProjectBandwidthDailyRollup_Update_Fields struct {
+	EgressAllocated ProjectBandwidthDailyRollup_EgressAllocated_Field
+	EgressSettled   ProjectBandwidthDailyRollup_EgressSettled_Field
+	EgressDead      ProjectBandwidthDailyRollup_EgressDead_Field
+}
+
+type ProjectBandwidthDailyRollup_ProjectId_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func OauthToken_Token(v []byte) OauthToken_Token_Field {
-	return OauthToken_Token_Field{_set: true, _value: v}
+func ProjectBandwidthDailyRollup_ProjectId(v []byte) ProjectBandwidthDailyRollup_ProjectId_Field {
+	return ProjectBandwidthDailyRollup_ProjectId_Field{_set: true, _value: v}
 }
 
-func (f OauthToken_Token_Field) value() interface{} {
+func (f ProjectBandwidthDailyRollup_ProjectId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OauthToken_Token_Field) _Column() string { return "token" }
+func (ProjectBandwidthDailyRollup_ProjectId_Field) _Column() string { return "project_id" }
 
-type OauthToken_CreatedAt_Field struct {
+type ProjectBandwidthDailyRollup_IntervalDay_Field struct {
 	_set   bool
 	_null  bool
 	_value time.Time
 }
 
-func OauthToken_CreatedAt(v time.Time) OauthToken_CreatedAt_Field {
-	return OauthToken_CreatedAt_Field{_set: true, _value: v}
+func ProjectBandwidthDailyRollup_IntervalDay(v time.Time) ProjectBandwidthDailyRollup_IntervalDay_Field {
+	v = toDate(v)
+	return ProjectBandwidthDailyRollup_IntervalDay_Field{_set: true, _value: v}
 }
 
-func (f OauthToken_CreatedAt_Field) value() interface{} {
+func (f ProjectBandwidthDailyRollup_IntervalDay_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OauthToken_CreatedAt_Field) _Column() string { return "created_at" }
+func (ProjectBandwidthDailyRollup_IntervalDay_Field) _Column() string { return "interval_day" }
 
-type OauthToken_ExpiresAt_Field struct {
+type ProjectBandwidthDailyRollup_EgressAllocated_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value uint64
 }
 
-func OauthToken_ExpiresAt(v time.Time) OauthToken_ExpiresAt_Field {
-	return OauthToken_ExpiresAt_Field{_set: true, _value: v}
+func ProjectBandwidthDailyRollup_EgressAllocated(v uint64) ProjectBandwidthDailyRollup_EgressAllocated_Field {
+	return ProjectBandwidthDailyRollup_EgressAllocated_Field{_set: true, _value: v}
 }
 
-func (f OauthToken_ExpiresAt_Field) value() interface{} {
+func (f ProjectBandwidthDailyRollup_EgressAllocated_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OauthToken_ExpiresAt_Field) _Column() string { return "expires_at" }
-
-type PeerIdentity struct {
-	NodeId           []byte
-	LeafSerialNumber []byte
-	Chain            []byte
-	UpdatedAt        time.Time
-}
-
-func (PeerIdentity) _Table() string { return "peer_identities" }
-
-type PeerIdentity_Update_Fields struct {
-	LeafSerialNumber PeerIdentity_LeafSerialNumber_Field
-	Chain            PeerIdentity_Chain_Field
-}
+func (ProjectBandwidthDailyRollup_EgressAllocated_Field) _Column() string { return "egress_allocated" }
 
-type PeerIdentity_NodeId_Field struct {
+type ProjectBandwidthDailyRollup_EgressSettled_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value uint64
 }
 
-func PeerIdentity_NodeId(v []byte) PeerIdentity_NodeId_Field {
-	return PeerIdentity_NodeId_Field{_set: true, _value: v}
+func ProjectBandwidthDailyRollup_EgressSettled(v uint64) ProjectBandwidthDailyRollup_EgressSettled_Field {
+	return ProjectBandwidthDailyRollup_EgressSettled_Field{_set: true, _value: v}
 }
 
-func (f PeerIdentity_NodeId_Field) value() interface{} {
+func (f ProjectBandwidthDailyRollup_EgressSettled_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (PeerIdentity_NodeId_Field) _Column() string { return "node_id" }
+func (ProjectBandwidthDailyRollup_EgressSettled_Field) _Column() string { return "egress_settled" }
 
-type PeerIdentity_LeafSerialNumber_Field struct {
+type ProjectBandwidthDailyRollup_EgressDead_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value uint64
 }
 
-func PeerIdentity_LeafSerialNumber(v []byte) PeerIdentity_LeafSerialNumber_Field {
-	return PeerIdentity_LeafSerialNumber_Field{_set: true, _value: v}
+func ProjectBandwidthDailyRollup_EgressDead(v uint64) ProjectBandwidthDailyRollup_EgressDead_Field {
+	return ProjectBandwidthDailyRollup_EgressDead_Field{_set: true, _value: v}
 }
 
-func (f PeerIdentity_LeafSerialNumber_Field) value() interface{} {
+func (f ProjectBandwidthDailyRollup_EgressDead_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (PeerIdentity_LeafSerialNumber_Field) _Column() string { return "leaf_serial_number" }
+func (ProjectBandwidthDailyRollup_EgressDead_Field) _Column() string { return "egress_dead" }
 
-type PeerIdentity_Chain_Field struct {
-	_set   bool
-	_null  bool
-	_value []byte
+type ProjectDataPurge struct {
+	ProjectId         []byte
+	Status            string
+	BucketName        []byte
+	ContinuationToken []byte
+	Attempts          int
+	DeletedObjects    int64
+	DeletedBuckets    int
+	Error             *string
+	CreatedBy         string
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+	FinishedAt        *time.Time
 }
 
-func PeerIdentity_Chain(v []byte) PeerIdentity_Chain_Field {
-	return PeerIdentity_Chain_Field{_set: true, _value: v}
-}
+func (ProjectDataPurge) _Table() string { return "project_data_purges" }
 
-func (f PeerIdentity_Chain_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
-	}
-	return f._value
+type ProjectDataPurge_Create_Fields struct {
+	BucketName        ProjectDataPurge_BucketName_Field
+	ContinuationToken ProjectDataPurge_ContinuationToken_Field
+	Error             ProjectDataPurge_Error_Field
+	FinishedAt        ProjectDataPurge_FinishedAt_Field
 }
 
-func (PeerIdentity_Chain_Field) _Column() string { return "chain" }
+type ProjectDataPurge_Update_Fields struct {
+	Status            ProjectDataPurge_Status_Field
+	BucketName        ProjectDataPurge_BucketName_Field
+	ContinuationToken ProjectDataPurge_ContinuationToken_Field
+	Attempts          ProjectDataPurge_Attempts_Field
+	DeletedObjects    ProjectDataPurge_DeletedObjects_Field
+	DeletedBuckets    ProjectDataPurge_DeletedBuckets_Field
+	Error             ProjectDataPurge_Error_Field
+	CreatedBy         ProjectDataPurge_CreatedBy_Field
+	CreatedAt         ProjectDataPurge_CreatedAt_Field
+	FinishedAt        ProjectDataPurge_FinishedAt_Field
+}
 
-type PeerIdentity_UpdatedAt_Field struct {
+type ProjectDataPurge_ProjectId_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value []byte
 }
 
-func PeerIdentity_UpdatedAt(v time.Time) PeerIdentity_UpdatedAt_Field {
-	return PeerIdentity_UpdatedAt_Field{_set: true, _value: v}
+func ProjectDataPurge_ProjectId(v []byte) ProjectDataPurge_ProjectId_Field {
+	return ProjectDataPurge_ProjectId_Field{_set: true, _value: v}
 }
 
-func (f PeerIdentity_UpdatedAt_Field) value() interface{} {
+func (f ProjectDataPurge_ProjectId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (PeerIdentity_UpdatedAt_Field) _Column() string { return "updated_at" }
-
-type Project struct {
-	Id                          []byte
-	PublicId                    []byte
-	Name                        string
-	Description                 string
-	UsageLimit                  *int64
-	BandwidthLimit              *int64
-	UserSpecifiedUsageLimit     *int64
-	UserSpecifiedBandwidthLimit *int64
-	SegmentLimit                *int64
-	RateLimit                   *int
-	BurstLimit                  *int
-	RateLimitHead               *int
-	BurstLimitHead              *int
-	RateLimitGet                *int
-	BurstLimitGet               *int
-	RateLimitPut                *int
-	BurstLimitPut               *int
-	RateLimitList               *int
-	BurstLimitList              *int
-	RateLimitDel                *int
-	BurstLimitDel               *int
-	MaxBuckets                  *int
-	UserAgent                   []byte
-	OwnerId                     []byte
-	Salt                        []byte
-	CreatedAt                   time.Time
-	DefaultPlacement            *int
-	DefaultVersioning           int
-	PromptedForVersioningBeta   bool
-	PassphraseEnc               []byte
-	PassphraseEncKeyId          *int
-	PathEncryption              bool
-}
-
-func (Project) _Table() string { return "projects" }
+func (ProjectDataPurge_ProjectId_Field) _Column() string { return "project_id" }
 
-type Project_Create_Fields struct {
-	PublicId                    Project_PublicId_Field
-	UsageLimit                  Project_UsageLimit_Field
-	BandwidthLimit              Project_BandwidthLimit_Field
-	UserSpecifiedUsageLimit     Project_UserSpecifiedUsageLimit_Field
-	UserSpecifiedBandwidthLimit Project_UserSpecifiedBandwidthLimit_Field
-	SegmentLimit                Project_SegmentLimit_Field
-	RateLimit                   Project_RateLimit_Field
-	BurstLimit                  Project_BurstLimit_Field
-	RateLimitHead               Project_RateLimitHead_Field
-	BurstLimitHead              Project_BurstLimitHead_Field
-	RateLimitGet                Project_RateLimitGet_Field
-	BurstLimitGet               Project_BurstLimitGet_Field
-	RateLimitPut                Project_RateLimitPut_Field
-	BurstLimitPut               Project_BurstLimitPut_Field
-	RateLimitList               Project_RateLimitList_Field
-	BurstLimitList              Project_BurstLimitList_Field
-	RateLimitDel                Project_RateLimitDel_Field
-	BurstLimitDel               Project_BurstLimitDel_Field
-	MaxBuckets                  Project_MaxBuckets_Field
-	UserAgent                   Project_UserAgent_Field
-	Salt                        Project_Salt_Field
-	DefaultPlacement            Project_DefaultPlacement_Field
-	DefaultVersioning           Project_DefaultVersioning_Field
-	PromptedForVersioningBeta   Project_PromptedForVersioningBeta_Field
-	PassphraseEnc               Project_PassphraseEnc_Field
-	PassphraseEncKeyId          Project_PassphraseEncKeyId_Field
-	PathEncryption              Project_PathEncryption_Field
+type ProjectDataPurge_Status_Field struct {
+	_set   bool
+	_null  bool
+	_value string
 }
 
-type Project_Update_Fields struct {
-	Name                        Project_Name_Field
-	Description                 Project_Description_Field
-	UsageLimit                  Project_UsageLimit_Field
-	BandwidthLimit              Project_BandwidthLimit_Field
-	UserSpecifiedUsageLimit     Project_UserSpecifiedUsageLimit_Field
-	UserSpecifiedBandwidthLimit Project_UserSpecifiedBandwidthLimit_Field
-	SegmentLimit                Project_SegmentLimit_Field
-	RateLimit                   Project_RateLimit_Field
-	BurstLimit                  Project_BurstLimit_Field
-	RateLimitHead               Project_RateLimitHead_Field
-	BurstLimitHead              Project_BurstLimitHead_Field
-	RateLimitGet                Project_RateLimitGet_Field
-	BurstLimitGet               Project_BurstLimitGet_Field
-	RateLimitPut                Project_RateLimitPut_Field
-	BurstLimitPut               Project_BurstLimitPut_Field
-	RateLimitList               Project_RateLimitList_Field
-	BurstLimitList              Project_BurstLimitList_Field
-	RateLimitDel                Project_RateLimitDel_Field
-	BurstLimitDel               Project_BurstLimitDel_Field
-	MaxBuckets                  Project_MaxBuckets_Field
-	UserAgent                   Project_UserAgent_Field
-	DefaultPlacement            Project_DefaultPlacement_Field
-	DefaultVersioning           Project_DefaultVersioning_Field
-	PromptedForVersioningBeta   Project_PromptedForVersioningBeta_Field
-	PassphraseEnc               Project_PassphraseEnc_Field
-	PassphraseEncKeyId          Project_PassphraseEncKeyId_Field
-	PathEncryption              Project_PathEncryption_Field
+func ProjectDataPurge_Status(v string) ProjectDataPurge_Status_Field {
+	return ProjectDataPurge_Status_Field{_set: true, _value: v}
 }
 
-type Project_Id_Field struct {
+func (f ProjectDataPurge_Status_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ProjectDataPurge_Status_Field) _Column() string { return "status" }
+
+type ProjectDataPurge_BucketName_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func Project_Id(v []byte) Project_Id_Field {
-	return Project_Id_Field{_set: true, _value: v}
+func ProjectDataPurge_BucketName(v []byte) ProjectDataPurge_BucketName_Field {
+	return ProjectDataPurge_BucketName_Field{_set: true, _value: v}
 }
 
-func (f Project_Id_Field) value() interface{} {
+func ProjectDataPurge_BucketName_Raw(v []byte) ProjectDataPurge_BucketName_Field {
+	if v == nil {
+		return ProjectDataPurge_BucketName_Null()
+	}
+	return ProjectDataPurge_BucketName(v)
+}
+
+func ProjectDataPurge_BucketName_Null() ProjectDataPurge_BucketName_Field {
+	return ProjectDataPurge_BucketName_Field{_set: true, _null: true}
+}
+
+func (f ProjectDataPurge_BucketName_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f ProjectDataPurge_BucketName_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Project_Id_Field) _Column() string { return "id" }
+func (ProjectDataPurge_BucketName_Field) _Column() string { return "bucket_name" }
 
-type Project_PublicId_Field struct {
+type ProjectDataPurge_ContinuationToken_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func Project_PublicId(v []byte) Project_PublicId_Field {
-	return Project_PublicId_Field{_set: true, _value: v}
+func ProjectDataPurge_ContinuationToken(v []byte) ProjectDataPurge_ContinuationToken_Field {
+	return ProjectDataPurge_ContinuationToken_Field{_set: true, _value: v}
 }
 
-func Project_PublicId_Raw(v []byte) Project_PublicId_Field {
+func ProjectDataPurge_ContinuationToken_Raw(v []byte) ProjectDataPurge_ContinuationToken_Field {
 	if v == nil {
-		return Project_PublicId_Null()
+		return ProjectDataPurge_ContinuationToken_Null()
 	}
-	return Project_PublicId(v)
+	return ProjectDataPurge_ContinuationToken(v)
 }
 
-func Project_PublicId_Null() Project_PublicId_Field {
-	return Project_PublicId_Field{_set: true, _null: true}
+func ProjectDataPurge_ContinuationToken_Null() ProjectDataPurge_ContinuationToken_Field {
+	return ProjectDataPurge_ContinuationToken_Field{_set: true, _null: true}
 }
 
-func (f Project_PublicId_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func (f ProjectDataPurge_ContinuationToken_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
 
-func (f Project_PublicId_Field) value() interface{} {
+func (f ProjectDataPurge_ContinuationToken_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Project_PublicId_Field) _Column() string { return "public_id" }
+func (ProjectDataPurge_ContinuationToken_Field) _Column() string { return "continuation_token" }
 
-type Project_Name_Field struct {
+type ProjectDataPurge_Attempts_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value int
 }
 
-func Project_Name(v string) Project_Name_Field {
-	return Project_Name_Field{_set: true, _value: v}
+func ProjectDataPurge_Attempts(v int) ProjectDataPurge_Attempts_Field {
+	return ProjectDataPurge_Attempts_Field{_set: true, _value: v}
 }
 
-func (f Project_Name_Field) value() interface{} {
+func (f ProjectDataPurge_Attempts_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Project_Name_Field) _Column() string { return "name" }
+func (ProjectDataPurge_Attempts_Field) _Column() string { return "attempts" }
 
-type Project_Description_Field struct {
+type ProjectDataPurge_DeletedObjects_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value int64
 }
 
-func Project_Description(v string) Project_Description_Field {
-	return Project_Description_Field{_set: true, _value: v}
+func ProjectDataPurge_DeletedObjects(v int64) ProjectDataPurge_DeletedObjects_Field {
+	return ProjectDataPurge_DeletedObjects_Field{_set: true, _value: v}
 }
 
-func (f Project_Description_Field) value() interface{} {
+func (f ProjectDataPurge_DeletedObjects_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Project_Description_Field) _Column() string { return "description" }
+func (ProjectDataPurge_DeletedObjects_Field) _Column() string { return "deleted_objects" }
 
-type Project_UsageLimit_Field struct {
+type ProjectDataPurge_DeletedBuckets_Field struct {
 	_set   bool
 	_null  bool
-	_value *int64
+	_value int
 }
 
-func Project_UsageLimit(v int64) Project_UsageLimit_Field {
-	return Project_UsageLimit_Field{_set: true, _value: &v}
+func ProjectDataPurge_DeletedBuckets(v int) ProjectDataPurge_DeletedBuckets_Field {
+	return ProjectDataPurge_DeletedBuckets_Field{_set: true, _value: v}
 }
 
-func Project_UsageLimit_Raw(v *int64) Project_UsageLimit_Field {
+func (f ProjectDataPurge_DeletedBuckets_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ProjectDataPurge_DeletedBuckets_Field) _Column() string { return "deleted_buckets" }
+
+type ProjectDataPurge_Error_Field struct {
+	_set   bool
+	_null  bool
+	_value *string
+}
+
+func ProjectDataPurge_Error(v string) ProjectDataPurge_Error_Field {
+	return ProjectDataPurge_Error_Field{_set: true, _value: &v}
+}
+
+func ProjectDataPurge_Error_Raw(v *string) ProjectDataPurge_Error_Field {
 	if v == nil {
-		return Project_UsageLimit_Null()
+		return ProjectDataPurge_Error_Null()
 	}
-	return Project_UsageLimit(*v)
+	return ProjectDataPurge_Error(*v)
 }
 
-func Project_UsageLimit_Null() Project_UsageLimit_Field {
-	return Project_UsageLimit_Field{_set: true, _null: true}
+func ProjectDataPurge_Error_Null() ProjectDataPurge_Error_Field {
+	return ProjectDataPurge_Error_Field{_set: true, _null: true}
 }
 
-func (f Project_UsageLimit_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func (f ProjectDataPurge_Error_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
 
-func (f Project_UsageLimit_Field) value() interface{} {
+func (f ProjectDataPurge_Error_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Project_UsageLimit_Field) _Column() string { return "usage_limit" }
+func (ProjectDataPurge_Error_Field) _Column() string { return "error" }
 
-type Project_BandwidthLimit_Field struct {
+type ProjectDataPurge_CreatedBy_Field struct {
 	_set   bool
 	_null  bool
-	_value *int64
+	_value string
 }
 
-func Project_BandwidthLimit(v int64) Project_BandwidthLimit_Field {
-	return Project_BandwidthLimit_Field{_set: true, _value: &v}
+func ProjectDataPurge_CreatedBy(v string) ProjectDataPurge_CreatedBy_Field {
+	return ProjectDataPurge_CreatedBy_Field{_set: true, _value: v}
 }
 
-func Project_BandwidthLimit_Raw(v *int64) Project_BandwidthLimit_Field {
-	if v == nil {
-		return Project_BandwidthLimit_Null()
+func (f ProjectDataPurge_CreatedBy_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return Project_BandwidthLimit(*v)
+	return f._value
 }
 
-func Project_BandwidthLimit_Null() Project_BandwidthLimit_Field {
-	return Project_BandwidthLimit_Field{_set: true, _null: true}
+func (ProjectDataPurge_CreatedBy_Field) _Column() string { return "created_by" }
+
+type ProjectDataPurge_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
 }
 
-func (f Project_BandwidthLimit_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func ProjectDataPurge_CreatedAt(v time.Time) ProjectDataPurge_CreatedAt_Field {
+	return ProjectDataPurge_CreatedAt_Field{_set: true, _value: v}
+}
 
-func (f Project_BandwidthLimit_Field) value() interface{} {
+func (f ProjectDataPurge_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Project_BandwidthLimit_Field) _Column() string { return "bandwidth_limit" }
+func (ProjectDataPurge_CreatedAt_Field) _Column() string { return "created_at" }
 
-type Project_UserSpecifiedUsageLimit_Field struct {
+type ProjectDataPurge_UpdatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value *int64
+	_value time.Time
 }
 
-func Project_UserSpecifiedUsageLimit(v int64) Project_UserSpecifiedUsageLimit_Field {
-	return Project_UserSpecifiedUsageLimit_Field{_set: true, _value: &v}
+func ProjectDataPurge_UpdatedAt(v time.Time) ProjectDataPurge_UpdatedAt_Field {
+	return ProjectDataPurge_UpdatedAt_Field{_set: true, _value: v}
 }
 
-func Project_UserSpecifiedUsageLimit_Raw(v *int64) Project_UserSpecifiedUsageLimit_Field {
+func (f ProjectDataPurge_UpdatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ProjectDataPurge_UpdatedAt_Field) _Column() string { return "updated_at" }
+
+type ProjectDataPurge_FinishedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func ProjectDataPurge_FinishedAt(v time.Time) ProjectDataPurge_FinishedAt_Field {
+	return ProjectDataPurge_FinishedAt_Field{_set: true, _value: &v}
+}
+
+func ProjectDataPurge_FinishedAt_Raw(v *time.Time) ProjectDataPurge_FinishedAt_Field {
 	if v == nil {
-		return Project_UserSpecifiedUsageLimit_Null()
+		return ProjectDataPurge_FinishedAt_Null()
 	}
-	return Project_UserSpecifiedUsageLimit(*v)
+	return ProjectDataPurge_FinishedAt(*v)
 }
 
-func Project_UserSpecifiedUsageLimit_Null() Project_UserSpecifiedUsageLimit_Field {
-	return Project_UserSpecifiedUsageLimit_Field{_set: true, _null: true}
+func ProjectDataPurge_FinishedAt_Null() ProjectDataPurge_FinishedAt_Field {
+	return ProjectDataPurge_FinishedAt_Field{_set: true, _null: true}
 }
 
-func (f Project_UserSpecifiedUsageLimit_Field) isnull() bool {
+func (f ProjectDataPurge_FinishedAt_Field) isnull() bool {
 	return !f._set || f._null || f._value == nil
 }
 
-func (f Project_UserSpecifiedUsageLimit_Field) value() interface{} {
+func (f ProjectDataPurge_FinishedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Project_UserSpecifiedUsageLimit_Field) _Column() string { return "user_specified_usage_limit" }
+func (ProjectDataPurge_FinishedAt_Field) _Column() string { return "finished_at" }
 
-type Project_UserSpecifiedBandwidthLimit_Field struct {
+type ProjectInvitationPolicy struct {
+	ProjectId             []byte
+	AllowedEmailDomains   string
+	MaxPendingInvitations int
+	DefaultRole           int
+	CreatedAt             time.Time
+	UpdatedAt             time.Time
+}
+
+func (ProjectInvitationPolicy) _Table() string { return "project_invitation_policies" }
+
+type ProjectInvitationPolicy_Update_Fields struct {
+	AllowedEmailDomains   ProjectInvitationPolicy_AllowedEmailDomains_Field
+	MaxPendingInvitations ProjectInvitationPolicy_MaxPendingInvitations_Field
+	DefaultRole           ProjectInvitationPolicy_DefaultRole_Field
+}
+
+type ProjectInvitationPolicy_ProjectId_Field struct {
 	_set   bool
 	_null  bool
-	_value *int64
+	_value []byte
 }
 
-func Project_UserSpecifiedBandwidthLimit(v int64) Project_UserSpecifiedBandwidthLimit_Field {
-	return Project_UserSpecifiedBandwidthLimit_Field{_set: true, _value: &v}
+func ProjectInvitationPolicy_ProjectId(v []byte) ProjectInvitationPolicy_ProjectId_Field {
+	return ProjectInvitationPolicy_ProjectId_Field{_set: true, _value: v}
 }
 
-func Project_UserSpecifiedBandwidthLimit_Raw(v *int64) Project_UserSpecifiedBandwidthLimit_Field {
-	if v == nil {
-		return Project_UserSpecifiedBandwidthLimit_Null()
+func (f ProjectInvitationPolicy_ProjectId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return Project_UserSpecifiedBandwidthLimit(*v)
+	return f._value
 }
 
-func Project_UserSpecifiedBandwidthLimit_Null() Project_UserSpecifiedBandwidthLimit_Field {
-	return Project_UserSpecifiedBandwidthLimit_Field{_set: true, _null: true}
+func (ProjectInvitationPolicy_ProjectId_Field) _Column() string { return "project_id" }
+
+type ProjectInvitationPolicy_AllowedEmailDomains_Field struct {
+	_set   bool
+	_null  bool
+	_value string
 }
 
-func (f Project_UserSpecifiedBandwidthLimit_Field) isnull() bool {
-	return !f._set || f._null || f._value == nil
+func ProjectInvitationPolicy_AllowedEmailDomains(v string) ProjectInvitationPolicy_AllowedEmailDomains_Field {
+	return ProjectInvitationPolicy_AllowedEmailDomains_Field{_set: true, _value: v}
 }
 
-func (f Project_UserSpecifiedBandwidthLimit_Field) value() interface{} {
+func (f ProjectInvitationPolicy_AllowedEmailDomains_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Project_UserSpecifiedBandwidthLimit_Field) _Column() string {
-	return "user_specified_bandwidth_limit"
+func (ProjectInvitationPolicy_AllowedEmailDomains_Field) _Column() string {
+	return "allowed_email_domains"
 }
 
-type Project_SegmentLimit_Field struct {
+type ProjectInvitationPolicy_MaxPendingInvitations_Field struct {
 	_set   bool
 	_null  bool
-	_value *int64
+	_value int
 }
 
-func Project_SegmentLimit(v int64) Project_SegmentLimit_Field {
-	return Project_SegmentLimit_Field{_set: true, _value: &v}
+func ProjectInvitationPolicy_MaxPendingInvitations(v int) ProjectInvitationPolicy_MaxPendingInvitations_Field {
+	return ProjectInvitationPolicy_MaxPendingInvitations_Field{_set: true, _value: v}
 }
 
-func Project_SegmentLimit_Raw(v *int64) Project_SegmentLimit_Field {
-	if v == nil {
-		return Project_SegmentLimit_Null()
+func (f ProjectInvitationPolicy_MaxPendingInvitations_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return Project_SegmentLimit(*v)
+	return f._value
 }
 
-func Project_SegmentLimit_Null() Project_SegmentLimit_Field {
-	return Project_SegmentLimit_Field{_set: true, _null: true}
+func (ProjectInvitationPolicy_MaxPendingInvitations_Field) _Column() string {
+	return "max_pending_invitations"
 }
 
-func (f Project_SegmentLimit_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+type ProjectInvitationPolicy_DefaultRole_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
 
-func (f Project_SegmentLimit_Field) value() interface{} {
+func ProjectInvitationPolicy_DefaultRole(v int) ProjectInvitationPolicy_DefaultRole_Field {
+	return ProjectInvitationPolicy_DefaultRole_Field{_set: true, _value: v}
+}
+
+func (f ProjectInvitationPolicy_DefaultRole_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ProjectInvitationPolicy_DefaultRole_Field) _Column() string { return "default_role" }
+
+type ProjectInvitationPolicy_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func ProjectInvitationPolicy_CreatedAt(v time.Time) ProjectInvitationPolicy_CreatedAt_Field {
+	return ProjectInvitationPolicy_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f ProjectInvitationPolicy_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ProjectInvitationPolicy_CreatedAt_Field) _Column() string { return "created_at" }
+
+type ProjectInvitationPolicy_UpdatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func ProjectInvitationPolicy_UpdatedAt(v time.Time) ProjectInvitationPolicy_UpdatedAt_Field {
+	return ProjectInvitationPolicy_UpdatedAt_Field{_set: true, _value: v}
+}
+
+func (f ProjectInvitationPolicy_UpdatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ProjectInvitationPolicy_UpdatedAt_Field) _Column() string { return "updated_at" }
+
+type ProjectLimitChange struct {
+	Id             []byte
+	ProjectId      []byte
+	EffectiveAt    time.Time
+	UsageLimit     *int64
+	BandwidthLimit *int64
+	SegmentLimit   *int64
+	RateLimit      *int
+	BurstLimit     *int
+	MaxBuckets     *int
+	CreatedBy      string
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	AppliedAt      *time.Time
+}
+
+func (ProjectLimitChange) _Table() string { return "project_limit_changes" }
+
+type ProjectLimitChange_Create_Fields struct {
+	UsageLimit     ProjectLimitChange_UsageLimit_Field
+	BandwidthLimit ProjectLimitChange_BandwidthLimit_Field
+	SegmentLimit   ProjectLimitChange_SegmentLimit_Field
+	RateLimit      ProjectLimitChange_RateLimit_Field
+	BurstLimit     ProjectLimitChange_BurstLimit_Field
+	MaxBuckets     ProjectLimitChange_MaxBuckets_Field
+	AppliedAt      ProjectLimitChange_AppliedAt_Field
+}
+
+type ProjectLimitChange_Update_Fields struct {
+	EffectiveAt    ProjectLimitChange_EffectiveAt_Field
+	UsageLimit     ProjectLimitChange_UsageLimit_Field
+	BandwidthLimit ProjectLimitChange_BandwidthLimit_Field
+	SegmentLimit   ProjectLimitChange_SegmentLimit_Field
+	RateLimit      ProjectLimitChange_RateLimit_Field
+	BurstLimit     ProjectLimitChange_BurstLimit_Field
+	MaxBuckets     ProjectLimitChange_MaxBuckets_Field
+	AppliedAt      ProjectLimitChange_AppliedAt_Field
+}
+
+type ProjectLimitChange_Id_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func ProjectLimitChange_Id(v []byte) ProjectLimitChange_Id_Field {
+	return ProjectLimitChange_Id_Field{_set: true, _value: v}
+}
+
+func (f ProjectLimitChange_Id_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Project_SegmentLimit_Field) _Column() string { return "segment_limit" }
+func (ProjectLimitChange_Id_Field) _Column() string { return "id" }
 
-type Project_RateLimit_Field struct {
+type ProjectLimitChange_ProjectId_Field struct {
 	_set   bool
 	_null  bool
-	_value *int
+	_value []byte
 }
 
-func Project_RateLimit(v int) Project_RateLimit_Field {
-	return Project_RateLimit_Field{_set: true, _value: &v}
+func ProjectLimitChange_ProjectId(v []byte) ProjectLimitChange_ProjectId_Field {
+	return ProjectLimitChange_ProjectId_Field{_set: true, _value: v}
 }
 
-func Project_RateLimit_Raw(v *int) Project_RateLimit_Field {
-	if v == nil {
-		return Project_RateLimit_Null()
+func (f ProjectLimitChange_ProjectId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return Project_RateLimit(*v)
+	return f._value
 }
 
-func Project_RateLimit_Null() Project_RateLimit_Field {
-	return Project_RateLimit_Field{_set: true, _null: true}
+func (ProjectLimitChange_ProjectId_Field) _Column() string { return "project_id" }
+
+type ProjectLimitChange_EffectiveAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
 }
 
-func (f Project_RateLimit_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func ProjectLimitChange_EffectiveAt(v time.Time) ProjectLimitChange_EffectiveAt_Field {
+	return ProjectLimitChange_EffectiveAt_Field{_set: true, _value: v}
+}
 
-func (f Project_RateLimit_Field) value() interface{} {
+func (f ProjectLimitChange_EffectiveAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Project_RateLimit_Field) _Column() string { return "rate_limit" }
+func (ProjectLimitChange_EffectiveAt_Field) _Column() string { return "effective_at" }
 
-type Project_BurstLimit_Field struct {
+type ProjectLimitChange_UsageLimit_Field struct {
 	_set   bool
 	_null  bool
-	_value *int
+	_value *int64
 }
 
-func Project_BurstLimit(v int) Project_BurstLimit_Field {
-	return Project_BurstLimit_Field{_set: true, _value: &v}
+func ProjectLimitChange_UsageLimit(v int64) ProjectLimitChange_UsageLimit_Field {
+	return ProjectLimitChange_UsageLimit_Field{_set: true, _value: &v}
 }
 
-func Project_BurstLimit_Raw(v *int) Project_BurstLimit_Field {
+func ProjectLimitChange_UsageLimit_Raw(v *int64) ProjectLimitChange_UsageLimit_Field {
 	if v == nil {
-		return Project_BurstLimit_Null()
+		return ProjectLimitChange_UsageLimit_Null()
 	}
-	return Project_BurstLimit(*v)
+	return ProjectLimitChange_UsageLimit(*v)
 }
 
-func Project_BurstLimit_Null() Project_BurstLimit_Field {
-	return Project_BurstLimit_Field{_set: true, _null: true}
+func ProjectLimitChange_UsageLimit_Null() ProjectLimitChange_UsageLimit_Field {
+	return ProjectLimitChange_UsageLimit_Field{_set: true, _null: true}
 }
 
-func (f Project_BurstLimit_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func (f ProjectLimitChange_UsageLimit_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
 
-func (f Project_BurstLimit_Field) value() interface{} {
+func (f ProjectLimitChange_UsageLimit_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Project_BurstLimit_Field) _Column() string { return "burst_limit" }
+func (ProjectLimitChange_UsageLimit_Field) _Column() string { return "usage_limit" }
 
-type Project_RateLimitHead_Field struct {
+type ProjectLimitChange_BandwidthLimit_Field struct {
 	_set   bool
 	_null  bool
-	_value *int
+	_value *int64
 }
 
-func Project_RateLimitHead(v int) Project_RateLimitHead_Field {
-	return Project_RateLimitHead_Field{_set: true, _value: &v}
+func ProjectLimitChange_BandwidthLimit(v int64) ProjectLimitChange_BandwidthLimit_Field {
+	return ProjectLimitChange_BandwidthLimit_Field{_set: true, _value: &v}
 }
 
-func Project_RateLimitHead_Raw(v *int) Project_RateLimitHead_Field {
+func ProjectLimitChange_BandwidthLimit_Raw(v *int64) ProjectLimitChange_BandwidthLimit_Field {
 	if v == nil {
-		return Project_RateLimitHead_Null()
+		return ProjectLimitChange_BandwidthLimit_Null()
 	}
-	return Project_RateLimitHead(*v)
+	return ProjectLimitChange_BandwidthLimit(*v)
 }
 
-func Project_RateLimitHead_Null() Project_RateLimitHead_Field {
-	return Project_RateLimitHead_Field{_set: true, _null: true}
+func ProjectLimitChange_BandwidthLimit_Null() ProjectLimitChange_BandwidthLimit_Field {
+	return ProjectLimitChange_BandwidthLimit_Field{_set: true, _null: true}
 }
 
-func (f Project_RateLimitHead_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func (f ProjectLimitChange_BandwidthLimit_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
 
-func (f Project_RateLimitHead_Field) value() interface{} {
+func (f ProjectLimitChange_BandwidthLimit_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Project_RateLimitHead_Field) _Column() string { return "rate_limit_head" }
+func (ProjectLimitChange_BandwidthLimit_Field) _Column() string { return "bandwidth_limit" }
 
-type Project_BurstLimitHead_Field struct {
+type ProjectLimitChange_SegmentLimit_Field struct {
 	_set   bool
 	_null  bool
-	_value *int
+	_value *int64
 }
 
-func Project_BurstLimitHead(v int) Project_BurstLimitHead_Field {
-	return Project_BurstLimitHead_Field{_set: true, _value: &v}
+func ProjectLimitChange_SegmentLimit(v int64) ProjectLimitChange_SegmentLimit_Field {
+	return ProjectLimitChange_SegmentLimit_Field{_set: true, _value: &v}
 }
 
-func Project_BurstLimitHead_Raw(v *int) Project_BurstLimitHead_Field {
+func ProjectLimitChange_SegmentLimit_Raw(v *int64) ProjectLimitChange_SegmentLimit_Field {
 	if v == nil {
-		return Project_BurstLimitHead_Null()
+		return ProjectLimitChange_SegmentLimit_Null()
 	}
-	return Project_BurstLimitHead(*v)
+	return ProjectLimitChange_SegmentLimit(*v)
 }
 
-func Project_BurstLimitHead_Null() Project_BurstLimitHead_Field {
-	return Project_BurstLimitHead_Field{_set: true, _null: true}
+func ProjectLimitChange_SegmentLimit_Null() ProjectLimitChange_SegmentLimit_Field {
+	return ProjectLimitChange_SegmentLimit_Field{_set: true, _null: true}
 }
 
-func (f Project_BurstLimitHead_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func (f ProjectLimitChange_SegmentLimit_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
 
-func (f Project_BurstLimitHead_Field) value() interface{} {
+func (f ProjectLimitChange_SegmentLimit_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Project_BurstLimitHead_Field) _Column() string { return "burst_limit_head" }
+func (ProjectLimitChange_SegmentLimit_Field) _Column() string { return "segment_limit" }
 
-type Project_RateLimitGet_Field struct {
+type ProjectLimitChange_RateLimit_Field struct {
 	_set   bool
 	_null  bool
 	_value *int
 }
 
-func Project_RateLimitGet(v int) Project_RateLimitGet_Field {
-	return Project_RateLimitGet_Field{_set: true, _value: &v}
+func ProjectLimitChange_RateLimit(v int) ProjectLimitChange_RateLimit_Field {
+	return ProjectLimitChange_RateLimit_Field{_set: true, _value: &v}
 }
 
-func Project_RateLimitGet_Raw(v *int) Project_RateLimitGet_Field {
+func ProjectLimitChange_RateLimit_Raw(v *int) ProjectLimitChange_RateLimit_Field {
 	if v == nil {
-		return Project_RateLimitGet_Null()
+		return ProjectLimitChange_RateLimit_Null()
 	}
-	return Project_RateLimitGet(*v)
+	return ProjectLimitChange_RateLimit(*v)
 }
 
-func Project_RateLimitGet_Null() Project_RateLimitGet_Field {
-	return Project_RateLimitGet_Field{_set: true, _null: true}
+func ProjectLimitChange_RateLimit_Null() ProjectLimitChange_RateLimit_Field {
+	return ProjectLimitChange_RateLimit_Field{_set: true, _null: true}
 }
 
-func (f Project_RateLimitGet_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func (f ProjectLimitChange_RateLimit_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
 
-func (f Project_RateLimitGet_Field) value() interface{} {
+func (f ProjectLimitChange_RateLimit_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Project_RateLimitGet_Field) _Column() string { return "rate_limit_get" }
+func (ProjectLimitChange_RateLimit_Field) _Column() string { return "rate_limit" }
 
-type Project_BurstLimitGet_Field struct {
+type ProjectLimitChange_BurstLimit_Field struct {
 	_set   bool
 	_null  bool
 	_value *int
 }
 
-func Project_BurstLimitGet(v int) Project_BurstLimitGet_Field {
-	return Project_BurstLimitGet_Field{_set: true, _value: &v}
+func ProjectLimitChange_BurstLimit(v int) ProjectLimitChange_BurstLimit_Field {
+	return ProjectLimitChange_BurstLimit_Field{_set: true, _value: &v}
 }
 
-func Project_BurstLimitGet_Raw(v *int) Project_BurstLimitGet_Field {
+func ProjectLimitChange_BurstLimit_Raw(v *int) ProjectLimitChange_BurstLimit_Field {
 	if v == nil {
-		return Project_BurstLimitGet_Null()
+		return ProjectLimitChange_BurstLimit_Null()
 	}
-	return Project_BurstLimitGet(*v)
+	return ProjectLimitChange_BurstLimit(*v)
 }
 
-func Project_BurstLimitGet_Null() Project_BurstLimitGet_Field {
-	return Project_BurstLimitGet_Field{_set: true, _null: true}
+func ProjectLimitChange_BurstLimit_Null() ProjectLimitChange_BurstLimit_Field {
+	return ProjectLimitChange_BurstLimit_Field{_set: true, _null: true}
 }
 
-func (f Project_BurstLimitGet_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func (f ProjectLimitChange_BurstLimit_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
 
-func (f Project_BurstLimitGet_Field) value() interface{} {
+func (f ProjectLimitChange_BurstLimit_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Project_BurstLimitGet_Field) _Column() string { return "burst_limit_get" }
+func (ProjectLimitChange_BurstLimit_Field) _Column() string { return "burst_limit" }
 
-type Project_RateLimitPut_Field struct {
+type ProjectLimitChange_MaxBuckets_Field struct {
 	_set   bool
 	_null  bool
 	_value *int
 }
 
-func Project_RateLimitPut(v int) Project_RateLimitPut_Field {
-	return Project_RateLimitPut_Field{_set: true, _value: &v}
+func ProjectLimitChange_MaxBuckets(v int) ProjectLimitChange_MaxBuckets_Field {
+	return ProjectLimitChange_MaxBuckets_Field{_set: true, _value: &v}
 }
 
-func Project_RateLimitPut_Raw(v *int) Project_RateLimitPut_Field {
+func ProjectLimitChange_MaxBuckets_Raw(v *int) ProjectLimitChange_MaxBuckets_Field {
 	if v == nil {
-		return Project_RateLimitPut_Null()
+		return ProjectLimitChange_MaxBuckets_Null()
 	}
-	return Project_RateLimitPut(*v)
+	return ProjectLimitChange_MaxBuckets(*v)
 }
 
-func Project_RateLimitPut_Null() Project_RateLimitPut_Field {
-	return Project_RateLimitPut_Field{_set: true, _null: true}
+func ProjectLimitChange_MaxBuckets_Null() ProjectLimitChange_MaxBuckets_Field {
+	return ProjectLimitChange_MaxBuckets_Field{_set: true, _null: true}
 }
 
-func (f Project_RateLimitPut_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func (f ProjectLimitChange_MaxBuckets_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
 
-func (f Project_RateLimitPut_Field) value() interface{} {
+func (f ProjectLimitChange_MaxBuckets_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Project_RateLimitPut_Field) _Column() string { return "rate_limit_put" }
+func (ProjectLimitChange_MaxBuckets_Field) _Column() string { return "max_buckets" }
 
-type Project_BurstLimitPut_Field struct {
+type ProjectLimitChange_CreatedBy_Field struct {
 	_set   bool
 	_null  bool
-	_value *int
-}
-
-func Project_BurstLimitPut(v int) Project_BurstLimitPut_Field {
-	return Project_BurstLimitPut_Field{_set: true, _value: &v}
-}
-
-func Project_BurstLimitPut_Raw(v *int) Project_BurstLimitPut_Field {
-	if v == nil {
-		return Project_BurstLimitPut_Null()
-	}
-	return Project_BurstLimitPut(*v)
+	_value string
 }
 
-func Project_BurstLimitPut_Null() Project_BurstLimitPut_Field {
-	return Project_BurstLimitPut_Field{_set: true, _null: true}
+func ProjectLimitChange_CreatedBy(v string) ProjectLimitChange_CreatedBy_Field {
+	return ProjectLimitChange_CreatedBy_Field{_set: true, _value: v}
 }
 
-func (f Project_BurstLimitPut_Field) isnull() bool { return !f._set || f._null || f._value == nil }
-
-func (f Project_BurstLimitPut_Field) value() interface{} {
+func (f ProjectLimitChange_CreatedBy_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Project_BurstLimitPut_Field) _Column() string { return "burst_limit_put" }
+func (ProjectLimitChange_CreatedBy_Field) _Column() string { return "created_by" }
 
-type Project_RateLimitList_Field struct {
+type ProjectLimitChange_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value *int
+	_value time.Time
 }
 
-func Project_RateLimitList(v int) Project_RateLimitList_Field {
-	return Project_RateLimitList_Field{_set: true, _value: &v}
+func ProjectLimitChange_CreatedAt(v time.Time) ProjectLimitChange_CreatedAt_Field {
+	return ProjectLimitChange_CreatedAt_Field{_set: true, _value: v}
 }
 
-func Project_RateLimitList_Raw(v *int) Project_RateLimitList_Field {
-	if v == nil {
-		return Project_RateLimitList_Null()
+func (f ProjectLimitChange_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return Project_RateLimitList(*v)
+	return f._value
 }
 
-func Project_RateLimitList_Null() Project_RateLimitList_Field {
-	return Project_RateLimitList_Field{_set: true, _null: true}
+func (ProjectLimitChange_CreatedAt_Field) _Column() string { return "created_at" }
+
+type ProjectLimitChange_UpdatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
 }
 
-func (f Project_RateLimitList_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func ProjectLimitChange_UpdatedAt(v time.Time) ProjectLimitChange_UpdatedAt_Field {
+	return ProjectLimitChange_UpdatedAt_Field{_set: true, _value: v}
+}
 
-func (f Project_RateLimitList_Field) value() interface{} {
+func (f ProjectLimitChange_UpdatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Project_RateLimitList_Field) _Column() string { return "rate_limit_list" }
+func (ProjectLimitChange_UpdatedAt_Field) _Column() string { return "updated_at" }
 
-type Project_BurstLimitList_Field struct {
+type ProjectLimitChange_AppliedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value *int
+	_value *time.Time
 }
 
-func Project_BurstLimitList(v int) Project_BurstLimitList_Field {
-	return Project_BurstLimitList_Field{_set: true, _value: &v}
+func ProjectLimitChange_AppliedAt(v time.Time) ProjectLimitChange_AppliedAt_Field {
+	return ProjectLimitChange_AppliedAt_Field{_set: true, _value: &v}
 }
 
-func Project_BurstLimitList_Raw(v *int) Project_BurstLimitList_Field {
+func ProjectLimitChange_AppliedAt_Raw(v *time.Time) ProjectLimitChange_AppliedAt_Field {
 	if v == nil {
-		return Project_BurstLimitList_Null()
+		return ProjectLimitChange_AppliedAt_Null()
 	}
-	return Project_BurstLimitList(*v)
+	return ProjectLimitChange_AppliedAt(*v)
 }
 
-func Project_BurstLimitList_Null() Project_BurstLimitList_Field {
-	return Project_BurstLimitList_Field{_set: true, _null: true}
+func ProjectLimitChange_AppliedAt_Null() ProjectLimitChange_AppliedAt_Field {
+	return ProjectLimitChange_AppliedAt_Field{_set: true, _null: true}
 }
 
-func (f Project_BurstLimitList_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func (f ProjectLimitChange_AppliedAt_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
 
-func (f Project_BurstLimitList_Field) value() interface{} {
+func (f ProjectLimitChange_AppliedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Project_BurstLimitList_Field) _Column() string { return "burst_limit_list" }
+func (ProjectLimitChange_AppliedAt_Field) _Column() string { return "applied_at" }
 
-type Project_RateLimitDel_Field struct {
-	_set   bool
-	_null  bool
-	_value *int
+type ProjectPassphraseEscrow struct {
+	ProjectId []byte
+	Envelope  []byte
+	CreatedAt time.Time
 }
 
-func Project_RateLimitDel(v int) Project_RateLimitDel_Field {
-	return Project_RateLimitDel_Field{_set: true, _value: &v}
-}
+func (ProjectPassphraseEscrow) _Table() string { return "project_passphrase_escrows" }
 
-func Project_RateLimitDel_Raw(v *int) Project_RateLimitDel_Field {
-	if v == nil {
-		return Project_RateLimitDel_Null()
-	}
-	return Project_RateLimitDel(*v)
+type ProjectPassphraseEscrow_Update_Fields struct {
 }
 
-func Project_RateLimitDel_Null() Project_RateLimitDel_Field {
-	return Project_RateLimitDel_Field{_set: true, _null: true}
+type ProjectPassphraseEscrow_ProjectId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
 }
 
-func (f Project_RateLimitDel_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func ProjectPassphraseEscrow_ProjectId(v []byte) ProjectPassphraseEscrow_ProjectId_Field {
+	return ProjectPassphraseEscrow_ProjectId_Field{_set: true, _value: v}
+}
 
-func (f Project_RateLimitDel_Field) value() interface{} {
+func (f ProjectPassphraseEscrow_ProjectId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Project_RateLimitDel_Field) _Column() string { return "rate_limit_del" }
+func (ProjectPassphraseEscrow_ProjectId_Field) _Column() string { return "project_id" }
 
-type Project_BurstLimitDel_Field struct {
+type ProjectPassphraseEscrow_Envelope_Field struct {
 	_set   bool
 	_null  bool
-	_value *int
+	_value []byte
 }
 
-func Project_BurstLimitDel(v int) Project_BurstLimitDel_Field {
-	return Project_BurstLimitDel_Field{_set: true, _value: &v}
+func ProjectPassphraseEscrow_Envelope(v []byte) ProjectPassphraseEscrow_Envelope_Field {
+	return ProjectPassphraseEscrow_Envelope_Field{_set: true, _value: v}
 }
 
-func Project_BurstLimitDel_Raw(v *int) Project_BurstLimitDel_Field {
-	if v == nil {
-		return Project_BurstLimitDel_Null()
+func (f ProjectPassphraseEscrow_Envelope_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return Project_BurstLimitDel(*v)
+	return f._value
 }
 
-func Project_BurstLimitDel_Null() Project_BurstLimitDel_Field {
-	return Project_BurstLimitDel_Field{_set: true, _null: true}
+func (ProjectPassphraseEscrow_Envelope_Field) _Column() string { return "envelope" }
+
+type ProjectPassphraseEscrow_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
 }
 
-func (f Project_BurstLimitDel_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func ProjectPassphraseEscrow_CreatedAt(v time.Time) ProjectPassphraseEscrow_CreatedAt_Field {
+	return ProjectPassphraseEscrow_CreatedAt_Field{_set: true, _value: v}
+}
 
-func (f Project_BurstLimitDel_Field) value() interface{} {
+func (f ProjectPassphraseEscrow_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Project_BurstLimitDel_Field) _Column() string { return "burst_limit_del" }
+func (ProjectPassphraseEscrow_CreatedAt_Field) _Column() string { return "created_at" }
 
-type Project_MaxBuckets_Field struct {
-	_set   bool
-	_null  bool
-	_value *int
+type RegistrationToken struct {
+	Secret       []byte
+	OwnerId      []byte
+	ProjectLimit int
+	CreatedAt    time.Time
 }
 
-func Project_MaxBuckets(v int) Project_MaxBuckets_Field {
-	return Project_MaxBuckets_Field{_set: true, _value: &v}
+func (RegistrationToken) _Table() string { return "registration_tokens" }
+
+type RegistrationToken_Create_Fields struct {
+	OwnerId RegistrationToken_OwnerId_Field
 }
 
-func Project_MaxBuckets_Raw(v *int) Project_MaxBuckets_Field {
-	if v == nil {
-		return Project_MaxBuckets_Null()
-	}
-	return Project_MaxBuckets(*v)
+type RegistrationToken_Update_Fields struct {
+	OwnerId RegistrationToken_OwnerId_Field
 }
 
-func Project_MaxBuckets_Null() Project_MaxBuckets_Field {
-	return Project_MaxBuckets_Field{_set: true, _null: true}
+type RegistrationToken_Secret_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
 }
 
-func (f Project_MaxBuckets_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func RegistrationToken_Secret(v []byte) RegistrationToken_Secret_Field {
+	return RegistrationToken_Secret_Field{_set: true, _value: v}
+}
 
-func (f Project_MaxBuckets_Field) value() interface{} {
+func (f RegistrationToken_Secret_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Project_MaxBuckets_Field) _Column() string { return "max_buckets" }
+func (RegistrationToken_Secret_Field) _Column() string { return "secret" }
 
-type Project_UserAgent_Field struct {
+type RegistrationToken_OwnerId_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func Project_UserAgent(v []byte) Project_UserAgent_Field {
-	return Project_UserAgent_Field{_set: true, _value: v}
+func RegistrationToken_OwnerId(v []byte) RegistrationToken_OwnerId_Field {
+	return RegistrationToken_OwnerId_Field{_set: true, _value: v}
 }
 
-func Project_UserAgent_Raw(v []byte) Project_UserAgent_Field {
+func RegistrationToken_OwnerId_Raw(v []byte) RegistrationToken_OwnerId_Field {
 	if v == nil {
-		return Project_UserAgent_Null()
+		return RegistrationToken_OwnerId_Null()
 	}
-	return Project_UserAgent(v)
+	return RegistrationToken_OwnerId(v)
 }
 
-func Project_UserAgent_Null() Project_UserAgent_Field {
-	return Project_UserAgent_Field{_set: true, _null: true}
+func RegistrationToken_OwnerId_Null() RegistrationToken_OwnerId_Field {
+	return RegistrationToken_OwnerId_Field{_set: true, _null: true}
 }
 
-func (f Project_UserAgent_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func (f RegistrationToken_OwnerId_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f Project_UserAgent_Field) value() interface{} {
+func (f RegistrationToken_OwnerId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Project_UserAgent_Field) _Column() string { return "user_agent" }
+func (RegistrationToken_OwnerId_Field) _Column() string { return "owner_id" }
 
-type Project_OwnerId_Field struct {
+type RegistrationToken_ProjectLimit_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value int
 }
 
-func Project_OwnerId(v []byte) Project_OwnerId_Field {
-	return Project_OwnerId_Field{_set: true, _value: v}
+func RegistrationToken_ProjectLimit(v int) RegistrationToken_ProjectLimit_Field {
+	return RegistrationToken_ProjectLimit_Field{_set: true, _value: v}
 }
 
-func (f Project_OwnerId_Field) value() interface{} {
+func (f RegistrationToken_ProjectLimit_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Project_OwnerId_Field) _Column() string { return "owner_id" }
+func (RegistrationToken_ProjectLimit_Field) _Column() string { return "project_limit" }
 
-type Project_Salt_Field struct {
+type RegistrationToken_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value time.Time
 }
 
-func Project_Salt(v []byte) Project_Salt_Field {
-	return Project_Salt_Field{_set: true, _value: v}
+func RegistrationToken_CreatedAt(v time.Time) RegistrationToken_CreatedAt_Field {
+	return RegistrationToken_CreatedAt_Field{_set: true, _value: v}
 }
 
-func Project_Salt_Raw(v []byte) Project_Salt_Field {
-	if v == nil {
-		return Project_Salt_Null()
+func (f RegistrationToken_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return Project_Salt(v)
+	return f._value
 }
 
-func Project_Salt_Null() Project_Salt_Field {
-	return Project_Salt_Field{_set: true, _null: true}
+func (RegistrationToken_CreatedAt_Field) _Column() string { return "created_at" }
+
+type RepairQueue struct {
+	StreamId      []byte
+	Position      uint64
+	AttemptedAt   *time.Time
+	UpdatedAt     time.Time
+	InsertedAt    time.Time
+	SegmentHealth float64
+	Placement     *int
 }
 
-func (f Project_Salt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func (RepairQueue) _Table() string { return "repair_queue" }
 
-func (f Project_Salt_Field) value() interface{} {
+type RepairQueue_Create_Fields struct {
+	AttemptedAt   RepairQueue_AttemptedAt_Field
+	UpdatedAt     RepairQueue_UpdatedAt_Field
+	InsertedAt    RepairQueue_InsertedAt_Field
+	SegmentHealth RepairQueue_SegmentHealth_Field
+	Placement     RepairQueue_Placement_Field
+}
+
+type RepairQueue_Update_Fields struct {
+	AttemptedAt RepairQueue_AttemptedAt_Field
+	UpdatedAt   RepairQueue_UpdatedAt_Field
+	Placement   RepairQueue_Placement_Field
+}
+
+type RepairQueue_StreamId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func RepairQueue_StreamId(v []byte) RepairQueue_StreamId_Field {
+	return RepairQueue_StreamId_Field{_set: true, _value: v}
+}
+
+func (f RepairQueue_StreamId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Project_Salt_Field) _Column() string { return "salt" }
+func (RepairQueue_StreamId_Field) _Column() string { return "stream_id" }
 
-type Project_CreatedAt_Field struct {
+type RepairQueue_Position_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value uint64
 }
 
-func Project_CreatedAt(v time.Time) Project_CreatedAt_Field {
-	return Project_CreatedAt_Field{_set: true, _value: v}
+func RepairQueue_Position(v uint64) RepairQueue_Position_Field {
+	return RepairQueue_Position_Field{_set: true, _value: v}
 }
 
-func (f Project_CreatedAt_Field) value() interface{} {
+func (f RepairQueue_Position_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Project_CreatedAt_Field) _Column() string { return "created_at" }
+func (RepairQueue_Position_Field) _Column() string { return "position" }
 
-type Project_DefaultPlacement_Field struct {
+type RepairQueue_AttemptedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value *int
+	_value *time.Time
 }
 
-func Project_DefaultPlacement(v int) Project_DefaultPlacement_Field {
-	return Project_DefaultPlacement_Field{_set: true, _value: &v}
+func RepairQueue_AttemptedAt(v time.Time) RepairQueue_AttemptedAt_Field {
+	return RepairQueue_AttemptedAt_Field{_set: true, _value: &v}
 }
 
-func Project_DefaultPlacement_Raw(v *int) Project_DefaultPlacement_Field {
+func RepairQueue_AttemptedAt_Raw(v *time.Time) RepairQueue_AttemptedAt_Field {
 	if v == nil {
-		return Project_DefaultPlacement_Null()
+		return RepairQueue_AttemptedAt_Null()
 	}
-	return Project_DefaultPlacement(*v)
+	return RepairQueue_AttemptedAt(*v)
 }
 
-func Project_DefaultPlacement_Null() Project_DefaultPlacement_Field {
-	return Project_DefaultPlacement_Field{_set: true, _null: true}
+func RepairQueue_AttemptedAt_Null() RepairQueue_AttemptedAt_Field {
+	return RepairQueue_AttemptedAt_Field{_set: true, _null: true}
 }
 
-func (f Project_DefaultPlacement_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func (f RepairQueue_AttemptedAt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f Project_DefaultPlacement_Field) value() interface{} {
+func (f RepairQueue_AttemptedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Project_DefaultPlacement_Field) _Column() string { return "default_placement" }
+func (RepairQueue_AttemptedAt_Field) _Column() string { return "attempted_at" }
 
-type Project_DefaultVersioning_Field struct {
+type RepairQueue_UpdatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value time.Time
 }
 
-func Project_DefaultVersioning(v int) Project_DefaultVersioning_Field {
-	return Project_DefaultVersioning_Field{_set: true, _value: v}
+func RepairQueue_UpdatedAt(v time.Time) RepairQueue_UpdatedAt_Field {
+	return RepairQueue_UpdatedAt_Field{_set: true, _value: v}
 }
 
-func (f Project_DefaultVersioning_Field) value() interface{} {
+func (f RepairQueue_UpdatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Project_DefaultVersioning_Field) _Column() string { return "default_versioning" }
+func (RepairQueue_UpdatedAt_Field) _Column() string { return "updated_at" }
 
-type Project_PromptedForVersioningBeta_Field struct {
+type RepairQueue_InsertedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value bool
+	_value time.Time
 }
 
-func Project_PromptedForVersioningBeta(v bool) Project_PromptedForVersioningBeta_Field {
-	return Project_PromptedForVersioningBeta_Field{_set: true, _value: v}
+func RepairQueue_InsertedAt(v time.Time) RepairQueue_InsertedAt_Field {
+	return RepairQueue_InsertedAt_Field{_set: true, _value: v}
 }
 
-func (f Project_PromptedForVersioningBeta_Field) value() interface{} {
+func (f RepairQueue_InsertedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Project_PromptedForVersioningBeta_Field) _Column() string {
-	return "prompted_for_versioning_beta"
+func (RepairQueue_InsertedAt_Field) _Column() string { return "inserted_at" }
+
+type RepairQueue_SegmentHealth_Field struct {
+	_set   bool
+	_null  bool
+	_value float64
 }
 
-type Project_PassphraseEnc_Field struct {
+func RepairQueue_SegmentHealth(v float64) RepairQueue_SegmentHealth_Field {
+	return RepairQueue_SegmentHealth_Field{_set: true, _value: v}
+}
+
+func (f RepairQueue_SegmentHealth_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (RepairQueue_SegmentHealth_Field) _Column() string { return "segment_health" }
+
+type RepairQueue_Placement_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value *int
 }
 
-func Project_PassphraseEnc(v []byte) Project_PassphraseEnc_Field {
-	return Project_PassphraseEnc_Field{_set: true, _value: v}
+func RepairQueue_Placement(v int) RepairQueue_Placement_Field {
+	return RepairQueue_Placement_Field{_set: true, _value: &v}
 }
 
-func Project_PassphraseEnc_Raw(v []byte) Project_PassphraseEnc_Field {
+func RepairQueue_Placement_Raw(v *int) RepairQueue_Placement_Field {
 	if v == nil {
-		return Project_PassphraseEnc_Null()
+		return RepairQueue_Placement_Null()
 	}
-	return Project_PassphraseEnc(v)
+	return RepairQueue_Placement(*v)
 }
 
-func Project_PassphraseEnc_Null() Project_PassphraseEnc_Field {
-	return Project_PassphraseEnc_Field{_set: true, _null: true}
+func RepairQueue_Placement_Null() RepairQueue_Placement_Field {
+	return RepairQueue_Placement_Field{_set: true, _null: true}
 }
 
-func (f Project_PassphraseEnc_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func (f RepairQueue_Placement_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f Project_PassphraseEnc_Field) value() interface{} {
+func (f RepairQueue_Placement_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Project_PassphraseEnc_Field) _Column() string { return "passphrase_enc" }
+func (RepairQueue_Placement_Field) _Column() string { return "placement" }
 
-type Project_PassphraseEncKeyId_Field struct {
-	_set   bool
-	_null  bool
-	_value *int
+type Reputation struct {
+	Id                          []byte
+	AuditSuccessCount           int64
+	TotalAuditCount             int64
+	VettedAt                    *time.Time
+	CreatedAt                   time.Time
+	UpdatedAt                   time.Time
+	Disqualified                *time.Time
+	DisqualificationReason      *int
+	UnknownAuditSuspended       *time.Time
+	OfflineSuspended            *time.Time
+	UnderReview                 *time.Time
+	OnlineScore                 float64
+	AuditHistory                []byte
+	AuditReputationAlpha        float64
+	AuditReputationBeta         float64
+	UnknownAuditReputationAlpha float64
+	UnknownAuditReputationBeta  float64
 }
 
-func Project_PassphraseEncKeyId(v int) Project_PassphraseEncKeyId_Field {
-	return Project_PassphraseEncKeyId_Field{_set: true, _value: &v}
+func (Reputation) _Table() string { return "reputations" }
+
+type Reputation_Create_Fields struct {
+	AuditSuccessCount           Reputation_AuditSuccessCount_Field
+	TotalAuditCount             Reputation_TotalAuditCount_Field
+	VettedAt                    Reputation_VettedAt_Field
+	Disqualified                Reputation_Disqualified_Field
+	DisqualificationReason      Reputation_DisqualificationReason_Field
+	UnknownAuditSuspended       Reputation_UnknownAuditSuspended_Field
+	OfflineSuspended            Reputation_OfflineSuspended_Field
+	UnderReview                 Reputation_UnderReview_Field
+	OnlineScore                 Reputation_OnlineScore_Field
+	AuditReputationAlpha        Reputation_AuditReputationAlpha_Field
+	AuditReputationBeta         Reputation_AuditReputationBeta_Field
+	UnknownAuditReputationAlpha Reputation_UnknownAuditReputationAlpha_Field
+	UnknownAuditReputationBeta  Reputation_UnknownAuditReputationBeta_Field
 }
 
-func Project_PassphraseEncKeyId_Raw(v *int) Project_PassphraseEncKeyId_Field {
-	if v == nil {
-		return Project_PassphraseEncKeyId_Null()
-	}
-	return Project_PassphraseEncKeyId(*v)
+type Reputation_Update_Fields struct {
+	AuditSuccessCount           Reputation_AuditSuccessCount_Field
+	TotalAuditCount             Reputation_TotalAuditCount_Field
+	VettedAt                    Reputation_VettedAt_Field
+	Disqualified                Reputation_Disqualified_Field
+	DisqualificationReason      Reputation_DisqualificationReason_Field
+	UnknownAuditSuspended       Reputation_UnknownAuditSuspended_Field
+	OfflineSuspended            Reputation_OfflineSuspended_Field
+	UnderReview                 Reputation_UnderReview_Field
+	OnlineScore                 Reputation_OnlineScore_Field
+	AuditHistory                Reputation_AuditHistory_Field
+	AuditReputationAlpha        Reputation_AuditReputationAlpha_Field
+	AuditReputationBeta         Reputation_AuditReputationBeta_Field
+	UnknownAuditReputationAlpha Reputation_UnknownAuditReputationAlpha_Field
+	UnknownAuditReputationBeta  Reputation_UnknownAuditReputationBeta_Field
 }
 
-func Project_PassphraseEncKeyId_Null() Project_PassphraseEncKeyId_Field {
-	return Project_PassphraseEncKeyId_Field{_set: true, _null: true}
+type Reputation_Id_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
 }
 
-func (f Project_PassphraseEncKeyId_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func Reputation_Id(v []byte) Reputation_Id_Field {
+	return Reputation_Id_Field{_set: true, _value: v}
+}
 
-func (f Project_PassphraseEncKeyId_Field) value() interface{} {
+func (f Reputation_Id_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Project_PassphraseEncKeyId_Field) _Column() string { return "passphrase_enc_key_id" }
+func (Reputation_Id_Field) _Column() string { return "id" }
 
-type Project_PathEncryption_Field struct {
+type Reputation_AuditSuccessCount_Field struct {
 	_set   bool
 	_null  bool
-	_value bool
+	_value int64
 }
 
-func Project_PathEncryption(v bool) Project_PathEncryption_Field {
-	return Project_PathEncryption_Field{_set: true, _value: v}
+func Reputation_AuditSuccessCount(v int64) Reputation_AuditSuccessCount_Field {
+	return Reputation_AuditSuccessCount_Field{_set: true, _value: v}
 }
 
-func (f Project_PathEncryption_Field) value() interface{} {
+func (f Reputation_AuditSuccessCount_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Project_PathEncryption_Field) _Column() string { return "path_encryption" }
-
-type ProjectBandwidthDailyRollup struct {
-	ProjectId       []byte
-	IntervalDay     time.Time
-	EgressAllocated uint64
-	EgressSettled   uint64
-	EgressDead      uint64
-}
-
-func (ProjectBandwidthDailyRollup) _Table() string { return "project_bandwidth_daily_rollups" }
-
-type ProjectBandwidthDailyRollup_Create_Fields struct {
-	EgressDead ProjectBandwidthDailyRollup_EgressDead_Field
-}
-
-type ProjectBandwidthDailyRollup_Update_Fields struct {
-	EgressAllocated ProjectBandwidthDailyRollup_EgressAllocated_Field
-	EgressSettled   ProjectBandwidthDailyRollup_EgressSettled_Field
-	EgressDead      ProjectBandwidthDailyRollup_EgressDead_Field
-}
+func (Reputation_AuditSuccessCount_Field) _Column() string { return "audit_success_count" }
 
-type ProjectBandwidthDailyRollup_ProjectId_Field struct {
+type Reputation_TotalAuditCount_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value int64
 }
 
-func ProjectBandwidthDailyRollup_ProjectId(v []byte) ProjectBandwidthDailyRollup_ProjectId_Field {
-	return ProjectBandwidthDailyRollup_ProjectId_Field{_set: true, _value: v}
+func Reputation_TotalAuditCount(v int64) Reputation_TotalAuditCount_Field {
+	return Reputation_TotalAuditCount_Field{_set: true, _value: v}
 }
 
-func (f ProjectBandwidthDailyRollup_ProjectId_Field) value() interface{} {
+func (f Reputation_TotalAuditCount_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ProjectBandwidthDailyRollup_ProjectId_Field) _Column() string { return "project_id" }
+func (Reputation_TotalAuditCount_Field) _Column() string { return "total_audit_count" }
 
-type ProjectBandwidthDailyRollup_IntervalDay_Field struct {
+type Reputation_VettedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value *time.Time
 }
 
-func ProjectBandwidthDailyRollup_IntervalDay(v time.Time) ProjectBandwidthDailyRollup_IntervalDay_Field {
-	v = toDate(v)
-	return ProjectBandwidthDailyRollup_IntervalDay_Field{_set: true, _value: v}
+func Reputation_VettedAt(v time.Time) Reputation_VettedAt_Field {
+	return Reputation_VettedAt_Field{_set: true, _value: &v}
 }
 
-func (f ProjectBandwidthDailyRollup_IntervalDay_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
+func Reputation_VettedAt_Raw(v *time.Time) Reputation_VettedAt_Field {
+	if v == nil {
+		return Reputation_VettedAt_Null()
 	}
-	return f._value
+	return Reputation_VettedAt(*v)
 }
 
-func (ProjectBandwidthDailyRollup_IntervalDay_Field) _Column() string { return "interval_day" }
-
-type ProjectBandwidthDailyRollup_EgressAllocated_Field struct {
-	_set   bool
-	_null  bool
-	_value uint64
+func Reputation_VettedAt_Null() Reputation_VettedAt_Field {
+	return Reputation_VettedAt_Field{_set: true, _null: true}
 }
 
-func ProjectBandwidthDailyRollup_EgressAllocated(v uint64) ProjectBandwidthDailyRollup_EgressAllocated_Field {
-	return ProjectBandwidthDailyRollup_EgressAllocated_Field{_set: true, _value: v}
-}
+func (f Reputation_VettedAt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f ProjectBandwidthDailyRollup_EgressAllocated_Field) value() interface{} {
+func (f Reputation_VettedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ProjectBandwidthDailyRollup_EgressAllocated_Field) _Column() string { return "egress_allocated" }
+func (Reputation_VettedAt_Field) _Column() string { return "vetted_at" }
 
-type ProjectBandwidthDailyRollup_EgressSettled_Field struct {
+type Reputation_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value uint64
+	_value time.Time
 }
 
-func ProjectBandwidthDailyRollup_EgressSettled(v uint64) ProjectBandwidthDailyRollup_EgressSettled_Field {
-	return ProjectBandwidthDailyRollup_EgressSettled_Field{_set: true, _value: v}
+func Reputation_CreatedAt(v time.Time) Reputation_CreatedAt_Field {
+	return Reputation_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f ProjectBandwidthDailyRollup_EgressSettled_Field) value() interface{} {
+func (f Reputation_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ProjectBandwidthDailyRollup_EgressSettled_Field) _Column() string { return "egress_settled" }
+func (Reputation_CreatedAt_Field) _Column() string { return "created_at" }
 
-type ProjectBandwidthDailyRollup_EgressDead_Field struct {
+type Reputation_UpdatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value uint64
+	_value time.Time
 }
 
-func ProjectBandwidthDailyRollup_EgressDead(v uint64) ProjectBandwidthDailyRollup_EgressDead_Field {
-	return ProjectBandwidthDailyRollup_EgressDead_Field{_set: true, _value: v}
+func Reputation_UpdatedAt(v time.Time) Reputation_UpdatedAt_Field {
+	return Reputation_UpdatedAt_Field{_set: true, _value: v}
 }
 
-func (f ProjectBandwidthDailyRollup_EgressDead_Field) value() interface{} {
+func (f Reputation_UpdatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ProjectBandwidthDailyRollup_EgressDead_Field) _Column() string { return "egress_dead" }
+func (Reputation_UpdatedAt_Field) _Column() string { return "updated_at" }
 
-type RegistrationToken struct {
-	Secret       []byte
-	OwnerId      []byte
-	ProjectLimit int
-	CreatedAt    time.Time
+type Reputation_Disqualified_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
 }
 
-func (RegistrationToken) _Table() string { return "registration_tokens" }
-
-type RegistrationToken_Create_Fields struct {
-	OwnerId RegistrationToken_OwnerId_Field
+func Reputation_Disqualified(v time.Time) Reputation_Disqualified_Field {
+	return Reputation_Disqualified_Field{_set: true, _value: &v}
 }
 
-type RegistrationToken_Update_Fields struct {
-	OwnerId RegistrationToken_OwnerId_Field
+func Reputation_Disqualified_Raw(v *time.Time) Reputation_Disqualified_Field {
+	if v == nil {
+		return Reputation_Disqualified_Null()
+	}
+	return Reputation_Disqualified(*v)
 }
 
-type RegistrationToken_Secret_Field struct {
-	_set   bool
-	_null  bool
-	_value []byte
+func Reputation_Disqualified_Null() Reputation_Disqualified_Field {
+	return Reputation_Disqualified_Field{_set: true, _null: true}
 }
 
-func RegistrationToken_Secret(v []byte) RegistrationToken_Secret_Field {
-	return RegistrationToken_Secret_Field{_set: true, _value: v}
-}
+func (f Reputation_Disqualified_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f RegistrationToken_Secret_Field) value() interface{} {
+func (f Reputation_Disqualified_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (RegistrationToken_Secret_Field) _Column() string { return "secret" }
+func (Reputation_Disqualified_Field) _Column() string { return "disqualified" }
 
-type RegistrationToken_OwnerId_Field struct {
+type Reputation_DisqualificationReason_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value *int
 }
 
-func RegistrationToken_OwnerId(v []byte) RegistrationToken_OwnerId_Field {
-	return RegistrationToken_OwnerId_Field{_set: true, _value: v}
+func Reputation_DisqualificationReason(v int) Reputation_DisqualificationReason_Field {
+	return Reputation_DisqualificationReason_Field{_set: true, _value: &v}
 }
 
-func RegistrationToken_OwnerId_Raw(v []byte) RegistrationToken_OwnerId_Field {
+func Reputation_DisqualificationReason_Raw(v *int) Reputation_DisqualificationReason_Field {
 	if v == nil {
-		return RegistrationToken_OwnerId_Null()
+		return Reputation_DisqualificationReason_Null()
 	}
-	return RegistrationToken_OwnerId(v)
+	return Reputation_DisqualificationReason(*v)
 }
 
-func RegistrationToken_OwnerId_Null() RegistrationToken_OwnerId_Field {
-	return RegistrationToken_OwnerId_Field{_set: true, _null: true}
+func Reputation_DisqualificationReason_Null() Reputation_DisqualificationReason_Field {
+	return Reputation_DisqualificationReason_Field{_set: true, _null: true}
 }
 
-func (f RegistrationToken_OwnerId_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func (f Reputation_DisqualificationReason_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
 
-func (f RegistrationToken_OwnerId_Field) value() interface{} {
+func (f Reputation_DisqualificationReason_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (RegistrationToken_OwnerId_Field) _Column() string { return "owner_id" }
+func (Reputation_DisqualificationReason_Field) _Column() string { return "disqualification_reason" }
 
-type RegistrationToken_ProjectLimit_Field struct {
+type Reputation_UnknownAuditSuspended_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value *time.Time
 }
 
-func RegistrationToken_ProjectLimit(v int) RegistrationToken_ProjectLimit_Field {
-	return RegistrationToken_ProjectLimit_Field{_set: true, _value: v}
+func Reputation_UnknownAuditSuspended(v time.Time) Reputation_UnknownAuditSuspended_Field {
+	return Reputation_UnknownAuditSuspended_Field{_set: true, _value: &v}
 }
 
-func (f RegistrationToken_ProjectLimit_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
+func Reputation_UnknownAuditSuspended_Raw(v *time.Time) Reputation_UnknownAuditSuspended_Field {
+	if v == nil {
+		return Reputation_UnknownAuditSuspended_Null()
 	}
-	return f._value
+	return Reputation_UnknownAuditSuspended(*v)
 }
 
-func (RegistrationToken_ProjectLimit_Field) _Column() string { return "project_limit" }
-
-type RegistrationToken_CreatedAt_Field struct {
-	_set   bool
-	_null  bool
-	_value time.Time
+func Reputation_UnknownAuditSuspended_Null() Reputation_UnknownAuditSuspended_Field {
+	return Reputation_UnknownAuditSuspended_Field{_set: true, _null: true}
 }
 
-func RegistrationToken_CreatedAt(v time.Time) RegistrationToken_CreatedAt_Field {
-	return RegistrationToken_CreatedAt_Field{_set: true, _value: v}
+func (f Reputation_UnknownAuditSuspended_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
 }
 
-func (f RegistrationToken_CreatedAt_Field) value() interface{} {
+func (f Reputation_UnknownAuditSuspended_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (RegistrationToken_CreatedAt_Field) _Column() string { return "created_at" }
-
-type RepairQueue struct {
-	StreamId      []byte
-	Position      uint64
-	AttemptedAt   *time.Time
-	UpdatedAt     time.Time
-	InsertedAt    time.Time
-	SegmentHealth float64
-	Placement     *int
-}
-
-func (RepairQueue) _Table() string { return "repair_queue" }
-
-type RepairQueue_Create_Fields struct {
-	AttemptedAt   RepairQueue_AttemptedAt_Field
-	UpdatedAt     RepairQueue_UpdatedAt_Field
-	InsertedAt    RepairQueue_InsertedAt_Field
-	SegmentHealth RepairQueue_SegmentHealth_Field
-	Placement     RepairQueue_Placement_Field
-}
-
-type RepairQueue_Update_Fields struct {
-	AttemptedAt RepairQueue_AttemptedAt_Field
-	UpdatedAt   RepairQueue_UpdatedAt_Field
-	Placement   RepairQueue_Placement_Field
-}
+func (Reputation_UnknownAuditSuspended_Field) _Column() string { return "unknown_audit_suspended" }
 
-type RepairQueue_StreamId_Field struct {
+type Reputation_OfflineSuspended_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value *time.Time
 }
 
-func RepairQueue_StreamId(v []byte) RepairQueue_StreamId_Field {
-	return RepairQueue_StreamId_Field{_set: true, _value: v}
+func Reputation_OfflineSuspended(v time.Time) Reputation_OfflineSuspended_Field {
+	return Reputation_OfflineSuspended_Field{_set: true, _value: &v}
 }
 
-func (f RepairQueue_StreamId_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
+func Reputation_OfflineSuspended_Raw(v *time.Time) Reputation_OfflineSuspended_Field {
+	if v == nil {
+		return Reputation_OfflineSuspended_Null()
 	}
-	return f._value
+	return Reputation_OfflineSuspended(*v)
 }
 
-func (RepairQueue_StreamId_Field) _Column() string { return "stream_id" }
-
-type RepairQueue_Position_Field struct {
-	_set   bool
-	_null  bool
-	_value uint64
+func Reputation_OfflineSuspended_Null() Reputation_OfflineSuspended_Field {
+	return Reputation_OfflineSuspended_Field{_set: true, _null: true}
 }
 
-func RepairQueue_Position(v uint64) RepairQueue_Position_Field {
-	return RepairQueue_Position_Field{_set: true, _value: v}
+func (f Reputation_OfflineSuspended_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
 }
 
-func (f RepairQueue_Position_Field) value() interface{} {
+func (f Reputation_OfflineSuspended_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (RepairQueue_Position_Field) _Column() string { return "position" }
+func (Reputation_OfflineSuspended_Field) _Column() string { return "offline_suspended" }
 
-type RepairQueue_AttemptedAt_Field struct {
+type Reputation_UnderReview_Field struct {
 	_set   bool
 	_null  bool
 	_value *time.Time
 }
 
-func RepairQueue_AttemptedAt(v time.Time) RepairQueue_AttemptedAt_Field {
-	return RepairQueue_AttemptedAt_Field{_set: true, _value: &v}
+func Reputation_UnderReview(v time.Time) Reputation_UnderReview_Field {
+	return Reputation_UnderReview_Field{_set: true, _value: &v}
 }
 
-func RepairQueue_AttemptedAt_Raw(v *time.Time) RepairQueue_AttemptedAt_Field {
+func Reputation_UnderReview_Raw(v *time.Time) Reputation_UnderReview_Field {
 	if v == nil {
-		return RepairQueue_AttemptedAt_Null()
+		return Reputation_UnderReview_Null()
 	}
-	return RepairQueue_AttemptedAt(*v)
+	return Reputation_UnderReview(*v)
 }
 
-func RepairQueue_AttemptedAt_Null() RepairQueue_AttemptedAt_Field {
-	return RepairQueue_AttemptedAt_Field{_set: true, _null: true}
+func Reputation_UnderReview_Null() Reputation_UnderReview_Field {
+	return Reputation_UnderReview_Field{_set: true, _null: true}
 }
 
-func (f RepairQueue_AttemptedAt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func (f Reputation_UnderReview_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f RepairQueue_AttemptedAt_Field) value() interface{} {
+func (f Reputation_UnderReview_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (RepairQueue_AttemptedAt_Field) _Column() string { return "attempted_at" }
+func (Reputation_UnderReview_Field) _Column() string { return "under_review" }
 
-type RepairQueue_UpdatedAt_Field struct {
+type Reputation_OnlineScore_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value float64
 }
 
-func RepairQueue_UpdatedAt(v time.Time) RepairQueue_UpdatedAt_Field {
-	return RepairQueue_UpdatedAt_Field{_set: true, _value: v}
+func Reputation_OnlineScore(v float64) Reputation_OnlineScore_Field {
+	return Reputation_OnlineScore_Field{_set: true, _value: v}
 }
 
-func (f RepairQueue_UpdatedAt_Field) value() interface{} {
+func (f Reputation_OnlineScore_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (RepairQueue_UpdatedAt_Field) _Column() string { return "updated_at" }
+func (Reputation_OnlineScore_Field) _Column() string { return "online_score" }
 
-type RepairQueue_InsertedAt_Field struct {
+type Reputation_AuditHistory_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value []byte
 }
 
-func RepairQueue_InsertedAt(v time.Time) RepairQueue_InsertedAt_Field {
-	return RepairQueue_InsertedAt_Field{_set: true, _value: v}
+func Reputation_AuditHistory(v []byte) Reputation_AuditHistory_Field {
+	return Reputation_AuditHistory_Field{_set: true, _value: v}
 }
 
-func (f RepairQueue_InsertedAt_Field) value() interface{} {
+func (f Reputation_AuditHistory_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (RepairQueue_InsertedAt_Field) _Column() string { return "inserted_at" }
+func (Reputation_AuditHistory_Field) _Column() string { return "audit_history" }
 
-type RepairQueue_SegmentHealth_Field struct {
+type Reputation_AuditReputationAlpha_Field struct {
 	_set   bool
 	_null  bool
 	_value float64
 }
 
-func RepairQueue_SegmentHealth(v float64) RepairQueue_SegmentHealth_Field {
-	return RepairQueue_SegmentHealth_Field{_set: true, _value: v}
+func Reputation_AuditReputationAlpha(v float64) Reputation_AuditReputationAlpha_Field {
+	return Reputation_AuditReputationAlpha_Field{_set: true, _value: v}
 }
 
-func (f RepairQueue_SegmentHealth_Field) value() interface{} {
+func (f Reputation_AuditReputationAlpha_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (RepairQueue_SegmentHealth_Field) _Column() string { return "segment_health" }
+func (Reputation_AuditReputationAlpha_Field) _Column() string { return "audit_reputation_alpha" }
 
-type RepairQueue_Placement_Field struct {
+type Reputation_AuditReputationBeta_Field struct {
 	_set   bool
 	_null  bool
-	_value *int
-}
-
-func RepairQueue_Placement(v int) RepairQueue_Placement_Field {
-	return RepairQueue_Placement_Field{_set: true, _value: &v}
-}
-
-func RepairQueue_Placement_Raw(v *int) RepairQueue_Placement_Field {
-	if v == nil {
-		return RepairQueue_Placement_Null()
-	}
-	return RepairQueue_Placement(*v)
+	_value float64
 }
 
-func RepairQueue_Placement_Null() RepairQueue_Placement_Field {
-	return RepairQueue_Placement_Field{_set: true, _null: true}
+func Reputation_AuditReputationBeta(v float64) Reputation_AuditReputationBeta_Field {
+	return Reputation_AuditReputationBeta_Field{_set: true, _value: v}
 }
 
-func (f RepairQueue_Placement_Field) isnull() bool { return !f._set || f._null || f._value == nil }
-
-func (f RepairQueue_Placement_Field) value() interface{} {
+func (f Reputation_AuditReputationBeta_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (RepairQueue_Placement_Field) _Column() string { return "placement" }
-
-type Reputation struct {
-	Id                          []byte
-	AuditSuccessCount           int64
-	TotalAuditCount             int64
-	VettedAt                    *time.Time
-	CreatedAt                   time.Time
-	UpdatedAt                   time.Time
-	Disqualified                *time.Time
-	DisqualificationReason      *int
-	UnknownAuditSuspended       *time.Time
-	OfflineSuspended            *time.Time
-	UnderReview                 *time.Time
-	OnlineScore                 float64
-	AuditHistory                []byte
-	AuditReputationAlpha        float64
-	AuditReputationBeta         float64
-	UnknownAuditReputationAlpha float64
-	UnknownAuditReputationBeta  float64
-}
-
-func (Reputation) _Table() string { return "reputations" }
-
-type Reputation_Create_Fields struct {
-	AuditSuccessCount           Reputation_AuditSuccessCount_Field
-	TotalAuditCount             Reputation_TotalAuditCount_Field
-	VettedAt                    Reputation_VettedAt_Field
-	Disqualified                Reputation_Disqualified_Field
-	DisqualificationReason      Reputation_DisqualificationReason_Field
-	UnknownAuditSuspended       Reputation_UnknownAuditSuspended_Field
-	OfflineSuspended            Reputation_OfflineSuspended_Field
-	UnderReview                 Reputation_UnderReview_Field
-	OnlineScore                 Reputation_OnlineScore_Field
-	AuditReputationAlpha        Reputation_AuditReputationAlpha_Field
-	AuditReputationBeta         Reputation_AuditReputationBeta_Field
-	UnknownAuditReputationAlpha Reputation_UnknownAuditReputationAlpha_Field
-	UnknownAuditReputationBeta  Reputation_UnknownAuditReputationBeta_Field
-}
-
-type Reputation_Update_Fields struct {
-	AuditSuccessCount           Reputation_AuditSuccessCount_Field
-	TotalAuditCount             Reputation_TotalAuditCount_Field
-	VettedAt                    Reputation_VettedAt_Field
-	Disqualified                Reputation_Disqualified_Field
-	DisqualificationReason      Reputation_DisqualificationReason_Field
-	UnknownAuditSuspended       Reputation_UnknownAuditSuspended_Field
-	OfflineSuspended            Reputation_OfflineSuspended_Field
-	UnderReview                 Reputation_UnderReview_Field
-	OnlineScore                 Reputation_OnlineScore_Field
-	AuditHistory                Reputation_AuditHistory_Field
-	AuditReputationAlpha        Reputation_AuditReputationAlpha_Field
-	AuditReputationBeta         Reputation_AuditReputationBeta_Field
-	UnknownAuditReputationAlpha Reputation_UnknownAuditReputationAlpha_Field
-	UnknownAuditReputationBeta  Reputation_UnknownAuditReputationBeta_Field
-}
+func (Reputation_AuditReputationBeta_Field) _Column() string { return "audit_reputation_beta" }
 
-type Reputation_Id_Field struct {
+type Reputation_UnknownAuditReputationAlpha_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value float64
 }
 
-func Reputation_Id(v []byte) Reputation_Id_Field {
-	return Reputation_Id_Field{_set: true, _value: v}
+func Reputation_UnknownAuditReputationAlpha(v float64) Reputation_UnknownAuditReputationAlpha_Field {
+	return Reputation_UnknownAuditReputationAlpha_Field{_set: true, _value: v}
 }
 
-func (f Reputation_Id_Field) value() interface{} {
+func (f Reputation_UnknownAuditReputationAlpha_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Reputation_Id_Field) _Column() string { return "id" }
+func (Reputation_UnknownAuditReputationAlpha_Field) _Column() string {
+	return "unknown_audit_reputation_alpha"
+}
 
-type Reputation_AuditSuccessCount_Field struct {
+type Reputation_UnknownAuditReputationBeta_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value float64
 }
 
-func Reputation_AuditSuccessCount(v int64) Reputation_AuditSuccessCount_Field {
-	return Reputation_AuditSuccessCount_Field{_set: true, _value: v}
+func Reputation_UnknownAuditReputationBeta(v float64) Reputation_UnknownAuditReputationBeta_Field {
+	return Reputation_UnknownAuditReputationBeta_Field{_set: true, _value: v}
 }
 
-func (f Reputation_AuditSuccessCount_Field) value() interface{} {
+func (f Reputation_UnknownAuditReputationBeta_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Reputation_AuditSuccessCount_Field) _Column() string { return "audit_success_count" }
-
-type Reputation_TotalAuditCount_Field struct {
-	_set   bool
-	_null  bool
-	_value int64
+func (Reputation_UnknownAuditReputationBeta_Field) _Column() string {
+	return "unknown_audit_reputation_beta"
 }
 
-func Reputation_TotalAuditCount(v int64) Reputation_TotalAuditCount_Field {
-	return Reputation_TotalAuditCount_Field{_set: true, _value: v}
+type ResetPasswordToken struct {
+	Secret    []byte
+	OwnerId   []byte
+	CreatedAt time.Time
 }
 
-func (f Reputation_TotalAuditCount_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
-	}
-	return f._value
-}
+func (ResetPasswordToken) _Table() string { return "reset_password_tokens" }
 
-func (Reputation_TotalAuditCount_Field) _Column() string { return "total_audit_count" }
+type ResetPasswordToken_Update_Fields struct {
+	OwnerId ResetPasswordToken_OwnerId_Field
+}
 
-type Reputation_VettedAt_Field struct {
+type ResetPasswordToken_Secret_Field struct {
 	_set   bool
 	_null  bool
-	_value *time.Time
-}
-
-func Reputation_VettedAt(v time.Time) Reputation_VettedAt_Field {
-	return Reputation_VettedAt_Field{_set: true, _value: &v}
-}
-
-func Reputation_VettedAt_Raw(v *time.Time) Reputation_VettedAt_Field {
-	if v == nil {
-		return Reputation_VettedAt_Null()
-	}
-	return Reputation_VettedAt(*v)
+	_value []byte
 }
 
-func Reputation_VettedAt_Null() Reputation_VettedAt_Field {
-	return Reputation_VettedAt_Field{_set: true, _null: true}
+func ResetPasswordToken_Secret(v []byte) ResetPasswordToken_Secret_Field {
+	return ResetPasswordToken_Secret_Field{_set: true, _value: v}
 }
 
-func (f Reputation_VettedAt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
-
-func (f Reputation_VettedAt_Field) value() interface{} {
+func (f ResetPasswordToken_Secret_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Reputation_VettedAt_Field) _Column() string { return "vetted_at" }
+func (ResetPasswordToken_Secret_Field) _Column() string { return "secret" }
 
-type Reputation_CreatedAt_Field struct {
+type ResetPasswordToken_OwnerId_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value []byte
 }
 
-func Reputation_CreatedAt(v time.Time) Reputation_CreatedAt_Field {
-	return Reputation_CreatedAt_Field{_set: true, _value: v}
+func ResetPasswordToken_OwnerId(v []byte) ResetPasswordToken_OwnerId_Field {
+	return ResetPasswordToken_OwnerId_Field{_set: true, _value: v}
 }
 
-func (f Reputation_CreatedAt_Field) value() interface{} {
+func (f ResetPasswordToken_OwnerId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Reputation_CreatedAt_Field) _Column() string { return "created_at" }
+func (ResetPasswordToken_OwnerId_Field) _Column() string { return "owner_id" }
 
-type Reputation_UpdatedAt_Field struct {
+type ResetPasswordToken_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
 	_value time.Time
 }
 
-func Reputation_UpdatedAt(v time.Time) Reputation_UpdatedAt_Field {
-	return Reputation_UpdatedAt_Field{_set: true, _value: v}
+func ResetPasswordToken_CreatedAt(v time.Time) ResetPasswordToken_CreatedAt_Field {
+	return ResetPasswordToken_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f Reputation_UpdatedAt_Field) value() interface{} {
+func (f ResetPasswordToken_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Reputation_UpdatedAt_Field) _Column() string { return "updated_at" }
+func (ResetPasswordToken_CreatedAt_Field) _Column() string { return "created_at" }
 
-type Reputation_Disqualified_Field struct {
-	_set   bool
-	_null  bool
-	_value *time.Time
+type ReverificationAudits struct {
+	NodeId        []byte
+	StreamId      []byte
+	Position      uint64
+	PieceNum      int
+	InsertedAt    time.Time
+	LastAttempt   *time.Time
+	ReverifyCount int64
 }
 
-func Reputation_Disqualified(v time.Time) Reputation_Disqualified_Field {
-	return Reputation_Disqualified_Field{_set: true, _value: &v}
+func (ReverificationAudits) _Table() string { return "reverification_audits" }
+
+type ReverificationAudits_Create_Fields struct {
+	InsertedAt    ReverificationAudits_InsertedAt_Field
+	LastAttempt   ReverificationAudits_LastAttempt_Field
+	ReverifyCount ReverificationAudits_ReverifyCount_Field
+}
+
+type ReverificationAudits_Update_Fields struct {
+	LastAttempt   ReverificationAudits_LastAttempt_Field
+	ReverifyCount ReverificationAudits_ReverifyCount_Field
 }
 
-func Reputation_Disqualified_Raw(v *time.Time) Reputation_Disqualified_Field {
-	if v == nil {
-		return Reputation_Disqualified_Null()
-	}
-	return Reputation_Disqualified(*v)
+type ReverificationAudits_NodeId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
 }
 
-func Reputation_Disqualified_Null() Reputation_Disqualified_Field {
-	return Reputation_Disqualified_Field{_set: true, _null: true}
+func ReverificationAudits_NodeId(v []byte) ReverificationAudits_NodeId_Field {
+	return ReverificationAudits_NodeId_Field{_set: true, _value: v}
 }
 
-func (f Reputation_Disqualified_Field) isnull() bool { return !f._set || f._null || f._value == nil }
-
-func (f Reputation_Disqualified_Field) value() interface{} {
+func (f ReverificationAudits_NodeId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Reputation_Disqualified_Field) _Column() string { return "disqualified" }
+func (ReverificationAudits_NodeId_Field) _Column() string { return "node_id" }
 
-type Reputation_DisqualificationReason_Field struct {
+type ReverificationAudits_StreamId_Field struct {
 	_set   bool
 	_null  bool
-	_value *int
+	_value []byte
 }
 
-func Reputation_DisqualificationReason(v int) Reputation_DisqualificationReason_Field {
-	return Reputation_DisqualificationReason_Field{_set: true, _value: &v}
+func ReverificationAudits_StreamId(v []byte) ReverificationAudits_StreamId_Field {
+	return ReverificationAudits_StreamId_Field{_set: true, _value: v}
 }
 
-func Reputation_DisqualificationReason_Raw(v *int) Reputation_DisqualificationReason_Field {
-	if v == nil {
-		return Reputation_DisqualificationReason_Null()
+func (f ReverificationAudits_StreamId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return Reputation_DisqualificationReason(*v)
+	return f._value
 }
 
-func Reputation_DisqualificationReason_Null() Reputation_DisqualificationReason_Field {
-	return Reputation_DisqualificationReason_Field{_set: true, _null: true}
+func (ReverificationAudits_StreamId_Field) _Column() string { return "stream_id" }
+
+type ReverificationAudits_Position_Field struct {
+	_set   bool
+	_null  bool
+	_value uint64
 }
 
-func (f Reputation_DisqualificationReason_Field) isnull() bool {
-	return !f._set || f._null || f._value == nil
+func ReverificationAudits_Position(v uint64) ReverificationAudits_Position_Field {
+	return ReverificationAudits_Position_Field{_set: true, _value: v}
 }
 
-func (f Reputation_DisqualificationReason_Field) value() interface{} {
+func (f ReverificationAudits_Position_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Reputation_DisqualificationReason_Field) _Column() string { return "disqualification_reason" }
+func (ReverificationAudits_Position_Field) _Column() string { return "position" }
 
-type Reputation_UnknownAuditSuspended_Field struct {
+type ReverificationAudits_PieceNum_Field struct {
 	_set   bool
 	_null  bool
-	_value *time.Time
+	_value int
 }
 
-func Reputation_UnknownAuditSuspended(v time.Time) Reputation_UnknownAuditSuspended_Field {
-	return Reputation_UnknownAuditSuspended_Field{_set: true, _value: &v}
+func ReverificationAudits_PieceNum(v int) ReverificationAudits_PieceNum_Field {
+	return ReverificationAudits_PieceNum_Field{_set: true, _value: v}
 }
 
-func Reputation_UnknownAuditSuspended_Raw(v *time.Time) Reputation_UnknownAuditSuspended_Field {
-	if v == nil {
-		return Reputation_UnknownAuditSuspended_Null()
+func (f ReverificationAudits_PieceNum_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return Reputation_UnknownAuditSuspended(*v)
+	return f._value
 }
 
-func Reputation_UnknownAuditSuspended_Null() Reputation_UnknownAuditSuspended_Field {
-	return Reputation_UnknownAuditSuspended_Field{_set: true, _null: true}
+func (ReverificationAudits_PieceNum_Field) _Column() string { return "piece_num" }
+
+type ReverificationAudits_InsertedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
 }
 
-func (f Reputation_UnknownAuditSuspended_Field) isnull() bool {
-	return !f._set || f._null || f._value == nil
+func ReverificationAudits_InsertedAt(v time.Time) ReverificationAudits_InsertedAt_Field {
+	return ReverificationAudits_InsertedAt_Field{_set: true, _value: v}
 }
 
-func (f Reputation_UnknownAuditSuspended_Field) value() interface{} {
+func (f ReverificationAudits_InsertedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Reputation_UnknownAuditSuspended_Field) _Column() string { return "unknown_audit_suspended" }
+func (ReverificationAudits_InsertedAt_Field) _Column() string { return "inserted_at" }
 
-type Reputation_OfflineSuspended_Field struct {
+type ReverificationAudits_LastAttempt_Field struct {
 	_set   bool
 	_null  bool
 	_value *time.Time
 }
 
-func Reputation_OfflineSuspended(v time.Time) Reputation_OfflineSuspended_Field {
-	return Reputation_OfflineSuspended_Field{_set: true, _value: &v}
+func ReverificationAudits_LastAttempt(v time.Time) ReverificationAudits_LastAttempt_Field {
+	return ReverificationAudits_LastAttempt_Field{_set: true, _value: &v}
 }
 
-func Reputation_OfflineSuspended_Raw(v *time.Time) Reputation_OfflineSuspended_Field {
+func ReverificationAudits_LastAttempt_Raw(v *time.Time) ReverificationAudits_LastAttempt_Field {
 	if v == nil {
-		return Reputation_OfflineSuspended_Null()
+		return ReverificationAudits_LastAttempt_Null()
 	}
-	return Reputation_OfflineSuspended(*v)
+	return ReverificationAudits_LastAttempt(*v)
 }
 
-func Reputation_OfflineSuspended_Null() Reputation_OfflineSuspended_Field {
-	return Reputation_OfflineSuspended_Field{_set: true, _null: true}
+func ReverificationAudits_LastAttempt_Null() ReverificationAudits_LastAttempt_Field {
+	return ReverificationAudits_LastAttempt_Field{_set: true, _null: true}
 }
 
-func (f Reputation_OfflineSuspended_Field) isnull() bool {
+func (f ReverificationAudits_LastAttempt_Field) isnull() bool {
 	return !f._set || f._null || f._value == nil
 }
 
-func (f Reputation_OfflineSuspended_Field) value() interface{} {
+func (f ReverificationAudits_LastAttempt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Reputation_OfflineSuspended_Field) _Column() string { return "offline_suspended" }
+func (ReverificationAudits_LastAttempt_Field) _Column() string { return "last_attempt" }
 
-type Reputation_UnderReview_Field struct {
+type ReverificationAudits_ReverifyCount_Field struct {
 	_set   bool
 	_null  bool
-	_value *time.Time
-}
-
-func Reputation_UnderReview(v time.Time) Reputation_UnderReview_Field {
-	return Reputation_UnderReview_Field{_set: true, _value: &v}
-}
-
-func Reputation_UnderReview_Raw(v *time.Time) Reputation_UnderReview_Field {
-	if v == nil {
-		return Reputation_UnderReview_Null()
-	}
-	return Reputation_UnderReview(*v)
+	_value int64
 }
 
-func Reputation_UnderReview_Null() Reputation_UnderReview_Field {
-	return Reputation_UnderReview_Field{_set: true, _null: true}
+func ReverificationAudits_ReverifyCount(v int64) ReverificationAudits_ReverifyCount_Field {
+	return ReverificationAudits_ReverifyCount_Field{_set: true, _value: v}
 }
 
-func (f Reputation_UnderReview_Field) isnull() bool { return !f._set || f._null || f._value == nil }
-
-func (f Reputation_UnderReview_Field) value() interface{} {
+func (f ReverificationAudits_ReverifyCount_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Reputation_UnderReview_Field) _Column() string { return "under_review" }
+func (ReverificationAudits_ReverifyCount_Field) _Column() string { return "reverify_count" }
 
-type Reputation_OnlineScore_Field struct {
-	_set   bool
-	_null  bool
-	_value float64
+type Revocation struct {
+	Revoked  []byte
+	ApiKeyId []byte
 }
 
-func Reputation_OnlineScore(v float64) Reputation_OnlineScore_Field {
-	return Reputation_OnlineScore_Field{_set: true, _value: v}
-}
+func (Revocation) _Table() string { return "revocations" }
 
-func (f Reputation_OnlineScore_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
-	}
-	return f._value
+type Revocation_Update_Fields struct {
 }
 
-func (Reputation_OnlineScore_Field) _Column() string { return "online_score" }
-
-type Reputation_AuditHistory_Field struct {
+type Revocation_Revoked_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func Reputation_AuditHistory(v []byte) Reputation_AuditHistory_Field {
-	return Reputation_AuditHistory_Field{_set: true, _value: v}
+func Revocation_Revoked(v []byte) Revocation_Revoked_Field {
+	return Revocation_Revoked_Field{_set: true, _value: v}
 }
 
-func (f Reputation_AuditHistory_Field) value() interface{} {
+func (f Revocation_Revoked_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Reputation_AuditHistory_Field) _Column() string { return "audit_history" }
+func (Revocation_Revoked_Field) _Column() string { return "revoked" }
 
-type Reputation_AuditReputationAlpha_Field struct {
+type Revocation_ApiKeyId_Field struct {
 	_set   bool
 	_null  bool
-	_value float64
+	_value []byte
 }
 
-func Reputation_AuditReputationAlpha(v float64) Reputation_AuditReputationAlpha_Field {
-	return Reputation_AuditReputationAlpha_Field{_set: true, _value: v}
+func Revocation_ApiKeyId(v []byte) Revocation_ApiKeyId_Field {
+	return Revocation_ApiKeyId_Field{_set: true, _value: v}
 }
 
-func (f Reputation_AuditReputationAlpha_Field) value() interface{} {
+func (f Revocation_ApiKeyId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Reputation_AuditReputationAlpha_Field) _Column() string { return "audit_reputation_alpha" }
+func (Revocation_ApiKeyId_Field) _Column() string { return "api_key_id" }
 
-type Reputation_AuditReputationBeta_Field struct {
-	_set   bool
-	_null  bool
-	_value float64
+type ScimUser struct {
+	UserId     []byte
+	Provider   string
+	ExternalId *string
+	CreatedAt  time.Time
 }
 
-func Reputation_AuditReputationBeta(v float64) Reputation_AuditReputationBeta_Field {
-	return Reputation_AuditReputationBeta_Field{_set: true, _value: v}
-}
+func (ScimUser) _Table() string { return "scim_users" }
 
-func (f Reputation_AuditReputationBeta_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
-	}
-	return f._value
+type ScimUser_Create_Fields struct {
+	ExternalId ScimUser_ExternalId_Field
 }
 
-func (Reputation_AuditReputationBeta_Field) _Column() string { return "audit_reputation_beta" }
+type ScimUser_Update_Fields struct {
+	ExternalId ScimUser_ExternalId_Field
+}
 
-type Reputation_UnknownAuditReputationAlpha_Field struct {
+type ScimUser_UserId_Field struct {
 	_set   bool
 	_null  bool
-	_value float64
+	_value []byte
 }
 
-func Reputation_UnknownAuditReputationAlpha(v float64) Reputation_UnknownAuditReputationAlpha_Field {
-	return Reputation_UnknownAuditReputationAlpha_Field{_set: true, _value: v}
+func ScimUser_UserId(v []byte) ScimUser_UserId_Field {
+	return ScimUser_UserId_Field{_set: true, _value: v}
 }
 
-func (f Reputation_UnknownAuditReputationAlpha_Field) value() interface{} {
+func (f ScimUser_UserId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Reputation_UnknownAuditReputationAlpha_Field) _Column() string {
-	return "unknown_audit_reputation_alpha"
-}
+func (ScimUser_UserId_Field) _Column() string { return "user_id" }
 
-type Reputation_UnknownAuditReputationBeta_Field struct {
+type ScimUser_Provider_Field struct {
 	_set   bool
 	_null  bool
-	_value float64
+	_value string
 }
 
-func Reputation_UnknownAuditReputationBeta(v float64) Reputation_UnknownAuditReputationBeta_Field {
-	return Reputation_UnknownAuditReputationBeta_Field{_set: true, _value: v}
+func ScimUser_Provider(v string) ScimUser_Provider_Field {
+	return ScimUser_Provider_Field{_set: true, _value: v}
 }
 
-func (f Reputation_UnknownAuditReputationBeta_Field) value() interface{} {
+func (f ScimUser_Provider_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Reputation_UnknownAuditReputationBeta_Field) _Column() string {
-	return "unknown_audit_reputation_beta"
-}
-
-type ResetPasswordToken struct {
-	Secret    []byte
-	OwnerId   []byte
-	CreatedAt time.Time
-}
-
-func (ResetPasswordToken) _Table() string { return "reset_password_tokens" }
-
-type ResetPasswordToken_Update_Fields struct {
-	OwnerId ResetPasswordToken_OwnerId_Field
-}
+func (ScimUser_Provider_Field) _Column() string { return "provider" }
 
-type ResetPasswordToken_Secret_Field struct {
+type ScimUser_ExternalId_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value *string
 }
 
-func ResetPasswordToken_Secret(v []byte) ResetPasswordToken_Secret_Field {
-	return ResetPasswordToken_Secret_Field{_set: true, _value: v}
+func ScimUser_ExternalId(v string) ScimUser_ExternalId_Field {
+	return ScimUser_ExternalId_Field{_set: true, _value: &v}
 }
 
-func (f ResetPasswordToken_Secret_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
+func ScimUser_ExternalId_Raw(v *string) ScimUser_ExternalId_Field {
+	if v == nil {
+		return ScimUser_ExternalId_Null()
 	}
-	return f._value
+	return ScimUser_ExternalId(*v)
 }
 
-func (ResetPasswordToken_Secret_Field) _Column() string { return "secret" }
-
-type ResetPasswordToken_OwnerId_Field struct {
-	_set   bool
-	_null  bool
-	_value []byte
+func ScimUser_ExternalId_Null() ScimUser_ExternalId_Field {
+	return ScimUser_ExternalId_Field{_set: true, _null: true}
 }
 
-func ResetPasswordToken_OwnerId(v []byte) ResetPasswordToken_OwnerId_Field {
-	return ResetPasswordToken_OwnerId_Field{_set: true, _value: v}
+func (f ScimUser_ExternalId_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
 }
 
-func (f ResetPasswordToken_OwnerId_Field) value() interface{} {
+func (f ScimUser_ExternalId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ResetPasswordToken_OwnerId_Field) _Column() string { return "owner_id" }
+func (ScimUser_ExternalId_Field) _Column() string { return "external_id" }
 
-type ResetPasswordToken_CreatedAt_Field struct {
+type ScimUser_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
 	_value time.Time
 }
 
-func ResetPasswordToken_CreatedAt(v time.Time) ResetPasswordToken_CreatedAt_Field {
-	return ResetPasswordToken_CreatedAt_Field{_set: true, _value: v}
+func ScimUser_CreatedAt(v time.Time) ScimUser_CreatedAt_Field {
+	return ScimUser_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f ResetPasswordToken_CreatedAt_Field) value() interface{} {
+func (f ScimUser_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
-		return nil
-	}
-	return f._value
-}
-
-func (ResetPasswordToken_CreatedAt_Field) _Column() string { return "created_at" }
-
-type ReverificationAudits struct {
-	NodeId        []byte
-	StreamId      []byte
-	Position      uint64
-	PieceNum      int
-	InsertedAt    time.Time
-	LastAttempt   *time.Time
-	ReverifyCount int64
+		return nil
+	}
+	return f._value
 }
 
-func (ReverificationAudits) _Table() string { return "reverification_audits" }
+func (ScimUser_CreatedAt_Field) _Column() string { return "created_at" }
 
-type ReverificationAudits_Create_Fields struct {
-	InsertedAt    ReverificationAudits_InsertedAt_Field
-	LastAttempt   ReverificationAudits_LastAttempt_Field
-	ReverifyCount ReverificationAudits_ReverifyCount_Field
+type SegmentIntegrityQueue struct {
+	StreamId         []byte
+	Kind             string
+	ProjectId        *[]byte
+	BucketName       *[]byte
+	ObjectKey        *[]byte
+	Version          *int64
+	ExpectedSegments int
+	ActualSegments   int
+	ExpectedSize     int64
+	ActualSize       int64
+	DetectedAt       time.Time
 }
 
-type ReverificationAudits_Update_Fields struct {
-	LastAttempt   ReverificationAudits_LastAttempt_Field
-	ReverifyCount ReverificationAudits_ReverifyCount_Field
+func (SegmentIntegrityQueue) _Table() string { return "segment_integrity_queue" }
+
+type SegmentIntegrityQueue_Create_Fields struct {
+	ProjectId  SegmentIntegrityQueue_ProjectId_Field
+	BucketName SegmentIntegrityQueue_BucketName_Field
+	ObjectKey  SegmentIntegrityQueue_ObjectKey_Field
+	Version    SegmentIntegrityQueue_Version_Field
 }
 
-type ReverificationAudits_NodeId_Field struct {
+type SegmentIntegrityQueue_Update_Fields struct {
+	ProjectId        SegmentIntegrityQueue_ProjectId_Field
+	BucketName       SegmentIntegrityQueue_BucketName_Field
+	ObjectKey        SegmentIntegrityQueue_ObjectKey_Field
+	Version          SegmentIntegrityQueue_Version_Field
+	ExpectedSegments SegmentIntegrityQueue_ExpectedSegments_Field
+	ActualSegments   SegmentIntegrityQueue_ActualSegments_Field
+	ExpectedSize     SegmentIntegrityQueue_ExpectedSize_Field
+	ActualSize       SegmentIntegrityQueue_ActualSize_Field
+	DetectedAt       SegmentIntegrityQueue_DetectedAt_Field
+}
+
+type SegmentIntegrityQueue_StreamId_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func ReverificationAudits_NodeId(v []byte) ReverificationAudits_NodeId_Field {
-	return ReverificationAudits_NodeId_Field{_set: true, _value: v}
+func SegmentIntegrityQueue_StreamId(v []byte) SegmentIntegrityQueue_StreamId_Field {
+	return SegmentIntegrityQueue_StreamId_Field{_set: true, _value: v}
 }
 
-func (f ReverificationAudits_NodeId_Field) value() interface{} {
+func (f SegmentIntegrityQueue_StreamId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ReverificationAudits_NodeId_Field) _Column() string { return "node_id" }
+func (SegmentIntegrityQueue_StreamId_Field) _Column() string { return "stream_id" }
 
-type ReverificationAudits_StreamId_Field struct {
+type SegmentIntegrityQueue_Kind_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value string
 }
 
-func ReverificationAudits_StreamId(v []byte) ReverificationAudits_StreamId_Field {
-	return ReverificationAudits_StreamId_Field{_set: true, _value: v}
+func SegmentIntegrityQueue_Kind(v string) SegmentIntegrityQueue_Kind_Field {
+	return SegmentIntegrityQueue_Kind_Field{_set: true, _value: v}
 }
 
-func (f ReverificationAudits_StreamId_Field) value() interface{} {
+func (f SegmentIntegrityQueue_Kind_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ReverificationAudits_StreamId_Field) _Column() string { return "stream_id" }
+func (SegmentIntegrityQueue_Kind_Field) _Column() string { return "kind" }
 
-type ReverificationAudits_Position_Field struct {
+type SegmentIntegrityQueue_ProjectId_Field struct {
 	_set   bool
 	_null  bool
-	_value uint64
+	_value *[]byte
 }
 
-func ReverificationAudits_Position(v uint64) ReverificationAudits_Position_Field {
-	return ReverificationAudits_Position_Field{_set: true, _value: v}
+func SegmentIntegrityQueue_ProjectId(v []byte) SegmentIntegrityQueue_ProjectId_Field {
+	return SegmentIntegrityQueue_ProjectId_Field{_set: true, _value: &v}
 }
 
-func (f ReverificationAudits_Position_Field) value() interface{} {
+func SegmentIntegrityQueue_ProjectId_Raw(v *[]byte) SegmentIntegrityQueue_ProjectId_Field {
+	if v == nil {
+		return SegmentIntegrityQueue_ProjectId_Null()
+	}
+	return SegmentIntegrityQueue_ProjectId(*v)
+}
+
+func SegmentIntegrityQueue_ProjectId_Null() SegmentIntegrityQueue_ProjectId_Field {
+	return SegmentIntegrityQueue_ProjectId_Field{_set: true, _null: true}
+}
+
+func (f SegmentIntegrityQueue_ProjectId_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f SegmentIntegrityQueue_ProjectId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ReverificationAudits_Position_Field) _Column() string { return "position" }
+func (SegmentIntegrityQueue_ProjectId_Field) _Column() string { return "project_id" }
 
-type ReverificationAudits_PieceNum_Field struct {
+type SegmentIntegrityQueue_BucketName_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value *[]byte
 }
 
-func ReverificationAudits_PieceNum(v int) ReverificationAudits_PieceNum_Field {
-	return ReverificationAudits_PieceNum_Field{_set: true, _value: v}
+func SegmentIntegrityQueue_BucketName(v []byte) SegmentIntegrityQueue_BucketName_Field {
+	return SegmentIntegrityQueue_BucketName_Field{_set: true, _value: &v}
 }
 
-func (f ReverificationAudits_PieceNum_Field) value() interface{} {
+func SegmentIntegrityQueue_BucketName_Raw(v *[]byte) SegmentIntegrityQueue_BucketName_Field {
+	if v == nil {
+		return SegmentIntegrityQueue_BucketName_Null()
+	}
+	return SegmentIntegrityQueue_BucketName(*v)
+}
+
+func SegmentIntegrityQueue_BucketName_Null() SegmentIntegrityQueue_BucketName_Field {
+	return SegmentIntegrityQueue_BucketName_Field{_set: true, _null: true}
+}
+
+func (f SegmentIntegrityQueue_BucketName_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f SegmentIntegrityQueue_BucketName_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ReverificationAudits_PieceNum_Field) _Column() string { return "piece_num" }
+func (SegmentIntegrityQueue_BucketName_Field) _Column() string { return "bucket_name" }
 
-type ReverificationAudits_InsertedAt_Field struct {
+type SegmentIntegrityQueue_ObjectKey_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value *[]byte
 }
 
-func ReverificationAudits_InsertedAt(v time.Time) ReverificationAudits_InsertedAt_Field {
-	return ReverificationAudits_InsertedAt_Field{_set: true, _value: v}
+func SegmentIntegrityQueue_ObjectKey(v []byte) SegmentIntegrityQueue_ObjectKey_Field {
+	return SegmentIntegrityQueue_ObjectKey_Field{_set: true, _value: &v}
 }
 
-func (f ReverificationAudits_InsertedAt_Field) value() interface{} {
+func SegmentIntegrityQueue_ObjectKey_Raw(v *[]byte) SegmentIntegrityQueue_ObjectKey_Field {
+	if v == nil {
+		return SegmentIntegrityQueue_ObjectKey_Null()
+	}
+	return SegmentIntegrityQueue_ObjectKey(*v)
+}
+
+func SegmentIntegrityQueue_ObjectKey_Null() SegmentIntegrityQueue_ObjectKey_Field {
+	return SegmentIntegrityQueue_ObjectKey_Field{_set: true, _null: true}
+}
+
+func (f SegmentIntegrityQueue_ObjectKey_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f SegmentIntegrityQueue_ObjectKey_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ReverificationAudits_InsertedAt_Field) _Column() string { return "inserted_at" }
+func (SegmentIntegrityQueue_ObjectKey_Field) _Column() string { return "object_key" }
 
-type ReverificationAudits_LastAttempt_Field struct {
+type SegmentIntegrityQueue_Version_Field struct {
 	_set   bool
 	_null  bool
-	_value *time.Time
+	_value *int64
 }
 
-func ReverificationAudits_LastAttempt(v time.Time) ReverificationAudits_LastAttempt_Field {
-	return ReverificationAudits_LastAttempt_Field{_set: true, _value: &v}
+func SegmentIntegrityQueue_Version(v int64) SegmentIntegrityQueue_Version_Field {
+	return SegmentIntegrityQueue_Version_Field{_set: true, _value: &v}
 }
 
-func ReverificationAudits_LastAttempt_Raw(v *time.Time) ReverificationAudits_LastAttempt_Field {
+func SegmentIntegrityQueue_Version_Raw(v *int64) SegmentIntegrityQueue_Version_Field {
 	if v == nil {
-		return ReverificationAudits_LastAttempt_Null()
+		return SegmentIntegrityQueue_Version_Null()
 	}
-	return ReverificationAudits_LastAttempt(*v)
+	return SegmentIntegrityQueue_Version(*v)
 }
 
-func ReverificationAudits_LastAttempt_Null() ReverificationAudits_LastAttempt_Field {
-	return ReverificationAudits_LastAttempt_Field{_set: true, _null: true}
+func SegmentIntegrityQueue_Version_Null() SegmentIntegrityQueue_Version_Field {
+	return SegmentIntegrityQueue_Version_Field{_set: true, _null: true}
 }
 
-func (f ReverificationAudits_LastAttempt_Field) isnull() bool {
+func (f SegmentIntegrityQueue_Version_Field) isnull() bool {
 	return !f._set || f._null || f._value == nil
 }
 
-func (f ReverificationAudits_LastAttempt_Field) value() interface{} {
+func (f SegmentIntegrityQueue_Version_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ReverificationAudits_LastAttempt_Field) _Column() string { return "last_attempt" }
+func (SegmentIntegrityQueue_Version_Field) _Column() string { return "version" }
 
-type ReverificationAudits_ReverifyCount_Field struct {
+type SegmentIntegrityQueue_ExpectedSegments_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value int
 }
 
-func ReverificationAudits_ReverifyCount(v int64) ReverificationAudits_ReverifyCount_Field {
-	return ReverificationAudits_ReverifyCount_Field{_set: true, _value: v}
+func SegmentIntegrityQueue_ExpectedSegments(v int) SegmentIntegrityQueue_ExpectedSegments_Field {
+	return SegmentIntegrityQueue_ExpectedSegments_Field{_set: true, _value: v}
 }
 
-func (f ReverificationAudits_ReverifyCount_Field) value() interface{} {
+func (f SegmentIntegrityQueue_ExpectedSegments_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ReverificationAudits_ReverifyCount_Field) _Column() string { return "reverify_count" }
+func (SegmentIntegrityQueue_ExpectedSegments_Field) _Column() string { return "expected_segments" }
 
-type Revocation struct {
-	Revoked  []byte
-	ApiKeyId []byte
+type SegmentIntegrityQueue_ActualSegments_Field struct {
+	_set   bool
+	_null  bool
+	_value int
 }
 
-func (Revocation) _Table() string { return "revocations" }
+func SegmentIntegrityQueue_ActualSegments(v int) SegmentIntegrityQueue_ActualSegments_Field {
+	return SegmentIntegrityQueue_ActualSegments_Field{_set: true, _value: v}
+}
 
-type Revocation_Update_Fields struct {
+func (f SegmentIntegrityQueue_ActualSegments_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
 }
 
-type Revocation_Revoked_Field struct {
+func (SegmentIntegrityQueue_ActualSegments_Field) _Column() string { return "actual_segments" }
+
+type SegmentIntegrityQueue_ExpectedSize_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value int64
 }
 
-func Revocation_Revoked(v []byte) Revocation_Revoked_Field {
-	return Revocation_Revoked_Field{_set: true, _value: v}
+func SegmentIntegrityQueue_ExpectedSize(v int64) SegmentIntegrityQueue_ExpectedSize_Field {
+	return SegmentIntegrityQueue_ExpectedSize_Field{_set: true, _value: v}
 }
 
-func (f Revocation_Revoked_Field) value() interface{} {
+func (f SegmentIntegrityQueue_ExpectedSize_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Revocation_Revoked_Field) _Column() string { return "revoked" }
+func (SegmentIntegrityQueue_ExpectedSize_Field) _Column() string { return "expected_size" }
 
-type Revocation_ApiKeyId_Field struct {
+type SegmentIntegrityQueue_ActualSize_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value int64
 }
 
-func Revocation_ApiKeyId(v []byte) Revocation_ApiKeyId_Field {
-	return Revocation_ApiKeyId_Field{_set: true, _value: v}
+func SegmentIntegrityQueue_ActualSize(v int64) SegmentIntegrityQueue_ActualSize_Field {
+	return SegmentIntegrityQueue_ActualSize_Field{_set: true, _value: v}
 }
 
-func (f Revocation_ApiKeyId_Field) value() interface{} {
+func (f SegmentIntegrityQueue_ActualSize_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Revocation_ApiKeyId_Field) _Column() string { return "api_key_id" }
+func (SegmentIntegrityQueue_ActualSize_Field) _Column() string { return "actual_size" }
+
+type SegmentIntegrityQueue_DetectedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func SegmentIntegrityQueue_DetectedAt(v time.Time) SegmentIntegrityQueue_DetectedAt_Field {
+	return SegmentIntegrityQueue_DetectedAt_Field{_set: true, _value: v}
+}
+
+func (f SegmentIntegrityQueue_DetectedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (SegmentIntegrityQueue_DetectedAt_Field) _Column() string { return "detected_at" }
 
 type SegmentPendingAudits struct {
 	NodeId            []byte
@@ -10423,88 +13807,237 @@ type StripecoinpaymentsInvoiceProjectRecord_CreatedAt_Field struct {
 	_value time.Time
 }
 
-func StripecoinpaymentsInvoiceProjectRecord_CreatedAt(v time.Time) StripecoinpaymentsInvoiceProjectRecord_CreatedAt_Field {
-	return StripecoinpaymentsInvoiceProjectRecord_CreatedAt_Field{_set: true, _value: v}
+func StripecoinpaymentsInvoiceProjectRecord_CreatedAt(v time.Time) StripecoinpaymentsInvoiceProjectRecord_CreatedAt_Field {
+	return StripecoinpaymentsInvoiceProjectRecord_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f StripecoinpaymentsInvoiceProjectRecord_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (StripecoinpaymentsInvoiceProjectRecord_CreatedAt_Field) _Column() string { return "created_at" }
+
+type StripecoinpaymentsTxConversionRate struct {
+	TxId        string
+	RateNumeric float64
+	CreatedAt   time.Time
+}
+
+func (StripecoinpaymentsTxConversionRate) _Table() string {
+	return "stripecoinpayments_tx_conversion_rates"
+}
+
+type StripecoinpaymentsTxConversionRate_Update_Fields struct {
+}
+
+type StripecoinpaymentsTxConversionRate_TxId_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func StripecoinpaymentsTxConversionRate_TxId(v string) StripecoinpaymentsTxConversionRate_TxId_Field {
+	return StripecoinpaymentsTxConversionRate_TxId_Field{_set: true, _value: v}
+}
+
+func (f StripecoinpaymentsTxConversionRate_TxId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (StripecoinpaymentsTxConversionRate_TxId_Field) _Column() string { return "tx_id" }
+
+type StripecoinpaymentsTxConversionRate_RateNumeric_Field struct {
+	_set   bool
+	_null  bool
+	_value float64
+}
+
+func StripecoinpaymentsTxConversionRate_RateNumeric(v float64) StripecoinpaymentsTxConversionRate_RateNumeric_Field {
+	return StripecoinpaymentsTxConversionRate_RateNumeric_Field{_set: true, _value: v}
+}
+
+func (f StripecoinpaymentsTxConversionRate_RateNumeric_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (StripecoinpaymentsTxConversionRate_RateNumeric_Field) _Column() string { return "rate_numeric" }
+
+type StripecoinpaymentsTxConversionRate_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func StripecoinpaymentsTxConversionRate_CreatedAt(v time.Time) StripecoinpaymentsTxConversionRate_CreatedAt_Field {
+	return StripecoinpaymentsTxConversionRate_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f StripecoinpaymentsTxConversionRate_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (StripecoinpaymentsTxConversionRate_CreatedAt_Field) _Column() string { return "created_at" }
+
+type TrustedDevice struct {
+	Id          []byte
+	UserId      []byte
+	Fingerprint []byte
+	UserAgent   string
+	IpAddress   string
+	CreatedAt   time.Time
+	LastUsedAt  time.Time
+}
+
+func (TrustedDevice) _Table() string { return "trusted_devices" }
+
+type TrustedDevice_Update_Fields struct {
+	LastUsedAt TrustedDevice_LastUsedAt_Field
+}
+
+type TrustedDevice_Id_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func TrustedDevice_Id(v []byte) TrustedDevice_Id_Field {
+	return TrustedDevice_Id_Field{_set: true, _value: v}
+}
+
+func (f TrustedDevice_Id_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (TrustedDevice_Id_Field) _Column() string { return "id" }
+
+type TrustedDevice_UserId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func TrustedDevice_UserId(v []byte) TrustedDevice_UserId_Field {
+	return TrustedDevice_UserId_Field{_set: true, _value: v}
+}
+
+func (f TrustedDevice_UserId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (TrustedDevice_UserId_Field) _Column() string { return "user_id" }
+
+type TrustedDevice_Fingerprint_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func TrustedDevice_Fingerprint(v []byte) TrustedDevice_Fingerprint_Field {
+	return TrustedDevice_Fingerprint_Field{_set: true, _value: v}
 }
 
-func (f StripecoinpaymentsInvoiceProjectRecord_CreatedAt_Field) value() interface{} {
+func (f TrustedDevice_Fingerprint_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StripecoinpaymentsInvoiceProjectRecord_CreatedAt_Field) _Column() string { return "created_at" }
+func (TrustedDevice_Fingerprint_Field) _Column() string { return "fingerprint" }
 
-type StripecoinpaymentsTxConversionRate struct {
-	TxId        string
-	RateNumeric float64
-	CreatedAt   time.Time
+type TrustedDevice_UserAgent_Field struct {
+	_set   bool
+	_null  bool
+	_value string
 }
 
-func (StripecoinpaymentsTxConversionRate) _Table() string {
-	return "stripecoinpayments_tx_conversion_rates"
+func TrustedDevice_UserAgent(v string) TrustedDevice_UserAgent_Field {
+	return TrustedDevice_UserAgent_Field{_set: true, _value: v}
 }
 
-type StripecoinpaymentsTxConversionRate_Update_Fields struct {
+func (f TrustedDevice_UserAgent_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
 }
 
-type StripecoinpaymentsTxConversionRate_TxId_Field struct {
+func (TrustedDevice_UserAgent_Field) _Column() string { return "user_agent" }
+
+type TrustedDevice_IpAddress_Field struct {
 	_set   bool
 	_null  bool
 	_value string
 }
 
-func StripecoinpaymentsTxConversionRate_TxId(v string) StripecoinpaymentsTxConversionRate_TxId_Field {
-	return StripecoinpaymentsTxConversionRate_TxId_Field{_set: true, _value: v}
+func TrustedDevice_IpAddress(v string) TrustedDevice_IpAddress_Field {
+	return TrustedDevice_IpAddress_Field{_set: true, _value: v}
 }
 
-func (f StripecoinpaymentsTxConversionRate_TxId_Field) value() interface{} {
+func (f TrustedDevice_IpAddress_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StripecoinpaymentsTxConversionRate_TxId_Field) _Column() string { return "tx_id" }
+func (TrustedDevice_IpAddress_Field) _Column() string { return "ip_address" }
 
-type StripecoinpaymentsTxConversionRate_RateNumeric_Field struct {
+type TrustedDevice_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value float64
+	_value time.Time
 }
 
-func StripecoinpaymentsTxConversionRate_RateNumeric(v float64) StripecoinpaymentsTxConversionRate_RateNumeric_Field {
-	return StripecoinpaymentsTxConversionRate_RateNumeric_Field{_set: true, _value: v}
+func TrustedDevice_CreatedAt(v time.Time) TrustedDevice_CreatedAt_Field {
+	return TrustedDevice_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f StripecoinpaymentsTxConversionRate_RateNumeric_Field) value() interface{} {
+func (f TrustedDevice_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StripecoinpaymentsTxConversionRate_RateNumeric_Field) _Column() string { return "rate_numeric" }
+func (TrustedDevice_CreatedAt_Field) _Column() string { return "created_at" }
 
-type StripecoinpaymentsTxConversionRate_CreatedAt_Field struct {
+type TrustedDevice_LastUsedAt_Field struct {
 	_set   bool
 	_null  bool
 	_value time.Time
 }
 
-func StripecoinpaymentsTxConversionRate_CreatedAt(v time.Time) StripecoinpaymentsTxConversionRate_CreatedAt_Field {
-	return StripecoinpaymentsTxConversionRate_CreatedAt_Field{_set: true, _value: v}
+func TrustedDevice_LastUsedAt(v time.Time) TrustedDevice_LastUsedAt_Field {
+	return TrustedDevice_LastUsedAt_Field{_set: true, _value: v}
 }
 
-func (f StripecoinpaymentsTxConversionRate_CreatedAt_Field) value() interface{} {
+func (f TrustedDevice_LastUsedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StripecoinpaymentsTxConversionRate_CreatedAt_Field) _Column() string { return "created_at" }
+func (TrustedDevice_LastUsedAt_Field) _Column() string { return "last_used_at" }
 
 type User struct {
 	Id                          []byte
@@ -10546,6 +14079,7 @@ type User struct {
 	SignupId                    *string
 	TrialExpiration             *time.Time
 	UpgradeTime                 *time.Time
+	SignupReferral              *string
 }
 
 func (User) _Table() string { return "users" }
@@ -10583,6 +14117,7 @@ type User_Create_Fields struct {
 	SignupId                    User_SignupId_Field
 	TrialExpiration             User_TrialExpiration_Field
 	UpgradeTime                 User_UpgradeTime_Field
+	SignupReferral              User_SignupReferral_Field
 }
 
 type User_Update_Fields struct {
@@ -11629,6 +15164,38 @@ func (f User_UpgradeTime_Field) value() interface{} {
 
 func (User_UpgradeTime_Field) _Column() string { return "upgrade_time" }
 
+type User_SignupReferral_Field struct {
+	_set   bool
+	_null  bool
+	_value *string
+}
+
+func User_SignupReferral(v string) User_SignupReferral_Field {
+	return User_SignupReferral_Field{_set: true, _value: &v}
+}
+
+func User_SignupReferral_Raw(v *string) User_SignupReferral_Field {
+	if v == nil {
+		return User_SignupReferral_Null()
+	}
+	return User_SignupReferral(*v)
+}
+
+func User_SignupReferral_Null() User_SignupReferral_Field {
+	return User_SignupReferral_Field{_set: true, _null: true}
+}
+
+func (f User_SignupReferral_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f User_SignupReferral_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (User_SignupReferral_Field) _Column() string { return "signup_referral" }
+
 type UserSettings struct {
 	UserId           []byte
 	SessionMinutes   *uint
@@ -11637,6 +15204,7 @@ type UserSettings struct {
 	OnboardingEnd    bool
 	OnboardingStep   *string
 	NoticeDismissal  []byte
+	LoginAlerts      bool
 }
 
 func (UserSettings) _Table() string { return "user_settings" }
@@ -11648,6 +15216,7 @@ type UserSettings_Create_Fields struct {
 	OnboardingEnd    UserSettings_OnboardingEnd_Field
 	OnboardingStep   UserSettings_OnboardingStep_Field
 	NoticeDismissal  UserSettings_NoticeDismissal_Field
+	LoginAlerts      UserSettings_LoginAlerts_Field
 }
 
 type UserSettings_Update_Fields struct {
@@ -11657,6 +15226,7 @@ type UserSettings_Update_Fields struct {
 	OnboardingEnd    UserSettings_OnboardingEnd_Field
 	OnboardingStep   UserSettings_OnboardingStep_Field
 	NoticeDismissal  UserSettings_NoticeDismissal_Field
+	LoginAlerts      UserSettings_LoginAlerts_Field
 }
 
 type UserSettings_UserId_Field struct {
@@ -11837,6 +15407,25 @@ func (f UserSettings_NoticeDismissal_Field) value() interface{} {
 
 func (UserSettings_NoticeDismissal_Field) _Column() string { return "notice_dismissal" }
 
+type UserSettings_LoginAlerts_Field struct {
+	_set   bool
+	_null  bool
+	_value bool
+}
+
+func UserSettings_LoginAlerts(v bool) UserSettings_LoginAlerts_Field {
+	return UserSettings_LoginAlerts_Field{_set: true, _value: v}
+}
+
+func (f UserSettings_LoginAlerts_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (UserSettings_LoginAlerts_Field) _Column() string { return "login_alerts" }
+
 type ValueAttribution struct {
 	ProjectId   []byte
 	BucketName  []byte
@@ -14943,15 +18532,16 @@ func (obj *pgxImpl) Create_User(ctx context.Context,
 	__signup_id_val := optional.SignupId.value()
 	__trial_expiration_val := optional.TrialExpiration.value()
 	__upgrade_time_val := optional.UpgradeTime.value()
+	__signup_referral_val := optional.SignupReferral.value()
 
-	var __columns = &__sqlbundle_Hole{SQL: __sqlbundle_Literal("id, email, normalized_email, full_name, short_name, password_hash, new_unverified_email, status, status_updated_at, user_agent, created_at, position, company_name, company_size, working_on, employee_count, mfa_secret_key, mfa_recovery_codes, signup_promo_code, failed_login_count, login_lockout_expiration, signup_captcha, default_placement, activation_code, signup_id, trial_expiration, upgrade_time")}
-	var __placeholders = &__sqlbundle_Hole{SQL: __sqlbundle_Literal("?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?")}
+	var __columns = &__sqlbundle_Hole{SQL: __sqlbundle_Literal("id, email, normalized_email, full_name, short_name, password_hash, new_unverified_email, status, status_updated_at, user_agent, created_at, position, company_name, company_size, working_on, employee_count, mfa_secret_key, mfa_recovery_codes, signup_promo_code, failed_login_count, login_lockout_expiration, signup_captcha, default_placement, activation_code, signup_id, trial_expiration, upgrade_time, signup_referral")}
+	var __placeholders = &__sqlbundle_Hole{SQL: __sqlbundle_Literal("?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?")}
 	var __clause = &__sqlbundle_Hole{SQL: __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("("), __columns, __sqlbundle_Literal(") VALUES ("), __placeholders, __sqlbundle_Literal(")")}}}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("INSERT INTO users "), __clause, __sqlbundle_Literal(" RETURNING users.id, users.email, users.normalized_email, users.full_name, users.short_name, users.password_hash, users.new_unverified_email, users.email_change_verification_step, users.status, users.status_updated_at, users.final_invoice_generated, users.user_agent, users.created_at, users.project_limit, users.project_bandwidth_limit, users.project_storage_limit, users.project_segment_limit, users.paid_tier, users.position, users.company_name, users.company_size, users.working_on, users.is_professional, users.employee_count, users.have_sales_contact, users.mfa_enabled, users.mfa_secret_key, users.mfa_recovery_codes, users.signup_promo_code, users.verification_reminders, users.trial_notifications, users.failed_login_count, users.login_lockout_expiration, users.signup_captcha, users.default_placement, users.activation_code, users.signup_id, users.trial_expiration, users.upgrade_time")}}
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("INSERT INTO users "), __clause, __sqlbundle_Literal(" RETURNING users.id, users.email, users.normalized_email, users.full_name, users.short_name, users.password_hash, users.new_unverified_email, users.email_change_verification_step, users.status, users.status_updated_at, users.final_invoice_generated, users.user_agent, users.created_at, users.project_limit, users.project_bandwidth_limit, users.project_storage_limit, users.project_segment_limit, users.paid_tier, users.position, users.company_name, users.company_size, users.working_on, users.is_professional, users.employee_count, users.have_sales_contact, users.mfa_enabled, users.mfa_secret_key, users.mfa_recovery_codes, users.signup_promo_code, users.verification_reminders, users.trial_notifications, users.failed_login_count, users.login_lockout_expiration, users.signup_captcha, users.default_placement, users.activation_code, users.signup_id, users.trial_expiration, users.upgrade_time, users.signup_referral")}}
 
 	var __values []interface{}
-	__values = append(__values, __id_val, __email_val, __normalized_email_val, __full_name_val, __short_name_val, __password_hash_val, __new_unverified_email_val, __status_val, __status_updated_at_val, __user_agent_val, __created_at_val, __position_val, __company_name_val, __company_size_val, __working_on_val, __employee_count_val, __mfa_secret_key_val, __mfa_recovery_codes_val, __signup_promo_code_val, __failed_login_count_val, __login_lockout_expiration_val, __signup_captcha_val, __default_placement_val, __activation_code_val, __signup_id_val, __trial_expiration_val, __upgrade_time_val)
+	__values = append(__values, __id_val, __email_val, __normalized_email_val, __full_name_val, __short_name_val, __password_hash_val, __new_unverified_email_val, __status_val, __status_updated_at_val, __user_agent_val, __created_at_val, __position_val, __company_name_val, __company_size_val, __working_on_val, __employee_count_val, __mfa_secret_key_val, __mfa_recovery_codes_val, __signup_promo_code_val, __failed_login_count_val, __login_lockout_expiration_val, __signup_captcha_val, __default_placement_val, __activation_code_val, __signup_id_val, __trial_expiration_val, __upgrade_time_val, __signup_referral_val)
 
 	__optional_columns := __sqlbundle_Literals{Join: ", "}
 	__optional_placeholders := __sqlbundle_Literals{Join: ", "}
@@ -15040,7 +18630,7 @@ func (obj *pgxImpl) Create_User(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	user = &User{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&user.Id, &user.Email, &user.NormalizedEmail, &user.FullName, &user.ShortName, &user.PasswordHash, &user.NewUnverifiedEmail, &user.EmailChangeVerificationStep, &user.Status, &user.StatusUpdatedAt, &user.FinalInvoiceGenerated, &user.UserAgent, &user.CreatedAt, &user.ProjectLimit, &user.ProjectBandwidthLimit, &user.ProjectStorageLimit, &user.ProjectSegmentLimit, &user.PaidTier, &user.Position, &user.CompanyName, &user.CompanySize, &user.WorkingOn, &user.IsProfessional, &user.EmployeeCount, &user.HaveSalesContact, &user.MfaEnabled, &user.MfaSecretKey, &user.MfaRecoveryCodes, &user.SignupPromoCode, &user.VerificationReminders, &user.TrialNotifications, &user.FailedLoginCount, &user.LoginLockoutExpiration, &user.SignupCaptcha, &user.DefaultPlacement, &user.ActivationCode, &user.SignupId, &user.TrialExpiration, &user.UpgradeTime)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&user.Id, &user.Email, &user.NormalizedEmail, &user.FullName, &user.ShortName, &user.PasswordHash, &user.NewUnverifiedEmail, &user.EmailChangeVerificationStep, &user.Status, &user.StatusUpdatedAt, &user.FinalInvoiceGenerated, &user.UserAgent, &user.CreatedAt, &user.ProjectLimit, &user.ProjectBandwidthLimit, &user.ProjectStorageLimit, &user.ProjectSegmentLimit, &user.PaidTier, &user.Position, &user.CompanyName, &user.CompanySize, &user.WorkingOn, &user.IsProfessional, &user.EmployeeCount, &user.HaveSalesContact, &user.MfaEnabled, &user.MfaSecretKey, &user.MfaRecoveryCodes, &user.SignupPromoCode, &user.VerificationReminders, &user.TrialNotifications, &user.FailedLoginCount, &user.LoginLockoutExpiration, &user.SignupCaptcha, &user.DefaultPlacement, &user.ActivationCode, &user.SignupId, &user.TrialExpiration, &user.UpgradeTime, &user.SignupReferral)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -15233,6 +18823,12 @@ func (obj *pgxImpl) CreateNoReturn_UserSettings(ctx context.Context,
 		__optional_placeholders.SQLs = append(__optional_placeholders.SQLs, __sqlbundle_Literal("?"))
 	}
 
+	if optional.LoginAlerts._set {
+		__values = append(__values, optional.LoginAlerts.value())
+		__optional_columns.SQLs = append(__optional_columns.SQLs, __sqlbundle_Literal("login_alerts"))
+		__optional_placeholders.SQLs = append(__optional_placeholders.SQLs, __sqlbundle_Literal("?"))
+	}
+
 	if len(__optional_columns.SQLs) == 0 {
 		if __columns.SQL == nil {
 			__clause.SQL = __sqlbundle_Literal("DEFAULT VALUES")
@@ -18484,7 +22080,7 @@ func (obj *pgxImpl) All_User_By_NormalizedEmail(ctx context.Context,
 	rows []*User, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT users.id, users.email, users.normalized_email, users.full_name, users.short_name, users.password_hash, users.new_unverified_email, users.email_change_verification_step, users.status, users.status_updated_at, users.final_invoice_generated, users.user_agent, users.created_at, users.project_limit, users.project_bandwidth_limit, users.project_storage_limit, users.project_segment_limit, users.paid_tier, users.position, users.company_name, users.company_size, users.working_on, users.is_professional, users.employee_count, users.have_sales_contact, users.mfa_enabled, users.mfa_secret_key, users.mfa_recovery_codes, users.signup_promo_code, users.verification_reminders, users.trial_notifications, users.failed_login_count, users.login_lockout_expiration, users.signup_captcha, users.default_placement, users.activation_code, users.signup_id, users.trial_expiration, users.upgrade_time FROM users WHERE users.normalized_email = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT users.id, users.email, users.normalized_email, users.full_name, users.short_name, users.password_hash, users.new_unverified_email, users.email_change_verification_step, users.status, users.status_updated_at, users.final_invoice_generated, users.user_agent, users.created_at, users.project_limit, users.project_bandwidth_limit, users.project_storage_limit, users.project_segment_limit, users.paid_tier, users.position, users.company_name, users.company_size, users.working_on, users.is_professional, users.employee_count, users.have_sales_contact, users.mfa_enabled, users.mfa_secret_key, users.mfa_recovery_codes, users.signup_promo_code, users.verification_reminders, users.trial_notifications, users.failed_login_count, users.login_lockout_expiration, users.signup_captcha, users.default_placement, users.activation_code, users.signup_id, users.trial_expiration, users.upgrade_time, users.signup_referral FROM users WHERE users.normalized_email = ?")
 
 	var __values []interface{}
 	__values = append(__values, user_normalized_email.value())
@@ -18502,7 +22098,7 @@ func (obj *pgxImpl) All_User_By_NormalizedEmail(ctx context.Context,
 
 			for __rows.Next() {
 				user := &User{}
-				err = __rows.Scan(&user.Id, &user.Email, &user.NormalizedEmail, &user.FullName, &user.ShortName, &user.PasswordHash, &user.NewUnverifiedEmail, &user.EmailChangeVerificationStep, &user.Status, &user.StatusUpdatedAt, &user.FinalInvoiceGenerated, &user.UserAgent, &user.CreatedAt, &user.ProjectLimit, &user.ProjectBandwidthLimit, &user.ProjectStorageLimit, &user.ProjectSegmentLimit, &user.PaidTier, &user.Position, &user.CompanyName, &user.CompanySize, &user.WorkingOn, &user.IsProfessional, &user.EmployeeCount, &user.HaveSalesContact, &user.MfaEnabled, &user.MfaSecretKey, &user.MfaRecoveryCodes, &user.SignupPromoCode, &user.VerificationReminders, &user.TrialNotifications, &user.FailedLoginCount, &user.LoginLockoutExpiration, &user.SignupCaptcha, &user.DefaultPlacement, &user.ActivationCode, &user.SignupId, &user.TrialExpiration, &user.UpgradeTime)
+				err = __rows.Scan(&user.Id, &user.Email, &user.NormalizedEmail, &user.FullName, &user.ShortName, &user.PasswordHash, &user.NewUnverifiedEmail, &user.EmailChangeVerificationStep, &user.Status, &user.StatusUpdatedAt, &user.FinalInvoiceGenerated, &user.UserAgent, &user.CreatedAt, &user.ProjectLimit, &user.ProjectBandwidthLimit, &user.ProjectStorageLimit, &user.ProjectSegmentLimit, &user.PaidTier, &user.Position, &user.CompanyName, &user.CompanySize, &user.WorkingOn, &user.IsProfessional, &user.EmployeeCount, &user.HaveSalesContact, &user.MfaEnabled, &user.MfaSecretKey, &user.MfaRecoveryCodes, &user.SignupPromoCode, &user.VerificationReminders, &user.TrialNotifications, &user.FailedLoginCount, &user.LoginLockoutExpiration, &user.SignupCaptcha, &user.DefaultPlacement, &user.ActivationCode, &user.SignupId, &user.TrialExpiration, &user.UpgradeTime, &user.SignupReferral)
 				if err != nil {
 					return nil, err
 				}
@@ -18529,7 +22125,7 @@ func (obj *pgxImpl) Get_User_By_NormalizedEmail_And_Status_Not_Number(ctx contex
 	user *User, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT users.id, users.email, users.normalized_email, users.full_name, users.short_name, users.password_hash, users.new_unverified_email, users.email_change_verification_step, users.status, users.status_updated_at, users.final_invoice_generated, users.user_agent, users.created_at, users.project_limit, users.project_bandwidth_limit, users.project_storage_limit, users.project_segment_limit, users.paid_tier, users.position, users.company_name, users.company_size, users.working_on, users.is_professional, users.employee_count, users.have_sales_contact, users.mfa_enabled, users.mfa_secret_key, users.mfa_recovery_codes, users.signup_promo_code, users.verification_reminders, users.trial_notifications, users.failed_login_count, users.login_lockout_expiration, users.signup_captcha, users.default_placement, users.activation_code, users.signup_id, users.trial_expiration, users.upgrade_time FROM users WHERE users.normalized_email = ? AND users.status != 0 LIMIT 2")
+	var __embed_stmt = __sqlbundle_Literal("SELECT users.id, users.email, users.normalized_email, users.full_name, users.short_name, users.password_hash, users.new_unverified_email, users.email_change_verification_step, users.status, users.status_updated_at, users.final_invoice_generated, users.user_agent, users.created_at, users.project_limit, users.project_bandwidth_limit, users.project_storage_limit, users.project_segment_limit, users.paid_tier, users.position, users.company_name, users.company_size, users.working_on, users.is_professional, users.employee_count, users.have_sales_contact, users.mfa_enabled, users.mfa_secret_key, users.mfa_recovery_codes, users.signup_promo_code, users.verification_reminders, users.trial_notifications, users.failed_login_count, users.login_lockout_expiration, users.signup_captcha, users.default_placement, users.activation_code, users.signup_id, users.trial_expiration, users.upgrade_time, users.signup_referral FROM users WHERE users.normalized_email = ? AND users.status != 0 LIMIT 2")
 
 	var __values []interface{}
 	__values = append(__values, user_normalized_email.value())
@@ -18553,7 +22149,7 @@ func (obj *pgxImpl) Get_User_By_NormalizedEmail_And_Status_Not_Number(ctx contex
 			}
 
 			user = &User{}
-			err = __rows.Scan(&user.Id, &user.Email, &user.NormalizedEmail, &user.FullName, &user.ShortName, &user.PasswordHash, &user.NewUnverifiedEmail, &user.EmailChangeVerificationStep, &user.Status, &user.StatusUpdatedAt, &user.FinalInvoiceGenerated, &user.UserAgent, &user.CreatedAt, &user.ProjectLimit, &user.ProjectBandwidthLimit, &user.ProjectStorageLimit, &user.ProjectSegmentLimit, &user.PaidTier, &user.Position, &user.CompanyName, &user.CompanySize, &user.WorkingOn, &user.IsProfessional, &user.EmployeeCount, &user.HaveSalesContact, &user.MfaEnabled, &user.MfaSecretKey, &user.MfaRecoveryCodes, &user.SignupPromoCode, &user.VerificationReminders, &user.TrialNotifications, &user.FailedLoginCount, &user.LoginLockoutExpiration, &user.SignupCaptcha, &user.DefaultPlacement, &user.ActivationCode, &user.SignupId, &user.TrialExpiration, &user.UpgradeTime)
+			err = __rows.Scan(&user.Id, &user.Email, &user.NormalizedEmail, &user.FullName, &user.ShortName, &user.PasswordHash, &user.NewUnverifiedEmail, &user.EmailChangeVerificationStep, &user.Status, &user.StatusUpdatedAt, &user.FinalInvoiceGenerated, &user.UserAgent, &user.CreatedAt, &user.ProjectLimit, &user.ProjectBandwidthLimit, &user.ProjectStorageLimit, &user.ProjectSegmentLimit, &user.PaidTier, &user.Position, &user.CompanyName, &user.CompanySize, &user.WorkingOn, &user.IsProfessional, &user.EmployeeCount, &user.HaveSalesContact, &user.MfaEnabled, &user.MfaSecretKey, &user.MfaRecoveryCodes, &user.SignupPromoCode, &user.VerificationReminders, &user.TrialNotifications, &user.FailedLoginCount, &user.LoginLockoutExpiration, &user.SignupCaptcha, &user.DefaultPlacement, &user.ActivationCode, &user.SignupId, &user.TrialExpiration, &user.UpgradeTime, &user.SignupReferral)
 			if err != nil {
 				return nil, err
 			}
@@ -18587,7 +22183,7 @@ func (obj *pgxImpl) Get_User_By_Id(ctx context.Context,
 	user *User, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT users.id, users.email, users.normalized_email, users.full_name, users.short_name, users.password_hash, users.new_unverified_email, users.email_change_verification_step, users.status, users.status_updated_at, users.final_invoice_generated, users.user_agent, users.created_at, users.project_limit, users.project_bandwidth_limit, users.project_storage_limit, users.project_segment_limit, users.paid_tier, users.position, users.company_name, users.company_size, users.working_on, users.is_professional, users.employee_count, users.have_sales_contact, users.mfa_enabled, users.mfa_secret_key, users.mfa_recovery_codes, users.signup_promo_code, users.verification_reminders, users.trial_notifications, users.failed_login_count, users.login_lockout_expiration, users.signup_captcha, users.default_placement, users.activation_code, users.signup_id, users.trial_expiration, users.upgrade_time FROM users WHERE users.id = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT users.id, users.email, users.normalized_email, users.full_name, users.short_name, users.password_hash, users.new_unverified_email, users.email_change_verification_step, users.status, users.status_updated_at, users.final_invoice_generated, users.user_agent, users.created_at, users.project_limit, users.project_bandwidth_limit, users.project_storage_limit, users.project_segment_limit, users.paid_tier, users.position, users.company_name, users.company_size, users.working_on, users.is_professional, users.employee_count, users.have_sales_contact, users.mfa_enabled, users.mfa_secret_key, users.mfa_recovery_codes, users.signup_promo_code, users.verification_reminders, users.trial_notifications, users.failed_login_count, users.login_lockout_expiration, users.signup_captcha, users.default_placement, users.activation_code, users.signup_id, users.trial_expiration, users.upgrade_time, users.signup_referral FROM users WHERE users.id = ?")
 
 	var __values []interface{}
 	__values = append(__values, user_id.value())
@@ -18596,7 +22192,7 @@ func (obj *pgxImpl) Get_User_By_Id(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	user = &User{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&user.Id, &user.Email, &user.NormalizedEmail, &user.FullName, &user.ShortName, &user.PasswordHash, &user.NewUnverifiedEmail, &user.EmailChangeVerificationStep, &user.Status, &user.StatusUpdatedAt, &user.FinalInvoiceGenerated, &user.UserAgent, &user.CreatedAt, &user.ProjectLimit, &user.ProjectBandwidthLimit, &user.ProjectStorageLimit, &user.ProjectSegmentLimit, &user.PaidTier, &user.Position, &user.CompanyName, &user.CompanySize, &user.WorkingOn, &user.IsProfessional, &user.EmployeeCount, &user.HaveSalesContact, &user.MfaEnabled, &user.MfaSecretKey, &user.MfaRecoveryCodes, &user.SignupPromoCode, &user.VerificationReminders, &user.TrialNotifications, &user.FailedLoginCount, &user.LoginLockoutExpiration, &user.SignupCaptcha, &user.DefaultPlacement, &user.ActivationCode, &user.SignupId, &user.TrialExpiration, &user.UpgradeTime)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&user.Id, &user.Email, &user.NormalizedEmail, &user.FullName, &user.ShortName, &user.PasswordHash, &user.NewUnverifiedEmail, &user.EmailChangeVerificationStep, &user.Status, &user.StatusUpdatedAt, &user.FinalInvoiceGenerated, &user.UserAgent, &user.CreatedAt, &user.ProjectLimit, &user.ProjectBandwidthLimit, &user.ProjectStorageLimit, &user.ProjectSegmentLimit, &user.PaidTier, &user.Position, &user.CompanyName, &user.CompanySize, &user.WorkingOn, &user.IsProfessional, &user.EmployeeCount, &user.HaveSalesContact, &user.MfaEnabled, &user.MfaSecretKey, &user.MfaRecoveryCodes, &user.SignupPromoCode, &user.VerificationReminders, &user.TrialNotifications, &user.FailedLoginCount, &user.LoginLockoutExpiration, &user.SignupCaptcha, &user.DefaultPlacement, &user.ActivationCode, &user.SignupId, &user.TrialExpiration, &user.UpgradeTime, &user.SignupReferral)
 	if err != nil {
 		return (*User)(nil), obj.makeErr(err)
 	}
@@ -18996,7 +22592,7 @@ func (obj *pgxImpl) Get_UserSettings_By_UserId(ctx context.Context,
 	user_settings *UserSettings, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT user_settings.user_id, user_settings.session_minutes, user_settings.passphrase_prompt, user_settings.onboarding_start, user_settings.onboarding_end, user_settings.onboarding_step, user_settings.notice_dismissal FROM user_settings WHERE user_settings.user_id = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT user_settings.user_id, user_settings.session_minutes, user_settings.passphrase_prompt, user_settings.onboarding_start, user_settings.onboarding_end, user_settings.onboarding_step, user_settings.notice_dismissal, user_settings.login_alerts FROM user_settings WHERE user_settings.user_id = ?")
 
 	var __values []interface{}
 	__values = append(__values, user_settings_user_id.value())
@@ -19005,7 +22601,7 @@ func (obj *pgxImpl) Get_UserSettings_By_UserId(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	user_settings = &UserSettings{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&user_settings.UserId, &user_settings.SessionMinutes, &user_settings.PassphrasePrompt, &user_settings.OnboardingStart, &user_settings.OnboardingEnd, &user_settings.OnboardingStep, &user_settings.NoticeDismissal)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&user_settings.UserId, &user_settings.SessionMinutes, &user_settings.PassphrasePrompt, &user_settings.OnboardingStart, &user_settings.OnboardingEnd, &user_settings.OnboardingStep, &user_settings.NoticeDismissal, &user_settings.LoginAlerts)
 	if err != nil {
 		return (*UserSettings)(nil), obj.makeErr(err)
 	}
@@ -21066,7 +24662,7 @@ func (obj *pgxImpl) Update_User_By_Id(ctx context.Context,
 	defer mon.Task()(&ctx)(&err)
 	var __sets = &__sqlbundle_Hole{}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE users SET "), __sets, __sqlbundle_Literal(" WHERE users.id = ? RETURNING users.id, users.email, users.normalized_email, users.full_name, users.short_name, users.password_hash, users.new_unverified_email, users.email_change_verification_step, users.status, users.status_updated_at, users.final_invoice_generated, users.user_agent, users.created_at, users.project_limit, users.project_bandwidth_limit, users.project_storage_limit, users.project_segment_limit, users.paid_tier, users.position, users.company_name, users.company_size, users.working_on, users.is_professional, users.employee_count, users.have_sales_contact, users.mfa_enabled, users.mfa_secret_key, users.mfa_recovery_codes, users.signup_promo_code, users.verification_reminders, users.trial_notifications, users.failed_login_count, users.login_lockout_expiration, users.signup_captcha, users.default_placement, users.activation_code, users.signup_id, users.trial_expiration, users.upgrade_time")}}
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE users SET "), __sets, __sqlbundle_Literal(" WHERE users.id = ? RETURNING users.id, users.email, users.normalized_email, users.full_name, users.short_name, users.password_hash, users.new_unverified_email, users.email_change_verification_step, users.status, users.status_updated_at, users.final_invoice_generated, users.user_agent, users.created_at, users.project_limit, users.project_bandwidth_limit, users.project_storage_limit, users.project_segment_limit, users.paid_tier, users.position, users.company_name, users.company_size, users.working_on, users.is_professional, users.employee_count, users.have_sales_contact, users.mfa_enabled, users.mfa_secret_key, users.mfa_recovery_codes, users.signup_promo_code, users.verification_reminders, users.trial_notifications, users.failed_login_count, users.login_lockout_expiration, users.signup_captcha, users.default_placement, users.activation_code, users.signup_id, users.trial_expiration, users.upgrade_time, users.signup_referral")}}
 
 	__sets_sql := __sqlbundle_Literals{Join: ", "}
 	var __values []interface{}
@@ -21265,7 +24861,7 @@ func (obj *pgxImpl) Update_User_By_Id(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	user = &User{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&user.Id, &user.Email, &user.NormalizedEmail, &user.FullName, &user.ShortName, &user.PasswordHash, &user.NewUnverifiedEmail, &user.EmailChangeVerificationStep, &user.Status, &user.StatusUpdatedAt, &user.FinalInvoiceGenerated, &user.UserAgent, &user.CreatedAt, &user.ProjectLimit, &user.ProjectBandwidthLimit, &user.ProjectStorageLimit, &user.ProjectSegmentLimit, &user.PaidTier, &user.Position, &user.CompanyName, &user.CompanySize, &user.WorkingOn, &user.IsProfessional, &user.EmployeeCount, &user.HaveSalesContact, &user.MfaEnabled, &user.MfaSecretKey, &user.MfaRecoveryCodes, &user.SignupPromoCode, &user.VerificationReminders, &user.TrialNotifications, &user.FailedLoginCount, &user.LoginLockoutExpiration, &user.SignupCaptcha, &user.DefaultPlacement, &user.ActivationCode, &user.SignupId, &user.TrialExpiration, &user.UpgradeTime)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&user.Id, &user.Email, &user.NormalizedEmail, &user.FullName, &user.ShortName, &user.PasswordHash, &user.NewUnverifiedEmail, &user.EmailChangeVerificationStep, &user.Status, &user.StatusUpdatedAt, &user.FinalInvoiceGenerated, &user.UserAgent, &user.CreatedAt, &user.ProjectLimit, &user.ProjectBandwidthLimit, &user.ProjectStorageLimit, &user.ProjectSegmentLimit, &user.PaidTier, &user.Position, &user.CompanyName, &user.CompanySize, &user.WorkingOn, &user.IsProfessional, &user.EmployeeCount, &user.HaveSalesContact, &user.MfaEnabled, &user.MfaSecretKey, &user.MfaRecoveryCodes, &user.SignupPromoCode, &user.VerificationReminders, &user.TrialNotifications, &user.FailedLoginCount, &user.LoginLockoutExpiration, &user.SignupCaptcha, &user.DefaultPlacement, &user.ActivationCode, &user.SignupId, &user.TrialExpiration, &user.UpgradeTime, &user.SignupReferral)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
@@ -21421,7 +25017,7 @@ func (obj *pgxImpl) Update_UserSettings_By_UserId(ctx context.Context,
 	defer mon.Task()(&ctx)(&err)
 	var __sets = &__sqlbundle_Hole{}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE user_settings SET "), __sets, __sqlbundle_Literal(" WHERE user_settings.user_id = ? RETURNING user_settings.user_id, user_settings.session_minutes, user_settings.passphrase_prompt, user_settings.onboarding_start, user_settings.onboarding_end, user_settings.onboarding_step, user_settings.notice_dismissal")}}
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE user_settings SET "), __sets, __sqlbundle_Literal(" WHERE user_settings.user_id = ? RETURNING user_settings.user_id, user_settings.session_minutes, user_settings.passphrase_prompt, user_settings.onboarding_start, user_settings.onboarding_end, user_settings.onboarding_step, user_settings.notice_dismissal, user_settings.login_alerts")}}
 
 	__sets_sql := __sqlbundle_Literals{Join: ", "}
 	var __values []interface{}
@@ -21457,6 +25053,11 @@ func (obj *pgxImpl) Update_UserSettings_By_UserId(ctx context.Context,
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("notice_dismissal = ?"))
 	}
 
+	if update.LoginAlerts._set {
+		__values = append(__values, update.LoginAlerts.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("login_alerts = ?"))
+	}
+
 	if len(__sets_sql.SQLs) == 0 {
 		return nil, emptyUpdate()
 	}
@@ -21470,7 +25071,7 @@ func (obj *pgxImpl) Update_UserSettings_By_UserId(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	user_settings = &UserSettings{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&user_settings.UserId, &user_settings.SessionMinutes, &user_settings.PassphrasePrompt, &user_settings.OnboardingStart, &user_settings.OnboardingEnd, &user_settings.OnboardingStep, &user_settings.NoticeDismissal)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&user_settings.UserId, &user_settings.SessionMinutes, &user_settings.PassphrasePrompt, &user_settings.OnboardingStart, &user_settings.OnboardingEnd, &user_settings.OnboardingStep, &user_settings.NoticeDismissal, &user_settings.LoginAlerts)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
@@ -22009,14 +25610,194 @@ func (impl pgxImpl) isConstraintError(err error) (
 			return e.ConstraintName, true
 		}
 	}
-	return "", false
-}
+	return "", false
+}
+
+func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+	var __res sql.Result
+	var __count int64
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM stripecoinpayments_apply_balance_intents;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM project_members;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM project_invitations;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM bucket_metainfos;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM api_keys;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM webapp_sessions;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM verification_audits;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM value_attributions;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM user_settings;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM users;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM trusted_devices;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM stripecoinpayments_tx_conversion_rates;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM stripecoinpayments_invoice_project_records;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM stripe_customers;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM storjscan_wallets;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM storjscan_payments;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM storagenode_storage_tallies;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM storagenode_paystubs;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
 
-func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
-	defer mon.Task()(&ctx)(&err)
-	var __res sql.Result
-	var __count int64
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM stripecoinpayments_apply_balance_intents;")
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM storagenode_payments;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -22026,7 +25807,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM project_members;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM storagenode_bandwidth_rollups_phase2;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -22036,7 +25817,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM project_invitations;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM storagenode_bandwidth_rollup_archives;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -22046,7 +25827,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM bucket_metainfos;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM storagenode_bandwidth_rollups;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -22056,7 +25837,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM api_keys;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM segment_pending_audits;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -22066,7 +25847,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM webapp_sessions;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM segment_integrity_queue;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -22076,7 +25857,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM verification_audits;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM scim_users;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -22086,7 +25867,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM value_attributions;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM revocations;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -22096,7 +25877,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM user_settings;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM reverification_audits;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -22106,7 +25887,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM users;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM reset_password_tokens;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -22116,7 +25897,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM stripecoinpayments_tx_conversion_rates;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM reputations;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -22126,7 +25907,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM stripecoinpayments_invoice_project_records;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM repair_queue;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -22136,7 +25917,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM stripe_customers;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM registration_tokens;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -22146,7 +25927,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM storjscan_wallets;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM project_passphrase_escrows;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -22156,7 +25937,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM storjscan_payments;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM project_limit_changes;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -22166,7 +25947,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM storagenode_storage_tallies;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM project_invitation_policies;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -22176,7 +25957,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM storagenode_paystubs;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM project_data_purges;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -22186,7 +25967,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM storagenode_payments;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM project_bandwidth_daily_rollups;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -22196,7 +25977,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM storagenode_bandwidth_rollups_phase2;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM projects;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -22206,7 +25987,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM storagenode_bandwidth_rollup_archives;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM pending_object_grace_periods;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -22216,7 +25997,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM storagenode_bandwidth_rollups;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM peer_identities;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -22226,7 +26007,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM segment_pending_audits;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM passphrase_recovery_approvals;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -22236,7 +26017,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM revocations;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM passphrase_recoveries;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -22246,7 +26027,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM reverification_audits;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM oauth_tokens;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -22256,7 +26037,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM reset_password_tokens;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM oauth_codes;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -22266,7 +26047,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM reputations;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM oauth_clients;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -22276,7 +26057,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM repair_queue;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM node_tags;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -22286,7 +26067,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM registration_tokens;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM node_events;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -22296,7 +26077,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM project_bandwidth_daily_rollups;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM node_api_versions;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -22306,7 +26087,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM projects;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM nodes;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -22316,7 +26097,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM peer_identities;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM maintenance_windows;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -22326,7 +26107,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM oauth_tokens;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM linksharing_brandings;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -22336,7 +26117,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM oauth_codes;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM graceful_exit_segment_transfer_queue;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -22346,7 +26127,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM oauth_clients;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM graceful_exit_progress;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -22356,7 +26137,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM node_tags;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM coinpayments_transactions;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -22366,7 +26147,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM node_events;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM bucket_storage_tallies;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -22376,7 +26157,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM node_api_versions;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM bucket_lifecycle_configurations;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -22386,7 +26167,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM nodes;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM bucket_inventory_configurations;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -22396,7 +26177,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM graceful_exit_segment_transfer_queue;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM bucket_bandwidth_rollup_archives;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -22406,7 +26187,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM graceful_exit_progress;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM bucket_bandwidth_rollups;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -22416,7 +26197,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM coinpayments_transactions;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM billing_transactions;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -22426,7 +26207,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM bucket_storage_tallies;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM billing_contacts;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -22436,7 +26217,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM bucket_bandwidth_rollup_archives;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM billing_balances;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -22446,7 +26227,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM bucket_bandwidth_rollups;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM api_key_bandwidth_rollups;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -22456,7 +26237,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM billing_transactions;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM admin_webhook_deliveries;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -22466,7 +26247,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM billing_balances;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM admin_audit_events;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -23787,15 +27568,16 @@ func (obj *pgxcockroachImpl) Create_User(ctx context.Context,
 	__signup_id_val := optional.SignupId.value()
 	__trial_expiration_val := optional.TrialExpiration.value()
 	__upgrade_time_val := optional.UpgradeTime.value()
+	__signup_referral_val := optional.SignupReferral.value()
 
-	var __columns = &__sqlbundle_Hole{SQL: __sqlbundle_Literal("id, email, normalized_email, full_name, short_name, password_hash, new_unverified_email, status, status_updated_at, user_agent, created_at, position, company_name, company_size, working_on, employee_count, mfa_secret_key, mfa_recovery_codes, signup_promo_code, failed_login_count, login_lockout_expiration, signup_captcha, default_placement, activation_code, signup_id, trial_expiration, upgrade_time")}
-	var __placeholders = &__sqlbundle_Hole{SQL: __sqlbundle_Literal("?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?")}
+	var __columns = &__sqlbundle_Hole{SQL: __sqlbundle_Literal("id, email, normalized_email, full_name, short_name, password_hash, new_unverified_email, status, status_updated_at, user_agent, created_at, position, company_name, company_size, working_on, employee_count, mfa_secret_key, mfa_recovery_codes, signup_promo_code, failed_login_count, login_lockout_expiration, signup_captcha, default_placement, activation_code, signup_id, trial_expiration, upgrade_time, signup_referral")}
+	var __placeholders = &__sqlbundle_Hole{SQL: __sqlbundle_Literal("?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?")}
 	var __clause = &__sqlbundle_Hole{SQL: __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("("), __columns, __sqlbundle_Literal(") VALUES ("), __placeholders, __sqlbundle_Literal(")")}}}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("INSERT INTO users "), __clause, __sqlbundle_Literal(" RETURNING users.id, users.email, users.normalized_email, users.full_name, users.short_name, users.password_hash, users.new_unverified_email, users.email_change_verification_step, users.status, users.status_updated_at, users.final_invoice_generated, users.user_agent, users.created_at, users.project_limit, users.project_bandwidth_limit, users.project_storage_limit, users.project_segment_limit, users.paid_tier, users.position, users.company_name, users.company_size, users.working_on, users.is_professional, users.employee_count, users.have_sales_contact, users.mfa_enabled, users.mfa_secret_key, users.mfa_recovery_codes, users.signup_promo_code, users.verification_reminders, users.trial_notifications, users.failed_login_count, users.login_lockout_expiration, users.signup_captcha, users.default_placement, users.activation_code, users.signup_id, users.trial_expiration, users.upgrade_time")}}
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("INSERT INTO users "), __clause, __sqlbundle_Literal(" RETURNING users.id, users.email, users.normalized_email, users.full_name, users.short_name, users.password_hash, users.new_unverified_email, users.email_change_verification_step, users.status, users.status_updated_at, users.final_invoice_generated, users.user_agent, users.created_at, users.project_limit, users.project_bandwidth_limit, users.project_storage_limit, users.project_segment_limit, users.paid_tier, users.position, users.company_name, users.company_size, users.working_on, users.is_professional, users.employee_count, users.have_sales_contact, users.mfa_enabled, users.mfa_secret_key, users.mfa_recovery_codes, users.signup_promo_code, users.verification_reminders, users.trial_notifications, users.failed_login_count, users.login_lockout_expiration, users.signup_captcha, users.default_placement, users.activation_code, users.signup_id, users.trial_expiration, users.upgrade_time, users.signup_referral")}}
 
 	var __values []interface{}
-	__values = append(__values, __id_val, __email_val, __normalized_email_val, __full_name_val, __short_name_val, __password_hash_val, __new_unverified_email_val, __status_val, __status_updated_at_val, __user_agent_val, __created_at_val, __position_val, __company_name_val, __company_size_val, __working_on_val, __employee_count_val, __mfa_secret_key_val, __mfa_recovery_codes_val, __signup_promo_code_val, __failed_login_count_val, __login_lockout_expiration_val, __signup_captcha_val, __default_placement_val, __activation_code_val, __signup_id_val, __trial_expiration_val, __upgrade_time_val)
+	__values = append(__values, __id_val, __email_val, __normalized_email_val, __full_name_val, __short_name_val, __password_hash_val, __new_unverified_email_val, __status_val, __status_updated_at_val, __user_agent_val, __created_at_val, __position_val, __company_name_val, __company_size_val, __working_on_val, __employee_count_val, __mfa_secret_key_val, __mfa_recovery_codes_val, __signup_promo_code_val, __failed_login_count_val, __login_lockout_expiration_val, __signup_captcha_val, __default_placement_val, __activation_code_val, __signup_id_val, __trial_expiration_val, __upgrade_time_val, __signup_referral_val)
 
 	__optional_columns := __sqlbundle_Literals{Join: ", "}
 	__optional_placeholders := __sqlbundle_Literals{Join: ", "}
@@ -23884,7 +27666,7 @@ func (obj *pgxcockroachImpl) Create_User(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	user = &User{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&user.Id, &user.Email, &user.NormalizedEmail, &user.FullName, &user.ShortName, &user.PasswordHash, &user.NewUnverifiedEmail, &user.EmailChangeVerificationStep, &user.Status, &user.StatusUpdatedAt, &user.FinalInvoiceGenerated, &user.UserAgent, &user.CreatedAt, &user.ProjectLimit, &user.ProjectBandwidthLimit, &user.ProjectStorageLimit, &user.ProjectSegmentLimit, &user.PaidTier, &user.Position, &user.CompanyName, &user.CompanySize, &user.WorkingOn, &user.IsProfessional, &user.EmployeeCount, &user.HaveSalesContact, &user.MfaEnabled, &user.MfaSecretKey, &user.MfaRecoveryCodes, &user.SignupPromoCode, &user.VerificationReminders, &user.TrialNotifications, &user.FailedLoginCount, &user.LoginLockoutExpiration, &user.SignupCaptcha, &user.DefaultPlacement, &user.ActivationCode, &user.SignupId, &user.TrialExpiration, &user.UpgradeTime)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&user.Id, &user.Email, &user.NormalizedEmail, &user.FullName, &user.ShortName, &user.PasswordHash, &user.NewUnverifiedEmail, &user.EmailChangeVerificationStep, &user.Status, &user.StatusUpdatedAt, &user.FinalInvoiceGenerated, &user.UserAgent, &user.CreatedAt, &user.ProjectLimit, &user.ProjectBandwidthLimit, &user.ProjectStorageLimit, &user.ProjectSegmentLimit, &user.PaidTier, &user.Position, &user.CompanyName, &user.CompanySize, &user.WorkingOn, &user.IsProfessional, &user.EmployeeCount, &user.HaveSalesContact, &user.MfaEnabled, &user.MfaSecretKey, &user.MfaRecoveryCodes, &user.SignupPromoCode, &user.VerificationReminders, &user.TrialNotifications, &user.FailedLoginCount, &user.LoginLockoutExpiration, &user.SignupCaptcha, &user.DefaultPlacement, &user.ActivationCode, &user.SignupId, &user.TrialExpiration, &user.UpgradeTime, &user.SignupReferral)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -24077,6 +27859,12 @@ func (obj *pgxcockroachImpl) CreateNoReturn_UserSettings(ctx context.Context,
 		__optional_placeholders.SQLs = append(__optional_placeholders.SQLs, __sqlbundle_Literal("?"))
 	}
 
+	if optional.LoginAlerts._set {
+		__values = append(__values, optional.LoginAlerts.value())
+		__optional_columns.SQLs = append(__optional_columns.SQLs, __sqlbundle_Literal("login_alerts"))
+		__optional_placeholders.SQLs = append(__optional_placeholders.SQLs, __sqlbundle_Literal("?"))
+	}
+
 	if len(__optional_columns.SQLs) == 0 {
 		if __columns.SQL == nil {
 			__clause.SQL = __sqlbundle_Literal("DEFAULT VALUES")
@@ -27328,7 +31116,7 @@ func (obj *pgxcockroachImpl) All_User_By_NormalizedEmail(ctx context.Context,
 	rows []*User, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT users.id, users.email, users.normalized_email, users.full_name, users.short_name, users.password_hash, users.new_unverified_email, users.email_change_verification_step, users.status, users.status_updated_at, users.final_invoice_generated, users.user_agent, users.created_at, users.project_limit, users.project_bandwidth_limit, users.project_storage_limit, users.project_segment_limit, users.paid_tier, users.position, users.company_name, users.company_size, users.working_on, users.is_professional, users.employee_count, users.have_sales_contact, users.mfa_enabled, users.mfa_secret_key, users.mfa_recovery_codes, users.signup_promo_code, users.verification_reminders, users.trial_notifications, users.failed_login_count, users.login_lockout_expiration, users.signup_captcha, users.default_placement, users.activation_code, users.signup_id, users.trial_expiration, users.upgrade_time FROM users WHERE users.normalized_email = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT users.id, users.email, users.normalized_email, users.full_name, users.short_name, users.password_hash, users.new_unverified_email, users.email_change_verification_step, users.status, users.status_updated_at, users.final_invoice_generated, users.user_agent, users.created_at, users.project_limit, users.project_bandwidth_limit, users.project_storage_limit, users.project_segment_limit, users.paid_tier, users.position, users.company_name, users.company_size, users.working_on, users.is_professional, users.employee_count, users.have_sales_contact, users.mfa_enabled, users.mfa_secret_key, users.mfa_recovery_codes, users.signup_promo_code, users.verification_reminders, users.trial_notifications, users.failed_login_count, users.login_lockout_expiration, users.signup_captcha, users.default_placement, users.activation_code, users.signup_id, users.trial_expiration, users.upgrade_time, users.signup_referral FROM users WHERE users.normalized_email = ?")
 
 	var __values []interface{}
 	__values = append(__values, user_normalized_email.value())
@@ -27346,7 +31134,7 @@ func (obj *pgxcockroachImpl) All_User_By_NormalizedEmail(ctx context.Context,
 
 			for __rows.Next() {
 				user := &User{}
-				err = __rows.Scan(&user.Id, &user.Email, &user.NormalizedEmail, &user.FullName, &user.ShortName, &user.PasswordHash, &user.NewUnverifiedEmail, &user.EmailChangeVerificationStep, &user.Status, &user.StatusUpdatedAt, &user.FinalInvoiceGenerated, &user.UserAgent, &user.CreatedAt, &user.ProjectLimit, &user.ProjectBandwidthLimit, &user.ProjectStorageLimit, &user.ProjectSegmentLimit, &user.PaidTier, &user.Position, &user.CompanyName, &user.CompanySize, &user.WorkingOn, &user.IsProfessional, &user.EmployeeCount, &user.HaveSalesContact, &user.MfaEnabled, &user.MfaSecretKey, &user.MfaRecoveryCodes, &user.SignupPromoCode, &user.VerificationReminders, &user.TrialNotifications, &user.FailedLoginCount, &user.LoginLockoutExpiration, &user.SignupCaptcha, &user.DefaultPlacement, &user.ActivationCode, &user.SignupId, &user.TrialExpiration, &user.UpgradeTime)
+				err = __rows.Scan(&user.Id, &user.Email, &user.NormalizedEmail, &user.FullName, &user.ShortName, &user.PasswordHash, &user.NewUnverifiedEmail, &user.EmailChangeVerificationStep, &user.Status, &user.StatusUpdatedAt, &user.FinalInvoiceGenerated, &user.UserAgent, &user.CreatedAt, &user.ProjectLimit, &user.ProjectBandwidthLimit, &user.ProjectStorageLimit, &user.ProjectSegmentLimit, &user.PaidTier, &user.Position, &user.CompanyName, &user.CompanySize, &user.WorkingOn, &user.IsProfessional, &user.EmployeeCount, &user.HaveSalesContact, &user.MfaEnabled, &user.MfaSecretKey, &user.MfaRecoveryCodes, &user.SignupPromoCode, &user.VerificationReminders, &user.TrialNotifications, &user.FailedLoginCount, &user.LoginLockoutExpiration, &user.SignupCaptcha, &user.DefaultPlacement, &user.ActivationCode, &user.SignupId, &user.TrialExpiration, &user.UpgradeTime, &user.SignupReferral)
 				if err != nil {
 					return nil, err
 				}
@@ -27373,7 +31161,7 @@ func (obj *pgxcockroachImpl) Get_User_By_NormalizedEmail_And_Status_Not_Number(c
 	user *User, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT users.id, users.email, users.normalized_email, users.full_name, users.short_name, users.password_hash, users.new_unverified_email, users.email_change_verification_step, users.status, users.status_updated_at, users.final_invoice_generated, users.user_agent, users.created_at, users.project_limit, users.project_bandwidth_limit, users.project_storage_limit, users.project_segment_limit, users.paid_tier, users.position, users.company_name, users.company_size, users.working_on, users.is_professional, users.employee_count, users.have_sales_contact, users.mfa_enabled, users.mfa_secret_key, users.mfa_recovery_codes, users.signup_promo_code, users.verification_reminders, users.trial_notifications, users.failed_login_count, users.login_lockout_expiration, users.signup_captcha, users.default_placement, users.activation_code, users.signup_id, users.trial_expiration, users.upgrade_time FROM users WHERE users.normalized_email = ? AND users.status != 0 LIMIT 2")
+	var __embed_stmt = __sqlbundle_Literal("SELECT users.id, users.email, users.normalized_email, users.full_name, users.short_name, users.password_hash, users.new_unverified_email, users.email_change_verification_step, users.status, users.status_updated_at, users.final_invoice_generated, users.user_agent, users.created_at, users.project_limit, users.project_bandwidth_limit, users.project_storage_limit, users.project_segment_limit, users.paid_tier, users.position, users.company_name, users.company_size, users.working_on, users.is_professional, users.employee_count, users.have_sales_contact, users.mfa_enabled, users.mfa_secret_key, users.mfa_recovery_codes, users.signup_promo_code, users.verification_reminders, users.trial_notifications, users.failed_login_count, users.login_lockout_expiration, users.signup_captcha, users.default_placement, users.activation_code, users.signup_id, users.trial_expiration, users.upgrade_time, users.signup_referral FROM users WHERE users.normalized_email = ? AND users.status != 0 LIMIT 2")
 
 	var __values []interface{}
 	__values = append(__values, user_normalized_email.value())
@@ -27397,7 +31185,7 @@ func (obj *pgxcockroachImpl) Get_User_By_NormalizedEmail_And_Status_Not_Number(c
 			}
 
 			user = &User{}
-			err = __rows.Scan(&user.Id, &user.Email, &user.NormalizedEmail, &user.FullName, &user.ShortName, &user.PasswordHash, &user.NewUnverifiedEmail, &user.EmailChangeVerificationStep, &user.Status, &user.StatusUpdatedAt, &user.FinalInvoiceGenerated, &user.UserAgent, &user.CreatedAt, &user.ProjectLimit, &user.ProjectBandwidthLimit, &user.ProjectStorageLimit, &user.ProjectSegmentLimit, &user.PaidTier, &user.Position, &user.CompanyName, &user.CompanySize, &user.WorkingOn, &user.IsProfessional, &user.EmployeeCount, &user.HaveSalesContact, &user.MfaEnabled, &user.MfaSecretKey, &user.MfaRecoveryCodes, &user.SignupPromoCode, &user.VerificationReminders, &user.TrialNotifications, &user.FailedLoginCount, &user.LoginLockoutExpiration, &user.SignupCaptcha, &user.DefaultPlacement, &user.ActivationCode, &user.SignupId, &user.TrialExpiration, &user.UpgradeTime)
+			err = __rows.Scan(&user.Id, &user.Email, &user.NormalizedEmail, &user.FullName, &user.ShortName, &user.PasswordHash, &user.NewUnverifiedEmail, &user.EmailChangeVerificationStep, &user.Status, &user.StatusUpdatedAt, &user.FinalInvoiceGenerated, &user.UserAgent, &user.CreatedAt, &user.ProjectLimit, &user.ProjectBandwidthLimit, &user.ProjectStorageLimit, &user.ProjectSegmentLimit, &user.PaidTier, &user.Position, &user.CompanyName, &user.CompanySize, &user.WorkingOn, &user.IsProfessional, &user.EmployeeCount, &user.HaveSalesContact, &user.MfaEnabled, &user.MfaSecretKey, &user.MfaRecoveryCodes, &user.SignupPromoCode, &user.VerificationReminders, &user.TrialNotifications, &user.FailedLoginCount, &user.LoginLockoutExpiration, &user.SignupCaptcha, &user.DefaultPlacement, &user.ActivationCode, &user.SignupId, &user.TrialExpiration, &user.UpgradeTime, &user.SignupReferral)
 			if err != nil {
 				return nil, err
 			}
@@ -27431,7 +31219,7 @@ func (obj *pgxcockroachImpl) Get_User_By_Id(ctx context.Context,
 	user *User, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT users.id, users.email, users.normalized_email, users.full_name, users.short_name, users.password_hash, users.new_unverified_email, users.email_change_verification_step, users.status, users.status_updated_at, users.final_invoice_generated, users.user_agent, users.created_at, users.project_limit, users.project_bandwidth_limit, users.project_storage_limit, users.project_segment_limit, users.paid_tier, users.position, users.company_name, users.company_size, users.working_on, users.is_professional, users.employee_count, users.have_sales_contact, users.mfa_enabled, users.mfa_secret_key, users.mfa_recovery_codes, users.signup_promo_code, users.verification_reminders, users.trial_notifications, users.failed_login_count, users.login_lockout_expiration, users.signup_captcha, users.default_placement, users.activation_code, users.signup_id, users.trial_expiration, users.upgrade_time FROM users WHERE users.id = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT users.id, users.email, users.normalized_email, users.full_name, users.short_name, users.password_hash, users.new_unverified_email, users.email_change_verification_step, users.status, users.status_updated_at, users.final_invoice_generated, users.user_agent, users.created_at, users.project_limit, users.project_bandwidth_limit, users.project_storage_limit, users.project_segment_limit, users.paid_tier, users.position, users.company_name, users.company_size, users.working_on, users.is_professional, users.employee_count, users.have_sales_contact, users.mfa_enabled, users.mfa_secret_key, users.mfa_recovery_codes, users.signup_promo_code, users.verification_reminders, users.trial_notifications, users.failed_login_count, users.login_lockout_expiration, users.signup_captcha, users.default_placement, users.activation_code, users.signup_id, users.trial_expiration, users.upgrade_time, users.signup_referral FROM users WHERE users.id = ?")
 
 	var __values []interface{}
 	__values = append(__values, user_id.value())
@@ -27440,7 +31228,7 @@ func (obj *pgxcockroachImpl) Get_User_By_Id(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	user = &User{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&user.Id, &user.Email, &user.NormalizedEmail, &user.FullName, &user.ShortName, &user.PasswordHash, &user.NewUnverifiedEmail, &user.EmailChangeVerificationStep, &user.Status, &user.StatusUpdatedAt, &user.FinalInvoiceGenerated, &user.UserAgent, &user.CreatedAt, &user.ProjectLimit, &user.ProjectBandwidthLimit, &user.ProjectStorageLimit, &user.ProjectSegmentLimit, &user.PaidTier, &user.Position, &user.CompanyName, &user.CompanySize, &user.WorkingOn, &user.IsProfessional, &user.EmployeeCount, &user.HaveSalesContact, &user.MfaEnabled, &user.MfaSecretKey, &user.MfaRecoveryCodes, &user.SignupPromoCode, &user.VerificationReminders, &user.TrialNotifications, &user.FailedLoginCount, &user.LoginLockoutExpiration, &user.SignupCaptcha, &user.DefaultPlacement, &user.ActivationCode, &user.SignupId, &user.TrialExpiration, &user.UpgradeTime)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&user.Id, &user.Email, &user.NormalizedEmail, &user.FullName, &user.ShortName, &user.PasswordHash, &user.NewUnverifiedEmail, &user.EmailChangeVerificationStep, &user.Status, &user.StatusUpdatedAt, &user.FinalInvoiceGenerated, &user.UserAgent, &user.CreatedAt, &user.ProjectLimit, &user.ProjectBandwidthLimit, &user.ProjectStorageLimit, &user.ProjectSegmentLimit, &user.PaidTier, &user.Position, &user.CompanyName, &user.CompanySize, &user.WorkingOn, &user.IsProfessional, &user.EmployeeCount, &user.HaveSalesContact, &user.MfaEnabled, &user.MfaSecretKey, &user.MfaRecoveryCodes, &user.SignupPromoCode, &user.VerificationReminders, &user.TrialNotifications, &user.FailedLoginCount, &user.LoginLockoutExpiration, &user.SignupCaptcha, &user.DefaultPlacement, &user.ActivationCode, &user.SignupId, &user.TrialExpiration, &user.UpgradeTime, &user.SignupReferral)
 	if err != nil {
 		return (*User)(nil), obj.makeErr(err)
 	}
@@ -27840,7 +31628,7 @@ func (obj *pgxcockroachImpl) Get_UserSettings_By_UserId(ctx context.Context,
 	user_settings *UserSettings, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT user_settings.user_id, user_settings.session_minutes, user_settings.passphrase_prompt, user_settings.onboarding_start, user_settings.onboarding_end, user_settings.onboarding_step, user_settings.notice_dismissal FROM user_settings WHERE user_settings.user_id = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT user_settings.user_id, user_settings.session_minutes, user_settings.passphrase_prompt, user_settings.onboarding_start, user_settings.onboarding_end, user_settings.onboarding_step, user_settings.notice_dismissal, user_settings.login_alerts FROM user_settings WHERE user_settings.user_id = ?")
 
 	var __values []interface{}
 	__values = append(__values, user_settings_user_id.value())
@@ -27849,7 +31637,7 @@ func (obj *pgxcockroachImpl) Get_UserSettings_By_UserId(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	user_settings = &UserSettings{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&user_settings.UserId, &user_settings.SessionMinutes, &user_settings.PassphrasePrompt, &user_settings.OnboardingStart, &user_settings.OnboardingEnd, &user_settings.OnboardingStep, &user_settings.NoticeDismissal)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&user_settings.UserId, &user_settings.SessionMinutes, &user_settings.PassphrasePrompt, &user_settings.OnboardingStart, &user_settings.OnboardingEnd, &user_settings.OnboardingStep, &user_settings.NoticeDismissal, &user_settings.LoginAlerts)
 	if err != nil {
 		return (*UserSettings)(nil), obj.makeErr(err)
 	}
@@ -29910,7 +33698,7 @@ func (obj *pgxcockroachImpl) Update_User_By_Id(ctx context.Context,
 	defer mon.Task()(&ctx)(&err)
 	var __sets = &__sqlbundle_Hole{}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE users SET "), __sets, __sqlbundle_Literal(" WHERE users.id = ? RETURNING users.id, users.email, users.normalized_email, users.full_name, users.short_name, users.password_hash, users.new_unverified_email, users.email_change_verification_step, users.status, users.status_updated_at, users.final_invoice_generated, users.user_agent, users.created_at, users.project_limit, users.project_bandwidth_limit, users.project_storage_limit, users.project_segment_limit, users.paid_tier, users.position, users.company_name, users.company_size, users.working_on, users.is_professional, users.employee_count, users.have_sales_contact, users.mfa_enabled, users.mfa_secret_key, users.mfa_recovery_codes, users.signup_promo_code, users.verification_reminders, users.trial_notifications, users.failed_login_count, users.login_lockout_expiration, users.signup_captcha, users.default_placement, users.activation_code, users.signup_id, users.trial_expiration, users.upgrade_time")}}
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE users SET "), __sets, __sqlbundle_Literal(" WHERE users.id = ? RETURNING users.id, users.email, users.normalized_email, users.full_name, users.short_name, users.password_hash, users.new_unverified_email, users.email_change_verification_step, users.status, users.status_updated_at, users.final_invoice_generated, users.user_agent, users.created_at, users.project_limit, users.project_bandwidth_limit, users.project_storage_limit, users.project_segment_limit, users.paid_tier, users.position, users.company_name, users.company_size, users.working_on, users.is_professional, users.employee_count, users.have_sales_contact, users.mfa_enabled, users.mfa_secret_key, users.mfa_recovery_codes, users.signup_promo_code, users.verification_reminders, users.trial_notifications, users.failed_login_count, users.login_lockout_expiration, users.signup_captcha, users.default_placement, users.activation_code, users.signup_id, users.trial_expiration, users.upgrade_time, users.signup_referral")}}
 
 	__sets_sql := __sqlbundle_Literals{Join: ", "}
 	var __values []interface{}
@@ -30109,7 +33897,7 @@ func (obj *pgxcockroachImpl) Update_User_By_Id(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	user = &User{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&user.Id, &user.Email, &user.NormalizedEmail, &user.FullName, &user.ShortName, &user.PasswordHash, &user.NewUnverifiedEmail, &user.EmailChangeVerificationStep, &user.Status, &user.StatusUpdatedAt, &user.FinalInvoiceGenerated, &user.UserAgent, &user.CreatedAt, &user.ProjectLimit, &user.ProjectBandwidthLimit, &user.ProjectStorageLimit, &user.ProjectSegmentLimit, &user.PaidTier, &user.Position, &user.CompanyName, &user.CompanySize, &user.WorkingOn, &user.IsProfessional, &user.EmployeeCount, &user.HaveSalesContact, &user.MfaEnabled, &user.MfaSecretKey, &user.MfaRecoveryCodes, &user.SignupPromoCode, &user.VerificationReminders, &user.TrialNotifications, &user.FailedLoginCount, &user.LoginLockoutExpiration, &user.SignupCaptcha, &user.DefaultPlacement, &user.ActivationCode, &user.SignupId, &user.TrialExpiration, &user.UpgradeTime)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&user.Id, &user.Email, &user.NormalizedEmail, &user.FullName, &user.ShortName, &user.PasswordHash, &user.NewUnverifiedEmail, &user.EmailChangeVerificationStep, &user.Status, &user.StatusUpdatedAt, &user.FinalInvoiceGenerated, &user.UserAgent, &user.CreatedAt, &user.ProjectLimit, &user.ProjectBandwidthLimit, &user.ProjectStorageLimit, &user.ProjectSegmentLimit, &user.PaidTier, &user.Position, &user.CompanyName, &user.CompanySize, &user.WorkingOn, &user.IsProfessional, &user.EmployeeCount, &user.HaveSalesContact, &user.MfaEnabled, &user.MfaSecretKey, &user.MfaRecoveryCodes, &user.SignupPromoCode, &user.VerificationReminders, &user.TrialNotifications, &user.FailedLoginCount, &user.LoginLockoutExpiration, &user.SignupCaptcha, &user.DefaultPlacement, &user.ActivationCode, &user.SignupId, &user.TrialExpiration, &user.UpgradeTime, &user.SignupReferral)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
@@ -30265,7 +34053,7 @@ func (obj *pgxcockroachImpl) Update_UserSettings_By_UserId(ctx context.Context,
 	defer mon.Task()(&ctx)(&err)
 	var __sets = &__sqlbundle_Hole{}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE user_settings SET "), __sets, __sqlbundle_Literal(" WHERE user_settings.user_id = ? RETURNING user_settings.user_id, user_settings.session_minutes, user_settings.passphrase_prompt, user_settings.onboarding_start, user_settings.onboarding_end, user_settings.onboarding_step, user_settings.notice_dismissal")}}
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE user_settings SET "), __sets, __sqlbundle_Literal(" WHERE user_settings.user_id = ? RETURNING user_settings.user_id, user_settings.session_minutes, user_settings.passphrase_prompt, user_settings.onboarding_start, user_settings.onboarding_end, user_settings.onboarding_step, user_settings.notice_dismissal, user_settings.login_alerts")}}
 
 	__sets_sql := __sqlbundle_Literals{Join: ", "}
 	var __values []interface{}
@@ -30301,6 +34089,11 @@ func (obj *pgxcockroachImpl) Update_UserSettings_By_UserId(ctx context.Context,
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("notice_dismissal = ?"))
 	}
 
+	if update.LoginAlerts._set {
+		__values = append(__values, update.LoginAlerts.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("login_alerts = ?"))
+	}
+
 	if len(__sets_sql.SQLs) == 0 {
 		return nil, emptyUpdate()
 	}
@@ -30314,7 +34107,7 @@ func (obj *pgxcockroachImpl) Update_UserSettings_By_UserId(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	user_settings = &UserSettings{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&user_settings.UserId, &user_settings.SessionMinutes, &user_settings.PassphrasePrompt, &user_settings.OnboardingStart, &user_settings.OnboardingEnd, &user_settings.OnboardingStep, &user_settings.NoticeDismissal)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&user_settings.UserId, &user_settings.SessionMinutes, &user_settings.PassphrasePrompt, &user_settings.OnboardingStart, &user_settings.OnboardingEnd, &user_settings.OnboardingStep, &user_settings.NoticeDismissal, &user_settings.LoginAlerts)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
@@ -30955,6 +34748,16 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM trusted_devices;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -31075,6 +34878,26 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM segment_integrity_queue;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM scim_users;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -31135,6 +34958,46 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM project_passphrase_escrows;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM project_limit_changes;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM project_invitation_policies;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM project_data_purges;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -31155,6 +35018,16 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM pending_object_grace_periods;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -31165,6 +35038,26 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM passphrase_recovery_approvals;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM passphrase_recoveries;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -31235,6 +35128,26 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM maintenance_windows;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM linksharing_brandings;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -31275,6 +35188,26 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM bucket_lifecycle_configurations;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM bucket_inventory_configurations;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -31305,6 +35238,16 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM billing_contacts;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -31315,6 +35258,36 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM api_key_bandwidth_rollups;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM admin_webhook_deliveries;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM admin_audit_events;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
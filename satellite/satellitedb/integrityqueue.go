@@ -0,0 +1,154 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"time"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/integrity"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/shared/dbutil/pgutil"
+	"storj.io/storj/shared/tagsql"
+)
+
+// integrityQueue implements storj.io/storj/satellite/integrity.Queue.
+type integrityQueue struct {
+	db *satelliteDB
+}
+
+var _ integrity.Queue = (*integrityQueue)(nil)
+
+func (queue *integrityQueue) Insert(ctx context.Context, discrepancies []integrity.Discrepancy) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(discrepancies) == 0 {
+		return nil
+	}
+
+	var (
+		streamIDs        = make([]uuid.UUID, len(discrepancies))
+		kinds            = make([]string, len(discrepancies))
+		projectIDs       = make([][]byte, len(discrepancies))
+		bucketNames      = make([][]byte, len(discrepancies))
+		objectKeys       = make([][]byte, len(discrepancies))
+		versions         = make([]int64, len(discrepancies))
+		expectedSegments = make([]int32, len(discrepancies))
+		actualSegments   = make([]int32, len(discrepancies))
+		expectedSizes    = make([]int64, len(discrepancies))
+		actualSizes      = make([]int64, len(discrepancies))
+		detectedAts      = make([]time.Time, len(discrepancies))
+	)
+	for i, discrepancy := range discrepancies {
+		streamIDs[i] = discrepancy.StreamID
+		kinds[i] = string(discrepancy.Kind)
+		if !discrepancy.Object.ProjectID.IsZero() {
+			projectIDs[i] = discrepancy.Object.ProjectID.Bytes()
+			bucketNames[i] = []byte(discrepancy.Object.BucketName)
+			objectKeys[i] = []byte(discrepancy.Object.ObjectKey)
+			versions[i] = int64(discrepancy.Object.Version)
+		}
+		expectedSegments[i] = discrepancy.ExpectedSegments
+		actualSegments[i] = discrepancy.ActualSegments
+		expectedSizes[i] = discrepancy.ExpectedSize
+		actualSizes[i] = discrepancy.ActualSize
+		detectedAts[i] = discrepancy.DetectedAt
+	}
+
+	_, err = queue.db.ExecContext(ctx, `
+		INSERT INTO segment_integrity_queue (
+			stream_id, kind, project_id, bucket_name, object_key, version,
+			expected_segments, actual_segments, expected_size, actual_size, detected_at
+		)
+		SELECT
+			stream_id, kind, project_id, bucket_name, object_key,
+			CASE WHEN project_id IS NULL THEN NULL ELSE version END,
+			expected_segments, actual_segments, expected_size, actual_size, detected_at
+		FROM (
+			SELECT
+				unnest($1::bytea[]) AS stream_id, unnest($2::text[]) AS kind,
+				unnest($3::bytea[]) AS project_id, unnest($4::bytea[]) AS bucket_name,
+				unnest($5::bytea[]) AS object_key, unnest($6::int8[]) AS version,
+				unnest($7::int4[]) AS expected_segments, unnest($8::int4[]) AS actual_segments,
+				unnest($9::int8[]) AS expected_size, unnest($10::int8[]) AS actual_size,
+				unnest($11::timestamptz[]) AS detected_at
+		) AS discrepancies
+		ON CONFLICT (stream_id, kind) DO UPDATE SET
+			project_id        = EXCLUDED.project_id,
+			bucket_name       = EXCLUDED.bucket_name,
+			object_key        = EXCLUDED.object_key,
+			version           = EXCLUDED.version,
+			expected_segments = EXCLUDED.expected_segments,
+			actual_segments   = EXCLUDED.actual_segments,
+			expected_size     = EXCLUDED.expected_size,
+			actual_size       = EXCLUDED.actual_size,
+			detected_at       = EXCLUDED.detected_at
+	`,
+		pgutil.UUIDArray(streamIDs),
+		pgutil.TextArray(kinds),
+		pgutil.NullByteaArray(projectIDs),
+		pgutil.NullByteaArray(bucketNames),
+		pgutil.NullByteaArray(objectKeys),
+		pgutil.Int8Array(versions),
+		pgutil.Int4Array(expectedSegments),
+		pgutil.Int4Array(actualSegments),
+		pgutil.Int8Array(expectedSizes),
+		pgutil.Int8Array(actualSizes),
+		pgutil.TimestampTZArray(detectedAts),
+	)
+	return Error.Wrap(err)
+}
+
+func (queue *integrityQueue) Select(ctx context.Context, kind integrity.Kind, limit int) (_ []integrity.Discrepancy, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var discrepancies []integrity.Discrepancy
+	err = withRows(queue.db.QueryContext(ctx, `
+		SELECT
+			stream_id, kind, project_id, bucket_name, object_key, version,
+			expected_segments, actual_segments, expected_size, actual_size, detected_at
+		FROM segment_integrity_queue
+		WHERE kind = $1
+		ORDER BY detected_at ASC
+		LIMIT $2
+	`, string(kind), limit))(func(rows tagsql.Rows) error {
+		for rows.Next() {
+			var discrepancy integrity.Discrepancy
+			var projectID, bucketName, objectKey []byte
+			var version *int64
+			err := rows.Scan(
+				&discrepancy.StreamID, &discrepancy.Kind, &projectID, &bucketName, &objectKey, &version,
+				&discrepancy.ExpectedSegments, &discrepancy.ActualSegments,
+				&discrepancy.ExpectedSize, &discrepancy.ActualSize, &discrepancy.DetectedAt,
+			)
+			if err != nil {
+				return err
+			}
+			if projectID != nil {
+				discrepancy.Object.ProjectID, err = uuid.FromBytes(projectID)
+				if err != nil {
+					return err
+				}
+				discrepancy.Object.BucketName = string(bucketName)
+				discrepancy.Object.ObjectKey = metabase.ObjectKey(objectKey)
+				discrepancy.Object.Version = metabase.Version(*version)
+				discrepancy.Object.StreamID = discrepancy.StreamID
+			}
+			discrepancies = append(discrepancies, discrepancy)
+		}
+		return nil
+	})
+	return discrepancies, Error.Wrap(err)
+}
+
+func (queue *integrityQueue) Delete(ctx context.Context, streamID uuid.UUID, kind integrity.Kind) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = queue.db.ExecContext(ctx, `
+		DELETE FROM segment_integrity_queue
+		WHERE stream_id = $1 AND kind = $2
+	`, streamID, string(kind))
+	return Error.Wrap(err)
+}
@@ -0,0 +1,74 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/console"
+)
+
+// Ensure that linksharingBrandings implements console.LinksharingBrandings.
+var _ console.LinksharingBrandings = (*linksharingBrandings)(nil)
+
+// linksharingBrandings is an implementation of console.LinksharingBrandings.
+type linksharingBrandings struct {
+	db *satelliteDB
+}
+
+// Get returns the linksharing branding settings of a project.
+func (brandings *linksharingBrandings) Get(ctx context.Context, projectID uuid.UUID) (_ *console.LinksharingBranding, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	branding := &console.LinksharingBranding{ProjectID: projectID}
+	err = brandings.db.QueryRowContext(ctx, `
+		SELECT logo_url, primary_color, footer, download_disclaimer, updated_at
+		FROM linksharing_brandings
+		WHERE project_id = $1
+	`, projectID).Scan(
+		&branding.LogoURL, &branding.PrimaryColor, &branding.Footer, &branding.DownloadDisclaimer, &branding.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return branding, nil
+}
+
+// Upsert updates the linksharing branding settings of a project if they exist and inserts them otherwise.
+func (brandings *linksharingBrandings) Upsert(ctx context.Context, branding console.LinksharingBranding) (_ *console.LinksharingBranding, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	result := &console.LinksharingBranding{ProjectID: branding.ProjectID}
+	err = brandings.db.QueryRowContext(ctx, `
+		INSERT INTO linksharing_brandings (
+			project_id, logo_url, primary_color, footer, download_disclaimer, created_at, updated_at
+		) VALUES (
+			$1, $2, $3, $4, $5, now(), now()
+		) ON CONFLICT (project_id) DO UPDATE SET
+			logo_url            = EXCLUDED.logo_url,
+			primary_color       = EXCLUDED.primary_color,
+			footer              = EXCLUDED.footer,
+			download_disclaimer = EXCLUDED.download_disclaimer,
+			updated_at          = EXCLUDED.updated_at
+		RETURNING logo_url, primary_color, footer, download_disclaimer, updated_at
+	`, branding.ProjectID, branding.LogoURL, branding.PrimaryColor, branding.Footer, branding.DownloadDisclaimer,
+	).Scan(
+		&result.LogoURL, &result.PrimaryColor, &result.Footer, &result.DownloadDisclaimer, &result.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Delete removes the linksharing branding settings of a project.
+func (brandings *linksharingBrandings) Delete(ctx context.Context, projectID uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = brandings.db.ExecContext(ctx, `DELETE FROM linksharing_brandings WHERE project_id = $1`, projectID)
+	return err
+}
@@ -0,0 +1,154 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/maintenance"
+	"storj.io/storj/shared/tagsql"
+)
+
+var _ maintenance.DB = (*maintenanceWindows)(nil)
+
+// maintenanceWindows implements maintenance.DB.
+type maintenanceWindows struct {
+	db *satelliteDB
+}
+
+const maintenanceWindowColumns = `id, starts_at, ends_at, components, message, created_by, created_at, updated_at`
+
+// Insert inserts a new maintenance window.
+func (m *maintenanceWindows) Insert(ctx context.Context, window maintenance.Window) (_ maintenance.Window, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	row := m.db.QueryRowContext(ctx, `
+		INSERT INTO maintenance_windows (
+			id, starts_at, ends_at, components, message, created_by, created_at, updated_at
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, now(), now()
+		) RETURNING `+maintenanceWindowColumns,
+		window.ID, window.StartsAt, window.EndsAt, int(window.Components), window.Message, window.CreatedBy,
+	)
+	return scanMaintenanceWindow(row)
+}
+
+// Get returns the maintenance window with the specified ID.
+func (m *maintenanceWindows) Get(ctx context.Context, id uuid.UUID) (_ maintenance.Window, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	row := m.db.QueryRowContext(ctx, `
+		SELECT `+maintenanceWindowColumns+`
+		FROM maintenance_windows
+		WHERE id = $1
+	`, id)
+	return scanMaintenanceWindow(row)
+}
+
+// Update updates the schedule, components and message of a maintenance window.
+func (m *maintenanceWindows) Update(ctx context.Context, window maintenance.Window) (_ maintenance.Window, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	row := m.db.QueryRowContext(ctx, `
+		UPDATE maintenance_windows
+		SET starts_at = $2, ends_at = $3, components = $4, message = $5, updated_at = now()
+		WHERE id = $1
+		RETURNING `+maintenanceWindowColumns,
+		window.ID, window.StartsAt, window.EndsAt, int(window.Components), window.Message,
+	)
+	return scanMaintenanceWindow(row)
+}
+
+// Delete deletes the maintenance window with the specified ID.
+func (m *maintenanceWindows) Delete(ctx context.Context, id uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	result, err := m.db.ExecContext(ctx, `DELETE FROM maintenance_windows WHERE id = $1`, id)
+	if err != nil {
+		return err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return maintenance.ErrNotFound.New("%s", id)
+	}
+	return nil
+}
+
+// List returns maintenance windows which end after the specified time, ordered by start time.
+func (m *maintenanceWindows) List(ctx context.Context, endsAfter time.Time) (_ []maintenance.Window, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := m.db.QueryContext(ctx, `
+		SELECT `+maintenanceWindowColumns+`
+		FROM maintenance_windows
+		WHERE ends_at > $1
+		ORDER BY starts_at, id
+	`, endsAfter)
+	if err != nil {
+		return nil, err
+	}
+	return scanMaintenanceWindows(rows)
+}
+
+// ListOverlapping returns maintenance windows overlapping the [from, to) interval, affecting any of the components.
+func (m *maintenanceWindows) ListOverlapping(ctx context.Context, from, to time.Time, components maintenance.Components) (_ []maintenance.Window, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := m.db.QueryContext(ctx, `
+		SELECT `+maintenanceWindowColumns+`
+		FROM maintenance_windows
+		WHERE ends_at > $1
+			AND starts_at < $2
+			AND (components & $3) <> 0
+		ORDER BY starts_at, id
+	`, from, to, int(components))
+	if err != nil {
+		return nil, err
+	}
+	return scanMaintenanceWindows(rows)
+}
+
+func scanMaintenanceWindow(row *sql.Row) (window maintenance.Window, err error) {
+	var components int
+	err = row.Scan(
+		&window.ID, &window.StartsAt, &window.EndsAt, &components,
+		&window.Message, &window.CreatedBy, &window.CreatedAt, &window.UpdatedAt,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return maintenance.Window{}, maintenance.ErrNotFound.New("")
+	}
+	if err != nil {
+		return maintenance.Window{}, err
+	}
+	window.Components = maintenance.Components(components)
+	return window, nil
+}
+
+func scanMaintenanceWindows(rows tagsql.Rows) (windows []maintenance.Window, err error) {
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	for rows.Next() {
+		var window maintenance.Window
+		var components int
+		err = rows.Scan(
+			&window.ID, &window.StartsAt, &window.EndsAt, &components,
+			&window.Message, &window.CreatedBy, &window.CreatedAt, &window.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		window.Components = maintenance.Components(components)
+		windows = append(windows, window)
+	}
+	return windows, rows.Err()
+}
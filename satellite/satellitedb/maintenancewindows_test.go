@@ -0,0 +1,90 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/maintenance"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestMaintenanceWindows(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		windowsDB := db.MaintenanceWindows()
+		now := time.Now().Truncate(time.Second)
+
+		inserted, err := windowsDB.Insert(ctx, maintenance.Window{
+			ID:         testrand.UUID(),
+			StartsAt:   now.Add(-time.Hour),
+			EndsAt:     now.Add(time.Hour),
+			Components: maintenance.ComponentConsole | maintenance.ComponentUplink,
+			Message:    "database upgrade",
+			CreatedBy:  "admin@storj.test",
+		})
+		require.NoError(t, err)
+		require.Equal(t, "database upgrade", inserted.Message)
+		require.False(t, inserted.CreatedAt.IsZero())
+
+		upcoming, err := windowsDB.Insert(ctx, maintenance.Window{
+			ID:         testrand.UUID(),
+			StartsAt:   now.Add(24 * time.Hour),
+			EndsAt:     now.Add(25 * time.Hour),
+			Components: maintenance.ComponentStoragenode,
+			Message:    "network maintenance",
+		})
+		require.NoError(t, err)
+
+		_, err = windowsDB.Insert(ctx, maintenance.Window{
+			ID:         testrand.UUID(),
+			StartsAt:   now.Add(-3 * time.Hour),
+			EndsAt:     now.Add(-2 * time.Hour),
+			Components: maintenance.ComponentAll,
+			Message:    "finished",
+		})
+		require.NoError(t, err)
+
+		got, err := windowsDB.Get(ctx, inserted.ID)
+		require.NoError(t, err)
+		require.Equal(t, inserted.ID, got.ID)
+		require.Equal(t, inserted.Components, got.Components)
+		require.WithinDuration(t, inserted.StartsAt, got.StartsAt, time.Second)
+
+		list, err := windowsDB.List(ctx, now)
+		require.NoError(t, err)
+		require.Len(t, list, 2)
+		require.Equal(t, inserted.ID, list[0].ID)
+		require.Equal(t, upcoming.ID, list[1].ID)
+
+		active, err := windowsDB.ListOverlapping(ctx, now, now.Add(time.Minute), maintenance.ComponentConsole)
+		require.NoError(t, err)
+		require.Len(t, active, 1)
+		require.Equal(t, inserted.ID, active[0].ID)
+
+		active, err = windowsDB.ListOverlapping(ctx, now, now.Add(time.Minute), maintenance.ComponentStoragenode)
+		require.NoError(t, err)
+		require.Empty(t, active)
+
+		got.Message = "extended database upgrade"
+		got.EndsAt = now.Add(2 * time.Hour)
+		updated, err := windowsDB.Update(ctx, got)
+		require.NoError(t, err)
+		require.Equal(t, "extended database upgrade", updated.Message)
+		require.WithinDuration(t, now.Add(2*time.Hour), updated.EndsAt, time.Second)
+
+		require.NoError(t, windowsDB.Delete(ctx, inserted.ID))
+
+		_, err = windowsDB.Get(ctx, inserted.ID)
+		require.True(t, maintenance.ErrNotFound.Has(err))
+
+		err = windowsDB.Delete(ctx, inserted.ID)
+		require.True(t, maintenance.ErrNotFound.Has(err))
+	})
+}
@@ -2815,6 +2815,325 @@ func (db *satelliteDB) ProductionMigration() *migrate.Migration {
 					`ALTER TABLE users ADD COLUMN email_change_verification_step INTEGER NOT NULL DEFAULT 0;`,
 				},
 			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add maintenance_windows table",
+				Version:     280,
+				Action: migrate.SQL{
+					`CREATE TABLE maintenance_windows (
+						id bytea NOT NULL,
+						starts_at timestamp with time zone NOT NULL,
+						ends_at timestamp with time zone NOT NULL,
+						components integer NOT NULL,
+						message text NOT NULL,
+						created_by text NOT NULL,
+						created_at timestamp with time zone NOT NULL,
+						updated_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( id )
+					);`,
+					`CREATE INDEX maintenance_windows_ends_at_index ON maintenance_windows ( ends_at );`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add linksharing_brandings table",
+				Version:     281,
+				Action: migrate.SQL{
+					`CREATE TABLE linksharing_brandings (
+						project_id bytea NOT NULL,
+						logo_url text NOT NULL,
+						primary_color text NOT NULL,
+						footer text NOT NULL,
+						download_disclaimer text NOT NULL,
+						created_at timestamp with time zone NOT NULL,
+						updated_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( project_id )
+					);`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add project_invitation_policies table",
+				Version:     282,
+				Action: migrate.SQL{
+					`CREATE TABLE project_invitation_policies (
+						project_id bytea NOT NULL,
+						allowed_email_domains text NOT NULL,
+						max_pending_invitations integer NOT NULL,
+						default_role integer NOT NULL,
+						created_at timestamp with time zone NOT NULL,
+						updated_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( project_id )
+					);`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add bucket_lifecycle_configurations table",
+				Version:     283,
+				Action: migrate.SQL{
+					`CREATE TABLE bucket_lifecycle_configurations (
+						project_id bytea NOT NULL,
+						bucket_name bytea NOT NULL,
+						rules bytea NOT NULL,
+						created_at timestamp with time zone NOT NULL,
+						updated_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( project_id, bucket_name )
+					);`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add trusted_devices table and login_alerts column to user_settings",
+				Version:     284,
+				Action: migrate.SQL{
+					`CREATE TABLE trusted_devices (
+						id bytea NOT NULL,
+						user_id bytea NOT NULL,
+						fingerprint bytea NOT NULL,
+						user_agent text NOT NULL,
+						ip_address text NOT NULL,
+						created_at timestamp with time zone NOT NULL,
+						last_used_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( id ),
+						UNIQUE ( user_id, fingerprint )
+					);`,
+					`ALTER TABLE user_settings ADD COLUMN login_alerts boolean NOT NULL DEFAULT false;`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add bucket_inventory_configurations table",
+				Version:     285,
+				Action: migrate.SQL{
+					`CREATE TABLE bucket_inventory_configurations (
+						project_id bytea NOT NULL,
+						bucket_name bytea NOT NULL,
+						configuration bytea NOT NULL,
+						last_run_at timestamp with time zone,
+						created_at timestamp with time zone NOT NULL,
+						updated_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( project_id, bucket_name )
+					);`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add segment_integrity_queue table",
+				Version:     286,
+				Action: migrate.SQL{
+					`CREATE TABLE segment_integrity_queue (
+						stream_id bytea NOT NULL,
+						kind text NOT NULL,
+						project_id bytea,
+						bucket_name bytea,
+						object_key bytea,
+						version bigint,
+						expected_segments integer NOT NULL,
+						actual_segments integer NOT NULL,
+						expected_size bigint NOT NULL,
+						actual_size bigint NOT NULL,
+						detected_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( stream_id, kind )
+					)`,
+					`CREATE INDEX segment_integrity_queue_kind_detected_at_index ON segment_integrity_queue ( kind, detected_at )`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add pending_object_grace_periods table",
+				Version:     287,
+				Action: migrate.SQL{
+					`CREATE TABLE pending_object_grace_periods (
+						project_id bytea NOT NULL,
+						bucket_name bytea NOT NULL,
+						grace_period bigint NOT NULL,
+						updated_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( project_id, bucket_name )
+					)`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add api_key_bandwidth_rollups table",
+				Version:     288,
+				Action: migrate.SQL{
+					`CREATE TABLE api_key_bandwidth_rollups (
+						project_id bytea NOT NULL,
+						api_key_id bytea NOT NULL,
+						interval_start timestamp with time zone NOT NULL,
+						inline bigint NOT NULL,
+						settled bigint NOT NULL,
+						PRIMARY KEY ( project_id, api_key_id, interval_start )
+					)`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add project_limit_changes table",
+				Version:     289,
+				Action: migrate.SQL{
+					`CREATE TABLE project_limit_changes (
+						id bytea NOT NULL,
+						project_id bytea NOT NULL,
+						effective_at timestamp with time zone NOT NULL,
+						usage_limit bigint,
+						bandwidth_limit bigint,
+						segment_limit bigint,
+						rate_limit integer,
+						burst_limit integer,
+						max_buckets integer,
+						created_by text NOT NULL,
+						created_at timestamp with time zone NOT NULL,
+						updated_at timestamp with time zone NOT NULL,
+						applied_at timestamp with time zone,
+						PRIMARY KEY ( id )
+					)`,
+					`CREATE INDEX project_limit_changes_project_id_index ON project_limit_changes ( project_id )`,
+					`CREATE INDEX project_limit_changes_pending_index ON project_limit_changes ( effective_at ) WHERE project_limit_changes.applied_at is NULL`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add project_data_purges table",
+				Version:     290,
+				Action: migrate.SQL{
+					`CREATE TABLE project_data_purges (
+						project_id bytea NOT NULL,
+						status text NOT NULL,
+						bucket_name bytea,
+						continuation_token bytea,
+						attempts integer NOT NULL,
+						deleted_objects bigint NOT NULL,
+						deleted_buckets integer NOT NULL,
+						error text,
+						created_by text NOT NULL,
+						created_at timestamp with time zone NOT NULL,
+						updated_at timestamp with time zone NOT NULL,
+						finished_at timestamp with time zone,
+						PRIMARY KEY ( project_id )
+					)`,
+					`CREATE INDEX project_data_purges_running_index ON project_data_purges ( updated_at ) WHERE project_data_purges.finished_at is NULL`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add signup_referral column to users table",
+				Version:     291,
+				Action: migrate.SQL{
+					`ALTER TABLE users ADD COLUMN signup_referral text;`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add project passphrase escrow and recovery tables",
+				Version:     292,
+				Action: migrate.SQL{
+					`CREATE TABLE project_passphrase_escrows (
+						project_id bytea NOT NULL,
+						envelope bytea NOT NULL,
+						created_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( project_id )
+					)`,
+					`CREATE TABLE passphrase_recoveries (
+						id bytea NOT NULL,
+						project_id bytea NOT NULL,
+						requested_by text NOT NULL,
+						reason text NOT NULL,
+						created_at timestamp with time zone NOT NULL,
+						recovered_at timestamp with time zone,
+						PRIMARY KEY ( id )
+					)`,
+					`CREATE INDEX passphrase_recoveries_project_id_index ON passphrase_recoveries ( project_id )`,
+					`CREATE TABLE passphrase_recovery_approvals (
+						recovery_id bytea NOT NULL,
+						approved_by text NOT NULL,
+						created_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( recovery_id, approved_by )
+					)`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add billing_contacts table",
+				Version:     293,
+				Action: migrate.SQL{
+					`CREATE TABLE billing_contacts (
+						id bytea NOT NULL,
+						user_id bytea NOT NULL,
+						email text NOT NULL,
+						created_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( id ),
+						UNIQUE ( user_id, email )
+					)`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add admin_audit_events table",
+				Version:     294,
+				Action: migrate.SQL{
+					`CREATE TABLE admin_audit_events (
+						id bytea NOT NULL,
+						admin_email text NOT NULL,
+						user_id bytea,
+						action text NOT NULL,
+						path text NOT NULL,
+						created_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( id )
+					)`,
+					`CREATE INDEX admin_audit_events_admin_email_created_at_index ON admin_audit_events ( admin_email, created_at )`,
+					`CREATE INDEX admin_audit_events_user_id_created_at_index ON admin_audit_events ( user_id, created_at )`,
+					`CREATE INDEX admin_audit_events_action_created_at_index ON admin_audit_events ( action, created_at )`,
+					`CREATE INDEX admin_audit_events_created_at_index ON admin_audit_events ( created_at )`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add admin_webhook_deliveries table",
+				Version:     295,
+				Action: migrate.SQL{
+					`CREATE TABLE admin_webhook_deliveries (
+						id bytea NOT NULL,
+						event_id bytea NOT NULL,
+						url text NOT NULL,
+						kind text NOT NULL,
+						payload bytea NOT NULL,
+						status text NOT NULL,
+						attempts integer NOT NULL,
+						last_error text,
+						next_attempt_at timestamp with time zone NOT NULL,
+						created_at timestamp with time zone NOT NULL,
+						finished_at timestamp with time zone,
+						PRIMARY KEY ( id )
+					)`,
+					`CREATE INDEX admin_webhook_deliveries_pending_index ON admin_webhook_deliveries ( next_attempt_at ) WHERE admin_webhook_deliveries.finished_at is NULL`,
+					`CREATE INDEX admin_webhook_deliveries_status_created_at_index ON admin_webhook_deliveries ( status, created_at )`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add reason column to admin_audit_events table",
+				Version:     296,
+				Action: migrate.SQL{
+					`ALTER TABLE admin_audit_events ADD COLUMN reason text;`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add scim_users table",
+				Version:     297,
+				Action: migrate.SQL{
+					`CREATE TABLE scim_users (
+						user_id bytea NOT NULL,
+						provider text NOT NULL,
+						external_id text,
+						created_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( user_id ),
+						UNIQUE ( provider, external_id )
+					)`,
+				},
+			},
 			// NB: after updating testdata in `testdata`, run
 			//     `go generate` to update `migratez.go`.
 		},
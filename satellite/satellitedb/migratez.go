@@ -13,7 +13,7 @@ func (db *satelliteDB) testMigration() *migrate.Migration {
 			{
 				DB:          &db.migrationDB,
 				Description: "Testing setup",
-				Version:     279,
+				Version:     297,
 				Action: migrate.SQL{`-- AUTOGENERATED BY storj.io/dbx
 -- DO NOT EDIT
 CREATE TABLE account_freeze_events (
@@ -42,12 +42,52 @@ CREATE TABLE accounting_timestamps (
 	value timestamp with time zone NOT NULL,
 	PRIMARY KEY ( name )
 );
+CREATE TABLE admin_audit_events (
+	id bytea NOT NULL,
+	admin_email text NOT NULL,
+	user_id bytea,
+	action text NOT NULL,
+	path text NOT NULL,
+	reason text,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE admin_webhook_deliveries (
+	id bytea NOT NULL,
+	event_id bytea NOT NULL,
+	url text NOT NULL,
+	kind text NOT NULL,
+	payload bytea NOT NULL,
+	status text NOT NULL,
+	attempts integer NOT NULL,
+	last_error text,
+	next_attempt_at timestamp with time zone NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	finished_at timestamp with time zone,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE api_key_bandwidth_rollups (
+	project_id bytea NOT NULL,
+	api_key_id bytea NOT NULL,
+	interval_start timestamp with time zone NOT NULL,
+	inline bigint NOT NULL,
+	settled bigint NOT NULL,
+	PRIMARY KEY ( project_id, api_key_id, interval_start )
+);
 CREATE TABLE billing_balances (
 	user_id bytea NOT NULL,
 	balance bigint NOT NULL,
 	last_updated timestamp with time zone NOT NULL,
 	PRIMARY KEY ( user_id )
 );
+CREATE TABLE billing_contacts (
+	id bytea NOT NULL,
+	user_id bytea NOT NULL,
+	email text NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id ),
+	UNIQUE ( user_id, email )
+);
 CREATE TABLE billing_transactions (
 	id bigserial NOT NULL,
 	user_id bytea NOT NULL,
@@ -84,6 +124,23 @@ CREATE TABLE bucket_bandwidth_rollup_archives (
 	settled bigint NOT NULL,
 	PRIMARY KEY ( bucket_name, project_id, interval_start, action )
 );
+CREATE TABLE bucket_inventory_configurations (
+	project_id bytea NOT NULL,
+	bucket_name bytea NOT NULL,
+	configuration bytea NOT NULL,
+	last_run_at timestamp with time zone,
+	created_at timestamp with time zone NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id, bucket_name )
+);
+CREATE TABLE bucket_lifecycle_configurations (
+	project_id bytea NOT NULL,
+	bucket_name bytea NOT NULL,
+	rules bytea NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id, bucket_name )
+);
 CREATE TABLE bucket_storage_tallies (
 	bucket_name bytea NOT NULL,
 	project_id bytea NOT NULL,
@@ -134,6 +191,27 @@ CREATE TABLE graceful_exit_segment_transfer_queue (
 	order_limit_send_count integer NOT NULL DEFAULT 0,
 	PRIMARY KEY ( node_id, stream_id, position, piece_num )
 );
+CREATE TABLE linksharing_brandings (
+	project_id bytea NOT NULL,
+	logo_url text NOT NULL,
+	primary_color text NOT NULL,
+	footer text NOT NULL,
+	download_disclaimer text NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id )
+);
+CREATE TABLE maintenance_windows (
+	id bytea NOT NULL,
+	starts_at timestamp with time zone NOT NULL,
+	ends_at timestamp with time zone NOT NULL,
+	components integer NOT NULL,
+	message text NOT NULL,
+	created_by text NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id )
+);
 CREATE TABLE nodes (
 	id bytea NOT NULL,
 	address text NOT NULL DEFAULT '',
@@ -234,6 +312,21 @@ CREATE TABLE oauth_tokens (
 	expires_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( token )
 );
+CREATE TABLE passphrase_recoveries (
+	id bytea NOT NULL,
+	project_id bytea NOT NULL,
+	requested_by text NOT NULL,
+	reason text NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	recovered_at timestamp with time zone,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE passphrase_recovery_approvals (
+	recovery_id bytea NOT NULL,
+	approved_by text NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( recovery_id, approved_by )
+);
 CREATE TABLE peer_identities (
 	node_id bytea NOT NULL,
 	leaf_serial_number bytea NOT NULL,
@@ -241,6 +334,13 @@ CREATE TABLE peer_identities (
 	updated_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( node_id )
 );
+CREATE TABLE pending_object_grace_periods (
+	project_id bytea NOT NULL,
+	bucket_name bytea NOT NULL,
+	grace_period bigint NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id, bucket_name )
+);
 CREATE TABLE projects (
 	id bytea NOT NULL,
 	public_id bytea,
@@ -284,6 +384,52 @@ CREATE TABLE project_bandwidth_daily_rollups (
 	egress_dead bigint NOT NULL DEFAULT 0,
 	PRIMARY KEY ( project_id, interval_day )
 );
+CREATE TABLE project_data_purges (
+	project_id bytea NOT NULL,
+	status text NOT NULL,
+	bucket_name bytea,
+	continuation_token bytea,
+	attempts integer NOT NULL,
+	deleted_objects bigint NOT NULL,
+	deleted_buckets integer NOT NULL,
+	error text,
+	created_by text NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	finished_at timestamp with time zone,
+	PRIMARY KEY ( project_id )
+);
+CREATE TABLE project_invitation_policies (
+	project_id bytea NOT NULL,
+	allowed_email_domains text NOT NULL,
+	max_pending_invitations integer NOT NULL,
+	default_role integer NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id )
+);
+CREATE TABLE project_limit_changes (
+	id bytea NOT NULL,
+	project_id bytea NOT NULL,
+	effective_at timestamp with time zone NOT NULL,
+	usage_limit bigint,
+	bandwidth_limit bigint,
+	segment_limit bigint,
+	rate_limit integer,
+	burst_limit integer,
+	max_buckets integer,
+	created_by text NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	applied_at timestamp with time zone,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE project_passphrase_escrows (
+	project_id bytea NOT NULL,
+	envelope bytea NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id )
+);
 CREATE TABLE registration_tokens (
 	secret bytea NOT NULL,
 	owner_id bytea,
@@ -344,6 +490,28 @@ CREATE TABLE revocations (
 	api_key_id bytea NOT NULL,
 	PRIMARY KEY ( revoked )
 );
+CREATE TABLE scim_users (
+	user_id bytea NOT NULL,
+	provider text NOT NULL,
+	external_id text,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( user_id ),
+	UNIQUE ( provider, external_id )
+);
+CREATE TABLE segment_integrity_queue (
+	stream_id bytea NOT NULL,
+	kind text NOT NULL,
+	project_id bytea,
+	bucket_name bytea,
+	object_key bytea,
+	version bigint,
+	expected_segments integer NOT NULL,
+	actual_segments integer NOT NULL,
+	expected_size bigint NOT NULL,
+	actual_size bigint NOT NULL,
+	detected_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( stream_id, kind )
+);
 CREATE TABLE segment_pending_audits (
 	node_id bytea NOT NULL,
 	stream_id bytea NOT NULL,
@@ -474,6 +642,17 @@ CREATE TABLE stripecoinpayments_tx_conversion_rates (
 	created_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( tx_id )
 );
+CREATE TABLE trusted_devices (
+	id bytea NOT NULL,
+	user_id bytea NOT NULL,
+	fingerprint bytea NOT NULL,
+	user_agent text NOT NULL,
+	ip_address text NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	last_used_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id ),
+	UNIQUE ( user_id, fingerprint )
+);
 CREATE TABLE users (
 	id bytea NOT NULL,
 	email text NOT NULL,
@@ -514,6 +693,7 @@ CREATE TABLE users (
 	signup_id text,
 	trial_expiration timestamp with time zone,
 	upgrade_time timestamp with time zone,
+	signup_referral text,
 	PRIMARY KEY ( id )
 );
 CREATE TABLE user_settings (
@@ -524,6 +704,7 @@ CREATE TABLE user_settings (
 	onboarding_end boolean NOT NULL DEFAULT true,
 	onboarding_step text,
 	notice_dismissal jsonb NOT NULL DEFAULT '{}',
+	login_alerts boolean NOT NULL DEFAULT false,
 	PRIMARY KEY ( user_id )
 );
 CREATE TABLE value_attributions (
@@ -607,6 +788,12 @@ CREATE TABLE stripecoinpayments_apply_balance_intents (
 	PRIMARY KEY ( tx_id )
 );
 CREATE INDEX accounting_rollups_start_time_index ON accounting_rollups ( start_time ) ;
+CREATE INDEX admin_audit_events_admin_email_created_at_index ON admin_audit_events ( admin_email, created_at ) ;
+CREATE INDEX admin_audit_events_user_id_created_at_index ON admin_audit_events ( user_id, created_at ) ;
+CREATE INDEX admin_audit_events_action_created_at_index ON admin_audit_events ( action, created_at ) ;
+CREATE INDEX admin_audit_events_created_at_index ON admin_audit_events ( created_at ) ;
+CREATE INDEX admin_webhook_deliveries_pending_index ON admin_webhook_deliveries ( next_attempt_at ) WHERE admin_webhook_deliveries.finished_at is NULL ;
+CREATE INDEX admin_webhook_deliveries_status_created_at_index ON admin_webhook_deliveries ( status, created_at ) ;
 CREATE INDEX billing_transactions_timestamp_index ON billing_transactions ( timestamp ) ;
 CREATE INDEX bucket_bandwidth_rollups_project_id_action_interval_index ON bucket_bandwidth_rollups ( project_id, action, interval_start ) ;
 CREATE INDEX bucket_bandwidth_rollups_action_interval_project_id_index ON bucket_bandwidth_rollups ( action, interval_start, project_id ) ;
@@ -615,6 +802,7 @@ CREATE INDEX bucket_bandwidth_rollups_archive_action_interval_project_id_index O
 CREATE INDEX bucket_storage_tallies_project_id_interval_start_index ON bucket_storage_tallies ( project_id, interval_start ) ;
 CREATE INDEX bucket_storage_tallies_interval_start_index ON bucket_storage_tallies ( interval_start ) ;
 CREATE INDEX graceful_exit_segment_transfer_nid_dr_qa_fa_lfa_index ON graceful_exit_segment_transfer_queue ( node_id, durability_ratio, queued_at, finished_at, last_failed_at ) ;
+CREATE INDEX maintenance_windows_ends_at_index ON maintenance_windows ( ends_at ) ;
 CREATE INDEX node_last_ip ON nodes ( last_net ) ;
 CREATE INDEX nodes_dis_unk_off_exit_fin_last_success_index ON nodes ( disqualified, unknown_audit_suspended, offline_suspended, exit_finished_at, last_contact_success ) ;
 CREATE INDEX nodes_last_cont_success_free_disk_ma_mi_patch_vetted_partial_index ON nodes ( last_contact_success, free_disk, major, minor, patch, vetted_at ) WHERE nodes.disqualified is NULL AND nodes.unknown_audit_suspended is NULL AND nodes.exit_initiated_at is NULL AND nodes.release = true AND nodes.last_net != '' ;
@@ -625,13 +813,18 @@ CREATE INDEX oauth_codes_user_id_index ON oauth_codes ( user_id ) ;
 CREATE INDEX oauth_codes_client_id_index ON oauth_codes ( client_id ) ;
 CREATE INDEX oauth_tokens_user_id_index ON oauth_tokens ( user_id ) ;
 CREATE INDEX oauth_tokens_client_id_index ON oauth_tokens ( client_id ) ;
+CREATE INDEX passphrase_recoveries_project_id_index ON passphrase_recoveries ( project_id ) ;
 CREATE INDEX projects_public_id_index ON projects ( public_id ) ;
 CREATE INDEX projects_owner_id_index ON projects ( owner_id ) ;
 CREATE INDEX project_bandwidth_daily_rollup_interval_day_index ON project_bandwidth_daily_rollups ( interval_day ) ;
+CREATE INDEX project_data_purges_running_index ON project_data_purges ( updated_at ) WHERE project_data_purges.finished_at is NULL ;
+CREATE INDEX project_limit_changes_project_id_index ON project_limit_changes ( project_id ) ;
+CREATE INDEX project_limit_changes_pending_index ON project_limit_changes ( effective_at ) WHERE project_limit_changes.applied_at is NULL ;
 CREATE INDEX repair_queue_updated_at_index ON repair_queue ( updated_at ) ;
 CREATE INDEX repair_queue_num_healthy_pieces_attempted_at_index ON repair_queue ( segment_health, attempted_at ) ;
 CREATE INDEX repair_queue_placement_index ON repair_queue ( placement ) ;
 CREATE INDEX reverification_audits_inserted_at_index ON reverification_audits ( inserted_at ) ;
+CREATE INDEX segment_integrity_queue_kind_detected_at_index ON segment_integrity_queue ( kind, detected_at ) ;
 CREATE INDEX storagenode_bandwidth_rollups_interval_start_index ON storagenode_bandwidth_rollups ( interval_start ) ;
 CREATE INDEX storagenode_bandwidth_rollup_archives_interval_start_index ON storagenode_bandwidth_rollup_archives ( interval_start ) ;
 CREATE INDEX storagenode_payments_node_id_period_index ON storagenode_payments ( node_id, period ) ;
@@ -162,6 +162,32 @@ func (db *ordersDB) UpdateBucketBandwidthInline(ctx context.Context, projectID u
 	return nil
 }
 
+// UpdateAPIKeyBandwidthSettle updates 'settled' egress for given API key.
+func (db *ordersDB) UpdateAPIKeyBandwidthSettle(ctx context.Context, projectID, apiKeyID uuid.UUID, amount int64, intervalStart time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = db.db.ExecContext(ctx, db.db.Rebind(
+		`INSERT INTO api_key_bandwidth_rollups (project_id, api_key_id, interval_start, inline, settled)
+		VALUES (?, ?, ?, ?, ?)
+		ON CONFLICT(project_id, api_key_id, interval_start)
+		DO UPDATE SET settled = api_key_bandwidth_rollups.settled + EXCLUDED.settled`,
+	), projectID[:], apiKeyID[:], intervalStart.UTC(), 0, uint64(amount))
+	return Error.Wrap(err)
+}
+
+// UpdateAPIKeyBandwidthInline updates 'inline' egress for given API key.
+func (db *ordersDB) UpdateAPIKeyBandwidthInline(ctx context.Context, projectID, apiKeyID uuid.UUID, amount int64, intervalStart time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = db.db.ExecContext(ctx, db.db.Rebind(
+		`INSERT INTO api_key_bandwidth_rollups (project_id, api_key_id, interval_start, inline, settled)
+		VALUES (?, ?, ?, ?, ?)
+		ON CONFLICT(project_id, api_key_id, interval_start)
+		DO UPDATE SET inline = api_key_bandwidth_rollups.inline + EXCLUDED.inline`,
+	), projectID[:], apiKeyID[:], intervalStart.UTC(), uint64(amount), 0)
+	return Error.Wrap(err)
+}
+
 // UpdateStoragenodeBandwidthSettle updates 'settled' bandwidth for given storage node for the given intervalStart time.
 func (db *ordersDB) UpdateStoragenodeBandwidthSettle(ctx context.Context, storageNode storj.NodeID, action pb.PieceAction, amount int64, intervalStart time.Time) (err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -0,0 +1,219 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/escrow"
+	"storj.io/storj/shared/tagsql"
+)
+
+var _ escrow.DB = (*passphraseEscrows)(nil)
+
+// passphraseEscrows implements escrow.DB.
+type passphraseEscrows struct {
+	db *satelliteDB
+}
+
+// InsertEnvelope stores the recovery envelope of a project, unless it has one already.
+func (p *passphraseEscrows) InsertEnvelope(ctx context.Context, projectID uuid.UUID, envelope []byte) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = p.db.ExecContext(ctx, `
+		INSERT INTO project_passphrase_escrows (project_id, envelope, created_at)
+		VALUES ($1, $2, now())
+		ON CONFLICT (project_id) DO NOTHING
+	`, projectID, envelope)
+	return err
+}
+
+// GetEnvelope returns the recovery envelope of a project.
+func (p *passphraseEscrows) GetEnvelope(ctx context.Context, projectID uuid.UUID) (envelope []byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = p.db.QueryRowContext(ctx, `
+		SELECT envelope FROM project_passphrase_escrows WHERE project_id = $1
+	`, projectID).Scan(&envelope)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, escrow.ErrNotFound.New("envelope of project %s", projectID)
+	}
+	return envelope, err
+}
+
+// ListUnescrowed returns at most limit projects with a satellite managed passphrase and no recovery envelope.
+func (p *passphraseEscrows) ListUnescrowed(ctx context.Context, limit int) (_ []escrow.Project, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := p.db.QueryContext(ctx, `
+		SELECT projects.id, projects.passphrase_enc
+		FROM projects
+		WHERE projects.passphrase_enc IS NOT NULL
+			AND NOT EXISTS (
+				SELECT 1 FROM project_passphrase_escrows
+				WHERE project_passphrase_escrows.project_id = projects.id
+			)
+		ORDER BY projects.id
+		LIMIT $1
+	`, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var projects []escrow.Project
+	for rows.Next() {
+		var project escrow.Project
+		if err := rows.Scan(&project.ID, &project.PassphraseEnc); err != nil {
+			return nil, err
+		}
+		projects = append(projects, project)
+	}
+	return projects, rows.Err()
+}
+
+// InsertRecovery inserts a new recovery.
+func (p *passphraseEscrows) InsertRecovery(ctx context.Context, recovery escrow.Recovery) (_ escrow.Recovery, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = p.db.QueryRowContext(ctx, `
+		INSERT INTO passphrase_recoveries (id, project_id, requested_by, reason, created_at)
+		VALUES ($1, $2, $3, $4, now())
+		RETURNING created_at
+	`, recovery.ID, recovery.ProjectID, recovery.RequestedBy, recovery.Reason).Scan(&recovery.CreatedAt)
+	if err != nil {
+		return escrow.Recovery{}, err
+	}
+	return recovery, nil
+}
+
+// GetRecovery returns the recovery with the specified ID, including its approvals.
+func (p *passphraseEscrows) GetRecovery(ctx context.Context, id uuid.UUID) (_ escrow.Recovery, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := p.db.QueryContext(ctx, passphraseRecoverySelect+`
+		WHERE passphrase_recoveries.id = $1
+		ORDER BY passphrase_recovery_approvals.created_at, passphrase_recovery_approvals.approved_by
+	`, id)
+	if err != nil {
+		return escrow.Recovery{}, err
+	}
+
+	recoveries, err := scanPassphraseRecoveries(rows)
+	if err != nil {
+		return escrow.Recovery{}, err
+	}
+	if len(recoveries) == 0 {
+		return escrow.Recovery{}, escrow.ErrNotFound.New("recovery %s", id)
+	}
+	return recoveries[0], nil
+}
+
+// ListRecoveries returns the recoveries of a project, including their approvals, ordered by creation time.
+func (p *passphraseEscrows) ListRecoveries(ctx context.Context, projectID uuid.UUID) (_ []escrow.Recovery, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := p.db.QueryContext(ctx, passphraseRecoverySelect+`
+		WHERE passphrase_recoveries.project_id = $1
+		ORDER BY passphrase_recoveries.created_at, passphrase_recoveries.id,
+			passphrase_recovery_approvals.created_at, passphrase_recovery_approvals.approved_by
+	`, projectID)
+	if err != nil {
+		return nil, err
+	}
+	return scanPassphraseRecoveries(rows)
+}
+
+// InsertApproval records the approval of an unused recovery by an operator.
+func (p *passphraseEscrows) InsertApproval(ctx context.Context, id uuid.UUID, approvedBy string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	result, err := p.db.ExecContext(ctx, `
+		INSERT INTO passphrase_recovery_approvals (recovery_id, approved_by, created_at)
+		SELECT id, $2, now() FROM passphrase_recoveries
+		WHERE id = $1 AND recovered_at IS NULL
+		ON CONFLICT (recovery_id, approved_by) DO NOTHING
+	`, id, approvedBy)
+	if err != nil {
+		return err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return escrow.ErrConflict.New("recovery %s can't be approved by %s", id, approvedBy)
+	}
+	return nil
+}
+
+// MarkRecovered marks an unused recovery as used.
+func (p *passphraseEscrows) MarkRecovered(ctx context.Context, id uuid.UUID, recoveredAt time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	result, err := p.db.ExecContext(ctx, `
+		UPDATE passphrase_recoveries SET recovered_at = $2
+		WHERE id = $1 AND recovered_at IS NULL
+	`, id, recoveredAt)
+	if err != nil {
+		return err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return escrow.ErrConflict.New("recovery %s was already used", id)
+	}
+	return nil
+}
+
+const passphraseRecoverySelect = `
+	SELECT passphrase_recoveries.id, passphrase_recoveries.project_id,
+		passphrase_recoveries.requested_by, passphrase_recoveries.reason,
+		passphrase_recoveries.created_at, passphrase_recoveries.recovered_at,
+		passphrase_recovery_approvals.approved_by, passphrase_recovery_approvals.created_at
+	FROM passphrase_recoveries
+	LEFT JOIN passphrase_recovery_approvals
+		ON passphrase_recovery_approvals.recovery_id = passphrase_recoveries.id
+`
+
+// scanPassphraseRecoveries scans the rows of passphraseRecoverySelect, which
+// contain a recovery once per approval, ordered by recovery.
+func scanPassphraseRecoveries(rows tagsql.Rows) (recoveries []escrow.Recovery, err error) {
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	for rows.Next() {
+		var recovery escrow.Recovery
+		var approvedBy *string
+		var approvedAt *time.Time
+		err := rows.Scan(
+			&recovery.ID, &recovery.ProjectID,
+			&recovery.RequestedBy, &recovery.Reason,
+			&recovery.CreatedAt, &recovery.RecoveredAt,
+			&approvedBy, &approvedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(recoveries) == 0 || recoveries[len(recoveries)-1].ID != recovery.ID {
+			recoveries = append(recoveries, recovery)
+		}
+		if approvedBy != nil && approvedAt != nil {
+			last := &recoveries[len(recoveries)-1]
+			last.Approvals = append(last.Approvals, escrow.Approval{
+				ApprovedBy: *approvedBy,
+				CreatedAt:  *approvedAt,
+			})
+		}
+	}
+	return recoveries, rows.Err()
+}
@@ -0,0 +1,106 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/metabase/zombiedeletion"
+	"storj.io/storj/shared/tagsql"
+)
+
+var _ zombiedeletion.GracePeriods = (*pendingObjectGracePeriods)(nil)
+
+// pendingObjectGracePeriods implements zombiedeletion.GracePeriods.
+type pendingObjectGracePeriods struct {
+	db *satelliteDB
+}
+
+// Set inserts or replaces the override of a project or bucket.
+func (g *pendingObjectGracePeriods) Set(ctx context.Context, gracePeriod zombiedeletion.GracePeriod) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = g.db.ExecContext(ctx, `
+		INSERT INTO pending_object_grace_periods (project_id, bucket_name, grace_period, updated_at)
+		VALUES ($1, $2, $3, now())
+		ON CONFLICT (project_id, bucket_name) DO UPDATE SET
+			grace_period = EXCLUDED.grace_period,
+			updated_at   = EXCLUDED.updated_at
+	`, gracePeriod.ProjectID, []byte(gracePeriod.BucketName), int64(gracePeriod.Period/time.Second))
+	return Error.Wrap(err)
+}
+
+// Get returns the override of a project or bucket.
+func (g *pendingObjectGracePeriods) Get(ctx context.Context, projectID uuid.UUID, bucketName string) (_ zombiedeletion.GracePeriod, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	gracePeriod, err := scanGracePeriod(g.db.QueryRowContext(ctx, `
+		SELECT project_id, bucket_name, grace_period, updated_at
+		FROM pending_object_grace_periods
+		WHERE project_id = $1 AND bucket_name = $2
+	`, projectID, []byte(bucketName)))
+	if errors.Is(err, sql.ErrNoRows) {
+		return zombiedeletion.GracePeriod{}, zombiedeletion.ErrGracePeriodNotFound.New("%s/%s", projectID, bucketName)
+	}
+	return gracePeriod, Error.Wrap(err)
+}
+
+// Delete removes the override of a project or bucket.
+func (g *pendingObjectGracePeriods) Delete(ctx context.Context, projectID uuid.UUID, bucketName string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	result, err := g.db.ExecContext(ctx, `
+		DELETE FROM pending_object_grace_periods
+		WHERE project_id = $1 AND bucket_name = $2
+	`, projectID, []byte(bucketName))
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if affected == 0 {
+		return zombiedeletion.ErrGracePeriodNotFound.New("%s/%s", projectID, bucketName)
+	}
+	return nil
+}
+
+// List returns all overrides.
+func (g *pendingObjectGracePeriods) List(ctx context.Context) (_ []zombiedeletion.GracePeriod, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var gracePeriods []zombiedeletion.GracePeriod
+	err = withRows(g.db.QueryContext(ctx, `
+		SELECT project_id, bucket_name, grace_period, updated_at
+		FROM pending_object_grace_periods
+		ORDER BY project_id, bucket_name
+	`))(func(rows tagsql.Rows) error {
+		for rows.Next() {
+			gracePeriod, err := scanGracePeriod(rows)
+			if err != nil {
+				return err
+			}
+			gracePeriods = append(gracePeriods, gracePeriod)
+		}
+		return nil
+	})
+	return gracePeriods, Error.Wrap(err)
+}
+
+func scanGracePeriod(row interface{ Scan(dest ...any) error }) (gracePeriod zombiedeletion.GracePeriod, err error) {
+	var bucketName []byte
+	var seconds int64
+	err = row.Scan(&gracePeriod.ProjectID, &bucketName, &seconds, &gracePeriod.UpdatedAt)
+	if err != nil {
+		return zombiedeletion.GracePeriod{}, err
+	}
+	gracePeriod.BucketName = string(bucketName)
+	gracePeriod.Period = time.Duration(seconds) * time.Second
+	return gracePeriod, nil
+}
@@ -699,6 +699,32 @@ func tryFindPartnerByUserAgent(userAgent []byte, partnerNames []string) (string,
 	return partner, nil
 }
 
+// GetAPIKeyEgress retrieves the summed egress attributed to every API key of particular project for a given period.
+func (db *ProjectAccounting) GetAPIKeyEgress(ctx context.Context, projectID uuid.UUID, since, before time.Time) (_ []accounting.APIKeyEgress, err error) {
+	defer mon.Task()(&ctx)(&err)
+	since = timeTruncateDown(since.UTC())
+	before = before.UTC()
+
+	var egress []accounting.APIKeyEgress
+	err = withRows(db.db.QueryContext(ctx, db.db.Rebind(`
+		SELECT api_key_id, COALESCE(SUM(settled), 0), COALESCE(SUM(inline), 0)
+		FROM api_key_bandwidth_rollups
+		WHERE project_id = ? AND interval_start >= ? AND interval_start <= ?
+		GROUP BY api_key_id
+		ORDER BY api_key_id
+	`), projectID[:], since, before))(func(rows tagsql.Rows) error {
+		for rows.Next() {
+			var item accounting.APIKeyEgress
+			if err := rows.Scan(&item.APIKeyID, &item.Settled, &item.Inline); err != nil {
+				return err
+			}
+			egress = append(egress, item)
+		}
+		return nil
+	})
+	return egress, Error.Wrap(err)
+}
+
 // GetBucketUsageRollups retrieves summed usage rollups for every bucket of particular project for a given period.
 func (db *ProjectAccounting) GetBucketUsageRollups(ctx context.Context, projectID uuid.UUID, since, before time.Time) (_ []accounting.BucketUsageRollup, err error) {
 	defer mon.Task()(&ctx)(&err)
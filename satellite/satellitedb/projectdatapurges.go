@@ -0,0 +1,174 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/projectdeletion"
+	"storj.io/storj/shared/tagsql"
+)
+
+var _ projectdeletion.DB = (*projectDataPurges)(nil)
+
+// projectDataPurges implements projectdeletion.DB.
+type projectDataPurges struct {
+	db *satelliteDB
+}
+
+const projectDataPurgeColumns = `project_id, status, bucket_name, continuation_token, attempts,
+	deleted_objects, deleted_buckets, error,
+	created_by, created_at, updated_at, finished_at`
+
+// Start starts purging the data of a project. A finished or failed purge of
+// the project is restarted; ErrAlreadyRunning is returned when a purge is running.
+func (p *projectDataPurges) Start(ctx context.Context, projectID uuid.UUID, createdBy string) (_ projectdeletion.Purge, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	row := p.db.QueryRowContext(ctx, `
+		INSERT INTO project_data_purges (
+			project_id, status, attempts, deleted_objects, deleted_buckets,
+			created_by, created_at, updated_at
+		) VALUES (
+			$1, $2, 0, 0, 0, $3, now(), now()
+		)
+		ON CONFLICT (project_id) DO UPDATE SET
+			status = EXCLUDED.status,
+			bucket_name = NULL,
+			continuation_token = NULL,
+			attempts = 0,
+			deleted_objects = 0,
+			deleted_buckets = 0,
+			error = NULL,
+			created_by = EXCLUDED.created_by,
+			created_at = EXCLUDED.created_at,
+			updated_at = EXCLUDED.updated_at,
+			finished_at = NULL
+		WHERE project_data_purges.finished_at IS NOT NULL
+		RETURNING `+projectDataPurgeColumns,
+		projectID, projectdeletion.PurgeRunning, createdBy,
+	)
+	purge, err := scanProjectDataPurgeInto(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return projectdeletion.Purge{}, projectdeletion.ErrAlreadyRunning.New("%s", projectID)
+	}
+	return purge, err
+}
+
+// Get returns the latest purge of a project.
+func (p *projectDataPurges) Get(ctx context.Context, projectID uuid.UUID) (_ projectdeletion.Purge, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	row := p.db.QueryRowContext(ctx, `
+		SELECT `+projectDataPurgeColumns+`
+		FROM project_data_purges
+		WHERE project_id = $1
+	`, projectID)
+	purge, err := scanProjectDataPurgeInto(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return projectdeletion.Purge{}, projectdeletion.ErrNotFound.New("%s", projectID)
+	}
+	return purge, err
+}
+
+// ListRunning returns at most limit running purges, least recently updated first.
+func (p *projectDataPurges) ListRunning(ctx context.Context, limit int) (_ []projectdeletion.Purge, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := p.db.QueryContext(ctx, `
+		SELECT `+projectDataPurgeColumns+`
+		FROM project_data_purges
+		WHERE finished_at IS NULL
+		ORDER BY updated_at
+		LIMIT $1
+	`, limit)
+	if err != nil {
+		return nil, err
+	}
+	return scanProjectDataPurges(rows)
+}
+
+// Update stores the progress of a running purge.
+func (p *projectDataPurges) Update(ctx context.Context, purge projectdeletion.Purge) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	result, err := p.db.ExecContext(ctx, `
+		UPDATE project_data_purges
+		SET status = $2,
+			bucket_name = $3, continuation_token = $4, attempts = $5,
+			deleted_objects = $6, deleted_buckets = $7, error = $8,
+			updated_at = now(), finished_at = $9
+		WHERE project_id = $1 AND finished_at IS NULL
+	`, purge.ProjectID, purge.Status,
+		nullBytes(purge.BucketName), []byte(purge.ContinuationToken), purge.Attempts,
+		purge.DeletedObjects, purge.DeletedBuckets, nullString(purge.Error),
+		purge.FinishedAt,
+	)
+	if err != nil {
+		return err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return projectdeletion.ErrNotFound.New("no running purge for %s", purge.ProjectID)
+	}
+	return nil
+}
+
+func nullBytes(value string) []byte {
+	if value == "" {
+		return nil
+	}
+	return []byte(value)
+}
+
+func nullString(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
+
+type projectDataPurgeScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanProjectDataPurgeInto(scanner projectDataPurgeScanner) (purge projectdeletion.Purge, err error) {
+	var bucketName, token []byte
+	var purgeError *string
+	err = scanner.Scan(
+		&purge.ProjectID, &purge.Status, &bucketName, &token, &purge.Attempts,
+		&purge.DeletedObjects, &purge.DeletedBuckets, &purgeError,
+		&purge.CreatedBy, &purge.CreatedAt, &purge.UpdatedAt, &purge.FinishedAt,
+	)
+	if err != nil {
+		return projectdeletion.Purge{}, err
+	}
+	purge.BucketName = string(bucketName)
+	purge.ContinuationToken = token
+	if purgeError != nil {
+		purge.Error = *purgeError
+	}
+	return purge, nil
+}
+
+func scanProjectDataPurges(rows tagsql.Rows) (purges []projectdeletion.Purge, err error) {
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	for rows.Next() {
+		purge, err := scanProjectDataPurgeInto(rows)
+		if err != nil {
+			return nil, err
+		}
+		purges = append(purges, purge)
+	}
+	return purges, rows.Err()
+}
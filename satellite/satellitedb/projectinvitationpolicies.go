@@ -0,0 +1,85 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"strings"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/console"
+)
+
+// Ensure that projectInvitationPolicies implements console.ProjectInvitationPolicies.
+var _ console.ProjectInvitationPolicies = (*projectInvitationPolicies)(nil)
+
+// projectInvitationPolicies is an implementation of console.ProjectInvitationPolicies.
+type projectInvitationPolicies struct {
+	db *satelliteDB
+}
+
+// Get returns the invitation policy of a project.
+func (policies *projectInvitationPolicies) Get(ctx context.Context, projectID uuid.UUID) (_ *console.ProjectInvitationPolicy, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	policy := &console.ProjectInvitationPolicy{ProjectID: projectID}
+	var domains string
+	err = policies.db.QueryRowContext(ctx, `
+		SELECT allowed_email_domains, max_pending_invitations, default_role, updated_at
+		FROM project_invitation_policies
+		WHERE project_id = $1
+	`, projectID).Scan(
+		&domains, &policy.MaxPendingInvitations, &policy.DefaultRole, &policy.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	policy.AllowedEmailDomains = splitDomains(domains)
+
+	return policy, nil
+}
+
+// Upsert updates the invitation policy of a project if it exists and inserts it otherwise.
+func (policies *projectInvitationPolicies) Upsert(ctx context.Context, policy console.ProjectInvitationPolicy) (_ *console.ProjectInvitationPolicy, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	result := &console.ProjectInvitationPolicy{ProjectID: policy.ProjectID}
+	var domains string
+	err = policies.db.QueryRowContext(ctx, `
+		INSERT INTO project_invitation_policies (
+			project_id, allowed_email_domains, max_pending_invitations, default_role, created_at, updated_at
+		) VALUES (
+			$1, $2, $3, $4, now(), now()
+		) ON CONFLICT (project_id) DO UPDATE SET
+			allowed_email_domains   = EXCLUDED.allowed_email_domains,
+			max_pending_invitations = EXCLUDED.max_pending_invitations,
+			default_role            = EXCLUDED.default_role,
+			updated_at              = EXCLUDED.updated_at
+		RETURNING allowed_email_domains, max_pending_invitations, default_role, updated_at
+	`, policy.ProjectID, strings.Join(policy.AllowedEmailDomains, ","), policy.MaxPendingInvitations, policy.DefaultRole,
+	).Scan(
+		&domains, &result.MaxPendingInvitations, &result.DefaultRole, &result.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	result.AllowedEmailDomains = splitDomains(domains)
+
+	return result, nil
+}
+
+// Delete removes the invitation policy of a project.
+func (policies *projectInvitationPolicies) Delete(ctx context.Context, projectID uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = policies.db.ExecContext(ctx, `DELETE FROM project_invitation_policies WHERE project_id = $1`, projectID)
+	return err
+}
+
+func splitDomains(domains string) []string {
+	if domains == "" {
+		return nil
+	}
+	return strings.Split(domains, ",")
+}
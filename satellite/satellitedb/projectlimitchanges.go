@@ -0,0 +1,252 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/memory"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/limitschedule"
+	"storj.io/storj/satellite/satellitedb/dbx"
+	"storj.io/storj/shared/tagsql"
+)
+
+var _ limitschedule.DB = (*projectLimitChanges)(nil)
+
+// projectLimitChanges implements limitschedule.DB.
+type projectLimitChanges struct {
+	db *satelliteDB
+}
+
+const projectLimitChangeColumns = `id, project_id, effective_at,
+	usage_limit, bandwidth_limit, segment_limit, rate_limit, burst_limit, max_buckets,
+	created_by, created_at, updated_at, applied_at`
+
+// Insert inserts a new limit change.
+func (p *projectLimitChanges) Insert(ctx context.Context, change limitschedule.Change) (_ limitschedule.Change, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	limits := change.Limits
+	row := p.db.QueryRowContext(ctx, `
+		INSERT INTO project_limit_changes (
+			id, project_id, effective_at,
+			usage_limit, bandwidth_limit, segment_limit, rate_limit, burst_limit, max_buckets,
+			created_by, created_at, updated_at
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, now(), now()
+		) RETURNING `+projectLimitChangeColumns,
+		change.ID, change.ProjectID, change.EffectiveAt,
+		sizeToNullInt64(limits.Usage), sizeToNullInt64(limits.Bandwidth), limits.Segments,
+		limits.Rate, limits.Burst, limits.Buckets,
+		change.CreatedBy,
+	)
+	return scanProjectLimitChange(row)
+}
+
+// Get returns the limit change with the specified ID.
+func (p *projectLimitChanges) Get(ctx context.Context, id uuid.UUID) (_ limitschedule.Change, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	row := p.db.QueryRowContext(ctx, `
+		SELECT `+projectLimitChangeColumns+`
+		FROM project_limit_changes
+		WHERE id = $1
+	`, id)
+	return scanProjectLimitChange(row)
+}
+
+// Update updates the effective time and the limits of a pending limit change.
+func (p *projectLimitChanges) Update(ctx context.Context, change limitschedule.Change) (_ limitschedule.Change, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	limits := change.Limits
+	row := p.db.QueryRowContext(ctx, `
+		UPDATE project_limit_changes
+		SET effective_at = $2,
+			usage_limit = $3, bandwidth_limit = $4, segment_limit = $5,
+			rate_limit = $6, burst_limit = $7, max_buckets = $8,
+			updated_at = now()
+		WHERE id = $1 AND applied_at IS NULL
+		RETURNING `+projectLimitChangeColumns,
+		change.ID, change.EffectiveAt,
+		sizeToNullInt64(limits.Usage), sizeToNullInt64(limits.Bandwidth), limits.Segments,
+		limits.Rate, limits.Burst, limits.Buckets,
+	)
+	return scanProjectLimitChange(row)
+}
+
+// Delete deletes the pending limit change with the specified ID.
+func (p *projectLimitChanges) Delete(ctx context.Context, id uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	result, err := p.db.ExecContext(ctx, `DELETE FROM project_limit_changes WHERE id = $1 AND applied_at IS NULL`, id)
+	if err != nil {
+		return err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return limitschedule.ErrNotFound.New("%s", id)
+	}
+	return nil
+}
+
+// ListByProject returns the limit changes of a project, ordered by effective time.
+func (p *projectLimitChanges) ListByProject(ctx context.Context, projectID uuid.UUID) (_ []limitschedule.Change, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := p.db.QueryContext(ctx, `
+		SELECT `+projectLimitChangeColumns+`
+		FROM project_limit_changes
+		WHERE project_id = $1
+		ORDER BY effective_at, id
+	`, projectID)
+	if err != nil {
+		return nil, err
+	}
+	return scanProjectLimitChanges(rows)
+}
+
+// ListDue returns at most limit pending changes which are effective at the specified time, ordered by effective time.
+func (p *projectLimitChanges) ListDue(ctx context.Context, now time.Time, limit int) (_ []limitschedule.Change, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := p.db.QueryContext(ctx, `
+		SELECT `+projectLimitChangeColumns+`
+		FROM project_limit_changes
+		WHERE applied_at IS NULL AND effective_at <= $1
+		ORDER BY effective_at, id
+		LIMIT $2
+	`, now, limit)
+	if err != nil {
+		return nil, err
+	}
+	return scanProjectLimitChanges(rows)
+}
+
+// Apply sets the limits of a pending limit change on its project and marks
+// the change as applied, in a single transaction.
+func (p *projectLimitChanges) Apply(ctx context.Context, change limitschedule.Change, appliedAt time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var update dbx.Project_Update_Fields
+	limits := change.Limits
+	if limits.Usage != nil {
+		update.UsageLimit = dbx.Project_UsageLimit(limits.Usage.Int64())
+	}
+	if limits.Bandwidth != nil {
+		update.BandwidthLimit = dbx.Project_BandwidthLimit(limits.Bandwidth.Int64())
+	}
+	if limits.Segments != nil {
+		update.SegmentLimit = dbx.Project_SegmentLimit(*limits.Segments)
+	}
+	// a negative limit resets the project to the satellite default.
+	if limits.Rate != nil {
+		update.RateLimit = dbx.Project_RateLimit_Null()
+		if *limits.Rate >= 0 {
+			update.RateLimit = dbx.Project_RateLimit(*limits.Rate)
+		}
+	}
+	if limits.Burst != nil {
+		update.BurstLimit = dbx.Project_BurstLimit_Null()
+		if *limits.Burst >= 0 {
+			update.BurstLimit = dbx.Project_BurstLimit(*limits.Burst)
+		}
+	}
+	if limits.Buckets != nil {
+		update.MaxBuckets = dbx.Project_MaxBuckets_Null()
+		if *limits.Buckets >= 0 {
+			update.MaxBuckets = dbx.Project_MaxBuckets(*limits.Buckets)
+		}
+	}
+
+	return p.db.WithTx(ctx, func(ctx context.Context, tx *dbx.Tx) error {
+		result, err := tx.Tx.ExecContext(ctx, `
+			UPDATE project_limit_changes SET applied_at = $2
+			WHERE id = $1 AND applied_at IS NULL
+		`, change.ID, appliedAt)
+		if err != nil {
+			return err
+		}
+		affected, err := result.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if affected == 0 {
+			return limitschedule.ErrNotFound.New("%s", change.ID)
+		}
+
+		project, err := tx.Update_Project_By_Id(ctx, dbx.Project_Id(change.ProjectID[:]), update)
+		if err != nil {
+			return err
+		}
+		if project == nil {
+			return limitschedule.ErrNotFound.New("project %s", change.ProjectID)
+		}
+		return nil
+	})
+}
+
+func sizeToNullInt64(size *memory.Size) *int64 {
+	if size == nil {
+		return nil
+	}
+	value := size.Int64()
+	return &value
+}
+
+type projectLimitChangeScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanProjectLimitChangeInto(scanner projectLimitChangeScanner) (change limitschedule.Change, err error) {
+	var usage, bandwidth *int64
+	err = scanner.Scan(
+		&change.ID, &change.ProjectID, &change.EffectiveAt,
+		&usage, &bandwidth, &change.Limits.Segments,
+		&change.Limits.Rate, &change.Limits.Burst, &change.Limits.Buckets,
+		&change.CreatedBy, &change.CreatedAt, &change.UpdatedAt, &change.AppliedAt,
+	)
+	if err != nil {
+		return limitschedule.Change{}, err
+	}
+	if usage != nil {
+		size := memory.Size(*usage)
+		change.Limits.Usage = &size
+	}
+	if bandwidth != nil {
+		size := memory.Size(*bandwidth)
+		change.Limits.Bandwidth = &size
+	}
+	return change, nil
+}
+
+func scanProjectLimitChange(row *sql.Row) (limitschedule.Change, error) {
+	change, err := scanProjectLimitChangeInto(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return limitschedule.Change{}, limitschedule.ErrNotFound.New("")
+	}
+	return change, err
+}
+
+func scanProjectLimitChanges(rows tagsql.Rows) (changes []limitschedule.Change, err error) {
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	for rows.Next() {
+		change, err := scanProjectLimitChangeInto(rows)
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, change)
+	}
+	return changes, rows.Err()
+}
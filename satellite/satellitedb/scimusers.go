@@ -0,0 +1,157 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/console"
+)
+
+// Ensure that scimUsers implements console.SCIMUsers.
+var _ console.SCIMUsers = (*scimUsers)(nil)
+
+// scimUsers is an implementation of console.SCIMUsers.
+type scimUsers struct {
+	db *satelliteDB
+}
+
+// Insert records that the user was provisioned by the provider.
+func (users *scimUsers) Insert(ctx context.Context, user console.SCIMUser) (_ *console.SCIMUser, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = users.db.QueryRowContext(ctx, `
+		INSERT INTO scim_users (user_id, provider, external_id, created_at)
+		VALUES ($1, $2, $3, now())
+		RETURNING created_at
+	`, user.UserID, user.Provider, nullString(user.ExternalID)).Scan(&user.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &user, nil
+}
+
+// Get returns the record of the user provisioned by the provider.
+func (users *scimUsers) Get(ctx context.Context, provider string, userID uuid.UUID) (_ *console.SCIMUser, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return scanSCIMUser(users.db.QueryRowContext(ctx, `
+		SELECT user_id, provider, external_id, created_at
+		FROM scim_users
+		WHERE provider = $1 AND user_id = $2
+	`, provider, userID))
+}
+
+// GetByExternalID returns the record of the user provisioned by the provider
+// with the ID of the user in the provider.
+func (users *scimUsers) GetByExternalID(ctx context.Context, provider, externalID string) (_ *console.SCIMUser, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return scanSCIMUser(users.db.QueryRowContext(ctx, `
+		SELECT user_id, provider, external_id, created_at
+		FROM scim_users
+		WHERE provider = $1 AND external_id = $2
+	`, provider, externalID))
+}
+
+// List returns the records of the users provisioned by the provider ordered
+// by provisioning time, and the total number of them.
+func (users *scimUsers) List(ctx context.Context, provider string, offset, limit int) (_ []console.SCIMUser, total int, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = users.db.QueryRowContext(ctx, `
+		SELECT COUNT(*) FROM scim_users WHERE provider = $1
+	`, provider).Scan(&total)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	rows, err := users.db.QueryContext(ctx, `
+		SELECT user_id, provider, external_id, created_at
+		FROM scim_users
+		WHERE provider = $1
+		ORDER BY created_at, user_id
+		LIMIT $2 OFFSET $3
+	`, provider, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var list []console.SCIMUser
+	for rows.Next() {
+		user, err := scanSCIMUser(rows)
+		if err != nil {
+			return nil, 0, err
+		}
+		list = append(list, *user)
+	}
+
+	return list, total, rows.Err()
+}
+
+// ListProjectMembers returns the IDs of the members of the project which
+// were provisioned by the provider.
+func (users *scimUsers) ListProjectMembers(ctx context.Context, provider string, projectID uuid.UUID) (_ []uuid.UUID, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := users.db.QueryContext(ctx, `
+		SELECT pm.member_id
+		FROM project_members pm
+		JOIN scim_users su ON su.user_id = pm.member_id
+		WHERE su.provider = $1 AND pm.project_id = $2
+		ORDER BY pm.created_at, pm.member_id
+	`, provider, projectID)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var ids []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, rows.Err()
+}
+
+// UpdateExternalID changes the ID of the user in the provider.
+func (users *scimUsers) UpdateExternalID(ctx context.Context, provider string, userID uuid.UUID, externalID string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = users.db.ExecContext(ctx, `
+		UPDATE scim_users SET external_id = $3
+		WHERE provider = $1 AND user_id = $2
+	`, provider, userID, nullString(externalID))
+	return err
+}
+
+// Delete removes the record of the user provisioned by the provider.
+func (users *scimUsers) Delete(ctx context.Context, provider string, userID uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = users.db.ExecContext(ctx, `DELETE FROM scim_users WHERE provider = $1 AND user_id = $2`, provider, userID)
+	return err
+}
+
+func scanSCIMUser(row interface{ Scan(dest ...any) error }) (*console.SCIMUser, error) {
+	var user console.SCIMUser
+	var externalID *string
+	err := row.Scan(&user.UserID, &user.Provider, &externalID, &user.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	if externalID != nil {
+		user.ExternalID = *externalID
+	}
+	return &user, nil
+}
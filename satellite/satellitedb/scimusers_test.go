@@ -0,0 +1,81 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb_test
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestSCIMUsers(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		scimDB := db.Console().SCIMUsers()
+
+		first, err := scimDB.Insert(ctx, console.SCIMUser{
+			UserID:     testrand.UUID(),
+			Provider:   "okta",
+			ExternalID: "00u1",
+		})
+		require.NoError(t, err)
+		require.False(t, first.CreatedAt.IsZero())
+
+		second, err := scimDB.Insert(ctx, console.SCIMUser{
+			UserID:   testrand.UUID(),
+			Provider: "okta",
+		})
+		require.NoError(t, err)
+
+		_, err = scimDB.Insert(ctx, console.SCIMUser{
+			UserID:   testrand.UUID(),
+			Provider: "azure",
+		})
+		require.NoError(t, err)
+
+		// the external IDs are unique per provider.
+		_, err = scimDB.Insert(ctx, console.SCIMUser{
+			UserID:     testrand.UUID(),
+			Provider:   "okta",
+			ExternalID: "00u1",
+		})
+		require.Error(t, err)
+
+		got, err := scimDB.Get(ctx, "okta", first.UserID)
+		require.NoError(t, err)
+		require.Equal(t, "00u1", got.ExternalID)
+
+		_, err = scimDB.Get(ctx, "azure", first.UserID)
+		require.ErrorIs(t, err, sql.ErrNoRows)
+
+		got, err = scimDB.GetByExternalID(ctx, "okta", "00u1")
+		require.NoError(t, err)
+		require.Equal(t, first.UserID, got.UserID)
+
+		list, total, err := scimDB.List(ctx, "okta", 0, 10)
+		require.NoError(t, err)
+		require.Equal(t, 2, total)
+		require.Len(t, list, 2)
+
+		list, total, err = scimDB.List(ctx, "okta", 1, 10)
+		require.NoError(t, err)
+		require.Equal(t, 2, total)
+		require.Len(t, list, 1)
+
+		require.NoError(t, scimDB.UpdateExternalID(ctx, "okta", second.UserID, "00u2"))
+		got, err = scimDB.GetByExternalID(ctx, "okta", "00u2")
+		require.NoError(t, err)
+		require.Equal(t, second.UserID, got.UserID)
+
+		require.NoError(t, scimDB.Delete(ctx, "okta", first.UserID))
+		_, err = scimDB.Get(ctx, "okta", first.UserID)
+		require.ErrorIs(t, err, sql.ErrNoRows)
+	})
+}
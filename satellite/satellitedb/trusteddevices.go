@@ -0,0 +1,176 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/shared/dbutil/pgutil"
+)
+
+// Ensure that trustedDevices implements console.TrustedDevices.
+var _ console.TrustedDevices = (*trustedDevices)(nil)
+
+// trustedDevices is an implementation of console.TrustedDevices.
+type trustedDevices struct {
+	db *satelliteDB
+}
+
+// Upsert records a login from the device, inserting it if it has not been seen before
+// and bumping its last used time otherwise. isNew reports whether the device was inserted.
+func (devices *trustedDevices) Upsert(ctx context.Context, device console.TrustedDevice) (isNew bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	// On conflict the id of the existing row is returned, so comparing it with
+	// the id we attempted to insert tells us whether the device is new.
+	var id uuid.UUID
+	err = devices.db.QueryRowContext(ctx, `
+		INSERT INTO trusted_devices (
+			id, user_id, fingerprint, user_agent, ip_address, created_at, last_used_at
+		) VALUES (
+			$1, $2, $3, $4, $5, now(), now()
+		) ON CONFLICT (user_id, fingerprint) DO UPDATE SET
+			ip_address   = EXCLUDED.ip_address,
+			last_used_at = EXCLUDED.last_used_at
+		RETURNING id
+	`, device.ID, device.UserID, device.Fingerprint, device.UserAgent, device.IPAddress).Scan(&id)
+	if err != nil {
+		return false, err
+	}
+
+	return id == device.ID, nil
+}
+
+// ListByUserID returns the devices of a user, most recently used first.
+func (devices *trustedDevices) ListByUserID(ctx context.Context, userID uuid.UUID) (_ []console.TrustedDevice, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := devices.db.QueryContext(ctx, `
+		SELECT id, fingerprint, user_agent, ip_address, created_at, last_used_at
+		FROM trusted_devices
+		WHERE user_id = $1
+		ORDER BY last_used_at DESC
+	`, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var list []console.TrustedDevice
+	for rows.Next() {
+		device := console.TrustedDevice{UserID: userID}
+		err = rows.Scan(&device.ID, &device.Fingerprint, &device.UserAgent, &device.IPAddress, &device.CreatedAt, &device.LastUsedAt)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, device)
+	}
+
+	return list, rows.Err()
+}
+
+// CountByUserID returns the number of devices of a user.
+func (devices *trustedDevices) CountByUserID(ctx context.Context, userID uuid.UUID) (count int, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = devices.db.QueryRowContext(ctx, `SELECT count(*) FROM trusted_devices WHERE user_id = $1`, userID).Scan(&count)
+	return count, err
+}
+
+// Delete removes a device of a user.
+func (devices *trustedDevices) Delete(ctx context.Context, userID, id uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = devices.db.ExecContext(ctx, `DELETE FROM trusted_devices WHERE user_id = $1 AND id = $2`, userID, id)
+	return err
+}
+
+// DeleteLeastRecentlyUsed removes the devices of a user except for the keep most recently used ones.
+func (devices *trustedDevices) DeleteLeastRecentlyUsed(ctx context.Context, userID uuid.UUID, keep int) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = devices.db.ExecContext(ctx, `
+		DELETE FROM trusted_devices
+		WHERE user_id = $1 AND id NOT IN (
+			SELECT id FROM trusted_devices
+			WHERE user_id = $1
+			ORDER BY last_used_at DESC
+			LIMIT $2
+		)
+	`, userID, keep)
+	return err
+}
+
+// DeleteLastUsedBefore removes the devices of all users that were last used before the given time.
+func (devices *trustedDevices) DeleteLastUsedBefore(ctx context.Context, before time.Time, asOfSystemTimeInterval time.Duration, pageSize int) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if pageSize <= 0 {
+		return Error.New("expected page size to be positive; got %d", pageSize)
+	}
+
+	var pageCursor uuid.UUID
+	selected := make([]uuid.UUID, pageSize)
+	aost := devices.db.impl.AsOfSystemInterval(asOfSystemTimeInterval)
+	for {
+		// Select the ID beginning this page of records
+		err := devices.db.QueryRowContext(ctx, `
+			SELECT id FROM trusted_devices
+			`+aost+`
+			WHERE id > $1 AND last_used_at < $2
+			ORDER BY id LIMIT 1
+		`, pageCursor, before).Scan(&pageCursor)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return nil
+			}
+			return Error.Wrap(err)
+		}
+
+		// Select page of records
+		rows, err := devices.db.QueryContext(ctx, `
+			SELECT id FROM trusted_devices
+			`+aost+`
+			WHERE id >= $1 ORDER BY id LIMIT $2
+		`, pageCursor, pageSize)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+
+		var i int
+		for i = 0; rows.Next(); i++ {
+			if err = rows.Scan(&selected[i]); err != nil {
+				return Error.Wrap(errs.Combine(err, rows.Close()))
+			}
+		}
+		if err = errs.Combine(rows.Err(), rows.Close()); err != nil {
+			return Error.Wrap(err)
+		}
+
+		// Delete all unused records in the page, the devices may have been
+		// used again since they were read.
+		_, err = devices.db.ExecContext(ctx, `
+			DELETE FROM trusted_devices
+			WHERE id = ANY($1)
+			AND last_used_at < $2
+		`, pgutil.UUIDArray(selected[:i]), before)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+
+		if i < pageSize {
+			return nil
+		}
+
+		// Advance the cursor to the next page
+		pageCursor = selected[i-1]
+	}
+}
@@ -328,6 +328,10 @@ func (users *users) Insert(ctx context.Context, user *console.User) (_ *console.
 		optional.TrialExpiration = dbx.User_TrialExpiration(*user.TrialExpiration)
 	}
 
+	if user.SignupReferral != "" {
+		optional.SignupReferral = dbx.User_SignupReferral(user.SignupReferral)
+	}
+
 	createdUser, err := users.db.Create_User(ctx,
 		dbx.User_Id(user.ID[:]),
 		dbx.User_Email(user.Email),
@@ -565,6 +569,7 @@ func (users *users) GetSettings(ctx context.Context, userID uuid.UUID) (settings
 	settings.OnboardingStart = row.OnboardingStart
 	settings.OnboardingEnd = row.OnboardingEnd
 	settings.OnboardingStep = row.OnboardingStep
+	settings.LoginAlerts = row.LoginAlerts
 	settings.PassphrasePrompt = true
 	if row.PassphrasePrompt != nil {
 		settings.PassphrasePrompt = *row.PassphrasePrompt
@@ -614,6 +619,10 @@ func (users *users) UpsertSettings(ctx context.Context, userID uuid.UUID, settin
 		update.OnboardingStep = dbx.UserSettings_OnboardingStep(*settings.OnboardingStep)
 		fieldCount++
 	}
+	if settings.LoginAlerts != nil {
+		update.LoginAlerts = dbx.UserSettings_LoginAlerts(*settings.LoginAlerts)
+		fieldCount++
+	}
 
 	if settings.NoticeDismissal != nil {
 		noticesBytes, err := json.Marshal(settings.NoticeDismissal)
@@ -843,6 +852,10 @@ func UserFromDBX(ctx context.Context, user *dbx.User) (_ *console.User, err erro
 		result.SignupPromoCode = *user.SignupPromoCode
 	}
 
+	if user.SignupReferral != nil {
+		result.SignupReferral = *user.SignupReferral
+	}
+
 	if user.FailedLoginCount != nil {
 		result.FailedLoginCount = *user.FailedLoginCount
 	}
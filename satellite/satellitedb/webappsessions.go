@@ -37,6 +37,31 @@ func (db *webappSessions) Create(ctx context.Context, id, userID uuid.UUID, addr
 	return getSessionFromDBX(dbxSession)
 }
 
+// CreateReadOnly creates a read-only webapp session and returns the session info.
+func (db *webappSessions) CreateReadOnly(ctx context.Context, id, userID uuid.UUID, address, userAgent string, expiresAt time.Time) (session consoleauth.WebappSession, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = db.db.ExecContext(ctx, `
+		INSERT INTO webapp_sessions (
+			id, user_id, ip_address, user_agent, status, expires_at
+		) VALUES (
+			$1, $2, $3, $4, $5, $6
+		)
+	`, id, userID, address, userAgent, consoleauth.SessionStatusReadOnly, expiresAt)
+	if err != nil {
+		return session, err
+	}
+
+	return consoleauth.WebappSession{
+		ID:        id,
+		UserID:    userID,
+		Address:   address,
+		UserAgent: userAgent,
+		Status:    consoleauth.SessionStatusReadOnly,
+		ExpiresAt: expiresAt,
+	}, nil
+}
+
 // UpdateExpiration updates the expiration time of the session.
 func (db *webappSessions) UpdateExpiration(ctx context.Context, sessionID uuid.UUID, expiresAt time.Time) (err error) {
 	defer mon.Task()(&ctx)(&err)
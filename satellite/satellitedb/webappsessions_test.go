@@ -13,6 +13,7 @@ import (
 	"storj.io/common/testcontext"
 	"storj.io/common/testrand"
 	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/console/consoleauth"
 	"storj.io/storj/satellite/satellitedb/satellitedbtest"
 )
 
@@ -40,6 +41,35 @@ func TestWebappSessionsCreate(t *testing.T) {
 	})
 }
 
+func TestWebappSessionsCreateReadOnly(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		sessions := db.Console().WebappSessions()
+
+		id := testrand.UUID()
+		userID := testrand.UUID()
+		address := "127.0.0.1"
+		userAgent := "test_user_agent"
+		expiresAt := time.Now().Add(15 * time.Minute).Truncate(time.Second)
+
+		session, err := sessions.CreateReadOnly(ctx, id, userID, address, userAgent, expiresAt)
+		require.NoError(t, err)
+		require.Equal(t, consoleauth.SessionStatusReadOnly, session.Status)
+
+		session, err = sessions.GetBySessionID(ctx, id)
+		require.NoError(t, err)
+
+		require.Equal(t, id, session.ID)
+		require.Equal(t, userID, session.UserID)
+		require.Equal(t, address, session.Address)
+		require.Equal(t, userAgent, session.UserAgent)
+		require.Equal(t, consoleauth.SessionStatusReadOnly, session.Status)
+		require.Equal(t, expiresAt, session.ExpiresAt)
+
+		_, err = sessions.CreateReadOnly(ctx, id, userID, address, userAgent, expiresAt)
+		require.Error(t, err)
+	})
+}
+
 func TestWebappSessionsGetBySessionID(t *testing.T) {
 	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
 		sessions := db.Console().WebappSessions()
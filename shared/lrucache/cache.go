@@ -23,9 +23,14 @@ type Options struct {
 	// is invalidated. A non-positive value means no expiration.
 	Expiration time.Duration
 
-	// Capacity is how many objects to keep in memory.
+	// Capacity is how many objects to keep in memory. When MaxBytes is set,
+	// a non-positive value means the number of objects is not limited.
 	Capacity int
 
+	// MaxBytes is the approximate amount of memory the cached values may use.
+	// It's only enforced by caches created with NewMemoryBoundedOf.
+	MaxBytes int64
+
 	// Name is used to differentiate cache in monkit stat.
 	Name string
 }
@@ -37,6 +42,7 @@ type cacheState[T any] struct {
 	order  *list.Element
 	value  T
 	loaded bool
+	size   int64
 }
 
 // ExpiringLRU is a backwards compatible implementation of ExpiringLRU.
@@ -49,6 +55,9 @@ type ExpiringLRUOf[T any] struct {
 	opts  Options
 	data  map[string]*cacheState[T]
 	order *list.List
+
+	sizeOf func(key string, value T) int64
+	bytes  int64
 }
 
 // New constructs an ExpiringLRU with the given options.
@@ -65,14 +74,30 @@ func NewOf[T any](opts Options) *ExpiringLRUOf[T] {
 	}
 }
 
+// NewMemoryBoundedOf constructs an ExpiringLRU which evicts the least recently
+// used values once the total size of the cached values, as estimated by sizeOf,
+// exceeds opts.MaxBytes.
+func NewMemoryBoundedOf[T any](opts Options, sizeOf func(key string, value T) int64) *ExpiringLRUOf[T] {
+	e := NewOf[T](opts)
+	if opts.MaxBytes > 0 {
+		e.sizeOf = sizeOf
+	}
+	return e
+}
+
+// enabled returns whether the cache keeps any values at all.
+func (e *ExpiringLRUOf[T]) enabled() bool {
+	return e.opts.Capacity > 0 || e.sizeOf != nil
+}
+
 // Get returns the value for some key if it exists and is valid. If not
 // it will call the provided function. Concurrent calls will dedupe as
 // best as they are able. If the function returns an error, it is not
 // cached and further calls will try again.
 func (e *ExpiringLRUOf[T]) Get(ctx context.Context, key string, fn func() (T, error)) (value T, err error) {
-	if e.opts.Capacity <= 0 {
+	if !e.enabled() {
 		e.monitorCache(false)
-		return fn()
+		return e.load(fn)
 	}
 
 	for {
@@ -81,20 +106,15 @@ func (e *ExpiringLRUOf[T]) Get(ctx context.Context, key string, fn func() (T, er
 		state, ok := e.data[key]
 		switch {
 		case !ok:
-			for len(e.data) >= e.opts.Capacity {
-				back := e.order.Back()
-				delete(e.data, back.Value.(string))
-				e.order.Remove(back)
-			}
 			state = &cacheState[T]{
 				when:  time2.Now(ctx),
 				order: e.order.PushFront(key),
 			}
 			e.data[key] = state
+			e.evictLocked()
 
 		case e.opts.Expiration > 0 && time2.Since(ctx, state.when) > e.opts.Expiration:
-			delete(e.data, key)
-			e.order.Remove(state.order)
+			e.removeLocked(key, state)
 			e.mu.Unlock()
 			continue
 
@@ -107,20 +127,29 @@ func (e *ExpiringLRUOf[T]) Get(ctx context.Context, key string, fn func() (T, er
 		called := false
 		state.once.Do(func() {
 			called = true
-			value, err = fn()
+			value, err = e.load(fn)
 
 			if err == nil {
 				// careful because we don't want a `(*T)(nil) != nil` situation
 				// that's why we only assign to state.value if err == nil.
 				state.value = value
 				state.loaded = true
+
+				if e.sizeOf != nil {
+					e.mu.Lock()
+					if e.data[key] == state {
+						state.size = e.sizeOf(key, value)
+						e.bytes += state.size
+						e.evictLocked()
+					}
+					e.mu.Unlock()
+				}
 			} else {
 				// the once has been used. delete it so that any other waiters
 				// will retry.
 				e.mu.Lock()
 				if e.data[key] == state {
-					delete(e.data, key)
-					e.order.Remove(state.order)
+					e.removeLocked(key, state)
 				}
 				e.mu.Unlock()
 			}
@@ -133,6 +162,18 @@ func (e *ExpiringLRUOf[T]) Get(ctx context.Context, key string, fn func() (T, er
 	}
 }
 
+// load calls fn and records how long it took.
+func (e *ExpiringLRUOf[T]) load(fn func() (T, error)) (T, error) {
+	if e.opts.Name == "" {
+		return fn()
+	}
+
+	start := time.Now()
+	value, err := fn()
+	mon.DurationVal("cache_load_duration", monkit.NewSeriesTag("name", e.opts.Name)).Observe(time.Since(start))
+	return value, err
+}
+
 func (e *ExpiringLRUOf[T]) monitorCache(valueFromCache bool) {
 	if e.opts.Name == "" {
 		return
@@ -146,6 +187,54 @@ func (e *ExpiringLRUOf[T]) monitorCache(valueFromCache bool) {
 	}
 }
 
+// evictLocked removes the least recently used entries until the cache is
+// within its capacity and memory bounds.
+//
+// NOTE the caller must always lock and unlock the mutex before calling this
+// method.
+func (e *ExpiringLRUOf[T]) evictLocked() {
+	for e.order.Len() > 0 && e.overLimitLocked() {
+		back := e.order.Back()
+		key := back.Value.(string)
+		e.removeLocked(key, e.data[key])
+
+		if e.opts.Name != "" {
+			mon.Event("cache_eviction", monkit.NewSeriesTag("name", e.opts.Name))
+		}
+	}
+}
+
+// overLimitLocked returns whether the cache holds more entries or bytes than
+// it's allowed to.
+//
+// NOTE the caller must always lock and unlock the mutex before calling this
+// method.
+func (e *ExpiringLRUOf[T]) overLimitLocked() bool {
+	if e.opts.Capacity > 0 && e.order.Len() > e.opts.Capacity {
+		return true
+	}
+	return e.sizeOf != nil && e.bytes > e.opts.MaxBytes
+}
+
+// removeLocked removes the entry of the key from the cache.
+//
+// NOTE the caller must always lock and unlock the mutex before calling this
+// method.
+func (e *ExpiringLRUOf[T]) removeLocked(key string, state *cacheState[T]) {
+	delete(e.data, key)
+	e.order.Remove(state.order)
+	e.bytes -= state.size
+}
+
+// Bytes returns the estimated size of the cached values. It's always zero
+// for caches which aren't memory bounded.
+func (e *ExpiringLRUOf[T]) Bytes() int64 {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	return e.bytes
+}
+
 // Delete explicitly removes a key from the cache if it exists.
 func (e *ExpiringLRUOf[T]) Delete(ctx context.Context, key string) {
 	e.mu.Lock()
@@ -155,42 +244,40 @@ func (e *ExpiringLRUOf[T]) Delete(ctx context.Context, key string) {
 	if !ok {
 		return
 	}
-	delete(e.data, key)
-	e.order.Remove(state.order)
+	e.removeLocked(key, state)
 }
 
 // Add adds a value to the cache.
 //
 // replaced is true if the key already existed in the cache and was valid, hence
-// the value is replaced.
+// the value is replaced. Nothing is added when the cache is disabled, i.e. it
+// has neither a positive capacity nor a memory bound.
 func (e *ExpiringLRUOf[T]) Add(ctx context.Context, key string, value T) (replaced bool) {
+	if !e.enabled() {
+		return false
+	}
+
 	e.mu.Lock()
 	defer e.mu.Unlock()
 
-	state, evicted := e.peek(ctx, key)
-	if state == nil {
-		if !evicted && e.order.Len() >= e.opts.Capacity {
-			item := e.order.Back()
-			delete(e.data, item.Value.(string))
-		}
-
-		e.data[key] = &cacheState[T]{
-			when:  time2.Now(ctx),
-			order: e.order.PushFront(key),
-			value: value,
-		}
-
-		return false
+	state, _ := e.peek(ctx, key)
+	if state != nil {
+		e.removeLocked(key, state)
 	}
 
-	e.order.Remove(state.order)
-	e.data[key] = &cacheState[T]{
+	newState := &cacheState[T]{
 		when:  time2.Now(ctx),
 		order: e.order.PushFront(key),
 		value: value,
 	}
+	if e.sizeOf != nil {
+		newState.size = e.sizeOf(key, value)
+		e.bytes += newState.size
+	}
+	e.data[key] = newState
+	e.evictLocked()
 
-	return true
+	return state != nil
 }
 
 // GetCached returns the value associated with key and true if it exists and
@@ -224,8 +311,7 @@ func (e *ExpiringLRUOf[T]) peek(ctx context.Context, key string) (state *cacheSt
 	}
 
 	if e.opts.Expiration > 0 && time2.Since(ctx, state.when) > e.opts.Expiration {
-		e.order.Remove(state.order)
-		delete(e.data, key)
+		e.removeLocked(key, state)
 
 		return nil, true
 	}
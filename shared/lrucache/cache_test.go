@@ -168,6 +168,25 @@ func TestCache_Add_and_GetCached(t *testing.T) {
 	require.Equal(t, 3, value)
 }
 
+func TestCache_Add_Disabled(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	for _, capacity := range []int{0, -1} {
+		cache := NewOf[int](Options{Capacity: capacity})
+
+		replaced := cache.Add(ctx, "key", 1)
+		require.False(t, replaced, "Add -> replaced")
+		replaced = cache.Add(ctx, "key", 2)
+		require.False(t, replaced, "Add -> replaced")
+
+		_, cached := cache.GetCached(ctx, "key")
+		require.False(t, cached, "GetCached -> cached")
+		require.Empty(t, cache.data)
+		require.Zero(t, cache.order.Len())
+	}
+}
+
 func TestCache_Add_and_GetCached_Fuzz(t *testing.T) {
 	const numEntries = 200
 	require.Zero(t, numEntries%2) // Ensure that numEntries is even.
@@ -269,3 +288,44 @@ func (c *checker) Check(ctx context.Context, key string, calls int) {
 	require.Equal(c.t, value, key)
 	require.NoError(c.t, err)
 }
+
+func TestCache_MemoryBounded(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	cache := NewMemoryBoundedOf[string](Options{MaxBytes: 10}, func(key string, value string) int64 {
+		return int64(len(value))
+	})
+
+	for _, key := range []string{"a", "b", "c"} {
+		value, err := cache.Get(ctx, key, func() (string, error) {
+			return key + key + key + key, nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, key+key+key+key, value)
+	}
+
+	// "a" was evicted, because all three values don't fit into the limit.
+	require.EqualValues(t, 8, cache.Bytes())
+	_, cached := cache.GetCached(ctx, "a")
+	require.False(t, cached)
+	_, cached = cache.GetCached(ctx, "b")
+	require.True(t, cached)
+
+	// "b" was used recently, so adding "d" evicts "c" instead.
+	replaced := cache.Add(ctx, "d", "dddddd")
+	require.False(t, replaced)
+	require.EqualValues(t, 10, cache.Bytes())
+	_, cached = cache.GetCached(ctx, "c")
+	require.False(t, cached)
+
+	// values larger than the limit are not kept.
+	cache.Add(ctx, "e", "eeeeeeeeeeee")
+	_, cached = cache.GetCached(ctx, "e")
+	require.False(t, cached)
+	require.Zero(t, cache.Bytes())
+
+	cache.Add(ctx, "f", "f")
+	cache.Delete(ctx, "f")
+	require.Zero(t, cache.Bytes())
+}
@@ -2,5 +2,6 @@
 // See LICENSE for copying information.
 
 // Package lrucache provides a LRU cache implementation with an optional key
-// expiration time.
+// expiration time. Caches can be bounded either by the number of entries or
+// by the approximate memory used by the cached values.
 package lrucache
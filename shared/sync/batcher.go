@@ -0,0 +1,148 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package sync contains synchronization utilities shared between the
+// services of this repository.
+package sync
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+
+	"storj.io/common/context2"
+)
+
+var mon = monkit.Package()
+
+// BatcherConfig configures a Batcher.
+type BatcherConfig struct {
+	// MaxBatchSize is the maximum number of items passed to a single flush.
+	// Reaching it flushes the batch without waiting for MaxDelay.
+	MaxBatchSize int
+	// MaxDelay is the maximum time an item waits in the batcher before it's flushed.
+	MaxDelay time.Duration
+}
+
+// Batcher collects items and flushes them in batches, either when a batch
+// is full or when the items have waited for the maximum delay. The items
+// which are still pending when Run stops are flushed before it returns.
+//
+// Add is safe to call concurrently; the flush function is only called from
+// a single goroutine at a time.
+type Batcher[T any] struct {
+	name   string
+	config BatcherConfig
+	flush  func(ctx context.Context, batch []T)
+
+	wake chan struct{}
+
+	flushMu sync.Mutex
+
+	mu      sync.Mutex
+	pending []T
+}
+
+// NewBatcher creates a new batcher, which calls flush with the batches. The
+// name is used to tag the monkit stats of the batcher.
+func NewBatcher[T any](name string, config BatcherConfig, flush func(ctx context.Context, batch []T)) *Batcher[T] {
+	if config.MaxBatchSize <= 0 {
+		config.MaxBatchSize = 1
+	}
+	return &Batcher[T]{
+		name:   name,
+		config: config,
+		flush:  flush,
+		wake:   make(chan struct{}, 1),
+	}
+}
+
+// Add queues an item for the next batch.
+func (batcher *Batcher[T]) Add(item T) {
+	batcher.mu.Lock()
+	batcher.pending = append(batcher.pending, item)
+	full := len(batcher.pending) >= batcher.config.MaxBatchSize
+	batcher.mu.Unlock()
+
+	mon.Counter("batcher_items", monkit.NewSeriesTag("name", batcher.name)).Inc(1)
+
+	if full {
+		select {
+		case batcher.wake <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// Pending returns the number of items waiting to be flushed.
+func (batcher *Batcher[T]) Pending() int {
+	batcher.mu.Lock()
+	defer batcher.mu.Unlock()
+	return len(batcher.pending)
+}
+
+// Run flushes the batches until ctx is canceled. The pending items are
+// flushed before returning, with a context which isn't canceled.
+func (batcher *Batcher[T]) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var timer <-chan time.Time
+	if batcher.config.MaxDelay > 0 {
+		ticker := time.NewTicker(batcher.config.MaxDelay)
+		defer ticker.Stop()
+		timer = ticker.C
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			batcher.Flush(context2.WithoutCancellation(ctx))
+			return nil
+		case <-batcher.wake:
+			batcher.flushPending(ctx, "size")
+		case <-timer:
+			batcher.flushPending(ctx, "delay")
+		}
+	}
+}
+
+// Flush flushes all the pending items.
+func (batcher *Batcher[T]) Flush(ctx context.Context) {
+	batcher.flushPending(ctx, "explicit")
+}
+
+func (batcher *Batcher[T]) flushPending(ctx context.Context, reason string) {
+	batcher.flushMu.Lock()
+	defer batcher.flushMu.Unlock()
+
+	batcher.mu.Lock()
+	pending := batcher.pending
+	batcher.pending = nil
+	batcher.mu.Unlock()
+
+	if len(pending) == 0 {
+		return
+	}
+
+	tags := []monkit.SeriesTag{
+		monkit.NewSeriesTag("name", batcher.name),
+		monkit.NewSeriesTag("reason", reason),
+	}
+	mon.Counter("batcher_flushes", tags...).Inc(1)
+
+	for len(pending) > 0 {
+		n := batcher.config.MaxBatchSize
+		if n > len(pending) {
+			n = len(pending)
+		}
+
+		mon.IntVal("batcher_batch_size", tags[0]).Observe(int64(n))
+		finish := mon.TaskNamed("batcher_flush", tags[0])(&ctx)
+		batcher.flush(ctx, pending[:n:n])
+		finish(nil)
+
+		pending = pending[n:]
+	}
+}
@@ -0,0 +1,103 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package sync_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	sharedsync "storj.io/storj/shared/sync"
+)
+
+type collector struct {
+	mu      sync.Mutex
+	batches [][]int
+	flushed chan struct{}
+}
+
+func newCollector() *collector {
+	return &collector{flushed: make(chan struct{}, 100)}
+}
+
+func (c *collector) flush(ctx context.Context, batch []int) {
+	c.mu.Lock()
+	c.batches = append(c.batches, append([]int(nil), batch...))
+	c.mu.Unlock()
+	c.flushed <- struct{}{}
+}
+
+func (c *collector) Batches() [][]int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([][]int(nil), c.batches...)
+}
+
+func TestBatcher_Size(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	c := newCollector()
+	batcher := sharedsync.NewBatcher("test", sharedsync.BatcherConfig{
+		MaxBatchSize: 3,
+		MaxDelay:     time.Hour,
+	}, c.flush)
+
+	runCtx, cancel := context.WithCancel(ctx)
+	ctx.Go(func() error { return batcher.Run(runCtx) })
+
+	for i := 0; i < 3; i++ {
+		batcher.Add(i)
+	}
+	<-c.flushed
+	require.Equal(t, [][]int{{0, 1, 2}}, c.Batches())
+
+	batcher.Add(3)
+	require.Equal(t, 1, batcher.Pending())
+
+	// the pending items are flushed on shutdown.
+	cancel()
+	<-c.flushed
+	require.Equal(t, [][]int{{0, 1, 2}, {3}}, c.Batches())
+	require.Zero(t, batcher.Pending())
+}
+
+func TestBatcher_Delay(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	c := newCollector()
+	batcher := sharedsync.NewBatcher("test", sharedsync.BatcherConfig{
+		MaxBatchSize: 100,
+		MaxDelay:     10 * time.Millisecond,
+	}, c.flush)
+
+	runCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	ctx.Go(func() error { return batcher.Run(runCtx) })
+
+	batcher.Add(1)
+	batcher.Add(2)
+	<-c.flushed
+	require.Equal(t, [][]int{{1, 2}}, c.Batches())
+}
+
+func TestBatcher_Flush(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	c := newCollector()
+	batcher := sharedsync.NewBatcher("test", sharedsync.BatcherConfig{MaxBatchSize: 2}, c.flush)
+
+	for i := 0; i < 5; i++ {
+		batcher.Add(i)
+	}
+	batcher.Flush(ctx)
+	require.Equal(t, [][]int{{0, 1}, {2, 3}, {4}}, c.Batches())
+
+	// flushing without pending items doesn't call flush.
+	batcher.Flush(ctx)
+	require.Len(t, c.Batches(), 3)
+}
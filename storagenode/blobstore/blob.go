@@ -143,6 +143,14 @@ type BlobInfo interface {
 	Stat(ctx context.Context) (os.FileInfo, error)
 }
 
+// AllocatedSizer is implemented by blob infos which account the disk space
+// allocated for the blob. It differs from the size of the blob for sparse files
+// and on compressing filesystems.
+type AllocatedSizer interface {
+	// AllocatedSize returns the disk space allocated for the blob.
+	AllocatedSize(ctx context.Context) (int64, error)
+}
+
 // DiskInfo contains information about the disk.
 type DiskInfo struct {
 	TotalSpace     int64
@@ -84,6 +84,7 @@ type blobWriter struct {
 	buffer        *bufio.Writer
 	fh            *os.File
 	sync          bool
+	preallocated  bool
 
 	track leak.Ref
 }
@@ -135,10 +136,25 @@ func (blob *blobWriter) Commit(ctx context.Context) (err error) {
 		return err
 	}
 
+	if blob.preallocated {
+		if err := blob.releasePreallocated(); err != nil {
+			return Error.Wrap(errs.Combine(err, blob.fh.Close(), os.Remove(blob.fh.Name()), blob.track.Close()))
+		}
+	}
+
 	err = blob.store.dir.Commit(ctx, blob.fh, blob.sync, blob.ref, blob.formatVersion)
 	return Error.Wrap(errs.Combine(err, blob.track.Close()))
 }
 
+// releasePreallocated releases the disk space preallocated beyond the end of the file.
+func (blob *blobWriter) releasePreallocated() error {
+	stat, err := blob.fh.Stat()
+	if err != nil {
+		return err
+	}
+	return blob.fh.Truncate(stat.Size())
+}
+
 // Seek flushes any buffer and seeks the underlying file.
 func (blob *blobWriter) Seek(offset int64, whence int) (int64, error) {
 	if err := blob.buffer.Flush(); err != nil {
@@ -162,3 +178,22 @@ func (blob *blobWriter) Size() (int64, error) {
 func (blob *blobWriter) StorageFormatVersion() blobstore.FormatVersion {
 	return blob.formatVersion
 }
+
+// allocatedBlobInfo is a blob info, which also reports the allocated disk space
+// of the blob.
+type allocatedBlobInfo struct {
+	blobstore.BlobInfo
+}
+
+// AllocatedSize returns the disk space allocated for the blob.
+func (info *allocatedBlobInfo) AllocatedSize(ctx context.Context) (int64, error) {
+	stat, err := info.BlobInfo.Stat(ctx)
+	if err != nil {
+		return 0, err
+	}
+	path, err := info.BlobInfo.FullPath(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return allocatedSize(path, stat)
+}
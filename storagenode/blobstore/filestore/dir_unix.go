@@ -7,6 +7,7 @@ package filestore
 
 import (
 	"os"
+	"syscall"
 
 	"golang.org/x/sys/unix"
 
@@ -40,3 +41,14 @@ func rename(oldpath, newpath string) error {
 func openFileReadOnly(path string, perm os.FileMode) (*os.File, error) {
 	return os.OpenFile(path, os.O_RDONLY, perm)
 }
+
+// allocatedSize returns the disk space allocated for the file, which is less than its
+// size for sparse files and on compressing filesystems.
+func allocatedSize(path string, info os.FileInfo) (int64, error) {
+	stat, ok := info.Sys().(*syscall.Stat_t)
+	if !ok {
+		return info.Size(), nil
+	}
+	// st_blocks is always in 512 byte units, regardless of the block size of the filesystem.
+	return int64(stat.Blocks) * 512, nil //nolint: unconvert
+}
@@ -108,3 +108,40 @@ func openFileReadOnly(path string, perm os.FileMode) (*os.File, error) {
 
 	return os.NewFile(uintptr(handle), path), nil
 }
+
+// invalidFileSize is returned by GetCompressedFileSizeW on failure, and when the low
+// part of the size happens to be the same.
+const invalidFileSize = 0xFFFFFFFF
+
+var procGetCompressedFileSizeW = windows.NewLazySystemDLL("kernel32.dll").NewProc("GetCompressedFileSizeW")
+
+// allocatedSize returns the disk space allocated for the file, which is less than its
+// size for sparse and compressed files.
+func allocatedSize(path string, info os.FileInfo) (int64, error) {
+	pathp, err := windows.UTF16PtrFromString(tryFixLongPath(path))
+	if err != nil {
+		return 0, err
+	}
+
+	// See https://learn.microsoft.com/en-us/windows/win32/api/fileapi/nf-fileapi-getcompressedfilesizew
+	var high uint32
+	low, _, err := procGetCompressedFileSizeW.Call(uintptr(unsafe.Pointer(pathp)), uintptr(unsafe.Pointer(&high)))
+	if uint32(low) == invalidFileSize {
+		if err = ignoreSuccess(err); err != nil {
+			return 0, err
+		}
+	}
+	return int64(high)<<32 | int64(uint32(low)), nil
+}
+
+// preallocate reserves disk space for the file without changing its size.
+//
+// SetFileValidData would avoid zeroing the allocated range later, but it requires
+// SE_MANAGE_VOLUME_NAME privilege and exposes the previous contents of the disk,
+// hence only the allocation size is set.
+func preallocate(file *os.File, size int64) error {
+	// See https://learn.microsoft.com/en-us/windows/win32/api/winbase/ns-winbase-file_allocation_info
+	info := struct{ AllocationSize int64 }{AllocationSize: size}
+	return windows.SetFileInformationByHandle(windows.Handle(file.Fd()), windows.FileAllocationInfo,
+		(*byte)(unsafe.Pointer(&info)), uint32(unsafe.Sizeof(info)))
+}
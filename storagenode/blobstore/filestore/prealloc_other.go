@@ -0,0 +1,15 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+//go:build !linux && !windows
+
+package filestore
+
+import (
+	"os"
+)
+
+// preallocate is not supported on this platform.
+func preallocate(file *os.File, size int64) error {
+	return nil
+}
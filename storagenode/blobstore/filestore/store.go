@@ -45,6 +45,8 @@ func MonFileInTrash(namespace []byte) *monkit.Meter {
 type Config struct {
 	WriteBufferSize memory.Size `help:"in-memory buffer for uploads" default:"128KiB"`
 	ForceSync       bool        `help:"if true, force disk synchronization and atomic writes" default:"false"`
+	Preallocate     memory.Size `help:"disk space to preallocate for new blobs to reduce fragmentation, the unused part is released on commit. 0 disables preallocation" default:"0"`
+	SparseAware     bool        `help:"account the disk space allocated for blobs instead of their size, which is different for sparse files and on compressing filesystems" default:"false"`
 }
 
 // DefaultConfig is the default value for Config.
@@ -119,14 +121,14 @@ func (store *blobStore) OpenWithStorageFormat(ctx context.Context, blobRef blobs
 func (store *blobStore) Stat(ctx context.Context, ref blobstore.BlobRef) (_ blobstore.BlobInfo, err error) {
 	defer mon.Task()(&ctx)(&err)
 	info, err := store.dir.Stat(ctx, ref)
-	return info, Error.Wrap(err)
+	return store.blobInfo(info), Error.Wrap(err)
 }
 
 // StatWithStorageFormat looks up disk metadata on the blob file with the given storage format version.
 func (store *blobStore) StatWithStorageFormat(ctx context.Context, ref blobstore.BlobRef, formatVer blobstore.FormatVersion) (_ blobstore.BlobInfo, err error) {
 	defer mon.Task()(&ctx)(&err)
 	info, err := store.dir.StatWithStorageFormat(ctx, ref, formatVer)
-	return info, Error.Wrap(err)
+	return store.blobInfo(info), Error.Wrap(err)
 }
 
 // Delete deletes blobs with the specified ref.
@@ -205,7 +207,38 @@ func (store *blobStore) Create(ctx context.Context, ref blobstore.BlobRef) (_ bl
 	if err != nil {
 		return nil, Error.Wrap(err)
 	}
-	return newBlobWriter(store.track.Child("blobWriter", 1), ref, store, MaxFormatVersionSupported, file, store.config.WriteBufferSize.Int(), store.config.ForceSync), nil
+	writer := newBlobWriter(store.track.Child("blobWriter", 1), ref, store, MaxFormatVersionSupported, file, store.config.WriteBufferSize.Int(), store.config.ForceSync)
+	if store.config.Preallocate > 0 {
+		// preallocation is only an optimization, not every filesystem supports it.
+		if err := preallocate(file, store.config.Preallocate.Int64()); err != nil {
+			store.log.Debug("failed to preallocate blob", zap.Stringer("Size", store.config.Preallocate), zap.Error(err))
+		} else {
+			writer.preallocated = true
+		}
+	}
+	return writer, nil
+}
+
+// blobInfo returns the info that also reports the allocated space of the blob
+// when sparse aware accounting is enabled.
+func (store *blobStore) blobInfo(info blobstore.BlobInfo) blobstore.BlobInfo {
+	if info == nil || !store.config.SparseAware {
+		return info
+	}
+	return &allocatedBlobInfo{BlobInfo: info}
+}
+
+// diskUsage returns the allocated disk space of the blob when it's known,
+// otherwise its size.
+func diskUsage(ctx context.Context, info blobstore.BlobInfo) (int64, error) {
+	if sizer, ok := info.(blobstore.AllocatedSizer); ok {
+		return sizer.AllocatedSize(ctx)
+	}
+	stat, err := info.Stat(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return stat.Size(), nil
 }
 
 // SpaceUsedForBlobs adds up the space used in all namespaces for blob storage.
@@ -231,13 +264,13 @@ func (store *blobStore) SpaceUsedForBlobs(ctx context.Context) (space int64, err
 func (store *blobStore) SpaceUsedForBlobsInNamespace(ctx context.Context, namespace []byte) (int64, error) {
 	var totalUsed int64
 	err := store.WalkNamespace(ctx, namespace, "", func(info blobstore.BlobInfo) error {
-		statInfo, statErr := info.Stat(ctx)
+		size, statErr := diskUsage(ctx, info)
 		if statErr != nil {
 			store.log.Error("failed to stat blob", zap.Binary("namespace", namespace), zap.Binary("key", info.BlobRef().Key), zap.Error(statErr))
 			// keep iterating; we want a best effort total here.
 			return nil
 		}
-		totalUsed += statInfo.Size()
+		totalUsed += size
 		return nil
 	})
 	if err != nil {
@@ -269,7 +302,7 @@ func (store *blobStore) SpaceUsedForTrash(ctx context.Context) (total int64, err
 func (store *blobStore) SpaceUsedForBlobsInNamespaceInTrash(ctx context.Context, namespace []byte) (int64, error) {
 	var totalUsed int64
 	err := store.walkNamespaceInTrash(ctx, namespace, func(info blobstore.BlobInfo, dirTime time.Time) error {
-		statInfo, statErr := info.Stat(ctx)
+		size, statErr := diskUsage(ctx, info)
 		if statErr != nil {
 			store.log.Error("failed to stat blob in trash",
 				zap.Binary("namespace", namespace),
@@ -278,7 +311,7 @@ func (store *blobStore) SpaceUsedForBlobsInNamespaceInTrash(ctx context.Context,
 			// keep iterating; we want a best effort total here.
 			return nil
 		}
-		totalUsed += statInfo.Size()
+		totalUsed += size
 		return nil
 	})
 	if err != nil {
@@ -314,7 +347,9 @@ func (store *blobStore) ListNamespaces(ctx context.Context) (ids [][]byte, err e
 // returns a non-nil error, WalkNamespace will stop iterating and return the error immediately. The
 // ctx parameter is intended specifically to allow canceling iteration early.
 func (store *blobStore) WalkNamespace(ctx context.Context, namespace []byte, startFromPrefix string, walkFunc func(blobstore.BlobInfo) error) (err error) {
-	return store.dir.WalkNamespace(ctx, namespace, startFromPrefix, walkFunc)
+	return store.dir.WalkNamespace(ctx, namespace, startFromPrefix, func(info blobstore.BlobInfo) error {
+		return walkFunc(store.blobInfo(info))
+	})
 }
 
 // walkNamespaceInTrash executes walkFunc for each blob stored in the trash under the given
@@ -322,7 +357,9 @@ func (store *blobStore) WalkNamespace(ctx context.Context, namespace []byte, sta
 // return the error immediately. The ctx parameter is intended specifically to allow canceling
 // iteration early.
 func (store *blobStore) walkNamespaceInTrash(ctx context.Context, namespace []byte, walkFunc func(info blobstore.BlobInfo, dirTime time.Time) error) error {
-	return store.dir.walkNamespaceInTrash(ctx, namespace, walkFunc)
+	return store.dir.walkNamespaceInTrash(ctx, namespace, func(info blobstore.BlobInfo, dirTime time.Time) error {
+		return walkFunc(store.blobInfo(info), dirTime)
+	})
 }
 
 // TestCreateV0 creates a new V0 blob that can be written. This is ONLY appropriate in test situations.
@@ -10,6 +10,7 @@ import (
 	"math/rand"
 	"os"
 	"path/filepath"
+	"runtime"
 	"sort"
 	"testing"
 	"time"
@@ -363,6 +364,63 @@ func TestStoreSpaceUsed(t *testing.T) {
 	}
 }
 
+func TestStoreSparseAwareSpaceUsed(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("files are not sparse on windows by default")
+	}
+
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	config := filestore.DefaultConfig
+	config.Preallocate = 4 * memory.MiB
+	config.SparseAware = true
+
+	store, err := filestore.NewAt(zaptest.NewLogger(t), ctx.Dir("store"), config)
+	require.NoError(t, err)
+	defer ctx.Check(store.Close)
+
+	namespace := testrand.Bytes(namespaceSize)
+	blobRef := blobstore.BlobRef{Namespace: namespace, Key: testrand.Bytes(keySize)}
+
+	// write a blob with a hole in the middle.
+	blobWriter, err := store.Create(ctx, blobRef)
+	require.NoError(t, err)
+	_, err = blobWriter.Write([]byte{1})
+	require.NoError(t, err)
+	_, err = blobWriter.Seek(memory.MiB.Int64(), io.SeekStart)
+	require.NoError(t, err)
+	_, err = blobWriter.Write([]byte{1})
+	require.NoError(t, err)
+	require.NoError(t, blobWriter.Commit(ctx))
+
+	reader, err := store.Open(ctx, blobRef)
+	require.NoError(t, err)
+	size, err := reader.Size()
+	require.NoError(t, err)
+	require.NoError(t, reader.Close())
+	require.Equal(t, memory.MiB.Int64()+1, size)
+
+	// neither the hole nor the unused preallocation is accounted.
+	spaceUsed, err := store.SpaceUsedForBlobsInNamespace(ctx, namespace)
+	require.NoError(t, err)
+	require.Greater(t, spaceUsed, int64(0))
+	require.Less(t, spaceUsed, memory.MiB.Int64())
+
+	// the size of the blob itself is not affected.
+	info, err := store.Stat(ctx, blobRef)
+	require.NoError(t, err)
+	stat, err := info.Stat(ctx)
+	require.NoError(t, err)
+	require.Equal(t, memory.MiB.Int64()+1, stat.Size())
+
+	sizer, ok := info.(blobstore.AllocatedSizer)
+	require.True(t, ok)
+	allocated, err := sizer.AllocatedSize(ctx)
+	require.NoError(t, err)
+	require.Equal(t, spaceUsed, allocated)
+}
+
 // Check that ListNamespaces and WalkNamespace work as expected.
 func TestStoreTraversals(t *testing.T) {
 	ctx := testcontext.New(t)
@@ -13,6 +13,7 @@ import (
 	"go.uber.org/zap"
 
 	"storj.io/common/sync2"
+	"storj.io/storj/storagenode/evidence"
 	"storj.io/storj/storagenode/pieces"
 	"storj.io/storj/storagenode/piecestore/usedserials"
 )
@@ -31,16 +32,18 @@ type Service struct {
 	log         *zap.Logger
 	pieces      *pieces.Store
 	usedSerials *usedserials.Table
+	evidence    *evidence.Mode
 
 	Loop *sync2.Cycle
 }
 
 // NewService creates a new collector service.
-func NewService(log *zap.Logger, pieces *pieces.Store, usedSerials *usedserials.Table, config Config) *Service {
+func NewService(log *zap.Logger, pieces *pieces.Store, usedSerials *usedserials.Table, evidence *evidence.Mode, config Config) *Service {
 	return &Service{
 		log:         log,
 		pieces:      pieces,
 		usedSerials: usedSerials,
+		evidence:    evidence,
 		Loop:        sync2.NewCycle(config.Interval),
 	}
 }
@@ -73,6 +76,11 @@ func (service *Service) Collect(ctx context.Context, now time.Time) (err error)
 
 	service.usedSerials.DeleteExpired(now)
 
+	if service.evidence.Frozen() {
+		service.log.Info("collecting expired pieces skipped, evidence mode is enabled")
+		return nil
+	}
+
 	var count int64
 	defer func() {
 		if count > 0 {
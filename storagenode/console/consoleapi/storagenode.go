@@ -14,6 +14,7 @@ import (
 
 	"storj.io/common/storj"
 	"storj.io/storj/storagenode/console"
+	"storj.io/storj/storagenode/evidence"
 )
 
 // ErrStorageNodeAPI - console storagenode api error type.
@@ -187,6 +188,104 @@ func (dashboard *StorageNode) Pricing(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// EvidenceMode returns the state of evidence mode.
+func (dashboard *StorageNode) EvidenceMode(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set(contentType, applicationJSON)
+
+	state, err := dashboard.service.GetEvidenceMode(ctx)
+	if err != nil {
+		dashboard.serveJSONError(w, http.StatusInternalServerError, ErrStorageNodeAPI.Wrap(err))
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(state); err != nil {
+		dashboard.log.Error("failed to encode json response", zap.Error(ErrStorageNodeAPI.Wrap(err)))
+		return
+	}
+}
+
+// PiecePopularity returns the approximate download popularity distribution of the stored pieces.
+func (dashboard *StorageNode) PiecePopularity(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set(contentType, applicationJSON)
+
+	distribution, err := dashboard.service.GetPiecePopularity(ctx)
+	if err != nil {
+		dashboard.serveJSONError(w, http.StatusInternalServerError, ErrStorageNodeAPI.Wrap(err))
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(distribution); err != nil {
+		dashboard.log.Error("failed to encode json response", zap.Error(ErrStorageNodeAPI.Wrap(err)))
+		return
+	}
+}
+
+// EnableEvidenceMode freezes the stored pieces. The request body contains an
+// optional duration, e.g. "72h", and the reason for enabling it.
+func (dashboard *StorageNode) EnableEvidenceMode(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set(contentType, applicationJSON)
+
+	var request struct {
+		Duration string `json:"duration"`
+		Reason   string `json:"reason"`
+	}
+	if err = json.NewDecoder(r.Body).Decode(&request); err != nil {
+		dashboard.serveJSONError(w, http.StatusBadRequest, ErrStorageNodeAPI.Wrap(err))
+		return
+	}
+
+	var duration time.Duration
+	if request.Duration != "" {
+		duration, err = time.ParseDuration(request.Duration)
+		if err != nil {
+			dashboard.serveJSONError(w, http.StatusBadRequest, ErrStorageNodeAPI.Wrap(err))
+			return
+		}
+	}
+
+	state, err := dashboard.service.EnableEvidenceMode(ctx, duration, request.Reason)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if evidence.Error.Has(err) {
+			status = http.StatusBadRequest
+		}
+		dashboard.serveJSONError(w, status, ErrStorageNodeAPI.Wrap(err))
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(state); err != nil {
+		dashboard.log.Error("failed to encode json response", zap.Error(ErrStorageNodeAPI.Wrap(err)))
+		return
+	}
+}
+
+// DisableEvidenceMode unfreezes the stored pieces.
+func (dashboard *StorageNode) DisableEvidenceMode(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	if err = dashboard.service.DisableEvidenceMode(ctx); err != nil {
+		w.Header().Set(contentType, applicationJSON)
+		dashboard.serveJSONError(w, http.StatusInternalServerError, ErrStorageNodeAPI.Wrap(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
 // serveJSONError writes JSON error to response output stream.
 func (dashboard *StorageNode) serveJSONError(w http.ResponseWriter, status int, err error) {
 	w.WriteHeader(status)
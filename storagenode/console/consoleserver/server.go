@@ -74,6 +74,10 @@ func NewServer(logger *zap.Logger, assets fs.FS, notifications *notifications.Se
 	storageNodeRouter.HandleFunc("/satellite/{id}", storageNodeController.Satellite).Methods(http.MethodGet)
 	storageNodeRouter.HandleFunc("/satellites/{id}/pricing", storageNodeController.Pricing).Methods(http.MethodGet)
 	storageNodeRouter.HandleFunc("/estimated-payout", storageNodeController.EstimatedPayout).Methods(http.MethodGet)
+	storageNodeRouter.HandleFunc("/evidence-mode", storageNodeController.EvidenceMode).Methods(http.MethodGet)
+	storageNodeRouter.HandleFunc("/evidence-mode", storageNodeController.EnableEvidenceMode).Methods(http.MethodPost)
+	storageNodeRouter.HandleFunc("/evidence-mode", storageNodeController.DisableEvidenceMode).Methods(http.MethodDelete)
+	storageNodeRouter.HandleFunc("/piece-popularity", storageNodeController.PiecePopularity).Methods(http.MethodGet)
 
 	notificationController := consoleapi.NewNotifications(server.log, server.notifications)
 	notificationRouter := router.PathPrefix("/api/notifications").Subrouter()
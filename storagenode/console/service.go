@@ -19,9 +19,11 @@ import (
 	"storj.io/storj/private/version/checker"
 	"storj.io/storj/storagenode/bandwidth"
 	"storj.io/storj/storagenode/contact"
+	"storj.io/storj/storagenode/evidence"
 	"storj.io/storj/storagenode/operator"
 	"storj.io/storj/storagenode/payouts/estimatedpayouts"
 	"storj.io/storj/storagenode/pieces"
+	"storj.io/storj/storagenode/popularity"
 	"storj.io/storj/storagenode/pricing"
 	"storj.io/storj/storagenode/reputation"
 	"storj.io/storj/storagenode/satellites"
@@ -65,6 +67,9 @@ type Service struct {
 
 	quicStats      *contact.QUICStats
 	configuredPort string
+
+	evidence   *evidence.Mode
+	popularity *popularity.Tracker
 }
 
 // NewService returns new instance of Service.
@@ -72,7 +77,8 @@ func NewService(log *zap.Logger, bandwidth bandwidth.DB, pieceStore *pieces.Stor
 	allocatedDiskSpace memory.Size, walletAddress string, versionInfo version.Info, trust *trust.Pool,
 	reputationDB reputation.DB, storageUsageDB storageusage.DB, pricingDB pricing.DB, satelliteDB satellites.DB,
 	pingStats *contact.PingStats, contact *contact.Service, estimation *estimatedpayouts.Service, usageCache *pieces.BlobsUsageCache,
-	walletFeatures operator.WalletFeatures, port string, quicStats *contact.QUICStats) (*Service, error) {
+	walletFeatures operator.WalletFeatures, port string, quicStats *contact.QUICStats, evidence *evidence.Mode,
+	popularity *popularity.Tracker) (*Service, error) {
 	if log == nil {
 		return nil, errs.New("log can't be nil")
 	}
@@ -126,6 +132,8 @@ func NewService(log *zap.Logger, bandwidth bandwidth.DB, pieceStore *pieces.Stor
 		walletFeatures:     walletFeatures,
 		quicStats:          quicStats,
 		configuredPort:     port,
+		evidence:           evidence,
+		popularity:         popularity,
 	}, nil
 }
 
@@ -136,6 +144,9 @@ type SatelliteInfo struct {
 	Disqualified       *time.Time   `json:"disqualified"`
 	Suspended          *time.Time   `json:"suspended"`
 	CurrentStorageUsed int64        `json:"currentStorageUsed"`
+	// RetainPercentComplete is the progress of the garbage collection, which
+	// is being applied or will be resumed. It's nil when there is none.
+	RetainPercentComplete *float64 `json:"retainPercentComplete"`
 }
 
 // Dashboard encapsulates dashboard stale data.
@@ -160,6 +171,8 @@ type Dashboard struct {
 	ConfiguredPort   string    `json:"configuredPort"`
 	QUICStatus       string    `json:"quicStatus"`
 	LastQUICPingedAt time.Time `json:"lastQuicPingedAt"`
+
+	EvidenceMode evidence.State `json:"evidenceMode"`
 }
 
 // GetDashboardData returns stale dashboard data.
@@ -178,6 +191,7 @@ func (s *Service) GetDashboardData(ctx context.Context) (_ *Dashboard, err error
 
 	data.QUICStatus = s.quicStats.Status()
 	data.LastQUICPingedAt = s.quicStats.WhenLastPinged()
+	data.EvidenceMode = s.evidence.State()
 	data.ConfiguredPort = s.configuredPort
 
 	stats, err := s.reputationDB.All(ctx)
@@ -199,13 +213,26 @@ func (s *Service) GetDashboardData(ctx context.Context) (_ *Dashboard, err error
 			continue
 		}
 
+		var retainPercentComplete *float64
+		if s.pieceStore.Filewalker != nil {
+			progress, ok, err := s.pieceStore.Filewalker.GCProgress(ctx, rep.SatelliteID)
+			if err != nil {
+				s.log.Warn("unable to get Satellite retain progress", zap.String("Satellite ID", rep.SatelliteID.String()),
+					zap.Error(SNOServiceErr.Wrap(err)))
+			} else if ok {
+				percent := progress.PercentComplete()
+				retainPercentComplete = &percent
+			}
+		}
+
 		data.Satellites = append(data.Satellites,
 			SatelliteInfo{
-				ID:                 rep.SatelliteID,
-				Disqualified:       rep.DisqualifiedAt,
-				Suspended:          rep.SuspendedAt,
-				URL:                url.Address,
-				CurrentStorageUsed: currentStorageUsed,
+				ID:                    rep.SatelliteID,
+				Disqualified:          rep.DisqualifiedAt,
+				Suspended:             rep.SuspendedAt,
+				URL:                   url.Address,
+				CurrentStorageUsed:    currentStorageUsed,
+				RetainPercentComplete: retainPercentComplete,
 			},
 		)
 	}
@@ -508,3 +535,38 @@ func (s *Service) GetSatellitePricingModel(ctx context.Context, satelliteID stor
 
 	return pricingModel, nil
 }
+
+// GetEvidenceMode returns the state of evidence mode.
+func (s *Service) GetEvidenceMode(ctx context.Context) (_ evidence.State, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return s.evidence.State(), nil
+}
+
+// EnableEvidenceMode freezes the stored pieces for duration.
+func (s *Service) EnableEvidenceMode(ctx context.Context, duration time.Duration, reason string) (_ evidence.State, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if s.evidence == nil {
+		return evidence.State{}, SNOServiceErr.New("evidence mode is not available")
+	}
+
+	state, err := s.evidence.Enable(ctx, duration, reason)
+	if err != nil {
+		return evidence.State{}, SNOServiceErr.Wrap(err)
+	}
+	return state, nil
+}
+
+// GetPiecePopularity returns the approximate download popularity distribution of the pieces.
+func (s *Service) GetPiecePopularity(ctx context.Context) (_ popularity.Distribution, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return s.popularity.Distribution(), nil
+}
+
+// DisableEvidenceMode unfreezes the stored pieces.
+func (s *Service) DisableEvidenceMode(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	if s.evidence == nil {
+		return nil
+	}
+	return SNOServiceErr.Wrap(s.evidence.Disable(ctx))
+}
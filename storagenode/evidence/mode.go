@@ -0,0 +1,198 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package evidence implements the evidence mode of a storage node, which
+// freezes the stored data for forensic preservation.
+package evidence
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+)
+
+var (
+	mon = monkit.Package()
+
+	// Error is the default error class for evidence mode.
+	Error = errs.Class("evidence mode")
+	// ErrFrozen is returned when a mutation is refused because evidence mode is enabled.
+	ErrFrozen = errs.Class("storage node is in evidence mode")
+)
+
+// stateFile is the name of the file, in the storage directory, which keeps
+// evidence mode enabled across restarts.
+const stateFile = "evidence-mode.json"
+
+// Config defines the evidence mode limits.
+type Config struct {
+	DefaultDuration time.Duration `help:"how long evidence mode stays enabled when no duration is requested" default:"72h0m0s"`
+	MaxDuration     time.Duration `help:"the longest duration evidence mode can be enabled for" default:"720h0m0s"`
+}
+
+// State describes whether evidence mode is enabled.
+type State struct {
+	Enabled   bool      `json:"enabled"`
+	Reason    string    `json:"reason,omitempty"`
+	EnabledAt time.Time `json:"enabledAt,omitempty"`
+	ExpiresAt time.Time `json:"expiresAt,omitempty"`
+}
+
+// Mode freezes all mutations of the stored pieces while it is enabled: uploads,
+// deletes, garbage collection, trash emptying and restoring, and collecting
+// expired pieces. Downloads keep being served. It is disabled automatically
+// when it expires.
+//
+// A nil Mode is never enabled.
+type Mode struct {
+	log    *zap.Logger
+	config Config
+	path   string
+
+	nowFn func() time.Time
+
+	mu    sync.Mutex
+	state State
+}
+
+// NewMode creates the evidence mode, restoring the persisted state from dir.
+func NewMode(log *zap.Logger, config Config, dir string) (*Mode, error) {
+	mode := &Mode{
+		log:    log,
+		config: config,
+		path:   filepath.Join(dir, stateFile),
+		nowFn:  time.Now,
+	}
+
+	data, err := os.ReadFile(mode.path)
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+		return mode, nil
+	case err != nil:
+		return nil, Error.Wrap(err)
+	}
+
+	if err := json.Unmarshal(data, &mode.state); err != nil {
+		return nil, Error.New("invalid state file %q: %v", mode.path, err)
+	}
+	if mode.state.Enabled {
+		log.Warn("evidence mode is enabled, stored pieces are frozen",
+			zap.String("Reason", mode.state.Reason),
+			zap.Time("Expires At", mode.state.ExpiresAt))
+	}
+	return mode, nil
+}
+
+// TestingSetNow allows tests to have the mode act as if the current time is whatever they want.
+func (mode *Mode) TestingSetNow(nowFn func() time.Time) {
+	mode.mu.Lock()
+	defer mode.mu.Unlock()
+	mode.nowFn = nowFn
+}
+
+// Enable freezes the stored pieces for duration. A zero duration uses the
+// configured default. Enabling an already enabled mode replaces its expiry.
+func (mode *Mode) Enable(ctx context.Context, duration time.Duration, reason string) (_ State, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if duration == 0 {
+		duration = mode.config.DefaultDuration
+	}
+	if duration < 0 || duration > mode.config.MaxDuration {
+		return State{}, Error.New("duration must be between 0 and %s", mode.config.MaxDuration)
+	}
+
+	mode.mu.Lock()
+	defer mode.mu.Unlock()
+
+	now := mode.nowFn()
+	state := State{
+		Enabled:   true,
+		Reason:    reason,
+		EnabledAt: now,
+		ExpiresAt: now.Add(duration),
+	}
+	if mode.state.Enabled && now.Before(mode.state.ExpiresAt) {
+		state.EnabledAt = mode.state.EnabledAt
+	}
+
+	data, err := json.Marshal(state)
+	if err != nil {
+		return State{}, Error.Wrap(err)
+	}
+	if err := os.WriteFile(mode.path, data, 0600); err != nil {
+		return State{}, Error.Wrap(err)
+	}
+
+	mode.state = state
+	mode.log.Warn("evidence mode enabled",
+		zap.String("Reason", reason),
+		zap.Time("Expires At", state.ExpiresAt))
+	return state, nil
+}
+
+// Disable unfreezes the stored pieces.
+func (mode *Mode) Disable(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	mode.mu.Lock()
+	defer mode.mu.Unlock()
+
+	if err := mode.disable(); err != nil {
+		return err
+	}
+	mode.log.Info("evidence mode disabled")
+	return nil
+}
+
+func (mode *Mode) disable() error {
+	err := os.Remove(mode.path)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return Error.Wrap(err)
+	}
+	mode.state = State{}
+	return nil
+}
+
+// State returns the current state.
+func (mode *Mode) State() State {
+	if mode == nil {
+		return State{}
+	}
+
+	mode.mu.Lock()
+	defer mode.mu.Unlock()
+
+	if mode.state.Enabled && !mode.nowFn().Before(mode.state.ExpiresAt) {
+		if err := mode.disable(); err != nil {
+			// keep the stored pieces frozen until the state can be cleared.
+			mode.log.Error("unable to disable expired evidence mode", zap.Error(err))
+			return mode.state
+		}
+		mode.log.Info("evidence mode expired")
+	}
+	return mode.state
+}
+
+// Frozen returns whether the stored pieces must not be mutated.
+func (mode *Mode) Frozen() bool {
+	return mode.State().Enabled
+}
+
+// Check returns ErrFrozen when the stored pieces must not be mutated.
+func (mode *Mode) Check() error {
+	if mode.Frozen() {
+		mon.Event("evidence_mode_mutation_refused")
+		return ErrFrozen.New("mutations are refused")
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package evidence_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/testcontext"
+	"storj.io/storj/storagenode/evidence"
+)
+
+func TestMode(t *testing.T) {
+	ctx := testcontext.New(t)
+	log := zaptest.NewLogger(t)
+
+	config := evidence.Config{
+		DefaultDuration: time.Hour,
+		MaxDuration:     24 * time.Hour,
+	}
+
+	now := time.Now()
+	nowFn := func() time.Time { return now }
+
+	mode, err := evidence.NewMode(log, config, ctx.Dir())
+	require.NoError(t, err)
+	mode.TestingSetNow(nowFn)
+
+	require.False(t, mode.Frozen())
+	require.NoError(t, mode.Check())
+
+	_, err = mode.Enable(ctx, 48*time.Hour, "too long")
+	require.Error(t, err)
+	require.False(t, mode.Frozen())
+
+	state, err := mode.Enable(ctx, 0, "investigation")
+	require.NoError(t, err)
+	require.True(t, state.Enabled)
+	require.Equal(t, "investigation", state.Reason)
+	require.WithinDuration(t, now.Add(time.Hour), state.ExpiresAt, time.Second)
+	require.True(t, mode.Frozen())
+	require.True(t, evidence.ErrFrozen.Has(mode.Check()))
+
+	// the state is kept across restarts.
+	reloaded, err := evidence.NewMode(log, config, ctx.Dir())
+	require.NoError(t, err)
+	reloaded.TestingSetNow(nowFn)
+	require.True(t, reloaded.Frozen())
+	require.Equal(t, "investigation", reloaded.State().Reason)
+
+	// it is disabled automatically when it expires.
+	now = now.Add(2 * time.Hour)
+	require.False(t, reloaded.Frozen())
+
+	reloaded, err = evidence.NewMode(log, config, ctx.Dir())
+	require.NoError(t, err)
+	require.False(t, reloaded.Frozen())
+
+	_, err = mode.Enable(ctx, 2*time.Hour, "second investigation")
+	require.NoError(t, err)
+	require.True(t, mode.Frozen())
+
+	require.NoError(t, mode.Disable(ctx))
+	require.False(t, mode.Frozen())
+
+	reloaded, err = evidence.NewMode(log, config, ctx.Dir())
+	require.NoError(t, err)
+	require.False(t, reloaded.Frozen())
+}
+
+func TestMode_Nil(t *testing.T) {
+	var mode *evidence.Mode
+	require.False(t, mode.Frozen())
+	require.NoError(t, mode.Check())
+}
@@ -40,6 +40,7 @@ import (
 	"storj.io/storj/storagenode/console"
 	"storj.io/storj/storagenode/console/consoleserver"
 	"storj.io/storj/storagenode/contact"
+	"storj.io/storj/storagenode/evidence"
 	"storj.io/storj/storagenode/forgetsatellite"
 	"storj.io/storj/storagenode/gracefulexit"
 	"storj.io/storj/storagenode/healthcheck"
@@ -57,6 +58,7 @@ import (
 	"storj.io/storj/storagenode/pieces/lazyfilewalker"
 	"storj.io/storj/storagenode/piecestore"
 	"storj.io/storj/storagenode/piecestore/usedserials"
+	"storj.io/storj/storagenode/popularity"
 	"storj.io/storj/storagenode/preflight"
 	"storj.io/storj/storagenode/pricing"
 	"storj.io/storj/storagenode/reputation"
@@ -128,6 +130,10 @@ type Config struct {
 
 	Retain retain.Config
 
+	Evidence evidence.Config
+
+	Popularity popularity.Config
+
 	Nodestats nodestats.Config
 
 	Console consoleserver.Config
@@ -258,6 +264,8 @@ type Peer struct {
 		BlobsCache     *pieces.BlobsUsageCache
 		CacheService   *pieces.CacheService
 		RetainService  *retain.Service
+		Evidence       *evidence.Mode
+		Popularity     *popularity.Tracker
 		PieceDeleter   *pieces.Deleter
 		Endpoint       *piecestore.Endpoint
 		Inspector      *inspector.Endpoint
@@ -508,6 +516,11 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 			peer.Storage2.LazyFileWalker = lazyfilewalker.NewSupervisor(process.NamedLog(peer.Log, "lazyfilewalker"), db.Config().LazyFilewalkerConfig(), executable)
 		}
 
+		peer.Storage2.Evidence, err = evidence.NewMode(process.NamedLog(peer.Log, "evidence"), config.Evidence, config.Storage.Path)
+		if err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
+
 		peer.Storage2.Store = pieces.NewStore(process.NamedLog(peer.Log, "pieces"),
 			peer.Storage2.FileWalker,
 			peer.Storage2.LazyFileWalker,
@@ -531,6 +544,7 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 			7*24*time.Hour, // trashExpiryInterval: when items in the trash should be deleted
 			peer.Storage2.Trust,
 			peer.Storage2.Store,
+			peer.Storage2.Evidence,
 		)
 		peer.Services.Add(lifecycle.Item{
 			Name:  "pieces:trash",
@@ -574,6 +588,7 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 		peer.Storage2.RetainService = retain.NewService(
 			process.NamedLog(peer.Log, "retain"),
 			peer.Storage2.Store,
+			peer.Storage2.Evidence,
 			config.Retain,
 		)
 
@@ -583,6 +598,18 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 			Close: peer.Storage2.RetainService.Close,
 		})
 
+		if config.Popularity.Enabled {
+			peer.Storage2.Popularity = popularity.NewTracker(process.NamedLog(peer.Log, "popularity"), config.Popularity)
+			peer.Services.Add(lifecycle.Item{
+				Name:  "popularity",
+				Run:   peer.Storage2.Popularity.Run,
+				Close: peer.Storage2.Popularity.Close,
+			})
+			peer.Debug.Server.Panel.Add(
+				debug.Cycle("Piece Popularity Decay", peer.Storage2.Popularity.Loop))
+			mon.Chain(peer.Storage2.Popularity)
+		}
+
 		peer.UsedSerials = usedserials.NewTable(config.Storage2.MaxUsedSerialsSize)
 
 		peer.OrdersStore, err = orders.NewFileStore(
@@ -611,6 +638,8 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 			peer.OrdersStore,
 			peer.Bandwidth.Cache,
 			peer.UsedSerials,
+			peer.Storage2.Evidence,
+			peer.Storage2.Popularity,
 			config.Storage2,
 		)
 		if err != nil {
@@ -747,6 +776,8 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 			config.Operator.WalletFeatures,
 			port,
 			peer.Contact.QUICStats,
+			peer.Storage2.Evidence,
+			peer.Storage2.Popularity,
 		)
 		if err != nil {
 			return nil, errs.Combine(err, peer.Close())
@@ -880,7 +911,7 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 			debug.Cycle("Forget Satellite", peer.ForgetSatellite.Chore.Loop))
 	}
 
-	peer.Collector = collector.NewService(process.NamedLog(peer.Log, "collector"), peer.Storage2.Store, peer.UsedSerials, config.Collector)
+	peer.Collector = collector.NewService(process.NamedLog(peer.Log, "collector"), peer.Storage2.Store, peer.UsedSerials, peer.Storage2.Evidence, config.Collector)
 	peer.Services.Add(lifecycle.Item{
 		Name:  "collector",
 		Run:   peer.Collector.Run,
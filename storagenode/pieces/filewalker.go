@@ -153,7 +153,7 @@ func (fw *FileWalker) WalkSatellitePiecesToTrash(ctx context.Context, satelliteI
 	if fw.gcProgressDB != nil {
 		progress, progressErr := fw.gcProgressDB.Get(ctx, satelliteID)
 		if progressErr != nil && !errs.Is(progressErr, sql.ErrNoRows) {
-			fw.log.Error("failed to get progress from database", zap.Error(err))
+			fw.log.Error("failed to get progress from database", zap.Error(progressErr))
 		}
 		curPrefix = progress.Prefix
 
@@ -167,6 +167,13 @@ func (fw *FileWalker) WalkSatellitePiecesToTrash(ctx context.Context, satelliteI
 			curPrefix = ""
 		}
 
+		if curPrefix != "" {
+			fw.log.Info("resuming retain from the last checkpoint",
+				zap.Stringer("Satellite ID", satelliteID),
+				zap.String("Prefix", curPrefix),
+				zap.Float64("Percent Complete", progress.PercentComplete()))
+		}
+
 		defer func() {
 			if err == nil { // reset progress if completed successfully
 				fw.log.Debug("resetting progress in database")
@@ -178,6 +185,7 @@ func (fw *FileWalker) WalkSatellitePiecesToTrash(ctx context.Context, satelliteI
 		}()
 	}
 
+	lastLoggedPercent := 0
 	err = fw.WalkSatellitePieces(ctx, satelliteID, curPrefix, func(access StoredPieceAccess) error {
 		piecesCount++
 
@@ -188,15 +196,28 @@ func (fw *FileWalker) WalkSatellitePiecesToTrash(ctx context.Context, satelliteI
 		if fw.gcProgressDB != nil {
 			keyPrefix := filestore.PathEncoding.EncodeToString(access.BlobRef().Key)[:2]
 			if keyPrefix != "" && keyPrefix != curPrefix {
-				err := fw.gcProgressDB.Store(ctx, GCFilewalkerProgress{
+				progress := GCFilewalkerProgress{
 					Prefix:                   keyPrefix,
 					SatelliteID:              satelliteID,
 					BloomfilterCreatedBefore: createdBefore,
-				})
+				}
+				err := fw.gcProgressDB.Store(ctx, progress)
 				if err != nil {
 					fw.log.Error("failed to save progress in the database", zap.Error(err))
 				}
 				curPrefix = keyPrefix
+
+				// log every 10% of the walk, there are 1024 prefixes.
+				percent := progress.PercentComplete()
+				mon.FloatVal("retain_percent_complete").Observe(percent)
+				if int(percent)/10 != lastLoggedPercent/10 {
+					lastLoggedPercent = int(percent)
+					fw.log.Info("retain progress",
+						zap.Stringer("Satellite ID", satelliteID),
+						zap.String("Prefix", keyPrefix),
+						zap.Float64("Percent Complete", percent),
+						zap.Int64("Pieces count", piecesCount))
+				}
 			}
 		}
 
@@ -247,6 +268,25 @@ func (fw *FileWalker) WalkSatellitePiecesToTrash(ctx context.Context, satelliteI
 	return pieceIDs, piecesCount, piecesSkipped, errFileWalker.Wrap(err)
 }
 
+// GCProgress returns the last checkpoint of the garbage collection of the satellite.
+// ok is false, when there is no garbage collection to resume.
+func (fw *FileWalker) GCProgress(ctx context.Context, satelliteID storj.NodeID) (progress GCFilewalkerProgress, ok bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if fw.gcProgressDB == nil {
+		return GCFilewalkerProgress{}, false, nil
+	}
+
+	progress, err = fw.gcProgressDB.Get(ctx, satelliteID)
+	if err != nil {
+		if errs.Is(err, sql.ErrNoRows) {
+			return GCFilewalkerProgress{}, false, nil
+		}
+		return GCFilewalkerProgress{}, false, errFileWalker.Wrap(err)
+	}
+	return progress, progress.Prefix != "", nil
+}
+
 // WalkCleanupTrash looks at all trash per-day directories owned by the given satellite and
 // recursively deletes any of them that correspond to a time before the given dateBefore.
 //
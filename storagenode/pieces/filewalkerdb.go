@@ -5,6 +5,7 @@ package pieces
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	"storj.io/common/storj"
@@ -36,6 +37,24 @@ type GCFilewalkerProgress struct {
 	BloomfilterCreatedBefore time.Time
 }
 
+// prefixOrder is the order, in which the filewalker walks the two character key
+// prefix directories, see filestore.PathEncoding.
+const prefixOrder = "abcdefghijklmnopqrstuvwxyz234567"
+
+// PercentComplete estimates how much of the satellite namespace the GC filewalker
+// has walked, from the position of the current prefix among all prefixes.
+func (progress GCFilewalkerProgress) PercentComplete() float64 {
+	if len(progress.Prefix) != 2 {
+		return 0
+	}
+	first := strings.IndexByte(prefixOrder, progress.Prefix[0])
+	second := strings.IndexByte(prefixOrder, progress.Prefix[1])
+	if first < 0 || second < 0 {
+		return 0
+	}
+	return float64(first*len(prefixOrder)+second) * 100 / float64(len(prefixOrder)*len(prefixOrder))
+}
+
 // PrefixUsedSpace contains the used space information of a prefix.
 type PrefixUsedSpace struct {
 	Prefix      string
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
 
 	"storj.io/common/testcontext"
 	"storj.io/common/testrand"
@@ -40,6 +41,16 @@ func TestGCFilewalkerDB_GetInsert(t *testing.T) {
 			require.Equal(t, result.Prefix, progress.Prefix)
 		})
 
+		fw := pieces.NewFileWalker(zaptest.NewLogger(t), nil, nil, gcFilewalkerDB)
+
+		t.Run("filewalker progress", func(t *testing.T) {
+			result, ok, err := fw.GCProgress(ctx, progress.SatelliteID)
+			require.NoError(t, err)
+			require.True(t, ok)
+			require.Equal(t, progress.Prefix, result.Prefix)
+			require.Greater(t, result.PercentComplete(), 70.0)
+		})
+
 		t.Run("reset", func(t *testing.T) {
 			err := gcFilewalkerDB.Reset(ctx, progress.SatelliteID)
 			require.NoError(t, err)
@@ -47,10 +58,33 @@ func TestGCFilewalkerDB_GetInsert(t *testing.T) {
 			result, err := gcFilewalkerDB.Get(ctx, progress.SatelliteID)
 			require.Error(t, err)
 			require.Equal(t, pieces.GCFilewalkerProgress{SatelliteID: progress.SatelliteID}, result)
+
+			_, ok, err := fw.GCProgress(ctx, progress.SatelliteID)
+			require.NoError(t, err)
+			require.False(t, ok)
 		})
 	})
 }
 
+func TestGCFilewalkerProgress_PercentComplete(t *testing.T) {
+	for _, test := range []struct {
+		prefix  string
+		percent float64
+	}{
+		{prefix: "", percent: 0},
+		{prefix: "aa", percent: 0},
+		{prefix: "ab", percent: 100.0 / 1024},
+		{prefix: "ba", percent: 100.0 * 32 / 1024},
+		{prefix: "2a", percent: 100.0 * 26 * 32 / 1024},
+		{prefix: "77", percent: 100.0 * 1023 / 1024},
+		{prefix: "a", percent: 0},
+		{prefix: "a1", percent: 0},
+	} {
+		progress := pieces.GCFilewalkerProgress{Prefix: test.prefix}
+		require.InDelta(t, test.percent, progress.PercentComplete(), 1e-9, test.prefix)
+	}
+}
+
 func TestUsedSpacePerPrefix_GetInsert(t *testing.T) {
 	storagenodedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db storagenode.DB) {
 		usedSpacePerPrefixDB := db.UsedSpacePerPrefix()
@@ -821,6 +821,7 @@ func (access storedPieceAccess) Satellite() (storj.NodeID, error) {
 }
 
 // Size gives the size of the piece on disk, and the size of the content (not including the piece header, if applicable).
+// The size on disk is the allocated disk space, when the blob store accounts it.
 func (access storedPieceAccess) Size(ctx context.Context) (size, contentSize int64, err error) {
 	defer mon.Task()(&ctx)(&err)
 	stat, err := access.Stat(ctx)
@@ -832,6 +833,12 @@ func (access storedPieceAccess) Size(ctx context.Context) (size, contentSize int
 	if access.StorageFormatVersion() >= filestore.FormatV1 {
 		contentSize -= V1PieceHeaderReservedArea
 	}
+	if sizer, ok := access.BlobInfo.(blobstore.AllocatedSizer); ok {
+		size, err = sizer.AllocatedSize(ctx)
+		if err != nil {
+			return 0, 0, err
+		}
+	}
 	return size, contentSize, nil
 }
 
@@ -411,7 +411,7 @@ func TestTrashAndRestore(t *testing.T) {
 		// Empty trash by running the chore once
 		trashDur := 4 * 24 * time.Hour
 		chorectx, chorecancel := context.WithCancel(ctx)
-		chore := pieces.NewTrashChore(log, 24*time.Hour, trashDur, trust, store)
+		chore := pieces.NewTrashChore(log, 24*time.Hour, trashDur, trust, store, nil)
 		ctx.Go(func() error {
 			return chore.Run(chorectx)
 		})
@@ -12,6 +12,7 @@ import (
 
 	"storj.io/common/storj"
 	"storj.io/common/sync2"
+	"storj.io/storj/storagenode/evidence"
 	"storj.io/storj/storagenode/trust"
 )
 
@@ -21,6 +22,7 @@ type TrashChore struct {
 	trashExpiryInterval time.Duration
 	store               *Store
 	trust               *trust.Pool
+	evidence            *evidence.Mode
 
 	Cycle *sync2.Cycle
 
@@ -40,12 +42,13 @@ const (
 // NewTrashChore instantiates a new TrashChore. choreInterval is how often this
 // chore runs, and trashExpiryInterval is passed into the EmptyTrash method to
 // determine which trashed pieces should be deleted.
-func NewTrashChore(log *zap.Logger, choreInterval, trashExpiryInterval time.Duration, trust *trust.Pool, store *Store) *TrashChore {
+func NewTrashChore(log *zap.Logger, choreInterval, trashExpiryInterval time.Duration, trust *trust.Pool, store *Store, evidence *evidence.Mode) *TrashChore {
 	return &TrashChore{
 		log:                 log,
 		trashExpiryInterval: trashExpiryInterval,
 		store:               store,
 		trust:               trust,
+		evidence:            evidence,
 
 		Cycle:      sync2.NewCycle(choreInterval),
 		satellites: map[storj.NodeID]*sync2.Workplace{},
@@ -60,6 +63,11 @@ func (chore *TrashChore) Run(ctx context.Context) (err error) {
 	chore.started.Release()
 
 	err = chore.Cycle.Run(ctx, func(ctx context.Context) error {
+		if chore.evidence.Frozen() {
+			chore.log.Info("emptying trash skipped, evidence mode is enabled")
+			return nil
+		}
+
 		chore.log.Debug("starting to empty trash")
 
 		var wg sync.WaitGroup
@@ -36,11 +36,13 @@ import (
 	"storj.io/drpc/drpcctx"
 	"storj.io/storj/storagenode/bandwidth"
 	"storj.io/storj/storagenode/blobstore/filestore"
+	"storj.io/storj/storagenode/evidence"
 	"storj.io/storj/storagenode/monitor"
 	"storj.io/storj/storagenode/orders"
 	"storj.io/storj/storagenode/orders/ordersfile"
 	"storj.io/storj/storagenode/pieces"
 	"storj.io/storj/storagenode/piecestore/usedserials"
+	"storj.io/storj/storagenode/popularity"
 	"storj.io/storj/storagenode/retain"
 	"storj.io/storj/storagenode/trust"
 	"storj.io/uplink/private/piecestore"
@@ -110,12 +112,14 @@ type Endpoint struct {
 	ordersStore  *orders.FileStore
 	usedSerials  *usedserials.Table
 	pieceDeleter *pieces.Deleter
+	evidence     *evidence.Mode
+	popularity   *popularity.Tracker
 
 	liveRequests int32
 }
 
 // NewEndpoint creates a new piecestore endpoint.
-func NewEndpoint(log *zap.Logger, ident *identity.FullIdentity, trust *trust.Pool, monitor *monitor.Service, retain *retain.Service, pingStats pingStatsSource, store *pieces.Store, trashChore *pieces.TrashChore, pieceDeleter *pieces.Deleter, ordersStore *orders.FileStore, usage bandwidth.DB, usedSerials *usedserials.Table, config Config) (*Endpoint, error) {
+func NewEndpoint(log *zap.Logger, ident *identity.FullIdentity, trust *trust.Pool, monitor *monitor.Service, retain *retain.Service, pingStats pingStatsSource, store *pieces.Store, trashChore *pieces.TrashChore, pieceDeleter *pieces.Deleter, ordersStore *orders.FileStore, usage bandwidth.DB, usedSerials *usedserials.Table, evidence *evidence.Mode, popularity *popularity.Tracker, config Config) (*Endpoint, error) {
 	return &Endpoint{
 		log:    log,
 		config: config,
@@ -132,6 +136,8 @@ func NewEndpoint(log *zap.Logger, ident *identity.FullIdentity, trust *trust.Poo
 		usage:        usage,
 		usedSerials:  usedSerials,
 		pieceDeleter: pieceDeleter,
+		evidence:     evidence,
+		popularity:   popularity,
 
 		liveRequests: 0,
 	}, nil
@@ -160,6 +166,10 @@ func (endpoint *Endpoint) Delete(ctx context.Context, delete *pb.PieceDeleteRequ
 		return nil, rpcstatus.Wrap(rpcstatus.Unauthenticated, err)
 	}
 
+	if err := endpoint.evidence.Check(); err != nil {
+		return nil, rpcstatus.Wrap(rpcstatus.Unavailable, err)
+	}
+
 	log := endpoint.log.With(
 		zap.Stringer("Satellite ID", delete.Limit.SatelliteId),
 		zap.Stringer("Piece ID", delete.Limit.PieceId),
@@ -196,6 +206,10 @@ func (endpoint *Endpoint) DeletePieces(
 		return nil, rpcstatus.Error(rpcstatus.PermissionDenied, "delete pieces called with untrusted ID")
 	}
 
+	if err := endpoint.evidence.Check(); err != nil {
+		return nil, rpcstatus.Wrap(rpcstatus.Unavailable, err)
+	}
+
 	unhandled := endpoint.pieceDeleter.Enqueue(ctx, peer.ID, req.PieceIds)
 
 	return &pb.DeletePiecesResponse{
@@ -288,6 +302,10 @@ func (endpoint *Endpoint) Upload(stream pb.DRPCPiecestore_UploadStream) (err err
 		return rpcstatus.Error(rpcstatus.Unavailable, errMsg)
 	}
 
+	if err := endpoint.evidence.Check(); err != nil {
+		return rpcstatus.Wrap(rpcstatus.Unavailable, err)
+	}
+
 	startTime := time.Now().UTC()
 
 	// TODO: set maximum message size
@@ -727,6 +745,10 @@ func (endpoint *Endpoint) Download(stream pb.DRPCPiecestore_DownloadStream) (err
 		}
 	}()
 
+	if limit.Action == pb.PieceAction_GET {
+		endpoint.popularity.Observe(limit.SatelliteId, limit.PieceId)
+	}
+
 	// for repair traffic, send along the PieceHash and original OrderLimit for validation
 	// before sending the piece itself
 	if message.Limit.Action == pb.PieceAction_GET_REPAIR {
@@ -954,6 +976,10 @@ func (endpoint *Endpoint) RestoreTrash(ctx context.Context, restoreTrashReq *pb.
 		return nil, rpcstatus.Error(rpcstatus.PermissionDenied, "RestoreTrash called with untrusted ID")
 	}
 
+	if err := endpoint.evidence.Check(); err != nil {
+		return nil, rpcstatus.Wrap(rpcstatus.Unavailable, err)
+	}
+
 	err = endpoint.trashChore.StartRestore(ctx, peer.ID)
 	if err != nil {
 		return nil, rpcstatus.Error(rpcstatus.Internal, "failed to start restore")
@@ -996,6 +1022,13 @@ func (endpoint *Endpoint) Retain(ctx context.Context, retainReq *pb.RetainReques
 }
 
 func (endpoint *Endpoint) processRetainReq(peerID storj.NodeID, retainReq *pb.RetainRequest) (res *pb.RetainResponse, err error) {
+	// the satellite sends a new bloom filter with its next garbage collection,
+	// so the request is dropped rather than refused.
+	if endpoint.evidence.Frozen() {
+		endpoint.log.Info("Retain job dropped (evidence mode)", zap.Stringer("Satellite ID", peerID))
+		return &pb.RetainResponse{}, nil
+	}
+
 	filter, err := bloomfilter.NewFromBytes(retainReq.GetFilter())
 	if err != nil {
 		return nil, rpcstatus.Wrap(rpcstatus.InvalidArgument, err)
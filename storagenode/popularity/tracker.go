@@ -0,0 +1,281 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package popularity approximates how often the stored pieces are downloaded.
+package popularity
+
+import (
+	"context"
+	"hash/maphash"
+	"math/bits"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"go.uber.org/zap"
+
+	"storj.io/common/storj"
+	"storj.io/common/sync2"
+)
+
+var mon = monkit.Package()
+
+// Config defines the piece popularity tracking parameters.
+type Config struct {
+	Enabled       bool          `help:"whether to track the approximate download frequency of pieces" default:"false"`
+	Width         int           `help:"number of counters in each row of the count-min sketch" default:"65536"`
+	Depth         int           `help:"number of rows of the count-min sketch" default:"4"`
+	DecayInterval time.Duration `help:"how often the tracked access counts are halved" default:"1h0m0s"`
+}
+
+// levels is the number of power of two access counts, which are tracked for
+// the distribution. The last level contains pieces with at least 2^30 accesses.
+const levels = 31
+
+// shardCount is the number of independently locked parts of the sketch, so
+// concurrent downloads rarely wait for each other. It must be a power of two.
+const shardCount = 16
+
+// Bucket contains the approximate number of pieces which were accessed at
+// least MinAccesses times.
+type Bucket struct {
+	MinAccesses int64 `json:"minAccesses"`
+	Pieces      int64 `json:"pieces"`
+}
+
+// Distribution describes the approximate popularity of the downloaded pieces.
+//
+// Access counts decay over time, so the distribution describes the recent
+// downloads, rather than all downloads since the start of the node.
+type Distribution struct {
+	Accesses int64    `json:"accesses"`
+	Buckets  []Bucket `json:"buckets"`
+}
+
+// Tracker counts piece downloads with a count-min sketch, which uses a fixed
+// amount of memory regardless of the number of pieces. The counts are
+// overestimated on hash collisions, never underestimated.
+//
+// The sketch is split into shards by the piece hash, each with its own lock,
+// so downloads of different pieces don't contend on a single lock.
+//
+// A nil Tracker doesn't track anything.
+type Tracker struct {
+	log    *zap.Logger
+	config Config
+	seed   maphash.Seed
+
+	Loop *sync2.Cycle
+
+	shards [shardCount]shard
+}
+
+// shard is an independently locked part of the sketch.
+type shard struct {
+	mu       sync.Mutex
+	rows     [][]uint32
+	accesses int64
+	// reached[k] is the number of pieces whose count has reached 2^k.
+	reached [levels]int64
+}
+
+// NewTracker creates a new piece popularity tracker.
+func NewTracker(log *zap.Logger, config Config) *Tracker {
+	if config.Width <= 0 {
+		config.Width = 65536
+	}
+	if config.Depth <= 0 {
+		config.Depth = 4
+	}
+
+	width := config.Width / shardCount
+	if width < 1 {
+		width = 1
+	}
+
+	tracker := &Tracker{
+		log:    log,
+		config: config,
+		seed:   maphash.MakeSeed(),
+		Loop:   sync2.NewCycle(config.DecayInterval),
+	}
+	for i := range tracker.shards {
+		rows := make([][]uint32, config.Depth)
+		for k := range rows {
+			rows[k] = make([]uint32, width)
+		}
+		tracker.shards[i].rows = rows
+	}
+	return tracker
+}
+
+// Run periodically halves the access counts.
+func (tracker *Tracker) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return tracker.Loop.Run(ctx, func(ctx context.Context) error {
+		tracker.Decay()
+		return nil
+	})
+}
+
+// Close stops the tracker.
+func (tracker *Tracker) Close() error {
+	tracker.Loop.Close()
+	return nil
+}
+
+// Observe records a download of the piece.
+func (tracker *Tracker) Observe(satelliteID storj.NodeID, pieceID storj.PieceID) {
+	if tracker == nil {
+		return
+	}
+
+	h1, h2 := tracker.hash(satelliteID, pieceID)
+	shard := tracker.shard(h1)
+
+	shard.mu.Lock()
+	defer shard.mu.Unlock()
+
+	shard.accesses++
+
+	// Conservative update: only the counters holding the current estimate are
+	// incremented, which reduces the overestimation caused by collisions.
+	estimate := shard.estimate(h1, h2)
+	if estimate == ^uint32(0) {
+		return
+	}
+	for i, row := range shard.rows {
+		index := shard.index(h1, h2, i)
+		if row[index] == estimate {
+			row[index]++
+		}
+	}
+
+	estimate++
+	if estimate&(estimate-1) == 0 {
+		if level := bits.TrailingZeros32(estimate); level < levels {
+			shard.reached[level]++
+		}
+	}
+}
+
+// Estimate returns the approximate recent number of downloads of the piece.
+func (tracker *Tracker) Estimate(satelliteID storj.NodeID, pieceID storj.PieceID) int64 {
+	if tracker == nil {
+		return 0
+	}
+
+	h1, h2 := tracker.hash(satelliteID, pieceID)
+	shard := tracker.shard(h1)
+
+	shard.mu.Lock()
+	defer shard.mu.Unlock()
+
+	return int64(shard.estimate(h1, h2))
+}
+
+// Decay halves all access counts, so that old downloads gradually stop
+// affecting the distribution. The shards are halved one at a time.
+func (tracker *Tracker) Decay() {
+	if tracker == nil {
+		return
+	}
+
+	for i := range tracker.shards {
+		tracker.shards[i].decay()
+	}
+}
+
+// Distribution returns the approximate popularity distribution of the pieces.
+func (tracker *Tracker) Distribution() Distribution {
+	if tracker == nil {
+		return Distribution{}
+	}
+
+	var accesses int64
+	var reached [levels]int64
+	for i := range tracker.shards {
+		shard := &tracker.shards[i]
+		shard.mu.Lock()
+		accesses += shard.accesses
+		for level, pieces := range shard.reached {
+			reached[level] += pieces
+		}
+		shard.mu.Unlock()
+	}
+
+	distribution := Distribution{Accesses: accesses}
+	for level, pieces := range reached {
+		if pieces == 0 {
+			break
+		}
+		distribution.Buckets = append(distribution.Buckets, Bucket{
+			MinAccesses: int64(1) << level,
+			Pieces:      pieces,
+		})
+	}
+	return distribution
+}
+
+// Stats implements monkit.StatSource to expose the distribution on the debug endpoint.
+func (tracker *Tracker) Stats(cb func(key monkit.SeriesKey, field string, val float64)) {
+	distribution := tracker.Distribution()
+
+	key := monkit.NewSeriesKey("piece_popularity")
+	cb(key, "accesses", float64(distribution.Accesses))
+	for _, bucket := range distribution.Buckets {
+		cb(key, "pieces_min_accesses_"+strconv.FormatInt(bucket.MinAccesses, 10), float64(bucket.Pieces))
+	}
+}
+
+func (tracker *Tracker) hash(satelliteID storj.NodeID, pieceID storj.PieceID) (h1, h2 uint32) {
+	var h maphash.Hash
+	h.SetSeed(tracker.seed)
+	_, _ = h.Write(satelliteID[:])
+	_, _ = h.Write(pieceID[:])
+	sum := h.Sum64()
+
+	// the second hash must be odd to visit different counters in each row.
+	return uint32(sum), uint32(sum>>32) | 1
+}
+
+// shard returns the shard of the piece. The shard is derived from the high
+// bits of a multiplicative hash, so it doesn't fix any bits of the counter
+// indexes within the shard.
+func (tracker *Tracker) shard(h1 uint32) *shard {
+	return &tracker.shards[(h1*0x9E3779B9)>>(32-bits.Len32(shardCount-1))]
+}
+
+func (shard *shard) decay() {
+	shard.mu.Lock()
+	defer shard.mu.Unlock()
+
+	for _, row := range shard.rows {
+		for i := range row {
+			row[i] >>= 1
+		}
+	}
+	shard.accesses /= 2
+
+	// pieces which had reached 2^(k+1) now have at least 2^k accesses.
+	copy(shard.reached[:], shard.reached[1:])
+	shard.reached[levels-1] = 0
+}
+
+// estimate returns the smallest counter of the piece. shard.mu must be held.
+func (shard *shard) estimate(h1, h2 uint32) uint32 {
+	estimate := uint32(0)
+	for i, row := range shard.rows {
+		count := row[shard.index(h1, h2, i)]
+		if i == 0 || count < estimate {
+			estimate = count
+		}
+	}
+	return estimate
+}
+
+func (shard *shard) index(h1, h2 uint32, row int) int {
+	return int((h1 + uint32(row)*h2) % uint32(len(shard.rows[row])))
+}
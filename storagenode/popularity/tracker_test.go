@@ -0,0 +1,100 @@
+// Copyright (C) 2024 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package popularity_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/storj"
+	"storj.io/common/testrand"
+	"storj.io/storj/storagenode/popularity"
+)
+
+func TestTracker(t *testing.T) {
+	tracker := popularity.NewTracker(zaptest.NewLogger(t), popularity.Config{Width: 1024, Depth: 4})
+
+	satellite := testrand.NodeID()
+	hot, warm, cold := testrand.PieceID(), testrand.PieceID(), testrand.PieceID()
+
+	observe := func(pieceID storj.PieceID, times int) {
+		for i := 0; i < times; i++ {
+			tracker.Observe(satellite, pieceID)
+		}
+	}
+	observe(hot, 16)
+	observe(warm, 4)
+	observe(cold, 1)
+
+	require.EqualValues(t, 16, tracker.Estimate(satellite, hot))
+	require.EqualValues(t, 4, tracker.Estimate(satellite, warm))
+	require.EqualValues(t, 1, tracker.Estimate(satellite, cold))
+	require.Zero(t, tracker.Estimate(satellite, testrand.PieceID()))
+
+	require.Equal(t, popularity.Distribution{
+		Accesses: 21,
+		Buckets: []popularity.Bucket{
+			{MinAccesses: 1, Pieces: 3},
+			{MinAccesses: 2, Pieces: 2},
+			{MinAccesses: 4, Pieces: 2},
+			{MinAccesses: 8, Pieces: 1},
+			{MinAccesses: 16, Pieces: 1},
+		},
+	}, tracker.Distribution())
+
+	tracker.Decay()
+
+	require.EqualValues(t, 8, tracker.Estimate(satellite, hot))
+	require.EqualValues(t, 2, tracker.Estimate(satellite, warm))
+	require.Zero(t, tracker.Estimate(satellite, cold))
+
+	require.Equal(t, popularity.Distribution{
+		Accesses: 10,
+		Buckets: []popularity.Bucket{
+			{MinAccesses: 1, Pieces: 2},
+			{MinAccesses: 2, Pieces: 2},
+			{MinAccesses: 4, Pieces: 1},
+			{MinAccesses: 8, Pieces: 1},
+		},
+	}, tracker.Distribution())
+}
+
+func TestTracker_Nil(t *testing.T) {
+	var tracker *popularity.Tracker
+	tracker.Observe(testrand.NodeID(), testrand.PieceID())
+	tracker.Decay()
+	require.Zero(t, tracker.Estimate(testrand.NodeID(), testrand.PieceID()))
+	require.Equal(t, popularity.Distribution{}, tracker.Distribution())
+}
+
+func TestTracker_Concurrent(t *testing.T) {
+	tracker := popularity.NewTracker(zaptest.NewLogger(t), popularity.Config{Width: 1024, Depth: 4})
+
+	satellite := testrand.NodeID()
+	pieces := make([]storj.PieceID, 8)
+	for i := range pieces {
+		pieces[i] = testrand.PieceID()
+	}
+
+	var wg sync.WaitGroup
+	for _, pieceID := range pieces {
+		pieceID := pieceID
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 100; i++ {
+				tracker.Observe(satellite, pieceID)
+			}
+		}()
+	}
+	wg.Wait()
+
+	for _, pieceID := range pieces {
+		require.GreaterOrEqual(t, tracker.Estimate(satellite, pieceID), int64(100))
+	}
+	require.EqualValues(t, 800, tracker.Distribution().Accesses)
+}
@@ -19,6 +19,7 @@ import (
 	"storj.io/common/pb"
 	"storj.io/common/storj"
 	"storj.io/storj/storagenode/blobstore/filestore"
+	"storj.io/storj/storagenode/evidence"
 	"storj.io/storj/storagenode/pieces"
 )
 
@@ -133,11 +134,12 @@ type Service struct {
 	closed     chan struct{}
 	started    bool
 
-	store *pieces.Store
+	store    *pieces.Store
+	evidence *evidence.Mode
 }
 
 // NewService creates a new retain service.
-func NewService(log *zap.Logger, store *pieces.Store, config Config) *Service {
+func NewService(log *zap.Logger, store *pieces.Store, evidence *evidence.Mode, config Config) *Service {
 	log = log.With(zap.String("cachePath", config.CachePath))
 	cache, err := NewRequestStore(config.CachePath)
 	if err != nil {
@@ -153,7 +155,8 @@ func NewService(log *zap.Logger, store *pieces.Store, config Config) *Service {
 		working: make(map[storj.NodeID]struct{}),
 		closed:  make(chan struct{}),
 
-		store: store,
+		store:    store,
+		evidence: evidence,
 	}
 }
 
@@ -358,6 +361,13 @@ func (s *Service) retainPieces(ctx context.Context, req Request) (err error) {
 
 	defer mon.Task()(&ctx, req.SatelliteID, req.CreatedBefore)(&err)
 
+	// requests queued before evidence mode was enabled are dropped, the
+	// satellite sends a new bloom filter with its next garbage collection.
+	if s.evidence.Frozen() {
+		s.log.Info("Retain request dropped, evidence mode is enabled", zap.Stringer("Satellite ID", req.SatelliteID))
+		return nil
+	}
+
 	satelliteID := req.SatelliteID
 	filter := req.Filter
 